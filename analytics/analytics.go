@@ -0,0 +1,154 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/models"
+)
+
+const (
+	// ArticleViews is the name of the buffered article views.
+	ArticleViews = "article_views"
+	// SearchQueries is the name of the buffered search queries and clicks.
+	SearchQueries = "search_queries"
+	// SessionEvents is the name of the buffered session funnel events.
+	SessionEvents = "session_events"
+
+	// expireInterval is the interval deleting the analytics older than the retention.
+	expireInterval = time.Hour
+)
+
+// flushFunc flushes the buffered analytics, returns the number of the flushed rows.
+type flushFunc func(ctx context.Context) (int, error)
+
+// deleteFunc deletes the analytics before the time, returns the number of the deleted rows.
+type deleteFunc func(ctx context.Context, before time.Time) (int, error)
+
+// Flusher flushes the analytics buffered in Redis into Postgres periodically,
+// the buffers are shared by the replicas so any of them can flush.
+type Flusher struct {
+	logger   *zerolog.Logger
+	interval time.Duration
+	flushes  map[string]flushFunc
+	// retention is how long the analytics carrying the free text queries are kept by deletes, 0 means forever.
+	retention time.Duration
+	deletes   map[string]deleteFunc
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// New returns a Flusher instance flushing the article views and the search queries and starts flushing,
+// the search queries and the session events older than the retention are deleted as well.
+func New(conf *config.Config, logger *zerolog.Logger, service models.Service) (*Flusher, error) {
+	if conf.Analytics.FlushIntervalSec <= 0 {
+		return nil, errors.Errorf("analytics: [New] flush interval:%d should be positive", conf.Analytics.FlushIntervalSec)
+	}
+
+	f := newFlusher(logger,
+		time.Duration(conf.Analytics.FlushIntervalSec)*time.Second,
+		map[string]flushFunc{
+			ArticleViews:  service.FlushArticleViews,
+			SearchQueries: service.FlushSearchQueries,
+			SessionEvents: service.FlushSessionEvents,
+		},
+	)
+	f.retention = time.Duration(conf.Analytics.RetentionDays) * 24 * time.Hour
+	f.deletes = map[string]deleteFunc{
+		SearchQueries: service.DeleteSearchQueriesBefore,
+		SessionEvents: service.DeleteSessionEventsBefore,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	f.cancel = cancel
+	go f.run(ctx)
+
+	return f, nil
+}
+
+func newFlusher(logger *zerolog.Logger, interval time.Duration, flushes map[string]flushFunc) *Flusher {
+	return &Flusher{
+		logger:   logger,
+		interval: interval,
+		flushes:  flushes,
+		cancel:   func() {},
+		done:     make(chan struct{}),
+	}
+}
+
+func (f *Flusher) run(ctx context.Context) {
+	defer close(f.done)
+
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+
+	var expire <-chan time.Time
+	if f.retention > 0 {
+		f.expire(ctx)
+		expireTicker := time.NewTicker(expireInterval)
+		defer expireTicker.Stop()
+		expire = expireTicker.C
+	}
+
+	for {
+		select {
+		case <-ticker.C:
+			f.flush(ctx)
+		case <-expire:
+			f.expire(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// flush flushes all the buffers, the failures are logged and retried by the next flush.
+func (f *Flusher) flush(ctx context.Context) {
+	for name, fn := range f.flushes {
+		n, err := fn(ctx)
+		if err != nil {
+			f.logger.Error().Err(err).Fields(map[string]interface{}{
+				"buffer": name,
+			}).Msgf("analytics: [flush] flush failed")
+			continue
+		}
+		if n > 0 {
+			f.logger.Debug().Fields(map[string]interface{}{
+				"buffer": name,
+				"rows":   n,
+			}).Msgf("analytics: [flush] flushed")
+		}
+	}
+}
+
+// expire deletes the analytics older than the retention, the failures are logged and retried by the next expire.
+func (f *Flusher) expire(ctx context.Context) {
+	before := time.Now().UTC().Add(-f.retention)
+	for name, fn := range f.deletes {
+		n, err := fn(ctx, before)
+		if err != nil {
+			f.logger.Error().Err(err).Fields(map[string]interface{}{
+				"table": name,
+			}).Msgf("analytics: [expire] delete failed")
+			continue
+		}
+		if n > 0 {
+			f.logger.Info().Fields(map[string]interface{}{
+				"table": name,
+				"rows":  n,
+			}).Msgf("analytics: [expire] deleted")
+		}
+	}
+}
+
+// Close stops flushing, the views not flushed yet are kept in the buffer for the other replicas.
+func (f *Flusher) Close() error {
+	f.cancel()
+	<-f.done
+	return nil
+}
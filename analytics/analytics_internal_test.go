@@ -0,0 +1,96 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/models"
+)
+
+var logger = zerolog.New(ioutil.Discard)
+
+func TestFlusherFlush(t *testing.T) {
+	calls := make(map[string]int)
+	flushReturn := func(name string, err error) flushFunc {
+		return func(ctx context.Context) (int, error) {
+			calls[name]++
+			return 1, err
+		}
+	}
+
+	f := newFlusher(&logger, time.Minute, map[string]flushFunc{
+		ArticleViews: flushReturn(ArticleViews, nil),
+		"broken":     flushReturn("broken", errors.New("postgres down")),
+	})
+	f.flush(context.Background())
+	f.flush(context.Background())
+
+	// The failed buffer doesn't block the others and is retried by the next flush.
+	for _, name := range []string{ArticleViews, "broken"} {
+		if calls[name] != 2 {
+			t.Errorf("[%s] expect 2 flushes, actual:%d", name, calls[name])
+		}
+	}
+}
+
+func TestFlusherExpire(t *testing.T) {
+	var befores []time.Time
+	f := newFlusher(&logger, time.Minute, nil)
+	f.retention = 90 * 24 * time.Hour
+	f.deletes = map[string]deleteFunc{
+		SessionEvents: func(ctx context.Context, before time.Time) (int, error) {
+			befores = append(befores, before)
+			return 1, nil
+		},
+		"broken": func(ctx context.Context, before time.Time) (int, error) {
+			return 0, errors.New("postgres down")
+		},
+	}
+
+	start := time.Now()
+	f.expire(context.Background())
+
+	if len(befores) != 1 {
+		t.Fatalf("expect 1 delete, actual:%d", len(befores))
+	}
+	if expect := start.Add(-f.retention); befores[0].Before(expect.Add(-time.Second)) || befores[0].After(expect.Add(time.Second)) {
+		t.Errorf("expect deleting before:%v, actual:%v", expect, befores[0])
+	}
+}
+
+func TestNew(t *testing.T) {
+	service := models.NewMockService()
+	service.RecordArticleView(context.Background(), 1, "en-us", "tw")
+
+	if _, err := New(&config.Config{Analytics: &config.Analytics{FlushIntervalSec: 0}}, &logger, service); err == nil {
+		t.Errorf("expect an error of the zero interval, actual none")
+	}
+
+	// The event older than the retention is deleted when the flusher starts.
+	service.RecordSessionEvent(context.Background(), &models.SessionEvent{
+		SessionID: "session", Kind: models.SearchSessionEvent, CountryCode: "tw", CreatedAt: time.Now().AddDate(0, 0, -2),
+	})
+
+	f, err := New(&config.Config{Analytics: &config.Analytics{FlushIntervalSec: 1, RetentionDays: 1}}, &logger, service)
+	if err != nil {
+		t.Fatalf("expect no error, actual:%v", err)
+	}
+	defer f.Close()
+
+	deadline := time.Now().Add(3 * time.Second)
+	for len(service.RecordedArticleViews()) > 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("expect the views flushed, actual:%v", service.RecordedArticleViews())
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	if events := service.RecordedSessionEvents(); len(events) != 0 {
+		t.Errorf("expect the expired events deleted, actual:%v", events)
+	}
+}
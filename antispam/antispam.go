@@ -0,0 +1,226 @@
+package antispam
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/errs"
+	"github.com/honestbee/Zen/models"
+)
+
+var (
+	// ErrHoneypotFilled means the hidden honeypot field is filled, most likely by a bot.
+	ErrHoneypotFilled = errors.New("antispam: honeypot field is filled")
+	// ErrCaptchaFailed means the captcha token is missing or invalid.
+	ErrCaptchaFailed = errors.New("antispam: captcha verification failed")
+	// ErrIPRateLimited means the remote ip sent too many requests in the window.
+	ErrIPRateLimited = errors.New("antispam: too many requests from the ip")
+	// ErrEmailRateLimited means the requester email sent too many requests in the window.
+	ErrEmailRateLimited = errors.New("antispam: too many requests from the email")
+	// ErrDuplicateContent means the same content has been submitted in the window.
+	ErrDuplicateContent = errors.New("antispam: duplicate request content")
+)
+
+// reasons maps the rejected errors to the reason recorded for review.
+var reasons = map[error]string{
+	ErrHoneypotFilled:   "honeypot",
+	ErrCaptchaFailed:    "captcha",
+	ErrIPRateLimited:    "ip_rate_limit",
+	ErrEmailRateLimited: "email_rate_limit",
+	ErrDuplicateContent: "duplicate",
+}
+
+const (
+	ipRateKind    = "ip"
+	emailRateKind = "email"
+)
+
+// Submission is a create request submission to be checked.
+type Submission struct {
+	Source       string
+	RemoteIP     string
+	CountryCode  string
+	Email        string
+	Subject      string
+	Body         string
+	CaptchaToken string
+	Honeypot     string
+}
+
+// Guard checks the create request submissions before they reach zendesk.
+type Guard struct {
+	logger   *zerolog.Logger
+	service  models.Service
+	verifier Verifier
+
+	// mu guards conf, its rate limits are replaced by Reload.
+	mu   sync.RWMutex
+	conf *config.Antispam
+}
+
+// New returns a Guard instance, a nil verifier skips the captcha verification.
+func New(conf *config.Config, logger *zerolog.Logger, service models.Service, verifier Verifier) (*Guard, error) {
+	return &Guard{
+		conf:     conf.Antispam,
+		logger:   logger,
+		service:  service,
+		verifier: verifier,
+	}, nil
+}
+
+// Check returns one of the rejected errors if the submission looks like spam,
+// the rejected submission will be recorded for review.
+// Errors from the verifier or the storage are logged and let the submission pass,
+// so a broken dependency does not block the support queue.
+func (g *Guard) Check(ctx context.Context, sub *Submission) error {
+	if !g.config().Enable {
+		return nil
+	}
+
+	if err := g.check(ctx, sub); err != nil {
+		g.reject(ctx, sub, err)
+		return err
+	}
+	return nil
+}
+
+// Release forgets the content of the submission passed Check, it is called if the request
+// failed to reach zendesk, so that the retry is not rejected as a duplicate.
+func (g *Guard) Release(ctx context.Context, sub *Submission) {
+	if !g.config().Enable {
+		return
+	}
+
+	email := strings.ToLower(strings.TrimSpace(sub.Email))
+	if err := g.service.UnmarkRequestDigest(ctx, digest(email, sub.Subject, sub.Body)); err != nil {
+		g.logger.Error().Err(err).Msgf("antispam: [Release] service.UnmarkRequestDigest failed")
+	}
+}
+
+func (g *Guard) check(ctx context.Context, sub *Submission) error {
+	conf := g.config()
+
+	if sub.Honeypot != "" {
+		return ErrHoneypotFilled
+	}
+
+	if g.verifier != nil {
+		ok, err := g.verifier.Verify(ctx, sub.CaptchaToken, sub.RemoteIP)
+		if err != nil {
+			g.logger.Error().Err(err).Msgf("antispam: [check] verifier.Verify failed")
+		} else if !ok {
+			return ErrCaptchaFailed
+		}
+	}
+
+	if sub.RemoteIP != "" && g.overLimit(ctx, ipRateKind, sub.RemoteIP, conf.IPRateLimit, conf.RateLimitWindowSec) {
+		return ErrIPRateLimited
+	}
+
+	email := strings.ToLower(strings.TrimSpace(sub.Email))
+	if email != "" && g.overLimit(ctx, emailRateKind, email, conf.EmailRateLimit, conf.RateLimitWindowSec) {
+		return ErrEmailRateLimited
+	}
+
+	isNew, err := g.service.MarkRequestDigest(ctx, digest(email, sub.Subject, sub.Body), conf.DuplicateWindowSec)
+	if err != nil {
+		g.logger.Error().Err(err).Msgf("antispam: [check] service.MarkRequestDigest failed")
+	} else if !isNew {
+		return ErrDuplicateContent
+	}
+
+	return nil
+}
+
+func (g *Guard) overLimit(ctx context.Context, kind, identity string, limit, windowSec int) bool {
+	if limit <= 0 {
+		return false
+	}
+
+	count, err := g.service.PlusOneRequestRateCounter(ctx, kind, identity, windowSec)
+	if err != nil {
+		g.logger.Error().Err(err).Msgf("antispam: [overLimit] service.PlusOneRequestRateCounter kind:%s failed", kind)
+		return false
+	}
+	return count > limit
+}
+
+// Reload replaces the rate limits and the windows by the ones of conf, the other settings are kept.
+func (g *Guard) Reload(conf *config.Config) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	next := *g.conf
+	next.IPRateLimit = conf.Antispam.IPRateLimit
+	next.EmailRateLimit = conf.Antispam.EmailRateLimit
+	next.RateLimitWindowSec = conf.Antispam.RateLimitWindowSec
+	next.DuplicateWindowSec = conf.Antispam.DuplicateWindowSec
+	g.conf = &next
+}
+
+func (g *Guard) config() *config.Antispam {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.conf
+}
+
+func (g *Guard) reject(ctx context.Context, sub *Submission, reason error) {
+	err := g.service.CreateRejectedRequest(ctx, &models.RejectedRequest{
+		Source:      sub.Source,
+		Reason:      reasons[reason],
+		RemoteIP:    sub.RemoteIP,
+		CountryCode: sub.CountryCode,
+		Email:       sub.Email,
+		Subject:     sub.Subject,
+		Body:        sub.Body,
+		CreatedAt:   time.Now().UTC(),
+	})
+	if err != nil {
+		g.logger.Error().Err(err).Msgf("antispam: [reject] service.CreateRejectedRequest failed")
+	}
+}
+
+// digest returns the content fingerprint used by the duplicate detector.
+func digest(email, subject, body string) string {
+	h := sha256.New()
+	for _, s := range []string{email, subject, body} {
+		h.Write([]byte(strings.TrimSpace(s)))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// RejectedErr wraps the rejected error returned by Check with the matching error code.
+func RejectedErr(err error, msg string) *errs.Error {
+	switch err {
+	case ErrIPRateLimited, ErrEmailRateLimited:
+		return errs.NewErr(errs.TooManyRequestsErrCode, errors.Wrap(err, msg))
+	default:
+		return errs.NewErr(errs.InvalidAttributeErrorCode, errors.Wrap(err, msg))
+	}
+}
+
+// RemoteIPMetadata is the gRPC metadata key the gateway forwards the client ip in, the ip of
+// the http request is lost by the in process calls otherwise.
+const RemoteIPMetadata = "x-real-ip"
+
+type remoteIPKey struct{}
+
+// WithRemoteIP returns a copy of ctx carrying the client remote ip.
+func WithRemoteIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, remoteIPKey{}, ip)
+}
+
+// RemoteIPFromContext returns the client remote ip carried by ctx.
+func RemoteIPFromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(remoteIPKey{}).(string)
+	return ip
+}
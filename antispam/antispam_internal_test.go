@@ -0,0 +1,223 @@
+package antispam
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/models"
+)
+
+const fakeToken = "fake-captcha-token"
+
+func newTestGuard(enable bool) (*Guard, *models.MockModels) {
+	logger := zerolog.New(ioutil.Discard)
+	ms := models.NewMockService()
+	g, _ := New(&config.Config{
+		Antispam: &config.Antispam{
+			Enable:             enable,
+			IPRateLimit:        10,
+			EmailRateLimit:     5,
+			RateLimitWindowSec: 3600,
+			DuplicateWindowSec: 86400,
+		},
+	}, &logger, ms, &FakeVerifier{Token: fakeToken})
+	return g, ms
+}
+
+func TestCheck(t *testing.T) {
+	testCases := [...]struct {
+		description  string
+		enable       bool
+		input        *Submission
+		expectErr    error
+		expectRecord bool
+	}{
+		{
+			description: "testing normal case",
+			enable:      true,
+			input: &Submission{
+				RemoteIP:     "10.0.0.1",
+				Email:        "zen.project.tester@honestbee.com",
+				Subject:      "testing normal case",
+				Body:         "testing, please ignore!!!",
+				CaptchaToken: fakeToken,
+			},
+		},
+		{
+			description: "testing disabled case",
+			enable:      false,
+			input: &Submission{
+				Honeypot: "http://spam.example.com",
+			},
+		},
+		{
+			description: "testing honeypot filled case",
+			enable:      true,
+			input: &Submission{
+				Subject:      "testing honeypot filled case",
+				CaptchaToken: fakeToken,
+				Honeypot:     "http://spam.example.com",
+			},
+			expectErr:    ErrHoneypotFilled,
+			expectRecord: true,
+		},
+		{
+			description: "testing captcha token missing case",
+			enable:      true,
+			input: &Submission{
+				Subject: "testing captcha token missing case",
+			},
+			expectErr:    ErrCaptchaFailed,
+			expectRecord: true,
+		},
+		{
+			description: "testing captcha token invalid case",
+			enable:      true,
+			input: &Submission{
+				Subject:      "testing captcha token invalid case",
+				CaptchaToken: "invalid",
+			},
+			expectErr:    ErrCaptchaFailed,
+			expectRecord: true,
+		},
+		{
+			description: "testing ip rate limited case",
+			enable:      true,
+			input: &Submission{
+				RemoteIP:     models.RateLimitedRemoteIP,
+				Subject:      "testing ip rate limited case",
+				CaptchaToken: fakeToken,
+			},
+			expectErr:    ErrIPRateLimited,
+			expectRecord: true,
+		},
+		{
+			description: "testing email rate limited case",
+			enable:      true,
+			input: &Submission{
+				RemoteIP:     "10.0.0.1",
+				Email:        " Rate.Limited@honestbee.com ",
+				Subject:      "testing email rate limited case",
+				CaptchaToken: fakeToken,
+			},
+			expectErr:    ErrEmailRateLimited,
+			expectRecord: true,
+		},
+		{
+			description: "testing rate counter failed case",
+			enable:      true,
+			input: &Submission{
+				RemoteIP:     models.RateCounterReturnErrorRemoteIP,
+				Subject:      "testing rate counter failed case",
+				CaptchaToken: fakeToken,
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			g, ms := newTestGuard(tt.enable)
+			err := g.Check(context.Background(), tt.input)
+			if err != tt.expectErr {
+				t.Errorf("[%s] expect error:%v, actual:%v", tt.description, tt.expectErr, err)
+			}
+			if ms.Sequence["CreateRejectedRequest"] != tt.expectRecord {
+				t.Errorf("[%s] expect record:%v, actual:%v", tt.description, tt.expectRecord, ms.Sequence["CreateRejectedRequest"])
+			}
+		})
+	}
+}
+
+func TestCheckDuplicateContent(t *testing.T) {
+	g, ms := newTestGuard(true)
+	sub := &Submission{
+		RemoteIP:     "10.0.0.1",
+		Email:        "zen.project.tester@honestbee.com",
+		Subject:      "testing, please ignore",
+		Body:         "testing, please ignore!!!",
+		CaptchaToken: fakeToken,
+	}
+
+	if err := g.Check(context.Background(), sub); err != nil {
+		t.Fatalf("first submission expect no error, actual:%v", err)
+	}
+
+	// Only the letter case of email and the surrounding spaces are changed.
+	dup := *sub
+	dup.Email = "Zen.Project.Tester@honestbee.com"
+	dup.Body = "  testing, please ignore!!!  "
+	if err := g.Check(context.Background(), &dup); err != ErrDuplicateContent {
+		t.Errorf("duplicate submission expect error:%v, actual:%v", ErrDuplicateContent, err)
+	}
+	if !ms.Sequence["CreateRejectedRequest"] {
+		t.Errorf("duplicate submission expect recorded")
+	}
+
+	other := *sub
+	other.Body = "another content"
+	if err := g.Check(context.Background(), &other); err != nil {
+		t.Errorf("different submission expect no error, actual:%v", err)
+	}
+}
+
+func TestRelease(t *testing.T) {
+	g, _ := newTestGuard(true)
+	sub := &Submission{
+		RemoteIP:     "10.0.0.1",
+		Email:        "zen.project.tester@honestbee.com",
+		Subject:      "testing release case",
+		Body:         "testing, please ignore!!!",
+		CaptchaToken: fakeToken,
+	}
+
+	if err := g.Check(context.Background(), sub); err != nil {
+		t.Fatalf("first submission expect no error, actual:%v", err)
+	}
+
+	// The retry after the request failed to reach zendesk is not a duplicate.
+	g.Release(context.Background(), sub)
+	if err := g.Check(context.Background(), sub); err != nil {
+		t.Errorf("retried submission expect no error, actual:%v", err)
+	}
+	if err := g.Check(context.Background(), sub); err != ErrDuplicateContent {
+		t.Errorf("duplicate submission expect error:%v, actual:%v", ErrDuplicateContent, err)
+	}
+}
+
+func TestReload(t *testing.T) {
+	g, _ := newTestGuard(true)
+	g.Reload(&config.Config{
+		Antispam: &config.Antispam{
+			Enable:             false,
+			IPRateLimit:        20,
+			EmailRateLimit:     10,
+			RateLimitWindowSec: 60,
+			DuplicateWindowSec: 600,
+		},
+	})
+
+	expect := config.Antispam{
+		Enable:             true,
+		IPRateLimit:        20,
+		EmailRateLimit:     10,
+		RateLimitWindowSec: 60,
+		DuplicateWindowSec: 600,
+	}
+	if actual := *g.config(); actual != expect {
+		t.Errorf("expect config:%+v, actual:%+v", expect, actual)
+	}
+}
+
+func TestRemoteIPContext(t *testing.T) {
+	ctx := WithRemoteIP(context.Background(), "10.0.0.1")
+	if ip := RemoteIPFromContext(ctx); ip != "10.0.0.1" {
+		t.Errorf("expect remote ip:10.0.0.1, actual:%s", ip)
+	}
+	if ip := RemoteIPFromContext(context.Background()); ip != "" {
+		t.Errorf("expect empty remote ip, actual:%s", ip)
+	}
+}
@@ -0,0 +1,127 @@
+package antispam
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/tracing"
+)
+
+const (
+	// NoneVerifier disables the captcha verification.
+	NoneVerifier = "none"
+	// RecaptchaVerifier verifies the captcha token with a reCAPTCHA compatible siteverify API.
+	RecaptchaVerifier = "recaptcha"
+	// FakeCaptchaVerifier verifies the captcha token with a fixed token, it's for local testing only.
+	FakeCaptchaVerifier = "fake"
+)
+
+// Verifier is the interface of verifying a captcha token.
+type Verifier interface {
+	// Verify returns false if the token is not a valid response, an error means
+	// the verifier can not get the verification result.
+	Verify(ctx context.Context, token, remoteIP string) (bool, error)
+}
+
+// NewVerifier returns the Verifier configured by conf, it returns nil if captcha
+// verification is disabled.
+func NewVerifier(conf *config.Config) (Verifier, error) {
+	switch conf.Antispam.CaptchaVerifier {
+	case NoneVerifier, "":
+		return nil, nil
+	case RecaptchaVerifier:
+		return NewHTTPVerifier(
+			conf.Antispam.CaptchaVerifyURL,
+			conf.Antispam.CaptchaSecret,
+			time.Duration(conf.Antispam.CaptchaTimeoutSec)*time.Second,
+		), nil
+	case FakeCaptchaVerifier:
+		return &FakeVerifier{Token: conf.Antispam.FakeCaptchaToken}, nil
+	default:
+		return nil, errors.Errorf("antispam: [NewVerifier] unknown captcha verifier:%q", conf.Antispam.CaptchaVerifier)
+	}
+}
+
+// HTTPVerifier verifies the captcha token with a reCAPTCHA compatible siteverify API.
+type HTTPVerifier struct {
+	verifyURL string
+	secret    string
+	client    *http.Client
+}
+
+// NewHTTPVerifier returns a HTTPVerifier instance.
+func NewHTTPVerifier(verifyURL, secret string, timeout time.Duration) *HTTPVerifier {
+	return &HTTPVerifier{
+		verifyURL: verifyURL,
+		secret:    secret,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+type siteVerifyResponse struct {
+	Success    bool     `json:"success"`
+	ErrorCodes []string `json:"error-codes"`
+}
+
+// Verify posts the token to the siteverify API.
+func (v *HTTPVerifier) Verify(ctx context.Context, token, remoteIP string) (bool, error) {
+	if token == "" {
+		return false, nil
+	}
+
+	form := url.Values{}
+	form.Set("secret", v.secret)
+	form.Set("response", token)
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, v.verifyURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return false, errors.Wrapf(err, "antispam: [Verify] url[%s] http NewRequest failed", v.verifyURL)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	span, ctx := tracing.StartSpan(ctx, req.URL.Path, tracing.WithKind(tracing.KindClient))
+	defer span.Finish()
+
+	resp, err := v.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return false, errors.Wrapf(err, "antispam: [Verify] url[%s] http client do failed", v.verifyURL)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, errors.Errorf("antispam: [Verify] url[%s] status expect[%v], actual[%v]",
+			v.verifyURL,
+			http.StatusOK,
+			resp.Status,
+		)
+	}
+
+	ret := new(siteVerifyResponse)
+	if err = json.NewDecoder(resp.Body).Decode(ret); err != nil {
+		return false, errors.Wrapf(err, "antispam: [Verify] url[%s] json decode failed", v.verifyURL)
+	}
+
+	return ret.Success, nil
+}
+
+// FakeVerifier is a local verifier which only accepts the configured token.
+type FakeVerifier struct {
+	Token string
+}
+
+// Verify returns true if the token equals to the configured token.
+func (v *FakeVerifier) Verify(ctx context.Context, token, remoteIP string) (bool, error) {
+	return token != "" && token == v.Token, nil
+}
@@ -0,0 +1,138 @@
+package antispam
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/honestbee/Zen/config"
+)
+
+func TestHTTPVerifierVerify(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if r.PostForm.Get("secret") != "secret" {
+			fmt.Fprint(w, `{"success":false,"error-codes":["invalid-input-secret"]}`)
+			return
+		}
+
+		switch r.PostForm.Get("response") {
+		case "valid":
+			fmt.Fprint(w, `{"success":true}`)
+		case "broken":
+			w.WriteHeader(http.StatusInternalServerError)
+		case "malformed":
+			fmt.Fprint(w, `{"success":`)
+		default:
+			fmt.Fprint(w, `{"success":false,"error-codes":["invalid-input-response"]}`)
+		}
+	}))
+	defer ts.Close()
+
+	testCases := [...]struct {
+		description string
+		secret      string
+		token       string
+		expect      bool
+		expectErr   bool
+	}{
+		{
+			description: "testing valid token case",
+			secret:      "secret",
+			token:       "valid",
+			expect:      true,
+		},
+		{
+			description: "testing invalid token case",
+			secret:      "secret",
+			token:       "invalid",
+		},
+		{
+			description: "testing empty token case",
+			secret:      "secret",
+			token:       "",
+		},
+		{
+			description: "testing invalid secret case",
+			secret:      "wrong",
+			token:       "valid",
+		},
+		{
+			description: "testing verify api status failed case",
+			secret:      "secret",
+			token:       "broken",
+			expectErr:   true,
+		},
+		{
+			description: "testing verify api json decode failed case",
+			secret:      "secret",
+			token:       "malformed",
+			expectErr:   true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			v := NewHTTPVerifier(ts.URL, tt.secret, time.Second)
+			actual, err := v.Verify(context.Background(), tt.token, "10.0.0.1")
+			if tt.expectErr && err == nil {
+				t.Errorf("[%s] expect an error, actual none", tt.description)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("[%s] expect no error, actual:%v", tt.description, err)
+			} else if actual != tt.expect {
+				t.Errorf("[%s] expect:%v, actual:%v", tt.description, tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestNewVerifier(t *testing.T) {
+	testCases := [...]struct {
+		description string
+		verifier    string
+		expectType  string
+		expectErr   bool
+	}{
+		{
+			description: "testing none verifier case",
+			verifier:    NoneVerifier,
+			expectType:  "<nil>",
+		},
+		{
+			description: "testing recaptcha verifier case",
+			verifier:    RecaptchaVerifier,
+			expectType:  "*antispam.HTTPVerifier",
+		},
+		{
+			description: "testing fake verifier case",
+			verifier:    FakeCaptchaVerifier,
+			expectType:  "*antispam.FakeVerifier",
+		},
+		{
+			description: "testing unknown verifier case",
+			verifier:    "unknown",
+			expectType:  "<nil>",
+			expectErr:   true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			v, err := NewVerifier(&config.Config{
+				Antispam: &config.Antispam{CaptchaVerifier: tt.verifier},
+			})
+			if tt.expectErr != (err != nil) {
+				t.Errorf("[%s] expect error:%v, actual:%v", tt.description, tt.expectErr, err)
+			}
+			if actual := fmt.Sprintf("%T", v); actual != tt.expectType {
+				t.Errorf("[%s] expect type:%s, actual:%s", tt.description, tt.expectType, actual)
+			}
+		})
+	}
+}
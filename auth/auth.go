@@ -0,0 +1,292 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/errs"
+)
+
+const (
+	// ScopeSyncWrite allows triggering the force sync jobs.
+	ScopeSyncWrite = "sync:write"
+	// ScopeTicketsCreate allows creating the zendesk requests.
+	ScopeTicketsCreate = "tickets:create"
+	// ScopeAnalyticsRead allows reading the search query reports.
+	ScopeAnalyticsRead = "analytics:read"
+)
+
+const (
+	// APIKeyHeader is the http header carrying the api key.
+	APIKeyHeader = "X-Api-Key"
+	// bearerPrefix is the authorization header prefix of the JWTs.
+	bearerPrefix = "Bearer "
+	// basicPrefix is the authorization header prefix of the basic auth credentials.
+	basicPrefix = "Basic "
+)
+
+var (
+	// ErrInvalidCredentials means the credentials are unknown, malformed or expired.
+	ErrInvalidCredentials = errors.New("auth: invalid credentials")
+	// ErrUnauthenticated means no credentials are carried by the request.
+	ErrUnauthenticated = errors.New("auth: no credentials")
+	// ErrInsufficientScope means the principal is not granted the required scope.
+	ErrInsufficientScope = errors.New("auth: insufficient scope")
+)
+
+// Principal is the authenticated caller.
+type Principal struct {
+	Name   string
+	Scopes []string
+}
+
+// HasScope reports whether the principal is granted the scope.
+func (p *Principal) HasScope(scope string) bool {
+	for _, s := range p.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Credentials are the credentials carried by a request, both are empty for the anonymous request.
+type Credentials struct {
+	// Authorization is the "Bearer <jwt>" or "Basic <base64 user:password>" value.
+	Authorization string
+	// APIKey is the plain api key.
+	APIKey string
+}
+
+// CredentialsFromRequest returns the credentials carried by the http headers.
+func CredentialsFromRequest(r *http.Request) Credentials {
+	return Credentials{
+		Authorization: r.Header.Get("Authorization"),
+		APIKey:        r.Header.Get(APIKeyHeader),
+	}
+}
+
+// Authenticator verifies the api keys, the JWTs and the basic auth credentials.
+// Only the SHA-256 hashes of the api keys and the bcrypt hash of the basic auth password are kept in the config.
+type Authenticator struct {
+	// keys maps the hex SHA-256 of the api keys to their principals.
+	keys         map[string]*Principal
+	basicUser    string
+	basicPwdHash []byte
+	jwtSecret    []byte
+	jwtParser    *jwt.Parser
+	now          func() time.Time
+}
+
+// New returns an Authenticator instance.
+func New(conf *config.Config) (*Authenticator, error) {
+	keys, err := parseAPIKeys(conf.Auth.APIKeys)
+	if err != nil {
+		return nil, errors.Wrapf(err, "auth: [New] parse api keys failed")
+	}
+	pwdHash, err := basicPwdHash(conf.HTTP)
+	if err != nil {
+		return nil, errors.Wrapf(err, "auth: [New] basic auth password hash failed")
+	}
+
+	a := &Authenticator{
+		keys:         keys,
+		basicUser:    conf.HTTP.BasicAuthUser,
+		basicPwdHash: pwdHash,
+		jwtSecret:    []byte(conf.Auth.JWTSecret),
+		now:          time.Now,
+	}
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithExpirationRequired(),
+		// The clock is read through a.now, so that it can be replaced.
+		jwt.WithTimeFunc(func() time.Time { return a.now() }),
+	}
+	if conf.Auth.JWTIssuer != "" {
+		opts = append(opts, jwt.WithIssuer(conf.Auth.JWTIssuer))
+	}
+	a.jwtParser = jwt.NewParser(opts...)
+
+	return a, nil
+}
+
+// basicPwdHash returns the bcrypt hash of the basic auth password. The plain password of the deprecated
+// http_basic_auth_pwd is hashed here, so that it is not compared in plain and the deployments still
+// setting it keep working until they move to http_basic_auth_pwd_hash.
+func basicPwdHash(conf *config.HTTP) ([]byte, error) {
+	if conf.BasicAuthPwd == "" {
+		return []byte(conf.BasicAuthPwdHash), nil
+	}
+	return bcrypt.GenerateFromPassword([]byte(conf.BasicAuthPwd), bcrypt.DefaultCost)
+}
+
+// parseAPIKeys parses the comma separated "name:sha256:scope+scope" entries.
+func parseAPIKeys(s string) (map[string]*Principal, error) {
+	keys := make(map[string]*Principal)
+	for _, entry := range strings.Split(s, ",") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+
+		// The scopes contain colons, so only the first two colons are separators.
+		parts := strings.SplitN(entry, ":", 3)
+		if len(parts) != 3 || parts[0] == "" {
+			return nil, errors.Errorf("auth: [parseAPIKeys] invalid entry of name:%q", parts[0])
+		}
+		hash := strings.ToLower(parts[1])
+		if b, err := hex.DecodeString(hash); err != nil || len(b) != sha256.Size {
+			return nil, errors.Errorf("auth: [parseAPIKeys] invalid sha256 of name:%q", parts[0])
+		}
+
+		keys[hash] = &Principal{
+			Name:   parts[0],
+			Scopes: splitScopes(parts[2], "+"),
+		}
+	}
+	return keys, nil
+}
+
+func splitScopes(s, sep string) []string {
+	scopes := make([]string, 0)
+	for _, scope := range strings.Split(s, sep) {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes
+}
+
+// Hash returns the hex SHA-256 of the api key, which is the form kept in the config.
+func Hash(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// Authenticate returns the principal of the credentials,
+// ErrUnauthenticated is returned if there are no credentials.
+func (a *Authenticator) Authenticate(c Credentials) (*Principal, error) {
+	switch {
+	case c.APIKey != "":
+		return a.apiKey(c.APIKey)
+	case strings.HasPrefix(c.Authorization, bearerPrefix):
+		return a.jwt(strings.TrimPrefix(c.Authorization, bearerPrefix))
+	case strings.HasPrefix(c.Authorization, basicPrefix):
+		b, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(c.Authorization, basicPrefix))
+		if err != nil {
+			return nil, ErrInvalidCredentials
+		}
+		i := strings.IndexByte(string(b), ':')
+		if i < 0 {
+			return nil, ErrInvalidCredentials
+		}
+		return a.BasicAuth(string(b[:i]), string(b[i+1:]))
+	case c.Authorization != "":
+		return nil, ErrInvalidCredentials
+	}
+	return nil, ErrUnauthenticated
+}
+
+// BasicAuth returns the principal of the basic auth user, who is granted the sync:write scope.
+// It is kept for the clients sending the username and password in the messages.
+func (a *Authenticator) BasicAuth(user, pwd string) (*Principal, error) {
+	if a.basicUser == "" || len(a.basicPwdHash) == 0 {
+		return nil, ErrInvalidCredentials
+	}
+	// The password is checked even for the wrong user, so that the users are not told by the timing.
+	userOK := subtle.ConstantTimeCompare([]byte(user), []byte(a.basicUser)) == 1
+	pwdOK := bcrypt.CompareHashAndPassword(a.basicPwdHash, []byte(pwd)) == nil
+	if !userOK || !pwdOK {
+		return nil, ErrInvalidCredentials
+	}
+	return &Principal{Name: user, Scopes: []string{ScopeSyncWrite}}, nil
+}
+
+func (a *Authenticator) apiKey(key string) (*Principal, error) {
+	// The map lookup is keyed by the hash, so the plain key is never compared.
+	p, ok := a.keys[Hash(key)]
+	if !ok {
+		return nil, ErrInvalidCredentials
+	}
+	return p, nil
+}
+
+// claims are the registered claims of the JWT used by the authenticator,
+// the scopes are space separated as the OAuth 2.0 "scope" claim.
+type claims struct {
+	jwt.RegisteredClaims
+	Scope string `json:"scope"`
+}
+
+// jwt verifies the HS256 signed JWT, the expiry is required and the issuer is checked if it is configured.
+func (a *Authenticator) jwt(token string) (*Principal, error) {
+	if len(a.jwtSecret) == 0 {
+		return nil, ErrInvalidCredentials
+	}
+
+	c := &claims{}
+	if _, err := a.jwtParser.ParseWithClaims(token, c, func(*jwt.Token) (interface{}, error) {
+		return a.jwtSecret, nil
+	}); err != nil || c.Subject == "" {
+		return nil, ErrInvalidCredentials
+	}
+
+	return &Principal{Name: c.Subject, Scopes: splitScopes(c.Scope, " ")}, nil
+}
+
+// NewContext authenticates the credentials and returns a copy of ctx carrying the principal,
+// ctx is returned as it is for the anonymous request.
+func (a *Authenticator) NewContext(ctx context.Context, c Credentials) (context.Context, error) {
+	p, err := a.Authenticate(c)
+	if err == ErrUnauthenticated {
+		return ctx, nil
+	}
+	if err != nil {
+		return nil, errs.NewErr(
+			errs.UnauthorizedErrCode,
+			errors.Wrapf(err, "auth: [NewContext] authenticate failed"),
+		)
+	}
+	return WithPrincipal(ctx, p), nil
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of ctx carrying the principal.
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the principal carried by ctx, nil for the anonymous request.
+func PrincipalFromContext(ctx context.Context) *Principal {
+	p, _ := ctx.Value(principalKey{}).(*Principal)
+	return p
+}
+
+// Require returns an error unless the principal carried by ctx is granted the scope.
+func Require(ctx context.Context, scope string) error {
+	p := PrincipalFromContext(ctx)
+	if p == nil {
+		return errs.NewErr(
+			errs.UnauthorizedErrCode,
+			errors.Wrapf(ErrUnauthenticated, "auth: [Require] scope:%s", scope),
+		)
+	}
+	if !p.HasScope(scope) {
+		return errs.NewErr(
+			errs.ForbiddenErrCode,
+			errors.Wrapf(ErrInsufficientScope, "auth: [Require] principal:%s scope:%s", p.Name, scope),
+		)
+	}
+	return nil
+}
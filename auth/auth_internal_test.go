@@ -0,0 +1,345 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/errs"
+)
+
+const jwtSecret = "jwt-secret"
+
+var now = time.Date(2018, 3, 3, 0, 0, 0, 0, time.UTC)
+
+func newTestAuthenticator(t *testing.T) *Authenticator {
+	a, err := New(&config.Config{
+		HTTP: &config.HTTP{
+			BasicAuthUser:    "admin",
+			BasicAuthPwdHash: "$2a$04$O2KD8USMnvxMoRBiwe/iAuiQPS6DghEYWN8pU9w8BKzR10rGCaWFW",
+		},
+		Auth: &config.Auth{
+			APIKeys:   "ops:" + Hash("ops-key") + ":sync:write+tickets:create, reader:" + Hash("reader-key") + ":",
+			JWTSecret: jwtSecret,
+			JWTIssuer: "zen",
+		},
+	})
+	if err != nil {
+		t.Fatalf("new authenticator failed:%v", err)
+	}
+	a.now = func() time.Time { return now }
+	return a
+}
+
+func signJWT(secret, header, payload string) string {
+	unsigned := base64.RawURLEncoding.EncodeToString([]byte(header)) + "." + base64.RawURLEncoding.EncodeToString([]byte(payload))
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unsigned))
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestNew(t *testing.T) {
+	testCases := [...]struct {
+		description string
+		apiKeys     string
+		expectErr   bool
+	}{
+		{
+			description: "testing empty case",
+			apiKeys:     "",
+		},
+		{
+			description: "testing missing scopes case",
+			apiKeys:     "ops:" + Hash("ops-key"),
+			expectErr:   true,
+		},
+		{
+			description: "testing invalid hash case",
+			apiKeys:     "ops:ops-key:sync:write",
+			expectErr:   true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			_, err := New(&config.Config{HTTP: &config.HTTP{}, Auth: &config.Auth{APIKeys: tt.apiKeys}})
+			if tt.expectErr && err == nil {
+				t.Errorf("[%s] expect an error, actual nil", tt.description)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("[%s] expect no error, actual:%v", tt.description, err)
+			}
+		})
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	a := newTestAuthenticator(t)
+	header := `{"alg":"HS256","typ":"JWT"}`
+
+	testCases := [...]struct {
+		description string
+		input       Credentials
+		expect      *Principal
+		expectErr   error
+	}{
+		{
+			description: "testing anonymous case",
+			input:       Credentials{},
+			expectErr:   ErrUnauthenticated,
+		},
+		{
+			description: "testing api key case",
+			input:       Credentials{APIKey: "ops-key"},
+			expect:      &Principal{Name: "ops", Scopes: []string{ScopeSyncWrite, ScopeTicketsCreate}},
+		},
+		{
+			description: "testing api key without scopes case",
+			input:       Credentials{APIKey: "reader-key"},
+			expect:      &Principal{Name: "reader", Scopes: []string{}},
+		},
+		{
+			description: "testing unknown api key case",
+			input:       Credentials{APIKey: "unknown"},
+			expectErr:   ErrInvalidCredentials,
+		},
+		{
+			description: "testing basic auth case",
+			input:       Credentials{Authorization: "Basic " + base64.StdEncoding.EncodeToString([]byte("admin:33456783345678"))},
+			expect:      &Principal{Name: "admin", Scopes: []string{ScopeSyncWrite}},
+		},
+		{
+			description: "testing basic auth wrong password case",
+			input:       Credentials{Authorization: "Basic " + base64.StdEncoding.EncodeToString([]byte("admin:1234"))},
+			expectErr:   ErrInvalidCredentials,
+		},
+		{
+			description: "testing jwt wrong signature case",
+			input: Credentials{Authorization: "Bearer " + signJWT("other", header,
+				`{"sub":"cms","iss":"zen","exp":9999999999,"scope":"sync:write"}`)},
+			expectErr: ErrInvalidCredentials,
+		},
+		{
+			description: "testing jwt alg none case",
+			input: Credentials{Authorization: "Bearer " + signJWT(jwtSecret, `{"alg":"none"}`,
+				`{"sub":"cms","iss":"zen","exp":9999999999,"scope":"sync:write"}`)},
+			expectErr: ErrInvalidCredentials,
+		},
+		{
+			description: "testing jwt hs512 case",
+			input: Credentials{Authorization: "Bearer " + signJWT(jwtSecret, `{"alg":"HS512","typ":"JWT"}`,
+				`{"sub":"cms","iss":"zen","exp":9999999999,"scope":"sync:write"}`)},
+			expectErr: ErrInvalidCredentials,
+		},
+		{
+			description: "testing jwt without expiry case",
+			input: Credentials{Authorization: "Bearer " + signJWT(jwtSecret, header,
+				`{"sub":"cms","iss":"zen","scope":"sync:write"}`)},
+			expectErr: ErrInvalidCredentials,
+		},
+		{
+			description: "testing jwt not before case",
+			input: Credentials{Authorization: "Bearer " + signJWT(jwtSecret, header,
+				`{"sub":"cms","iss":"zen","exp":9999999999,"nbf":9999999000,"scope":"sync:write"}`)},
+			expectErr: ErrInvalidCredentials,
+		},
+		{
+			description: "testing jwt expired case",
+			input: Credentials{Authorization: "Bearer " + signJWT(jwtSecret, header,
+				`{"sub":"cms","iss":"zen","exp":1000,"scope":"sync:write"}`)},
+			expectErr: ErrInvalidCredentials,
+		},
+		{
+			description: "testing jwt wrong issuer case",
+			input: Credentials{Authorization: "Bearer " + signJWT(jwtSecret, header,
+				`{"sub":"cms","iss":"other","exp":9999999999,"scope":"sync:write"}`)},
+			expectErr: ErrInvalidCredentials,
+		},
+		{
+			description: "testing valid jwt case",
+			input: Credentials{Authorization: "Bearer " + signJWT(jwtSecret, header,
+				`{"sub":"cms","iss":"zen","exp":9999999999,"scope":"sync:write tickets:create"}`)},
+			expect: &Principal{Name: "cms", Scopes: []string{ScopeSyncWrite, ScopeTicketsCreate}},
+		},
+		{
+			description: "testing unknown scheme case",
+			input:       Credentials{Authorization: "Digest abc"},
+			expectErr:   ErrInvalidCredentials,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			actual, err := a.Authenticate(tt.input)
+			if diff := deep.Equal(tt.expectErr, err); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			} else if diff := deep.Equal(tt.expect, actual); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+}
+
+func TestBasicAuth(t *testing.T) {
+	testCases := [...]struct {
+		description string
+		conf        *config.HTTP
+		user        string
+		pwd         string
+		expectErr   error
+	}{
+		{
+			description: "testing password hash case",
+			conf:        &config.HTTP{BasicAuthUser: "admin", BasicAuthPwdHash: "$2a$04$O2KD8USMnvxMoRBiwe/iAuiQPS6DghEYWN8pU9w8BKzR10rGCaWFW"},
+			user:        "admin",
+			pwd:         "33456783345678",
+		},
+		{
+			description: "testing deprecated plain password case",
+			conf:        &config.HTTP{BasicAuthUser: "admin", BasicAuthPwd: "33456783345678"},
+			user:        "admin",
+			pwd:         "33456783345678",
+		},
+		{
+			description: "testing wrong user case",
+			conf:        &config.HTTP{BasicAuthUser: "admin", BasicAuthPwd: "33456783345678"},
+			user:        "root",
+			pwd:         "33456783345678",
+			expectErr:   ErrInvalidCredentials,
+		},
+		{
+			description: "testing disabled case",
+			conf:        &config.HTTP{BasicAuthUser: "admin"},
+			user:        "admin",
+			pwd:         "",
+			expectErr:   ErrInvalidCredentials,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			a, err := New(&config.Config{HTTP: tt.conf, Auth: &config.Auth{}})
+			if err != nil {
+				t.Fatalf("[%s] new authenticator failed:%v", tt.description, err)
+			}
+			if _, err := a.BasicAuth(tt.user, tt.pwd); err != tt.expectErr {
+				t.Errorf("[%s] expect error:%v, actual:%v", tt.description, tt.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestRequire(t *testing.T) {
+	testCases := [...]struct {
+		description string
+		ctx         context.Context
+		expectCode  int
+	}{
+		{
+			description: "testing granted case",
+			ctx:         WithPrincipal(context.Background(), &Principal{Name: "ops", Scopes: []string{ScopeSyncWrite}}),
+		},
+		{
+			description: "testing anonymous case",
+			ctx:         context.Background(),
+			expectCode:  401,
+		},
+		{
+			description: "testing insufficient scope case",
+			ctx:         WithPrincipal(context.Background(), &Principal{Name: "reader"}),
+			expectCode:  403,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			var code int
+			if err := Require(tt.ctx, ScopeSyncWrite); err != nil {
+				code = err.(*errs.Error).Status
+			}
+			if code != tt.expectCode {
+				t.Errorf("[%s] expect status:%d, actual:%d", tt.description, tt.expectCode, code)
+			}
+		})
+	}
+}
+
+type forceSyncRequest struct {
+	username, password string
+}
+
+func (r *forceSyncRequest) GetUsername() string { return r.username }
+func (r *forceSyncRequest) GetPassword() string { return r.password }
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	a := newTestAuthenticator(t)
+	interceptor := UnaryServerInterceptor(a, map[string]string{"/protobuf.Zendesk/SetForceSync": ScopeSyncWrite})
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return PrincipalFromContext(ctx).Name, nil
+	}
+
+	testCases := [...]struct {
+		description string
+		ctx         context.Context
+		method      string
+		req         interface{}
+		expect      interface{}
+		expectErr   bool
+	}{
+		{
+			description: "testing api key metadata case",
+			ctx:         metadata.NewIncomingContext(context.Background(), metadata.Pairs(APIKeyMetadata, "ops-key")),
+			method:      "/protobuf.Zendesk/SetForceSync",
+			req:         &forceSyncRequest{},
+			expect:      "ops",
+		},
+		{
+			description: "testing legacy username and password case",
+			ctx:         context.Background(),
+			method:      "/protobuf.Zendesk/SetForceSync",
+			req:         &forceSyncRequest{username: "admin", password: "33456783345678"},
+			expect:      "admin",
+		},
+		{
+			description: "testing legacy wrong password case",
+			ctx:         context.Background(),
+			method:      "/protobuf.Zendesk/SetForceSync",
+			req:         &forceSyncRequest{username: "admin", password: "1234"},
+			expectErr:   true,
+		},
+		{
+			description: "testing insufficient scope case",
+			ctx:         metadata.NewIncomingContext(context.Background(), metadata.Pairs(APIKeyMetadata, "reader-key")),
+			method:      "/protobuf.Zendesk/SetForceSync",
+			req:         &forceSyncRequest{},
+			expectErr:   true,
+		},
+		{
+			description: "testing invalid credentials on public method case",
+			ctx:         metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer invalid")),
+			method:      "/protobuf.Zendesk/GetArticle",
+			req:         &forceSyncRequest{},
+			expectErr:   true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			actual, err := interceptor(tt.ctx, tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if tt.expectErr && err == nil {
+				t.Errorf("[%s] expect an error, actual nil", tt.description)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("[%s] expect no error, actual:%v", tt.description, err)
+			} else if diff := deep.Equal(tt.expect, actual); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+}
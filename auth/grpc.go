@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/honestbee/Zen/errs"
+)
+
+// APIKeyMetadata is the gRPC metadata key carrying the api key.
+const APIKeyMetadata = "x-api-key"
+
+// legacyCredentials is implemented by the request messages carrying the username and password.
+type legacyCredentials interface {
+	GetUsername() string
+	GetPassword() string
+}
+
+// CredentialsFromMetadata returns the credentials carried by the incoming gRPC metadata.
+func CredentialsFromMetadata(ctx context.Context) Credentials {
+	md, _ := metadata.FromIncomingContext(ctx)
+	first := func(key string) string {
+		if values := md.Get(key); len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	}
+	return Credentials{
+		Authorization: first("authorization"),
+		APIKey:        first(APIKeyMetadata),
+	}
+}
+
+// UnaryServerInterceptor authenticates the credentials of the metadata
+// and requires the scopes keyed by the full method names.
+func UnaryServerInterceptor(a *Authenticator, scopes map[string]string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		c := CredentialsFromMetadata(ctx)
+		ctx, err := a.NewContext(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+
+		// Fall back to the username and password of the message if the metadata carries no credentials.
+		if lc, ok := req.(legacyCredentials); ok && c == (Credentials{}) && lc.GetUsername() != "" {
+			p, err := a.BasicAuth(lc.GetUsername(), lc.GetPassword())
+			if err != nil {
+				return nil, errs.NewErr(
+					errs.UnauthorizedErrCode,
+					errors.Wrapf(err, "auth: [UnaryServerInterceptor] basic auth failed"),
+				)
+			}
+			ctx = WithPrincipal(ctx, p)
+		}
+
+		if scope, ok := scopes[info.FullMethod]; ok {
+			if err = Require(ctx, scope); err != nil {
+				return nil, err
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor authenticates the credentials of the metadata
+// and requires the scopes keyed by the full method names.
+func StreamServerInterceptor(a *Authenticator, scopes map[string]string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.NewContext(ss.Context(), CredentialsFromMetadata(ss.Context()))
+		if err != nil {
+			return err
+		}
+
+		if scope, ok := scopes[info.FullMethod]; ok {
+			if err = Require(ctx, scope); err != nil {
+				return err
+			}
+		}
+
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// serverStream overrides the context of the wrapped stream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,209 @@
+package certs
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/honestbee/Zen/config"
+)
+
+// Store keeps the server certificate and the client CA bundle loaded from the files,
+// and reloads them when the files are changed, so that the rotated certificates are
+// served by the new connections without restarting the server.
+type Store struct {
+	conf     *config.TLS
+	logger   *zerolog.Logger
+	interval time.Duration
+
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+	modTimes  map[string]time.Time
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// New returns a Store instance, the files are loaded and watched only if TLS is enabled.
+func New(conf *config.Config, logger *zerolog.Logger) (*Store, error) {
+	s := &Store{
+		conf:     conf.TLS,
+		logger:   logger,
+		interval: time.Duration(conf.TLS.ReloadIntervalSec) * time.Second,
+		cancel:   func() {},
+		done:     make(chan struct{}),
+	}
+	if !s.Enabled() {
+		close(s.done)
+		return s, nil
+	}
+
+	if err := s.load(); err != nil {
+		return nil, errors.Wrapf(err, "certs: [New] load failed")
+	}
+
+	if s.interval <= 0 {
+		close(s.done)
+		return s, nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	go s.run(ctx)
+
+	return s, nil
+}
+
+// Enabled reports whether the server certificate is configured.
+func (s *Store) Enabled() bool {
+	return s.conf.CertFile != "" && s.conf.KeyFile != ""
+}
+
+// MutualTLS reports whether the client CA bundle is configured.
+func (s *Store) MutualTLS() bool {
+	return s.Enabled() && s.conf.ClientCAFile != ""
+}
+
+func (s *Store) files() []string {
+	files := []string{s.conf.CertFile, s.conf.KeyFile}
+	if s.conf.ClientCAFile != "" {
+		files = append(files, s.conf.ClientCAFile)
+	}
+	return files
+}
+
+// load reads the files and replaces the certificate and the client CA bundle,
+// nothing is replaced if any of the files is invalid.
+func (s *Store) load() error {
+	modTimes := make(map[string]time.Time)
+	for _, file := range s.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			return errors.Wrapf(err, "certs: [load] stat file:%q failed", file)
+		}
+		modTimes[file] = info.ModTime()
+	}
+
+	cert, err := tls.LoadX509KeyPair(s.conf.CertFile, s.conf.KeyFile)
+	if err != nil {
+		return errors.Wrapf(err, "certs: [load] load key pair failed")
+	}
+
+	var clientCAs *x509.CertPool
+	if s.conf.ClientCAFile != "" {
+		pem, err := ioutil.ReadFile(s.conf.ClientCAFile)
+		if err != nil {
+			return errors.Wrapf(err, "certs: [load] read client ca file:%q failed", s.conf.ClientCAFile)
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pem) {
+			return errors.Errorf("certs: [load] no certificates in client ca file:%q", s.conf.ClientCAFile)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cert = &cert
+	s.clientCAs = clientCAs
+	s.modTimes = modTimes
+	return nil
+}
+
+// changed reports whether any of the files is modified since the last load.
+func (s *Store) changed() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, file := range s.files() {
+		info, err := os.Stat(file)
+		// The file being replaced may be missing for a moment, it is checked again next time.
+		if err != nil {
+			continue
+		}
+		if !info.ModTime().Equal(s.modTimes[file]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Store) run(ctx context.Context) {
+	defer close(s.done)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+
+		if !s.changed() {
+			continue
+		}
+		// The certificate in use is kept if the new files are invalid, for example partially written.
+		if err := s.load(); err != nil {
+			s.logger.Error().Err(err).Msgf("certs: [run] reload failed")
+			continue
+		}
+		s.logger.Info().Msgf("certs: [run] certificates reloaded")
+	}
+}
+
+// Certificate returns the current server certificate.
+func (s *Store) Certificate() *tls.Certificate {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.cert
+}
+
+// ClientCAs returns the current client CA bundle, nil if mutual TLS is disabled.
+func (s *Store) ClientCAs() *x509.CertPool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.clientCAs
+}
+
+// TLSConfig returns the server TLS config using the current certificate and client CA bundle
+// on every handshake. The client certificates are verified by the client CA bundle
+// with the clientAuth policy, which is ignored if mutual TLS is disabled.
+func (s *Store) TLSConfig(clientAuth tls.ClientAuthType) *tls.Config {
+	if !s.MutualTLS() {
+		clientAuth = tls.NoClientCert
+	}
+	getCertificate := func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+		return s.Certificate(), nil
+	}
+
+	return &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		NextProtos:     []string{"h2", "http/1.1"},
+		GetCertificate: getCertificate,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return &tls.Config{
+				MinVersion:     tls.VersionTLS12,
+				NextProtos:     []string{"h2", "http/1.1"},
+				GetCertificate: getCertificate,
+				ClientAuth:     clientAuth,
+				ClientCAs:      s.ClientCAs(),
+			}, nil
+		},
+	}
+}
+
+// Close stops watching the files.
+func (s *Store) Close() error {
+	s.cancel()
+	<-s.done
+	return nil
+}
@@ -0,0 +1,301 @@
+package certs
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/honestbee/Zen/config"
+)
+
+var logger = zerolog.New(ioutil.Discard)
+
+// issuer is a locally generated certificate and its key.
+type issuer struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+// newCert generates a certificate signed by parent, it is self-signed if parent is nil.
+func newCert(t *testing.T, serial int64, parent *issuer, isCA bool, usage x509.ExtKeyUsage) *issuer {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key failed:%v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(serial),
+		Subject:               pkix.Name{CommonName: "zen-test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	if !isCA {
+		tmpl.ExtKeyUsage = []x509.ExtKeyUsage{usage}
+	}
+
+	signerCert, signerKey := tmpl, key
+	if parent != nil {
+		signerCert, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signerCert, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatalf("create certificate failed:%v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parse certificate failed:%v", err)
+	}
+	return &issuer{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+func (i *issuer) keyPEM(t *testing.T) []byte {
+	der, err := x509.MarshalECPrivateKey(i.key)
+	if err != nil {
+		t.Fatalf("marshal key failed:%v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+}
+
+func (i *issuer) tlsCertificate(t *testing.T) tls.Certificate {
+	cert, err := tls.X509KeyPair(i.pem, i.keyPEM(t))
+	if err != nil {
+		t.Fatalf("x509 key pair failed:%v", err)
+	}
+	return cert
+}
+
+func writeFile(t *testing.T, path string, b []byte, modTime time.Time) {
+	if err := ioutil.WriteFile(path, b, 0600); err != nil {
+		t.Fatalf("write file failed:%v", err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatalf("chtimes failed:%v", err)
+	}
+}
+
+// testFiles writes the CA bundle and a server certificate issued by the CA into a temp dir.
+func testFiles(t *testing.T) (*config.TLS, *issuer, func()) {
+	dir, err := ioutil.TempDir("", "certs")
+	if err != nil {
+		t.Fatalf("temp dir failed:%v", err)
+	}
+
+	ca := newCert(t, 1, nil, true, 0)
+	server := newCert(t, 2, ca, false, x509.ExtKeyUsageServerAuth)
+	conf := &config.TLS{
+		CertFile:     filepath.Join(dir, "server.pem"),
+		KeyFile:      filepath.Join(dir, "server-key.pem"),
+		ClientCAFile: filepath.Join(dir, "ca.pem"),
+	}
+	modTime := time.Now().Add(-time.Minute)
+	writeFile(t, conf.CertFile, server.pem, modTime)
+	writeFile(t, conf.KeyFile, server.keyPEM(t), modTime)
+	writeFile(t, conf.ClientCAFile, ca.pem, modTime)
+
+	return conf, ca, func() { os.RemoveAll(dir) }
+}
+
+func TestNew(t *testing.T) {
+	conf, _, cleanup := testFiles(t)
+	defer cleanup()
+
+	testCases := [...]struct {
+		description   string
+		conf          *config.TLS
+		expectEnabled bool
+		expectMutual  bool
+		expectErr     bool
+	}{
+		{
+			description: "testing disabled case",
+			conf:        &config.TLS{},
+		},
+		{
+			description:   "testing tls case",
+			conf:          &config.TLS{CertFile: conf.CertFile, KeyFile: conf.KeyFile},
+			expectEnabled: true,
+		},
+		{
+			description:   "testing mutual tls case",
+			conf:          conf,
+			expectEnabled: true,
+			expectMutual:  true,
+		},
+		{
+			description: "testing missing file case",
+			conf:        &config.TLS{CertFile: conf.CertFile, KeyFile: conf.KeyFile + ".missing"},
+			expectErr:   true,
+		},
+		{
+			description: "testing invalid client ca case",
+			conf:        &config.TLS{CertFile: conf.CertFile, KeyFile: conf.KeyFile, ClientCAFile: conf.KeyFile},
+			expectErr:   true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			s, err := New(&config.Config{TLS: tt.conf}, &logger)
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("[%s] expect an error, actual nil", tt.description)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("[%s] expect no error, actual:%v", tt.description, err)
+			}
+			defer s.Close()
+
+			if s.Enabled() != tt.expectEnabled || s.MutualTLS() != tt.expectMutual {
+				t.Errorf("[%s] expect enabled:%v mutual:%v, actual enabled:%v mutual:%v",
+					tt.description, tt.expectEnabled, tt.expectMutual, s.Enabled(), s.MutualTLS())
+			}
+		})
+	}
+}
+
+func TestReload(t *testing.T) {
+	conf, ca, cleanup := testFiles(t)
+	defer cleanup()
+
+	s, err := New(&config.Config{TLS: conf}, &logger)
+	if err != nil {
+		t.Fatalf("new store failed:%v", err)
+	}
+	defer s.Close()
+
+	if s.changed() {
+		t.Errorf("expect no changes after loading")
+	}
+
+	// A partially written certificate is not loaded, the certificate in use is kept.
+	writeFile(t, conf.CertFile, []byte("-----BEGIN CERTIFICATE-----"), time.Now())
+	if !s.changed() {
+		t.Errorf("expect changes after writing the certificate")
+	}
+	if err = s.load(); err == nil {
+		t.Errorf("expect an error loading the invalid certificate")
+	}
+	if actual := mustLeaf(t, s.Certificate()).SerialNumber.Int64(); actual != 2 {
+		t.Errorf("expect the certificate in use is kept, actual serial:%d", actual)
+	}
+
+	rotated := newCert(t, 3, ca, false, x509.ExtKeyUsageServerAuth)
+	writeFile(t, conf.CertFile, rotated.pem, time.Now())
+	writeFile(t, conf.KeyFile, rotated.keyPEM(t), time.Now())
+	if err = s.load(); err != nil {
+		t.Fatalf("load rotated certificate failed:%v", err)
+	}
+	if actual := mustLeaf(t, s.Certificate()).SerialNumber.Int64(); actual != 3 {
+		t.Errorf("expect the rotated certificate, actual serial:%d", actual)
+	}
+	if s.changed() {
+		t.Errorf("expect no changes after reloading")
+	}
+}
+
+func mustLeaf(t *testing.T, cert *tls.Certificate) *x509.Certificate {
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		t.Fatalf("parse leaf failed:%v", err)
+	}
+	return leaf
+}
+
+func TestMutualTLSHandshake(t *testing.T) {
+	conf, ca, cleanup := testFiles(t)
+	defer cleanup()
+
+	s, err := New(&config.Config{TLS: conf}, &logger)
+	if err != nil {
+		t.Fatalf("new store failed:%v", err)
+	}
+	defer s.Close()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen failed:%v", err)
+	}
+	lis = tls.NewListener(lis, s.TLSConfig(tls.RequireAndVerifyClientCert))
+	defer lis.Close()
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				// The handshake is done by the first read, the byte written by the client is echoed.
+				b := make([]byte, 1)
+				if _, err := conn.Read(b); err == nil {
+					conn.Write(b)
+				}
+			}()
+		}
+	}()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	client := newCert(t, 4, ca, false, x509.ExtKeyUsageClientAuth)
+	stranger := newCert(t, 5, newCert(t, 6, nil, true, 0), false, x509.ExtKeyUsageClientAuth)
+
+	testCases := [...]struct {
+		description  string
+		certificates []tls.Certificate
+		expectErr    bool
+	}{
+		{
+			description:  "testing client certificate case",
+			certificates: []tls.Certificate{client.tlsCertificate(t)},
+		},
+		{
+			description: "testing no client certificate case",
+			expectErr:   true,
+		},
+		{
+			description:  "testing client certificate of unknown ca case",
+			certificates: []tls.Certificate{stranger.tlsCertificate(t)},
+			expectErr:    true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			conn, err := tls.Dial("tcp", lis.Addr().String(), &tls.Config{
+				RootCAs:      roots,
+				Certificates: tt.certificates,
+			})
+			if err == nil {
+				defer conn.Close()
+				// With TLS 1.3 the client certificate is rejected after the client handshake completes.
+				if _, err = conn.Write([]byte{1}); err == nil {
+					_, err = conn.Read(make([]byte, 1))
+				}
+			}
+			if tt.expectErr && err == nil {
+				t.Errorf("[%s] expect an error, actual nil", tt.description)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("[%s] expect no error, actual:%v", tt.description, err)
+			}
+		})
+	}
+}
@@ -2,10 +2,13 @@ package config
 
 import (
 	"flag"
-	"io/ioutil"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strings"
 
 	"github.com/pkg/errors"
-	"gopkg.in/yaml.v2"
 )
 
 var (
@@ -20,7 +23,51 @@ type HTTP struct {
 	IdleTimeoutSec  int    `yaml:"idle_timeout_sec"`
 	ListenAddr      string `yaml:"listen_addr"`
 	BasicAuthUser   string `yaml:"basic_auth_user"`
-	BasicAuthPwd    string `yaml:"basic_auth_pwd"`
+	// BasicAuthPwdHash is the bcrypt hash of the basic auth password, the basic auth is disabled if it is empty.
+	BasicAuthPwdHash string `yaml:"basic_auth_pwd_hash"`
+	// BasicAuthPwd is the deprecated plain basic auth password, it is hashed by the authenticator
+	// at the startup, so that the existing deployments keep their password.
+	BasicAuthPwd string `yaml:"basic_auth_pwd"`
+	// ValidateResponses validates the RESTful responses against the OpenAPI document, the invalid
+	// responses are replaced by 500 errors, so that it is meant for the testing environments.
+	ValidateResponses bool `yaml:"validate_responses"`
+	// CacheMaxAgeSec and CDNMaxAgeSec are the Cache-Control max-age and s-maxage of the content responses,
+	// the clients revalidate the responses by their ETag every time if both are 0.
+	CacheMaxAgeSec int `yaml:"cache_max_age_sec"`
+	CDNMaxAgeSec   int `yaml:"cdn_max_age_sec"`
+	// SurrogateKeyEnable tags the content responses with the Surrogate-Key header, so that the CDN can purge them by keys.
+	SurrogateKeyEnable bool `yaml:"surrogate_key_enable"`
+	// TrustedProxies are the comma separated ips or CIDRs of the load balancers, the client ip is the
+	// rightmost X-Forwarded-For address not added by them. The header is ignored if it is empty.
+	TrustedProxies string `yaml:"trusted_proxies"`
+}
+
+// TrustedProxyNets parses TrustedProxies, an ip is taken as a single address CIDR.
+func (h *HTTP) TrustedProxyNets() ([]*net.IPNet, error) {
+	var nets []*net.IPNet
+	for _, proxy := range strings.Split(h.TrustedProxies, ",") {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, errors.Errorf("config: [TrustedProxyNets] invalid ip:%q", proxy)
+			}
+			bits := 8 * net.IPv4len
+			if ip.To4() == nil {
+				bits = 8 * net.IPv6len
+			}
+			proxy = fmt.Sprintf("%s/%d", proxy, bits)
+		}
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, errors.Wrapf(err, "config: [TrustedProxyNets] parse cidr:%q failed", proxy)
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets, nil
 }
 
 // Database is the database configuration.
@@ -78,8 +125,11 @@ type Examiner struct {
 
 // GraphQL is the GraphQL package configurations.
 type GraphQL struct {
-	MaxDepth       int `yaml:"max_depth"`
-	MaxParallelism int `yaml:"max_parallelism"`
+	MaxDepth            int `yaml:"max_depth"`
+	MaxParallelism      int `yaml:"max_parallelism"`
+	MaxCost             int `yaml:"max_cost"`
+	CostBudget          int `yaml:"cost_budget"`
+	CostBudgetWindowSec int `yaml:"cost_budget_window_sec"`
 }
 
 // Datadog is the Datadog package configurations.
@@ -94,6 +144,163 @@ type Datadog struct {
 // GRPC is the gRPC package configurations.
 type GRPC struct {
 	ListenAddr string `yaml:"listen_addr"`
+	// StreamBatchSize is the number of the articles or the events read per query by the streaming methods.
+	StreamBatchSize int `yaml:"stream_batch_size"`
+	// GatewayEnable serves the gRPC methods as the RESTful JSON APIs under /v1 of the http server.
+	GatewayEnable bool `yaml:"gateway_enable"`
+	// ShareHTTPPort serves the gRPC methods on the http listener instead of ListenAddr,
+	// the connections are HTTP/2 over cleartext (h2c) if TLS is disabled.
+	ShareHTTPPort bool `yaml:"share_http_port"`
+}
+
+// Antispam is the antispam package configurations.
+type Antispam struct {
+	Enable             bool   `yaml:"enable"`
+	CaptchaVerifier    string `yaml:"captcha_verifier"`
+	CaptchaVerifyURL   string `yaml:"captcha_verify_url"`
+	CaptchaSecret      string `yaml:"captcha_secret"`
+	CaptchaTimeoutSec  int    `yaml:"captcha_timeout_sec"`
+	FakeCaptchaToken   string `yaml:"fake_captcha_token"`
+	IPRateLimit        int    `yaml:"ip_rate_limit"`
+	EmailRateLimit     int    `yaml:"email_rate_limit"`
+	RateLimitWindowSec int    `yaml:"rate_limit_window_sec"`
+	DuplicateWindowSec int    `yaml:"duplicate_window_sec"`
+}
+
+// Redact is the redact package configurations.
+type Redact struct {
+	CustomFieldIDs string `yaml:"custom_field_ids"`
+}
+
+// Subscription is the GraphQL subscriptions configurations.
+type Subscription struct {
+	KeepAliveSec     int `yaml:"keep_alive_sec"`
+	BufferSize       int `yaml:"buffer_size"`
+	MaxPerConnection int `yaml:"max_per_connection"`
+	// AllowedOrigins are the comma separated origins of the pages allowed to open the websocket connections,
+	// the connections without the Origin header are opened by the non-browser clients and always allowed.
+	AllowedOrigins string `yaml:"allowed_origins"`
+}
+
+// AllowedOriginList parses AllowedOrigins into the lowercase scheme://host[:port] origins.
+func (s *Subscription) AllowedOriginList() ([]string, error) {
+	var origins []string
+	for _, origin := range strings.Split(s.AllowedOrigins, ",") {
+		origin = strings.TrimSpace(origin)
+		if origin == "" {
+			continue
+		}
+		u, err := url.Parse(origin)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || strings.TrimSuffix(u.Path, "/") != "" {
+			return nil, errors.Errorf("config: [AllowedOriginList] invalid origin:%q", origin)
+		}
+		origins = append(origins, strings.ToLower(u.Scheme+"://"+u.Host))
+	}
+	return origins, nil
+}
+
+// PersistedQuery is the GraphQL persisted queries configurations.
+type PersistedQuery struct {
+	Enable         bool   `yaml:"enable"`
+	AllowListOnly  bool   `yaml:"allow_list_only"`
+	ManifestPath   string `yaml:"manifest_path"`
+	TTLSec         int    `yaml:"ttl_sec"`
+	CacheMaxAgeSec int    `yaml:"cache_max_age_sec"`
+}
+
+// Health is the dependencies health checking configurations.
+type Health struct {
+	IntervalSec   int `yaml:"interval_sec"`
+	TimeoutSec    int `yaml:"timeout_sec"`
+	MaxSyncAgeSec int `yaml:"max_sync_age_sec"`
+}
+
+// Auth is the authentication configurations.
+type Auth struct {
+	// APIKeys are the comma separated "name:sha256:scopes" entries,
+	// sha256 is the hex SHA-256 of the key and the scopes are separated by "+".
+	APIKeys   string `yaml:"api_keys"`
+	JWTSecret string `yaml:"jwt_secret"`
+	JWTIssuer string `yaml:"jwt_issuer"`
+	// TicketsScopeRequired requires the tickets:create scope for creating requests,
+	// the anonymous requests are only checked by the antispam guard otherwise.
+	TicketsScopeRequired bool `yaml:"tickets_scope_required"`
+}
+
+// TLS is the TLS configurations of the http and gRPC listeners.
+type TLS struct {
+	// CertFile and KeyFile are the PEM server certificate and key, TLS is disabled if either is empty.
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+	// ClientCAFile is the PEM CA bundle verifying the gRPC client certificates, mutual TLS is disabled if empty.
+	ClientCAFile      string `yaml:"client_ca_file"`
+	ReloadIntervalSec int    `yaml:"reload_interval_sec"`
+}
+
+// Purge is the CDN purging configurations.
+type Purge struct {
+	// Purger is the purger kind (none/http).
+	Purger string `yaml:"purger"`
+	// Endpoint is the purge API the surrogate keys are posted to, the keys are not purged if empty.
+	Endpoint string `yaml:"endpoint"`
+	Token    string `yaml:"token"`
+	// BaseURL is the CDN URL the paths are purged under, the paths are not purged if empty.
+	BaseURL    string `yaml:"base_url"`
+	TimeoutSec int    `yaml:"timeout_sec"`
+}
+
+// Analytics is the analytics buffering configurations.
+type Analytics struct {
+	// FlushIntervalSec is the interval flushing the analytics buffered in Redis into Postgres.
+	FlushIntervalSec int `yaml:"flush_interval_sec"`
+	// SessionTTLSec is how long the articles viewed in a session are kept for the ticket filed later.
+	SessionTTLSec int `yaml:"session_ttl_sec"`
+	// RetentionDays is how long the search queries, clicks and session events are kept, 0 means forever.
+	RetentionDays int `yaml:"retention_days"`
+	// DeflectionFieldID is the zendesk custom field of the tickets the viewed articles are put in,
+	// the viewed articles are appended to the comment if it's 0.
+	DeflectionFieldID int `yaml:"deflection_field_id"`
+}
+
+// Votes is the article votes configurations.
+type Votes struct {
+	// DeviceSecret signs the device ids issued to the voters, the device ids are not trusted if it's empty.
+	DeviceSecret string `yaml:"device_secret"`
+	// MaxPerIP is the max votes forwarded from an ip on an article inside the window, 0 means no limit.
+	MaxPerIP    int `yaml:"max_per_ip"`
+	IPWindowSec int `yaml:"ip_window_sec"`
+}
+
+// Metrics is the Prometheus metrics configurations.
+type Metrics struct {
+	Enable bool `yaml:"enable"`
+	// Path is the http path the metrics are scraped from.
+	Path string `yaml:"path"`
+}
+
+// Tracing is the distributed tracing configurations.
+type Tracing struct {
+	// Exporter is the backend of the spans, datadog, otlp or none.
+	Exporter    string  `yaml:"exporter"`
+	ServiceName string  `yaml:"service_name"`
+	SampleRatio float64 `yaml:"sample_ratio"`
+	// OTLPEndpoint is the OTLP/HTTP traces url of the collector.
+	OTLPEndpoint string `yaml:"otlp_endpoint"`
+	// OTLPHeaders are the comma separated key=value headers of the export requests, such as the credentials.
+	OTLPHeaders          string `yaml:"otlp_headers"`
+	OTLPFlushIntervalSec int    `yaml:"otlp_flush_interval_sec"`
+}
+
+// Log is the logging configurations.
+type Log struct {
+	// Level is the minimum level of the logs, debug, info, warn or error.
+	Level string `yaml:"level"`
+}
+
+// Reload is the config reloading configurations.
+type Reload struct {
+	// IntervalSec is the interval checking the config file for changes, the config is only reloaded on SIGHUP if it's 0.
+	IntervalSec int `yaml:"interval_sec"`
 }
 
 // Config is the main configuration for Zen server.
@@ -106,11 +313,48 @@ type Config struct {
 	GraphQL  *GraphQL  `yaml:"graphql"`
 	Datadog  *Datadog  `yaml:"datadog"`
 	GRPC     *GRPC     `yaml:"grpc"`
+	Antispam *Antispam `yaml:"antispam"`
+	Redact   *Redact   `yaml:"redact"`
+
+	Subscription   *Subscription   `yaml:"subscription"`
+	PersistedQuery *PersistedQuery `yaml:"persisted_query"`
+	Health         *Health         `yaml:"health"`
+	Auth           *Auth           `yaml:"auth"`
+	TLS            *TLS            `yaml:"tls"`
+	Purge          *Purge          `yaml:"purge"`
+	Analytics      *Analytics      `yaml:"analytics"`
+	Votes          *Votes          `yaml:"votes"`
+	Metrics        *Metrics        `yaml:"metrics"`
+	Tracing        *Tracing        `yaml:"tracing"`
+	Log            *Log            `yaml:"log"`
+	Reload         *Reload         `yaml:"reload"`
+
+	// path and explicit are the config file and the flags given on the command line,
+	// they are kept for loading the config again.
+	path     string
+	explicit map[string]string
 }
 
-// New returns a Config instance.
+// New returns a Config instance, the settings are layered from the flag defaults, the config file,
+// the environment variables and the secret files, each layer overrides the former ones.
+// The flags given on the command line override all of the layers.
 func New() (*Config, error) {
-	c := &Config{
+	c := newConfig()
+
+	path := c.register(flag.CommandLine)
+	flag.Parse()
+
+	explicit := make(map[string]string)
+	flag.Visit(func(f *flag.Flag) { explicit[f.Name] = f.Value.String() })
+
+	if err := c.load(flag.CommandLine, *path, explicit, os.LookupEnv); err != nil {
+		return nil, errors.Wrapf(err, "config: [New] load failed")
+	}
+	return c, nil
+}
+
+func newConfig() *Config {
+	return &Config{
 		HTTP:     &HTTP{},
 		Database: &Database{},
 		Cache:    &Cache{},
@@ -119,73 +363,147 @@ func New() (*Config, error) {
 		GraphQL:  &GraphQL{},
 		Datadog:  &Datadog{},
 		GRPC:     &GRPC{},
+		Antispam: &Antispam{},
+		Redact:   &Redact{},
+
+		Subscription:   &Subscription{},
+		PersistedQuery: &PersistedQuery{},
+		Health:         &Health{},
+		Auth:           &Auth{},
+		TLS:            &TLS{},
+		Purge:          &Purge{},
+		Analytics:      &Analytics{},
+		Votes:          &Votes{},
+		Metrics:        &Metrics{},
+		Tracing:        &Tracing{},
+		Log:            &Log{},
+		Reload:         &Reload{},
 	}
+}
 
-	path := flag.String("config_path", "env.yml", "config file path, if provided will replace flag setting values")
-	flag.StringVar(&c.HTTP.ListenAddr, "http_listen_addr", ":8080", "http server listening address")
-	flag.IntVar(&c.HTTP.IdleTimeoutSec, "http_idle_timeout_sec", 1200, "http idle timeout second")
-	flag.IntVar(&c.HTTP.ReadTimeoutSec, "http_read_timeout_sec", 30, "http read timeout second")
-	flag.IntVar(&c.HTTP.WriteTimeoutSec, "http_write_timeout_sec", 60, "http write timeout second")
-	flag.StringVar(&c.HTTP.BasicAuthUser, "http_basic_auth_user", "admin", "basic auth user")
-	flag.StringVar(&c.HTTP.BasicAuthPwd, "http_basic_auth_pwd", "33456783345678", "basic auth password")
-	flag.IntVar(&c.Database.MaxIdle, "db_max_idle", 500, "database max idle")
-	flag.IntVar(&c.Database.MaxActive, "db_max_active", 1000, "database max active")
-	flag.IntVar(&c.Database.ConnectTimeoutSec, "db_connect_timeout_sec", 5, "database connect timeout second")
-	flag.IntVar(&c.Database.ReadTimeoutSec, "db_read_timeout_sec", 10, "database read timeout second")
-	flag.IntVar(&c.Database.WriteTimeoutSec, "db_write_timeout_sec", 15, "database write timeout second")
-	flag.IntVar(&c.Database.TransactionMaxTimeoutSec, "db_transaction_max_timeout_sec", 60, "database transaction max timeout second")
-	flag.StringVar(&c.Database.Host, "db_host", "localhost", "database host")
-	flag.StringVar(&c.Database.Port, "db_port", "5432", "database port")
-	flag.StringVar(&c.Database.User, "db_user", "root", "database user")
-	flag.StringVar(&c.Database.Password, "db_password", "", "database password")
-	flag.StringVar(&c.Database.DBName, "db_dbname", "", "database db name")
-	flag.IntVar(&c.ZenDesk.RequestTimeoutSec, "zendesk_request_timeout_sec", 10, "zendesk api http request timeout")
-	flag.StringVar(&c.ZenDesk.AuthToken, "zendesk_auth_token", "", "zendesk api authorization token")
-	flag.StringVar(&c.ZenDesk.HKBaseURL, "zendesk_hk_base_url", "https://honestbeehelp-hk.zendesk.com", "zendesk hk base url")
-	flag.StringVar(&c.ZenDesk.IDBaseURL, "zendesk_id_base_url", "https://honestbee-idn.zendesk.com", "zendesk id base url")
-	flag.StringVar(&c.ZenDesk.JPBaseURL, "zendesk_jp_base_url", "https://honestbeehelp-jp.zendesk.com", "zendesk jp base url")
-	flag.StringVar(&c.ZenDesk.MYBaseURL, "zendesk_my_base_url", "https://honestbee-my.zendesk.com", "zendesk my base url")
-	flag.StringVar(&c.ZenDesk.PHBaseURL, "zendesk_ph_base_url", "https://honestbee-ph.zendesk.com", "zendesk ph base url")
-	flag.StringVar(&c.ZenDesk.SGBaseURL, "zendesk_sg_base_url", "https://honestbeehelp-sg.zendesk.com", "zendesk sg base url")
-	flag.StringVar(&c.ZenDesk.THBaseURL, "zendesk_th_base_url", "https://honestbee-th.zendesk.com", "zendesk th base url")
-	flag.StringVar(&c.ZenDesk.TWBaseURL, "zendesk_tw_base_url", "https://honestbeehelp-tw.zendesk.com", "zendesk tw base url")
-	flag.IntVar(&c.Cache.MaxIdle, "cache_max_idle", 500, "cache max idle")
-	flag.IntVar(&c.Cache.MaxActive, "cache_max_active", 1000, "cache max active")
-	flag.IntVar(&c.Cache.IdleTimeoutSec, "cache_idle_timeout_sec", 1200, "close connections after remaining idle for this duration")
-	flag.BoolVar(&c.Cache.Wait, "cache_wait", false, "if true and the pool is at the MaxActive limit then Get() waits for a connection to be returned to the pool before returning")
-	flag.IntVar(&c.Cache.ConnectTimeoutSec, "cache_connect_timeout_sec", 5, "cache connect timeout second")
-	flag.IntVar(&c.Cache.ReadTimeoutSec, "cache_read_timeout_sec", 10, "cache read timeout second")
-	flag.IntVar(&c.Cache.WriteTimeoutSec, "cache_write_timeout_sec", 15, "cache write timeout second")
-	flag.StringVar(&c.Cache.Host, "cache_host", "127.0.0.1", "cache host")
-	flag.StringVar(&c.Cache.Port, "cache_port", "6379", "cache port")
-	flag.StringVar(&c.Cache.Password, "cache_password", "", "cache password")
-	flag.IntVar(&c.Examiner.MaxWorkerSize, "examiner_max_worker_size", 100, "examiner max worker size")
-	flag.IntVar(&c.Examiner.MaxPoolSize, "examiner_max_pool_size", 200, "examiner max pool size")
-	flag.IntVar(&c.Examiner.CategoriesRefreshLimit, "examiner_categories_refresh_limit", 0, "examiner categories refresh limit")
-	flag.IntVar(&c.Examiner.SectionsRefreshLimit, "examiner_sections_refresh_limit", 0, "examiner sections refresh limit")
-	flag.IntVar(&c.Examiner.ArticlesRefreshLimit, "examiner_articles_refresh_limit", 0, "examiner articles refresh limit")
-	flag.IntVar(&c.Examiner.TicketFormsRefreshLimit, "examiner_ticket_forms_refresh_limit", 0, "examiner ticket forms refresh limit")
-	flag.IntVar(&c.GraphQL.MaxDepth, "graphql_max_depth", 13, "max field nesting depth in a query")
-	flag.IntVar(&c.GraphQL.MaxParallelism, "graphql_max_parallelism", 10, "max number of resolvers per request allowed to run in parallel")
-	flag.BoolVar(&c.Datadog.Enable, "datadog_enable", true, "datadog enable")
-	flag.BoolVar(&c.Datadog.Debug, "datadog_debug", false, "datadog debug")
-	flag.StringVar(&c.Datadog.Env, "datadog_env", "development", "datadog environment (development/staging/production)")
-	flag.StringVar(&c.Datadog.Host, "datadog_host", "localhost", "datadog host")
-	flag.StringVar(&c.Datadog.Port, "datadog_port", "8126", "datadog port")
-	flag.StringVar(&c.GRPC.ListenAddr, "grpc_listen_addr", ":50051", "grpc server listening address")
+// register defines the flags of all the settings on fs, their defaults are the bottom layer of the config.
+// It returns the config file path flag.
+func (c *Config) register(fs *flag.FlagSet) *string {
+	path := fs.String("config_path", "env.yml", "config file path, its values are overridden by the environment variables and the secret files")
+	fs.StringVar(&c.HTTP.ListenAddr, "http_listen_addr", ":8080", "http server listening address")
+	fs.IntVar(&c.HTTP.IdleTimeoutSec, "http_idle_timeout_sec", 1200, "http idle timeout second")
+	fs.IntVar(&c.HTTP.ReadTimeoutSec, "http_read_timeout_sec", 30, "http read timeout second")
+	fs.IntVar(&c.HTTP.WriteTimeoutSec, "http_write_timeout_sec", 60, "http write timeout second")
+	fs.StringVar(&c.HTTP.BasicAuthUser, "http_basic_auth_user", "admin", "basic auth user")
+	fs.StringVar(&c.HTTP.BasicAuthPwdHash, "http_basic_auth_pwd_hash", "", "bcrypt hash of the basic auth password, the basic auth is disabled if it is empty")
+	fs.StringVar(&c.HTTP.BasicAuthPwd, "http_basic_auth_pwd", "", "deprecated plain basic auth password, use http_basic_auth_pwd_hash instead")
+	fs.BoolVar(&c.HTTP.ValidateResponses, "http_validate_responses", false, "validate the restful responses against the openapi document, for testing environments")
+	fs.IntVar(&c.HTTP.CacheMaxAgeSec, "http_cache_max_age_sec", 60, "Cache-Control max-age second of the content responses")
+	fs.IntVar(&c.HTTP.CDNMaxAgeSec, "http_cdn_max_age_sec", 300, "Cache-Control s-maxage second of the content responses cached by the cdn")
+	fs.BoolVar(&c.HTTP.SurrogateKeyEnable, "http_surrogate_key_enable", true, "Surrogate-Key header of the content responses enable")
+	fs.StringVar(&c.HTTP.TrustedProxies, "http_trusted_proxies", "", "comma separated ips or CIDRs of the load balancers trusted for the X-Forwarded-For header")
+	fs.IntVar(&c.Database.MaxIdle, "db_max_idle", 500, "database max idle")
+	fs.IntVar(&c.Database.MaxActive, "db_max_active", 1000, "database max active")
+	fs.IntVar(&c.Database.ConnectTimeoutSec, "db_connect_timeout_sec", 5, "database connect timeout second")
+	fs.IntVar(&c.Database.ReadTimeoutSec, "db_read_timeout_sec", 10, "database read timeout second")
+	fs.IntVar(&c.Database.WriteTimeoutSec, "db_write_timeout_sec", 15, "database write timeout second")
+	fs.IntVar(&c.Database.TransactionMaxTimeoutSec, "db_transaction_max_timeout_sec", 60, "database transaction max timeout second")
+	fs.StringVar(&c.Database.Host, "db_host", "localhost", "database host")
+	fs.StringVar(&c.Database.Port, "db_port", "5432", "database port")
+	fs.StringVar(&c.Database.User, "db_user", "root", "database user")
+	fs.StringVar(&c.Database.Password, "db_password", "", "database password")
+	fs.StringVar(&c.Database.DBName, "db_dbname", "", "database db name")
+	fs.IntVar(&c.ZenDesk.RequestTimeoutSec, "zendesk_request_timeout_sec", 10, "zendesk api http request timeout")
+	fs.StringVar(&c.ZenDesk.AuthToken, "zendesk_auth_token", "", "zendesk api authorization token")
+	fs.StringVar(&c.ZenDesk.HKBaseURL, "zendesk_hk_base_url", "https://honestbeehelp-hk.zendesk.com", "zendesk hk base url")
+	fs.StringVar(&c.ZenDesk.IDBaseURL, "zendesk_id_base_url", "https://honestbee-idn.zendesk.com", "zendesk id base url")
+	fs.StringVar(&c.ZenDesk.JPBaseURL, "zendesk_jp_base_url", "https://honestbeehelp-jp.zendesk.com", "zendesk jp base url")
+	fs.StringVar(&c.ZenDesk.MYBaseURL, "zendesk_my_base_url", "https://honestbee-my.zendesk.com", "zendesk my base url")
+	fs.StringVar(&c.ZenDesk.PHBaseURL, "zendesk_ph_base_url", "https://honestbee-ph.zendesk.com", "zendesk ph base url")
+	fs.StringVar(&c.ZenDesk.SGBaseURL, "zendesk_sg_base_url", "https://honestbeehelp-sg.zendesk.com", "zendesk sg base url")
+	fs.StringVar(&c.ZenDesk.THBaseURL, "zendesk_th_base_url", "https://honestbee-th.zendesk.com", "zendesk th base url")
+	fs.StringVar(&c.ZenDesk.TWBaseURL, "zendesk_tw_base_url", "https://honestbeehelp-tw.zendesk.com", "zendesk tw base url")
+	fs.IntVar(&c.Cache.MaxIdle, "cache_max_idle", 500, "cache max idle")
+	fs.IntVar(&c.Cache.MaxActive, "cache_max_active", 1000, "cache max active")
+	fs.IntVar(&c.Cache.IdleTimeoutSec, "cache_idle_timeout_sec", 1200, "close connections after remaining idle for this duration")
+	fs.BoolVar(&c.Cache.Wait, "cache_wait", false, "if true and the pool is at the MaxActive limit then Get() waits for a connection to be returned to the pool before returning")
+	fs.IntVar(&c.Cache.ConnectTimeoutSec, "cache_connect_timeout_sec", 5, "cache connect timeout second")
+	fs.IntVar(&c.Cache.ReadTimeoutSec, "cache_read_timeout_sec", 10, "cache read timeout second")
+	fs.IntVar(&c.Cache.WriteTimeoutSec, "cache_write_timeout_sec", 15, "cache write timeout second")
+	fs.StringVar(&c.Cache.Host, "cache_host", "127.0.0.1", "cache host")
+	fs.StringVar(&c.Cache.Port, "cache_port", "6379", "cache port")
+	fs.StringVar(&c.Cache.Password, "cache_password", "", "cache password")
+	fs.IntVar(&c.Examiner.MaxWorkerSize, "examiner_max_worker_size", 100, "examiner max worker size")
+	fs.IntVar(&c.Examiner.MaxPoolSize, "examiner_max_pool_size", 200, "examiner max pool size")
+	fs.IntVar(&c.Examiner.CategoriesRefreshLimit, "examiner_categories_refresh_limit", 0, "examiner categories refresh limit")
+	fs.IntVar(&c.Examiner.SectionsRefreshLimit, "examiner_sections_refresh_limit", 0, "examiner sections refresh limit")
+	fs.IntVar(&c.Examiner.ArticlesRefreshLimit, "examiner_articles_refresh_limit", 0, "examiner articles refresh limit")
+	fs.IntVar(&c.Examiner.TicketFormsRefreshLimit, "examiner_ticket_forms_refresh_limit", 0, "examiner ticket forms refresh limit")
+	fs.IntVar(&c.GraphQL.MaxDepth, "graphql_max_depth", 13, "max field nesting depth in a query")
+	fs.IntVar(&c.GraphQL.MaxParallelism, "graphql_max_parallelism", 10, "max number of resolvers per request allowed to run in parallel")
+	fs.IntVar(&c.GraphQL.MaxCost, "graphql_max_cost", 5000, "max static cost of a query, 0 means no limit")
+	fs.IntVar(&c.GraphQL.CostBudget, "graphql_cost_budget", 0, "max total cost of the queries per client in a window, 0 means no budget")
+	fs.IntVar(&c.GraphQL.CostBudgetWindowSec, "graphql_cost_budget_window_sec", 60, "cost budget window second")
+	fs.BoolVar(&c.Datadog.Enable, "datadog_enable", true, "datadog enable")
+	fs.BoolVar(&c.Datadog.Debug, "datadog_debug", false, "datadog debug")
+	fs.StringVar(&c.Datadog.Env, "datadog_env", "development", "datadog environment (development/staging/production)")
+	fs.StringVar(&c.Datadog.Host, "datadog_host", "localhost", "datadog host")
+	fs.StringVar(&c.Datadog.Port, "datadog_port", "8126", "datadog port")
+	fs.StringVar(&c.GRPC.ListenAddr, "grpc_listen_addr", ":50051", "grpc server listening address")
+	fs.IntVar(&c.GRPC.StreamBatchSize, "grpc_stream_batch_size", 100, "grpc streaming methods batch size")
+	fs.BoolVar(&c.GRPC.GatewayEnable, "grpc_gateway_enable", true, "grpc gateway serving the grpc methods as json apis under /v1 enable")
+	fs.BoolVar(&c.GRPC.ShareHTTPPort, "grpc_share_http_port", false, "serve grpc on the http listener, over h2c if tls is disabled")
+	fs.BoolVar(&c.Antispam.Enable, "antispam_enable", true, "antispam protection on create request enable")
+	fs.StringVar(&c.Antispam.CaptchaVerifier, "antispam_captcha_verifier", "none", "captcha verifier (none/recaptcha/fake)")
+	fs.StringVar(&c.Antispam.CaptchaVerifyURL, "antispam_captcha_verify_url", "https://www.google.com/recaptcha/api/siteverify", "captcha verify url")
+	fs.StringVar(&c.Antispam.CaptchaSecret, "antispam_captcha_secret", "", "captcha verify secret")
+	fs.IntVar(&c.Antispam.CaptchaTimeoutSec, "antispam_captcha_timeout_sec", 5, "captcha verify http request timeout")
+	fs.StringVar(&c.Antispam.FakeCaptchaToken, "antispam_fake_captcha_token", "", "the only token accepted by the fake captcha verifier")
+	fs.IntVar(&c.Antispam.IPRateLimit, "antispam_ip_rate_limit", 10, "max create requests per ip in a window, 0 means no limit")
+	fs.IntVar(&c.Antispam.EmailRateLimit, "antispam_email_rate_limit", 5, "max create requests per email in a window, 0 means no limit")
+	fs.IntVar(&c.Antispam.RateLimitWindowSec, "antispam_rate_limit_window_sec", 3600, "rate limit window second")
+	fs.IntVar(&c.Antispam.DuplicateWindowSec, "antispam_duplicate_window_sec", 86400, "duplicate content detecting window second")
 
-	flag.Parse()
+	fs.StringVar(&c.Redact.CustomFieldIDs, "redact_custom_field_ids", "", "comma separated ticket custom field ids whose values are scrubbed from logs and errors")
 
-	if *path != "" {
-		yFile, err := ioutil.ReadFile(*path)
-		if err != nil {
-			return nil, errors.Wrapf(err, "config: [New] ioutil read path:%q failed", *path)
-		}
+	fs.IntVar(&c.Subscription.KeepAliveSec, "subscription_keep_alive_sec", 15, "graphql-ws keep alive message interval second, 0 means no keep alive")
+	fs.IntVar(&c.Subscription.BufferSize, "subscription_buffer_size", 16, "events buffered per subscription, the events are dropped for a slow subscriber")
+	fs.IntVar(&c.Subscription.MaxPerConnection, "subscription_max_per_connection", 10, "max active subscriptions per websocket connection")
+	fs.StringVar(&c.Subscription.AllowedOrigins, "subscription_allowed_origins", "", "comma separated origins of the pages allowed to open the graphql-ws connections")
 
-		if err = yaml.Unmarshal(yFile, c); err != nil {
-			return nil, errors.Wrapf(err, "config: [New] yaml unmarshal failed")
-		}
-	}
+	fs.BoolVar(&c.PersistedQuery.Enable, "persisted_query_enable", true, "automatic persisted queries registered by the clients enable")
+	fs.BoolVar(&c.PersistedQuery.AllowListOnly, "persisted_query_allow_list_only", false, "only the queries of the manifest are executed")
+	fs.StringVar(&c.PersistedQuery.ManifestPath, "persisted_query_manifest_path", "", "json file path of the pre-registered queries keyed by their ids")
+	fs.IntVar(&c.PersistedQuery.TTLSec, "persisted_query_ttl_sec", 86400, "automatic persisted query TTL second, 0 means no expiration")
+	fs.IntVar(&c.PersistedQuery.CacheMaxAgeSec, "persisted_query_cache_max_age_sec", 60, "Cache-Control max-age second of the persisted queries over GET, 0 means no caching")
+	fs.IntVar(&c.Health.IntervalSec, "health_interval_sec", 10, "dependencies health check interval second")
+	fs.IntVar(&c.Health.TimeoutSec, "health_timeout_sec", 5, "dependencies health check timeout second")
+	fs.IntVar(&c.Health.MaxSyncAgeSec, "health_max_sync_age_sec", 0, "max age second of the last sync of a country before not ready, 0 means no limit")
+	fs.StringVar(&c.Auth.APIKeys, "auth_api_keys", "", "comma separated api keys in name:sha256:scopes form, the scopes are separated by +")
+	fs.StringVar(&c.Auth.JWTSecret, "auth_jwt_secret", "", "HS256 secret verifying the bearer JWTs, empty means the JWTs are rejected")
+	fs.StringVar(&c.Auth.JWTIssuer, "auth_jwt_issuer", "", "required iss claim of the JWTs, empty means any issuer")
+	fs.BoolVar(&c.Auth.TicketsScopeRequired, "auth_tickets_scope_required", false, "require the tickets:create scope for creating requests")
+	fs.StringVar(&c.TLS.CertFile, "tls_cert_file", "", "pem server certificate file of the http and grpc listeners, empty means tls is disabled")
+	fs.StringVar(&c.TLS.KeyFile, "tls_key_file", "", "pem server key file of the http and grpc listeners, empty means tls is disabled")
+	fs.StringVar(&c.TLS.ClientCAFile, "tls_client_ca_file", "", "pem ca bundle verifying the grpc client certificates, empty means mutual tls is disabled")
+	fs.IntVar(&c.TLS.ReloadIntervalSec, "tls_reload_interval_sec", 30, "interval second checking the certificate files for changes, 0 means no reloading")
+	fs.StringVar(&c.Purge.Purger, "purge_purger", "none", "cdn purger of the synced contents (none/http)")
+	fs.StringVar(&c.Purge.Endpoint, "purge_endpoint", "", "purge api url the surrogate keys are posted to, empty means the keys are not purged")
+	fs.StringVar(&c.Purge.Token, "purge_token", "", "bearer token of the purge requests")
+	fs.StringVar(&c.Purge.BaseURL, "purge_base_url", "", "cdn base url the content paths are purged under, empty means the paths are not purged")
+	fs.IntVar(&c.Purge.TimeoutSec, "purge_timeout_sec", 5, "purge http request timeout second")
+	fs.IntVar(&c.Analytics.FlushIntervalSec, "analytics_flush_interval_sec", 60, "interval second flushing the buffered analytics into the database")
+	fs.IntVar(&c.Analytics.SessionTTLSec, "analytics_session_ttl_sec", 86400, "second keeping the articles viewed in a session for the ticket filed later")
+	fs.IntVar(&c.Analytics.RetentionDays, "analytics_retention_days", 90, "days keeping the search queries, clicks and session events, 0 means they are kept forever")
+	fs.IntVar(&c.Analytics.DeflectionFieldID, "analytics_deflection_field_id", 0, "zendesk ticket custom field id of the viewed articles, 0 means they are appended to the comment")
+	fs.StringVar(&c.Votes.DeviceSecret, "votes_device_secret", "", "HMAC secret signing the device ids issued to the voters, empty means the votes are keyed by the ip without a principal")
+	fs.IntVar(&c.Votes.MaxPerIP, "votes_max_per_ip", 10, "max votes per ip on an article in a window, 0 means no limit")
+	fs.IntVar(&c.Votes.IPWindowSec, "votes_ip_window_sec", 86400, "votes per ip limit window second")
+	fs.BoolVar(&c.Metrics.Enable, "metrics_enable", true, "serve the prometheus metrics")
+	fs.StringVar(&c.Metrics.Path, "metrics_path", "/metrics", "http path of the prometheus metrics")
+	fs.StringVar(&c.Tracing.Exporter, "tracing_exporter", "datadog", "exporter of the spans (datadog/otlp/none), datadog also requires datadog_enable")
+	fs.StringVar(&c.Tracing.ServiceName, "tracing_service_name", "helpcenter-zendesk", "service name of the spans")
+	fs.Float64Var(&c.Tracing.SampleRatio, "tracing_sample_ratio", 1, "ratio of the new traces sampled by the otlp exporter, the traces continued from the callers follow their sampled flag")
+	fs.StringVar(&c.Tracing.OTLPEndpoint, "tracing_otlp_endpoint", "http://localhost:4318/v1/traces", "otlp/http traces url of the collector")
+	fs.StringVar(&c.Tracing.OTLPHeaders, "tracing_otlp_headers", "", "comma separated key=value headers of the otlp export requests")
+	fs.IntVar(&c.Tracing.OTLPFlushIntervalSec, "tracing_otlp_flush_interval_sec", 5, "interval second exporting the finished spans to the collector")
+	fs.StringVar(&c.Log.Level, "log_level", "info", "log level (debug/info/warn/error), reloaded on SIGHUP or the config file change")
+	fs.IntVar(&c.Reload.IntervalSec, "reload_interval_sec", 30, "interval second checking the config file for changes, 0 means only reloading on SIGHUP")
 
-	return c, nil
+	return path
 }
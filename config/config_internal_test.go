@@ -0,0 +1,307 @@
+package config
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/rs/zerolog"
+)
+
+var logger = zerolog.New(ioutil.Discard)
+
+func envOf(envs map[string]string) lookupEnvFunc {
+	return func(key string) (string, bool) {
+		value, ok := envs[key]
+		return value, ok
+	}
+}
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("write file:%q failed:%v", path, err)
+	}
+	return path
+}
+
+func loadConfig(path string, explicit map[string]string, lookupEnv lookupEnvFunc) (*Config, error) {
+	c := newConfig()
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	c.register(fs)
+	if explicit == nil {
+		explicit = make(map[string]string)
+	}
+	return c, c.load(fs, path, explicit, lookupEnv)
+}
+
+func TestLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatalf("temp dir failed:%v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := writeFile(t, dir, "env.yml", "database:\n  host: file-host\n  password: file-pwd\n  user: file-user\nlog:\n  level: warn\n")
+	otherFile := writeFile(t, dir, "other.yml", "database:\n  host: other-host\n")
+	secret := writeFile(t, dir, "secret", "secret-pwd\r\n")
+
+	type expect struct {
+		host     string
+		user     string
+		password string
+		level    string
+		ddHost   string
+	}
+	testCases := []struct {
+		description string
+		path        string
+		explicit    map[string]string
+		envs        map[string]string
+		expect      expect
+	}{
+		{
+			description: "testing defaults case",
+			expect:      expect{host: "localhost", user: "root", password: "", level: "info", ddHost: "localhost"},
+		},
+		{
+			description: "testing config file case",
+			path:        file,
+			expect:      expect{host: "file-host", user: "file-user", password: "file-pwd", level: "warn", ddHost: "localhost"},
+		},
+		{
+			description: "testing env overrides file case",
+			path:        file,
+			envs:        map[string]string{"ZEN_DB_HOST": "env-host", "ZEN_LOG_LEVEL": "error"},
+			expect:      expect{host: "env-host", user: "file-user", password: "file-pwd", level: "error", ddHost: "localhost"},
+		},
+		{
+			description: "testing secret file overrides env case",
+			path:        file,
+			envs:        map[string]string{"ZEN_DB_PASSWORD": "env-pwd", "ZEN_DB_PASSWORD_FILE": secret},
+			expect:      expect{host: "file-host", user: "file-user", password: "secret-pwd", level: "warn", ddHost: "localhost"},
+		},
+		{
+			description: "testing explicit flag overrides env case",
+			path:        file,
+			explicit:    map[string]string{"db_host": "flag-host"},
+			envs:        map[string]string{"ZEN_DB_HOST": "env-host"},
+			expect:      expect{host: "flag-host", user: "file-user", password: "file-pwd", level: "warn", ddHost: "localhost"},
+		},
+		{
+			description: "testing config path env case",
+			path:        file,
+			envs:        map[string]string{"ZEN_CONFIG_PATH": otherFile},
+			expect:      expect{host: "other-host", user: "root", password: "", level: "info", ddHost: "localhost"},
+		},
+		{
+			description: "testing explicit config path ignores env case",
+			path:        file,
+			explicit:    map[string]string{"config_path": file},
+			envs:        map[string]string{"ZEN_CONFIG_PATH": otherFile},
+			expect:      expect{host: "file-host", user: "file-user", password: "file-pwd", level: "warn", ddHost: "localhost"},
+		},
+		{
+			description: "testing legacy datadog env case",
+			envs:        map[string]string{"DATADOG_HOST": "legacy-host"},
+			expect:      expect{host: "localhost", user: "root", password: "", level: "info", ddHost: "legacy-host"},
+		},
+		{
+			description: "testing prefixed env overrides legacy env case",
+			envs:        map[string]string{"DATADOG_HOST": "legacy-host", "ZEN_DATADOG_HOST": "env-host"},
+			expect:      expect{host: "localhost", user: "root", password: "", level: "info", ddHost: "env-host"},
+		},
+	}
+
+	for _, tt := range testCases {
+		c, err := loadConfig(tt.path, tt.explicit, envOf(tt.envs))
+		if err != nil {
+			t.Errorf("[%s] load failed:%v", tt.description, err)
+			continue
+		}
+		got := expect{
+			host:     c.Database.Host,
+			user:     c.Database.User,
+			password: c.Database.Password,
+			level:    c.Log.Level,
+			ddHost:   c.Datadog.Host,
+		}
+		if diff := deep.Equal(got, tt.expect); diff != nil {
+			t.Errorf("[%s] %v", tt.description, diff)
+		}
+	}
+}
+
+func TestLoadError(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatalf("temp dir failed:%v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	unknown := writeFile(t, dir, "unknown.yml", "database:\n  hots: typo\n")
+	invalid := writeFile(t, dir, "invalid.yml", "database:\n  max_active: 0\nlog:\n  level: verbose\n")
+	secret := writeFile(t, dir, "secret", "not-a-number\n")
+	sha256Pwd := writeFile(t, dir, "sha256.yml", "http:\n  basic_auth_pwd_sha256: c63a08bdbcc51453b551e8503e4ca83fd4e4d37460a381917e05a5c0213d577d\n")
+	bothPwds := writeFile(t, dir, "both.yml", "http:\n  basic_auth_pwd: plain-pwd\n  basic_auth_pwd_hash: $2a$04$O2KD8USMnvxMoRBiwe/iAuiQPS6DghEYWN8pU9w8BKzR10rGCaWFW\n")
+
+	testCases := []struct {
+		description string
+		path        string
+		envs        map[string]string
+		expect      []string
+		unexpected  string
+	}{
+		{
+			description: "testing unknown key case",
+			path:        unknown,
+			expect:      []string{"yaml unmarshal", "hots"},
+		},
+		{
+			description: "testing missing file case",
+			path:        filepath.Join(dir, "missing.yml"),
+			expect:      []string{"ioutil read"},
+		},
+		{
+			description: "testing invalid settings case",
+			path:        invalid,
+			expect:      []string{"2 invalid settings", `db_max_active:"0" must be positive`, `log_level:"verbose" must be one of`},
+		},
+		{
+			description: "testing removed sha256 password key case",
+			path:        sha256Pwd,
+			expect:      []string{"yaml unmarshal", "basic_auth_pwd_sha256"},
+		},
+		{
+			description: "testing both basic auth passwords case",
+			path:        bothPwds,
+			expect:      []string{"only one of http_basic_auth_pwd_hash and the deprecated http_basic_auth_pwd"},
+			unexpected:  "plain-pwd",
+		},
+		{
+			description: "testing invalid basic auth password hash case",
+			envs:        map[string]string{"ZEN_HTTP_BASIC_AUTH_PWD_HASH": "not-a-hash"},
+			expect:      []string{"http_basic_auth_pwd_hash must be a bcrypt hash"},
+			unexpected:  "not-a-hash",
+		},
+		{
+			description: "testing invalid subscription allowed origin case",
+			envs:        map[string]string{"ZEN_SUBSCRIPTION_ALLOWED_ORIGINS": "https://help.honestbee.tw,help.honestbee.sg/path"},
+			expect:      []string{"subscription_allowed_origins", "must be comma separated http origins"},
+		},
+		{
+			description: "testing invalid env case",
+			envs:        map[string]string{"ZEN_DB_MAX_ACTIVE": "many"},
+			expect:      []string{"env:ZEN_DB_MAX_ACTIVE set failed"},
+		},
+		{
+			description: "testing invalid secret file case",
+			envs:        map[string]string{"ZEN_DB_MAX_ACTIVE_FILE": secret},
+			expect:      []string{"env:ZEN_DB_MAX_ACTIVE_FILE", "has invalid value"},
+			unexpected:  "not-a-number",
+		},
+	}
+
+	for _, tt := range testCases {
+		_, err := loadConfig(tt.path, nil, envOf(tt.envs))
+		if err == nil {
+			t.Errorf("[%s] expect an error", tt.description)
+			continue
+		}
+		for _, expect := range tt.expect {
+			if !strings.Contains(err.Error(), expect) {
+				t.Errorf("[%s] error:%q should contain %q", tt.description, err, expect)
+			}
+		}
+		if tt.unexpected != "" && strings.Contains(err.Error(), tt.unexpected) {
+			t.Errorf("[%s] error:%q should not contain %q", tt.description, err, tt.unexpected)
+		}
+	}
+}
+
+func TestLoadRepoConfig(t *testing.T) {
+	if _, err := loadConfig("../env.yml", nil, envOf(nil)); err != nil {
+		t.Errorf("load env.yml failed:%v", err)
+	}
+}
+
+func TestWatcherReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatalf("temp dir failed:%v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	base := "log:\n  level: info\nexaminer:\n  max_worker_size: 10\n  articles_refresh_limit: 30\n"
+
+	type expect struct {
+		level         string
+		articlesLimit int
+		maxWorkerSize int
+		notified      int
+	}
+	testCases := []struct {
+		description string
+		content     string
+		expectErr   bool
+		expect      expect
+	}{
+		{
+			description: "testing unchanged case",
+			content:     base,
+			expect:      expect{level: "info", articlesLimit: 30, maxWorkerSize: 10, notified: 0},
+		},
+		{
+			description: "testing reloadable settings changed case",
+			content:     "log:\n  level: debug\nexaminer:\n  max_worker_size: 10\n  articles_refresh_limit: 60\n",
+			expect:      expect{level: "debug", articlesLimit: 60, maxWorkerSize: 10, notified: 1},
+		},
+		{
+			description: "testing non reloadable setting changed case",
+			content:     "log:\n  level: info\nexaminer:\n  max_worker_size: 20\n  articles_refresh_limit: 30\n",
+			expect:      expect{level: "info", articlesLimit: 30, maxWorkerSize: 10, notified: 0},
+		},
+		{
+			description: "testing invalid config case",
+			content:     "log:\n  level: verbose\n",
+			expectErr:   true,
+			expect:      expect{level: "info", articlesLimit: 30, maxWorkerSize: 10, notified: 0},
+		},
+	}
+
+	for _, tt := range testCases {
+		path := writeFile(t, dir, "env.yml", base)
+		conf, err := loadConfig(path, nil, envOf(nil))
+		if err != nil {
+			t.Fatalf("[%s] load failed:%v", tt.description, err)
+		}
+		w := newWatcher(conf, &logger, envOf(nil))
+		var notified int
+		w.Subscribe(func(conf *Config) { notified++ })
+
+		writeFile(t, dir, "env.yml", tt.content)
+		err = w.Reload()
+		if (err != nil) != tt.expectErr {
+			t.Errorf("[%s] expect error:%v, got:%v", tt.description, tt.expectErr, err)
+		}
+
+		current := w.Current()
+		got := expect{
+			level:         current.Log.Level,
+			articlesLimit: current.Examiner.ArticlesRefreshLimit,
+			maxWorkerSize: current.Examiner.MaxWorkerSize,
+			notified:      notified,
+		}
+		if diff := deep.Equal(got, tt.expect); diff != nil {
+			t.Errorf("[%s] %v", tt.description, diff)
+		}
+		if conf.Log.Level != "info" {
+			t.Errorf("[%s] the loaded config should not be modified, got level:%q", tt.description, conf.Log.Level)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package config
+
+import (
+	"flag"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// EnvPrefix prefixes the environment variables of the flags, such as ZEN_DB_PASSWORD of db_password.
+	EnvPrefix = "ZEN_"
+	// SecretFileSuffix suffixes the environment variables of the files holding the values,
+	// such as ZEN_DB_PASSWORD_FILE, the trailing newlines of the files are trimmed.
+	SecretFileSuffix = "_FILE"
+)
+
+// configPathFlag is the flag of the config file path, it is not a setting.
+const configPathFlag = "config_path"
+
+// legacyEnvs are the environment variables read before the prefixed ones, kept for the existing deployments.
+var legacyEnvs = []struct {
+	env  string
+	flag string
+}{
+	{"DATADOG_HOST", "datadog_host"},
+	{"DATADOG_APM_PORT", "datadog_port"},
+}
+
+// lookupEnvFunc looks up an environment variable, such as os.LookupEnv.
+type lookupEnvFunc func(key string) (string, bool)
+
+// EnvName returns the environment variable of the flag.
+func EnvName(flagName string) string {
+	return EnvPrefix + strings.ToUpper(flagName)
+}
+
+// load layers the config file, the environment variables, the secret files and the explicit flags
+// over the flag defaults of fs, which are registered by c.register, and validates the result.
+func (c *Config) load(fs *flag.FlagSet, path string, explicit map[string]string, lookupEnv lookupEnvFunc) error {
+	if _, ok := explicit[configPathFlag]; !ok {
+		if env, ok := lookupEnv(EnvName(configPathFlag)); ok {
+			path = env
+		}
+	}
+
+	if path != "" {
+		yFile, err := ioutil.ReadFile(path)
+		if err != nil {
+			return errors.Wrapf(err, "config: [load] ioutil read path:%q failed", path)
+		}
+		// The unknown keys are rejected, so that a misspelled setting is not silently ignored.
+		if err = yaml.UnmarshalStrict(yFile, c); err != nil {
+			return errors.Wrapf(err, "config: [load] yaml unmarshal path:%q failed", path)
+		}
+	}
+
+	for _, legacy := range legacyEnvs {
+		if value, ok := lookupEnv(legacy.env); ok && value != "" {
+			if err := fs.Set(legacy.flag, value); err != nil {
+				return errors.Wrapf(err, "config: [load] env:%s set failed", legacy.env)
+			}
+		}
+	}
+
+	var err error
+	fs.VisitAll(func(f *flag.Flag) {
+		if err != nil || f.Name == configPathFlag {
+			return
+		}
+		name := EnvName(f.Name)
+		if value, ok := lookupEnv(name); ok {
+			if err = f.Value.Set(value); err != nil {
+				err = errors.Wrapf(err, "config: [load] env:%s set failed", name)
+				return
+			}
+		}
+		// The secret file is read after the variable, so that the file wins if both are given.
+		if file, ok := lookupEnv(name + SecretFileSuffix); ok {
+			b, rerr := ioutil.ReadFile(file)
+			if rerr != nil {
+				err = errors.Wrapf(rerr, "config: [load] env:%s read file:%q failed", name+SecretFileSuffix, file)
+				return
+			}
+			// The value is not put in the error since it's probably a secret.
+			if serr := f.Value.Set(strings.TrimRight(string(b), "\r\n")); serr != nil {
+				err = errors.Errorf("config: [load] env:%s file:%q has invalid value", name+SecretFileSuffix, file)
+				return
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(explicit))
+	for name := range explicit {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := fs.Set(name, explicit[name]); err != nil {
+			return errors.Wrapf(err, "config: [load] flag:%s set failed", name)
+		}
+	}
+
+	c.path = path
+	c.explicit = explicit
+
+	return errors.Wrapf(c.Validate(), "config: [load] validate failed")
+}
+
+// reload loads the config again from the same config file and explicit flags, with the current
+// environment variables and secret files.
+func (c *Config) reload(lookupEnv lookupEnvFunc) (*Config, error) {
+	next := newConfig()
+	fs := flag.NewFlagSet("config", flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	next.register(fs)
+
+	explicit := make(map[string]string, len(c.explicit))
+	for name, value := range c.explicit {
+		explicit[name] = value
+	}
+	// The path resolved at the start is used, the environment variable is not looked up again.
+	explicit[configPathFlag] = c.path
+
+	if err := next.load(fs, c.path, explicit, lookupEnv); err != nil {
+		return nil, errors.Wrapf(err, "config: [reload] load failed")
+	}
+	return next, nil
+}
@@ -0,0 +1,181 @@
+package config
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// validator collects the problems of the settings, the settings are named by their flags.
+type validator struct {
+	problems []string
+}
+
+func (v *validator) check(ok bool, name string, value interface{}, format string, args ...interface{}) {
+	if !ok {
+		v.problems = append(v.problems, fmt.Sprintf("%s:%q %s", name, fmt.Sprint(value), fmt.Sprintf(format, args...)))
+	}
+}
+
+func (v *validator) positive(name string, value int) {
+	v.check(value > 0, name, value, "must be positive")
+}
+
+func (v *validator) nonNegative(name string, value int) {
+	v.check(value >= 0, name, value, "must not be negative")
+}
+
+func (v *validator) oneOf(name, value string, options ...string) {
+	for _, option := range options {
+		if value == option {
+			return
+		}
+	}
+	v.check(false, name, value, "must be one of %s", strings.Join(options, "/"))
+}
+
+func (v *validator) port(name, value string) {
+	port, err := strconv.Atoi(value)
+	v.check(err == nil && port > 0 && port <= 65535, name, value, "must be a port number")
+}
+
+func (v *validator) addr(name, value string) {
+	_, port, err := net.SplitHostPort(value)
+	v.check(err == nil && port != "", name, value, "must be a host:port address")
+}
+
+// url checks value is an absolute http url, the empty value is valid unless required.
+func (v *validator) url(name, value string, required bool) {
+	if value == "" && !required {
+		return
+	}
+	u, err := url.Parse(value)
+	v.check(err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != "", name, value, "must be an absolute http url")
+}
+
+func (v *validator) err() error {
+	if len(v.problems) == 0 {
+		return nil
+	}
+	return errors.Errorf("config: [Validate] %d invalid settings: %s", len(v.problems), strings.Join(v.problems, "; "))
+}
+
+// Validate checks the settings, all the invalid settings are reported by their flags in the error.
+// The secrets are not checked, so that they are not put in the error.
+func (c *Config) Validate() error {
+	v := new(validator)
+
+	v.addr("http_listen_addr", c.HTTP.ListenAddr)
+	v.positive("http_read_timeout_sec", c.HTTP.ReadTimeoutSec)
+	v.positive("http_write_timeout_sec", c.HTTP.WriteTimeoutSec)
+	v.nonNegative("http_idle_timeout_sec", c.HTTP.IdleTimeoutSec)
+	if c.HTTP.BasicAuthPwdHash != "" {
+		if _, err := bcrypt.Cost([]byte(c.HTTP.BasicAuthPwdHash)); err != nil {
+			v.problems = append(v.problems, "http_basic_auth_pwd_hash must be a bcrypt hash")
+		}
+		if c.HTTP.BasicAuthPwd != "" {
+			v.problems = append(v.problems, "only one of http_basic_auth_pwd_hash and the deprecated http_basic_auth_pwd can be set")
+		}
+	}
+	v.nonNegative("http_cache_max_age_sec", c.HTTP.CacheMaxAgeSec)
+	v.nonNegative("http_cdn_max_age_sec", c.HTTP.CDNMaxAgeSec)
+	_, err := c.HTTP.TrustedProxyNets()
+	v.check(err == nil, "http_trusted_proxies", c.HTTP.TrustedProxies, "must be comma separated ips or CIDRs")
+
+	v.nonNegative("db_max_idle", c.Database.MaxIdle)
+	v.positive("db_max_active", c.Database.MaxActive)
+	v.positive("db_connect_timeout_sec", c.Database.ConnectTimeoutSec)
+	v.positive("db_read_timeout_sec", c.Database.ReadTimeoutSec)
+	v.positive("db_write_timeout_sec", c.Database.WriteTimeoutSec)
+	v.positive("db_transaction_max_timeout_sec", c.Database.TransactionMaxTimeoutSec)
+	v.check(c.Database.Host != "", "db_host", c.Database.Host, "must not be empty")
+	v.port("db_port", c.Database.Port)
+
+	v.positive("zendesk_request_timeout_sec", c.ZenDesk.RequestTimeoutSec)
+	v.url("zendesk_hk_base_url", c.ZenDesk.HKBaseURL, true)
+	v.url("zendesk_id_base_url", c.ZenDesk.IDBaseURL, true)
+	v.url("zendesk_jp_base_url", c.ZenDesk.JPBaseURL, true)
+	v.url("zendesk_my_base_url", c.ZenDesk.MYBaseURL, true)
+	v.url("zendesk_ph_base_url", c.ZenDesk.PHBaseURL, true)
+	v.url("zendesk_sg_base_url", c.ZenDesk.SGBaseURL, true)
+	v.url("zendesk_th_base_url", c.ZenDesk.THBaseURL, true)
+	v.url("zendesk_tw_base_url", c.ZenDesk.TWBaseURL, true)
+
+	v.nonNegative("cache_max_idle", c.Cache.MaxIdle)
+	v.nonNegative("cache_max_active", c.Cache.MaxActive)
+	v.nonNegative("cache_idle_timeout_sec", c.Cache.IdleTimeoutSec)
+	v.positive("cache_connect_timeout_sec", c.Cache.ConnectTimeoutSec)
+	v.positive("cache_read_timeout_sec", c.Cache.ReadTimeoutSec)
+	v.positive("cache_write_timeout_sec", c.Cache.WriteTimeoutSec)
+	v.check(c.Cache.Host != "", "cache_host", c.Cache.Host, "must not be empty")
+	v.port("cache_port", c.Cache.Port)
+
+	v.positive("examiner_max_worker_size", c.Examiner.MaxWorkerSize)
+	v.nonNegative("examiner_max_pool_size", c.Examiner.MaxPoolSize)
+
+	v.positive("graphql_max_depth", c.GraphQL.MaxDepth)
+	v.positive("graphql_max_parallelism", c.GraphQL.MaxParallelism)
+	v.nonNegative("graphql_max_cost", c.GraphQL.MaxCost)
+	v.nonNegative("graphql_cost_budget", c.GraphQL.CostBudget)
+	v.positive("graphql_cost_budget_window_sec", c.GraphQL.CostBudgetWindowSec)
+
+	v.port("datadog_port", c.Datadog.Port)
+
+	v.addr("grpc_listen_addr", c.GRPC.ListenAddr)
+	v.positive("grpc_stream_batch_size", c.GRPC.StreamBatchSize)
+
+	v.oneOf("antispam_captcha_verifier", c.Antispam.CaptchaVerifier, "none", "recaptcha", "fake")
+	v.url("antispam_captcha_verify_url", c.Antispam.CaptchaVerifyURL, c.Antispam.CaptchaVerifier == "recaptcha")
+	v.positive("antispam_captcha_timeout_sec", c.Antispam.CaptchaTimeoutSec)
+	v.nonNegative("antispam_ip_rate_limit", c.Antispam.IPRateLimit)
+	v.nonNegative("antispam_email_rate_limit", c.Antispam.EmailRateLimit)
+	v.positive("antispam_rate_limit_window_sec", c.Antispam.RateLimitWindowSec)
+	v.positive("antispam_duplicate_window_sec", c.Antispam.DuplicateWindowSec)
+
+	v.nonNegative("subscription_keep_alive_sec", c.Subscription.KeepAliveSec)
+	v.positive("subscription_buffer_size", c.Subscription.BufferSize)
+	v.positive("subscription_max_per_connection", c.Subscription.MaxPerConnection)
+	_, err = c.Subscription.AllowedOriginList()
+	v.check(err == nil, "subscription_allowed_origins", c.Subscription.AllowedOrigins, "must be comma separated http origins")
+
+	v.nonNegative("persisted_query_ttl_sec", c.PersistedQuery.TTLSec)
+	v.nonNegative("persisted_query_cache_max_age_sec", c.PersistedQuery.CacheMaxAgeSec)
+
+	v.positive("health_interval_sec", c.Health.IntervalSec)
+	v.positive("health_timeout_sec", c.Health.TimeoutSec)
+	v.nonNegative("health_max_sync_age_sec", c.Health.MaxSyncAgeSec)
+
+	v.check((c.TLS.CertFile == "") == (c.TLS.KeyFile == ""), "tls_key_file", c.TLS.KeyFile, "must be given with tls_cert_file")
+	v.check(c.TLS.ClientCAFile == "" || c.TLS.CertFile != "", "tls_client_ca_file", c.TLS.ClientCAFile, "requires tls_cert_file")
+	v.nonNegative("tls_reload_interval_sec", c.TLS.ReloadIntervalSec)
+
+	v.oneOf("purge_purger", c.Purge.Purger, "none", "http")
+	v.url("purge_endpoint", c.Purge.Endpoint, false)
+	v.url("purge_base_url", c.Purge.BaseURL, false)
+	v.positive("purge_timeout_sec", c.Purge.TimeoutSec)
+
+	v.positive("analytics_flush_interval_sec", c.Analytics.FlushIntervalSec)
+	v.positive("analytics_session_ttl_sec", c.Analytics.SessionTTLSec)
+	v.nonNegative("analytics_retention_days", c.Analytics.RetentionDays)
+	v.nonNegative("analytics_deflection_field_id", c.Analytics.DeflectionFieldID)
+
+	v.nonNegative("votes_max_per_ip", c.Votes.MaxPerIP)
+	v.positive("votes_ip_window_sec", c.Votes.IPWindowSec)
+
+	v.check(strings.HasPrefix(c.Metrics.Path, "/"), "metrics_path", c.Metrics.Path, "must start with /")
+
+	v.oneOf("tracing_exporter", c.Tracing.Exporter, "datadog", "otlp", "none", "")
+	v.check(c.Tracing.SampleRatio >= 0 && c.Tracing.SampleRatio <= 1, "tracing_sample_ratio", c.Tracing.SampleRatio, "must be between 0 and 1")
+	v.url("tracing_otlp_endpoint", c.Tracing.OTLPEndpoint, c.Tracing.Exporter == "otlp")
+	v.positive("tracing_otlp_flush_interval_sec", c.Tracing.OTLPFlushIntervalSec)
+
+	v.oneOf("log_level", c.Log.Level, "debug", "info", "warn", "error")
+	v.nonNegative("reload_interval_sec", c.Reload.IntervalSec)
+
+	return v.err()
+}
@@ -0,0 +1,232 @@
+package config
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"reflect"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// reloadable are the settings applied by reloading, named by their yaml paths.
+// The other settings are read once at the start, so that their changes require restarting the server.
+var reloadable = map[string]bool{
+	"log.level":                           true,
+	"examiner.categories_refresh_limit":   true,
+	"examiner.sections_refresh_limit":     true,
+	"examiner.articles_refresh_limit":     true,
+	"examiner.ticket_forms_refresh_limit": true,
+	"antispam.ip_rate_limit":              true,
+	"antispam.email_rate_limit":           true,
+	"antispam.rate_limit_window_sec":      true,
+	"antispam.duplicate_window_sec":       true,
+}
+
+// Watcher reloads the config on SIGHUP or when the config file is changed, the reloadable settings
+// are applied and the reloaded config is passed to the subscribers.
+// The current config is kept if the reloaded one is invalid.
+type Watcher struct {
+	logger    *zerolog.Logger
+	interval  time.Duration
+	lookupEnv lookupEnvFunc
+
+	mu          sync.Mutex
+	current     *Config
+	modTime     time.Time
+	subscribers []func(conf *Config)
+
+	signals chan os.Signal
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// NewWatcher returns a Watcher instance of conf loaded by New and starts watching.
+func NewWatcher(conf *Config, logger *zerolog.Logger) (*Watcher, error) {
+	w := newWatcher(conf, logger, os.LookupEnv)
+
+	signal.Notify(w.signals, syscall.SIGHUP)
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	go w.run(ctx)
+
+	return w, nil
+}
+
+func newWatcher(conf *Config, logger *zerolog.Logger, lookupEnv lookupEnvFunc) *Watcher {
+	w := &Watcher{
+		logger:    logger,
+		interval:  time.Duration(conf.Reload.IntervalSec) * time.Second,
+		lookupEnv: lookupEnv,
+		current:   conf,
+		signals:   make(chan os.Signal, 1),
+		cancel:    func() {},
+		done:      make(chan struct{}),
+	}
+	w.modTime, _ = w.stat()
+	return w
+}
+
+// Subscribe adds fn called with the config whenever the reloadable settings are changed.
+func (w *Watcher) Subscribe(fn func(conf *Config)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.subscribers = append(w.subscribers, fn)
+}
+
+// Reload loads the config again and applies the changed reloadable settings,
+// the changes of the other settings are only logged.
+func (w *Watcher) Reload() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.modTime, _ = w.stat()
+	loaded, err := w.current.reload(w.lookupEnv)
+	if err != nil {
+		return errors.Wrapf(err, "config: [Reload] reload failed")
+	}
+
+	next := w.current.clone()
+	current, changes := settings(next), settings(loaded)
+	paths := make([]string, 0, len(changes))
+	for path := range changes {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var applied, ignored []string
+	for _, path := range paths {
+		if reflect.DeepEqual(current[path].Interface(), changes[path].Interface()) {
+			continue
+		}
+		if !reloadable[path] {
+			ignored = append(ignored, path)
+			continue
+		}
+		current[path].Set(changes[path])
+		applied = append(applied, path)
+	}
+
+	if len(ignored) > 0 {
+		w.logger.Warn().Fields(map[string]interface{}{
+			"settings": ignored,
+		}).Msgf("config: [Reload] settings changed, restart to apply")
+	}
+	if len(applied) == 0 {
+		return nil
+	}
+
+	w.current = next
+	for _, fn := range w.subscribers {
+		fn(next)
+	}
+	w.logger.Info().Fields(map[string]interface{}{
+		"settings": applied,
+	}).Msgf("config: [Reload] settings reloaded")
+	return nil
+}
+
+// Current returns the config with the reloaded settings applied.
+func (w *Watcher) Current() *Config {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.current
+}
+
+// stat returns the modification time of the config file.
+func (w *Watcher) stat() (time.Time, error) {
+	if w.current.path == "" {
+		return time.Time{}, nil
+	}
+	info, err := os.Stat(w.current.path)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "config: [stat] stat path:%q failed", w.current.path)
+	}
+	return info.ModTime(), nil
+}
+
+// changed reports whether the config file is modified since the last reload.
+func (w *Watcher) changed() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	modTime, err := w.stat()
+	// The file being replaced may be missing for a moment, it is checked again next time.
+	if err != nil {
+		return false
+	}
+	return !modTime.Equal(w.modTime)
+}
+
+func (w *Watcher) run(ctx context.Context) {
+	defer close(w.done)
+
+	var tick <-chan time.Time
+	if w.interval > 0 {
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		select {
+		case <-w.signals:
+		case <-tick:
+			if !w.changed() {
+				continue
+			}
+		case <-ctx.Done():
+			return
+		}
+
+		if err := w.Reload(); err != nil {
+			w.logger.Error().Err(err).Msgf("config: [run] reload failed, the current config is kept")
+		}
+	}
+}
+
+// Close stops watching.
+func (w *Watcher) Close() error {
+	signal.Stop(w.signals)
+	w.cancel()
+	<-w.done
+	return nil
+}
+
+// clone returns a copy of c, the sections are copied as well.
+func (c *Config) clone() *Config {
+	clone := newConfig()
+	src, dst := reflect.ValueOf(c).Elem(), reflect.ValueOf(clone).Elem()
+	for i := 0; i < src.NumField(); i++ {
+		if src.Field(i).Kind() == reflect.Ptr && dst.Field(i).CanSet() {
+			dst.Field(i).Elem().Set(src.Field(i).Elem())
+		}
+	}
+	clone.path = c.path
+	clone.explicit = c.explicit
+	return clone
+}
+
+// settings returns the settings of c by their yaml paths, such as examiner.max_worker_size.
+func settings(c *Config) map[string]reflect.Value {
+	values := make(map[string]reflect.Value)
+	v := reflect.ValueOf(c).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		section := v.Type().Field(i).Tag.Get("yaml")
+		if section == "" || v.Field(i).Kind() != reflect.Ptr {
+			continue
+		}
+		s := v.Field(i).Elem()
+		for j := 0; j < s.NumField(); j++ {
+			values[section+"."+s.Type().Field(j).Tag.Get("yaml")] = s.Field(j)
+		}
+	}
+	return values
+}
@@ -0,0 +1,235 @@
+package cost
+
+import (
+	"strconv"
+
+	gographql "github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/introspection"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/honestbee/Zen/gqldoc"
+	"github.com/honestbee/Zen/inout"
+)
+
+const (
+	// defaultListSize is the assumed size of the list whose size is not limited by any argument.
+	defaultListSize = 10
+	// maxFragmentDepth stops the analysis of the cyclic fragments which are rejected by the validation anyway.
+	maxFragmentDepth = 32
+)
+
+// pageSizeArgs are the arguments limiting the size of a listing, first takes precedence over perPage.
+var pageSizeArgs = [...]string{"first", "perPage", "topN"}
+
+// Analyzer computes the static cost of the graphql operations before they are executed.
+// Every object resolved costs 1, the cost of the selections of a list are multiplied by the
+// size of the list which is taken from the paging arguments, such as first and perPage.
+type Analyzer struct {
+	types map[string]*typeInfo
+	roots map[string]string
+}
+
+type typeInfo struct {
+	leaf   bool
+	fields map[string]*fieldInfo
+}
+
+type fieldInfo struct {
+	typeName string
+	list     bool
+	defaults map[string]interface{} // The default values of the arguments.
+}
+
+// NewAnalyzer returns an Analyzer instance of the schema.
+func NewAnalyzer(s *gographql.Schema) *Analyzer {
+	inspected := s.Inspect()
+
+	a := &Analyzer{
+		types: make(map[string]*typeInfo),
+		roots: make(map[string]string),
+	}
+	for keyword, t := range map[string]*introspection.Type{
+		"query":        inspected.QueryType(),
+		"mutation":     inspected.MutationType(),
+		"subscription": inspected.SubscriptionType(),
+	} {
+		if t != nil {
+			a.roots[keyword] = *t.Name()
+		}
+	}
+
+	for _, t := range inspected.Types() {
+		info := &typeInfo{
+			leaf:   t.Kind() == "SCALAR" || t.Kind() == "ENUM",
+			fields: make(map[string]*fieldInfo),
+		}
+		if fields := t.Fields(&struct{ IncludeDeprecated bool }{true}); fields != nil {
+			for _, f := range *fields {
+				info.fields[f.Name()] = newFieldInfo(f)
+			}
+		}
+		a.types[*t.Name()] = info
+	}
+
+	return a
+}
+
+func newFieldInfo(f *introspection.Field) *fieldInfo {
+	info := &fieldInfo{defaults: make(map[string]interface{})}
+
+	for t := f.Type(); t != nil; t = t.OfType() {
+		if t.Kind() == "LIST" {
+			info.list = true
+		}
+		if name := t.Name(); name != nil {
+			info.typeName = *name
+		}
+	}
+
+	for _, arg := range f.Args() {
+		if v := arg.DefaultValue(); v != nil {
+			if n, err := strconv.Atoi(*v); err == nil {
+				info.defaults[arg.Name()] = n
+			}
+		}
+	}
+	return info
+}
+
+// Cost returns the cost of the operation of the document. The invalid fields are left
+// for the validation of the schema and they cost nothing.
+func (a *Analyzer) Cost(doc *gqldoc.Document, op *ast.OperationDefinition, variables map[string]interface{}) int {
+	c := &computation{
+		analyzer:  a,
+		fragments: doc.Fragments,
+		variables: variables,
+		defaults:  op.VariableDefinitions,
+	}
+	return c.selectionCost(op.SelectionSet, a.roots[string(op.Operation)], 0, 0)
+}
+
+type computation struct {
+	analyzer  *Analyzer
+	fragments ast.FragmentDefinitionList
+	variables map[string]interface{}
+	defaults  ast.VariableDefinitionList
+}
+
+// selectionCost returns the cost of the selections of the type,
+// pageSize is the size of the lists selected, it is set by the parent connection field.
+func (c *computation) selectionCost(sels ast.SelectionSet, typeName string, pageSize, depth int) int {
+	if depth > maxFragmentDepth {
+		return 0
+	}
+	t, ok := c.analyzer.types[typeName]
+	if !ok {
+		return 0
+	}
+
+	total := 0
+	for _, sel := range sels {
+		switch s := sel.(type) {
+		case *ast.Field:
+			total += c.fieldCost(s, t, pageSize, depth)
+		case *ast.InlineFragment:
+			condition := s.TypeCondition
+			if condition == "" {
+				condition = typeName
+			}
+			total += c.selectionCost(s.SelectionSet, condition, pageSize, depth+1)
+		case *ast.FragmentSpread:
+			if frag := c.fragments.ForName(s.Name); frag != nil {
+				total += c.selectionCost(frag.SelectionSet, frag.TypeCondition, pageSize, depth+1)
+			}
+		}
+	}
+	return total
+}
+
+func (c *computation) fieldCost(f *ast.Field, parent *typeInfo, pageSize, depth int) int {
+	info, ok := parent.fields[f.Name]
+	if !ok {
+		return 0
+	}
+	if t, ok := c.analyzer.types[info.typeName]; !ok || t.leaf {
+		return 0
+	}
+
+	size, limited := c.sizeOf(f, info)
+	if !info.list {
+		// The size limits the lists of the connection type.
+		if !limited {
+			size = 0
+		}
+		return 1 + c.selectionCost(f.SelectionSet, info.typeName, size, depth)
+	}
+
+	if !limited {
+		size = pageSize
+	}
+	if size <= 0 {
+		size = defaultListSize
+	}
+	return 1 + size*c.selectionCost(f.SelectionSet, info.typeName, 0, depth)
+}
+
+// sizeOf returns the size limited by the arguments of the field, false is returned if
+// the field has no argument limiting the size.
+func (c *computation) sizeOf(f *ast.Field, info *fieldInfo) (int, bool) {
+	for _, name := range pageSizeArgs {
+		if v, ok := c.argument(f, info, name).(int); ok {
+			if v > inout.MaxPerPage && name != "topN" {
+				v = inout.MaxPerPage
+			}
+			return v, true
+		}
+	}
+
+	if ids, ok := c.argument(f, info, "ids").([]interface{}); ok {
+		return len(ids), true
+	}
+	return 0, false
+}
+
+// argument returns the value of the argument, the variables are resolved and
+// the default value of the argument is returned if it is not given.
+func (c *computation) argument(f *ast.Field, info *fieldInfo, name string) interface{} {
+	arg := f.Arguments.ForName(name)
+	if arg == nil {
+		return info.defaults[name]
+	}
+
+	value := arg.Value
+	if value.Kind == ast.Variable {
+		if v, ok := c.variables[value.Raw]; ok {
+			if v == nil {
+				return info.defaults[name]
+			}
+			return normalize(v)
+		}
+		def := c.defaults.ForName(value.Raw)
+		if def == nil || def.DefaultValue == nil {
+			return info.defaults[name]
+		}
+		value = def.DefaultValue
+	}
+
+	v, err := value.Value(c.variables)
+	if err != nil || v == nil {
+		return info.defaults[name]
+	}
+	return normalize(v)
+}
+
+// normalize converts the json decoded numbers into int.
+func normalize(v interface{}) interface{} {
+	switch n := v.(type) {
+	case float64:
+		return int(n)
+	case int32:
+		return int(n)
+	case int64:
+		return int(n)
+	}
+	return v
+}
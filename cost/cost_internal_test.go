@@ -0,0 +1,94 @@
+package cost
+
+import (
+	"testing"
+
+	gographql "github.com/graph-gophers/graphql-go"
+
+	"github.com/honestbee/Zen/gqldoc"
+	"github.com/honestbee/Zen/schema"
+)
+
+func TestCost(t *testing.T) {
+	analyzer := NewAnalyzer(gographql.MustParseSchema(schema.String(), nil))
+
+	testCases := [...]struct {
+		description   string
+		query         string
+		operationName string
+		variables     map[string]interface{}
+		expect        int
+	}{
+		{
+			description: "testing default page size case",
+			query:       `{ allCategories { edges { node { name } } } }`,
+			expect:      32,
+		},
+		{
+			description: "testing nested connections case",
+			query:       `{ allCategories(perPage: 100) { edges { node { articlesConnection(perPage: 100) { edges { node { title } } } } } } }`,
+			expect:      10302,
+		},
+		{
+			description: "testing first takes precedence over perPage case",
+			query:       `{ allCategories(first: 5, perPage: 100) { edges { node { name } } } }`,
+			expect:      7,
+		},
+		{
+			description: "testing page size over the max case",
+			query:       `{ allArticles(perPage: 1000) { edges { node { title } } } }`,
+			expect:      102,
+		},
+		{
+			description: "testing variable case",
+			query:       `query Articles($n: Int = 20) { allArticles(first: $n) { edges { node { title } } } }`,
+			variables:   map[string]interface{}{"n": float64(10)},
+			expect:      12,
+		},
+		{
+			description: "testing variable default value case",
+			query:       `query Articles($n: Int = 20) { allArticles(first: $n) { edges { node { title } } } }`,
+			expect:      22,
+		},
+		{
+			description:   "testing fragments and selected operation case",
+			query:         `fragment F on Category { articlesConnection(first: 2) { edges { node { title } } } } query A { allCategories(first: 3) { edges { node { ...F } } } } query B { nodes(ids: ["a", "b"]) { ... on Category { articlesConnection(first: 2) { edges { node { title } } } } } }`,
+			operationName: "A",
+			expect:        17,
+		},
+		{
+			description:   "testing inline fragments and list arguments case",
+			query:         `fragment F on Category { articlesConnection(first: 2) { edges { node { title } } } } query A { allCategories(first: 3) { edges { node { ...F } } } } query B { nodes(ids: ["a", "b"]) { ... on Category { articlesConnection(first: 2) { edges { node { title } } } } } }`,
+			operationName: "B",
+			expect:        9,
+		},
+		{
+			description: "testing mutation case",
+			query:       `mutation { voteArticle(articleId: "1", vote: UP) { id title } }`,
+			expect:      1,
+		},
+		{
+			description: "testing explicit null variable case",
+			query:       `query Articles($n: Int = 20) { allArticles(first: $n) { edges { node { title } } } }`,
+			variables:   map[string]interface{}{"n": nil},
+			expect:      32,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			doc, err := gqldoc.Parse(tt.query)
+			if err != nil {
+				t.Fatalf("[%s] parse failed:%v", tt.description, err)
+			}
+			op, operr := doc.Operation(tt.operationName)
+			if operr != nil {
+				t.Fatalf("[%s] operation failed:%v", tt.description, operr)
+			}
+			actual := analyzer.Cost(doc, op, tt.variables)
+			if actual != tt.expect {
+				t.Errorf("[%s] expect cost:%d, actual:%d", tt.description, tt.expect, actual)
+			}
+		})
+	}
+}
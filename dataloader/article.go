@@ -15,6 +15,7 @@ import (
 	"github.com/honestbee/Zen/examiner"
 	"github.com/honestbee/Zen/inout"
 	"github.com/honestbee/Zen/models"
+	"github.com/honestbee/Zen/session"
 )
 
 // LoadArticles implements data loader.
@@ -105,10 +106,12 @@ func (l articlesLoader) loadBatch(ctx context.Context, keys dataloader.Keys) []*
 						&models.GetArticlesParams{
 							Locale:      data.Locale,
 							CountryCode: data.CountryCode,
-							PerPage:     int(data.PerPage),
+							PerPage:     int(data.PerPage) + 1,
 							Page:        int(data.Page),
 							SortBy:      data.SortBy,
 							SortOrder:   data.SortOrder,
+							After:       data.Cursor,
+							Keyset:      data.Keyset,
 							CategoryID:  int(id64),
 						}, []string{})
 					if err != nil {
@@ -120,14 +123,26 @@ func (l articlesLoader) loadBatch(ctx context.Context, keys dataloader.Keys) []*
 						return
 					}
 
+					hasNextPage := len(articles) > int(data.PerPage)
+					if hasNextPage {
+						articles = articles[:data.PerPage]
+					}
+
 					articlesOut := &inout.GetArticlesOut{
 						Articles: articles,
 						BaseOut: &inout.BaseOut{
-							Page:      int(math.Round(float64(data.Page)/float64(data.PerPage))) + 1,
+							Page:      inout.CurrentPage(int(data.Page), int(data.PerPage)),
 							PerPage:   int(data.PerPage),
 							PageCount: int(math.Ceil(float64(total) / float64(data.PerPage))),
 							Count:     total,
 						},
+						Connection: &inout.ConnectionOut{
+							SortBy:          data.SortBy,
+							SortOrder:       data.SortOrder,
+							Offset:          int(data.Page),
+							HasNextPage:     hasNextPage,
+							HasPreviousPage: data.Page > 0 || data.Cursor != nil,
+						},
 					}
 					results[i] = &dataloader.Result{Data: articlesOut}
 
@@ -167,10 +182,12 @@ func (l articlesLoader) loadBatch(ctx context.Context, keys dataloader.Keys) []*
 						&models.GetArticlesParams{
 							Locale:      data.Locale,
 							CountryCode: data.CountryCode,
-							PerPage:     int(data.PerPage),
+							PerPage:     int(data.PerPage) + 1,
 							Page:        int(data.Page),
 							SortBy:      data.SortBy,
 							SortOrder:   data.SortOrder,
+							After:       data.Cursor,
+							Keyset:      data.Keyset,
 							SectionID:   int(id64),
 						})
 					if err != nil {
@@ -182,14 +199,26 @@ func (l articlesLoader) loadBatch(ctx context.Context, keys dataloader.Keys) []*
 						return
 					}
 
+					hasNextPage := len(articles) > int(data.PerPage)
+					if hasNextPage {
+						articles = articles[:data.PerPage]
+					}
+
 					articlesOut := &inout.GetArticlesOut{
 						Articles: articles,
 						BaseOut: &inout.BaseOut{
-							Page:      int(math.Round(float64(data.Page)/float64(data.PerPage))) + 1,
+							Page:      inout.CurrentPage(int(data.Page), int(data.PerPage)),
 							PerPage:   int(data.PerPage),
 							PageCount: int(math.Ceil(float64(total) / float64(data.PerPage))),
 							Count:     total,
 						},
+						Connection: &inout.ConnectionOut{
+							SortBy:          data.SortBy,
+							SortOrder:       data.SortOrder,
+							Offset:          int(data.Page),
+							HasNextPage:     hasNextPage,
+							HasPreviousPage: data.Page > 0 || data.Cursor != nil,
+						},
 					}
 					results[i] = &dataloader.Result{Data: articlesOut}
 
@@ -219,10 +248,12 @@ func (l articlesLoader) loadBatch(ctx context.Context, keys dataloader.Keys) []*
 						&models.GetArticlesParams{
 							Locale:      data.Locale,
 							CountryCode: data.CountryCode,
-							PerPage:     int(data.PerPage),
+							PerPage:     int(data.PerPage) + 1,
 							Page:        int(data.Page),
 							SortBy:      data.SortBy,
 							SortOrder:   data.SortOrder,
+							After:       data.Cursor,
+							Keyset:      data.Keyset,
 						})
 					if err != nil {
 						results[i] = &dataloader.Result{
@@ -233,14 +264,26 @@ func (l articlesLoader) loadBatch(ctx context.Context, keys dataloader.Keys) []*
 						return
 					}
 
+					hasNextPage := len(articles) > int(data.PerPage)
+					if hasNextPage {
+						articles = articles[:data.PerPage]
+					}
+
 					articlesOut := &inout.GetArticlesOut{
 						Articles: articles,
 						BaseOut: &inout.BaseOut{
-							Page:      int(math.Round(float64(data.Page)/float64(data.PerPage))) + 1,
+							Page:      inout.CurrentPage(int(data.Page), int(data.PerPage)),
 							PerPage:   int(data.PerPage),
 							PageCount: int(math.Ceil(float64(total) / float64(data.PerPage))),
 							Count:     total,
 						},
+						Connection: &inout.ConnectionOut{
+							SortBy:          data.SortBy,
+							SortOrder:       data.SortOrder,
+							Offset:          int(data.Page),
+							HasNextPage:     hasNextPage,
+							HasPreviousPage: data.Page > 0 || data.Cursor != nil,
+						},
 					}
 					results[i] = &dataloader.Result{Data: articlesOut}
 
@@ -324,6 +367,37 @@ func (l topArticlesLoader) loadBatch(ctx context.Context, keys dataloader.Keys)
 				return
 			}
 
+			params := &models.GetTopNArticlesParams{
+				TopN:        uint64(data.TopN),
+				Locale:      data.Locale,
+				CountryCode: data.CountryCode,
+				WindowDays:  int(data.WindowDays),
+			}
+			if data.SectionID != nil {
+				id64, err := strconv.ParseInt(string(*data.SectionID), 10, 64)
+				if err != nil {
+					results[i] = &dataloader.Result{
+						Error: errs.NewErr(
+							errs.InvalidAttributeErrorCode,
+							errors.Wrapf(err, "dataloader: [topArticlesLoader] parse section id to int failed"),
+						)}
+					return
+				}
+				params.SectionID = int(id64)
+			}
+			if data.CategoryID != nil {
+				id64, err := strconv.ParseInt(string(*data.CategoryID), 10, 64)
+				if err != nil {
+					results[i] = &dataloader.Result{
+						Error: errs.NewErr(
+							errs.InvalidAttributeErrorCode,
+							errors.Wrapf(err, "dataloader: [topArticlesLoader] parse category id to int failed"),
+						)}
+					return
+				}
+				params.CategoryID = int(id64)
+			}
+
 			// Get key-value from cache.
 			value, exist := l.service.ArticlesCacheGet(ctx, key.String(), data.CountryCode, data.Locale)
 			if exist {
@@ -338,7 +412,7 @@ func (l topArticlesLoader) loadBatch(ctx context.Context, keys dataloader.Keys)
 				}
 				results[i] = &dataloader.Result{Data: articlesOut}
 			} else {
-				articlesOut, err := l.service.GetTopNArticles(ctx, uint64(data.TopN), data.Locale, data.CountryCode)
+				articlesOut, err := l.service.GetTopNArticles(ctx, params)
 				if err != nil {
 					results[i] = &dataloader.Result{
 						Error: errs.NewErr(
@@ -430,10 +504,28 @@ func (l articleLoader) loadBatch(ctx context.Context, keys dataloader.Keys) []*d
 			}
 
 			defer l.examiner.CheckArticles(ctx, data.CountryCode, data.Locale)
-			defer l.service.PlusOneArticleClickCounter(ctx, int(articleID64), data.Locale, data.CountryCode)
+			defer l.service.RecordArticleView(ctx, int(articleID64), data.Locale, data.CountryCode)
+			defer l.service.RecordSessionEvent(ctx, &models.SessionEvent{
+				SessionID:   session.IDFromContext(ctx),
+				Kind:        models.ViewSessionEvent,
+				ArticleID:   int(articleID64),
+				CountryCode: data.CountryCode,
+				Locale:      data.Locale,
+			})
+
+			cacheKey := key.String()
+			if data.SearchQuery != nil {
+				defer l.service.RecordSearchClick(ctx, *data.SearchQuery, int(articleID64), data.Locale, data.CountryCode)
+
+				// The cached article is shared with the loads not clicked from the searches.
+				unclicked := data
+				unclicked.SearchQuery = nil
+				b, _ := json.Marshal(unclicked)
+				cacheKey = string(b)
+			}
 
 			// Get key-value from cache.
-			value, exist := l.service.ArticlesCacheGet(ctx, key.String(), data.CountryCode, data.Locale)
+			value, exist := l.service.ArticlesCacheGet(ctx, cacheKey, data.CountryCode, data.Locale)
 			if exist {
 				articlesOut := &models.Article{}
 				if err := json.Unmarshal([]byte(value), &articlesOut); err != nil {
@@ -468,7 +560,7 @@ func (l articleLoader) loadBatch(ctx context.Context, keys dataloader.Keys) []*d
 
 				// Set key-value to cache.
 				if b, err := json.Marshal(articleOut); err == nil {
-					l.service.ArticlesCacheSet(ctx, key.String(), string(b), data.CountryCode, data.Locale)
+					l.service.ArticlesCacheSet(ctx, cacheKey, string(b), data.CountryCode, data.Locale)
 				}
 			}
 		}(i, key)
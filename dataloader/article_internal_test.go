@@ -68,6 +68,12 @@ func TestLoadArticles(t *testing.T) {
 					PageCount: 1,
 					Count:     1,
 				},
+				Connection: &inout.ConnectionOut{
+					SortBy:          "position",
+					SortOrder:       "asc",
+					Offset:          1,
+					HasPreviousPage: true,
+				},
 			},
 		},
 		{
@@ -165,6 +171,12 @@ func TestLoadArticles(t *testing.T) {
 					PageCount: 1,
 					Count:     1,
 				},
+				Connection: &inout.ConnectionOut{
+					SortBy:          "position",
+					SortOrder:       "asc",
+					Offset:          1,
+					HasPreviousPage: true,
+				},
 			},
 		},
 		{
@@ -287,31 +299,6 @@ func TestLoadArticles(t *testing.T) {
 						Locale:          "en-us",
 						SectionID:       33456789,
 					},
-					&models.Article{
-						ID:              33456713,
-						AuthorID:        1234570,
-						CommentsDisable: false,
-						Draft:           false,
-						Promoted:        false,
-						Position:        0,
-						VoteSum:         0,
-						VoteCount:       0,
-						CreatedAt:       models.FixCreatedAt1,
-						UpdatedAt:       models.FixUpdatedAt1,
-						SourceLocale:    "en-us",
-						Outdated:        false,
-						OutdatedLocales: []string{},
-						EditedAt:        models.FixEditedAt1,
-						LabelNames:      []string{"delivered"},
-						CountryCode:     "tw",
-						URL:             "www.honestbee.com",
-						HTMLURL:         "www.honestbee.com",
-						Name:            "testing article 4",
-						Title:           "testing article 4",
-						Body:            "this is testing article 4",
-						Locale:          "en-us",
-						SectionID:       33456789,
-					},
 				},
 				BaseOut: &inout.BaseOut{
 					Page:      1,
@@ -319,6 +306,13 @@ func TestLoadArticles(t *testing.T) {
 					PageCount: 2,
 					Count:     4,
 				},
+				Connection: &inout.ConnectionOut{
+					SortBy:          "position",
+					SortOrder:       "asc",
+					Offset:          1,
+					HasNextPage:     true,
+					HasPreviousPage: true,
+				},
 			},
 		},
 		{
@@ -692,3 +686,23 @@ func TestLoadArticle(t *testing.T) {
 		})
 	}
 }
+
+func TestLoadArticleRecordsClick(t *testing.T) {
+	ms := models.NewMockService()
+	clickCtx := Initialize(ms, exam, nil).Attach(context.Background())
+
+	query := "Refund"
+	for _, params := range []inout.QueryArticleIn{
+		{ArticleID: gographql.ID("33456710"), CountryCode: "tw", Locale: "en-us", SearchQuery: &query},
+		{ArticleID: gographql.ID("33456710"), CountryCode: "tw", Locale: "en-us"},
+	} {
+		if _, err := LoadArticle(clickCtx, params); err != nil {
+			t.Fatalf("expect no error, actual:%v", err)
+		}
+	}
+
+	expect := []*models.SearchQueryStat{{Query: "refund", Clicks: 1}}
+	if diff := deep.Equal(expect, ms.RecordedSearchQueries()); diff != nil {
+		t.Errorf("[recorded clicks] %v", diff)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"strconv"
 	"sync"
 
 	"github.com/graph-gophers/dataloader"
@@ -93,10 +94,12 @@ func (l categoriesLoader) loadBatch(ctx context.Context, keys dataloader.Keys) [
 					&models.GetCategoriesParams{
 						Locale:      data.Locale,
 						CountryCode: data.CountryCode,
-						PerPage:     int(data.PerPage),
+						PerPage:     int(data.PerPage) + 1,
 						Page:        int(data.Page),
 						SortBy:      data.SortBy,
 						SortOrder:   data.SortOrder,
+						After:       data.Cursor,
+						Keyset:      data.Keyset,
 					})
 				if err != nil {
 					results[i] = &dataloader.Result{
@@ -107,14 +110,26 @@ func (l categoriesLoader) loadBatch(ctx context.Context, keys dataloader.Keys) [
 					return
 				}
 
+				hasNextPage := len(categories) > int(data.PerPage)
+				if hasNextPage {
+					categories = categories[:data.PerPage]
+				}
+
 				categoriesOut := &inout.GetCategoriesOut{
 					Categories: categories,
 					BaseOut: &inout.BaseOut{
-						Page:      int(math.Round(float64(data.Page)/float64(data.PerPage))) + 1,
+						Page:      inout.CurrentPage(int(data.Page), int(data.PerPage)),
 						PerPage:   int(data.PerPage),
 						PageCount: int(math.Ceil(float64(total) / float64(data.PerPage))),
 						Count:     total,
 					},
+					Connection: &inout.ConnectionOut{
+						SortBy:          data.SortBy,
+						SortOrder:       data.SortOrder,
+						Offset:          int(data.Page),
+						HasNextPage:     hasNextPage,
+						HasPreviousPage: data.Page > 0 || data.Cursor != nil,
+					},
 				}
 				results[i] = &dataloader.Result{Data: categoriesOut}
 
@@ -165,74 +180,129 @@ func newCategoryLoader(service models.Service, examiner *examiner.Examiner) data
 	return categoryLoader{service: service, examiner: examiner}.loadBatch
 }
 
+// loadBatch loads the categories of the numeric ids by one query per country and locale,
+// the key names are loaded one by one.
 func (l categoryLoader) loadBatch(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 	var (
 		n       = len(keys)
 		results = make([]*dataloader.Result, n)
+		batches = make(map[batchScope][]batchItem)
 		wg      sync.WaitGroup
 	)
 
-	wg.Add(n)
-
 	for i, key := range keys {
-		go func(i int, key dataloader.Key) {
-			defer wg.Done()
-
-			data := inout.QueryCategoryIn{}
-			if err := json.Unmarshal([]byte(key.String()), &data); err != nil {
+		data := inout.QueryCategoryIn{}
+		if err := json.Unmarshal([]byte(key.String()), &data); err != nil {
+			results[i] = &dataloader.Result{
+				Error: errs.NewErr(
+					errs.ServerInternalErrorCode,
+					errors.Wrapf(err, "dataloader: [categoryLoader] json unmarshal failed"),
+				)}
+			continue
+		}
+
+		defer l.examiner.CheckCategories(ctx, data.CountryCode, data.Locale)
+
+		// Get key-value from cache.
+		value, exist := l.service.CategoriesCacheGet(ctx, key.String(), data.CountryCode, data.Locale)
+		if exist {
+			categoryOut := &models.Category{}
+			if err := json.Unmarshal([]byte(value), &categoryOut); err != nil {
 				results[i] = &dataloader.Result{
 					Error: errs.NewErr(
 						errs.ServerInternalErrorCode,
 						errors.Wrapf(err, "dataloader: [categoryLoader] json unmarshal failed"),
 					)}
-				return
+				continue
 			}
+			results[i] = &dataloader.Result{Data: categoryOut}
+			continue
+		}
+
+		categoryID, err := strconv.Atoi(string(data.CategoryIDOrKeyName))
+		if err != nil {
+			wg.Add(1)
+			go func(i int, key dataloader.Key, data inout.QueryCategoryIn) {
+				defer wg.Done()
+				results[i] = l.loadByKeyName(ctx, key, data)
+			}(i, key, data)
+			continue
+		}
+
+		scope := batchScope{countryCode: data.CountryCode, locale: data.Locale}
+		batches[scope] = append(batches[scope], batchItem{index: i, id: categoryID})
+	}
 
-			defer l.examiner.CheckCategories(ctx, data.CountryCode, data.Locale)
-
-			// Get key-value from cache.
-			value, exist := l.service.CategoriesCacheGet(ctx, key.String(), data.CountryCode, data.Locale)
-			if exist {
-				categoryOut := &models.Category{}
-				if err := json.Unmarshal([]byte(value), &categoryOut); err != nil {
-					results[i] = &dataloader.Result{
-						Error: errs.NewErr(
-							errs.ServerInternalErrorCode,
-							errors.Wrapf(err, "dataloader: [categoryLoader] json unmarshal failed"),
-						)}
-					return
-				}
-				results[i] = &dataloader.Result{Data: categoryOut}
-			} else {
-				categoryOut, err := l.service.GetCategoryByCategoryIDOrKeyName(ctx, string(data.CategoryIDOrKeyName), data.Locale, data.CountryCode)
-				if err != nil {
-					switch err {
-					case models.ErrNotFound:
-						results[i] = &dataloader.Result{
-							Error: errs.NewErr(
-								errs.RecordNotFoundErrorCode,
-								errors.Wrapf(err, "dataloader: [categoryLoader] service.GetCategoryByCategoryIDOrKeyName not found"),
-							)}
-					default:
-						results[i] = &dataloader.Result{
-							Error: errs.NewErr(
-								errs.ServerInternalErrorCode,
-								errors.Wrapf(err, "dataloader: [categoryLoader] service.GetCategoryByCategoryIDOrKeyName failed"),
-							)}
-					}
-					return
-				}
-				results[i] = &dataloader.Result{Data: categoryOut}
-
-				// Set key-value to cache.
-				if b, err := json.Marshal(categoryOut); err == nil {
-					l.service.CategoriesCacheSet(ctx, key.String(), string(b), data.CountryCode, data.Locale)
-				}
-			}
-		}(i, key)
+	for scope, items := range batches {
+		wg.Add(1)
+		go func(scope batchScope, items []batchItem) {
+			defer wg.Done()
+			l.loadByIDs(ctx, keys, results, scope, items)
+		}(scope, items)
 	}
 
 	wg.Wait()
 
 	return results
 }
+
+// loadByIDs sets the results of the items by one query.
+func (l categoryLoader) loadByIDs(ctx context.Context, keys dataloader.Keys, results []*dataloader.Result, scope batchScope, items []batchItem) {
+	categories, err := l.service.GetCategoriesByIDs(ctx, batchIDs(items), scope.locale, scope.countryCode)
+	if err != nil {
+		for _, item := range items {
+			results[item.index] = &dataloader.Result{
+				Error: errs.NewErr(
+					errs.ServerInternalErrorCode,
+					errors.Wrapf(err, "dataloader: [categoryLoader] service.GetCategoriesByIDs failed"),
+				)}
+		}
+		return
+	}
+
+	for _, item := range items {
+		categoryOut, ok := categories[item.id]
+		if !ok {
+			results[item.index] = &dataloader.Result{
+				Error: errs.NewErr(
+					errs.RecordNotFoundErrorCode,
+					errors.Wrapf(models.ErrNotFound, "dataloader: [categoryLoader] service.GetCategoriesByIDs not found"),
+				)}
+			continue
+		}
+		results[item.index] = &dataloader.Result{Data: categoryOut}
+
+		// Set key-value to cache.
+		if b, err := json.Marshal(categoryOut); err == nil {
+			l.service.CategoriesCacheSet(ctx, keys[item.index].String(), string(b), scope.countryCode, scope.locale)
+		}
+	}
+}
+
+// loadByKeyName returns the result of the key which is a category key name.
+func (l categoryLoader) loadByKeyName(ctx context.Context, key dataloader.Key, data inout.QueryCategoryIn) *dataloader.Result {
+	categoryOut, err := l.service.GetCategoryByCategoryIDOrKeyName(ctx, string(data.CategoryIDOrKeyName), data.Locale, data.CountryCode)
+	if err != nil {
+		switch err {
+		case models.ErrNotFound:
+			return &dataloader.Result{
+				Error: errs.NewErr(
+					errs.RecordNotFoundErrorCode,
+					errors.Wrapf(err, "dataloader: [categoryLoader] service.GetCategoryByCategoryIDOrKeyName not found"),
+				)}
+		default:
+			return &dataloader.Result{
+				Error: errs.NewErr(
+					errs.ServerInternalErrorCode,
+					errors.Wrapf(err, "dataloader: [categoryLoader] service.GetCategoryByCategoryIDOrKeyName failed"),
+				)}
+		}
+	}
+
+	// Set key-value to cache.
+	if b, err := json.Marshal(categoryOut); err == nil {
+		l.service.CategoriesCacheSet(ctx, key.String(), string(b), data.CountryCode, data.Locale)
+	}
+
+	return &dataloader.Result{Data: categoryOut}
+}
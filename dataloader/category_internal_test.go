@@ -2,9 +2,13 @@ package dataloader
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/go-test/deep"
+	"github.com/graph-gophers/dataloader"
 	gographql "github.com/graph-gophers/graphql-go"
 
 	"github.com/honestbee/Zen/inout"
@@ -55,6 +59,12 @@ func TestLoadCategories(t *testing.T) {
 					PageCount: 1,
 					Count:     1,
 				},
+				Connection: &inout.ConnectionOut{
+					SortBy:          "position",
+					SortOrder:       "asc",
+					Offset:          1,
+					HasPreviousPage: true,
+				},
 			},
 		},
 		{
@@ -155,6 +165,31 @@ func TestLoadCategory(t *testing.T) {
 				KeyName:      "food",
 			},
 		},
+		{
+			description:  "testing key name case",
+			inputContext: ctx,
+			inputParams: inout.QueryCategoryIn{
+				CategoryIDOrKeyName: gographql.ID("food"),
+				CountryCode:         "tw",
+				Locale:              "en-us",
+			},
+			expectErr: false,
+			expect: &models.Category{
+				ID:           3345678,
+				Position:     0,
+				CreatedAt:    models.FixCreatedAt1,
+				UpdatedAt:    models.FixUpdatedAt1,
+				SourceLocale: "en-us",
+				Outdated:     false,
+				CountryCode:  "tw",
+				URL:          "www.honestbee.com",
+				HTMLURL:      "www.honestbee.com",
+				Name:         "testing category 1",
+				Description:  "",
+				Locale:       "en-us",
+				KeyName:      "food",
+			},
+		},
 		{
 			description:  "testing json marshal parameter failed case",
 			inputContext: ctx,
@@ -224,3 +259,96 @@ func TestLoadCategory(t *testing.T) {
 		})
 	}
 }
+
+func TestCategoryLoaderBatch(t *testing.T) {
+	testCases := [...]struct {
+		description   string
+		inputKeys     dataloader.Keys
+		expectQueries int32
+		expectErrs    int
+	}{
+		{
+			description:   "testing one query for the ids of a country and locale case",
+			inputKeys:     categoryKeys(100, "tw", "en-us"),
+			expectQueries: 1,
+		},
+		{
+			description: "testing one query per country and locale case",
+			inputKeys: append(
+				append(categoryKeys(3, "tw", "en-us"), categoryKeys(3, "tw", "zh-tw")...),
+				categoryKeys(3, "sg", "en-us")...,
+			),
+			expectQueries: 3,
+		},
+		{
+			description: "testing key names are loaded one by one case",
+			inputKeys: append(
+				categoryKeys(3, "tw", "en-us"),
+				dataloader.StringKey(`{"CategoryIDOrKeyName":"food","CountryCode":"tw","Locale":"en-us"}`),
+				dataloader.StringKey(`{"CategoryIDOrKeyName":"groceries","CountryCode":"tw","Locale":"en-us"}`),
+			),
+			expectQueries: 3,
+		},
+		{
+			description:   "testing not found ids case",
+			inputKeys:     categoryKeys(3, models.ModelsReturnNotFoundCountryCode, "en-us"),
+			expectQueries: 1,
+			expectErrs:    3,
+		},
+		{
+			description: "testing invalid key case",
+			inputKeys: append(
+				categoryKeys(2, "tw", "en-us"),
+				dataloader.StringKey(`{"CountryCode":123}`),
+			),
+			expectQueries: 1,
+			expectErrs:    1,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			service := &countingService{MockModels: &models.MockModels{}}
+			results := categoryLoader{service: service, examiner: exam}.loadBatch(context.Background(), tt.inputKeys)
+
+			if len(results) != len(tt.inputKeys) {
+				t.Fatalf("[%s] expect %d results, actual:%d", tt.description, len(tt.inputKeys), len(results))
+			}
+			if tt.expectQueries != service.queries {
+				t.Errorf("[%s] expect %d queries, actual:%d", tt.description, tt.expectQueries, service.queries)
+			}
+
+			errCount := 0
+			for i, result := range results {
+				if result.Error != nil {
+					errCount++
+					continue
+				}
+				data := inout.QueryCategoryIn{}
+				json.Unmarshal([]byte(tt.inputKeys[i].String()), &data)
+				if id, err := strconv.Atoi(string(data.CategoryIDOrKeyName)); err == nil && result.Data.(*models.Category).ID != id {
+					t.Errorf("[%s] expect category id %d, actual:%d", tt.description, id, result.Data.(*models.Category).ID)
+				}
+			}
+			if tt.expectErrs != errCount {
+				t.Errorf("[%s] expect %d errors, actual:%d", tt.description, tt.expectErrs, errCount)
+			}
+		})
+	}
+}
+
+func BenchmarkCategoryLoader(b *testing.B) {
+	for _, n := range []int{1, 10, 100} {
+		b.Run(fmt.Sprintf("keys=%d", n), func(b *testing.B) {
+			service := &countingService{MockModels: &models.MockModels{}}
+			loader := categoryLoader{service: service, examiner: exam}
+			keys := categoryKeys(n, "tw", "en-us")
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				loader.loadBatch(context.Background(), keys)
+			}
+			b.ReportMetric(float64(service.queries)/float64(b.N), "queries/op")
+		})
+	}
+}
@@ -28,6 +28,7 @@ const (
 	articleLoaderKey             dataloader.StringKey = "article"
 	ticketFormLoaderKey          dataloader.StringKey = "ticket_form"
 	ticketFieldsLoaderKey        dataloader.StringKey = "ticket_fields"
+	ticketFieldLoaderKey         dataloader.StringKey = "ticket_field"
 	ticketFieldCustomFieldOption dataloader.StringKey = "ticket_field_custom_field_option"
 	ticketFieldSystemFieldOption dataloader.StringKey = "ticket_field_system_field_option"
 	searchTitleArticlesLoaderKey dataloader.StringKey = "search_title_articles"
@@ -55,9 +56,10 @@ func Initialize(
 			articleLoaderKey:             newArticleLoader(service, examiner),
 			ticketFormLoaderKey:          newTicketFormLoader(service, examiner),
 			ticketFieldsLoaderKey:        newTicketFieldsLoader(service),
+			ticketFieldLoaderKey:         newTicketFieldLoader(service),
 			ticketFieldCustomFieldOption: newTicketFieldCustomFieldOptionsLoader(service),
 			ticketFieldSystemFieldOption: newTicketFieldSystemFieldOptionsLoader(service),
-			searchTitleArticlesLoaderKey: newSearchTitleArticlesLoader(zend),
+			searchTitleArticlesLoaderKey: newSearchTitleArticlesLoader(service, zend),
 			searchBodyArticlesLoaderKey:  newSearchBodyArticlesLoader(service, zend),
 		},
 	}
@@ -73,6 +75,27 @@ func (c Collection) Attach(ctx context.Context) context.Context {
 	return ctx
 }
 
+// batchScope is the country and locale shared by the keys which are loaded by one query.
+type batchScope struct {
+	countryCode string
+	locale      string
+}
+
+// batchItem is a key of the batch with its parsed id, index is the position of the key in the batch.
+type batchItem struct {
+	index int
+	id    int
+}
+
+// batchIDs returns the ids of the items.
+func batchIDs(items []batchItem) []int {
+	ids := make([]int, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.id)
+	}
+	return ids
+}
+
 // extract is a helper function to make common get-value, assert-type, return-error-or-value
 // operations easier.
 func extract(ctx context.Context, k dataloader.StringKey) (*dataloader.Loader, error) {
@@ -2,17 +2,24 @@ package dataloader
 
 import (
 	"context"
+	"fmt"
 	"io/ioutil"
+	"sync/atomic"
 
+	"github.com/graph-gophers/dataloader"
 	"github.com/rs/zerolog"
 
 	"github.com/honestbee/Zen/config"
 	"github.com/honestbee/Zen/examiner"
 	"github.com/honestbee/Zen/models"
+	"github.com/honestbee/Zen/purge"
 	"github.com/honestbee/Zen/zendesk"
 )
 
-var ctx context.Context
+var (
+	ctx  context.Context
+	exam *examiner.Examiner
+)
 
 func init() {
 	logger := zerolog.New(ioutil.Discard)
@@ -31,7 +38,7 @@ func init() {
 			TWBaseURL:         "https://honestbeehelp-tw.zendesk.com",
 		},
 	})
-	exam, _ := examiner.NewExaminer(&config.Config{
+	exam, _ = examiner.NewExaminer(&config.Config{
 		Examiner: &config.Examiner{
 			MaxWorkerSize:          1,
 			MaxPoolSize:            2,
@@ -39,7 +46,55 @@ func init() {
 			SectionsRefreshLimit:   1000,
 			ArticlesRefreshLimit:   1000,
 		},
-	}, &logger, ms, zend)
+	}, &logger, ms, zend, purge.NopPurger{})
 
 	ctx = Initialize(ms, exam, zend).Attach(context.Background())
 }
+
+// countingService counts the queries of the category and section loaders on the mock service.
+type countingService struct {
+	*models.MockModels
+	queries int32
+}
+
+func (s *countingService) GetCategoriesByIDs(ctx context.Context, categoryIDs []int, locale, countryCode string) (map[int]*models.Category, error) {
+	atomic.AddInt32(&s.queries, 1)
+	return s.MockModels.GetCategoriesByIDs(ctx, categoryIDs, locale, countryCode)
+}
+
+func (s *countingService) GetCategoryByCategoryIDOrKeyName(ctx context.Context, idOrKeyName, locale, countryCode string) (*models.Category, error) {
+	atomic.AddInt32(&s.queries, 1)
+	return s.MockModels.GetCategoryByCategoryIDOrKeyName(ctx, idOrKeyName, locale, countryCode)
+}
+
+func (s *countingService) GetSectionsByIDs(ctx context.Context, sectionIDs []int, locale, countryCode string) (map[int]*models.Section, error) {
+	atomic.AddInt32(&s.queries, 1)
+	return s.MockModels.GetSectionsByIDs(ctx, sectionIDs, locale, countryCode)
+}
+
+func (s *countingService) GetSectionBySectionID(ctx context.Context, sectionID int, locale, countryCode string) (*models.Section, error) {
+	atomic.AddInt32(&s.queries, 1)
+	return s.MockModels.GetSectionBySectionID(ctx, sectionID, locale, countryCode)
+}
+
+// categoryKeys returns the keys of n category ids in the country and locale.
+func categoryKeys(n int, countryCode, locale string) dataloader.Keys {
+	keys := make(dataloader.Keys, 0, n)
+	for i := 0; i < n; i++ {
+		keys = append(keys, dataloader.StringKey(fmt.Sprintf(
+			`{"CategoryIDOrKeyName":"%d","CountryCode":"%s","Locale":"%s"}`, 3345678+i, countryCode, locale,
+		)))
+	}
+	return keys
+}
+
+// sectionKeys returns the keys of n section ids in the country and locale.
+func sectionKeys(n int, countryCode, locale string) dataloader.Keys {
+	keys := make(dataloader.Keys, 0, n)
+	for i := 0; i < n; i++ {
+		keys = append(keys, dataloader.StringKey(fmt.Sprintf(
+			`{"SectionID":"%d","CountryCode":"%s","Locale":"%s"}`, 3345679+i, countryCode, locale,
+		)))
+	}
+	return keys
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"math"
 	"sync"
 
 	"github.com/graph-gophers/dataloader"
@@ -13,6 +12,7 @@ import (
 	"github.com/honestbee/Zen/errs"
 	"github.com/honestbee/Zen/inout"
 	"github.com/honestbee/Zen/models"
+	"github.com/honestbee/Zen/session"
 	"github.com/honestbee/Zen/zendesk"
 )
 
@@ -83,10 +83,9 @@ func (l searchBodyArticlesLoader) loadBatch(ctx context.Context, keys dataloader
 				return
 			}
 
-			zendeskSearch, err := l.zend.Search(ctx, categoryIDs, data.Query, data.CountryCode, data.Locale,
+			zendeskSearch, err := l.zend.SearchFrom(ctx, categoryIDs, data.Query, data.CountryCode, data.Locale, int(data.Page),
 				&zendesk.Pagination{
 					PerPage:   int(data.PerPage),
-					Page:      int(math.Round(float64(data.Page)/float64(data.PerPage))) + 1,
 					SortOrder: data.SortOrder,
 				})
 			if err != nil {
@@ -97,22 +96,43 @@ func (l searchBodyArticlesLoader) loadBatch(ctx context.Context, keys dataloader
 					)}
 				return
 			}
+			// Paging through the results isn't counted as another search.
+			if data.Page == 0 {
+				l.service.RecordSearchQuery(ctx, data.Query, data.Locale, data.CountryCode, zendeskSearch.Count)
+				l.service.RecordSessionEvent(ctx, &models.SessionEvent{
+					SessionID:   session.IDFromContext(ctx),
+					Kind:        models.SearchSessionEvent,
+					Query:       data.Query,
+					CountryCode: data.CountryCode,
+					Locale:      data.Locale,
+				})
+			}
 
-			articles := make([]*models.SearchArticle, 0)
+			// The categories of the articles are loaded by one query per locale.
+			articleIDs := make(map[string][]int)
 			for _, zendeskArticle := range zendeskSearch.Articles {
-				category, err := l.service.GetCategoryByArticleID(ctx, zendeskArticle.ID, zendeskArticle.Locale)
+				articleIDs[zendeskArticle.Locale] = append(articleIDs[zendeskArticle.Locale], zendeskArticle.ID)
+			}
+			categories := make(map[string]map[int]*models.Category, len(articleIDs))
+			for locale, ids := range articleIDs {
+				categories[locale], err = l.service.GetCategoriesByArticleIDs(ctx, ids, locale)
 				if err != nil {
-					if err == models.ErrNotFound {
-						// if article not found in locale db, ignore it.
-						continue
-					}
 					results[i] = &dataloader.Result{
 						Error: errs.NewErr(
-							errs.RecordNotFoundErrorCode,
-							errors.Wrapf(err, "dataloader: [searchBodyArticlesLoader] service.GetCategoryByArticleID failed"),
+							errs.ServerInternalErrorCode,
+							errors.Wrapf(err, "dataloader: [searchBodyArticlesLoader] service.GetCategoriesByArticleIDs failed"),
 						)}
 					return
 				}
+			}
+
+			articles := make([]*models.SearchArticle, 0)
+			for _, zendeskArticle := range zendeskSearch.Articles {
+				category, ok := categories[zendeskArticle.Locale][zendeskArticle.ID]
+				if !ok {
+					// if article not found in locale db, ignore it.
+					continue
+				}
 
 				articles = append(articles, &models.SearchArticle{
 					Article: &models.Article{
@@ -154,6 +174,13 @@ func (l searchBodyArticlesLoader) loadBatch(ctx context.Context, keys dataloader
 					PageCount: zendeskSearch.PageCount,
 					Count:     zendeskSearch.Count,
 				},
+				// The search results are paged by zendesk, so the edges use the offset cursors.
+				Connection: &inout.ConnectionOut{
+					SortOrder:       data.SortOrder,
+					Offset:          int(data.Page),
+					HasNextPage:     int(data.Page)+zendeskSearch.PerPage < zendeskSearch.Count,
+					HasPreviousPage: data.Page > 0,
+				},
 			}
 			results[i] = &dataloader.Result{Data: articlesOut}
 		}(i, key)
@@ -3,6 +3,7 @@ package dataloader
 import (
 	"context"
 	"net/http"
+	"strconv"
 	"testing"
 
 	"github.com/go-test/deep"
@@ -77,7 +78,7 @@ func TestLoadSearchBodyArticles(t *testing.T) {
 				CountryCode: "sg",
 				Locale:      "en-us",
 				PerPage:     30,
-				Page:        1,
+				Page:        0,
 				SortOrder:   "asc",
 			},
 			expectErr: false,
@@ -116,6 +117,10 @@ func TestLoadSearchBodyArticles(t *testing.T) {
 					PageCount: 2,
 					Count:     12,
 				},
+				Connection: &inout.ConnectionOut{
+					SortOrder:   "asc",
+					HasNextPage: true,
+				},
 			},
 		},
 		{
@@ -182,3 +187,51 @@ func TestLoadSearchBodyArticles(t *testing.T) {
 		})
 	}
 }
+
+func TestLoadSearchBodyArticlesInsidePage(t *testing.T) {
+	defer gock.Off()
+
+	pages := map[int][]int{1: {11, 12, 13}, 2: {21, 22, 23}}
+	for page, ids := range pages {
+		page := page
+		articles := make([]*zendesk.SearchArticle, 0, len(ids))
+		for _, id := range ids {
+			articles = append(articles, &zendesk.SearchArticle{Article: &zendesk.Article{ID: id, Locale: "en-us"}})
+		}
+		gock.New("https://honestbeehelp-sg.zendesk.com").
+			Get("/api/v2/help_center/articles/search.json").
+			Filter(func(req *http.Request) bool {
+				return req.URL.Query().Get("query") == "inside-page" && req.URL.Query().Get("page") == strconv.Itoa(page)
+			}).
+			Reply(http.StatusOK).
+			JSON(&zendesk.Search{
+				Articles: articles,
+				BaseOut:  &zendesk.BaseOut{PerPage: 3, Page: page, PageCount: 2, Count: 6},
+			})
+	}
+
+	// The cursor of the second result points inside the first page.
+	actual, err := LoadSearchBodyArticles(ctx, inout.QuerySearchBodyArticlesIn{
+		Query:       "inside-page",
+		CountryCode: "sg",
+		Locale:      "en-us",
+		PerPage:     3,
+		Page:        2,
+		SortOrder:   "asc",
+	})
+	if err != nil {
+		t.Fatalf("expect no error, actual:%v", err)
+	}
+
+	ids := make([]int, 0, len(actual.Articles))
+	for _, article := range actual.Articles {
+		ids = append(ids, article.ID)
+	}
+	if diff := deep.Equal(ids, []int{13, 21, 22}); diff != nil {
+		t.Errorf("%v", diff)
+	}
+	expect := &inout.ConnectionOut{SortOrder: "asc", Offset: 2, HasNextPage: true, HasPreviousPage: true}
+	if diff := deep.Equal(actual.Connection, expect); diff != nil {
+		t.Errorf("%v", diff)
+	}
+}
@@ -11,6 +11,8 @@ import (
 
 	"github.com/honestbee/Zen/errs"
 	"github.com/honestbee/Zen/inout"
+	"github.com/honestbee/Zen/models"
+	"github.com/honestbee/Zen/session"
 	"github.com/honestbee/Zen/zendesk"
 )
 
@@ -40,11 +42,12 @@ func LoadSearchTitleArticles(ctx context.Context, params interface{}) ([]*zendes
 }
 
 type searchTitleArticlesLoader struct {
-	zend *zendesk.ZenDesk
+	service models.Service
+	zend    *zendesk.ZenDesk
 }
 
-func newSearchTitleArticlesLoader(zend *zendesk.ZenDesk) dataloader.BatchFunc {
-	return searchTitleArticlesLoader{zend: zend}.loadBatch
+func newSearchTitleArticlesLoader(service models.Service, zend *zendesk.ZenDesk) dataloader.BatchFunc {
+	return searchTitleArticlesLoader{service: service, zend: zend}.loadBatch
 }
 
 func (l searchTitleArticlesLoader) loadBatch(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
@@ -79,6 +82,14 @@ func (l searchTitleArticlesLoader) loadBatch(ctx context.Context, keys dataloade
 					)}
 				return
 			}
+			l.service.RecordSearchQuery(ctx, data.Query, data.Locale, data.CountryCode, len(zendeskInstantSearch.Results))
+			l.service.RecordSessionEvent(ctx, &models.SessionEvent{
+				SessionID:   session.IDFromContext(ctx),
+				Kind:        models.SearchSessionEvent,
+				Query:       data.Query,
+				CountryCode: data.CountryCode,
+				Locale:      data.Locale,
+			})
 
 			searchResult := make([]*zendesk.InstantSearchResult, len(zendeskInstantSearch.Results))
 			for i, result := range zendeskInstantSearch.Results {
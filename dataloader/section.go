@@ -105,10 +105,12 @@ func (l sectionsLoader) loadBatch(ctx context.Context, keys dataloader.Keys) []*
 						&models.GetSectionsParams{
 							Locale:      data.Locale,
 							CountryCode: data.CountryCode,
-							PerPage:     int(data.PerPage),
+							PerPage:     int(data.PerPage) + 1,
 							Page:        int(data.Page),
 							SortBy:      data.SortBy,
 							SortOrder:   data.SortOrder,
+							After:       data.Cursor,
+							Keyset:      data.Keyset,
 							CategoryID:  int(id64),
 						})
 					if err != nil {
@@ -120,14 +122,26 @@ func (l sectionsLoader) loadBatch(ctx context.Context, keys dataloader.Keys) []*
 						return
 					}
 
+					hasNextPage := len(sections) > int(data.PerPage)
+					if hasNextPage {
+						sections = sections[:data.PerPage]
+					}
+
 					sectionsOut := &inout.GetSectionsOut{
 						Sections: sections,
 						BaseOut: &inout.BaseOut{
-							Page:      int(math.Round(float64(data.Page)/float64(data.PerPage))) + 1,
+							Page:      inout.CurrentPage(int(data.Page), int(data.PerPage)),
 							PerPage:   int(data.PerPage),
 							PageCount: int(math.Ceil(float64(total) / float64(data.PerPage))),
 							Count:     total,
 						},
+						Connection: &inout.ConnectionOut{
+							SortBy:          data.SortBy,
+							SortOrder:       data.SortOrder,
+							Offset:          int(data.Page),
+							HasNextPage:     hasNextPage,
+							HasPreviousPage: data.Page > 0 || data.Cursor != nil,
+						},
 					}
 					results[i] = &dataloader.Result{Data: sectionsOut}
 
@@ -157,10 +171,12 @@ func (l sectionsLoader) loadBatch(ctx context.Context, keys dataloader.Keys) []*
 						&models.GetSectionsParams{
 							Locale:      data.Locale,
 							CountryCode: data.CountryCode,
-							PerPage:     int(data.PerPage),
+							PerPage:     int(data.PerPage) + 1,
 							Page:        int(data.Page),
 							SortBy:      data.SortBy,
 							SortOrder:   data.SortOrder,
+							After:       data.Cursor,
+							Keyset:      data.Keyset,
 						})
 					if err != nil {
 						results[i] = &dataloader.Result{
@@ -171,14 +187,26 @@ func (l sectionsLoader) loadBatch(ctx context.Context, keys dataloader.Keys) []*
 						return
 					}
 
+					hasNextPage := len(sections) > int(data.PerPage)
+					if hasNextPage {
+						sections = sections[:data.PerPage]
+					}
+
 					sectionsOut := &inout.GetSectionsOut{
 						Sections: sections,
 						BaseOut: &inout.BaseOut{
-							Page:      int(math.Round(float64(data.Page)/float64(data.PerPage))) + 1,
+							Page:      inout.CurrentPage(int(data.Page), int(data.PerPage)),
 							PerPage:   int(data.PerPage),
 							PageCount: int(math.Ceil(float64(total) / float64(data.PerPage))),
 							Count:     total,
 						},
+						Connection: &inout.ConnectionOut{
+							SortBy:          data.SortBy,
+							SortOrder:       data.SortOrder,
+							Offset:          int(data.Page),
+							HasNextPage:     hasNextPage,
+							HasPreviousPage: data.Page > 0 || data.Cursor != nil,
+						},
 					}
 					results[i] = &dataloader.Result{Data: sectionsOut}
 
@@ -230,84 +258,100 @@ func newSectionLoader(service models.Service, examiner *examiner.Examiner) datal
 	return sectionLoader{service: service, examiner: examiner}.loadBatch
 }
 
+// loadBatch loads the sections by one query per country and locale.
 func (l sectionLoader) loadBatch(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 	var (
 		n       = len(keys)
 		results = make([]*dataloader.Result, n)
+		batches = make(map[batchScope][]batchItem)
 		wg      sync.WaitGroup
 	)
 
-	wg.Add(n)
-
 	for i, key := range keys {
-		go func(i int, key dataloader.Key) {
-			defer wg.Done()
-
-			data := inout.QuerySectionIn{}
-			if err := json.Unmarshal([]byte(key.String()), &data); err != nil {
+		data := inout.QuerySectionIn{}
+		if err := json.Unmarshal([]byte(key.String()), &data); err != nil {
+			results[i] = &dataloader.Result{
+				Error: errs.NewErr(
+					errs.ServerInternalErrorCode,
+					errors.Wrapf(err, "dataloader: [sectionLoader] json unmarshal failed"),
+				)}
+			continue
+		}
+
+		sectionID64, err := strconv.ParseInt(string(data.SectionID), 10, 64)
+		if err != nil {
+			results[i] = &dataloader.Result{
+				Error: errs.NewErr(
+					errs.RecordNotFoundErrorCode,
+					errors.Wrapf(err, "dataloader: [sectionLoader] parse section id to int failed"),
+				)}
+			continue
+		}
+
+		defer l.examiner.CheckSections(ctx, data.CountryCode, data.Locale)
+
+		// Get key-value from cache.
+		value, exist := l.service.SectionsCacheGet(ctx, key.String(), data.CountryCode, data.Locale)
+		if exist {
+			sectionOut := &models.Section{}
+			if err := json.Unmarshal([]byte(value), &sectionOut); err != nil {
 				results[i] = &dataloader.Result{
 					Error: errs.NewErr(
 						errs.ServerInternalErrorCode,
 						errors.Wrapf(err, "dataloader: [sectionLoader] json unmarshal failed"),
 					)}
-				return
-			}
-
-			sectionID64, err := strconv.ParseInt(string(data.SectionID), 10, 64)
-			if err != nil {
-				results[i] = &dataloader.Result{
-					Error: errs.NewErr(
-						errs.RecordNotFoundErrorCode,
-						errors.Wrapf(err, "dataloader: [sectionLoader] parse section id to int failed"),
-					)}
-				return
+				continue
 			}
+			results[i] = &dataloader.Result{Data: sectionOut}
+			continue
+		}
 
-			defer l.examiner.CheckSections(ctx, data.CountryCode, data.Locale)
-
-			// Get key-value from cache.
-			value, exist := l.service.SectionsCacheGet(ctx, key.String(), data.CountryCode, data.Locale)
-			if exist {
-				sectionOut := &models.Section{}
-				if err := json.Unmarshal([]byte(value), &sectionOut); err != nil {
-					results[i] = &dataloader.Result{
-						Error: errs.NewErr(
-							errs.ServerInternalErrorCode,
-							errors.Wrapf(err, "dataloader: [sectionLoader] json unmarshal failed"),
-						)}
-					return
-				}
-				results[i] = &dataloader.Result{Data: sectionOut}
-			} else {
-				sectionOut, err := l.service.GetSectionBySectionID(ctx, int(sectionID64), data.Locale, data.CountryCode)
-				if err != nil {
-					switch err {
-					case models.ErrNotFound:
-						results[i] = &dataloader.Result{
-							Error: errs.NewErr(
-								errs.RecordNotFoundErrorCode,
-								errors.Wrapf(err, "dataloader: [sectionLoader] service.GetSectionBySectionID not found"),
-							)}
-					default:
-						results[i] = &dataloader.Result{
-							Error: errs.NewErr(
-								errs.ServerInternalErrorCode,
-								errors.Wrapf(err, "dataloader: [sectionLoader] service.GetSectionBySectionID failed"),
-							)}
-					}
-					return
-				}
-				results[i] = &dataloader.Result{Data: sectionOut}
+		scope := batchScope{countryCode: data.CountryCode, locale: data.Locale}
+		batches[scope] = append(batches[scope], batchItem{index: i, id: int(sectionID64)})
+	}
 
-				// Set key-value to cache.
-				if b, err := json.Marshal(sectionOut); err == nil {
-					l.service.SectionsCacheSet(ctx, key.String(), string(b), data.CountryCode, data.Locale)
-				}
-			}
-		}(i, key)
+	for scope, items := range batches {
+		wg.Add(1)
+		go func(scope batchScope, items []batchItem) {
+			defer wg.Done()
+			l.loadByIDs(ctx, keys, results, scope, items)
+		}(scope, items)
 	}
 
 	wg.Wait()
 
 	return results
 }
+
+// loadByIDs sets the results of the items by one query.
+func (l sectionLoader) loadByIDs(ctx context.Context, keys dataloader.Keys, results []*dataloader.Result, scope batchScope, items []batchItem) {
+	sections, err := l.service.GetSectionsByIDs(ctx, batchIDs(items), scope.locale, scope.countryCode)
+	if err != nil {
+		for _, item := range items {
+			results[item.index] = &dataloader.Result{
+				Error: errs.NewErr(
+					errs.ServerInternalErrorCode,
+					errors.Wrapf(err, "dataloader: [sectionLoader] service.GetSectionsByIDs failed"),
+				)}
+		}
+		return
+	}
+
+	for _, item := range items {
+		sectionOut, ok := sections[item.id]
+		if !ok {
+			results[item.index] = &dataloader.Result{
+				Error: errs.NewErr(
+					errs.RecordNotFoundErrorCode,
+					errors.Wrapf(models.ErrNotFound, "dataloader: [sectionLoader] service.GetSectionsByIDs not found"),
+				)}
+			continue
+		}
+		results[item.index] = &dataloader.Result{Data: sectionOut}
+
+		// Set key-value to cache.
+		if b, err := json.Marshal(sectionOut); err == nil {
+			l.service.SectionsCacheSet(ctx, keys[item.index].String(), string(b), scope.countryCode, scope.locale)
+		}
+	}
+}
@@ -2,9 +2,13 @@ package dataloader
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/go-test/deep"
+	"github.com/graph-gophers/dataloader"
 	gographql "github.com/graph-gophers/graphql-go"
 
 	"github.com/honestbee/Zen/inout"
@@ -57,6 +61,12 @@ func TestLoadSections(t *testing.T) {
 					PageCount: 1,
 					Count:     1,
 				},
+				Connection: &inout.ConnectionOut{
+					SortBy:          "position",
+					SortOrder:       "asc",
+					Offset:          1,
+					HasPreviousPage: true,
+				},
 			},
 		},
 		{
@@ -144,6 +154,12 @@ func TestLoadSections(t *testing.T) {
 					PageCount: 1,
 					Count:     1,
 				},
+				Connection: &inout.ConnectionOut{
+					SortBy:          "position",
+					SortOrder:       "asc",
+					Offset:          1,
+					HasPreviousPage: true,
+				},
 			},
 		},
 		{
@@ -306,3 +322,90 @@ func TestLoadSection(t *testing.T) {
 		})
 	}
 }
+
+func TestSectionLoaderBatch(t *testing.T) {
+	testCases := [...]struct {
+		description   string
+		inputKeys     dataloader.Keys
+		expectQueries int32
+		expectErrs    int
+	}{
+		{
+			description:   "testing one query for the ids of a country and locale case",
+			inputKeys:     sectionKeys(100, "tw", "en-us"),
+			expectQueries: 1,
+		},
+		{
+			description:   "testing one query per country and locale case",
+			inputKeys:     append(sectionKeys(3, "tw", "en-us"), sectionKeys(3, "sg", "en-us")...),
+			expectQueries: 2,
+		},
+		{
+			description:   "testing not found ids case",
+			inputKeys:     sectionKeys(3, models.ModelsReturnNotFoundCountryCode, "en-us"),
+			expectQueries: 1,
+			expectErrs:    3,
+		},
+		{
+			description:   "testing query failed case",
+			inputKeys:     sectionKeys(3, models.ModelsReturnErrorCountryCode, "en-us"),
+			expectQueries: 1,
+			expectErrs:    3,
+		},
+		{
+			description: "testing invalid section id case",
+			inputKeys: append(
+				sectionKeys(2, "tw", "en-us"),
+				dataloader.StringKey(`{"SectionID":"abc","CountryCode":"tw","Locale":"en-us"}`),
+			),
+			expectQueries: 1,
+			expectErrs:    1,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			service := &countingService{MockModels: &models.MockModels{}}
+			results := sectionLoader{service: service, examiner: exam}.loadBatch(context.Background(), tt.inputKeys)
+
+			if len(results) != len(tt.inputKeys) {
+				t.Fatalf("[%s] expect %d results, actual:%d", tt.description, len(tt.inputKeys), len(results))
+			}
+			if tt.expectQueries != service.queries {
+				t.Errorf("[%s] expect %d queries, actual:%d", tt.description, tt.expectQueries, service.queries)
+			}
+
+			errCount := 0
+			for i, result := range results {
+				if result.Error != nil {
+					errCount++
+					continue
+				}
+				data := inout.QuerySectionIn{}
+				json.Unmarshal([]byte(tt.inputKeys[i].String()), &data)
+				if id, _ := strconv.Atoi(string(data.SectionID)); result.Data.(*models.Section).ID != id {
+					t.Errorf("[%s] expect section id %d, actual:%d", tt.description, id, result.Data.(*models.Section).ID)
+				}
+			}
+			if tt.expectErrs != errCount {
+				t.Errorf("[%s] expect %d errors, actual:%d", tt.description, tt.expectErrs, errCount)
+			}
+		})
+	}
+}
+
+func BenchmarkSectionLoader(b *testing.B) {
+	for _, n := range []int{1, 10, 100} {
+		b.Run(fmt.Sprintf("keys=%d", n), func(b *testing.B) {
+			service := &countingService{MockModels: &models.MockModels{}}
+			loader := sectionLoader{service: service, examiner: exam}
+			keys := sectionKeys(n, "tw", "en-us")
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				loader.loadBatch(context.Background(), keys)
+			}
+			b.ReportMetric(float64(service.queries)/float64(b.N), "queries/op")
+		})
+	}
+}
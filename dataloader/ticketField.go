@@ -130,6 +130,119 @@ func (l ticketFieldsLoader) loadBatch(ctx context.Context, keys dataloader.Keys)
 	return results
 }
 
+// LoadTicketField implements data loader.
+func LoadTicketField(ctx context.Context, params interface{}) (*models.TicketField, error) {
+	b, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	ldr, err := extract(ctx, ticketFieldLoaderKey)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := ldr.Load(ctx, dataloader.StringKey(b))()
+	if err != nil {
+		return nil, err
+	}
+
+	ticketField, ok := data.(*models.TicketField)
+	if !ok {
+		return nil, fmt.Errorf("wrong type: the expected type is %T but got %T", ticketField, data)
+	}
+
+	return ticketField, nil
+}
+
+type ticketFieldLoader struct {
+	service models.Service
+}
+
+func newTicketFieldLoader(service models.Service) dataloader.BatchFunc {
+	return ticketFieldLoader{service: service}.loadBatch
+}
+
+func (l ticketFieldLoader) loadBatch(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+	var (
+		n       = len(keys)
+		results = make([]*dataloader.Result, n)
+		wg      sync.WaitGroup
+	)
+
+	wg.Add(n)
+
+	for i, key := range keys {
+		go func(i int, key dataloader.Key) {
+			defer wg.Done()
+
+			data := inout.QueryTicketFieldIn{}
+			if err := json.Unmarshal([]byte(key.String()), &data); err != nil {
+				results[i] = &dataloader.Result{
+					Error: errs.NewErr(
+						errs.ServerInternalErrorCode,
+						errors.Wrapf(err, "dataloader: [ticketFieldLoader] json unmarshal failed"),
+					)}
+				return
+			}
+
+			fieldID64, err := strconv.ParseInt(string(data.FieldID), 10, 64)
+			if err != nil {
+				results[i] = &dataloader.Result{
+					Error: errs.NewErr(
+						errs.RecordNotFoundErrorCode,
+						errors.Wrapf(err, "dataloader: [ticketFieldLoader] parse field id to int failed"),
+					)}
+				return
+			}
+
+			// Get key-value from cache.
+			value, exist := l.service.TicketFieldCacheGet(ctx, key.String())
+			if exist {
+				ticketFieldOut := &models.TicketField{}
+				if err := json.Unmarshal([]byte(value), ticketFieldOut); err != nil {
+					results[i] = &dataloader.Result{
+						Error: errs.NewErr(
+							errs.ServerInternalErrorCode,
+							errors.Wrapf(err, "dataloader: [ticketFieldLoader] json unmarshal failed"),
+						)}
+					return
+				}
+				results[i] = &dataloader.Result{Data: ticketFieldOut}
+			} else {
+				ticketFieldOut, err := l.service.GetTicketFieldByFieldID(ctx, int(fieldID64), data.Locale)
+				if err != nil {
+					switch err {
+					case models.ErrNotFound:
+						results[i] = &dataloader.Result{
+							Error: errs.NewErr(
+								errs.RecordNotFoundErrorCode,
+								errors.Wrapf(err, "dataloader: [ticketFieldLoader] service.GetTicketFieldByFieldID not found"),
+							)}
+					default:
+						results[i] = &dataloader.Result{
+							Error: errs.NewErr(
+								errs.ServerInternalErrorCode,
+								errors.Wrapf(err, "dataloader: [ticketFieldLoader] service.GetTicketFieldByFieldID failed"),
+							)}
+					}
+					return
+				}
+				results[i] = &dataloader.Result{Data: ticketFieldOut}
+
+				// Set key-value to cache.
+				if b, err := json.Marshal(ticketFieldOut); err == nil {
+					l.service.TicketFieldCacheSet(ctx, key.String(), string(b))
+				}
+			}
+		}(i, key)
+	}
+
+	wg.Wait()
+
+	return results
+}
+
 // LoadTicketFieldCustomFieldOptions implements data loader.
 func LoadTicketFieldCustomFieldOptions(ctx context.Context, params interface{}) ([]*models.CustomFieldOption, error) {
 	b, err := json.Marshal(params)
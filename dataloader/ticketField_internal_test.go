@@ -171,6 +171,102 @@ func TestLoadTicketField(t *testing.T) {
 	}
 }
 
+func TestLoadTicketFieldByFieldID(t *testing.T) {
+	testCases := [...]struct {
+		description  string
+		inputContext context.Context
+		inputParams  interface{}
+		expectErr    bool
+		expect       *models.TicketField
+	}{
+		{
+			description:  "testing normal case",
+			inputContext: ctx,
+			inputParams: inout.QueryTicketFieldIn{
+				FieldID: gographql.ID("81469808"),
+				Locale:  "en-us",
+			},
+			expectErr: false,
+			expect: &models.TicketField{
+				ID:                  81469808,
+				Type:                "text",
+				Title:               "Order Number",
+				RawTitle:            "Order Number",
+				Description:         "",
+				RawDescription:      "",
+				Position:            13,
+				RegexpForValidation: "",
+				TitleInPortal:       "Order Number",
+				RawTitleInPortal:    "訂單號碼",
+				CreatedAt:           models.FixCreatedAt1,
+				UpdatedAt:           models.FixUpdatedAt1,
+				CustomFieldOptions:  make([]*models.CustomFieldOption, 0),
+			},
+		},
+		{
+			description:  "testing json marshal parameter failed case",
+			inputContext: ctx,
+			inputParams:  make(chan int),
+			expectErr:    true,
+			expect:       nil,
+		},
+		{
+			description:  "testing extract dataloader failed case",
+			inputContext: context.TODO(),
+			inputParams: inout.QueryTicketFieldIn{
+				FieldID: gographql.ID("81469808"),
+				Locale:  "en-us",
+			},
+			expectErr: true,
+			expect:    nil,
+		},
+		{
+			description:  "testing invalid field id case",
+			inputContext: ctx,
+			inputParams: inout.QueryTicketFieldIn{
+				FieldID: gographql.ID(""),
+				Locale:  "en-us",
+			},
+			expectErr: true,
+			expect:    nil,
+		},
+		{
+			description:  "testing locale error case",
+			inputContext: ctx,
+			inputParams: inout.QueryTicketFieldIn{
+				FieldID: gographql.ID("81469808"),
+				Locale:  models.ModelsReturnErrorLocale,
+			},
+			expectErr: true,
+			expect:    nil,
+		},
+		{
+			description:  "testing locale not found case",
+			inputContext: ctx,
+			inputParams: inout.QueryTicketFieldIn{
+				FieldID: gographql.ID("81469808"),
+				Locale:  models.ModelsReturnNotFoundLocale,
+			},
+			expectErr: true,
+			expect:    nil,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			actual, err := LoadTicketField(tt.inputContext, tt.inputParams)
+
+			if tt.expectErr && err == nil {
+				t.Errorf("[%s] expect an error, actual == nil", tt.description)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("[%s] expect no error, actual:%v", tt.description, err)
+			} else if diff := deep.Equal(tt.expect, actual); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+}
+
 func TestLoadTicketFieldCustomFieldOptions(t *testing.T) {
 	testCases := [...]struct {
 		description  string
@@ -0,0 +1,23 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- +goose StatementBegin
+CREATE TABLE rejected_requests (
+        sn serial primary key,
+        source varchar(16) not null,
+        reason varchar(32) not null,
+        remote_ip varchar(64) not null,
+        country_code varchar(8) not null,
+        email varchar(256) not null,
+        subject text not null,
+        body text not null,
+        created_at timestamp default localtimestamp
+);
+ALTER SEQUENCE rejected_requests_sn_seq RESTART WITH 1 INCREMENT BY 1;
+CREATE INDEX rejected_requests_created_at_index ON rejected_requests(created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+-- +goose StatementBegin
+DROP TABLE rejected_requests;
+-- +goose StatementEnd
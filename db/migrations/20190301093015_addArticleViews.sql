@@ -0,0 +1,19 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- +goose StatementBegin
+CREATE TABLE article_views (
+        article_id bigint not null,
+        country_code varchar(8) not null,
+        locale varchar(8) not null,
+        day date not null,
+        views bigint not null default 0,
+        primary key (article_id, country_code, locale, day)
+);
+CREATE INDEX article_views_country_code_locale_day_index ON article_views(country_code, locale, day);
+-- +goose StatementEnd
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+-- +goose StatementBegin
+DROP TABLE article_views;
+-- +goose StatementEnd
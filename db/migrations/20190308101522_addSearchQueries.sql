@@ -0,0 +1,32 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- +goose StatementBegin
+CREATE TABLE search_queries (
+        query varchar(200) not null,
+        country_code varchar(8) not null,
+        locale varchar(8) not null,
+        day date not null,
+        searches bigint not null default 0,
+        zero_results bigint not null default 0,
+        primary key (query, country_code, locale, day)
+);
+CREATE INDEX search_queries_country_code_locale_day_index ON search_queries(country_code, locale, day);
+
+CREATE TABLE search_clicks (
+        query varchar(200) not null,
+        article_id bigint not null,
+        country_code varchar(8) not null,
+        locale varchar(8) not null,
+        day date not null,
+        clicks bigint not null default 0,
+        primary key (query, article_id, country_code, locale, day)
+);
+CREATE INDEX search_clicks_country_code_locale_day_index ON search_clicks(country_code, locale, day);
+-- +goose StatementEnd
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+-- +goose StatementBegin
+DROP TABLE search_clicks;
+DROP TABLE search_queries;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- +goose StatementBegin
+CREATE TABLE session_events (
+        sn serial primary key,
+        session_id varchar(64) not null,
+        kind varchar(16) not null,
+        article_id bigint not null default 0,
+        query varchar(200) not null default '',
+        country_code varchar(8) not null,
+        locale varchar(8) not null default '',
+        created_at timestamp with time zone not null
+);
+CREATE INDEX session_events_country_code_created_at_index ON session_events(country_code, created_at);
+CREATE INDEX session_events_session_id_index ON session_events(session_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+-- +goose StatementBegin
+DROP TABLE session_events;
+-- +goose StatementEnd
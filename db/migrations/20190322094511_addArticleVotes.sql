@@ -0,0 +1,32 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- +goose StatementBegin
+CREATE TABLE article_votes (
+        article_id bigint not null,
+        country_code varchar(8) not null,
+        voter varchar(64) not null,
+        value varchar(8) not null,
+        zendesk_vote_id bigint not null default 0,
+        created_at timestamptz not null,
+        updated_at timestamptz not null,
+        primary key (article_id, country_code, voter)
+);
+
+CREATE TABLE article_vote_events (
+        sn serial primary key,
+        article_id bigint not null,
+        country_code varchar(8) not null,
+        voter varchar(64) not null,
+        value varchar(8) not null,
+        previous_value varchar(8) not null default '',
+        created_at timestamptz not null
+);
+CREATE INDEX article_vote_events_article_id_country_code_created_at_index ON article_vote_events(article_id, country_code, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+-- +goose StatementBegin
+DROP TABLE article_vote_events;
+DROP TABLE article_votes;
+-- +goose StatementEnd
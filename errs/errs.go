@@ -19,6 +19,10 @@ const (
 	SuccessCreatedCode
 	// UnauthorizedErrCode means 401 unauthorized = 1005
 	UnauthorizedErrCode
+	// TooManyRequestsErrCode means 429 too many requests = 1006
+	TooManyRequestsErrCode
+	// ForbiddenErrCode means 403 forbidden = 1007
+	ForbiddenErrCode
 )
 
 const (
@@ -30,6 +34,25 @@ const (
 	RecordNotFoundErrorMsg = "Record Not Found"
 	// UnauthorizedErrMsg is the UnauthorizedErrCode message
 	UnauthorizedErrMsg = "Unauthorized"
+	// TooManyRequestsErrMsg is the TooManyRequestsErrCode message
+	TooManyRequestsErrMsg = "Too Many Requests"
+	// ForbiddenErrMsg is the ForbiddenErrCode message
+	ForbiddenErrMsg = "Forbidden"
+)
+
+const (
+	// ServerInternalErrorReason is the ServerInternalErrorCode machine code
+	ServerInternalErrorReason = "internal_error"
+	// InvalidAttributeErrorReason is the InvalidAttributeErrorCode machine code
+	InvalidAttributeErrorReason = "invalid_attribute"
+	// RecordNotFoundErrorReason is the RecordNotFoundErrorCode machine code
+	RecordNotFoundErrorReason = "not_found"
+	// UnauthorizedErrReason is the UnauthorizedErrCode machine code
+	UnauthorizedErrReason = "unauthorized"
+	// TooManyRequestsErrReason is the TooManyRequestsErrCode machine code
+	TooManyRequestsErrReason = "too_many_requests"
+	// ForbiddenErrReason is the ForbiddenErrCode machine code
+	ForbiddenErrReason = "forbidden"
 )
 
 // Error represents an error with an associated ExternalAPI status code.
@@ -38,6 +61,14 @@ type Error struct {
 	Status      int        `json:"-"`
 	GRPCStatus  codes.Code `json:"-"`
 	OutputErr   string     `json:"error"`
+	// Reason is the machine code of the error, it is empty for the success codes.
+	Reason string `json:"-"`
+	// Parameter is the name of the invalid path or query parameter.
+	Parameter string `json:"-"`
+	// Pointer is the JSON pointer of the invalid request body field.
+	Pointer string `json:"-"`
+	// Detail explains the invalid field to the client, it never contains the internal errors.
+	Detail string `json:"-"`
 }
 
 // NewErr returns a Error instance.
@@ -66,17 +97,31 @@ func (e *Error) setup(code int) {
 		e.Status = http.StatusBadRequest
 		e.GRPCStatus = codes.InvalidArgument
 		e.OutputErr = InvalidAttributeErrorMsg
+		e.Reason = InvalidAttributeErrorReason
 	case RecordNotFoundErrorCode:
 		e.Status = http.StatusNotFound
 		e.GRPCStatus = codes.NotFound
 		e.OutputErr = RecordNotFoundErrorMsg
+		e.Reason = RecordNotFoundErrorReason
 	case UnauthorizedErrCode:
 		e.Status = http.StatusUnauthorized
 		e.GRPCStatus = codes.Unauthenticated
 		e.OutputErr = UnauthorizedErrMsg
+		e.Reason = UnauthorizedErrReason
+	case TooManyRequestsErrCode:
+		e.Status = http.StatusTooManyRequests
+		e.GRPCStatus = codes.ResourceExhausted
+		e.OutputErr = TooManyRequestsErrMsg
+		e.Reason = TooManyRequestsErrReason
+	case ForbiddenErrCode:
+		e.Status = http.StatusForbidden
+		e.GRPCStatus = codes.PermissionDenied
+		e.OutputErr = ForbiddenErrMsg
+		e.Reason = ForbiddenErrReason
 	default:
 		e.Status = http.StatusInternalServerError
 		e.GRPCStatus = codes.Internal
 		e.OutputErr = ServerInternalErrorMsg
+		e.Reason = ServerInternalErrorReason
 	}
 }
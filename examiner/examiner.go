@@ -4,12 +4,17 @@ import (
 	"context"
 	"encoding/json"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 
 	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/metrics"
 	"github.com/honestbee/Zen/models"
+	"github.com/honestbee/Zen/purge"
+	"github.com/honestbee/Zen/tracing"
 	"github.com/honestbee/Zen/zendesk"
 )
 
@@ -18,6 +23,8 @@ const (
 	sectionsItem    = "sections"
 	articlesItem    = "articles"
 	ticketFormsItem = "ticket_forms"
+	// articleItem is the sync of an article, it is only used by the metrics.
+	articleItem = "article"
 )
 
 var (
@@ -28,8 +35,10 @@ var (
 	ErrAcquireCounterLockFailed = errors.New("acquire counter lock failed")
 )
 
+// task is a sync task of the item, link is the span context of the span which enqueued it.
 type task struct {
 	ctx         context.Context
+	link        tracing.SpanContext
 	item        string
 	locale      string
 	countryCode string
@@ -37,6 +46,7 @@ type task struct {
 
 type articleTask struct {
 	ctx         context.Context
+	link        tracing.SpanContext
 	locale      string
 	countryCode string
 	articleID   int
@@ -46,37 +56,41 @@ type articleTask struct {
 // if the counter number of each cache subject reach the limit,
 // it will refresh the database data by reaching zendesk api.
 type Examiner struct {
-	tasks                   chan interface{}
-	wg                      *sync.WaitGroup
-	logger                  *zerolog.Logger
-	categoriesRefreshLimit  int
-	sectionsRefreshLimit    int
-	articlesRefreshLimit    int
-	ticketFormsRefreshLimit int
-	service                 models.Service
-	zendesk                 *zendesk.ZenDesk
-}
-
-// NewExaminer returns a Examiner instance and runs workers to work.
+	tasks      chan interface{}
+	wg         *sync.WaitGroup
+	workers    int32
+	maxWorkers int
+	logger     *zerolog.Logger
+	service    models.Service
+	zendesk    *zendesk.ZenDesk
+	purger     purge.Purger
+
+	// refreshLimits keeps the *config.Examiner of the refresh limits, it is replaced by Reload.
+	refreshLimits atomic.Value
+}
+
+// NewExaminer returns a Examiner instance and runs workers to work,
+// the CDN cached responses of the synced contents are purged by purger.
 func NewExaminer(conf *config.Config,
 	logger *zerolog.Logger,
 	service models.Service,
-	zendesk *zendesk.ZenDesk) (*Examiner, error) {
+	zendesk *zendesk.ZenDesk,
+	purger purge.Purger) (*Examiner, error) {
 
 	e := &Examiner{
-		tasks:                   make(chan interface{}, conf.Examiner.MaxPoolSize),
-		wg:                      new(sync.WaitGroup),
-		logger:                  logger,
-		service:                 service,
-		zendesk:                 zendesk,
-		categoriesRefreshLimit:  conf.Examiner.CategoriesRefreshLimit,
-		sectionsRefreshLimit:    conf.Examiner.SectionsRefreshLimit,
-		articlesRefreshLimit:    conf.Examiner.ArticlesRefreshLimit,
-		ticketFormsRefreshLimit: conf.Examiner.TicketFormsRefreshLimit,
+		tasks:      make(chan interface{}, conf.Examiner.MaxPoolSize),
+		wg:         new(sync.WaitGroup),
+		maxWorkers: conf.Examiner.MaxWorkerSize,
+		logger:     logger,
+		service:    service,
+		zendesk:    zendesk,
+		purger:     purger,
 	}
+	e.Reload(conf)
 
 	for i := 0; i < conf.Examiner.MaxWorkerSize; i++ {
 		e.wg.Add(1)
+		atomic.AddInt32(&e.workers, 1)
 		go e.worker(i)
 	}
 
@@ -85,12 +99,17 @@ func NewExaminer(conf *config.Config,
 
 func (e *Examiner) worker(workerID int) {
 	defer e.wg.Done()
+	defer atomic.AddInt32(&e.workers, -1)
 	defer e.logger.Info().Msgf("examiner: [%d]worker return", workerID)
 
 	for eachTask := range e.tasks {
+		metrics.SetExaminerQueueDepth(len(e.tasks))
 		switch vTask := eachTask.(type) {
 		case *task:
-			if err := e.work(vTask); err != nil {
+			span, ctx := startTaskSpan(vTask.ctx, vTask.item, vTask.link)
+			err := e.work(ctx, vTask)
+			finishTaskSpan(span, err)
+			if err != nil {
 				if err != ErrAcquireCounterLockFailed {
 					e.logger.Error().Err(err).Fields(map[string]interface{}{
 						"item":        vTask.item,
@@ -100,7 +119,10 @@ func (e *Examiner) worker(workerID int) {
 				}
 			}
 		case *articleTask:
-			if err := e.articleWork(vTask); err != nil {
+			span, ctx := startTaskSpan(vTask.ctx, articleItem, vTask.link)
+			err := e.articleWork(ctx, vTask)
+			finishTaskSpan(span, err)
+			if err != nil {
 				if err != ErrAcquireCounterLockFailed {
 					e.logger.Error().Err(err).Fields(map[string]interface{}{
 						"countryCode": vTask.countryCode,
@@ -113,16 +135,38 @@ func (e *Examiner) worker(workerID int) {
 	}
 }
 
-func (e *Examiner) work(task *task) (err error) {
+// startTaskSpan starts the span of a task, the task starts a new trace linked to the span which enqueued it
+// since the task outlives the request.
+func startTaskSpan(ctx context.Context, item string, link tracing.SpanContext) (tracing.Span, context.Context) {
+	return tracing.StartSpan(ctx, "examiner.task",
+		tracing.WithNewRoot(),
+		tracing.WithLink(link),
+		tracing.WithKind(tracing.KindConsumer),
+		tracing.WithService("examiner"),
+		tracing.WithResource(item),
+	)
+}
+
+// finishTaskSpan finishes the span of a task, the task skipped for the lock of another sync is not a failure.
+func finishTaskSpan(span tracing.Span, err error) {
+	if err == ErrAcquireCounterLockFailed {
+		span.SetTag("examiner.skipped", true)
+	} else {
+		span.SetError(err)
+	}
+	span.Finish()
+}
+
+func (e *Examiner) work(ctx context.Context, task *task) (err error) {
 	switch task.item {
 	case categoriesItem:
-		err = e.categoriesWork(task.ctx, task.countryCode, task.locale)
+		err = e.categoriesWork(ctx, task.countryCode, task.locale)
 	case sectionsItem:
-		err = e.sectionsWork(task.ctx, task.countryCode, task.locale)
+		err = e.sectionsWork(ctx, task.countryCode, task.locale)
 	case articlesItem:
-		err = e.articlesWork(task.ctx, task.countryCode, task.locale)
+		err = e.articlesWork(ctx, task.countryCode, task.locale)
 	case ticketFormsItem:
-		err = e.ticketFormsWork(task.ctx)
+		err = e.ticketFormsWork(ctx)
 	default:
 		err = errors.Errorf("examiner: [work] receive unknown item:%s", task.item)
 	}
@@ -130,8 +174,10 @@ func (e *Examiner) work(task *task) (err error) {
 	return err
 }
 
-func (e *Examiner) articleWork(task *articleTask) (err error) {
-	return e.articleSync(task.ctx, task.articleID, task.countryCode, task.locale)
+func (e *Examiner) articleWork(ctx context.Context, task *articleTask) (err error) {
+	return observeSync(articleItem, func() error {
+		return e.articleSync(ctx, task.articleID, task.countryCode, task.locale)
+	})
 }
 
 func (e *Examiner) categoriesWork(ctx context.Context, countryCode, locale string) error {
@@ -143,14 +189,15 @@ func (e *Examiner) categoriesWork(ctx context.Context, countryCode, locale strin
 	// refresh limit <= 0: always not sync.
 	// refresh limit == 1: always sync.
 	// refresh limit > 1: sync when count >= refresh limit.
-	if e.categoriesRefreshLimit <= 0 {
+	limit := e.limits().CategoriesRefreshLimit
+	if limit <= 0 {
 		return nil
 	}
-	if count < e.categoriesRefreshLimit {
+	if count < limit {
 		return nil
 	}
 
-	if err := e.categoriesSync(ctx, countryCode, locale); err != nil {
+	if err := observeSync(categoriesItem, func() error { return e.categoriesSync(ctx, countryCode, locale) }); err != nil {
 		switch err {
 		case ErrAcquireCounterLockFailed:
 			return ErrAcquireCounterLockFailed
@@ -198,18 +245,29 @@ func (e *Examiner) categoriesSync(ctx context.Context, countryCode, locale strin
 		categories[i].URL = zendeskCategory.URL
 	}
 
-	if err = e.service.SyncWithCategories(ctx, categories, countryCode, locale); err != nil {
+	changedIDs, err := e.service.SyncWithCategories(ctx, categories, countryCode, locale)
+	if err != nil {
 		return errors.Wrapf(err, "examiner: [categoriesSync] service.SyncWithCategories failed")
 	}
 	if err = e.service.CategoriesCacheInvalidate(ctx, countryCode, locale); err != nil {
 		return errors.Wrapf(err, "examiner: [categoriesSync] service.CategoriesCacheInvalidate failed")
 	}
+	if len(changedIDs) > 0 {
+		e.publishEvent(ctx, &models.Event{
+			Type:        models.EventCategoriesChanged,
+			CountryCode: countryCode,
+			Locale:      locale,
+			IDs:         changedIDs,
+		})
+		e.purge(ctx, categoriesItem, changedIDs, countryCode, locale)
+	}
 	if err = e.service.ResetCategoriesCounter(ctx, countryCode, locale); err != nil {
 		return errors.Wrapf(err, "examiner: [categoriesSync] service.ResetCategoriesCounter failed")
 	}
 	if err = e.service.UnlockCategoriesCounter(ctx, countryCode, locale); err != nil {
 		return errors.Wrapf(err, "examiner: [categoriesSync] service.UnlockCategoriesCounter failed")
 	}
+	e.markSynced(ctx, countryCode)
 
 	return nil
 }
@@ -223,14 +281,15 @@ func (e *Examiner) sectionsWork(ctx context.Context, countryCode, locale string)
 	// refresh limit <= 0: always not sync.
 	// refresh limit == 1: always sync.
 	// refresh limit > 1: sync when count >= refresh limit.
-	if e.sectionsRefreshLimit <= 0 {
+	limit := e.limits().SectionsRefreshLimit
+	if limit <= 0 {
 		return nil
 	}
-	if count < e.sectionsRefreshLimit {
+	if count < limit {
 		return nil
 	}
 
-	if err = e.sectionsSync(ctx, countryCode, locale); err != nil {
+	if err = observeSync(sectionsItem, func() error { return e.sectionsSync(ctx, countryCode, locale) }); err != nil {
 		switch err {
 		case ErrAcquireCounterLockFailed:
 			return ErrAcquireCounterLockFailed
@@ -279,18 +338,29 @@ func (e *Examiner) sectionsSync(ctx context.Context, countryCode, locale string)
 		sections[i].URL = zendeskSection.URL
 	}
 
-	if err = e.service.SyncWithSections(ctx, sections, countryCode, locale); err != nil {
+	changedIDs, err := e.service.SyncWithSections(ctx, sections, countryCode, locale)
+	if err != nil {
 		return errors.Wrapf(err, "examiner: [sectionsSync] service.SyncWithSections failed")
 	}
 	if err = e.service.SectionsCacheInvalidate(ctx, countryCode, locale); err != nil {
 		return errors.Wrapf(err, "examiner: [sectionsSync] service.SectionsCacheInvalidate failed")
 	}
+	if len(changedIDs) > 0 {
+		e.publishEvent(ctx, &models.Event{
+			Type:        models.EventSectionsChanged,
+			CountryCode: countryCode,
+			Locale:      locale,
+			IDs:         changedIDs,
+		})
+		e.purge(ctx, sectionsItem, changedIDs, countryCode, locale)
+	}
 	if err = e.service.ResetSectionsCounter(ctx, countryCode, locale); err != nil {
 		return errors.Wrapf(err, "examiner: [sectionsSync] service.ResetSectionsCounter failed")
 	}
 	if err = e.service.UnlockSectionsCounter(ctx, countryCode, locale); err != nil {
 		return errors.Wrapf(err, "examiner: [sectionsSync] service.UnlockSectionsCounter failed")
 	}
+	e.markSynced(ctx, countryCode)
 
 	return nil
 }
@@ -304,14 +374,15 @@ func (e *Examiner) articlesWork(ctx context.Context, countryCode, locale string)
 	// refresh limit <= 0: always not sync.
 	// refresh limit == 1: always sync.
 	// refresh limit > 1: sync when count >= refresh limit.
-	if e.articlesRefreshLimit <= 0 {
+	limit := e.limits().ArticlesRefreshLimit
+	if limit <= 0 {
 		return nil
 	}
-	if count < e.articlesRefreshLimit {
+	if count < limit {
 		return nil
 	}
 
-	if err := e.articlesSync(ctx, countryCode, locale); err != nil {
+	if err := observeSync(articlesItem, func() error { return e.articlesSync(ctx, countryCode, locale) }); err != nil {
 		switch err {
 		case ErrAcquireCounterLockFailed:
 			return ErrAcquireCounterLockFailed
@@ -370,18 +441,22 @@ func (e *Examiner) articlesSync(ctx context.Context, countryCode, locale string)
 		articles[i].VoteSum = zendeskArticle.VoteSum
 	}
 
-	if err = e.service.SyncWithArticles(ctx, articles, countryCode, locale); err != nil {
+	changedIDs, err := e.service.SyncWithArticles(ctx, articles, countryCode, locale)
+	if err != nil {
 		return errors.Wrapf(err, "examiner: [articlesSync] service.SyncWithArticles failed")
 	}
 	if err = e.service.ArticlesCacheInvalidate(ctx, countryCode, locale); err != nil {
 		return errors.Wrapf(err, "examiner: [articlesSync] service.ArticlesCacheInvalidate failed")
 	}
+	e.publishArticlesUpdated(ctx, articles, changedIDs, countryCode, locale)
+	e.purge(ctx, articlesItem, changedIDs, countryCode, locale)
 	if err = e.service.ResetArticlesCounter(ctx, countryCode, locale); err != nil {
 		return errors.Wrapf(err, "examiner: [articlesSync] service.ResetArticlesCounter failed")
 	}
 	if err = e.service.UnlockArticlesCounter(ctx, countryCode, locale); err != nil {
 		return errors.Wrapf(err, "examiner: [articlesSync] service.UnlockArticlesCounter failed")
 	}
+	e.markSynced(ctx, countryCode)
 
 	return nil
 }
@@ -419,13 +494,111 @@ func (e *Examiner) articleSync(ctx context.Context, articleID int, countryCode,
 		Locale:          zendeskArticle.Locale,
 	}
 
-	if err = e.service.SyncWithArticle(ctx, articleID, article, countryCode, locale); err != nil {
+	changedIDs, err := e.service.SyncWithArticle(ctx, articleID, article, countryCode, locale)
+	if err != nil {
 		return errors.Wrapf(err, "examiner: [articleSync] service.SyncWithArticle failed")
 	}
+	e.publishArticlesUpdated(ctx, []*models.Article{article}, changedIDs, countryCode, locale)
+	e.purge(ctx, articlesItem, changedIDs, countryCode, locale)
 
 	return nil
 }
 
+// publishArticlesUpdated publishes an article updated event for each changed article,
+// the changed ids which are not in the articles are published in one articles deleted event.
+func (e *Examiner) publishArticlesUpdated(ctx context.Context, articles []*models.Article, changedIDs []int, countryCode, locale string) {
+	changed := make(map[int]bool, len(changedIDs))
+	for _, id := range changedIDs {
+		changed[id] = true
+	}
+
+	for _, article := range articles {
+		if !changed[article.ID] {
+			continue
+		}
+		delete(changed, article.ID)
+		e.publishEvent(ctx, &models.Event{
+			Type:        models.EventArticleUpdated,
+			CountryCode: countryCode,
+			Locale:      locale,
+			IDs:         []int{article.ID},
+			SectionID:   article.SectionID,
+		})
+	}
+
+	deletedIDs := make([]int, 0, len(changed))
+	for _, id := range changedIDs {
+		if changed[id] {
+			deletedIDs = append(deletedIDs, id)
+		}
+	}
+	if len(deletedIDs) > 0 {
+		e.publishEvent(ctx, &models.Event{
+			Type:        models.EventArticlesDeleted,
+			CountryCode: countryCode,
+			Locale:      locale,
+			IDs:         deletedIDs,
+		})
+	}
+}
+
+// publishEvent publishes the content change event, the failure only is logged
+// since the database has been synced already.
+func (e *Examiner) publishEvent(ctx context.Context, event *models.Event) {
+	if err := e.service.PublishEvent(ctx, event); err != nil {
+		e.logger.Error().Err(err).Fields(map[string]interface{}{
+			"type":        event.Type,
+			"countryCode": event.CountryCode,
+			"locale":      event.Locale,
+		}).Msgf("examiner: [publishEvent] service.PublishEvent failed")
+	}
+}
+
+// markSynced keeps now as the last successful sync of the country, the failure only is logged
+// since the database has been synced already.
+func (e *Examiner) markSynced(ctx context.Context, countryCode string) {
+	if err := e.service.SetLastSync(ctx, countryCode, time.Now().UTC()); err != nil {
+		e.logger.Error().Err(err).Fields(map[string]interface{}{
+			"countryCode": countryCode,
+		}).Msgf("examiner: [markSynced] service.SetLastSync failed")
+	}
+}
+
+// purge purges the CDN cached responses of the changed rows of the item, the failure only is logged
+// since the database has been synced already and the cached responses expire anyway.
+// The listings are purged as well, since the rows may be added or removed.
+func (e *Examiner) purge(ctx context.Context, item string, changedIDs []int, countryCode, locale string) {
+	if len(changedIDs) == 0 {
+		return
+	}
+
+	req := &purge.Request{Keys: []string{models.ListSurrogateKey(item, countryCode, locale)}}
+	for _, id := range changedIDs {
+		switch item {
+		case categoriesItem:
+			req.Keys = append(req.Keys, (&models.Category{ID: id}).SurrogateKey())
+		case sectionsItem:
+			req.Keys = append(req.Keys, (&models.Section{ID: id}).SurrogateKey())
+		case articlesItem:
+			req.Keys = append(req.Keys, (&models.Article{ID: id}).SurrogateKey())
+		}
+	}
+	// The categories have no cached item route.
+	if item == categoriesItem {
+		req.Paths = purge.ListPaths(item, countryCode, locale)
+	} else {
+		req.Paths = purge.ItemPaths(item, changedIDs, countryCode, locale)
+	}
+
+	if err := e.purger.Purge(ctx, req); err != nil {
+		e.logger.Error().Err(err).Fields(map[string]interface{}{
+			"item":        item,
+			"countryCode": countryCode,
+			"locale":      locale,
+		}).Msgf("examiner: [purge] purger.Purge failed")
+	}
+}
+
 func (e *Examiner) ticketFormsWork(ctx context.Context) error {
 	count, err := e.service.PlusOneTicketFormsCounter(ctx)
 	if err != nil {
@@ -435,14 +608,15 @@ func (e *Examiner) ticketFormsWork(ctx context.Context) error {
 	// refresh limit <= 0: always not sync.
 	// refresh limit == 1: always sync.
 	// refresh limit > 1: sync when count >= refresh limit.
-	if e.ticketFormsRefreshLimit <= 0 {
+	limit := e.limits().TicketFormsRefreshLimit
+	if limit <= 0 {
 		return nil
 	}
-	if count < e.ticketFormsRefreshLimit {
+	if count < limit {
 		return nil
 	}
 
-	if err := e.ticketFormsSync(ctx); err != nil {
+	if err := observeSync(ticketFormsItem, func() error { return e.ticketFormsSync(ctx) }); err != nil {
 		switch err {
 		case ErrAcquireCounterLockFailed:
 			return ErrAcquireCounterLockFailed
@@ -607,6 +781,9 @@ func (e *Examiner) ticketFormsSync(ctx context.Context) error {
 	if err = e.service.SyncWithDynamicContentItems(ctx, dcItems); err != nil {
 		return errors.Wrapf(err, "examiner: [ticketFormsSync] service.SyncWithDynamicContentItems failed")
 	}
+	if err = e.service.DynamicContentCacheInvalidate(ctx); err != nil {
+		return errors.Wrapf(err, "examiner: [ticketFormsSync] service.DynamicContentCacheInvalidate failed")
+	}
 	if err = e.service.ResetTicketFormsCounter(ctx); err != nil {
 		return errors.Wrapf(err, "examiner: [ticketFormsSync] service.ResetTicketFormsCounter failed")
 	}
@@ -620,31 +797,46 @@ func (e *Examiner) ticketFormsSync(ctx context.Context) error {
 func (e *Examiner) check(ctx context.Context, item, countryCode, locale string) {
 	e.tasks <- &task{
 		ctx:         ctx,
+		link:        tracing.SpanContextFromContext(ctx),
 		item:        item,
 		countryCode: countryCode,
 		locale:      locale,
 	}
+	metrics.SetExaminerQueueDepth(len(e.tasks))
 }
 
 func (e *Examiner) syncArticle(ctx context.Context, articleID int, countryCode, locale string) {
 	e.tasks <- &articleTask{
 		ctx:         ctx,
+		link:        tracing.SpanContextFromContext(ctx),
 		articleID:   articleID,
 		countryCode: countryCode,
 		locale:      locale,
 	}
+	metrics.SetExaminerQueueDepth(len(e.tasks))
+}
+
+// observeSync runs the sync of the item and observes its duration,
+// the sync skipped for the lock of another sync is not observed.
+func observeSync(item string, run func() error) error {
+	start := time.Now()
+	err := run()
+	if err != ErrAcquireCounterLockFailed {
+		metrics.ObserveExaminerSync(item, start, err)
+	}
+	return err
 }
 
 func (e *Examiner) force(ctx context.Context, item, countryCode, locale string) (err error) {
 	switch item {
 	case categoriesItem:
-		err = e.categoriesSync(ctx, countryCode, locale)
+		err = observeSync(item, func() error { return e.categoriesSync(ctx, countryCode, locale) })
 	case sectionsItem:
-		err = e.sectionsSync(ctx, countryCode, locale)
+		err = observeSync(item, func() error { return e.sectionsSync(ctx, countryCode, locale) })
 	case articlesItem:
-		err = e.articlesSync(ctx, countryCode, locale)
+		err = observeSync(item, func() error { return e.articlesSync(ctx, countryCode, locale) })
 	case ticketFormsItem:
-		err = e.ticketFormsSync(ctx)
+		err = observeSync(item, func() error { return e.ticketFormsSync(ctx) })
 	default:
 		err = errors.Errorf("examiner: [force] receive unknown item:%s", item)
 	}
@@ -716,6 +908,25 @@ func (e *Examiner) ForceSyncTicketForms(ctx context.Context) error {
 	)
 }
 
+// Reload replaces the refresh limits by the ones of conf, the other settings are kept.
+func (e *Examiner) Reload(conf *config.Config) {
+	limits := *conf.Examiner
+	e.refreshLimits.Store(&limits)
+}
+
+func (e *Examiner) limits() *config.Examiner {
+	return e.refreshLimits.Load().(*config.Examiner)
+}
+
+// Health checks all the workers are running. The full queue is not checked since it is drained
+// by the running workers, restarting the server only drops the queued tasks.
+func (e *Examiner) Health(ctx context.Context) error {
+	if workers := int(atomic.LoadInt32(&e.workers)); workers < e.maxWorkers {
+		return errors.Errorf("examiner: [Health] %d of %d workers are running", workers, e.maxWorkers)
+	}
+	return nil
+}
+
 // Close let the gone out goroutine to stop it self.
 func (e *Examiner) Close() error {
 	close(e.tasks)
@@ -11,6 +11,7 @@ import (
 
 	"github.com/honestbee/Zen/config"
 	"github.com/honestbee/Zen/models"
+	"github.com/honestbee/Zen/purge"
 	"github.com/honestbee/Zen/zendesk"
 )
 
@@ -43,7 +44,7 @@ func TestCheckTicketForms(t *testing.T) {
 			ArticlesRefreshLimit:    1,
 			TicketFormsRefreshLimit: 1,
 		},
-	}, &logger, mockServ, zend)
+	}, &logger, mockServ, zend, purge.NopPurger{})
 	defer exam.Close()
 
 	testCases := []struct {
@@ -62,6 +63,7 @@ func TestCheckTicketForms(t *testing.T) {
 				"TicketFieldCustomFieldOptionCacheInvalidate": true,
 				"TicketFieldSystemFieldOptionCacheInvalidate": true,
 				"SyncWithDynamicContentItems":                 true,
+				"DynamicContentCacheInvalidate":               true,
 				"ResetTicketFormsCounter":                     true,
 				"UnlockTicketFormsCounter":                    true,
 			},
@@ -91,7 +93,7 @@ func TestCheckCategories(t *testing.T) {
 			SectionsRefreshLimit:   1,
 			ArticlesRefreshLimit:   1,
 		},
-	}, &logger, mockServ, zend)
+	}, &logger, mockServ, zend, purge.NopPurger{})
 	defer exam.Close()
 
 	testCases := []struct {
@@ -207,7 +209,7 @@ func TestCheckSections(t *testing.T) {
 			SectionsRefreshLimit:   1,
 			ArticlesRefreshLimit:   1,
 		},
-	}, &logger, mockServ, zend)
+	}, &logger, mockServ, zend, purge.NopPurger{})
 	defer exam.Close()
 
 	testCases := []struct {
@@ -323,7 +325,7 @@ func TestCheckArticles(t *testing.T) {
 			SectionsRefreshLimit:   1,
 			ArticlesRefreshLimit:   1,
 		},
-	}, &logger, mockServ, zend)
+	}, &logger, mockServ, zend, purge.NopPurger{})
 	defer exam.Close()
 
 	testCases := []struct {
@@ -440,7 +442,7 @@ func TestAlwaysNotSync(t *testing.T) {
 			ArticlesRefreshLimit:    0,
 			TicketFormsRefreshLimit: 0,
 		},
-	}, &logger, mockServ, zend)
+	}, &logger, mockServ, zend, purge.NopPurger{})
 	defer exam.Close()
 
 	testCases := []struct {
@@ -477,3 +479,179 @@ func TestAlwaysNotSync(t *testing.T) {
 		})
 	}
 }
+
+func TestPublishArticlesUpdated(t *testing.T) {
+	testCases := []struct {
+		description string
+		articles    []*models.Article
+		changedIDs  []int
+		expect      []*models.Event
+	}{
+		{
+			description: "testing normal case",
+			articles: []*models.Article{
+				{ID: 1, SectionID: 10},
+				{ID: 2, SectionID: 20},
+			},
+			changedIDs: []int{2},
+			expect: []*models.Event{
+				{
+					Type:        models.EventArticleUpdated,
+					CountryCode: "tw",
+					Locale:      "en-us",
+					IDs:         []int{2},
+					SectionID:   20,
+					Seq:         1,
+				},
+			},
+		},
+		{
+			description: "testing deleted article case",
+			articles: []*models.Article{
+				{ID: 1, SectionID: 10},
+			},
+			changedIDs: []int{3, 1, 4},
+			expect: []*models.Event{
+				{
+					Type:        models.EventArticleUpdated,
+					CountryCode: "tw",
+					Locale:      "en-us",
+					IDs:         []int{1},
+					SectionID:   10,
+					Seq:         1,
+				},
+				{
+					Type:        models.EventArticlesDeleted,
+					CountryCode: "tw",
+					Locale:      "en-us",
+					IDs:         []int{3, 4},
+					Seq:         2,
+				},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			mockServ := models.NewMockService()
+			exam := &Examiner{logger: &logger, service: mockServ}
+
+			exam.publishArticlesUpdated(context.Background(), tt.articles, tt.changedIDs, "tw", "en-us")
+			if diff := deep.Equal(tt.expect, mockServ.PublishedEvents()); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+}
+
+// recordPurger records the purge request, the HTTPPurger skips the paths covered by the keys.
+type recordPurger struct {
+	req purge.Request
+}
+
+func (p *recordPurger) Purge(ctx context.Context, req *purge.Request) error {
+	p.req = *req
+	return nil
+}
+
+func TestPurge(t *testing.T) {
+	testCases := []struct {
+		description string
+		item        string
+		changedIDs  []int
+		expectKeys  []string
+		expectPaths []string
+	}{
+		{
+			description: "testing articles case",
+			item:        articlesItem,
+			changedIDs:  []int{1, 2},
+			expectKeys:  []string{"articles-tw-en-us", "article-1", "article-2"},
+			expectPaths: []string{
+				"/api/articles/1?country_code=tw&locale=en-us",
+				"/api/v2/articles/1?country_code=tw&locale=en-us",
+				"/api/articles/2?country_code=tw&locale=en-us",
+				"/api/v2/articles/2?country_code=tw&locale=en-us",
+			},
+		},
+		{
+			description: "testing categories case",
+			item:        categoriesItem,
+			changedIDs:  []int{3},
+			expectKeys:  []string{"categories-tw-en-us", "category-3"},
+			expectPaths: []string{
+				"/api/categories?country_code=tw&locale=en-us",
+				"/api/v2/categories?country_code=tw&locale=en-us",
+			},
+		},
+		{
+			description: "testing nothing changed case",
+			item:        sectionsItem,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			purger := new(recordPurger)
+			exam := &Examiner{
+				logger: &logger,
+				purger: purger,
+			}
+
+			exam.purge(context.Background(), tt.item, tt.changedIDs, "tw", "en-us")
+			if diff := deep.Equal(tt.expectKeys, purger.req.Keys); diff != nil {
+				t.Errorf("[%s] keys %v", tt.description, diff)
+			}
+			if diff := deep.Equal(tt.expectPaths, purger.req.Paths); diff != nil {
+				t.Errorf("[%s] paths %v", tt.description, diff)
+			}
+		})
+	}
+}
+
+func TestHealth(t *testing.T) {
+	testCases := []struct {
+		description string
+		exam        func() *Examiner
+		expectErr   bool
+	}{
+		{
+			description: "testing all workers running case",
+			exam: func() *Examiner {
+				exam, _ := NewExaminer(&config.Config{
+					Examiner: &config.Examiner{MaxPoolSize: 1, MaxWorkerSize: 2},
+				}, &logger, models.NewMockService(), zend, purge.NopPurger{})
+				return exam
+			},
+		},
+		{
+			description: "testing workers stopped case",
+			exam: func() *Examiner {
+				exam, _ := NewExaminer(&config.Config{
+					Examiner: &config.Examiner{MaxPoolSize: 1, MaxWorkerSize: 2},
+				}, &logger, models.NewMockService(), zend, purge.NopPurger{})
+				exam.Close()
+				return exam
+			},
+			expectErr: true,
+		},
+		{
+			description: "testing queue full case",
+			exam: func() *Examiner {
+				exam := &Examiner{tasks: make(chan interface{}, 1)}
+				exam.tasks <- &task{}
+				return exam
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			err := tt.exam().Health(context.Background())
+			if (err != nil) != tt.expectErr {
+				t.Errorf("[%s] expectErr:%v, actual:%v", tt.description, tt.expectErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/honestbee/Zen/antispam"
+	"github.com/honestbee/Zen/auth"
+	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/protobuf"
+	"github.com/honestbee/Zen/redact"
+	"github.com/honestbee/Zen/session"
+	"github.com/honestbee/Zen/tracing"
+)
+
+// listenerBufferSize is the buffer size of the in process connections to the gRPC server.
+const listenerBufferSize = 1024 * 1024
+
+// Gateway transcodes the RESTful JSON requests into the gRPC calls by the handlers generated by
+// protoc-gen-grpc-gateway from the google.api.http annotations of zendesk.proto, so that the proto
+// is the single source of the REST contract. The server streams are written as newline delimited
+// JSON objects, each of which has the message in result.
+type Gateway struct {
+	logger *zerolog.Logger
+	conn   *grpc.ClientConn
+	mux    *runtime.ServeMux
+	lis    *bufconn.Listener
+	// requireClientCert rejects the requests without a verified client certificate,
+	// since the in process calls skip the mutual TLS of the gRPC listener.
+	requireClientCert bool
+}
+
+// New returns a Gateway instance calling the gRPC server of this replica in process,
+// the gRPC server has to serve the Listener of the gateway. Calling in process keeps
+// the gateway working whatever the transport security of the gRPC listener is, so the
+// requests are required to carry a verified client certificate if requireClientCert is set,
+// as the gRPC requests are.
+func New(conf *config.Config, logger *zerolog.Logger, requireClientCert bool) (*Gateway, error) {
+	lis := bufconn.Listen(listenerBufferSize)
+	conn, err := grpc.NewClient("passthrough:///gateway",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "gateway: [New] grpc new client failed")
+	}
+
+	g, err := newGateway(logger, conn)
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrapf(err, "gateway: [New] newGateway failed")
+	}
+	g.lis = lis
+	g.requireClientCert = requireClientCert
+	return g, nil
+}
+
+// Listener returns the in process listener the gateway connects to.
+func (g *Gateway) Listener() net.Listener {
+	return g.lis
+}
+
+func newGateway(logger *zerolog.Logger, conn *grpc.ClientConn) (*Gateway, error) {
+	g := &Gateway{
+		logger: logger,
+		conn:   conn,
+	}
+	g.mux = runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions:   protojson.MarshalOptions{EmitUnpopulated: true},
+			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+		}),
+		runtime.WithMetadata(outgoingMetadata),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeader),
+		runtime.WithErrorHandler(g.writeError),
+	)
+
+	if err := protobuf.RegisterZendeskHandlerClient(context.Background(), g.mux, protobuf.NewZendeskClient(conn)); err != nil {
+		return nil, errors.Wrapf(err, "gateway: [newGateway] protobuf.RegisterZendeskHandlerClient failed")
+	}
+	return g, nil
+}
+
+// ServeHTTP serves the RESTful requests by the gRPC methods.
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if g.requireClientCert && (r.TLS == nil || len(r.TLS.VerifiedChains) == 0) {
+		g.writeError(r.Context(), g.mux, nil, w, r, status.Error(codes.Unauthenticated, "client certificate required"))
+		return
+	}
+	g.mux.ServeHTTP(w, r)
+}
+
+// Close closes the connection to the gRPC server.
+func (g *Gateway) Close() error {
+	return errors.Wrapf(g.conn.Close(), "gateway: [Close] grpc connection close failed")
+}
+
+// outgoingMetadata forwards the api key of the http headers as the gRPC metadata, so that the
+// gRPC server authenticates the caller, and the traceparent of the request span. The session id,
+// the device id and the client ip resolved by handlers.RemoteIPMiddleware are forwarded as well,
+// so that the votes and the session events are kept by the client. The authorization header is
+// forwarded by the generated handlers.
+func outgoingMetadata(ctx context.Context, r *http.Request) metadata.MD {
+	md := metadata.MD{}
+	if c := auth.CredentialsFromRequest(r); c.APIKey != "" {
+		md.Set(auth.APIKeyMetadata, c.APIKey)
+	}
+	if id := r.Header.Get(session.Header); id != "" {
+		md.Set(session.Metadata, id)
+	}
+	if id := r.Header.Get(session.DeviceHeader); id != "" {
+		md.Set(session.DeviceMetadata, id)
+	}
+	if ip := antispam.RemoteIPFromContext(r.Context()); ip != "" {
+		md.Set(antispam.RemoteIPMetadata, ip)
+	}
+	tracing.Inject(r.Context(), tracing.MetadataCarrier(md))
+	return md
+}
+
+// outgoingHeader sends the device id issued by the gRPC server back in the same header as the RESTful
+// handlers, the other header metadata are prefixed as the default.
+func outgoingHeader(key string) (string, bool) {
+	if key == session.DeviceMetadata {
+		return session.DeviceHeader, true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+// errorBody is the body of the error responses, it is the same as the RESTful handlers.
+type errorBody struct {
+	Error string `json:"error"`
+}
+
+func (g *Gateway) writeError(ctx context.Context, _ *runtime.ServeMux, _ runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	st := status.Convert(err)
+	if st.Code() == codes.Internal || st.Code() == codes.Unavailable || st.Code() == codes.Unknown {
+		g.logger.Error().Fields(map[string]interface{}{
+			"from":   r.RemoteAddr,
+			"path":   redact.String(r.URL.Path),
+			"method": r.Method,
+			"error":  redact.String(err.Error()),
+		}).Msgf("gateway error occurred")
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(runtime.HTTPStatusFromCode(st.Code()))
+	json.NewEncoder(w).Encode(errorBody{Error: st.Message()})
+}
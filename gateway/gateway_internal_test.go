@@ -0,0 +1,320 @@
+package gateway
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/honestbee/Zen/antispam"
+	"github.com/honestbee/Zen/auth"
+	"github.com/honestbee/Zen/protobuf"
+	"github.com/honestbee/Zen/session"
+)
+
+var logger = zerolog.New(ioutil.Discard)
+
+// fakeZendeskServer records the requests, the methods not overridden are not called by the tests.
+type fakeZendeskServer struct {
+	protobuf.ZendeskServer
+	in proto.Message
+	md metadata.MD
+}
+
+func (f *fakeZendeskServer) GetArticles(ctx context.Context, in *protobuf.GetArticlesRequest) (*protobuf.GetArticlesResponse, error) {
+	f.in = in
+	return &protobuf.GetArticlesResponse{PageInfo: &protobuf.PageInfo{Page: 1, PerPage: in.PerPage}}, nil
+}
+
+func (f *fakeZendeskServer) GetArticle(ctx context.Context, in *protobuf.GetArticleRequest) (*protobuf.GetArticleResponse, error) {
+	f.in = in
+	f.md, _ = metadata.FromIncomingContext(ctx)
+	if in.ArticleId == "404" {
+		return nil, status.Error(codes.NotFound, "Record Not Found")
+	}
+	return &protobuf.GetArticleResponse{Article: &protobuf.Article{Id: in.ArticleId, Title: "title"}}, nil
+}
+
+func (f *fakeZendeskServer) SetVoteArticle(ctx context.Context, in *protobuf.SetVoteArticleRequest) (*protobuf.SetVoteArticleResponse, error) {
+	f.in = in
+	return &protobuf.SetVoteArticleResponse{Article: &protobuf.Article{Id: in.ArticleId, VoteSum: 1}}, nil
+}
+
+func (f *fakeZendeskServer) StreamArticles(in *protobuf.StreamArticlesRequest, stream protobuf.Zendesk_StreamArticlesServer) error {
+	f.in = in
+	for _, id := range []string{"1", "2"} {
+		if err := stream.Send(&protobuf.StreamArticlesResponse{Article: &protobuf.Article{Id: id}}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func timestampProto(t *testing.T, year int, month time.Month, day, hour, min, sec int) *timestamp.Timestamp {
+	ts, err := ptypes.TimestampProto(time.Date(year, month, day, hour, min, sec, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("timestamp proto failed:%v", err)
+	}
+	return ts
+}
+
+// decodeJSON decodes the body for the comparison, since the spaces of the protojson output are not stable.
+func decodeJSON(t *testing.T, body string) interface{} {
+	var v interface{}
+	if err := json.Unmarshal([]byte(body), &v); err != nil {
+		t.Fatalf("json unmarshal body:%q failed:%v", body, err)
+	}
+	return v
+}
+
+func newTestGateway(t *testing.T) (*Gateway, *fakeZendeskServer, func()) {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	fake := &fakeZendeskServer{}
+	protobuf.RegisterZendeskServer(s, fake)
+	go s.Serve(lis)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+	)
+	if err != nil {
+		t.Fatalf("grpc new client failed:%v", err)
+	}
+	g, err := newGateway(&logger, conn)
+	if err != nil {
+		t.Fatalf("new gateway failed:%v", err)
+	}
+
+	return g, fake, func() {
+		g.Close()
+		s.Stop()
+	}
+}
+
+func TestGateway(t *testing.T) {
+	g, fake, closer := newTestGateway(t)
+	defer closer()
+
+	testCases := []struct {
+		description  string
+		method       string
+		target       string
+		body         string
+		expectStatus int
+		expectBody   string
+		expectIn     proto.Message
+	}{
+		{
+			description:  "testing path variable and enum name case",
+			method:       http.MethodGet,
+			target:       "/v1/articles/123?countryCode=COUNTRY_CODE_TW&locale=LOCALE_ZH_TW",
+			expectStatus: http.StatusOK,
+			expectBody:   `{"article":{"id":"123","authorId":"","commentsDisable":false,"draft":false,"promoted":false,"position":0,"voteSum":0,"voteCount":0,"createdAt":null,"updatedAt":null,"sourceLocale":"","outdated":false,"outdatedLocales":[],"editedAt":null,"labelNames":[],"countryCode":"","url":"","htmlUrl":"","name":"","title":"title","body":"","locale":"","sectionId":""}}`,
+			expectIn: &protobuf.GetArticleRequest{
+				CountryCode: protobuf.CountryCode_COUNTRY_CODE_TW,
+				Locale:      protobuf.Locale_LOCALE_ZH_TW,
+				ArticleId:   "123",
+			},
+		},
+		{
+			description:  "testing enum number, bool, int and repeated query case",
+			method:       http.MethodGet,
+			target:       "/v1/articles?countryCode=2&all=true&perPage=5&labelNames=a&labelNames=b",
+			expectStatus: http.StatusOK,
+			expectBody:   `{"pageInfo":{"perPage":5,"page":1,"pageCount":0,"count":0},"articles":[]}`,
+			expectIn: &protobuf.GetArticlesRequest{
+				CountryCode: protobuf.CountryCode_COUNTRY_CODE_TW,
+				PerPage:     5,
+				Id:          &protobuf.GetArticlesRequest_All{All: true},
+				LabelNames:  []string{"a", "b"},
+			},
+		},
+		{
+			description:  "testing invalid bool case",
+			method:       http.MethodGet,
+			target:       "/v1/articles?all=yes",
+			expectStatus: http.StatusBadRequest,
+			expectBody:   `{"error":"parsing field \"all\": strconv.ParseBool: parsing \"yes\": invalid syntax"}`,
+		},
+		{
+			description:  "testing invalid enum case",
+			method:       http.MethodGet,
+			target:       "/v1/articles/1?countryCode=COUNTRY_CODE_XX",
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			description:  "testing not found case",
+			method:       http.MethodGet,
+			target:       "/v1/articles/404",
+			expectStatus: http.StatusNotFound,
+			expectBody:   `{"error":"Record Not Found"}`,
+		},
+		{
+			description:  "testing body case",
+			method:       http.MethodPost,
+			target:       "/v1/articles/5/vote",
+			body:         `{"countryCode":"COUNTRY_CODE_SG","vote":"VOTE_UP","articleId":"ignored"}`,
+			expectStatus: http.StatusOK,
+			expectIn: &protobuf.SetVoteArticleRequest{
+				CountryCode: protobuf.CountryCode_COUNTRY_CODE_SG,
+				ArticleId:   "5",
+				Vote:        protobuf.Vote_VOTE_UP,
+			},
+		},
+		{
+			description:  "testing invalid body case",
+			method:       http.MethodPost,
+			target:       "/v1/articles/5/vote",
+			body:         `[]`,
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			description:  "testing server streaming case",
+			method:       http.MethodGet,
+			target:       "/v1/export/articles?since=2018-01-02T03:04:05Z",
+			expectStatus: http.StatusOK,
+			expectIn: &protobuf.StreamArticlesRequest{
+				Since: timestampProto(t, 2018, 1, 2, 3, 4, 5),
+			},
+		},
+		{
+			description:  "testing not annotated path case",
+			method:       http.MethodGet,
+			target:       "/v1/not_exist",
+			expectStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			fake.in = nil
+			w := httptest.NewRecorder()
+			g.ServeHTTP(w, httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body)))
+
+			if w.Code != tt.expectStatus {
+				t.Errorf("[%s] expect status:%d, actual:%d body:%s", tt.description, tt.expectStatus, w.Code, w.Body.String())
+			}
+			if tt.expectBody != "" {
+				if diff := deep.Equal(decodeJSON(t, tt.expectBody), decodeJSON(t, w.Body.String())); diff != nil {
+					t.Errorf("[%s] %v", tt.description, diff)
+				}
+			}
+			if tt.expectIn != nil && !proto.Equal(tt.expectIn, fake.in) {
+				t.Errorf("[%s] expect request:%v, actual:%v", tt.description, tt.expectIn, fake.in)
+			}
+		})
+	}
+}
+
+func TestGatewayStreamLines(t *testing.T) {
+	g, _, closer := newTestGateway(t)
+	defer closer()
+
+	w := httptest.NewRecorder()
+	g.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v1/export/articles", nil))
+
+	lines := strings.Split(strings.TrimSpace(w.Body.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expect 2 lines, actual:%q", w.Body.String())
+	}
+	for i, id := range []string{"1", "2"} {
+		var line struct {
+			Result struct {
+				Article struct {
+					ID string `json:"id"`
+				} `json:"article"`
+			} `json:"result"`
+		}
+		if err := json.Unmarshal([]byte(lines[i]), &line); err != nil || line.Result.Article.ID != id {
+			t.Errorf("line %d expect article:%s, actual:%s", i, id, lines[i])
+		}
+	}
+}
+
+func TestGatewayRequireClientCert(t *testing.T) {
+	g, _, closer := newTestGateway(t)
+	defer closer()
+	g.requireClientCert = true
+
+	testCases := []struct {
+		description string
+		tls         *tls.ConnectionState
+		expect      int
+	}{
+		{
+			description: "testing plain request case",
+			expect:      http.StatusUnauthorized,
+		},
+		{
+			description: "testing unverified client certificate case",
+			tls:         &tls.ConnectionState{},
+			expect:      http.StatusUnauthorized,
+		},
+		{
+			description: "testing verified client certificate case",
+			tls:         &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{{}}}},
+			expect:      http.StatusOK,
+		},
+	}
+
+	for _, tt := range testCases {
+		r := httptest.NewRequest(http.MethodGet, "/v1/articles/1", nil)
+		r.TLS = tt.tls
+		w := httptest.NewRecorder()
+		g.ServeHTTP(w, r)
+		if w.Code != tt.expect {
+			t.Errorf("[%s] expect status:%d, actual:%d %s", tt.description, tt.expect, w.Code, w.Body.String())
+		}
+	}
+}
+
+func TestGatewayForwardCredentials(t *testing.T) {
+	g, fake, closer := newTestGateway(t)
+	defer closer()
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/articles/1", nil)
+	r.Header.Set("Authorization", "Bearer token")
+	r.Header.Set(auth.APIKeyHeader, "key")
+	r.Header.Set(session.Header, "anon-42")
+	r.Header.Set(session.DeviceHeader, "device-42")
+	r = r.WithContext(antispam.WithRemoteIP(r.Context(), "203.0.113.7"))
+	g.ServeHTTP(httptest.NewRecorder(), r)
+
+	if diff := deep.Equal([]string{"Bearer token"}, fake.md.Get("authorization")); diff != nil {
+		t.Errorf("[authorization] %v", diff)
+	}
+	if diff := deep.Equal([]string{"key"}, fake.md.Get(auth.APIKeyMetadata)); diff != nil {
+		t.Errorf("[%s] %v", auth.APIKeyMetadata, diff)
+	}
+	if diff := deep.Equal([]string{"anon-42"}, fake.md.Get(session.Metadata)); diff != nil {
+		t.Errorf("[%s] %v", session.Metadata, diff)
+	}
+	if diff := deep.Equal([]string{"device-42"}, fake.md.Get(session.DeviceMetadata)); diff != nil {
+		t.Errorf("[%s] %v", session.DeviceMetadata, diff)
+	}
+	if diff := deep.Equal([]string{"203.0.113.7"}, fake.md.Get(antispam.RemoteIPMetadata)); diff != nil {
+		t.Errorf("[%s] %v", antispam.RemoteIPMetadata, diff)
+	}
+}
@@ -0,0 +1,93 @@
+// Package gqldoc parses the executable graphql documents. The syntax tree of graphql-go is internal,
+// so the cost analysis and the operation selection share this parser instead.
+package gqldoc
+
+import (
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/pkg/errors"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// Document is a parsed executable graphql document, it is only checked for the syntax
+// and it is validated by the schema when it is executed.
+type Document struct {
+	*ast.QueryDocument
+
+	source string
+}
+
+// Parse parses the query, the syntax error is returned as a query error with its location.
+func Parse(query string) (*Document, *gqlerrors.QueryError) {
+	doc, err := parser.ParseQuery(&ast.Source{Input: query})
+	if err != nil {
+		return nil, queryError(err)
+	}
+	return &Document{QueryDocument: doc, source: query}, nil
+}
+
+// Operation returns the operation which is selected by the operationName,
+// the operationName can be empty if the document has only one operation.
+// The errors are the ones of graphql-go for the same documents.
+func (d *Document) Operation(operationName string) (*ast.OperationDefinition, error) {
+	switch {
+	case len(d.Operations) == 0:
+		return nil, errors.New("no operations in query document")
+	case operationName == "" && len(d.Operations) > 1:
+		return nil, errors.New("more than one operation in query document and no operation name given")
+	case operationName == "":
+		return d.Operations[0], nil
+	}
+
+	if op := d.Operations.ForName(operationName); op != nil {
+		return op, nil
+	}
+	return nil, errors.Errorf("no operation with name %q", operationName)
+}
+
+// Offset returns the byte offset of the position in the source of the document.
+func (d *Document) Offset(pos *ast.Position) int {
+	// The positions of the parser count runes.
+	runes := 0
+	for offset := range d.source {
+		if runes == pos.Start {
+			return offset
+		}
+		runes++
+	}
+	return len(d.source)
+}
+
+// Source returns the query text of the document.
+func (d *Document) Source() string {
+	return d.source
+}
+
+// OperationType returns the keyword of the operation which is selected by the operationName,
+// the query shorthand is a query. Empty string is returned if the query can not be parsed
+// or no operation is selected.
+func OperationType(query, operationName string) string {
+	doc, parseErr := Parse(query)
+	if parseErr != nil {
+		return ""
+	}
+	op, err := doc.Operation(operationName)
+	if err != nil {
+		return ""
+	}
+	return string(op.Operation)
+}
+
+func queryError(err error) *gqlerrors.QueryError {
+	gqlErr, ok := err.(*gqlerror.Error)
+	if !ok {
+		return gqlerrors.Errorf("%s", err)
+	}
+
+	qe := gqlerrors.Errorf("%s", gqlErr.Message)
+	for _, loc := range gqlErr.Locations {
+		qe.Locations = append(qe.Locations, gqlerrors.Location{Line: loc.Line, Column: loc.Column})
+	}
+	return qe
+}
@@ -0,0 +1,82 @@
+package gqldoc
+
+import (
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		description   string
+		query         string
+		operationName string
+		expect        string
+		expectErr     bool
+	}{
+		{
+			description: "testing query shorthand case",
+			query:       `{ subscription: oneArticle(articleId: 1) { id } }`,
+			expect:      "query",
+		},
+		{
+			description:   "testing operation name case",
+			query:         "# subscription\nfragment f on Article { id }\nquery Q { oneArticle(articleId: 1) { ...f } } subscription S($s: ID = \"subscription\") { articleUpdated(sectionId: $s) { id } }",
+			operationName: "S",
+			expect:        "subscription",
+		},
+		{
+			description:   "testing unknown operation case",
+			query:         `query Q { oneArticle(articleId: 1) { id } }`,
+			operationName: "S",
+			expect:        "",
+		},
+		{
+			description: "testing no operation name for many operations case",
+			query:       `subscription A { articleUpdated { id } } subscription B { categoriesChanged { id } }`,
+			expect:      "",
+		},
+		{
+			description: "testing syntax error case",
+			query:       `{ allCategories { edges { node { name } } }`,
+			expectErr:   true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			doc, err := Parse(tt.query)
+			if tt.expectErr {
+				if err == nil || len(err.Locations) == 0 {
+					t.Errorf("[%s] expect an error with the location, actual:%v", tt.description, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("[%s] expect no error, actual:%v", tt.description, err)
+			}
+
+			actual := ""
+			if op, err := doc.Operation(tt.operationName); err == nil {
+				actual = string(op.Operation)
+			}
+			if actual != tt.expect {
+				t.Errorf("[%s] expect:%q, actual:%q", tt.description, tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestOffset(t *testing.T) {
+	doc, err := Parse("# 標題\nsubscription S { articleUpdated { id } }")
+	if err != nil {
+		t.Fatalf("parse failed:%v", err)
+	}
+
+	op, operr := doc.Operation("S")
+	if operr != nil {
+		t.Fatalf("operation failed:%v", operr)
+	}
+	offset := doc.Offset(op.Position)
+	if actual := doc.Source()[offset:]; actual != "subscription S { articleUpdated { id } }" {
+		t.Errorf("expect the offset of the operation, actual:%q", actual)
+	}
+}
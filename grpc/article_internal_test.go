@@ -776,6 +776,28 @@ func TestGetTopArticles(t *testing.T) {
 			expectErr: true,
 			expect:    nil,
 		},
+		{
+			description: "testing window days out of range case",
+			input: &protobuf.GetTopArticlesRequest{
+				CountryCode: protobuf.CountryCode_COUNTRY_CODE_TW,
+				Locale:      protobuf.Locale_LOCALE_EN_US,
+				TopN:        5,
+				WindowDays:  -1,
+			},
+			expectErr: true,
+			expect:    nil,
+		},
+		{
+			description: "testing invalid section id case",
+			input: &protobuf.GetTopArticlesRequest{
+				CountryCode: protobuf.CountryCode_COUNTRY_CODE_TW,
+				Locale:      protobuf.Locale_LOCALE_EN_US,
+				TopN:        5,
+				SectionId:   "abc",
+			},
+			expectErr: true,
+			expect:    nil,
+		},
 	}
 
 	for _, tt := range testCases {
@@ -0,0 +1,140 @@
+package grpc
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/h2non/gock"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/honestbee/Zen/antispam"
+	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/gateway"
+	"github.com/honestbee/Zen/protobuf"
+	"github.com/honestbee/Zen/session"
+	"github.com/honestbee/Zen/zendesk"
+)
+
+func TestGatewayVoteArticle(t *testing.T) {
+	defer gock.Off()
+
+	// Only the first vote of each client is forwarded to zendesk.
+	gock.New("https://honestbeehelp-tw.zendesk.com/").
+		Post("/hc/en-us/articles/3345681/vote").
+		Times(2).
+		Reply(http.StatusOK).
+		JSON(&zendesk.Vote{ID: 360002569614, VoteSum: 1, VoteCount: 1, Value: "up"})
+
+	logger := zerolog.New(ioutil.Discard)
+	s := initServer()
+	s.conf.Votes = &config.Votes{DeviceSecret: "secret", MaxPerIP: 1, IPWindowSec: 60}
+
+	gw, err := gateway.New(s.conf, &logger, false)
+	if err != nil {
+		t.Fatalf("new gateway failed:%v", err)
+	}
+	defer gw.Close()
+
+	srv := grpc.NewServer(grpc.UnaryInterceptor(grpcmiddleware.ChainUnaryServer(
+		logUnaryInterceptor(&logger),
+		session.UnaryServerInterceptor(),
+	)))
+	protobuf.RegisterZendeskServer(srv, s)
+	go srv.Serve(gw.Listener())
+	defer srv.Stop()
+
+	vote := func(remoteIP, deviceID string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/v1/articles/3345681/vote",
+			strings.NewReader(`{"countryCode":"COUNTRY_CODE_TW","locale":"LOCALE_EN_US","vote":"VOTE_UP"}`))
+		if deviceID != "" {
+			r.Header.Set(session.DeviceHeader, deviceID)
+		}
+		r = r.WithContext(antispam.WithRemoteIP(r.Context(), remoteIP))
+		w := httptest.NewRecorder()
+		gw.ServeHTTP(w, r)
+		return w
+	}
+
+	first := vote("203.0.113.7", "")
+	if first.Code != http.StatusOK {
+		t.Fatalf("[first vote] expect status:%d, actual:%d %s", http.StatusOK, first.Code, first.Body.String())
+	}
+	deviceID := first.Header().Get(session.DeviceHeader)
+	if !session.VerifyDeviceID("secret", deviceID) {
+		t.Fatalf("[first vote] expect a signed device id, actual:%q", deviceID)
+	}
+
+	// The forwarded device id keeps the voter, the same vote is a duplicate not counted by the ip cap.
+	second := vote("203.0.113.7", deviceID)
+	if second.Code != http.StatusOK {
+		t.Errorf("[same device vote] expect status:%d, actual:%d %s", http.StatusOK, second.Code, second.Body.String())
+	}
+	if issued := second.Header().Get(session.DeviceHeader); issued != "" {
+		t.Errorf("[same device vote] expect no device id issued, actual:%q", issued)
+	}
+
+	// The forwarded client ip keys the cap, the votes of the other clients are not capped by the gateway peer.
+	if other := vote("203.0.113.8", ""); other.Code != http.StatusOK {
+		t.Errorf("[other client vote] expect status:%d, actual:%d %s", http.StatusOK, other.Code, other.Body.String())
+	}
+
+	// A new device id from the same ip doesn't reset the cap.
+	if again := vote("203.0.113.8", ""); again.Code != http.StatusTooManyRequests {
+		t.Errorf("[new device vote] expect status:%d, actual:%d %s", http.StatusTooManyRequests, again.Code, again.Body.String())
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("expect all the zendesk requests are sent, pending:%d", len(gock.Pending()))
+	}
+}
+
+func TestPeerIP(t *testing.T) {
+	forwarded := metadata.Pairs(antispam.RemoteIPMetadata, "203.0.113.7")
+
+	testCases := [...]struct {
+		description string
+		addr        net.Addr
+		md          metadata.MD
+		expect      string
+	}{
+		{
+			description: "testing tcp peer case",
+			addr:        &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 34567},
+			expect:      "10.0.0.1",
+		},
+		{
+			description: "testing tcp peer forwarding ip case",
+			addr:        &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 34567},
+			md:          forwarded,
+			expect:      "10.0.0.1",
+		},
+		{
+			description: "testing gateway peer forwarding ip case",
+			addr:        bufconn.Listen(1).Addr(),
+			md:          forwarded,
+			expect:      "203.0.113.7",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: tt.addr})
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+			if actual := peerIP(ctx); actual != tt.expect {
+				t.Errorf("[%s] expect:%q, actual:%q", tt.description, tt.expect, actual)
+			}
+		})
+	}
+}
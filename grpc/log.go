@@ -2,15 +2,22 @@ package grpc
 
 import (
 	"context"
+	"net"
 
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 
+	"github.com/honestbee/Zen/antispam"
 	"github.com/honestbee/Zen/errs"
+	"github.com/honestbee/Zen/redact"
 )
 
+// bufconnNetwork is the network of the in process connections of the gateway.
+const bufconnNetwork = "bufconn"
+
 func logUnaryInterceptor(logger *zerolog.Logger) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		var remoteAddr string
@@ -36,7 +43,7 @@ func logUnaryInterceptor(logger *zerolog.Logger) grpc.UnaryServerInterceptor {
 				logger.Info().Fields(map[string]interface{}{
 					"from":  remoteAddr,
 					"path":  info.FullMethod,
-					"error": er.Error(),
+					"error": redact.String(er.Error()),
 				}).Msgf("grpc unary error occurred")
 			}
 		}
@@ -44,3 +51,61 @@ func logUnaryInterceptor(logger *zerolog.Logger) grpc.UnaryServerInterceptor {
 		return resp, err
 	}
 }
+
+func logStreamInterceptor(logger *zerolog.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		var remoteAddr string
+		if p, ok := peer.FromContext(ss.Context()); ok {
+			remoteAddr = p.Addr.String()
+		}
+
+		logger.Info().Fields(map[string]interface{}{
+			"from": remoteAddr,
+			"path": info.FullMethod,
+		}).Msgf("receiving stream")
+
+		err := handler(srv, ss)
+
+		if err != nil {
+			er, ok := err.(*errs.Error)
+			if !ok {
+				er = errs.NewErr(errs.ServerInternalErrorCode, err)
+			}
+			if er.InternalErr != nil {
+				err = status.Error(er.GRPCStatus, er.OutputErr)
+
+				logger.Info().Fields(map[string]interface{}{
+					"from":  remoteAddr,
+					"path":  info.FullMethod,
+					"error": redact.String(er.Error()),
+				}).Msgf("grpc stream error occurred")
+			}
+		}
+
+		return err
+	}
+}
+
+// peerIP returns the ip of the client, it is empty if the peer is unknown. The ip forwarded by the gateway
+// is preferred for the in process calls, whose peer is the bufconn listener, the metadata of the other
+// peers is ignored since it is set by the clients.
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	if p.Addr.Network() == bufconnNetwork {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(antispam.RemoteIPMetadata); len(values) > 0 {
+				return values[0]
+			}
+		}
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
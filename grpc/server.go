@@ -14,15 +14,23 @@ import (
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
-	grpctrace "gopkg.in/DataDog/dd-trace-go.v1/contrib/google.golang.org/grpc"
 
+	"github.com/honestbee/Zen/auth"
 	"github.com/honestbee/Zen/config"
 	"github.com/honestbee/Zen/errs"
 	"github.com/honestbee/Zen/examiner"
+	"github.com/honestbee/Zen/health"
 	"github.com/honestbee/Zen/inout"
+	"github.com/honestbee/Zen/metrics"
 	"github.com/honestbee/Zen/models"
 	"github.com/honestbee/Zen/protobuf"
+	"github.com/honestbee/Zen/redact"
+	"github.com/honestbee/Zen/session"
+	"github.com/honestbee/Zen/subscription"
+	"github.com/honestbee/Zen/tracing"
+	"github.com/honestbee/Zen/votes"
 	"github.com/honestbee/Zen/zendesk"
 )
 
@@ -32,6 +40,8 @@ type server struct {
 	service  models.Service
 	examiner *examiner.Examiner
 	zend     *zendesk.ZenDesk
+	broker   *subscription.Broker
+	votes    *votes.Ledger
 }
 
 // New register ZendeskServer instance to gRPC server and returns it.
@@ -39,12 +49,26 @@ func New(conf *config.Config,
 	logger *zerolog.Logger,
 	service models.Service,
 	examiner *examiner.Examiner,
-	zend *zendesk.ZenDesk) (*grpc.Server, error) {
-	// Initialize the grpc server as normal, using the tracing and logging interceptor.
+	zend *zendesk.ZenDesk,
+	broker *subscription.Broker,
+	checker *health.Checker,
+	authn *auth.Authenticator) (*grpc.Server, error) {
+	scopes := methodScopes(conf)
+
+	// Initialize the grpc server as normal, using the metrics, tracing, logging and authentication interceptor.
 	s := grpc.NewServer(
 		grpc.UnaryInterceptor(grpcmiddleware.ChainUnaryServer(
+			metrics.UnaryServerInterceptor(),
 			logUnaryInterceptor(logger),
-			grpctrace.UnaryServerInterceptor(grpctrace.WithServiceName("helpcenter-zendesk-grpc")),
+			tracing.UnaryServerInterceptor(),
+			auth.UnaryServerInterceptor(authn, scopes),
+			session.UnaryServerInterceptor(),
+		)),
+		grpc.StreamInterceptor(grpcmiddleware.ChainStreamServer(
+			metrics.StreamServerInterceptor(),
+			logStreamInterceptor(logger),
+			tracing.StreamServerInterceptor(),
+			auth.StreamServerInterceptor(authn, scopes),
 		)),
 	)
 
@@ -55,14 +79,30 @@ func New(conf *config.Config,
 		service:  service,
 		examiner: examiner,
 		zend:     zend,
+		broker:   broker,
+		votes:    votes.New(conf, service, zend),
 	})
 
+	// Register the standard health service keeping the status of the dependencies.
+	healthpb.RegisterHealthServer(s, checker.Server())
+
 	// Register reflection service on gRPC server.
 	reflection.Register(s)
 
 	return s, nil
 }
 
+// methodScopes returns the scopes required by the methods keyed by the full method names.
+func methodScopes(conf *config.Config) map[string]string {
+	scopes := map[string]string{
+		"/protobuf.Zendesk/SetForceSync": auth.ScopeSyncWrite,
+	}
+	if conf.Auth.TicketsScopeRequired {
+		scopes["/protobuf.Zendesk/SetCreateRequest"] = auth.ScopeTicketsCreate
+	}
+	return scopes
+}
+
 func (s *server) GetCategories(ctx context.Context, in *protobuf.GetCategoriesRequest) (*protobuf.GetCategoriesResponse, error) {
 	perPage, page := inout.ProcessPage(in.PerPage, in.Page)
 
@@ -92,7 +132,7 @@ func (s *server) GetCategories(ctx context.Context, in *protobuf.GetCategoriesRe
 
 	categoriesOut := &protobuf.GetCategoriesResponse{
 		PageInfo: &protobuf.PageInfo{
-			Page:      int32(math.Round(float64(page)/float64(perPage))) + 1,
+			Page:      int32(inout.CurrentPage(int(page), int(perPage))),
 			PerPage:   int32(perPage),
 			PageCount: int32(math.Ceil(float64(total) / float64(perPage))),
 			Count:     int32(total),
@@ -296,7 +336,7 @@ func (s *server) GetSections(ctx context.Context, in *protobuf.GetSectionsReques
 
 		sectionsOut := &protobuf.GetSectionsResponse{
 			PageInfo: &protobuf.PageInfo{
-				Page:      int32(math.Round(float64(page)/float64(perPage))) + 1,
+				Page:      int32(inout.CurrentPage(int(page), int(perPage))),
 				PerPage:   int32(perPage),
 				PageCount: int32(math.Ceil(float64(total) / float64(perPage))),
 				Count:     int32(total),
@@ -363,7 +403,7 @@ func (s *server) GetSections(ctx context.Context, in *protobuf.GetSectionsReques
 
 		sectionsOut := &protobuf.GetSectionsResponse{
 			PageInfo: &protobuf.PageInfo{
-				Page:      int32(math.Round(float64(page)/float64(perPage))) + 1,
+				Page:      int32(inout.CurrentPage(int(page), int(perPage))),
 				PerPage:   int32(perPage),
 				PageCount: int32(math.Ceil(float64(total) / float64(perPage))),
 				Count:     int32(total),
@@ -536,7 +576,7 @@ func (s *server) GetArticles(ctx context.Context, in *protobuf.GetArticlesReques
 
 		out := &protobuf.GetArticlesResponse{
 			PageInfo: &protobuf.PageInfo{
-				Page:      int32(math.Round(float64(page)/float64(perPage))) + 1,
+				Page:      int32(inout.CurrentPage(int(page), int(perPage))),
 				PerPage:   int32(perPage),
 				PageCount: int32(math.Ceil(float64(total) / float64(perPage))),
 				Count:     int32(total),
@@ -613,7 +653,7 @@ func (s *server) GetArticles(ctx context.Context, in *protobuf.GetArticlesReques
 
 		out := &protobuf.GetArticlesResponse{
 			PageInfo: &protobuf.PageInfo{
-				Page:      int32(math.Round(float64(page)/float64(perPage))) + 1,
+				Page:      int32(inout.CurrentPage(int(page), int(perPage))),
 				PerPage:   int32(perPage),
 				PageCount: int32(math.Ceil(float64(total) / float64(perPage))),
 				Count:     int32(total),
@@ -690,7 +730,7 @@ func (s *server) GetArticles(ctx context.Context, in *protobuf.GetArticlesReques
 
 		out := &protobuf.GetArticlesResponse{
 			PageInfo: &protobuf.PageInfo{
-				Page:      int32(math.Round(float64(page)/float64(perPage))) + 1,
+				Page:      int32(inout.CurrentPage(int(page), int(perPage))),
 				PerPage:   int32(perPage),
 				PageCount: int32(math.Ceil(float64(total) / float64(perPage))),
 				Count:     int32(total),
@@ -736,11 +776,37 @@ func (s *server) GetArticles(ctx context.Context, in *protobuf.GetArticlesReques
 }
 
 func (s *server) GetTopArticles(ctx context.Context, in *protobuf.GetTopArticlesRequest) (*protobuf.GetTopArticlesResponse, error) {
-	articles, err := s.service.GetTopNArticles(ctx,
-		uint64(in.TopN),
-		inout.GRPCLocaleMap[in.Locale],
-		inout.GRPCCountryCodeMap[in.CountryCode],
-	)
+	if in.WindowDays < 0 || in.WindowDays > models.MaxTopNArticlesWindowDays {
+		return nil, errs.NewErr(
+			errs.InvalidAttributeErrorCode,
+			errors.Errorf("grpc: [GetTopNArticles] windowDays:%d is not between 0 and %d", in.WindowDays, models.MaxTopNArticlesWindowDays),
+		)
+	}
+	params := &models.GetTopNArticlesParams{
+		TopN:        uint64(in.TopN),
+		Locale:      inout.GRPCLocaleMap[in.Locale],
+		CountryCode: inout.GRPCCountryCodeMap[in.CountryCode],
+		WindowDays:  int(in.WindowDays),
+	}
+	var err error
+	if in.SectionId != "" {
+		if params.SectionID, err = strconv.Atoi(in.SectionId); err != nil {
+			return nil, errs.NewErr(
+				errs.InvalidAttributeErrorCode,
+				errors.Wrapf(err, "grpc: [GetTopNArticles] parse sectionId failed"),
+			)
+		}
+	}
+	if in.CategoryId != "" {
+		if params.CategoryID, err = strconv.Atoi(in.CategoryId); err != nil {
+			return nil, errs.NewErr(
+				errs.InvalidAttributeErrorCode,
+				errors.Wrapf(err, "grpc: [GetTopNArticles] parse categoryId failed"),
+			)
+		}
+	}
+
+	articles, err := s.service.GetTopNArticles(ctx, params)
 	if err != nil {
 		if err == models.ErrNotFound {
 			return nil, errs.NewErr(
@@ -802,7 +868,14 @@ func (s *server) GetArticle(ctx context.Context, in *protobuf.GetArticleRequest)
 	}
 
 	defer s.examiner.CheckArticles(ctx, inout.GRPCCountryCodeMap[in.CountryCode], inout.GRPCLocaleMap[in.Locale])
-	defer s.service.PlusOneArticleClickCounter(ctx, articleID, inout.GRPCLocaleMap[in.Locale], inout.GRPCCountryCodeMap[in.CountryCode])
+	defer s.service.RecordArticleView(ctx, articleID, inout.GRPCLocaleMap[in.Locale], inout.GRPCCountryCodeMap[in.CountryCode])
+	defer s.service.RecordSessionEvent(ctx, &models.SessionEvent{
+		SessionID:   session.IDFromContext(ctx),
+		Kind:        models.ViewSessionEvent,
+		ArticleID:   articleID,
+		CountryCode: inout.GRPCCountryCodeMap[in.CountryCode],
+		Locale:      inout.GRPCLocaleMap[in.Locale],
+	})
 
 	article, err := s.service.GetArticleByArticleID(ctx,
 		articleID,
@@ -1017,6 +1090,15 @@ func (s *server) GetSearchTitleArticles(ctx context.Context, in *protobuf.GetSea
 			errors.Wrapf(err, "grpc: [InstantSearch] failed"),
 		)
 	}
+	s.service.RecordSearchQuery(ctx, in.Query,
+		inout.GRPCLocaleMap[in.Locale], inout.GRPCCountryCodeMap[in.CountryCode], len(zendeskInstantSearch.Results))
+	s.service.RecordSessionEvent(ctx, &models.SessionEvent{
+		SessionID:   session.IDFromContext(ctx),
+		Kind:        models.SearchSessionEvent,
+		Query:       in.Query,
+		CountryCode: inout.GRPCCountryCodeMap[in.CountryCode],
+		Locale:      inout.GRPCLocaleMap[in.Locale],
+	})
 
 	out := &protobuf.GetSearchTitleArticlesResponse{
 		Articles: make([]*protobuf.SearchTitleArticle, 0),
@@ -1053,7 +1135,7 @@ func (s *server) GetSearchBodyArticles(ctx context.Context, in *protobuf.GetSear
 		inout.GRPCCountryCodeMap[in.CountryCode], inout.GRPCLocaleMap[in.Locale],
 		&zendesk.Pagination{
 			PerPage:   int(perPage),
-			Page:      int(math.Round(float64(page)/float64(perPage))) + 1,
+			Page:      inout.CurrentPage(int(page), int(perPage)),
 			SortOrder: inout.GRPCSortOrderMap[in.SortOrder],
 		})
 	if err != nil {
@@ -1062,6 +1144,18 @@ func (s *server) GetSearchBodyArticles(ctx context.Context, in *protobuf.GetSear
 			errors.Wrapf(err, "grpc: [Search] failed"),
 		)
 	}
+	// Paging through the results isn't counted as another search.
+	if zendeskSearch.Page == 1 {
+		s.service.RecordSearchQuery(ctx, in.Query,
+			inout.GRPCLocaleMap[in.Locale], inout.GRPCCountryCodeMap[in.CountryCode], zendeskSearch.Count)
+		s.service.RecordSessionEvent(ctx, &models.SessionEvent{
+			SessionID:   session.IDFromContext(ctx),
+			Kind:        models.SearchSessionEvent,
+			Query:       in.Query,
+			CountryCode: inout.GRPCCountryCodeMap[in.CountryCode],
+			Locale:      inout.GRPCLocaleMap[in.Locale],
+		})
+	}
 
 	out := &protobuf.GetSearchBodyArticlesResponse{
 		PageInfo: &protobuf.PageInfo{
@@ -1178,14 +1272,29 @@ func (s *server) SetCreateRequest(ctx context.Context, in *protobuf.SetCreateReq
 		request.Data.Request.CustomFields = &customFields
 	}
 
+	// The viewed articles are attached on a best effort basis, the request is filed without them on failure.
+	if sessionID := session.IDFromContext(ctx); sessionID != "" {
+		if articleIDs, err := s.service.GetSessionViewedArticles(ctx, sessionID); err == nil {
+			request.Data.AttachViewedArticles(s.conf.Analytics.DeflectionFieldID, articleIDs)
+		}
+	}
+
 	if err := s.zend.CreateRequest(ctx, request.CountryCode, request.Data); err != nil {
 		return &protobuf.SetCreateRequestResponse{
 				Status: http.StatusText(http.StatusBadRequest),
 			}, errs.NewErr(
 				errs.InvalidAttributeErrorCode,
-				errors.Wrapf(err, "grpc: [CreateRequest] failed"),
+				errors.Wrapf(
+					redact.Error(err, request.Data.Secrets(s.conf)...),
+					"grpc: [CreateRequest] failed",
+				),
 			)
 	}
+	s.service.RecordSessionEvent(ctx, &models.SessionEvent{
+		SessionID:   session.IDFromContext(ctx),
+		Kind:        models.RequestSessionEvent,
+		CountryCode: request.CountryCode,
+	})
 
 	return &protobuf.SetCreateRequestResponse{
 		Status: http.StatusText(http.StatusCreated),
@@ -1201,20 +1310,29 @@ func (s *server) SetVoteArticle(ctx context.Context, in *protobuf.SetVoteArticle
 		)
 	}
 
-	voteResult, err := s.zend.CreateVote(ctx,
-		articleID,
-		inout.GRPCVoteMap[in.Vote],
-		inout.GRPCCountryCodeMap[in.CountryCode],
-		inout.GRPCLocaleMap[in.Locale],
-	)
+	remoteIP := peerIP(ctx)
+	voteResult, err := s.votes.Cast(ctx, &votes.Ballot{
+		ArticleID:   articleID,
+		Value:       inout.GRPCVoteMap[in.Vote],
+		Voter:       session.Voter(ctx, remoteIP, s.conf.Votes.DeviceSecret),
+		RemoteIP:    remoteIP,
+		CountryCode: inout.GRPCCountryCodeMap[in.CountryCode],
+		Locale:      inout.GRPCLocaleMap[in.Locale],
+	})
 	if err != nil {
-		return nil, errs.NewErr(
-			errs.ServerInternalErrorCode,
-			errors.Wrapf(err, "grpc: [CreateVote] failed"),
-		)
+		return nil, votes.CastErr(err, "grpc: [CreateVote] failed")
 	}
 
-	defer s.examiner.SyncArticle(ctx, articleID, inout.GRPCCountryCodeMap[in.CountryCode], inout.GRPCLocaleMap[in.Locale])
+	if !voteResult.Duplicate {
+		defer s.examiner.SyncArticle(ctx, articleID, inout.GRPCCountryCodeMap[in.CountryCode], inout.GRPCLocaleMap[in.Locale])
+		s.service.RecordSessionEvent(ctx, &models.SessionEvent{
+			SessionID:   session.IDFromContext(ctx),
+			Kind:        models.VoteSessionEvent,
+			ArticleID:   articleID,
+			CountryCode: inout.GRPCCountryCodeMap[in.CountryCode],
+			Locale:      inout.GRPCLocaleMap[in.Locale],
+		})
+	}
 
 	article, err := s.service.GetArticleByArticleID(ctx,
 		articleID,
@@ -1265,14 +1383,8 @@ func (s *server) SetVoteArticle(ctx context.Context, in *protobuf.SetVoteArticle
 	return &protobuf.SetVoteArticleResponse{Article: outArticle}, nil
 }
 
+// SetForceSync triggers the force sync jobs, the sync:write scope is required by the auth interceptor.
 func (s *server) SetForceSync(ctx context.Context, in *protobuf.SetForceSyncRequest) (*protobuf.SetForceSyncResponse, error) {
-	if s.conf.HTTP.BasicAuthUser != in.Username || s.conf.HTTP.BasicAuthPwd != in.Password {
-		return nil, errs.NewErr(
-			errs.UnauthorizedErrCode,
-			errors.New("grpc: [SetForceSync] failed"),
-		)
-	}
-
 	go func() {
 		// Loop by countryCode.
 		for countryCode, locales := range inout.SupportCountryLocaleMap {
@@ -1302,3 +1414,209 @@ func (s *server) SetForceSync(ctx context.Context, in *protobuf.SetForceSyncRequ
 
 	return &protobuf.SetForceSyncResponse{Status: inout.SuccessForceSync}, nil
 }
+
+// StreamArticles sends all the articles of the country and the locale updated since the given time,
+// the articles are read by batches in the order of updated_at and id.
+func (s *server) StreamArticles(in *protobuf.StreamArticlesRequest, stream protobuf.Zendesk_StreamArticlesServer) error {
+	params := &models.ExportArticlesParams{
+		Locale:      inout.GRPCLocaleMap[in.Locale],
+		CountryCode: inout.GRPCCountryCodeMap[in.CountryCode],
+		Limit:       s.conf.GRPC.StreamBatchSize,
+	}
+	if in.Since != nil {
+		since, err := ptypes.Timestamp(in.Since)
+		if err != nil {
+			return errs.NewErr(
+				errs.InvalidAttributeErrorCode,
+				errors.Wrapf(err, "grpc: [StreamArticles] invalid since"),
+			)
+		}
+		params.Since = since
+	}
+
+	for {
+		articles, err := s.service.ExportArticles(stream.Context(), params)
+		if err != nil {
+			return errs.NewErr(
+				errs.ServerInternalErrorCode,
+				errors.Wrapf(err, "grpc: [StreamArticles] failed"),
+			)
+		}
+
+		for _, article := range articles {
+			outArticle := &protobuf.Article{
+				Id:              strconv.Itoa(article.ID),
+				AuthorId:        strconv.Itoa(article.AuthorID),
+				CommentsDisable: article.CommentsDisable,
+				Draft:           article.Draft,
+				Promoted:        article.Promoted,
+				Position:        int32(article.Position),
+				VoteSum:         int32(article.VoteSum),
+				VoteCount:       int32(article.VoteCount),
+				SourceLocale:    article.SourceLocale,
+				Outdated:        article.Outdated,
+				OutdatedLocales: article.OutdatedLocales,
+				LabelNames:      article.LabelNames,
+				CountryCode:     article.CountryCode,
+				Url:             article.URL,
+				HtmlUrl:         article.HTMLURL,
+				Name:            article.Name,
+				Title:           article.Title,
+				Body:            article.Body,
+				Locale:          article.Locale,
+				SectionId:       strconv.Itoa(article.SectionID),
+			}
+			// Due to timestamp format needs to be convert,
+			// we cannot use json Marshal + Unmarshal to converts format.
+			outArticle.CreatedAt, _ = ptypes.TimestampProto(article.CreatedAt)
+			outArticle.UpdatedAt, _ = ptypes.TimestampProto(article.UpdatedAt)
+			outArticle.EditedAt, _ = ptypes.TimestampProto(article.EditedAt)
+
+			if err = stream.Send(&protobuf.StreamArticlesResponse{Article: outArticle}); err != nil {
+				return errors.Wrapf(err, "grpc: [StreamArticles] send failed")
+			}
+		}
+
+		if len(articles) < params.Limit {
+			return nil
+		}
+		params.After = articles[len(articles)-1].Cursor()
+	}
+}
+
+// WatchChanges sends the content change events of the country and the locale until the client leaves.
+// The events published after the resume token are sent first, so that a reconnecting client
+// does not miss the events as long as they are still kept in the events log.
+func (s *server) WatchChanges(in *protobuf.WatchChangesRequest, stream protobuf.Zendesk_WatchChangesServer) error {
+	if s.broker == nil {
+		return errs.NewErr(
+			errs.ServerInternalErrorCode,
+			errors.New("grpc: [WatchChanges] subscriptions are not supported"),
+		)
+	}
+
+	ctx := stream.Context()
+	w := &changesWatcher{
+		server:      s,
+		stream:      stream,
+		countryCode: inout.GRPCCountryCodeMap[in.CountryCode],
+		locale:      inout.GRPCLocaleMap[in.Locale],
+	}
+
+	// Subscribing before replaying the log leaves no gap between the replayed and the live events.
+	events, err := s.broker.Subscribe(ctx)
+	if err != nil {
+		return errs.NewErr(
+			errs.ServerInternalErrorCode,
+			errors.Wrapf(err, "grpc: [WatchChanges] broker.Subscribe failed"),
+		)
+	}
+
+	if in.ResumeToken != "" {
+		seq, err := strconv.ParseInt(in.ResumeToken, 10, 64)
+		if err != nil {
+			return errs.NewErr(
+				errs.InvalidAttributeErrorCode,
+				errors.Wrapf(err, "grpc: [WatchChanges] invalid resume token"),
+			)
+		}
+		if err = w.replay(ctx, seq); err != nil {
+			return err
+		}
+	}
+
+	for event := range events {
+		if w.resumed && event.Seq <= w.last {
+			continue
+		}
+		// The events dropped by the broker are read from the log.
+		if w.resumed && event.Seq > w.last+1 {
+			if err = w.replay(ctx, w.last); err != nil {
+				return err
+			}
+			if event.Seq <= w.last {
+				continue
+			}
+		}
+		if err = w.send(event); err != nil {
+			return err
+		}
+	}
+
+	if ctx.Err() != nil {
+		return nil
+	}
+	return errs.NewErr(
+		errs.ServerInternalErrorCode,
+		errors.Wrapf(subscription.ErrBrokerClosed, "grpc: [WatchChanges] subscription ended"),
+	)
+}
+
+// changesWatcher keeps the sequence number of the last event handled by a WatchChanges stream.
+type changesWatcher struct {
+	server      *server
+	stream      protobuf.Zendesk_WatchChangesServer
+	countryCode string
+	locale      string
+
+	// resumed is false until the first event is handled, then last is known.
+	resumed bool
+	last    int64
+}
+
+// replay sends the events published after seq from the events log.
+func (w *changesWatcher) replay(ctx context.Context, seq int64) error {
+	w.resumed, w.last = true, seq
+
+	limit := w.server.conf.GRPC.StreamBatchSize
+	for {
+		events, err := w.server.service.GetEventsAfter(ctx, w.last, limit)
+		if err != nil {
+			if err == models.ErrEventsExpired {
+				return errs.NewErr(
+					errs.InvalidAttributeErrorCode,
+					errors.Wrapf(err, "grpc: [WatchChanges] resume token expired"),
+				)
+			}
+			return errs.NewErr(
+				errs.ServerInternalErrorCode,
+				errors.Wrapf(err, "grpc: [WatchChanges] service.GetEventsAfter failed"),
+			)
+		}
+
+		for _, event := range events {
+			if err = w.send(event); err != nil {
+				return err
+			}
+		}
+		if len(events) < limit {
+			return nil
+		}
+	}
+}
+
+// send sends the event if it is of the watched country and locale, the event is handled either way.
+func (w *changesWatcher) send(event *models.Event) error {
+	w.resumed, w.last = true, event.Seq
+
+	changeType, ok := inout.GRPCChangeTypeMap[event.Type]
+	if !ok || event.CountryCode != w.countryCode || event.Locale != w.locale {
+		return nil
+	}
+
+	out := &protobuf.WatchChangesResponse{
+		ResumeToken: strconv.FormatInt(event.Seq, 10),
+		Type:        changeType,
+		CountryCode: event.CountryCode,
+		Locale:      event.Locale,
+		Ids:         make([]string, 0, len(event.IDs)),
+	}
+	for _, id := range event.IDs {
+		out.Ids = append(out.Ids, strconv.Itoa(id))
+	}
+	if event.SectionID != 0 {
+		out.SectionId = strconv.Itoa(event.SectionID)
+	}
+
+	return errors.Wrapf(w.stream.Send(out), "grpc: [WatchChanges] send failed")
+}
@@ -8,6 +8,9 @@ import (
 	"github.com/honestbee/Zen/config"
 	"github.com/honestbee/Zen/examiner"
 	"github.com/honestbee/Zen/models"
+	"github.com/honestbee/Zen/purge"
+	"github.com/honestbee/Zen/subscription"
+	"github.com/honestbee/Zen/votes"
 	"github.com/honestbee/Zen/zendesk"
 )
 
@@ -15,9 +18,20 @@ func initServer() *server {
 	logger := zerolog.New(ioutil.Discard)
 	conf := &config.Config{
 		HTTP: &config.HTTP{
-			BasicAuthUser: "admin",
-			BasicAuthPwd:  "33456783345678",
+			BasicAuthUser:    "admin",
+			BasicAuthPwdHash: "$2a$04$O2KD8USMnvxMoRBiwe/iAuiQPS6DghEYWN8pU9w8BKzR10rGCaWFW",
 		},
+		Auth: &config.Auth{
+			APIKeys: "reader:ec4408df15da46b328f6f3246fa723d0aa6cb0f0a0dd9c4626080ab1b02aa3b2:tickets:create",
+		},
+		GRPC: &config.GRPC{
+			StreamBatchSize: 1,
+		},
+		Subscription: &config.Subscription{
+			BufferSize: 16,
+		},
+		Analytics: &config.Analytics{},
+		Votes:     &config.Votes{},
 	}
 	ms := &models.MockModels{}
 	zend, _ := zendesk.NewZenDesk(&config.Config{
@@ -42,7 +56,8 @@ func initServer() *server {
 			SectionsRefreshLimit:   1000,
 			ArticlesRefreshLimit:   1000,
 		},
-	}, &logger, ms, zend)
+	}, &logger, ms, zend, purge.NopPurger{})
+	broker, _ := subscription.New(conf, &logger, ms)
 
 	return &server{
 		conf:     conf,
@@ -50,5 +65,7 @@ func initServer() *server {
 		service:  ms,
 		examiner: exam,
 		zend:     zend,
+		broker:   broker,
+		votes:    votes.New(conf, ms, zend),
 	}
 }
@@ -0,0 +1,33 @@
+package grpc
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// ShareHandler returns a http handler serving the gRPC requests by s and the others by h,
+// so that gRPC and http share one listener. The gRPC requests without a verified
+// client certificate are rejected if requireClientCert is set.
+func ShareHandler(s *grpc.Server, h http.Handler, requireClientCert bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor != 2 || !strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		if requireClientCert && (r.TLS == nil || len(r.TLS.VerifiedChains) == 0) {
+			// A trailers-only response carrying the gRPC status.
+			w.Header().Set("Content-Type", "application/grpc")
+			w.Header().Set("Grpc-Status", strconv.Itoa(int(codes.Unauthenticated)))
+			w.Header().Set("Grpc-Message", "client certificate required")
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		s.ServeHTTP(w, r)
+	})
+}
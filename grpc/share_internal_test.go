@@ -0,0 +1,74 @@
+package grpc
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func TestShareHandler(t *testing.T) {
+	s := grpc.NewServer()
+	healthpb.RegisterHealthServer(s, grpchealth.NewServer())
+	fallback := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("http"))
+	})
+
+	testCases := [...]struct {
+		description       string
+		requireClientCert bool
+		expectCode        codes.Code
+	}{
+		{
+			description: "testing grpc over h2c case",
+			expectCode:  codes.OK,
+		},
+		{
+			description:       "testing client certificate required case",
+			requireClientCert: true,
+			expectCode:        codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			svr := httptest.NewServer(h2c.NewHandler(ShareHandler(s, fallback, tt.requireClientCert), &http2.Server{}))
+			defer svr.Close()
+
+			// The test client has its own transport, which is not intercepted by gock of the other tests.
+			resp, err := svr.Client().Get(svr.URL)
+			if err != nil {
+				t.Fatalf("[%s] http get failed:%v", tt.description, err)
+			}
+			body, _ := ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+			if string(body) != "http" {
+				t.Errorf("[%s] expect http body, actual:%s", tt.description, body)
+			}
+
+			conn, err := grpc.Dial(strings.TrimPrefix(svr.URL, "http://"), grpc.WithInsecure())
+			if err != nil {
+				t.Fatalf("[%s] grpc dial failed:%v", tt.description, err)
+			}
+			defer conn.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+			if code := status.Code(err); code != tt.expectCode {
+				t.Errorf("[%s] expect code:%v, actual:%v err:%v", tt.description, tt.expectCode, code, err)
+			}
+		})
+	}
+}
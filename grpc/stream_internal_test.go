@@ -0,0 +1,203 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-test/deep"
+	"google.golang.org/grpc"
+
+	"github.com/honestbee/Zen/models"
+	"github.com/honestbee/Zen/protobuf"
+)
+
+// fakeServerStream is the server stream whose context is done,
+// the streaming methods return once the sent messages are kept.
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func newFakeServerStream() fakeServerStream {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	return fakeServerStream{ctx: ctx}
+}
+
+func (f fakeServerStream) Context() context.Context {
+	return f.ctx
+}
+
+type fakeStreamArticlesServer struct {
+	fakeServerStream
+	responses []*protobuf.StreamArticlesResponse
+}
+
+func (f *fakeStreamArticlesServer) Send(res *protobuf.StreamArticlesResponse) error {
+	f.responses = append(f.responses, res)
+	return nil
+}
+
+type fakeWatchChangesServer struct {
+	fakeServerStream
+	responses []*protobuf.WatchChangesResponse
+}
+
+func (f *fakeWatchChangesServer) Send(res *protobuf.WatchChangesResponse) error {
+	f.responses = append(f.responses, res)
+	return nil
+}
+
+func TestStreamArticles(t *testing.T) {
+	s := initServer()
+
+	testCases := [...]struct {
+		description string
+		input       *protobuf.StreamArticlesRequest
+		expectErr   bool
+		expect      []*protobuf.StreamArticlesResponse
+	}{
+		{
+			description: "testing normal case",
+			input: &protobuf.StreamArticlesRequest{
+				CountryCode: protobuf.CountryCode_COUNTRY_CODE_TW,
+				Locale:      protobuf.Locale_LOCALE_EN_US,
+				Since:       models.FixCreatedAtProto1,
+			},
+			expectErr: false,
+			expect: []*protobuf.StreamArticlesResponse{
+				{
+					Article: &protobuf.Article{
+						Id:              "33456710",
+						AuthorId:        "1234567",
+						CreatedAt:       models.FixCreatedAtProto1,
+						UpdatedAt:       models.FixUpdatedAtProto1,
+						SourceLocale:    "en-us",
+						OutdatedLocales: []string{},
+						EditedAt:        models.FixEditedAtProto1,
+						LabelNames:      []string{},
+						CountryCode:     "tw",
+						Url:             "www.honestbee.com",
+						HtmlUrl:         "www.honestbee.com",
+						Name:            "testing article 1",
+						Title:           "testing article 1",
+						Body:            "this is testing article 1",
+						Locale:          "en-us",
+						SectionId:       "33456789",
+					},
+				},
+			},
+		},
+		{
+			description: "testing ExportArticles failed case",
+			input: &protobuf.StreamArticlesRequest{
+				CountryCode: protobuf.CountryCode_COUNTRY_CODE_TH,
+				Locale:      protobuf.Locale_LOCALE_EN_US,
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			stream := &fakeStreamArticlesServer{fakeServerStream: newFakeServerStream()}
+			err := s.StreamArticles(tt.input, stream)
+			if tt.expectErr != (err != nil) {
+				t.Fatalf("[%s] expect error:%t, got:%v", tt.description, tt.expectErr, err)
+			}
+			if diff := deep.Equal(tt.expect, stream.responses); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+}
+
+func TestWatchChanges(t *testing.T) {
+	testCases := [...]struct {
+		description string
+		noBroker    bool
+		input       *protobuf.WatchChangesRequest
+		expectErr   bool
+		expect      []*protobuf.WatchChangesResponse
+	}{
+		{
+			description: "testing resume case",
+			input: &protobuf.WatchChangesRequest{
+				CountryCode: protobuf.CountryCode_COUNTRY_CODE_TW,
+				Locale:      protobuf.Locale_LOCALE_EN_US,
+				ResumeToken: "1",
+			},
+			expectErr: false,
+			expect: []*protobuf.WatchChangesResponse{
+				{
+					ResumeToken: "3",
+					Type:        protobuf.ChangeType_CHANGE_TYPE_ARTICLE_UPDATED,
+					CountryCode: "tw",
+					Locale:      "en-us",
+					Ids:         []string{"3"},
+					SectionId:   "30",
+				},
+				{
+					ResumeToken: "4",
+					Type:        protobuf.ChangeType_CHANGE_TYPE_ARTICLES_DELETED,
+					CountryCode: "tw",
+					Locale:      "en-us",
+					Ids:         []string{"4", "5"},
+				},
+			},
+		},
+		{
+			description: "testing invalid resume token case",
+			input: &protobuf.WatchChangesRequest{
+				CountryCode: protobuf.CountryCode_COUNTRY_CODE_TW,
+				Locale:      protobuf.Locale_LOCALE_EN_US,
+				ResumeToken: "abc",
+			},
+			expectErr: true,
+		},
+		{
+			description: "testing expired resume token case",
+			input: &protobuf.WatchChangesRequest{
+				CountryCode: protobuf.CountryCode_COUNTRY_CODE_TW,
+				Locale:      protobuf.Locale_LOCALE_EN_US,
+				ResumeToken: "-1",
+			},
+			expectErr: true,
+		},
+		{
+			description: "testing no broker case",
+			noBroker:    true,
+			input: &protobuf.WatchChangesRequest{
+				CountryCode: protobuf.CountryCode_COUNTRY_CODE_TW,
+				Locale:      protobuf.Locale_LOCALE_EN_US,
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			s := initServer()
+			if tt.noBroker {
+				s.broker = nil
+			}
+			for _, event := range []*models.Event{
+				{Type: models.EventCategoriesChanged, CountryCode: "tw", Locale: "en-us", IDs: []int{1}},
+				{Type: models.EventSectionsChanged, CountryCode: "sg", Locale: "en-us", IDs: []int{2}},
+				{Type: models.EventArticleUpdated, CountryCode: "tw", Locale: "en-us", IDs: []int{3}, SectionID: 30},
+				{Type: models.EventArticlesDeleted, CountryCode: "tw", Locale: "en-us", IDs: []int{4, 5}},
+			} {
+				s.service.PublishEvent(context.Background(), event)
+			}
+
+			stream := &fakeWatchChangesServer{fakeServerStream: newFakeServerStream()}
+			err := s.WatchChanges(tt.input, stream)
+			if tt.expectErr != (err != nil) {
+				t.Fatalf("[%s] expect error:%t, got:%v", tt.description, tt.expectErr, err)
+			}
+			if diff := deep.Equal(tt.expect, stream.responses); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+}
@@ -5,27 +5,43 @@ import (
 	"testing"
 
 	"github.com/go-test/deep"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 
+	"github.com/honestbee/Zen/auth"
+	"github.com/honestbee/Zen/errs"
 	"github.com/honestbee/Zen/protobuf"
 )
 
 func TestSetForceSync(t *testing.T) {
 	s := initServer()
+	authn, err := auth.New(s.conf)
+	if err != nil {
+		t.Fatalf("new authenticator failed:%v", err)
+	}
+	interceptor := auth.UnaryServerInterceptor(authn, methodScopes(s.conf))
+	info := &grpc.UnaryServerInfo{FullMethod: "/protobuf.Zendesk/SetForceSync"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.SetForceSync(ctx, req.(*protobuf.SetForceSyncRequest))
+	}
 
 	testCases := [...]struct {
 		description string
+		ctx         context.Context
 		input       *protobuf.SetForceSyncRequest
-		expectErr   bool
-		expect      *protobuf.SetForceSyncResponse
+		expectCode  codes.Code
+		expect      interface{}
 	}{
 		/*
 			{
 				description: "testing normal case",
+				ctx:         context.Background(),
 				input: &protobuf.SetForceSyncRequest{
 					Username: "admin",
 					Password: "33456783345678",
 				},
-				expectErr: false,
+				expectCode: codes.OK,
 				expect: &protobuf.SetForceSyncResponse{
 					Status: "success trigger force sync job",
 				},
@@ -33,23 +49,45 @@ func TestSetForceSync(t *testing.T) {
 		*/
 		{
 			description: "testing basic auth failed case",
+			ctx:         context.Background(),
 			input: &protobuf.SetForceSyncRequest{
 				Username: "admin",
 				Password: "",
 			},
-			expectErr: true,
-			expect:    nil,
+			expectCode: codes.Unauthenticated,
+		},
+		{
+			description: "testing no credentials case",
+			ctx:         context.Background(),
+			input:       &protobuf.SetForceSyncRequest{},
+			expectCode:  codes.Unauthenticated,
+		},
+		{
+			description: "testing invalid api key case",
+			ctx:         metadata.NewIncomingContext(context.Background(), metadata.Pairs(auth.APIKeyMetadata, "unknown")),
+			input:       &protobuf.SetForceSyncRequest{},
+			expectCode:  codes.Unauthenticated,
+		},
+		{
+			description: "testing insufficient scope case",
+			ctx:         metadata.NewIncomingContext(context.Background(), metadata.Pairs(auth.APIKeyMetadata, "reader-key")),
+			input:       &protobuf.SetForceSyncRequest{},
+			expectCode:  codes.PermissionDenied,
 		},
 	}
 
 	for _, tt := range testCases {
 		t.Run(tt.description, func(t *testing.T) {
-			resp, err := s.SetForceSync(context.Background(), tt.input)
+			resp, err := interceptor(tt.ctx, tt.input, info, handler)
 
-			if tt.expectErr && err == nil {
-				t.Errorf("[%s] expect an error, actual == nil", tt.description)
-			} else if !tt.expectErr && err != nil {
-				t.Errorf("[%s] expect no error, actual:%v", tt.description, err)
+			code := codes.OK
+			if er, ok := err.(*errs.Error); ok {
+				code = er.GRPCStatus
+			} else if err != nil {
+				code = codes.Unknown
+			}
+			if code != tt.expectCode {
+				t.Errorf("[%s] expect code:%v, actual:%v err:%v", tt.description, tt.expectCode, code, err)
 			} else if diff := deep.Equal(tt.expect, resp); diff != nil {
 				t.Errorf("[%s] %v", tt.description, diff)
 			}
@@ -2,11 +2,13 @@ package grpc
 
 import (
 	"context"
+	"net"
 	"net/http"
 	"testing"
 
 	"github.com/go-test/deep"
 	"github.com/h2non/gock"
+	"google.golang.org/grpc/peer"
 
 	"github.com/honestbee/Zen/models"
 	"github.com/honestbee/Zen/protobuf"
@@ -196,7 +198,10 @@ func TestSetVoteArticle(t *testing.T) {
 
 	for _, tt := range testCases {
 		t.Run(tt.description, func(t *testing.T) {
-			resp, err := s.SetVoteArticle(context.Background(), tt.input)
+			ctx := peer.NewContext(context.Background(), &peer.Peer{
+				Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 50051},
+			})
+			resp, err := s.SetVoteArticle(ctx, tt.input)
 
 			if tt.expectErr && err == nil {
 				t.Errorf("[%s] expect an error, actual == nil", tt.description)
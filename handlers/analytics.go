@@ -0,0 +1,259 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/pkg/errors"
+
+	"github.com/honestbee/Zen/auth"
+	"github.com/honestbee/Zen/errs"
+	"github.com/honestbee/Zen/inout"
+	"github.com/honestbee/Zen/models"
+)
+
+const (
+	defaultSearchQueryStatsLimit      = 20
+	maxSearchQueryStatsLimit          = 100
+	defaultSearchQueryStatsWindowDays = 30
+
+	defaultDeflectionStatsLimit      = 20
+	maxDeflectionStatsLimit          = 100
+	defaultDeflectionStatsWindowDays = 30
+
+	defaultArticleVoteHistoryWindowDays = 30
+)
+
+// GetSearchQueryStatsDecompressor combines params from URL or FORM
+// and returns params in a structure that GetSearchQueryStatsHandler needs.
+func GetSearchQueryStatsDecompressor(ps httprouter.Params, r *http.Request) (interface{}, error) {
+	report := models.SearchQueryReport(ps.ByName("report"))
+	switch report {
+	case models.TopSearchQueries, models.ZeroResultSearchQueries, models.ClickThroughSearchQueries:
+	default:
+		return nil, errs.NewErr(
+			errs.RecordNotFoundErrorCode,
+			errors.Errorf("handlers: [GetSearchQueryStatsDecompressor] unknown report:%q", report),
+		)
+	}
+
+	baseParams, err := inout.FetchBaseParams(r)
+	if err != nil {
+		return nil, errs.NewErr(
+			errs.InvalidAttributeErrorCode,
+			errors.Wrapf(err, "handlers: [GetSearchQueryStatsDecompressor] inout.FetchBaseParams failed"),
+		)
+	}
+
+	limit, err := fetchIntParam(r, "limit", maxSearchQueryStatsLimit)
+	if err != nil {
+		return nil, errs.NewErr(
+			errs.InvalidAttributeErrorCode,
+			errors.Wrapf(err, "handlers: [GetSearchQueryStatsDecompressor] parse limit failed"),
+		)
+	}
+	if limit == 0 {
+		limit = defaultSearchQueryStatsLimit
+	}
+
+	windowDays, err := fetchIntParam(r, "window_days", models.MaxTopNArticlesWindowDays)
+	if err != nil {
+		return nil, errs.NewErr(
+			errs.InvalidAttributeErrorCode,
+			errors.Wrapf(err, "handlers: [GetSearchQueryStatsDecompressor] parse window_days failed"),
+		)
+	}
+	if windowDays == 0 {
+		windowDays = defaultSearchQueryStatsWindowDays
+	}
+
+	return &inout.GetSearchQueryStatsIn{
+		Report:      report,
+		Limit:       uint64(limit),
+		WindowDays:  windowDays,
+		Locale:      baseParams.Locale,
+		CountryCode: baseParams.CountryCode,
+	}, nil
+}
+
+// GetSearchQueryStatsHandler handles get search query stats request, the analytics:read scope is required.
+func GetSearchQueryStatsHandler(ctx context.Context, e *Env, in interface{}) (interface{}, error) {
+	if err := auth.Require(ctx, auth.ScopeAnalyticsRead); err != nil {
+		return nil, err
+	}
+
+	data, ok := in.(*inout.GetSearchQueryStatsIn)
+	if !ok {
+		return nil, errs.NewErr(
+			errs.ServerInternalErrorCode,
+			errors.Errorf("handlers: [GetSearchQueryStatsHandler] cast %v into *GetSearchQueryStatsIn failed", in),
+		)
+	}
+
+	stats, err := e.Service.GetSearchQueryStats(ctx, &models.GetSearchQueryStatsParams{
+		Report:      data.Report,
+		Limit:       data.Limit,
+		WindowDays:  data.WindowDays,
+		Locale:      data.Locale,
+		CountryCode: data.CountryCode,
+	})
+	if err != nil {
+		return nil, errs.NewErr(
+			errs.ServerInternalErrorCode,
+			errors.Wrapf(err, "handlers: [GetSearchQueryStatsHandler] Service.GetSearchQueryStats failed"),
+		)
+	}
+
+	return &inout.GetSearchQueryStatsOut{Queries: stats}, nil
+}
+
+// GetDeflectionStatsDecompressor combines params from URL or FORM
+// and returns params in a structure that GetDeflectionStatsHandler needs.
+func GetDeflectionStatsDecompressor(ps httprouter.Params, r *http.Request) (interface{}, error) {
+	group := models.DeflectionGroup(ps.ByName("group"))
+	switch group {
+	case models.ArticleDeflection, models.CategoryDeflection:
+	default:
+		return nil, errs.NewErr(
+			errs.RecordNotFoundErrorCode,
+			errors.Errorf("handlers: [GetDeflectionStatsDecompressor] unknown group:%q", group),
+		)
+	}
+
+	baseParams, err := inout.FetchBaseParams(r)
+	if err != nil {
+		return nil, errs.NewErr(
+			errs.InvalidAttributeErrorCode,
+			errors.Wrapf(err, "handlers: [GetDeflectionStatsDecompressor] inout.FetchBaseParams failed"),
+		)
+	}
+
+	limit, err := fetchIntParam(r, "limit", maxDeflectionStatsLimit)
+	if err != nil {
+		return nil, errs.NewErr(
+			errs.InvalidAttributeErrorCode,
+			errors.Wrapf(err, "handlers: [GetDeflectionStatsDecompressor] parse limit failed"),
+		)
+	}
+	if limit == 0 {
+		limit = defaultDeflectionStatsLimit
+	}
+
+	windowDays, err := fetchIntParam(r, "window_days", models.MaxTopNArticlesWindowDays)
+	if err != nil {
+		return nil, errs.NewErr(
+			errs.InvalidAttributeErrorCode,
+			errors.Wrapf(err, "handlers: [GetDeflectionStatsDecompressor] parse window_days failed"),
+		)
+	}
+	if windowDays == 0 {
+		windowDays = defaultDeflectionStatsWindowDays
+	}
+
+	return &inout.GetDeflectionStatsIn{
+		Group:       group,
+		Limit:       uint64(limit),
+		WindowDays:  windowDays,
+		Locale:      baseParams.Locale,
+		CountryCode: baseParams.CountryCode,
+	}, nil
+}
+
+// GetDeflectionStatsHandler handles get deflection stats request, the analytics:read scope is required.
+func GetDeflectionStatsHandler(ctx context.Context, e *Env, in interface{}) (interface{}, error) {
+	if err := auth.Require(ctx, auth.ScopeAnalyticsRead); err != nil {
+		return nil, err
+	}
+
+	data, ok := in.(*inout.GetDeflectionStatsIn)
+	if !ok {
+		return nil, errs.NewErr(
+			errs.ServerInternalErrorCode,
+			errors.Errorf("handlers: [GetDeflectionStatsHandler] cast %v into *GetDeflectionStatsIn failed", in),
+		)
+	}
+
+	stats, err := e.Service.GetDeflectionStats(ctx, &models.GetDeflectionStatsParams{
+		Group:       data.Group,
+		Limit:       data.Limit,
+		WindowDays:  data.WindowDays,
+		Locale:      data.Locale,
+		CountryCode: data.CountryCode,
+	})
+	if err != nil {
+		return nil, errs.NewErr(
+			errs.ServerInternalErrorCode,
+			errors.Wrapf(err, "handlers: [GetDeflectionStatsHandler] Service.GetDeflectionStats failed"),
+		)
+	}
+
+	return &inout.GetDeflectionStatsOut{Deflections: stats}, nil
+}
+
+// GetArticleVoteHistoryDecompressor combines params from URL or FORM
+// and returns params in a structure that GetArticleVoteHistoryHandler needs.
+func GetArticleVoteHistoryDecompressor(ps httprouter.Params, r *http.Request) (interface{}, error) {
+	baseParams, err := inout.FetchBaseParams(r)
+	if err != nil {
+		return nil, errs.NewErr(
+			errs.InvalidAttributeErrorCode,
+			errors.Wrapf(err, "handlers: [GetArticleVoteHistoryDecompressor] inout.FetchBaseParams failed"),
+		)
+	}
+
+	articleID, err := strconv.ParseInt(ps.ByName("article_id"), 10, 64)
+	if err != nil {
+		return nil, errs.NewErr(
+			errs.RecordNotFoundErrorCode,
+			errors.Wrapf(err, "handlers: [GetArticleVoteHistoryDecompressor] parse article id to int failed"),
+		)
+	}
+
+	windowDays, err := fetchIntParam(r, "window_days", models.MaxTopNArticlesWindowDays)
+	if err != nil {
+		return nil, errs.NewErr(
+			errs.InvalidAttributeErrorCode,
+			errors.Wrapf(err, "handlers: [GetArticleVoteHistoryDecompressor] parse window_days failed"),
+		)
+	}
+	if windowDays == 0 {
+		windowDays = defaultArticleVoteHistoryWindowDays
+	}
+
+	return &inout.GetArticleVoteHistoryIn{
+		ArticleID:   int(articleID),
+		WindowDays:  windowDays,
+		CountryCode: baseParams.CountryCode,
+	}, nil
+}
+
+// GetArticleVoteHistoryHandler handles get article vote history request, the analytics:read scope is required.
+func GetArticleVoteHistoryHandler(ctx context.Context, e *Env, in interface{}) (interface{}, error) {
+	if err := auth.Require(ctx, auth.ScopeAnalyticsRead); err != nil {
+		return nil, err
+	}
+
+	data, ok := in.(*inout.GetArticleVoteHistoryIn)
+	if !ok {
+		return nil, errs.NewErr(
+			errs.ServerInternalErrorCode,
+			errors.Errorf("handlers: [GetArticleVoteHistoryHandler] cast %v into *GetArticleVoteHistoryIn failed", in),
+		)
+	}
+
+	days, err := e.Service.GetArticleVoteHistory(ctx, &models.GetArticleVoteHistoryParams{
+		ArticleID:   data.ArticleID,
+		WindowDays:  data.WindowDays,
+		CountryCode: data.CountryCode,
+	})
+	if err != nil {
+		return nil, errs.NewErr(
+			errs.ServerInternalErrorCode,
+			errors.Wrapf(err, "handlers: [GetArticleVoteHistoryHandler] Service.GetArticleVoteHistory failed"),
+		)
+	}
+
+	return &inout.GetArticleVoteHistoryOut{Days: days}, nil
+}
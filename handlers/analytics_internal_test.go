@@ -0,0 +1,553 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+	"github.com/julienschmidt/httprouter"
+
+	"github.com/honestbee/Zen/auth"
+	"github.com/honestbee/Zen/inout"
+	"github.com/honestbee/Zen/models"
+	"github.com/honestbee/Zen/session"
+)
+
+func TestGetSearchQueryStatsDecompressor(t *testing.T) {
+	testCases := [...]struct {
+		description string
+		input1      httprouter.Params
+		input2      *http.Request
+		expect      interface{}
+		expectErr   bool
+	}{
+		{
+			description: "testing default limit and window case",
+			input1:      httprouter.Params{httprouter.Param{Key: "report", Value: "top"}},
+			input2: &http.Request{
+				Form: url.Values{
+					"locale":       []string{"en-us"},
+					"country_code": []string{"tw"},
+				},
+			},
+			expectErr: false,
+			expect: &inout.GetSearchQueryStatsIn{
+				Report:      models.TopSearchQueries,
+				Limit:       20,
+				WindowDays:  30,
+				Locale:      "en-us",
+				CountryCode: "tw",
+			},
+		},
+		{
+			description: "testing limit and window case",
+			input1:      httprouter.Params{httprouter.Param{Key: "report", Value: "zero_results"}},
+			input2: &http.Request{
+				Form: url.Values{
+					"locale":       []string{"zh-tw"},
+					"country_code": []string{"tw"},
+					"limit":        []string{"5"},
+					"window_days":  []string{"7"},
+				},
+			},
+			expectErr: false,
+			expect: &inout.GetSearchQueryStatsIn{
+				Report:      models.ZeroResultSearchQueries,
+				Limit:       5,
+				WindowDays:  7,
+				Locale:      "zh-tw",
+				CountryCode: "tw",
+			},
+		},
+		{
+			description: "testing unknown report case",
+			input1:      httprouter.Params{httprouter.Param{Key: "report", Value: "slowest"}},
+			input2: &http.Request{
+				Form: url.Values{
+					"locale":       []string{"en-us"},
+					"country_code": []string{"tw"},
+				},
+			},
+			expectErr: true,
+			expect:    nil,
+		},
+		{
+			description: "testing limit out of range case",
+			input1:      httprouter.Params{httprouter.Param{Key: "report", Value: "click_through"}},
+			input2: &http.Request{
+				Form: url.Values{
+					"locale":       []string{"en-us"},
+					"country_code": []string{"tw"},
+					"limit":        []string{"101"},
+				},
+			},
+			expectErr: true,
+			expect:    nil,
+		},
+		{
+			description: "testing window out of range case",
+			input1:      httprouter.Params{httprouter.Param{Key: "report", Value: "top"}},
+			input2: &http.Request{
+				Form: url.Values{
+					"locale":       []string{"en-us"},
+					"country_code": []string{"tw"},
+					"window_days":  []string{"366"},
+				},
+			},
+			expectErr: true,
+			expect:    nil,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			actual, err := GetSearchQueryStatsDecompressor(tt.input1, tt.input2)
+			if tt.expectErr && err == nil {
+				t.Errorf("[%s] expect an error, actual nil", tt.description)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("[%s] expect no error, actual:%v", tt.description, err)
+			} else if diff := deep.Equal(tt.expect, actual); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+}
+
+func TestGetSearchQueryStatsHandler(t *testing.T) {
+	// The stats are kept by a separated mock service, so the searches of the other tests are not counted.
+	ms := models.NewMockService()
+	env := *e
+	env.Service = ms
+
+	ctx := context.Background()
+	ms.RecordSearchQuery(ctx, "Refund", "en-us", "tw", 3)
+	ms.RecordSearchQuery(ctx, " refund ", "en-us", "tw", 3)
+	ms.RecordSearchClick(ctx, "REFUND", 3345679, "en-us", "tw")
+	ms.RecordSearchQuery(ctx, "gift card", "en-us", "tw", 0)
+
+	reader := auth.WithPrincipal(ctx, &auth.Principal{Name: "content", Scopes: []string{auth.ScopeAnalyticsRead}})
+	in := func(report models.SearchQueryReport) *inout.GetSearchQueryStatsIn {
+		return &inout.GetSearchQueryStatsIn{Report: report, Limit: 20, WindowDays: 30, Locale: "en-us", CountryCode: "tw"}
+	}
+	refund := &models.SearchQueryStat{Query: "refund", Searches: 2, Clicks: 1, ClickThroughRate: 0.5}
+	giftCard := &models.SearchQueryStat{Query: "gift card", Searches: 1, ZeroResults: 1}
+
+	testCases := [...]struct {
+		description string
+		ctx         context.Context
+		input       interface{}
+		expect      interface{}
+		expectErr   bool
+	}{
+		{
+			description: "testing top queries case",
+			ctx:         reader,
+			input:       in(models.TopSearchQueries),
+			expectErr:   false,
+			expect:      &inout.GetSearchQueryStatsOut{Queries: []*models.SearchQueryStat{refund, giftCard}},
+		},
+		{
+			description: "testing zero result queries case",
+			ctx:         reader,
+			input:       in(models.ZeroResultSearchQueries),
+			expectErr:   false,
+			expect:      &inout.GetSearchQueryStatsOut{Queries: []*models.SearchQueryStat{giftCard}},
+		},
+		{
+			description: "testing click-through case",
+			ctx:         reader,
+			input:       in(models.ClickThroughSearchQueries),
+			expectErr:   false,
+			expect:      &inout.GetSearchQueryStatsOut{Queries: []*models.SearchQueryStat{giftCard, refund}},
+		},
+		{
+			description: "testing anonymous case",
+			ctx:         ctx,
+			input:       in(models.TopSearchQueries),
+			expectErr:   true,
+			expect:      nil,
+		},
+		{
+			description: "testing insufficient scope case",
+			ctx:         auth.WithPrincipal(ctx, &auth.Principal{Name: "ops", Scopes: []string{auth.ScopeSyncWrite}}),
+			input:       in(models.TopSearchQueries),
+			expectErr:   true,
+			expect:      nil,
+		},
+		{
+			description: "testing service error case",
+			ctx:         reader,
+			input: &inout.GetSearchQueryStatsIn{
+				Report:      models.TopSearchQueries,
+				Limit:       20,
+				WindowDays:  30,
+				Locale:      "en-us",
+				CountryCode: models.ModelsReturnErrorCountryCode,
+			},
+			expectErr: true,
+			expect:    nil,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			actual, err := GetSearchQueryStatsHandler(tt.ctx, &env, tt.input)
+			if tt.expectErr && err == nil {
+				t.Errorf("[%s] expect an error, actual nil", tt.description)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("[%s] expect no error, actual:%v", tt.description, err)
+			} else if diff := deep.Equal(tt.expect, actual); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+}
+
+func TestGetDeflectionStatsDecompressor(t *testing.T) {
+	testCases := [...]struct {
+		description string
+		input1      httprouter.Params
+		input2      *http.Request
+		expect      interface{}
+		expectErr   bool
+	}{
+		{
+			description: "testing default limit and window case",
+			input1:      httprouter.Params{httprouter.Param{Key: "group", Value: "categories"}},
+			input2: &http.Request{
+				Form: url.Values{
+					"locale":       []string{"en-us"},
+					"country_code": []string{"tw"},
+				},
+			},
+			expectErr: false,
+			expect: &inout.GetDeflectionStatsIn{
+				Group:       models.CategoryDeflection,
+				Limit:       20,
+				WindowDays:  30,
+				Locale:      "en-us",
+				CountryCode: "tw",
+			},
+		},
+		{
+			description: "testing limit and window case",
+			input1:      httprouter.Params{httprouter.Param{Key: "group", Value: "articles"}},
+			input2: &http.Request{
+				Form: url.Values{
+					"locale":       []string{"zh-tw"},
+					"country_code": []string{"tw"},
+					"limit":        []string{"5"},
+					"window_days":  []string{"7"},
+				},
+			},
+			expectErr: false,
+			expect: &inout.GetDeflectionStatsIn{
+				Group:       models.ArticleDeflection,
+				Limit:       5,
+				WindowDays:  7,
+				Locale:      "zh-tw",
+				CountryCode: "tw",
+			},
+		},
+		{
+			description: "testing unknown group case",
+			input1:      httprouter.Params{httprouter.Param{Key: "group", Value: "sections"}},
+			input2: &http.Request{
+				Form: url.Values{
+					"locale":       []string{"en-us"},
+					"country_code": []string{"tw"},
+				},
+			},
+			expectErr: true,
+			expect:    nil,
+		},
+		{
+			description: "testing limit out of range case",
+			input1:      httprouter.Params{httprouter.Param{Key: "group", Value: "articles"}},
+			input2: &http.Request{
+				Form: url.Values{
+					"locale":       []string{"en-us"},
+					"country_code": []string{"tw"},
+					"limit":        []string{"101"},
+				},
+			},
+			expectErr: true,
+			expect:    nil,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			actual, err := GetDeflectionStatsDecompressor(tt.input1, tt.input2)
+			if tt.expectErr && err == nil {
+				t.Errorf("[%s] expect an error, actual nil", tt.description)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("[%s] expect no error, actual:%v", tt.description, err)
+			} else if diff := deep.Equal(tt.expect, actual); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+}
+
+func TestGetDeflectionStatsHandler(t *testing.T) {
+	ms := models.NewMockService()
+	env := *e
+	env.Service = ms
+
+	// The session "filed" submits a request after viewing 3345679, the session "deflected" doesn't.
+	ctx := context.Background()
+	for _, event := range []*models.SessionEvent{
+		{SessionID: "filed", Kind: models.ViewSessionEvent, ArticleID: 3345679, CountryCode: "tw", Locale: "en-us"},
+		{SessionID: "deflected", Kind: models.ViewSessionEvent, ArticleID: 3345679, CountryCode: "tw", Locale: "en-us"},
+		{SessionID: "deflected", Kind: models.ViewSessionEvent, ArticleID: 3345680, CountryCode: "tw", Locale: "en-us"},
+		{SessionID: "filed", Kind: models.RequestSessionEvent, CountryCode: "tw"},
+	} {
+		ms.RecordSessionEvent(ctx, event)
+	}
+
+	reader := auth.WithPrincipal(ctx, &auth.Principal{Name: "content", Scopes: []string{auth.ScopeAnalyticsRead}})
+	in := func(group models.DeflectionGroup) *inout.GetDeflectionStatsIn {
+		return &inout.GetDeflectionStatsIn{Group: group, Limit: 20, WindowDays: 30, Locale: "en-us", CountryCode: "tw"}
+	}
+
+	testCases := [...]struct {
+		description string
+		ctx         context.Context
+		input       interface{}
+		expect      interface{}
+		expectErr   bool
+	}{
+		{
+			description: "testing articles case",
+			ctx:         reader,
+			input:       in(models.ArticleDeflection),
+			expectErr:   false,
+			expect: &inout.GetDeflectionStatsOut{Deflections: []*models.DeflectionStat{
+				{ID: 3345679, Sessions: 2, Deflected: 1, DeflectionRate: 0.5},
+				{ID: 3345680, Sessions: 1, Deflected: 1, DeflectionRate: 1},
+			}},
+		},
+		{
+			description: "testing categories case",
+			ctx:         reader,
+			input:       in(models.CategoryDeflection),
+			expectErr:   false,
+			expect: &inout.GetDeflectionStatsOut{Deflections: []*models.DeflectionStat{
+				{ID: 3345678, Sessions: 2, Deflected: 1, DeflectionRate: 0.5},
+			}},
+		},
+		{
+			description: "testing anonymous case",
+			ctx:         ctx,
+			input:       in(models.ArticleDeflection),
+			expectErr:   true,
+			expect:      nil,
+		},
+		{
+			description: "testing service error case",
+			ctx:         reader,
+			input: &inout.GetDeflectionStatsIn{
+				Group:       models.ArticleDeflection,
+				Limit:       20,
+				WindowDays:  30,
+				Locale:      "en-us",
+				CountryCode: models.ModelsReturnErrorCountryCode,
+			},
+			expectErr: true,
+			expect:    nil,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			actual, err := GetDeflectionStatsHandler(tt.ctx, &env, tt.input)
+			if tt.expectErr && err == nil {
+				t.Errorf("[%s] expect an error, actual nil", tt.description)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("[%s] expect no error, actual:%v", tt.description, err)
+			} else if diff := deep.Equal(tt.expect, actual); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+}
+
+func TestMiddlewareTracksSession(t *testing.T) {
+	ms := models.NewMockService()
+	env := *e
+	env.Service = ms
+
+	for _, id := range []string{"anon-42", "", "not valid"} {
+		r := httptest.NewRequest(http.MethodGet, "/api/articles/3345679?locale=en-us&country_code=tw", nil)
+		if id != "" {
+			r.Header.Set(session.Header, id)
+		}
+		Middleware(&env, GetArticleDecompressor, GetArticleHandler)(
+			httptest.NewRecorder(), r, httprouter.Params{httprouter.Param{Key: "article_id", Value: "3345679"}},
+		)
+	}
+
+	// Only the view of the valid session is recorded.
+	expect := []*models.SessionEvent{
+		{SessionID: "anon-42", Kind: models.ViewSessionEvent, ArticleID: 3345679, CountryCode: "tw", Locale: "en-us"},
+	}
+	if diff := deep.Equal(expect, ms.RecordedSessionEvents()); diff != nil {
+		t.Errorf("[recorded session events] %v", diff)
+	}
+}
+
+func TestGetArticleVoteHistoryDecompressor(t *testing.T) {
+	testCases := [...]struct {
+		description string
+		input1      httprouter.Params
+		input2      *http.Request
+		expect      interface{}
+		expectErr   bool
+	}{
+		{
+			description: "testing default window case",
+			input1:      httprouter.Params{httprouter.Param{Key: "article_id", Value: "3345679"}},
+			input2: &http.Request{
+				Form: url.Values{
+					"country_code": []string{"tw"},
+				},
+			},
+			expectErr: false,
+			expect: &inout.GetArticleVoteHistoryIn{
+				ArticleID:   3345679,
+				WindowDays:  30,
+				CountryCode: "tw",
+			},
+		},
+		{
+			description: "testing window case",
+			input1:      httprouter.Params{httprouter.Param{Key: "article_id", Value: "3345679"}},
+			input2: &http.Request{
+				Form: url.Values{
+					"country_code": []string{"sg"},
+					"window_days":  []string{"90"},
+				},
+			},
+			expectErr: false,
+			expect: &inout.GetArticleVoteHistoryIn{
+				ArticleID:   3345679,
+				WindowDays:  90,
+				CountryCode: "sg",
+			},
+		},
+		{
+			description: "testing parse article id failed",
+			input1:      httprouter.Params{httprouter.Param{Key: "article_id", Value: "fake"}},
+			input2: &http.Request{
+				Form: url.Values{
+					"country_code": []string{"tw"},
+				},
+			},
+			expectErr: true,
+			expect:    nil,
+		},
+		{
+			description: "testing window out of range case",
+			input1:      httprouter.Params{httprouter.Param{Key: "article_id", Value: "3345679"}},
+			input2: &http.Request{
+				Form: url.Values{
+					"country_code": []string{"tw"},
+					"window_days":  []string{"366"},
+				},
+			},
+			expectErr: true,
+			expect:    nil,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			actual, err := GetArticleVoteHistoryDecompressor(tt.input1, tt.input2)
+			if tt.expectErr && err == nil {
+				t.Errorf("[%s] expect an error, actual nil", tt.description)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("[%s] expect no error, actual:%v", tt.description, err)
+			} else if diff := deep.Equal(tt.expect, actual); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+}
+
+func TestGetArticleVoteHistoryHandler(t *testing.T) {
+	ms := models.NewMockService()
+	env := *e
+	env.Service = ms
+
+	// Two voters vote up, then one of them changes the vote to down.
+	ctx := context.Background()
+	for _, vote := range []struct {
+		voter, value, previousValue string
+	}{
+		{"a", models.UpVote, ""},
+		{"b", models.UpVote, ""},
+		{"a", models.DownVote, models.UpVote},
+	} {
+		ms.SaveArticleVote(ctx, &models.ArticleVote{ArticleID: 3345679, CountryCode: "tw", Voter: vote.voter, Value: vote.value}, vote.previousValue)
+	}
+	today := time.Now().UTC().Format("2006-01-02")
+
+	reader := auth.WithPrincipal(ctx, &auth.Principal{Name: "content", Scopes: []string{auth.ScopeAnalyticsRead}})
+
+	testCases := [...]struct {
+		description string
+		ctx         context.Context
+		input       interface{}
+		expect      interface{}
+		expectErr   bool
+	}{
+		{
+			description: "testing normal case",
+			ctx:         reader,
+			input:       &inout.GetArticleVoteHistoryIn{ArticleID: 3345679, WindowDays: 30, CountryCode: "tw"},
+			expectErr:   false,
+			expect: &inout.GetArticleVoteHistoryOut{Days: []*models.ArticleVoteDay{
+				{Day: today, Votes: 3, Upvotes: 1, Downvotes: 1, HelpfulnessRatio: 0.5},
+			}},
+		},
+		{
+			description: "testing no votes case",
+			ctx:         reader,
+			input:       &inout.GetArticleVoteHistoryIn{ArticleID: 3345680, WindowDays: 30, CountryCode: "tw"},
+			expectErr:   false,
+			expect:      &inout.GetArticleVoteHistoryOut{Days: []*models.ArticleVoteDay{}},
+		},
+		{
+			description: "testing anonymous case",
+			ctx:         ctx,
+			input:       &inout.GetArticleVoteHistoryIn{ArticleID: 3345679, WindowDays: 30, CountryCode: "tw"},
+			expectErr:   true,
+			expect:      nil,
+		},
+		{
+			description: "testing service error case",
+			ctx:         reader,
+			input:       &inout.GetArticleVoteHistoryIn{ArticleID: 3345679, WindowDays: 30, CountryCode: models.ModelsReturnErrorCountryCode},
+			expectErr:   true,
+			expect:      nil,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			actual, err := GetArticleVoteHistoryHandler(tt.ctx, &env, tt.input)
+			if tt.expectErr && err == nil {
+				t.Errorf("[%s] expect an error, actual nil", tt.description)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("[%s] expect no error, actual:%v", tt.description, err)
+			} else if diff := deep.Equal(tt.expect, actual); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+}
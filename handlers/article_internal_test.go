@@ -42,6 +42,29 @@ func TestGetArticleDecompressor(t *testing.T) {
 				CountryCode: "tw",
 			},
 		},
+		{
+			description: "testing clicked from search case",
+			input1: httprouter.Params{
+				httprouter.Param{
+					Key:   "article_id",
+					Value: "33456711",
+				},
+			},
+			input2: &http.Request{
+				Form: url.Values{
+					"locale":       []string{"en-us"},
+					"country_code": []string{"tw"},
+					"search_query": []string{"refund"},
+				},
+			},
+			expectErr: false,
+			expect: &inout.GetArticleIn{
+				ArticleID:   33456711,
+				Locale:      "en-us",
+				CountryCode: "tw",
+				SearchQuery: "refund",
+			},
+		},
 		{
 			description: "testing fetchBaseIn failed",
 			input1:      nil,
@@ -178,6 +201,34 @@ func TestGetArticleHandler(t *testing.T) {
 	}
 }
 
+func TestGetArticleHandlerRecordsView(t *testing.T) {
+	ms := e.Service.(*models.MockModels)
+	ms.FlushArticleViews(context.Background())
+
+	in := &inout.GetArticleIn{Locale: "en-us", CountryCode: "tw", ArticleID: 3345679}
+	if _, err := GetArticleHandler(context.Background(), e, in); err != nil {
+		t.Fatalf("expect no error, actual:%v", err)
+	}
+	if diff := deep.Equal([]int{3345679}, ms.RecordedArticleViews()); diff != nil {
+		t.Errorf("[recorded views] %v", diff)
+	}
+}
+
+func TestGetArticleHandlerRecordsClick(t *testing.T) {
+	ms := models.NewMockService()
+	env := *e
+	env.Service = ms
+
+	in := &inout.GetArticleIn{Locale: "en-us", CountryCode: "tw", ArticleID: 3345679, SearchQuery: "Refund"}
+	if _, err := GetArticleHandler(context.Background(), &env, in); err != nil {
+		t.Fatalf("expect no error, actual:%v", err)
+	}
+	expect := []*models.SearchQueryStat{{Query: "refund", Clicks: 1}}
+	if diff := deep.Equal(expect, ms.RecordedSearchQueries()); diff != nil {
+		t.Errorf("[recorded clicks] %v", diff)
+	}
+}
+
 func TestGetTopNArticlesDecompressor(t *testing.T) {
 	testCases := [...]struct {
 		description string
@@ -207,6 +258,33 @@ func TestGetTopNArticlesDecompressor(t *testing.T) {
 				CountryCode: "tw",
 			},
 		},
+		{
+			description: "testing window and filters case",
+			input1: httprouter.Params{
+				httprouter.Param{
+					Key:   "top_n",
+					Value: "5",
+				},
+			},
+			input2: &http.Request{
+				Form: url.Values{
+					"locale":       []string{"en-us"},
+					"country_code": []string{"tw"},
+					"window_days":  []string{"7"},
+					"section_id":   []string{"115004118448"},
+					"category_id":  []string{"115001239528"},
+				},
+			},
+			expectErr: false,
+			expect: &inout.GetTopNArticlesIn{
+				TopN:        5,
+				Locale:      "en-us",
+				CountryCode: "tw",
+				WindowDays:  7,
+				SectionID:   115004118448,
+				CategoryID:  115001239528,
+			},
+		},
 		{
 			description: "testing fetchBaseIn failed",
 			input1:      nil,
@@ -236,6 +314,42 @@ func TestGetTopNArticlesDecompressor(t *testing.T) {
 			expectErr: true,
 			expect:    nil,
 		},
+		{
+			description: "testing window days out of range failed",
+			input1: httprouter.Params{
+				httprouter.Param{
+					Key:   "top_n",
+					Value: "5",
+				},
+			},
+			input2: &http.Request{
+				Form: url.Values{
+					"locale":       []string{"en-us"},
+					"country_code": []string{"tw"},
+					"window_days":  []string{"366"},
+				},
+			},
+			expectErr: true,
+			expect:    nil,
+		},
+		{
+			description: "testing parse section id failed",
+			input1: httprouter.Params{
+				httprouter.Param{
+					Key:   "top_n",
+					Value: "5",
+				},
+			},
+			input2: &http.Request{
+				Form: url.Values{
+					"locale":       []string{"en-us"},
+					"country_code": []string{"tw"},
+					"section_id":   []string{"abc"},
+				},
+			},
+			expectErr: true,
+			expect:    nil,
+		},
 	}
 
 	for _, tt := range testCases {
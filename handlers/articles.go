@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"math"
 	"net/http"
 	"strconv"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/honestbee/Zen/errs"
 	"github.com/honestbee/Zen/inout"
 	"github.com/honestbee/Zen/models"
+	"github.com/honestbee/Zen/session"
 )
 
 // GetArticleDecompressor combines params from URL or FORM
@@ -36,6 +38,7 @@ func GetArticleDecompressor(ps httprouter.Params, r *http.Request) (interface{},
 		Locale:      baseParams.Locale,
 		CountryCode: baseParams.CountryCode,
 		ArticleID:   int(articleID),
+		SearchQuery: r.FormValue("search_query"),
 	}, nil
 }
 
@@ -50,7 +53,17 @@ func GetArticleHandler(ctx context.Context, e *Env, in interface{}) (interface{}
 	}
 
 	defer e.Examiner.CheckArticles(ctx, data.CountryCode, data.Locale)
-	defer e.Service.PlusOneArticleClickCounter(ctx, data.ArticleID, data.Locale, data.CountryCode)
+	defer e.Service.RecordArticleView(ctx, data.ArticleID, data.Locale, data.CountryCode)
+	defer e.Service.RecordSessionEvent(ctx, &models.SessionEvent{
+		SessionID:   session.IDFromContext(ctx),
+		Kind:        models.ViewSessionEvent,
+		ArticleID:   data.ArticleID,
+		CountryCode: data.CountryCode,
+		Locale:      data.Locale,
+	})
+	if data.SearchQuery != "" {
+		defer e.Service.RecordSearchClick(ctx, data.SearchQuery, data.ArticleID, data.Locale, data.CountryCode)
+	}
 
 	article, err := e.Service.GetArticleByArticleID(ctx, data.ArticleID, data.Locale, data.CountryCode)
 	if err != nil {
@@ -90,13 +103,54 @@ func GetTopNArticlesDecompressor(ps httprouter.Params, r *http.Request) (interfa
 		)
 	}
 
+	windowDays, err := fetchIntParam(r, "window_days", models.MaxTopNArticlesWindowDays)
+	if err != nil {
+		return nil, errs.NewErr(
+			errs.InvalidAttributeErrorCode,
+			errors.Wrapf(err, "handlers: [GetTopNArticlesDecompressor] parse window_days failed"),
+		)
+	}
+	sectionID, err := fetchIntParam(r, "section_id", math.MaxInt64)
+	if err != nil {
+		return nil, errs.NewErr(
+			errs.InvalidAttributeErrorCode,
+			errors.Wrapf(err, "handlers: [GetTopNArticlesDecompressor] parse section_id failed"),
+		)
+	}
+	categoryID, err := fetchIntParam(r, "category_id", math.MaxInt64)
+	if err != nil {
+		return nil, errs.NewErr(
+			errs.InvalidAttributeErrorCode,
+			errors.Wrapf(err, "handlers: [GetTopNArticlesDecompressor] parse category_id failed"),
+		)
+	}
+
 	return &inout.GetTopNArticlesIn{
 		TopN:        topN,
 		Locale:      baseParams.Locale,
 		CountryCode: baseParams.CountryCode,
+		WindowDays:  windowDays,
+		SectionID:   sectionID,
+		CategoryID:  categoryID,
 	}, nil
 }
 
+// fetchIntParam returns the optional integer param between 0 and max, 0 is returned if it's absent.
+func fetchIntParam(r *http.Request, name string, max int) (int, error) {
+	str := r.FormValue(name)
+	if str == "" {
+		return 0, nil
+	}
+	v, err := strconv.Atoi(str)
+	if err != nil {
+		return 0, errors.Wrapf(err, "handlers: [fetchIntParam] %s:%q is not an integer", name, str)
+	}
+	if v < 0 || v > max {
+		return 0, errors.Errorf("handlers: [fetchIntParam] %s:%d is not between 0 and %d", name, v, max)
+	}
+	return v, nil
+}
+
 // GetTopNArticlesHandler handles get topN articles request.
 func GetTopNArticlesHandler(ctx context.Context, e *Env, in interface{}) (interface{}, error) {
 	data, ok := in.(*inout.GetTopNArticlesIn)
@@ -107,7 +161,14 @@ func GetTopNArticlesHandler(ctx context.Context, e *Env, in interface{}) (interf
 		)
 	}
 
-	articles, err := e.Service.GetTopNArticles(ctx, data.TopN, data.Locale, data.CountryCode)
+	articles, err := e.Service.GetTopNArticles(ctx, &models.GetTopNArticlesParams{
+		TopN:        data.TopN,
+		Locale:      data.Locale,
+		CountryCode: data.CountryCode,
+		WindowDays:  data.WindowDays,
+		SectionID:   data.SectionID,
+		CategoryID:  data.CategoryID,
+	})
 	if err != nil {
 		return nil, errs.NewErr(
 			errs.ServerInternalErrorCode,
@@ -0,0 +1,187 @@
+package handlers
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/inout"
+	"github.com/honestbee/Zen/models"
+)
+
+// content is the caching metadata of a content response.
+type content struct {
+	lastModified time.Time
+	keys         []string
+	// listing is set for the listings, whose removed or reordered items do not move the latest updated_at,
+	// so they are only validated by the ETag.
+	listing bool
+}
+
+func (c *content) add(key string, updatedAt time.Time) {
+	c.keys = append(c.keys, key)
+	if updatedAt.After(c.lastModified) {
+		c.lastModified = updatedAt
+	}
+}
+
+func (c *content) categories(categories []*models.Category) {
+	for _, category := range categories {
+		c.add(category.SurrogateKey(), category.UpdatedAt)
+	}
+}
+
+func (c *content) sections(sections []*models.Section) {
+	for _, section := range sections {
+		c.add(section.SurrogateKey(), section.UpdatedAt)
+	}
+}
+
+func (c *content) articles(articles []*models.Article) {
+	for _, article := range articles {
+		c.add(article.SurrogateKey(), article.UpdatedAt)
+	}
+}
+
+func (c *content) ticketForm(form *models.TicketForm) {
+	if form == nil {
+		return
+	}
+	c.add(form.SurrogateKey(), form.UpdatedAt)
+	for _, field := range form.TicketFields {
+		if field.UpdatedAt.After(c.lastModified) {
+			c.lastModified = field.UpdatedAt
+		}
+	}
+}
+
+// list adds the key of the listing of the kind in the requested country and locale.
+func (c *content) list(r *http.Request, kind string) {
+	if base, err := inout.FetchBaseParams(r); err == nil {
+		c.keys = append(c.keys, models.ListSurrogateKey(kind, base.CountryCode, base.Locale))
+	}
+	c.listing = true
+}
+
+// contentOf returns the caching metadata of the handler output, nil is returned
+// if the output is not the categories, sections, articles or ticket forms.
+func contentOf(r *http.Request, v interface{}) *content {
+	c := new(content)
+	switch v := v.(type) {
+	case *inout.V2Out:
+		return contentOf(r, v.Data)
+	case *inout.GetCategoriesOut:
+		c.list(r, "categories")
+		c.categories(v.Categories)
+	case []*models.Category:
+		c.list(r, "categories")
+		c.categories(v)
+	case *inout.GetSectionsOut:
+		c.list(r, "sections")
+		c.sections(v.Sections)
+	case []*models.Section:
+		c.list(r, "sections")
+		c.sections(v)
+	case *inout.GetArticlesOut:
+		c.list(r, "articles")
+		c.articles(v.Articles)
+	case *inout.GetTopNArticlesOut:
+		c.list(r, "articles")
+		c.articles(v.Articles)
+	case []*models.Article:
+		c.list(r, "articles")
+		c.articles(v)
+	case *inout.GetSectionOut:
+		c.sections([]*models.Section{v.Section})
+	case *models.Section:
+		c.sections([]*models.Section{v})
+	case *inout.GetArticleOut:
+		c.articles([]*models.Article{v.Article})
+	case *models.Article:
+		c.articles([]*models.Article{v})
+	case *inout.GetTicketFormOut:
+		c.ticketForm(v.TicketForm)
+	case *models.TicketForm:
+		c.ticketForm(v)
+	default:
+		return nil
+	}
+	if c.listing {
+		c.lastModified = time.Time{}
+	}
+	return c
+}
+
+// writeContent writes the handler output with the status, the content responses of GET are written with
+// the ETag, Last-Modified, Cache-Control and Surrogate-Key headers of c, and 304 is written if the
+// conditional request matches the ETag or is not modified since. The listings have no Last-Modified.
+func writeContent(w http.ResponseWriter, r *http.Request, conf *config.HTTP, c *content, status int, v interface{}) error {
+	if c == nil || (r.Method != http.MethodGet && r.Method != http.MethodHead) {
+		w.WriteHeader(status)
+		return json.NewEncoder(w).Encode(v)
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(v); err != nil {
+		return err
+	}
+	// The body contains the updated_at of the contents, so the ETag changes whenever they are updated.
+	sum := sha256.Sum256(buf.Bytes())
+	etag := `"` + base64.RawURLEncoding.EncodeToString(sum[:16]) + `"`
+
+	h := w.Header()
+	h.Set("ETag", etag)
+	if !c.lastModified.IsZero() {
+		h.Set("Last-Modified", c.lastModified.UTC().Format(http.TimeFormat))
+	}
+	h.Set("Cache-Control", cacheControl(conf))
+	if conf.SurrogateKeyEnable && len(c.keys) > 0 {
+		h.Set("Surrogate-Key", strings.Join(c.keys, " "))
+	}
+
+	if notModified(r, etag, c.lastModified) {
+		w.WriteHeader(http.StatusNotModified)
+		return nil
+	}
+	w.WriteHeader(status)
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+func cacheControl(conf *config.HTTP) string {
+	if conf.CacheMaxAgeSec <= 0 && conf.CDNMaxAgeSec <= 0 {
+		return "no-cache"
+	}
+	return fmt.Sprintf("public, max-age=%d, s-maxage=%d", conf.CacheMaxAgeSec, conf.CDNMaxAgeSec)
+}
+
+// notModified reports if the conditional request is fulfilled by the cached response,
+// If-Modified-Since is ignored if If-None-Match is present as RFC 7232 requires.
+func notModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		for _, tag := range strings.Split(inm, ",") {
+			tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+			if tag == "*" || tag == etag {
+				return true
+			}
+		}
+		return false
+	}
+
+	ims := r.Header.Get("If-Modified-Since")
+	if ims == "" || lastModified.IsZero() {
+		return false
+	}
+	t, err := http.ParseTime(ims)
+	if err != nil {
+		return false
+	}
+	// The header has the second precision.
+	return !lastModified.Truncate(time.Second).After(t)
+}
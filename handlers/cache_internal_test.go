@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+
+	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/inout"
+	"github.com/honestbee/Zen/models"
+)
+
+func TestNotModified(t *testing.T) {
+	lastModified := time.Date(2018, 7, 1, 10, 0, 0, 500, time.UTC)
+
+	testCases := [...]struct {
+		description string
+		header      map[string]string
+		expect      bool
+	}{
+		{
+			description: "testing unconditional request case",
+			expect:      false,
+		},
+		{
+			description: "testing matched etag case",
+			header:      map[string]string{"If-None-Match": `"other", W/"etag"`},
+			expect:      true,
+		},
+		{
+			description: "testing mismatched etag ignores modified since case",
+			header: map[string]string{
+				"If-None-Match":     `"other"`,
+				"If-Modified-Since": lastModified.Format(http.TimeFormat),
+			},
+			expect: false,
+		},
+		{
+			description: "testing not modified since case",
+			header:      map[string]string{"If-Modified-Since": lastModified.Format(http.TimeFormat)},
+			expect:      true,
+		},
+		{
+			description: "testing modified since case",
+			header:      map[string]string{"If-Modified-Since": lastModified.Add(-time.Second).Format(http.TimeFormat)},
+			expect:      false,
+		},
+		{
+			description: "testing invalid modified since case",
+			header:      map[string]string{"If-Modified-Since": "yesterday"},
+			expect:      false,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "http://fake.url.com", nil)
+			for k, v := range tt.header {
+				r.Header.Set(k, v)
+			}
+			if actual := notModified(r, `"etag"`, lastModified); actual != tt.expect {
+				t.Errorf("[%s] expect:%v, actual:%v", tt.description, tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestMiddlewareCacheHeaders(t *testing.T) {
+	updatedAt := time.Date(2018, 7, 1, 10, 0, 0, 0, time.UTC)
+	env := &Env{
+		Config: &config.Config{HTTP: &config.HTTP{CacheMaxAgeSec: 60, CDNMaxAgeSec: 300, SurrogateKeyEnable: true}},
+		Logger: e.Logger,
+	}
+	dec := func(httprouter.Params, *http.Request) (interface{}, error) {
+		return nil, nil
+	}
+	articles := func(ctx context.Context, e *Env, in interface{}) (interface{}, error) {
+		return &inout.GetArticlesOut{
+			Articles: []*models.Article{
+				&models.Article{ID: 1, UpdatedAt: updatedAt.Add(-time.Hour)},
+				&models.Article{ID: 2, UpdatedAt: updatedAt},
+			},
+			BaseOut: &inout.BaseOut{},
+		}, nil
+	}
+	m := Middleware(env, dec, articles)
+
+	w := httptest.NewRecorder()
+	m(w, httptest.NewRequest(http.MethodGet, "http://fake.url.com?country_code=tw", nil), nil)
+	etag := w.Header().Get("ETag")
+	if w.Code != http.StatusOK || etag == "" {
+		t.Fatalf("expect 200 with an etag, actual:%d %q", w.Code, etag)
+	}
+	expectHeader := map[string]string{
+		"Last-Modified": "",
+		"Cache-Control": "public, max-age=60, s-maxage=300",
+		"Surrogate-Key": "articles-tw-en-us article-1 article-2",
+	}
+	for k, v := range expectHeader {
+		if actual := w.Header().Get(k); actual != v {
+			t.Errorf("expect %s:%q, actual:%q", k, v, actual)
+		}
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "http://fake.url.com?country_code=tw", nil)
+	r.Header.Set("If-None-Match", etag)
+	w = httptest.NewRecorder()
+	m(w, r, nil)
+	if w.Code != http.StatusNotModified || w.Body.Len() != 0 {
+		t.Errorf("expect 304 without body, actual:%d %q", w.Code, w.Body.String())
+	}
+
+	// The listing is not validated by the date, since removing an article does not move it.
+	r = httptest.NewRequest(http.MethodGet, "http://fake.url.com?country_code=tw", nil)
+	r.Header.Set("If-Modified-Since", updatedAt.Format(http.TimeFormat))
+	w = httptest.NewRecorder()
+	m(w, r, nil)
+	if w.Code != http.StatusOK {
+		t.Errorf("expect listing 200 for If-Modified-Since, actual:%d", w.Code)
+	}
+
+	v2 := func(ctx context.Context, e *Env, in interface{}) (interface{}, error) {
+		return &inout.V2Out{Data: &models.TicketForm{ID: 3, UpdatedAt: updatedAt}}, nil
+	}
+	w = httptest.NewRecorder()
+	V2Middleware(env, dec, v2)(w, httptest.NewRequest(http.MethodGet, "http://fake.url.com?fields=id", nil), nil)
+	if actual := w.Header().Get("Surrogate-Key"); actual != "ticket-form-3" {
+		t.Errorf("expect v2 Surrogate-Key:ticket-form-3, actual:%q", actual)
+	}
+	if actual := w.Header().Get("Last-Modified"); actual != "Sun, 01 Jul 2018 10:00:00 GMT" {
+		t.Errorf("expect v2 Last-Modified of the ticket form, actual:%q", actual)
+	}
+}
@@ -50,6 +50,8 @@ func GetCategoriesHandler(ctx context.Context, e *Env, in interface{}) (interfac
 		Page:        data.Page,
 		SortBy:      data.SortBy,
 		SortOrder:   data.SortOrder,
+		After:       data.After,
+		Keyset:      data.Keyset,
 	})
 	if err != nil {
 		return nil, errs.NewErr(
@@ -61,7 +63,7 @@ func GetCategoriesHandler(ctx context.Context, e *Env, in interface{}) (interfac
 	return &inout.GetCategoriesOut{
 		Categories: categories,
 		BaseOut: &inout.BaseOut{
-			Page:      int(math.Round(float64(data.Page)/float64(data.PerPage))) + 1,
+			Page:      inout.CurrentPage(data.Page, data.PerPage),
 			PerPage:   data.PerPage,
 			PageCount: int(math.Ceil(float64(total) / float64(data.PerPage))),
 			Count:     total,
@@ -162,6 +164,8 @@ func GetSectionsHandler(ctx context.Context, e *Env, in interface{}) (interface{
 			Page:        data.Page,
 			SortBy:      data.SortBy,
 			SortOrder:   data.SortOrder,
+			After:       data.After,
+			Keyset:      data.Keyset,
 			CategoryID:  data.CategoryID,
 		})
 	if err != nil {
@@ -174,7 +178,7 @@ func GetSectionsHandler(ctx context.Context, e *Env, in interface{}) (interface{
 	return &inout.GetSectionsOut{
 		Sections: sections,
 		BaseOut: &inout.BaseOut{
-			Page:      int(math.Round(float64(data.Page)/float64(data.PerPage))) + 1,
+			Page:      inout.CurrentPage(data.Page, data.PerPage),
 			PerPage:   data.PerPage,
 			PageCount: int(math.Ceil(float64(total) / float64(data.PerPage))),
 			Count:     total,
@@ -233,6 +237,8 @@ func GetCategoriesArticlesHandler(ctx context.Context, e *Env, in interface{}) (
 			Page:        data.Page,
 			SortBy:      data.SortBy,
 			SortOrder:   data.SortOrder,
+			After:       data.After,
+			Keyset:      data.Keyset,
 			CategoryID:  data.CategoryID,
 		}, labels)
 	if err != nil {
@@ -245,7 +251,7 @@ func GetCategoriesArticlesHandler(ctx context.Context, e *Env, in interface{}) (
 	return &inout.GetArticlesOut{
 		Articles: articles,
 		BaseOut: &inout.BaseOut{
-			Page:      int(math.Round(float64(data.Page)/float64(data.PerPage))) + 1,
+			Page:      inout.CurrentPage(data.Page, data.PerPage),
 			PerPage:   data.PerPage,
 			PageCount: int(math.Ceil(float64(total) / float64(data.PerPage))),
 			Count:     total,
@@ -0,0 +1,23 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+)
+
+// DeadlineMiddleware sets the read and the write deadlines of each request, as the server timeouts do.
+// It is for the server sharing its listener with gRPC, whose streams outlive the server timeouts,
+// so that only the http handlers are limited and the gRPC calls are limited by their own deadlines.
+func DeadlineMiddleware(readTimeout, writeTimeout time.Duration, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rc := http.NewResponseController(w)
+		// The errors are ignored since the writers not supporting the deadlines have no timeouts to keep.
+		if readTimeout > 0 {
+			rc.SetReadDeadline(time.Now().Add(readTimeout))
+		}
+		if writeTimeout > 0 {
+			rc.SetWriteDeadline(time.Now().Add(writeTimeout))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestDeadlineMiddleware(t *testing.T) {
+	testCases := [...]struct {
+		description  string
+		writeTimeout time.Duration
+		expectErr    bool
+	}{
+		{
+			description:  "testing within write timeout case",
+			writeTimeout: time.Second,
+		},
+		{
+			description:  "testing write timeout exceeded case",
+			writeTimeout: 10 * time.Millisecond,
+			expectErr:    true,
+		},
+	}
+
+	for _, tt := range testCases {
+		slow := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(50 * time.Millisecond)
+			w.Write([]byte("ok"))
+		})
+		srv := httptest.NewServer(DeadlineMiddleware(time.Second, tt.writeTimeout, slow))
+
+		resp, err := http.Get(srv.URL)
+		if err == nil {
+			_, err = ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+		}
+		if (err != nil) != tt.expectErr {
+			t.Errorf("[%s] expect error:%v, actual:%v", tt.description, tt.expectErr, err)
+		}
+		srv.Close()
+	}
+}
@@ -5,44 +5,21 @@ import (
 	"net/http"
 
 	"github.com/julienschmidt/httprouter"
-	"github.com/pkg/errors"
 
-	"github.com/honestbee/Zen/errs"
+	"github.com/honestbee/Zen/auth"
 	"github.com/honestbee/Zen/inout"
 )
 
-// CreateForceSyncDecompressor combines params from authorization header
-// and returns params in a structure that CreateForceSyncHandler needs.
+// CreateForceSyncDecompressor has no params to combine,
+// the credentials are authenticated by the middleware.
 func CreateForceSyncDecompressor(ps httprouter.Params, r *http.Request) (interface{}, error) {
-	gotUser, gotPwd, ok := r.BasicAuth()
-	if !ok {
-		return nil, errs.NewErr(
-			errs.InvalidAttributeErrorCode,
-			errors.Errorf("handlers: [CreateForceSyncDecompressor] fetchBasicAuth failed"),
-		)
-	}
-
-	return &inout.GetBasicAuthIn{
-		User: gotUser,
-		Pwd:  gotPwd,
-	}, nil
+	return nil, nil
 }
 
-// CreateForceSyncHandler handles force sync request.
+// CreateForceSyncHandler handles force sync request, the sync:write scope is required.
 func CreateForceSyncHandler(ctx context.Context, e *Env, in interface{}) (interface{}, error) {
-	data, ok := in.(*inout.GetBasicAuthIn)
-	if !ok {
-		return nil, errs.NewErr(
-			errs.ServerInternalErrorCode,
-			errors.Errorf("handlers: [CreateForceSyncHandler] cast %v into *GetBasicAuthIn failed", in),
-		)
-	}
-
-	if e.Config.HTTP.BasicAuthUser != data.User || e.Config.HTTP.BasicAuthPwd != data.Pwd {
-		return nil, errs.NewErr(
-			errs.UnauthorizedErrCode,
-			errors.Errorf("handlers: [CreateForceSyncHandler] user or password not match"),
-		)
+	if err := auth.Require(ctx, auth.ScopeSyncWrite); err != nil {
+		return nil, err
 	}
 
 	go func() {
@@ -4,40 +4,38 @@ import (
 	"context"
 	"encoding/base64"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/go-test/deep"
-	"github.com/julienschmidt/httprouter"
 
-	"github.com/honestbee/Zen/inout"
+	"github.com/honestbee/Zen/auth"
 )
 
-func TestGetForceSyncDecompressor(t *testing.T) {
+func TestGetForceSyncHandler(t *testing.T) {
 	testCases := [...]struct {
 		description string
-		input1      httprouter.Params
-		input2      *http.Request
+		ctx         context.Context
 		expect      interface{}
 		expectErr   bool
 	}{
-		{
-			description: "testing normal case",
-			input1:      nil,
-			input2: &http.Request{
-				Header: http.Header{
-					"Authorization": {"Basic " + base64.StdEncoding.EncodeToString([]byte("admin:1234"))},
-				},
-			},
-			expectErr: false,
-			expect: &inout.GetBasicAuthIn{
-				User: "admin",
-				Pwd:  "1234",
+		/*
+			{
+				description: "testing normal case",
+				ctx:         auth.WithPrincipal(context.Background(), &auth.Principal{Name: "admin", Scopes: []string{auth.ScopeSyncWrite}}),
+				expectErr:   false,
+				expect:      "success trigger force sync job",
 			},
+		*/
+		{
+			description: "testing anonymous case",
+			ctx:         context.Background(),
+			expectErr:   true,
+			expect:      nil,
 		},
 		{
-			description: "testing fetchBasicAuth failed",
-			input1:      nil,
-			input2:      &http.Request{},
+			description: "testing insufficient scope case",
+			ctx:         auth.WithPrincipal(context.Background(), &auth.Principal{Name: "reader", Scopes: []string{auth.ScopeTicketsCreate}}),
 			expectErr:   true,
 			expect:      nil,
 		},
@@ -45,9 +43,9 @@ func TestGetForceSyncDecompressor(t *testing.T) {
 
 	for _, tt := range testCases {
 		t.Run(tt.description, func(t *testing.T) {
-			actual, err := CreateForceSyncDecompressor(tt.input1, tt.input2)
+			actual, err := CreateForceSyncHandler(tt.ctx, e, nil)
 			if tt.expectErr && err == nil {
-				t.Errorf("[%s] expect an error, actual == nil", tt.description)
+				t.Errorf("[%s] expect an error, actual nil", tt.description)
 			} else if !tt.expectErr && err != nil {
 				t.Errorf("[%s] expect no error, actual:%v", tt.description, err)
 			} else if diff := deep.Equal(tt.expect, actual); diff != nil {
@@ -57,56 +55,49 @@ func TestGetForceSyncDecompressor(t *testing.T) {
 	}
 }
 
-func TestGetForceSyncHandler(t *testing.T) {
+func TestForceSyncMiddlewareAuthentication(t *testing.T) {
 	testCases := [...]struct {
-		description string
-		input       interface{}
-		expect      interface{}
-		expectErr   bool
+		description  string
+		header       http.Header
+		expectStatus int
 	}{
-		/*
-			{
-				description: "testing normal case",
-				input: &inout.GetBasicAuthIn{
-					User: "admin",
-					Pwd:  "33456783345678",
-				},
-				expectErr: false,
-				expect:    "success trigger force sync job",
+		{
+			description:  "testing no credentials case",
+			header:       http.Header{},
+			expectStatus: http.StatusUnauthorized,
+		},
+		{
+			description: "testing basic auth failed case",
+			header: http.Header{
+				"Authorization": {"Basic " + base64.StdEncoding.EncodeToString([]byte("admin:1234"))},
 			},
-		*/
+			expectStatus: http.StatusUnauthorized,
+		},
 		{
-			description: "testing input casting failed case",
-			input: &struct {
-				name string
-				age  int
-			}{
-				name: "honestbee",
-				age:  99,
+			description: "testing invalid api key case",
+			header: http.Header{
+				auth.APIKeyHeader: {"unknown"},
 			},
-			expectErr: true,
-			expect:    nil,
+			expectStatus: http.StatusUnauthorized,
 		},
 		{
-			description: "testing basic auth failed case",
-			input: &inout.GetBasicAuthIn{
-				User: "admin",
-				Pwd:  "",
+			description: "testing insufficient scope case",
+			header: http.Header{
+				auth.APIKeyHeader: {"reader-key"},
 			},
-			expectErr: true,
-			expect:    nil,
+			expectStatus: http.StatusForbidden,
 		},
 	}
 
 	for _, tt := range testCases {
 		t.Run(tt.description, func(t *testing.T) {
-			actual, err := CreateForceSyncHandler(context.Background(), e, tt.input)
-			if tt.expectErr && err == nil {
-				t.Errorf("[%s] expect an error, actual nil", tt.description)
-			} else if !tt.expectErr && err != nil {
-				t.Errorf("[%s] expect no error, actual:%v", tt.description, err)
-			} else if diff := deep.Equal(tt.expect, actual); diff != nil {
-				t.Errorf("[%s] %v", tt.description, diff)
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodPost, "/api/forcesync", nil)
+			r.Header = tt.header
+
+			Middleware(e, CreateForceSyncDecompressor, CreateForceSyncHandler)(w, r, nil)
+			if w.Code != tt.expectStatus {
+				t.Errorf("[%s] expect status:%d, actual:%d", tt.description, tt.expectStatus, w.Code)
 			}
 		})
 	}
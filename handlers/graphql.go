@@ -3,16 +3,21 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"sync"
 
 	gographql "github.com/graph-gophers/graphql-go"
+	gographqlerrors "github.com/graph-gophers/graphql-go/errors"
 	"github.com/julienschmidt/httprouter"
 	"github.com/pkg/errors"
 
+	"github.com/honestbee/Zen/antispam"
 	"github.com/honestbee/Zen/errs"
+	"github.com/honestbee/Zen/gqldoc"
 	"github.com/honestbee/Zen/inout"
+	"github.com/honestbee/Zen/session"
 )
 
 // CreateGraphQLDecompressor combines params from URL or FORM
@@ -63,9 +68,54 @@ func CreateGraphQLDecompressor(ps httprouter.Params, r *http.Request) (interface
 	}
 
 	return &inout.GraphQLIn{
-		Ctx:     r.Context(),
-		Queries: queries,
-		IsBatch: isBatch,
+		Ctx:      r.Context(),
+		Queries:  queries,
+		IsBatch:  isBatch,
+		RemoteIP: remoteIP(r),
+	}, nil
+}
+
+// GetGraphQLDecompressor combines the persisted query params from URL
+// and returns params in a structure that CreateGraphQLHandler needs.
+// The variables and the extensions are json encoded.
+func GetGraphQLDecompressor(ps httprouter.Params, r *http.Request) (interface{}, error) {
+	values := r.URL.Query()
+	query := inout.GraphQLQuery{
+		Query:  values.Get("query"),
+		OpName: values.Get("operationName"),
+		ID:     values.Get("id"),
+	}
+
+	if variables := values.Get("variables"); variables != "" {
+		if err := json.Unmarshal([]byte(variables), &query.Variables); err != nil {
+			return nil, errs.NewErr(
+				errs.InvalidAttributeErrorCode,
+				errors.Errorf("handlers: [GetGraphQLDecompressor] json unmarshal variables failed"),
+			)
+		}
+	}
+	if extensions := values.Get("extensions"); extensions != "" {
+		if err := json.Unmarshal([]byte(extensions), &query.Extensions); err != nil {
+			return nil, errs.NewErr(
+				errs.InvalidAttributeErrorCode,
+				errors.Errorf("handlers: [GetGraphQLDecompressor] json unmarshal extensions failed"),
+			)
+		}
+	}
+
+	// Only the persisted queries are served over GET so that the URLs are short enough to be cached.
+	if !query.IsPersisted() {
+		return nil, errs.NewErr(
+			errs.InvalidAttributeErrorCode,
+			errors.Errorf("handlers: [GetGraphQLDecompressor] no persisted query to execute"),
+		)
+	}
+
+	return &inout.GraphQLIn{
+		Ctx:      r.Context(),
+		Queries:  []inout.GraphQLQuery{query},
+		RemoteIP: remoteIP(r),
+		ReadOnly: true,
 	}, nil
 }
 
@@ -81,6 +131,7 @@ func CreateGraphQLHandler(ctx context.Context, e *Env, in interface{}) (interfac
 
 	lens := len(data.Queries)
 	ctx = e.GraphQL.Loader.Attach(ctx) // Attach dataloaders onto the request context.
+	ctx = antispam.WithRemoteIP(ctx, data.RemoteIP)
 	var (
 		responses      = make([]*gographql.Response, lens) // Allocate a slice large enough for all responses.
 		wg             sync.WaitGroup                      // Use the WaitGroup to wait for all executions to finish.
@@ -94,14 +145,20 @@ func CreateGraphQLHandler(ctx context.Context, e *Env, in interface{}) (interfac
 		// These queries are executed in separate goroutines so they
 		// process in parallel.
 		go func(i int, q inout.GraphQLQuery) {
-			res := e.GraphQL.Schema.Exec(ctx, q.Query, q.OpName, q.Variables)
+			defer wg.Done()
+
+			if err := resolveGraphQLQuery(ctx, e, &q, data.ReadOnly); err != nil {
+				responses[i] = &gographql.Response{Errors: []*gographqlerrors.QueryError{{Message: err.Error()}}}
+				return
+			}
+
+			// The session id of the header is preferred to the one of the variables.
+			res := e.GraphQL.Exec(session.FromVariables(ctx, q.Variables), q.Query, q.OpName, q.Variables)
 
 			// We have to do some work here to expand errors when it is possible for a resolver to return
 			// more than one error (for example, a list resolver).
 			res.Errors, resolverErrors = Expand(res.Errors)
 			responses[i] = res
-
-			wg.Done()
 		}(i, q)
 	}
 
@@ -112,3 +169,29 @@ func CreateGraphQLHandler(ctx context.Context, e *Env, in interface{}) (interfac
 	}
 	return responses[0], resolverErrors
 }
+
+// resolveGraphQLQuery fills the query text of the persisted query,
+// the read only query is rejected if the selected operation is not a query.
+func resolveGraphQLQuery(ctx context.Context, e *Env, q *inout.GraphQLQuery, readOnly bool) error {
+	if e.Persisted != nil {
+		if err := e.Persisted.Resolve(ctx, q); err != nil {
+			return err
+		}
+	}
+
+	if readOnly && gqldoc.OperationType(q.Query, q.OpName) != "query" {
+		return errors.New("only query operations are allowed over GET")
+	}
+	return nil
+}
+
+// setGraphQLCacheControl allows the CDN to cache the response of the persisted query over GET,
+// the response with any error is not cached.
+func setGraphQLCacheControl(w http.ResponseWriter, e *Env, proc *processor) {
+	res, ok := proc.product.(*gographql.Response)
+	if maxAge := e.Config.PersistedQuery.CacheMaxAgeSec; ok && proc.err == nil && len(res.Errors) == 0 && maxAge > 0 {
+		w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", maxAge))
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+}
@@ -5,12 +5,20 @@ import (
 	"context"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/go-test/deep"
 	"github.com/julienschmidt/httprouter"
+	"github.com/rs/zerolog"
 
+	"github.com/honestbee/Zen/config"
 	"github.com/honestbee/Zen/inout"
+	"github.com/honestbee/Zen/models"
+	"github.com/honestbee/Zen/persisted"
+	"github.com/honestbee/Zen/resolvers"
 )
 
 func TestCreateGraphQLDecompressor(t *testing.T) {
@@ -109,3 +117,148 @@ func TestCreateGraphQLDecompressor(t *testing.T) {
 		})
 	}
 }
+
+func TestGetGraphQLDecompressor(t *testing.T) {
+	testCases := [...]struct {
+		description string
+		input       *http.Request
+		expect      interface{}
+		expectErr   bool
+	}{
+		{
+			description: "testing automatic persisted query case",
+			input: &http.Request{
+				URL: &url.URL{RawQuery: url.Values{
+					"operationName": []string{"Nodes"},
+					"variables":     []string{`{"ids":[]}`},
+					"extensions":    []string{`{"persistedQuery":{"version":1,"sha256Hash":"abc"}}`},
+				}.Encode()},
+			},
+			expectErr: false,
+			expect: &inout.GraphQLIn{
+				Ctx: context.Background(),
+				Queries: []inout.GraphQLQuery{
+					inout.GraphQLQuery{
+						OpName:    "Nodes",
+						Variables: map[string]interface{}{"ids": []interface{}{}},
+						Extensions: &inout.GraphQLExtensions{
+							PersistedQuery: &inout.GraphQLPersistedQuery{Version: 1, Sha256Hash: "abc"},
+						},
+					},
+				},
+				ReadOnly: true,
+			},
+		},
+		{
+			description: "testing persisted query id case",
+			input: &http.Request{
+				URL: &url.URL{RawQuery: "id=nodes"},
+			},
+			expectErr: false,
+			expect: &inout.GraphQLIn{
+				Ctx: context.Background(),
+				Queries: []inout.GraphQLQuery{
+					inout.GraphQLQuery{ID: "nodes"},
+				},
+				ReadOnly: true,
+			},
+		},
+		{
+			description: "testing not persisted query case",
+			input: &http.Request{
+				URL: &url.URL{RawQuery: "query=%7B+nodes%28ids%3A+%5B%5D%29+%7B+id+%7D+%7D"},
+			},
+			expectErr: true,
+			expect:    nil,
+		},
+		{
+			description: "testing variables json unmarshal failed case",
+			input: &http.Request{
+				URL: &url.URL{RawQuery: "id=nodes&variables=%7B"},
+			},
+			expectErr: true,
+			expect:    nil,
+		},
+		{
+			description: "testing extensions json unmarshal failed case",
+			input: &http.Request{
+				URL: &url.URL{RawQuery: "extensions=%7B"},
+			},
+			expectErr: true,
+			expect:    nil,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			actual, err := GetGraphQLDecompressor(nil, tt.input)
+			if tt.expectErr && err == nil {
+				t.Errorf("[%s] expect an error, actual == nil", tt.description)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("[%s] expect no error, actual:%v", tt.description, err)
+			} else if diff := deep.Equal(tt.expect, actual); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+}
+
+func TestGraphQLPersistedQuery(t *testing.T) {
+	logger := zerolog.New(ioutil.Discard)
+	conf := &config.Config{
+		GraphQL:        &config.GraphQL{MaxDepth: 13, MaxParallelism: 10},
+		PersistedQuery: &config.PersistedQuery{Enable: true, CacheMaxAgeSec: 60},
+	}
+	ms := models.NewMockService()
+	graphql, err := resolvers.New(conf, &logger, ms, nil, nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("new graphql failed:%v", err)
+	}
+	store, err := persisted.New(conf, &logger, ms)
+	if err != nil {
+		t.Fatalf("new persisted query store failed:%v", err)
+	}
+	e := &Env{Config: conf, Logger: &logger, Service: ms, GraphQL: graphql, Persisted: store}
+
+	mux := httprouter.New()
+	mux.POST("/graphql", GraphQLMiddleware(e, CreateGraphQLDecompressor, CreateGraphQLHandler))
+	mux.GET("/graphql", GraphQLMiddleware(e, GetGraphQLDecompressor, CreateGraphQLHandler))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	const query = `{ nodes(ids: []) { id } }`
+	extensions := `{"persistedQuery":{"version":1,"sha256Hash":"` + persisted.Hash(query) + `"}}`
+	get := func(values url.Values) (string, string) {
+		resp, err := http.Get(server.URL + "/graphql?" + values.Encode())
+		if err != nil {
+			t.Fatalf("get failed:%v", err)
+		}
+		defer resp.Body.Close()
+		body, _ := ioutil.ReadAll(resp.Body)
+		return strings.TrimSpace(string(body)), resp.Header.Get("Cache-Control")
+	}
+
+	if body, cacheControl := get(url.Values{"extensions": []string{extensions}}); body != `{"errors":[{"message":"PersistedQueryNotFound"}]}` || cacheControl != "no-store" {
+		t.Errorf("expect persisted query not found, actual body:%s cache control:%s", body, cacheControl)
+	}
+
+	resp, err := http.Post(server.URL+"/graphql", "application/json", bytes.NewBufferString(`{"query":"`+query+`","extensions":`+extensions+`}`))
+	if err != nil {
+		t.Fatalf("post failed:%v", err)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if actual := strings.TrimSpace(string(body)); actual != `{"data":{"nodes":[]},"extensions":{"cost":{"requestedQueryCost":1}}}` {
+		t.Errorf("expect the registered query executed, actual:%s", actual)
+	}
+
+	if body, cacheControl := get(url.Values{"extensions": []string{extensions}}); body != `{"data":{"nodes":[]},"extensions":{"cost":{"requestedQueryCost":1}}}` || cacheControl != "public, max-age=60" {
+		t.Errorf("expect cacheable data, actual body:%s cache control:%s", body, cacheControl)
+	}
+
+	const mutation = `mutation { voteArticle(articleId: "1", vote: UP) { id } }`
+	mutationExtensions := `{"persistedQuery":{"version":1,"sha256Hash":"` + persisted.Hash(mutation) + `"}}`
+	if body, _ := get(url.Values{"query": []string{mutation}, "extensions": []string{mutationExtensions}}); body != `{"errors":[{"message":"only query operations are allowed over GET"}]}` {
+		t.Errorf("expect mutation rejected over GET, actual:%s", body)
+	}
+}
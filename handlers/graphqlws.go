@@ -0,0 +1,307 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	gographqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/julienschmidt/httprouter"
+	"github.com/pkg/errors"
+	"golang.org/x/net/websocket"
+
+	"github.com/honestbee/Zen/antispam"
+	"github.com/honestbee/Zen/inout"
+	"github.com/honestbee/Zen/redact"
+	"github.com/honestbee/Zen/session"
+)
+
+const graphqlWSProtocol = "graphql-ws"
+
+// GraphQLWS serves the GraphQL subscriptions over the graphql-ws websocket protocol,
+// the queries and the mutations sent over the connection are executed once.
+// The connections are authenticated by the upgrade request, and the browsers may only open them
+// from the allowed origins so that the other pages cannot ride on the credentials of the users.
+func GraphQLWS(e *Env) httprouter.Handle {
+	server := websocket.Server{
+		Handshake: func(config *websocket.Config, r *http.Request) error {
+			if origin := r.Header.Get("Origin"); origin != "" {
+				// The origins are validated with the config.
+				origins, _ := e.Config.Subscription.AllowedOriginList()
+				if !allowedOrigin(origins, origin) {
+					return errors.Errorf("handlers: [GraphQLWS] origin:%q is not allowed", origin)
+				}
+			}
+			for _, protocol := range config.Protocol {
+				if protocol == graphqlWSProtocol {
+					config.Protocol = []string{graphqlWSProtocol}
+					return nil
+				}
+			}
+			return errors.Errorf("handlers: [GraphQLWS] protocols:%v are not supported", config.Protocol)
+		},
+		Handler: func(ws *websocket.Conn) {
+			newGraphQLWSConn(e, ws).serve()
+		},
+	}
+
+	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		e.Logger.Info().Fields(map[string]interface{}{
+			"from":   r.RemoteAddr,
+			"path":   redact.String(r.URL.Path),
+			"method": r.Method,
+		}).Msgf("receiving websocket connection")
+
+		proc := &processor{e: e, source1: p, source2: r}
+		proc.authentication()
+		if proc.err != nil {
+			e.Logger.Warn().Fields(map[string]interface{}{
+				"from":  r.RemoteAddr,
+				"error": redact.String(proc.err.Error()),
+			}).Msgf("websocket connection is not authenticated")
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		server.ServeHTTP(w, proc.source2)
+	}
+}
+
+// allowedOrigin reports whether the Origin header value is one of the allowed origins.
+func allowedOrigin(origins []string, origin string) bool {
+	origin = strings.ToLower(strings.TrimSuffix(origin, "/"))
+	for _, allowed := range origins {
+		if origin == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// UpgradeOr serves the websocket upgrade requests by upgrade and the others by fallback,
+// so that the websocket connections share the path with the plain requests.
+func UpgradeOr(upgrade, fallback httprouter.Handle) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+			upgrade(w, r, p)
+			return
+		}
+		fallback(w, r, p)
+	}
+}
+
+// graphqlWSConn is a graphql-ws connection, the operations are identified by the ids given by the client.
+type graphqlWSConn struct {
+	e  *Env
+	ws *websocket.Conn
+
+	mu         sync.Mutex
+	operations map[string]*graphqlWSOperation
+	wg         sync.WaitGroup
+}
+
+// graphqlWSOperation is a running operation of a graphql-ws connection.
+type graphqlWSOperation struct {
+	cancel context.CancelFunc
+}
+
+func newGraphQLWSConn(e *Env, ws *websocket.Conn) *graphqlWSConn {
+	return &graphqlWSConn{
+		e:          e,
+		ws:         ws,
+		operations: make(map[string]*graphqlWSOperation),
+	}
+}
+
+func (c *graphqlWSConn) serve() {
+	// The connection is long lived, the deadlines of the http server do not apply.
+	c.ws.SetDeadline(time.Time{})
+
+	// The request context carries the principal, the remote span context and the request scoped values,
+	// it is done when the connection is closed.
+	ctx, cancel := context.WithCancel(c.ws.Request().Context())
+	ctx = antispam.WithRemoteIP(ctx, remoteIP(c.ws.Request()))
+	ctx = session.FromHeader(ctx, c.ws.Request().Header)
+	defer func() {
+		cancel()
+		c.wg.Wait()
+		c.ws.Close()
+	}()
+
+	initialized := false
+	for {
+		var data []byte
+		if err := websocket.Message.Receive(c.ws, &data); err != nil {
+			return
+		}
+
+		msg := new(inout.GraphQLWSMessage)
+		if err := json.Unmarshal(data, msg); err != nil {
+			c.sendError("", inout.GraphQLWSConnectionError, "invalid message")
+			continue
+		}
+
+		switch msg.Type {
+		case inout.GraphQLWSConnectionInit:
+			if !initialized {
+				initialized = true
+				c.send(&inout.GraphQLWSMessage{Type: inout.GraphQLWSConnectionAck})
+				c.keepAlive(ctx)
+			}
+		case inout.GraphQLWSStart:
+			if !initialized {
+				c.sendError(msg.ID, inout.GraphQLWSError, "connection is not initialized")
+				continue
+			}
+			c.start(ctx, msg)
+		case inout.GraphQLWSStop:
+			c.stop(msg.ID)
+		case inout.GraphQLWSConnectionTerminate:
+			return
+		default:
+			c.sendError(msg.ID, inout.GraphQLWSError, "unknown message type:"+msg.Type)
+		}
+	}
+}
+
+// keepAlive sends the keep alive messages until ctx is done.
+func (c *graphqlWSConn) keepAlive(ctx context.Context) {
+	keepAliveSec := c.e.Config.Subscription.KeepAliveSec
+	if keepAliveSec <= 0 {
+		return
+	}
+
+	c.send(&inout.GraphQLWSMessage{Type: inout.GraphQLWSConnectionKeepAlive})
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+
+		ticker := time.NewTicker(time.Duration(keepAliveSec) * time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				c.send(&inout.GraphQLWSMessage{Type: inout.GraphQLWSConnectionKeepAlive})
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// start runs the operation of the start message, the results are sent until the operation
+// completes or it is stopped by the client.
+func (c *graphqlWSConn) start(ctx context.Context, msg *inout.GraphQLWSMessage) {
+	query := inout.GraphQLQuery{}
+	if err := json.Unmarshal(msg.Payload, &query); err != nil {
+		c.sendError(msg.ID, inout.GraphQLWSError, "invalid payload")
+		return
+	}
+
+	if c.e.Persisted != nil {
+		if err := c.e.Persisted.Resolve(ctx, &query); err != nil {
+			c.sendError(msg.ID, inout.GraphQLWSError, err.Error())
+			return
+		}
+	}
+
+	c.mu.Lock()
+	_, exist := c.operations[msg.ID]
+	count := len(c.operations)
+	c.mu.Unlock()
+
+	if exist {
+		c.sendError(msg.ID, inout.GraphQLWSError, "operation id is in use")
+		return
+	}
+	if max := c.e.Config.Subscription.MaxPerConnection; max > 0 && count >= max {
+		c.sendError(msg.ID, inout.GraphQLWSError, "too many operations on the connection")
+		return
+	}
+
+	opCtx, cancel := context.WithCancel(ctx)
+	responses, queryErrors := c.e.GraphQL.Subscribe(session.FromVariables(opCtx, query.Variables), query.Query, query.OpName, query.Variables)
+	if len(queryErrors) > 0 {
+		cancel()
+		queryErrors, _ = Expand(queryErrors)
+		c.sendPayload(msg.ID, inout.GraphQLWSError, queryErrors)
+		return
+	}
+
+	op := &graphqlWSOperation{cancel: cancel}
+	c.mu.Lock()
+	c.operations[msg.ID] = op
+	c.mu.Unlock()
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+
+		for res := range responses {
+			var resolverErrors error
+			res.Errors, resolverErrors = Expand(res.Errors)
+			if resolverErrors != nil {
+				c.e.Logger.Error().Fields(map[string]interface{}{
+					"id":    msg.ID,
+					"error": redact.String(resolverErrors.Error()),
+				}).Msgf("handlers: [GraphQLWS] operation error occurred")
+			}
+			c.sendPayload(msg.ID, inout.GraphQLWSData, res)
+		}
+
+		// The operation which is stopped by the client or the closing connection does not complete.
+		if opCtx.Err() == nil {
+			c.sendPayload(msg.ID, inout.GraphQLWSComplete, nil)
+		}
+		c.remove(msg.ID, op)
+	}()
+}
+
+// stop cancels the operation of the id.
+func (c *graphqlWSConn) stop(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if op, ok := c.operations[id]; ok {
+		op.cancel()
+		delete(c.operations, id)
+	}
+}
+
+// remove removes the completed operation, the id may be used by a new operation after it is stopped.
+func (c *graphqlWSConn) remove(id string, op *graphqlWSOperation) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	op.cancel()
+	if c.operations[id] == op {
+		delete(c.operations, id)
+	}
+}
+
+func (c *graphqlWSConn) sendError(id, typ, message string) {
+	c.sendPayload(id, typ, []*gographqlerrors.QueryError{{Message: message}})
+}
+
+func (c *graphqlWSConn) sendPayload(id, typ string, payload interface{}) {
+	msg := &inout.GraphQLWSMessage{ID: id, Type: typ}
+	if payload != nil {
+		b, err := json.Marshal(payload)
+		if err != nil {
+			c.e.Logger.Error().Err(err).Msgf("handlers: [GraphQLWS] json marshal payload failed")
+			return
+		}
+		msg.Payload = b
+	}
+	c.send(msg)
+}
+
+// send writes the message, the failure is ignored since the receiving loop ends on the broken connection.
+func (c *graphqlWSConn) send(msg *inout.GraphQLWSMessage) {
+	websocket.JSON.Send(c.ws, msg)
+}
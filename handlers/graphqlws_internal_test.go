@@ -0,0 +1,234 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/rs/zerolog"
+	"golang.org/x/net/websocket"
+
+	"github.com/honestbee/Zen/auth"
+	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/inout"
+	"github.com/honestbee/Zen/models"
+	"github.com/honestbee/Zen/resolvers"
+	"github.com/honestbee/Zen/subscription"
+)
+
+const (
+	testWSOrigin = "https://help.honestbee.tw"
+	testWSAPIKey = "secret"
+)
+
+func newGraphQLWSTestServer(t *testing.T) (*httptest.Server, *models.MockModels, func()) {
+	logger := zerolog.New(ioutil.Discard)
+	conf := &config.Config{
+		HTTP:         &config.HTTP{},
+		Auth:         &config.Auth{APIKeys: "web:" + auth.Hash(testWSAPIKey) + ":" + auth.ScopeTicketsCreate},
+		GraphQL:      &config.GraphQL{MaxDepth: 13, MaxParallelism: 10},
+		Subscription: &config.Subscription{BufferSize: 16, MaxPerConnection: 1, AllowedOrigins: testWSOrigin},
+	}
+	authenticator, err := auth.New(conf)
+	if err != nil {
+		t.Fatalf("new authenticator failed:%v", err)
+	}
+	ms := models.NewMockService()
+	broker, _ := subscription.New(conf, &logger, ms)
+	graphql, err := resolvers.New(conf, &logger, ms, nil, nil, nil, broker, nil, nil)
+	if err != nil {
+		t.Fatalf("new graphql failed:%v", err)
+	}
+
+	mux := httprouter.New()
+	mux.GET("/graphql", GraphQLWS(&Env{Config: conf, Logger: &logger, Service: ms, GraphQL: graphql, Auth: authenticator}))
+	server := httptest.NewServer(mux)
+
+	return server, ms, func() {
+		broker.Close()
+		server.Close()
+	}
+}
+
+func dialGraphQLWS(t *testing.T, server *httptest.Server, protocol string) (*websocket.Conn, error) {
+	return dialGraphQLWSFrom(t, server, testWSOrigin, protocol, nil)
+}
+
+func dialGraphQLWSFrom(t *testing.T, server *httptest.Server, origin, protocol string, header http.Header) (*websocket.Conn, error) {
+	wsConf, err := websocket.NewConfig(strings.Replace(server.URL, "http", "ws", 1)+"/graphql", origin)
+	if err != nil {
+		t.Fatalf("new websocket config failed:%v", err)
+	}
+	wsConf.Protocol = []string{protocol}
+	wsConf.Header = header
+	return websocket.DialConfig(wsConf)
+}
+
+func receiveGraphQLWS(t *testing.T, ws *websocket.Conn) *inout.GraphQLWSMessage {
+	ws.SetReadDeadline(time.Now().Add(time.Second))
+	msg := new(inout.GraphQLWSMessage)
+	if err := websocket.JSON.Receive(ws, msg); err != nil {
+		t.Fatalf("receive message failed:%v", err)
+	}
+	return msg
+}
+
+func startGraphQLWS(t *testing.T, ws *websocket.Conn, id, query string) {
+	payload, _ := json.Marshal(inout.GraphQLQuery{Query: query})
+	if err := websocket.JSON.Send(ws, &inout.GraphQLWSMessage{ID: id, Type: inout.GraphQLWSStart, Payload: payload}); err != nil {
+		t.Fatalf("send start message failed:%v", err)
+	}
+}
+
+func TestGraphQLWS(t *testing.T) {
+	server, ms, closeAll := newGraphQLWSTestServer(t)
+	defer closeAll()
+
+	if _, err := dialGraphQLWS(t, server, "unknown"); err == nil {
+		t.Errorf("expect the unknown protocol rejected, actual accepted")
+	}
+
+	ws, err := dialGraphQLWS(t, server, graphqlWSProtocol)
+	if err != nil {
+		t.Fatalf("dial failed:%v", err)
+	}
+	defer ws.Close()
+
+	startGraphQLWS(t, ws, "0", `{ nodes(ids: []) { id } }`)
+	if msg := receiveGraphQLWS(t, ws); msg.Type != inout.GraphQLWSError || msg.ID != "0" {
+		t.Errorf("expect error of the uninitialized connection, actual:%+v", msg)
+	}
+
+	websocket.JSON.Send(ws, &inout.GraphQLWSMessage{Type: inout.GraphQLWSConnectionInit})
+	if msg := receiveGraphQLWS(t, ws); msg.Type != inout.GraphQLWSConnectionAck {
+		t.Errorf("expect connection ack, actual:%+v", msg)
+	}
+
+	startGraphQLWS(t, ws, "1", `subscription { categoriesChanged(countryCode: TW) { zendeskId } }`)
+	startGraphQLWS(t, ws, "2", `subscription { categoriesChanged(countryCode: TW) { zendeskId } }`)
+	if msg := receiveGraphQLWS(t, ws); msg.Type != inout.GraphQLWSError || msg.ID != "2" {
+		t.Errorf("expect error of the operations limit, actual:%+v", msg)
+	}
+
+	// The broker subscribes the events of the mock in background, publish until the data is received.
+	received := make(chan *inout.GraphQLWSMessage, 1)
+	go func() {
+		ws.SetReadDeadline(time.Now().Add(time.Second))
+		msg := new(inout.GraphQLWSMessage)
+		websocket.JSON.Receive(ws, msg)
+		received <- msg
+	}()
+	var msg *inout.GraphQLWSMessage
+	for i := 0; i < 100 && msg == nil; i++ {
+		ms.PublishEvent(context.Background(), &models.Event{Type: models.EventCategoriesChanged, CountryCode: "tw", Locale: "en-us", IDs: []int{1}})
+		select {
+		case msg = <-received:
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	if msg == nil || msg.Type != inout.GraphQLWSData || msg.ID != "1" ||
+		string(msg.Payload) != `{"data":{"categoriesChanged":[{"zendeskId":"3345678"}]}}` {
+		t.Errorf("expect data of the subscription, actual:%+v", msg)
+	}
+
+	websocket.JSON.Send(ws, &inout.GraphQLWSMessage{ID: "1", Type: inout.GraphQLWSStop})
+
+	// The operation id can be used after it is stopped, the query completes after its data.
+	for i := 0; i < 100; i++ {
+		startGraphQLWS(t, ws, "1", `{ nodes(ids: []) { id } }`)
+		if msg = receiveGraphQLWS(t, ws); msg.Type != inout.GraphQLWSError {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	for msg.Type == inout.GraphQLWSData && string(msg.Payload) != `{"data":{"nodes":[]},"extensions":{"cost":{"requestedQueryCost":1}}}` {
+		// Skip the data of the events published before stopping.
+		msg = receiveGraphQLWS(t, ws)
+	}
+	if msg.Type != inout.GraphQLWSData || string(msg.Payload) != `{"data":{"nodes":[]},"extensions":{"cost":{"requestedQueryCost":1}}}` {
+		t.Errorf("expect data of the query, actual:%+v", msg)
+	}
+	if msg = receiveGraphQLWS(t, ws); msg.Type != inout.GraphQLWSComplete || msg.ID != "1" {
+		t.Errorf("expect complete of the query, actual:%+v", msg)
+	}
+}
+
+func TestGraphQLWSHandshake(t *testing.T) {
+	server, _, closeAll := newGraphQLWSTestServer(t)
+	defer closeAll()
+
+	testCases := [...]struct {
+		description string
+		origin      string
+		apiKey      string
+		expectErr   bool
+	}{
+		{
+			description: "testing allowed origin case",
+			origin:      testWSOrigin,
+		},
+		{
+			description: "testing allowed origin in uppercase case",
+			origin:      "HTTPS://Help.Honestbee.TW",
+		},
+		{
+			description: "testing not allowed origin case",
+			origin:      "https://evil.example.com",
+			expectErr:   true,
+		},
+		{
+			description: "testing allowed origin with api key case",
+			origin:      testWSOrigin,
+			apiKey:      testWSAPIKey,
+		},
+		{
+			description: "testing invalid api key case",
+			origin:      testWSOrigin,
+			apiKey:      "invalid",
+			expectErr:   true,
+		},
+	}
+
+	for _, tt := range testCases {
+		header := http.Header{}
+		if tt.apiKey != "" {
+			header.Set(auth.APIKeyHeader, tt.apiKey)
+		}
+		ws, err := dialGraphQLWSFrom(t, server, tt.origin, graphqlWSProtocol, header)
+		if (err != nil) != tt.expectErr {
+			t.Errorf("[%s] expect error:%v, actual:%v", tt.description, tt.expectErr, err)
+		}
+		if ws != nil {
+			ws.Close()
+		}
+	}
+}
+
+func TestAllowedOrigin(t *testing.T) {
+	origins := []string{"https://help.honestbee.tw", "http://localhost:3000"}
+
+	testCases := [...]struct {
+		origin string
+		expect bool
+	}{
+		{origin: "https://help.honestbee.tw", expect: true},
+		{origin: "https://help.honestbee.tw/", expect: true},
+		{origin: "http://localhost:3000", expect: true},
+		{origin: "http://localhost:3001", expect: false},
+		{origin: "http://help.honestbee.tw", expect: false},
+		{origin: "https://help.honestbee.tw.example.com", expect: false},
+		{origin: "null", expect: false},
+	}
+
+	for _, tt := range testCases {
+		if actual := allowedOrigin(origins, tt.origin); actual != tt.expect {
+			t.Errorf("origin:%s expect:%v, actual:%v", tt.origin, tt.expect, actual)
+		}
+	}
+}
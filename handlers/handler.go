@@ -8,22 +8,32 @@ import (
 	"github.com/julienschmidt/httprouter"
 	"github.com/rs/zerolog"
 
+	"github.com/honestbee/Zen/antispam"
+	"github.com/honestbee/Zen/auth"
 	"github.com/honestbee/Zen/config"
 	"github.com/honestbee/Zen/errs"
 	"github.com/honestbee/Zen/examiner"
 	"github.com/honestbee/Zen/models"
+	"github.com/honestbee/Zen/persisted"
+	"github.com/honestbee/Zen/redact"
 	"github.com/honestbee/Zen/resolvers"
+	"github.com/honestbee/Zen/session"
+	"github.com/honestbee/Zen/votes"
 	"github.com/honestbee/Zen/zendesk"
 )
 
 // Env is the application-wid configuration.
 type Env struct {
-	Config   *config.Config
-	Logger   *zerolog.Logger
-	Service  models.HelpDeskService
-	Examiner *examiner.Examiner
-	ZenDesk  *zendesk.ZenDesk
-	GraphQL  *resolvers.GraphQL
+	Config    *config.Config
+	Logger    *zerolog.Logger
+	Service   models.HelpDeskService
+	Examiner  *examiner.Examiner
+	ZenDesk   *zendesk.ZenDesk
+	GraphQL   *resolvers.GraphQL
+	Guard     *antispam.Guard
+	Persisted *persisted.Store
+	Auth      *auth.Authenticator
+	Votes     *votes.Ledger
 }
 
 type decompressor func(httprouter.Params, *http.Request) (interface{}, error)
@@ -37,7 +47,33 @@ type processor struct {
 	product interface{}
 }
 
+// authentication puts the principal of the request credentials into the request context,
+// the request without credentials is served as anonymous.
+func (p *processor) authentication() {
+	if p.e.Auth == nil {
+		return
+	}
+	ctx, err := p.e.Auth.NewContext(p.source2.Context(), auth.CredentialsFromRequest(p.source2))
+	if err != nil {
+		p.err = err
+		return
+	}
+	p.source2 = p.source2.WithContext(ctx)
+}
+
+// tracking puts the anonymous session id and the device id of the request headers into the request context,
+// the device id issued to the voter is sent back in the response header.
+func (p *processor) tracking(w http.ResponseWriter) {
+	ctx := session.WithDeviceIssuer(session.FromRequest(p.source2), func(deviceID string) {
+		w.Header().Set(session.DeviceHeader, deviceID)
+	})
+	p.source2 = p.source2.WithContext(ctx)
+}
+
 func (p *processor) preparation(f decompressor) {
+	if p.err != nil {
+		return
+	}
 	p.product, p.err = f(p.source1, p.source2)
 }
 
@@ -69,37 +105,47 @@ func Middleware(e *Env, dec decompressor, fn handler) httprouter.Handle {
 
 		e.Logger.Info().Fields(map[string]interface{}{
 			"from":   r.RemoteAddr,
-			"path":   r.URL.Path,
+			"path":   redact.String(r.URL.Path),
 			"method": r.Method,
 			"agent":  r.UserAgent(),
 		}).Msgf("receiving data")
 
+		proc.authentication()
+		proc.tracking(w)
 		proc.preparation(dec)
 		proc.handling(fn)
-		proc.production(encoder.Encode)
+		proc.production(func(v interface{}) error {
+			return writeContent(w, r, e.Config.HTTP, contentOf(r, v), http.StatusOK, v)
+		})
 
 		if proc.err != nil {
-			er, ok := proc.err.(*errs.Error)
-			if !ok {
-				// Any error types we don't specifically look out for default
-				// to serving a HTTP 500.
-				er = errs.NewErr(errs.ServerInternalErrorCode, proc.err)
-			}
-			if er.InternalErr != nil {
-				e.Logger.Error().Fields(map[string]interface{}{
-					"from":   r.RemoteAddr,
-					"path":   r.URL.Path,
-					"method": r.Method,
-					"agent":  r.UserAgent(),
-					"error":  er.Error(),
-				}).Msgf("middleware error occurred")
-			}
+			er := failure(e, r, proc.err)
 			w.WriteHeader(er.Status)
 			encoder.Encode(er)
 		}
 	}
 }
 
+// failure converts the processor error into *errs.Error and logs the internal error.
+func failure(e *Env, r *http.Request, err error) *errs.Error {
+	er, ok := err.(*errs.Error)
+	if !ok {
+		// Any error types we don't specifically look out for default
+		// to serving a HTTP 500.
+		er = errs.NewErr(errs.ServerInternalErrorCode, err)
+	}
+	if er.InternalErr != nil {
+		e.Logger.Error().Fields(map[string]interface{}{
+			"from":   r.RemoteAddr,
+			"path":   redact.String(r.URL.Path),
+			"method": r.Method,
+			"agent":  r.UserAgent(),
+			"error":  redact.String(er.Error()),
+		}).Msgf("middleware error occurred")
+	}
+	return er
+}
+
 // GraphQLMiddleware pre-handle every incoming request and generate output to the client based on every handler returned error code.
 func GraphQLMiddleware(e *Env, dec decompressor, fn handler) httprouter.Handle {
 	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
@@ -114,12 +160,17 @@ func GraphQLMiddleware(e *Env, dec decompressor, fn handler) httprouter.Handle {
 
 		e.Logger.Info().Fields(map[string]interface{}{
 			"from":   r.RemoteAddr,
-			"path":   r.URL.Path,
+			"path":   redact.String(r.URL.Path),
 			"method": r.Method,
 		}).Msgf("receiving data")
 
+		proc.authentication()
+		proc.tracking(w)
 		proc.preparation(dec)
 		proc.handling(fn)
+		if r.Method == http.MethodGet {
+			setGraphQLCacheControl(w, e, proc)
+		}
 		encoder.Encode(proc.product)
 
 		er, ok := proc.err.(*errs.Error)
@@ -131,10 +182,10 @@ func GraphQLMiddleware(e *Env, dec decompressor, fn handler) httprouter.Handle {
 		if er.InternalErr != nil {
 			e.Logger.Error().Fields(map[string]interface{}{
 				"from":   r.RemoteAddr,
-				"path":   r.URL.Path,
+				"path":   redact.String(r.URL.Path),
 				"method": r.Method,
 				"agent":  r.UserAgent(),
-				"error":  er.Error(),
+				"error":  redact.String(er.Error()),
 			}).Msgf("middleware error occurred")
 		}
 	}
@@ -16,11 +16,15 @@ import (
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 
+	"github.com/honestbee/Zen/antispam"
+	"github.com/honestbee/Zen/auth"
 	"github.com/honestbee/Zen/config"
 	"github.com/honestbee/Zen/errs"
 	"github.com/honestbee/Zen/examiner"
 	"github.com/honestbee/Zen/inout"
 	"github.com/honestbee/Zen/models"
+	"github.com/honestbee/Zen/purge"
+	"github.com/honestbee/Zen/votes"
 	"github.com/honestbee/Zen/zendesk"
 )
 
@@ -30,9 +34,14 @@ func init() {
 	logger := zerolog.New(ioutil.Discard)
 	conf := &config.Config{
 		HTTP: &config.HTTP{
-			BasicAuthUser: "admin",
-			BasicAuthPwd:  "33456783345678",
+			BasicAuthUser:    "admin",
+			BasicAuthPwdHash: "$2a$04$O2KD8USMnvxMoRBiwe/iAuiQPS6DghEYWN8pU9w8BKzR10rGCaWFW",
 		},
+		Auth: &config.Auth{
+			APIKeys: "reader:ec4408df15da46b328f6f3246fa723d0aa6cb0f0a0dd9c4626080ab1b02aa3b2:tickets:create",
+		},
+		Analytics: &config.Analytics{},
+		Votes:     &config.Votes{},
 	}
 	ms := &models.MockModels{}
 	zend, _ := zendesk.NewZenDesk(&config.Config{
@@ -57,13 +66,20 @@ func init() {
 			SectionsRefreshLimit:   1000,
 			ArticlesRefreshLimit:   1000,
 		},
-	}, &logger, ms, zend)
+	}, &logger, ms, zend, purge.NopPurger{})
+	guard, _ := antispam.New(&config.Config{
+		Antispam: &config.Antispam{Enable: false},
+	}, &logger, ms, nil)
+	authn, _ := auth.New(conf)
 	e = &Env{
 		Config:   conf,
 		Logger:   &logger,
 		Service:  ms,
 		Examiner: exam,
 		ZenDesk:  zend,
+		Guard:    guard,
+		Auth:     authn,
+		Votes:    votes.New(conf, ms, zend),
 	}
 }
 func TestMiddleware(t *testing.T) {
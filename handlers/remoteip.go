@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/honestbee/Zen/antispam"
+)
+
+// RemoteIPMiddleware resolves the client ip of the requests by clientIP and carries it in their contexts,
+// so that the rate limits, the cost budgets and the voters are keyed on it.
+func RemoteIPMiddleware(proxies []*net.IPNet, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := antispam.WithRemoteIP(r.Context(), clientIP(r, proxies))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// remoteIP returns the client ip resolved by RemoteIPMiddleware, or the peer address without it.
+func remoteIP(r *http.Request) string {
+	if ip := antispam.RemoteIPFromContext(r.Context()); ip != "" {
+		return ip
+	}
+	return clientIP(r, nil)
+}
+
+// clientIP returns the rightmost address not added by the trusted proxies. The X-Forwarded-For
+// addresses are only trusted as far as the proxies appended them, since the client sets the leftmost ones.
+func clientIP(r *http.Request, proxies []*net.IPNet) string {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+
+	var hops []string
+	for _, forwarded := range r.Header["X-Forwarded-For"] {
+		hops = append(hops, strings.Split(forwarded, ",")...)
+	}
+	for i := len(hops) - 1; i >= 0 && trusted(ip, proxies); i-- {
+		if hop := strings.TrimSpace(hops[i]); hop != "" {
+			ip = hop
+		}
+	}
+	return ip
+}
+
+func trusted(ip string, proxies []*net.IPNet) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, proxy := range proxies {
+		if proxy.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/honestbee/Zen/antispam"
+)
+
+func TestClientIP(t *testing.T) {
+	_, lb, _ := net.ParseCIDR("10.0.0.0/8")
+	proxies := []*net.IPNet{lb}
+
+	testCases := [...]struct {
+		description string
+		input       *http.Request
+		proxies     []*net.IPNet
+		expect      string
+	}{
+		{
+			description: "testing remote addr case",
+			input:       &http.Request{RemoteAddr: "10.0.0.1:34567"},
+			expect:      "10.0.0.1",
+		},
+		{
+			description: "testing remote addr without port case",
+			input:       &http.Request{RemoteAddr: "10.0.0.1"},
+			expect:      "10.0.0.1",
+		},
+		{
+			description: "testing x-forwarded-for without trusted proxies case",
+			input: &http.Request{
+				RemoteAddr: "10.0.0.1:34567",
+				Header:     http.Header{"X-Forwarded-For": []string{"203.0.113.7"}},
+			},
+			expect: "10.0.0.1",
+		},
+		{
+			description: "testing x-forwarded-for from trusted proxy case",
+			input: &http.Request{
+				RemoteAddr: "10.0.0.1:34567",
+				Header:     http.Header{"X-Forwarded-For": []string{"203.0.113.7"}},
+			},
+			proxies: proxies,
+			expect:  "203.0.113.7",
+		},
+		{
+			description: "testing spoofed x-forwarded-for case",
+			input: &http.Request{
+				RemoteAddr: "10.0.0.1:34567",
+				Header:     http.Header{"X-Forwarded-For": []string{"198.51.100.1, 203.0.113.7, 10.0.0.2"}},
+			},
+			proxies: proxies,
+			expect:  "203.0.113.7",
+		},
+		{
+			description: "testing x-forwarded-for from untrusted peer case",
+			input: &http.Request{
+				RemoteAddr: "203.0.113.9:34567",
+				Header:     http.Header{"X-Forwarded-For": []string{"198.51.100.1"}},
+			},
+			proxies: proxies,
+			expect:  "203.0.113.9",
+		},
+		{
+			description: "testing all hops trusted case",
+			input: &http.Request{
+				RemoteAddr: "10.0.0.1:34567",
+				Header:     http.Header{"X-Forwarded-For": []string{"10.0.0.3", "10.0.0.2"}},
+			},
+			proxies: proxies,
+			expect:  "10.0.0.3",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			if actual := clientIP(tt.input, tt.proxies); actual != tt.expect {
+				t.Errorf("[%s] expect:%s, actual:%s", tt.description, tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestRemoteIPMiddleware(t *testing.T) {
+	_, lb, _ := net.ParseCIDR("10.0.0.0/8")
+
+	var actual string
+	h := RemoteIPMiddleware([]*net.IPNet{lb}, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actual = antispam.RemoteIPFromContext(r.Context())
+		if ip := remoteIP(r); ip != actual {
+			t.Errorf("remote ip expect:%s, actual:%s", actual, ip)
+		}
+	}))
+
+	r := httptest.NewRequest("GET", "/graphql", nil)
+	r.RemoteAddr = "10.0.0.1:34567"
+	r.Header.Set("X-Forwarded-For", "198.51.100.1, 203.0.113.7")
+	h.ServeHTTP(httptest.NewRecorder(), r)
+	if actual != "203.0.113.7" {
+		t.Errorf("expect:203.0.113.7, actual:%s", actual)
+	}
+}
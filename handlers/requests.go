@@ -1,15 +1,22 @@
 package handlers
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"github.com/julienschmidt/httprouter"
 	"github.com/pkg/errors"
 
+	"github.com/honestbee/Zen/antispam"
+	"github.com/honestbee/Zen/auth"
 	"github.com/honestbee/Zen/errs"
 	"github.com/honestbee/Zen/inout"
+	"github.com/honestbee/Zen/models"
+	"github.com/honestbee/Zen/redact"
+	"github.com/honestbee/Zen/session"
 )
 
 // CreateRequestDecompressor combines params from URL or FORM
@@ -29,6 +36,7 @@ func CreateRequestDecompressor(ps httprouter.Params, r *http.Request) (interface
 			errors.Errorf("handlers: [CreateRequestDecompressor] country code is empty"),
 		)
 	}
+	ret.RemoteIP = remoteIP(r)
 
 	return ret, nil
 }
@@ -39,16 +47,94 @@ func CreateRequestHandler(ctx context.Context, e *Env, in interface{}) (interfac
 	if !ok {
 		return nil, errs.NewErr(
 			errs.ServerInternalErrorCode,
-			errors.Errorf("handlers: [CreateRequestHandler] cast %v into *CreateRequestIn failed", in),
+			errors.Errorf("handlers: [CreateRequestHandler] cast %T into *CreateRequestIn failed", in),
 		)
 	}
 
+	if e.Config.Auth.TicketsScopeRequired {
+		if err := auth.Require(ctx, auth.ScopeTicketsCreate); err != nil {
+			return nil, err
+		}
+	}
+
+	data := createRequestData(request)
+	sub := newSubmission(request, data)
+	if err := e.Guard.Check(ctx, sub); err != nil {
+		return nil, antispam.RejectedErr(err, "handlers: [CreateRequestHandler] guard check failed")
+	}
+
+	// The viewed articles are attached on a best effort basis, the request is filed without them on failure.
+	if sessionID := session.IDFromContext(ctx); sessionID != "" {
+		if articleIDs, err := e.Service.GetSessionViewedArticles(ctx, sessionID); err == nil {
+			request.AttachViewedArticles(e.Config.Analytics.DeflectionFieldID, articleIDs)
+		}
+	}
+
 	if err := e.ZenDesk.CreateRequest(ctx, request.CountryCode, request.Data); err != nil {
+		e.Guard.Release(ctx, sub)
 		return nil, errs.NewErr(
 			errs.InvalidAttributeErrorCode,
-			errors.Wrapf(err, "handlers: [CreateRequestHandler] zendesk create request failed"),
+			errors.Wrapf(
+				redact.Error(err, data.Secrets(e.Config)...),
+				"handlers: [CreateRequestHandler] zendesk create request failed",
+			),
 		)
 	}
+	e.Service.RecordSessionEvent(ctx, &models.SessionEvent{
+		SessionID:   session.IDFromContext(ctx),
+		Kind:        models.RequestSessionEvent,
+		CountryCode: request.CountryCode,
+	})
 
 	return nil, errs.NewErr(errs.SuccessCreatedCode, nil)
 }
+
+// createRequestData extracts the known fields of the create request input,
+// the data is passed to zendesk as it is.
+func createRequestData(in *inout.CreateRequestIn) inout.CreateRequestData {
+	data := inout.CreateRequestData{}
+	b, err := json.Marshal(in.Data)
+	if err != nil {
+		return data
+	}
+	json.Unmarshal(b, &data)
+
+	// Zendesk accepts numeric custom field ids and values which are not decoded above.
+	raw := struct {
+		Request struct {
+			CustomFields []struct {
+				ID    interface{} `json:"id"`
+				Value interface{} `json:"value"`
+			} `json:"custom_fields"`
+		} `json:"request"`
+	}{}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	dec.Decode(&raw)
+	if len(raw.Request.CustomFields) > 0 {
+		customFields := make([]inout.CreateRequestDataRequestCustomField, 0, len(raw.Request.CustomFields))
+		for _, field := range raw.Request.CustomFields {
+			customFields = append(customFields, inout.CreateRequestDataRequestCustomField{
+				ID:    fmt.Sprint(field.ID),
+				Value: fmt.Sprint(field.Value),
+			})
+		}
+		data.Request.CustomFields = &customFields
+	}
+
+	return data
+}
+
+// newSubmission returns the antispam submission of the create request input.
+func newSubmission(in *inout.CreateRequestIn, data inout.CreateRequestData) *antispam.Submission {
+	return &antispam.Submission{
+		Source:       "rest",
+		RemoteIP:     in.RemoteIP,
+		CountryCode:  in.CountryCode,
+		Email:        data.Request.Requester.Email,
+		Subject:      data.Request.Subject,
+		Body:         data.Request.Comment.Body,
+		CaptchaToken: in.CaptchaToken,
+		Honeypot:     in.Website,
+	}
+}
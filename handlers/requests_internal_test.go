@@ -6,13 +6,21 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/go-test/deep"
 	"github.com/h2non/gock"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
 
+	"github.com/honestbee/Zen/antispam"
+	"github.com/honestbee/Zen/config"
 	"github.com/honestbee/Zen/errs"
 	"github.com/honestbee/Zen/inout"
+	"github.com/honestbee/Zen/models"
+	"github.com/honestbee/Zen/session"
 )
 
 func TestCreateRequestDecompressor(t *testing.T) {
@@ -172,3 +180,257 @@ func TestCreateRequestHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateRequestHandlerRejected(t *testing.T) {
+	logger := zerolog.New(ioutil.Discard)
+	guard, _ := antispam.New(&config.Config{
+		Antispam: &config.Antispam{
+			Enable:             true,
+			IPRateLimit:        10,
+			EmailRateLimit:     5,
+			RateLimitWindowSec: 3600,
+			DuplicateWindowSec: 86400,
+		},
+	}, &logger, models.NewMockService(), &antispam.FakeVerifier{Token: "valid"})
+	guarded := &Env{
+		Config:   e.Config,
+		Logger:   e.Logger,
+		Service:  e.Service,
+		Examiner: e.Examiner,
+		ZenDesk:  e.ZenDesk,
+		Guard:    guard,
+	}
+
+	newInput := func(email, captchaToken, website, remoteIP string) *inout.CreateRequestIn {
+		return &inout.CreateRequestIn{
+			CountryCode: "tw",
+			Data: map[string]interface{}{
+				"request": map[string]interface{}{
+					"requester": map[string]interface{}{
+						"name":  "zen project tester",
+						"email": email,
+					},
+					"subject": "testing, please ignore",
+					"comment": map[string]interface{}{
+						"body": "testing, please ignore!!!",
+					},
+				},
+			},
+			CaptchaToken: captchaToken,
+			Website:      website,
+			RemoteIP:     remoteIP,
+		}
+	}
+
+	testCases := [...]struct {
+		description   string
+		input         interface{}
+		expectErrCode int
+	}{
+		{
+			description:   "testing honeypot filled case",
+			input:         newInput("zen.project.tester@honestbee.com", "valid", "http://spam.example.com", "10.0.0.1"),
+			expectErrCode: http.StatusBadRequest,
+		},
+		{
+			description:   "testing captcha failed case",
+			input:         newInput("zen.project.tester@honestbee.com", "invalid", "", "10.0.0.1"),
+			expectErrCode: http.StatusBadRequest,
+		},
+		{
+			description:   "testing ip rate limited case",
+			input:         newInput("zen.project.tester@honestbee.com", "valid", "", models.RateLimitedRemoteIP),
+			expectErrCode: http.StatusTooManyRequests,
+		},
+		{
+			description:   "testing email rate limited case",
+			input:         newInput(models.RateLimitedEmail, "valid", "", "10.0.0.1"),
+			expectErrCode: http.StatusTooManyRequests,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			_, err := CreateRequestHandler(context.Background(), guarded, tt.input)
+			if tt.expectErrCode != err.(*errs.Error).Status {
+				t.Errorf("[%s] error code expect:%v, actual:%v", tt.description, tt.expectErrCode, err)
+			}
+		})
+	}
+}
+
+func TestCreateRequestHandlerAttachesViewedArticles(t *testing.T) {
+	defer gock.Off()
+
+	testCases := [...]struct {
+		description string
+		fieldID     int
+		expectBody  string
+	}{
+		{
+			description: "testing comment case",
+			fieldID:     0,
+			expectBody: `{
+				"request": {
+					"comment": {"body": "where is my refund\n\nViewed articles: 3345679, 3345680"},
+					"requester": {"name": "zen project tester", "email": "zen.project.tester@honestbee.com"},
+					"subject": "refund"
+				}
+			}`,
+		},
+		{
+			description: "testing custom field case",
+			fieldID:     360000123456,
+			expectBody: `{
+				"request": {
+					"comment": {"body": "where is my refund"},
+					"requester": {"name": "zen project tester", "email": "zen.project.tester@honestbee.com"},
+					"subject": "refund",
+					"custom_fields": [{"id": 360000123456, "value": "3345679,3345680"}]
+				}
+			}`,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			gock.New("https://honestbeehelp-tw.zendesk.com").
+				Post("/api/v2/requests.json").
+				BodyString(tt.expectBody).
+				Reply(http.StatusCreated)
+
+			ms := models.NewMockService()
+			conf := *e.Config
+			conf.Analytics = &config.Analytics{DeflectionFieldID: tt.fieldID}
+			env := *e
+			env.Config = &conf
+			env.Service = ms
+
+			// The articles are attached distinctly, the latest viewed first.
+			ctx := session.WithID(context.Background(), "anon-42")
+			for _, articleID := range []int{3345679, 3345680, 3345679} {
+				ms.RecordSessionEvent(ctx, &models.SessionEvent{
+					SessionID:   "anon-42",
+					Kind:        models.ViewSessionEvent,
+					ArticleID:   articleID,
+					CountryCode: "tw",
+					Locale:      "en-us",
+				})
+			}
+
+			in := &inout.CreateRequestIn{
+				CountryCode: "tw",
+				Data: map[string]interface{}{
+					"request": map[string]interface{}{
+						"requester": map[string]interface{}{
+							"name":  "zen project tester",
+							"email": "zen.project.tester@honestbee.com",
+						},
+						"subject": "refund",
+						"comment": map[string]interface{}{
+							"body": "where is my refund",
+						},
+					},
+				},
+			}
+			_, err := CreateRequestHandler(ctx, &env, in)
+			if http.StatusCreated != err.(*errs.Error).Status {
+				t.Fatalf("[%s] error code expect:%v, actual:%v", tt.description, http.StatusCreated, err)
+			}
+
+			events := ms.RecordedSessionEvents()
+			if last := events[len(events)-1]; last.Kind != models.RequestSessionEvent || last.SessionID != "anon-42" {
+				t.Errorf("[%s] expect the request event recorded, actual:%+v", tt.description, last)
+			}
+		})
+	}
+}
+
+func TestCreateRequestRedacted(t *testing.T) {
+	defer gock.Off()
+
+	const (
+		name        = "Zen Project Tester"
+		email       = "zen.redact.tester@honestbee.com"
+		phone       = "+65 9123 4567"
+		subject     = "my order never arrived"
+		body        = "please call me back on +65 9123 4567"
+		orderNumber = "HB-ORDER-778899"
+	)
+	input := &inout.CreateRequestIn{
+		CountryCode: "tw",
+		Data: map[string]interface{}{
+			"request": map[string]interface{}{
+				"requester": map[string]interface{}{
+					"name":  name,
+					"email": email,
+				},
+				"subject": subject,
+				"comment": map[string]interface{}{
+					"body": body,
+				},
+				"custom_fields": []interface{}{
+					map[string]interface{}{"id": 360000123456, "value": orderNumber},
+					map[string]interface{}{"id": "360000654321", "value": phone},
+				},
+			},
+		},
+	}
+	inputBytes, err := json.Marshal(input)
+	if err != nil {
+		t.Fatalf("json marshal input failed:%v", err)
+	}
+
+	testCases := [...]struct {
+		description string
+		mock        func()
+	}{
+		{
+			description: "testing zendesk create request failed case",
+			mock: func() {
+				gock.New("https://honestbeehelp-tw.zendesk.com").
+					Post("/api/v2/requests.json").
+					Reply(http.StatusUnprocessableEntity)
+			},
+		},
+		{
+			description: "testing zendesk echoes the payload in error case",
+			mock: func() {
+				gock.New("https://honestbeehelp-tw.zendesk.com").
+					Post("/api/v2/requests.json").
+					ReplyError(errors.Errorf("connection reset, payload:%s", inputBytes))
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			tt.mock()
+
+			buf := new(bytes.Buffer)
+			logger := zerolog.New(buf)
+			env := &Env{
+				Config: &config.Config{
+					Redact: &config.Redact{CustomFieldIDs: "360000123456, 360000654321"},
+					Auth:   &config.Auth{},
+				},
+				Logger:  &logger,
+				ZenDesk: e.ZenDesk,
+				Guard:   e.Guard,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/request", bytes.NewReader(inputBytes))
+			Middleware(env, CreateRequestDecompressor, CreateRequestHandler)(httptest.NewRecorder(), req, nil)
+
+			logs := buf.String()
+			if !strings.Contains(logs, "middleware error occurred") {
+				t.Fatalf("[%s] expect the error is logged, actual:%s", tt.description, logs)
+			}
+			for _, secret := range []string{name, email, phone, subject, body, orderNumber} {
+				if strings.Contains(logs, secret) {
+					t.Errorf("[%s] expect %q is redacted, actual:%s", tt.description, secret, logs)
+				}
+			}
+		})
+	}
+}
@@ -2,7 +2,6 @@ package handlers
 
 import (
 	"context"
-	"math"
 	"net/http"
 
 	"github.com/julienschmidt/httprouter"
@@ -11,6 +10,7 @@ import (
 	"github.com/honestbee/Zen/errs"
 	"github.com/honestbee/Zen/inout"
 	"github.com/honestbee/Zen/models"
+	"github.com/honestbee/Zen/session"
 	"github.com/honestbee/Zen/zendesk"
 )
 
@@ -58,6 +58,14 @@ func GetInstantSearchHandler(ctx context.Context, e *Env, in interface{}) (inter
 			errors.Wrapf(err, "handlers: [GetInstantSearchHandler] ZenDesk.InstantSearch failed"),
 		)
 	}
+	e.Service.RecordSearchQuery(ctx, data.Query, data.Locale, data.CountryCode, len(zendeskInstantSearch.Results))
+	e.Service.RecordSessionEvent(ctx, &models.SessionEvent{
+		SessionID:   session.IDFromContext(ctx),
+		Kind:        models.SearchSessionEvent,
+		Query:       data.Query,
+		CountryCode: data.CountryCode,
+		Locale:      data.Locale,
+	})
 
 	searchResult := make([]*inout.InstantSearchResult, len(zendeskInstantSearch.Results))
 
@@ -116,10 +124,9 @@ func GetSearchHandler(ctx context.Context, e *Env, in interface{}) (interface{},
 		)
 	}
 
-	zendeskSearch, err := e.ZenDesk.Search(ctx, categoryIDs, data.Query, data.CountryCode, data.Locale,
+	zendeskSearch, err := e.ZenDesk.SearchFrom(ctx, categoryIDs, data.Query, data.CountryCode, data.Locale, data.Page,
 		&zendesk.Pagination{
 			PerPage:   data.PerPage,
-			Page:      int(math.Round(float64(data.Page)/float64(data.PerPage))) + 1,
 			SortOrder: data.SortOrder,
 		},
 	)
@@ -130,6 +137,17 @@ func GetSearchHandler(ctx context.Context, e *Env, in interface{}) (interface{},
 			errors.Wrapf(err, "handlers: [GetSearchHandler] ZenDesk.Search failed"),
 		)
 	}
+	// Paging through the results isn't counted as another search.
+	if data.Page == 0 {
+		e.Service.RecordSearchQuery(ctx, data.Query, data.Locale, data.CountryCode, zendeskSearch.Count)
+		e.Service.RecordSessionEvent(ctx, &models.SessionEvent{
+			SessionID:   session.IDFromContext(ctx),
+			Kind:        models.SearchSessionEvent,
+			Query:       data.Query,
+			CountryCode: data.CountryCode,
+			Locale:      data.Locale,
+		})
+	}
 	articles := make([]*models.SearchArticle, 0)
 	for _, zendeskArticle := range zendeskSearch.Articles {
 		category, err := e.Service.GetCategoryByArticleID(ctx, zendeskArticle.ID, zendeskArticle.Locale)
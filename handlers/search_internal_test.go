@@ -11,6 +11,7 @@ import (
 
 	"github.com/honestbee/Zen/inout"
 	"github.com/honestbee/Zen/models"
+	"github.com/honestbee/Zen/session"
 	"github.com/honestbee/Zen/zendesk"
 )
 
@@ -239,6 +240,53 @@ func TestInstantSearchHandler(t *testing.T) {
 	}
 }
 
+func TestInstantSearchHandlerRecordsQuery(t *testing.T) {
+	gock.New("https://honestbeehelp-tw.zendesk.com").
+		Get("hc/api/internal/instant_search.json").
+		Filter(func(req *http.Request) bool {
+			return req.URL.Query().Get("query") == "Gift  Card" && req.URL.Query().Get("locale") == "en-us"
+		}).
+		Reply(http.StatusOK).
+		JSON(&zendesk.InstantSearch{Results: []*zendesk.InstantSearchResult{}})
+
+	ms := models.NewMockService()
+	env := *e
+	env.Service = ms
+
+	in := &inout.GetInstantSearchIn{Query: "Gift  Card", Locale: "en-us", CountryCode: "tw"}
+	if _, err := GetInstantSearchHandler(context.Background(), &env, in); err != nil {
+		t.Fatalf("expect no error, actual:%v", err)
+	}
+	expect := []*models.SearchQueryStat{{Query: "gift card", Searches: 1, ZeroResults: 1}}
+	if diff := deep.Equal(expect, ms.RecordedSearchQueries()); diff != nil {
+		t.Errorf("[recorded queries] %v", diff)
+	}
+}
+
+func TestInstantSearchHandlerRedactsQuery(t *testing.T) {
+	gock.New("https://honestbeehelp-tw.zendesk.com").
+		Get("hc/api/internal/instant_search.json").
+		Reply(http.StatusOK).
+		JSON(&zendesk.InstantSearch{Results: []*zendesk.InstantSearchResult{}})
+
+	ms := models.NewMockService()
+	env := *e
+	env.Service = ms
+
+	ctx := session.WithID(context.Background(), "0123456789abcdef")
+	in := &inout.GetInstantSearchIn{Query: "Refund for zen.project.tester@honestbee.com +6591234567", Locale: "en-us", CountryCode: "tw"}
+	if _, err := GetInstantSearchHandler(ctx, &env, in); err != nil {
+		t.Fatalf("expect no error, actual:%v", err)
+	}
+	expect := []*models.SearchQueryStat{{Query: "refund for [email] [phone]", Searches: 1, ZeroResults: 1}}
+	if diff := deep.Equal(expect, ms.RecordedSearchQueries()); diff != nil {
+		t.Errorf("[recorded queries] %v", diff)
+	}
+	if events := ms.RecordedSessionEvents(); len(events) != 1 || events[0].Query != "refund for [email] [phone]" {
+		t.Errorf("[recorded session events] expect the redacted query, actual:%+v", events)
+	}
+}
+
 func TestSearchDecompressor(t *testing.T) {
 	testCases := [...]struct {
 		description string
@@ -59,6 +59,8 @@ func GetArticlesHandler(ctx context.Context, e *Env, in interface{}) (interface{
 			Page:        data.Page,
 			SortBy:      data.SortBy,
 			SortOrder:   data.SortOrder,
+			After:       data.After,
+			Keyset:      data.Keyset,
 			SectionID:   data.SectionID,
 		})
 	if err != nil {
@@ -71,7 +73,7 @@ func GetArticlesHandler(ctx context.Context, e *Env, in interface{}) (interface{
 	return &inout.GetArticlesOut{
 		Articles: articles,
 		BaseOut: &inout.BaseOut{
-			Page:      int(math.Round(float64(data.Page)/float64(data.PerPage))) + 1,
+			Page:      inout.CurrentPage(data.Page, data.PerPage),
 			PerPage:   data.PerPage,
 			PageCount: int(math.Ceil(float64(total) / float64(data.PerPage))),
 			Count:     total,
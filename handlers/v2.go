@@ -0,0 +1,475 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/pkg/errors"
+
+	"github.com/honestbee/Zen/errs"
+	"github.com/honestbee/Zen/inout"
+	"github.com/honestbee/Zen/models"
+	"github.com/honestbee/Zen/redact"
+)
+
+// V2Middleware pre-handle every incoming request of the v2 API, the handler output *inout.V2Out
+// is written with the fields selected by the fields parameter, and the errors are written as the errors of the envelope.
+func V2Middleware(e *Env, dec decompressor, fn handler) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		w.Header().Set("Content-Type", "application/json")
+
+		proc := &processor{
+			e:       e,
+			source1: p,
+			source2: r,
+		}
+
+		e.Logger.Info().Fields(map[string]interface{}{
+			"from":   r.RemoteAddr,
+			"path":   redact.String(r.URL.Path),
+			"method": r.Method,
+			"agent":  r.UserAgent(),
+		}).Msgf("receiving data")
+
+		proc.authentication()
+		proc.tracking(w)
+		proc.preparation(dec)
+		proc.handling(fn)
+		proc.production(func(v interface{}) error {
+			out, ok := v.(*inout.V2Out)
+			if !ok {
+				return errs.NewErr(
+					errs.ServerInternalErrorCode,
+					errors.Errorf("handlers: [V2Middleware] cast %v into *V2Out failed", v),
+				)
+			}
+			// The caching metadata is taken before the fields are dropped.
+			c := contentOf(r, out)
+			if fields := inout.FetchV2Fields(r); fields != nil {
+				data, err := selectFields(out.Data, fields)
+				if err != nil {
+					return errs.NewErr(
+						errs.ServerInternalErrorCode,
+						errors.Wrapf(err, "handlers: [V2Middleware] selectFields failed"),
+					)
+				}
+				out.Data = data
+			}
+
+			status := out.Status
+			if status == 0 {
+				status = http.StatusOK
+			}
+			return writeContent(w, r, e.Config.HTTP, c, status, out)
+		})
+
+		if proc.err != nil {
+			WriteV2Error(w, r, failure(e, r, proc.err))
+		}
+	}
+}
+
+// WriteV2Error writes the error as the errors of the v2 envelope.
+func WriteV2Error(w http.ResponseWriter, r *http.Request, er *errs.Error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(er.Status)
+	json.NewEncoder(w).Encode(&inout.V2Out{
+		Errors: []*inout.V2ErrorOut{inout.NewV2ErrorOut(er)},
+	})
+}
+
+// selectFields keeps the fields of the data object, or of every object of the data array.
+// The unknown fields are ignored.
+func selectFields(data interface{}, fields []string) (interface{}, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "handlers: [selectFields] json marshal failed")
+	}
+	// The numbers are kept as json.Number, so that the IDs are not rounded as float64.
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var v interface{}
+	if err = dec.Decode(&v); err != nil {
+		return nil, errors.Wrapf(err, "handlers: [selectFields] json decode failed")
+	}
+
+	selected := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		selected[field] = true
+	}
+	pick := func(v interface{}) {
+		if m, ok := v.(map[string]interface{}); ok {
+			for name := range m {
+				if !selected[name] {
+					delete(m, name)
+				}
+			}
+		}
+	}
+
+	if a, ok := v.([]interface{}); ok {
+		for _, item := range a {
+			pick(item)
+		}
+	} else {
+		pick(v)
+	}
+	return v, nil
+}
+
+// v2ParamErr returns the invalid attribute error of the request parameter.
+func v2ParamErr(parameter, detail string, err error) *errs.Error {
+	er := errs.NewErr(errs.InvalidAttributeErrorCode, err)
+	er.Parameter, er.Detail = parameter, detail
+	return er
+}
+
+// listBaseIn returns the base input parameters of the v1 listing input.
+func listBaseIn(in interface{}) (*inout.BaseIn, error) {
+	switch in := in.(type) {
+	case *inout.GetCategoriesIn:
+		return in.BaseIn, nil
+	case *inout.GetSectionsIn:
+		return in.BaseIn, nil
+	case *inout.GetCategoriesArticlesIn:
+		return in.BaseIn, nil
+	case *inout.GetArticlesIn:
+		return in.BaseIn, nil
+	case *inout.GetSearchIn:
+		return in.BaseIn, nil
+	}
+	return nil, errs.NewErr(
+		errs.ServerInternalErrorCode,
+		errors.Errorf("handlers: [listBaseIn] %v is not a listing input", in),
+	)
+}
+
+// V2ListDecompressor wraps the decompressor of a v1 listing, the listing is paged by
+// the first and after parameters instead of per_page and page.
+func V2ListDecompressor(dec decompressor) decompressor {
+	return func(ps httprouter.Params, r *http.Request) (interface{}, error) {
+		in, err := dec(ps, r)
+		if err != nil {
+			return nil, err
+		}
+		base, err := listBaseIn(in)
+		if err != nil {
+			return nil, err
+		}
+
+		first, after, err := inout.FetchV2PageParams(r)
+		if err != nil {
+			return nil, v2ParamErr("first", "is not a positive integer",
+				errors.Wrapf(err, "handlers: [V2ListDecompressor] inout.FetchV2PageParams failed"))
+		}
+		// One more row is selected to tell if there is a next page.
+		base.PerPage, base.Page, base.Keyset = first+1, 0, true
+		if after != "" {
+			if base.After, err = inout.DecodeCursor(after, base.SortBy, base.SortOrder); err != nil {
+				return nil, v2ParamErr("after", "is not a valid cursor",
+					errors.Wrapf(err, "handlers: [V2ListDecompressor] inout.DecodeCursor failed"))
+			}
+		}
+
+		return &inout.V2ListIn{In: in, First: first}, nil
+	}
+}
+
+// GetV2SearchDecompressor combines params from URL or FORM and returns params in a structure
+// that GetV2SearchHandler needs, the search results are paged by the offset cursors.
+func GetV2SearchDecompressor(ps httprouter.Params, r *http.Request) (interface{}, error) {
+	in, err := GetSearchDecompressor(ps, r)
+	if err != nil {
+		return nil, err
+	}
+	data := in.(*inout.GetSearchIn)
+
+	first, after, err := inout.FetchV2PageParams(r)
+	if err != nil {
+		return nil, v2ParamErr("first", "is not a positive integer",
+			errors.Wrapf(err, "handlers: [GetV2SearchDecompressor] inout.FetchV2PageParams failed"))
+	}
+	offset := 0
+	if after != "" {
+		last, err := inout.DecodeOffsetCursor(after)
+		if err != nil {
+			return nil, v2ParamErr("after", "is not a valid cursor",
+				errors.Wrapf(err, "handlers: [GetV2SearchDecompressor] inout.DecodeOffsetCursor failed"))
+		}
+		offset = last + 1
+	}
+	data.PerPage, data.Page = first, offset
+
+	return &inout.V2ListIn{In: data, First: first, Offset: offset}, nil
+}
+
+func v2ListIn(in interface{}, name string) (*inout.V2ListIn, error) {
+	list, ok := in.(*inout.V2ListIn)
+	if !ok {
+		return nil, errs.NewErr(
+			errs.ServerInternalErrorCode,
+			errors.Errorf("handlers: [%s] cast %v into *V2ListIn failed", name, in),
+		)
+	}
+	return list, nil
+}
+
+// newV2KeysetOut returns the envelope of a page of the keyset listing, end is the cursor of the last row of the page.
+func newV2KeysetOut(list *inout.V2ListIn, data interface{}, size, total int, hasNext bool, end *models.Cursor) *inout.V2Out {
+	base, _ := listBaseIn(list.In)
+	page := &inout.V2PageOut{
+		Size:            size,
+		Total:           total,
+		HasNextPage:     hasNext,
+		HasPreviousPage: base.After != nil,
+	}
+	if end != nil {
+		page.EndCursor = inout.EncodeCursor(end, base.SortBy, base.SortOrder)
+	}
+	return &inout.V2Out{Data: data, Meta: &inout.V2MetaOut{Page: page}}
+}
+
+// GetV2CategoriesHandler handles get categories request of the v2 API.
+func GetV2CategoriesHandler(ctx context.Context, e *Env, in interface{}) (interface{}, error) {
+	list, err := v2ListIn(in, "GetV2CategoriesHandler")
+	if err != nil {
+		return nil, err
+	}
+	out, err := GetCategoriesHandler(ctx, e, list.In)
+	if err != nil {
+		return nil, err
+	}
+	data := out.(*inout.GetCategoriesOut)
+
+	categories := data.Categories
+	hasNext := len(categories) > list.First
+	if hasNext {
+		categories = categories[:list.First]
+	}
+	var end *models.Cursor
+	if len(categories) == 0 {
+		categories = []*models.Category{}
+	} else {
+		end = categories[len(categories)-1].Cursor()
+	}
+	return newV2KeysetOut(list, categories, len(categories), data.Count, hasNext, end), nil
+}
+
+// GetV2SectionsHandler handles get sections request of the v2 API.
+func GetV2SectionsHandler(ctx context.Context, e *Env, in interface{}) (interface{}, error) {
+	list, err := v2ListIn(in, "GetV2SectionsHandler")
+	if err != nil {
+		return nil, err
+	}
+	out, err := GetSectionsHandler(ctx, e, list.In)
+	if err != nil {
+		return nil, err
+	}
+	data := out.(*inout.GetSectionsOut)
+
+	sections := data.Sections
+	hasNext := len(sections) > list.First
+	if hasNext {
+		sections = sections[:list.First]
+	}
+	var end *models.Cursor
+	if len(sections) == 0 {
+		sections = []*models.Section{}
+	} else {
+		end = sections[len(sections)-1].Cursor()
+	}
+	return newV2KeysetOut(list, sections, len(sections), data.Count, hasNext, end), nil
+}
+
+// GetV2CategoriesArticlesHandler handles get categories articles request of the v2 API.
+func GetV2CategoriesArticlesHandler(ctx context.Context, e *Env, in interface{}) (interface{}, error) {
+	list, err := v2ListIn(in, "GetV2CategoriesArticlesHandler")
+	if err != nil {
+		return nil, err
+	}
+	out, err := GetCategoriesArticlesHandler(ctx, e, list.In)
+	if err != nil {
+		return nil, err
+	}
+	return newV2ArticlesOut(list, out.(*inout.GetArticlesOut)), nil
+}
+
+// GetV2ArticlesHandler handles get sections articles request of the v2 API.
+func GetV2ArticlesHandler(ctx context.Context, e *Env, in interface{}) (interface{}, error) {
+	list, err := v2ListIn(in, "GetV2ArticlesHandler")
+	if err != nil {
+		return nil, err
+	}
+	out, err := GetArticlesHandler(ctx, e, list.In)
+	if err != nil {
+		return nil, err
+	}
+	return newV2ArticlesOut(list, out.(*inout.GetArticlesOut)), nil
+}
+
+func newV2ArticlesOut(list *inout.V2ListIn, data *inout.GetArticlesOut) *inout.V2Out {
+	articles := data.Articles
+	hasNext := len(articles) > list.First
+	if hasNext {
+		articles = articles[:list.First]
+	}
+	var end *models.Cursor
+	if len(articles) == 0 {
+		articles = []*models.Article{}
+	} else {
+		end = articles[len(articles)-1].Cursor()
+	}
+	return newV2KeysetOut(list, articles, len(articles), data.Count, hasNext, end)
+}
+
+// GetV2SearchHandler handles search request of the v2 API.
+func GetV2SearchHandler(ctx context.Context, e *Env, in interface{}) (interface{}, error) {
+	list, err := v2ListIn(in, "GetV2SearchHandler")
+	if err != nil {
+		return nil, err
+	}
+	out, err := GetSearchHandler(ctx, e, list.In)
+	if err != nil {
+		return nil, err
+	}
+	data := out.(*inout.GetSearchOut)
+
+	page := &inout.V2PageOut{
+		Size:            len(data.Articles),
+		Total:           data.Count,
+		HasNextPage:     list.Offset+list.First < data.Count,
+		HasPreviousPage: list.Offset > 0,
+	}
+	if len(data.Articles) > 0 {
+		page.EndCursor = inout.EncodeOffsetCursor(list.Offset + list.First - 1)
+	}
+	return &inout.V2Out{Data: data.Articles, Meta: &inout.V2MetaOut{Page: page}}, nil
+}
+
+// GetV2CategoryKeyNameToIDHandler handles get key ID request of the v2 API.
+func GetV2CategoryKeyNameToIDHandler(ctx context.Context, e *Env, in interface{}) (interface{}, error) {
+	out, err := GetCategoryKeyNameToIDHandler(ctx, e, in)
+	if err != nil {
+		return nil, err
+	}
+	return &inout.V2Out{Data: out}, nil
+}
+
+// GetV2SectionHandler handles get section request of the v2 API.
+func GetV2SectionHandler(ctx context.Context, e *Env, in interface{}) (interface{}, error) {
+	out, err := GetSectionHandler(ctx, e, in)
+	if err != nil {
+		return nil, err
+	}
+	return &inout.V2Out{Data: out.(*inout.GetSectionOut).Section}, nil
+}
+
+// GetV2ArticleHandler handles get article request of the v2 API.
+func GetV2ArticleHandler(ctx context.Context, e *Env, in interface{}) (interface{}, error) {
+	out, err := GetArticleHandler(ctx, e, in)
+	if err != nil {
+		return nil, err
+	}
+	return &inout.V2Out{Data: out.(*inout.GetArticleOut).Article}, nil
+}
+
+// GetV2TopNArticlesHandler handles get top n articles request of the v2 API.
+func GetV2TopNArticlesHandler(ctx context.Context, e *Env, in interface{}) (interface{}, error) {
+	out, err := GetTopNArticlesHandler(ctx, e, in)
+	if err != nil {
+		return nil, err
+	}
+	articles := out.(*inout.GetTopNArticlesOut).Articles
+	if articles == nil {
+		articles = []*models.Article{}
+	}
+	return &inout.V2Out{Data: articles}, nil
+}
+
+// GetV2TicketFormHandler handles get ticket form request of the v2 API.
+func GetV2TicketFormHandler(ctx context.Context, e *Env, in interface{}) (interface{}, error) {
+	out, err := GetTicketFormHandler(ctx, e, in)
+	if err != nil {
+		return nil, err
+	}
+	return &inout.V2Out{Data: out.(*inout.GetTicketFormOut).TicketForm}, nil
+}
+
+// GetV2InstantSearchHandler handles instant search request of the v2 API.
+func GetV2InstantSearchHandler(ctx context.Context, e *Env, in interface{}) (interface{}, error) {
+	out, err := GetInstantSearchHandler(ctx, e, in)
+	if err != nil {
+		return nil, err
+	}
+	results := out.(*inout.GetInstantSearchOut).Results
+	if results == nil {
+		results = []*inout.InstantSearchResult{}
+	}
+	return &inout.V2Out{Data: results}, nil
+}
+
+// GetV2SearchQueryStatsHandler handles get search query stats request of the v2 API.
+func GetV2SearchQueryStatsHandler(ctx context.Context, e *Env, in interface{}) (interface{}, error) {
+	out, err := GetSearchQueryStatsHandler(ctx, e, in)
+	if err != nil {
+		return nil, err
+	}
+	return &inout.V2Out{Data: out.(*inout.GetSearchQueryStatsOut).Queries}, nil
+}
+
+// GetV2DeflectionStatsHandler handles get deflection stats request of the v2 API.
+func GetV2DeflectionStatsHandler(ctx context.Context, e *Env, in interface{}) (interface{}, error) {
+	out, err := GetDeflectionStatsHandler(ctx, e, in)
+	if err != nil {
+		return nil, err
+	}
+	return &inout.V2Out{Data: out.(*inout.GetDeflectionStatsOut).Deflections}, nil
+}
+
+// GetV2ArticleVoteHistoryHandler handles get article vote history request of the v2 API.
+func GetV2ArticleVoteHistoryHandler(ctx context.Context, e *Env, in interface{}) (interface{}, error) {
+	out, err := GetArticleVoteHistoryHandler(ctx, e, in)
+	if err != nil {
+		return nil, err
+	}
+	return &inout.V2Out{Data: out.(*inout.GetArticleVoteHistoryOut).Days}, nil
+}
+
+// CreateV2VoteHandler handles create vote request of the v2 API.
+func CreateV2VoteHandler(ctx context.Context, e *Env, in interface{}) (interface{}, error) {
+	out, err := CreateVoteHandler(ctx, e, in)
+	if err != nil {
+		return nil, err
+	}
+	return &inout.V2Out{Data: out}, nil
+}
+
+// CreateV2RequestHandler handles create request request of the v2 API,
+// the created request is answered with 201 and the created status instead of an empty body.
+func CreateV2RequestHandler(ctx context.Context, e *Env, in interface{}) (interface{}, error) {
+	// The v1 handler answers the created request with the SuccessCreatedCode error.
+	if _, err := CreateRequestHandler(ctx, e, in); err != nil {
+		if er, ok := err.(*errs.Error); !ok || er.Status != http.StatusCreated {
+			return nil, err
+		}
+	}
+	return &inout.V2Out{
+		Data:   &inout.V2StatusOut{Status: inout.V2StatusCreated},
+		Status: http.StatusCreated,
+	}, nil
+}
+
+// CreateV2ForceSyncHandler handles force sync request of the v2 API,
+// the sync runs in the background so it is answered with 202.
+func CreateV2ForceSyncHandler(ctx context.Context, e *Env, in interface{}) (interface{}, error) {
+	if _, err := CreateForceSyncHandler(ctx, e, in); err != nil {
+		return nil, err
+	}
+	return &inout.V2Out{
+		Data:   &inout.V2StatusOut{Status: inout.V2StatusAccepted},
+		Status: http.StatusAccepted,
+	}, nil
+}
@@ -0,0 +1,263 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/julienschmidt/httprouter"
+	"github.com/pkg/errors"
+
+	"github.com/honestbee/Zen/errs"
+	"github.com/honestbee/Zen/inout"
+	"github.com/honestbee/Zen/models"
+)
+
+func TestV2Middleware(t *testing.T) {
+	nop := func(httprouter.Params, *http.Request) (interface{}, error) {
+		return nil, nil
+	}
+
+	testCases := [...]struct {
+		description  string
+		target       string
+		dec          decompressor
+		fn           handler
+		expectStatus int
+		expectBody   map[string]interface{}
+	}{
+		{
+			description: "testing normal work flow",
+			target:      "http://fake.url.com",
+			dec:         nop,
+			fn: func(ctx context.Context, e *Env, in interface{}) (interface{}, error) {
+				return &inout.V2Out{Data: map[string]interface{}{"name": "tester"}}, nil
+			},
+			expectStatus: http.StatusOK,
+			expectBody: map[string]interface{}{
+				"data": map[string]interface{}{"name": "tester"},
+			},
+		},
+		{
+			description: "testing status and sparse fieldset case",
+			target:      "http://fake.url.com?fields=id,unknown",
+			dec:         nop,
+			fn: func(ctx context.Context, e *Env, in interface{}) (interface{}, error) {
+				return &inout.V2Out{
+					Data: []*models.Category{
+						&models.Category{ID: 3345678, Name: "testing category 1"},
+					},
+					Status: http.StatusAccepted,
+				}, nil
+			},
+			expectStatus: http.StatusAccepted,
+			expectBody: map[string]interface{}{
+				"data": []interface{}{map[string]interface{}{"id": float64(3345678)}},
+			},
+		},
+		{
+			description: "testing decompressor failed with the parameter case",
+			target:      "http://fake.url.com",
+			dec: func(httprouter.Params, *http.Request) (interface{}, error) {
+				return nil, v2ParamErr("after", "is not a valid cursor", errors.New("decompressor failed"))
+			},
+			fn: func(ctx context.Context, e *Env, in interface{}) (interface{}, error) {
+				return &inout.V2Out{}, nil
+			},
+			expectStatus: http.StatusBadRequest,
+			expectBody: map[string]interface{}{
+				"errors": []interface{}{map[string]interface{}{
+					"code":   errs.InvalidAttributeErrorReason,
+					"title":  errs.InvalidAttributeErrorMsg,
+					"detail": "is not a valid cursor",
+					"source": map[string]interface{}{"parameter": "after"},
+				}},
+			},
+		},
+		{
+			description: "testing handler failed goes to 500 response",
+			target:      "http://fake.url.com",
+			dec:         nop,
+			fn: func(ctx context.Context, e *Env, in interface{}) (interface{}, error) {
+				return nil, errors.New("handler failed")
+			},
+			expectStatus: http.StatusInternalServerError,
+			expectBody: map[string]interface{}{
+				"errors": []interface{}{map[string]interface{}{
+					"code":  errs.ServerInternalErrorReason,
+					"title": errs.ServerInternalErrorMsg,
+				}},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			V2Middleware(e, tt.dec, tt.fn)(w, httptest.NewRequest(http.MethodGet, tt.target, nil), nil)
+
+			if tt.expectStatus != w.Code {
+				t.Errorf("[%s] expectStatus:%v, actual:%v", tt.description, tt.expectStatus, w.Code)
+			}
+			actualBody := make(map[string]interface{})
+			json.NewDecoder(w.Body).Decode(&actualBody)
+			if diff := deep.Equal(tt.expectBody, actualBody); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+}
+
+func TestV2ListDecompressor(t *testing.T) {
+	cursor := &models.Cursor{ID: 33456711, CreatedAt: models.FixCreatedAt1, UpdatedAt: models.FixUpdatedAt1}
+
+	testCases := [...]struct {
+		description     string
+		input           url.Values
+		expect          interface{}
+		expectParameter string
+	}{
+		{
+			description: "testing normal case",
+			input: url.Values{
+				"first": []string{"2"},
+				"after": []string{inout.EncodeCursor(cursor, "position", "asc")},
+			},
+			expect: &inout.V2ListIn{
+				In: &inout.GetCategoriesIn{
+					BaseIn: &inout.BaseIn{
+						Locale:      "en-us",
+						CountryCode: "sg",
+						PerPage:     3,
+						Page:        0,
+						SortBy:      "position",
+						SortOrder:   "asc",
+						After:       cursor,
+						Keyset:      true,
+					},
+				},
+				First: 2,
+			},
+		},
+		{
+			description:     "testing invalid first case",
+			input:           url.Values{"first": []string{"0"}},
+			expectParameter: "first",
+		},
+		{
+			description: "testing cursor of another sorting case",
+			input: url.Values{
+				"sort_order": []string{"desc"},
+				"after":      []string{inout.EncodeCursor(cursor, "position", "asc")},
+			},
+			expectParameter: "after",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			actual, err := V2ListDecompressor(GetCategoriesDecompressor)(nil, &http.Request{Form: tt.input})
+			if tt.expectParameter != "" {
+				er, ok := err.(*errs.Error)
+				if !ok || er.Parameter != tt.expectParameter {
+					t.Errorf("[%s] expect an error of parameter:%s, actual:%v", tt.description, tt.expectParameter, err)
+				}
+			} else if diff := deep.Equal(tt.expect, actual); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+}
+
+func TestGetV2CategoriesArticlesHandler(t *testing.T) {
+	in := func(first int, after *models.Cursor) *inout.V2ListIn {
+		return &inout.V2ListIn{
+			In: &inout.GetCategoriesArticlesIn{
+				CategoryID: 3345678,
+				BaseIn: &inout.BaseIn{
+					Locale:      "en-us",
+					CountryCode: "tw",
+					PerPage:     first + 1,
+					SortBy:      "position",
+					SortOrder:   "asc",
+					After:       after,
+				},
+			},
+			First: first,
+		}
+	}
+	end := func(id int) string {
+		return inout.EncodeCursor(&models.Cursor{
+			ID:        id,
+			CreatedAt: models.FixCreatedAt1,
+			UpdatedAt: models.FixUpdatedAt1,
+		}, "position", "asc")
+	}
+
+	testCases := [...]struct {
+		description string
+		input       interface{}
+		expectIDs   []int
+		expectPage  *inout.V2PageOut
+		expectErr   bool
+	}{
+		{
+			description: "testing first page case",
+			input:       in(2, nil),
+			expectIDs:   []int{33456710, 33456711},
+			expectPage: &inout.V2PageOut{
+				Size:        2,
+				Total:       4,
+				HasNextPage: true,
+				EndCursor:   end(33456711),
+			},
+		},
+		{
+			description: "testing last page case",
+			input:       in(4, &models.Cursor{ID: 33456709}),
+			expectIDs:   []int{33456710, 33456711, 33456712, 33456713},
+			expectPage: &inout.V2PageOut{
+				Size:            4,
+				Total:           4,
+				HasPreviousPage: true,
+				EndCursor:       end(33456713),
+			},
+		},
+		{
+			description: "testing input casting failed case",
+			input:       &inout.GetCategoriesArticlesIn{},
+			expectErr:   true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			actual, err := GetV2CategoriesArticlesHandler(context.Background(), e, tt.input)
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("[%s] expect an error, actual nil", tt.description)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("[%s] unexpected error:%v", tt.description, err)
+			}
+
+			out := actual.(*inout.V2Out)
+			var ids []int
+			for _, article := range out.Data.([]*models.Article) {
+				ids = append(ids, article.ID)
+			}
+			if diff := deep.Equal(tt.expectIDs, ids); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+			if diff := deep.Equal(tt.expectPage, out.Meta.Page); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+}
@@ -10,6 +10,9 @@ import (
 
 	"github.com/honestbee/Zen/errs"
 	"github.com/honestbee/Zen/inout"
+	"github.com/honestbee/Zen/models"
+	"github.com/honestbee/Zen/session"
+	"github.com/honestbee/Zen/votes"
 )
 
 // CreateVoteDecompressor combines params from URL or FORM
@@ -44,6 +47,7 @@ func CreateVoteDecompressor(ps httprouter.Params, r *http.Request) (interface{},
 		Value:       voteValue,
 		Locale:      baseParams.Locale,
 		CountryCode: baseParams.CountryCode,
+		RemoteIP:    remoteIP(r),
 	}, nil
 }
 
@@ -57,15 +61,32 @@ func CreateVoteHandler(ctx context.Context, e *Env, in interface{}) (interface{}
 		)
 	}
 
-	voteResult, err := e.ZenDesk.CreateVote(ctx, data.ArticleID, data.Value, data.CountryCode, data.Locale)
+	voteResult, err := e.Votes.Cast(ctx, &votes.Ballot{
+		ArticleID:   data.ArticleID,
+		Value:       data.Value,
+		Voter:       session.Voter(ctx, data.RemoteIP, e.Config.Votes.DeviceSecret),
+		RemoteIP:    data.RemoteIP,
+		CountryCode: data.CountryCode,
+		Locale:      data.Locale,
+	})
 	if err != nil {
-		return nil, errs.NewErr(
-			errs.ServerInternalErrorCode,
-			errors.Wrapf(err, "handlers: [CreateVoteHandler] ZenDesk.CreateVote failed"),
-		)
+		return nil, votes.CastErr(err, "handlers: [CreateVoteHandler] Votes.Cast failed")
+	}
+	if voteResult.Duplicate {
+		return &inout.CreateVoteOut{
+			VoteSum:   voteResult.VoteSum,
+			VoteCount: voteResult.VoteCount,
+		}, nil
 	}
 
 	defer e.Examiner.SyncArticle(ctx, data.ArticleID, data.CountryCode, data.Locale)
+	e.Service.RecordSessionEvent(ctx, &models.SessionEvent{
+		SessionID:   session.IDFromContext(ctx),
+		Kind:        models.VoteSessionEvent,
+		ArticleID:   data.ArticleID,
+		CountryCode: data.CountryCode,
+		Locale:      data.Locale,
+	})
 
 	return &inout.CreateVoteOut{
 		VoteSum:   voteResult.VoteSum,
@@ -159,6 +159,7 @@ func TestCreateVoteHandler(t *testing.T) {
 				CountryCode: "tw",
 				ArticleID:   3345679,
 				Value:       "up",
+				RemoteIP:    "10.0.0.1",
 			},
 			expectErr: false,
 			expect: &inout.CreateVoteOut{
@@ -173,6 +174,7 @@ func TestCreateVoteHandler(t *testing.T) {
 				CountryCode: "tw",
 				ArticleID:   3345678,
 				Value:       "down",
+				RemoteIP:    "10.0.0.1",
 			},
 			expectErr: false,
 			expect: &inout.CreateVoteOut{
@@ -187,6 +189,18 @@ func TestCreateVoteHandler(t *testing.T) {
 				CountryCode: "tw",
 				ArticleID:   111,
 				Value:       "down",
+				RemoteIP:    "10.0.0.1",
+			},
+			expectErr: true,
+			expect:    nil,
+		},
+		{
+			description: "testing unknown voter error case",
+			input: &inout.CreateVoteIn{
+				Locale:      "en-us",
+				CountryCode: "tw",
+				ArticleID:   3345679,
+				Value:       "up",
 			},
 			expectErr: true,
 			expect:    nil,
@@ -0,0 +1,276 @@
+package health
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/examiner"
+	"github.com/honestbee/Zen/models"
+	"github.com/honestbee/Zen/redact"
+	"github.com/honestbee/Zen/zendesk"
+)
+
+// The dependencies checked by the Checker, the names are the services of the gRPC health service.
+const (
+	Postgres = "postgres"
+	Redis    = "redis"
+	Zendesk  = "zendesk"
+	Examiner = "examiner"
+	Sync     = "sync"
+)
+
+// The statuses of the checks and the reports.
+const (
+	StatusOK       = "ok"
+	StatusDegraded = "degraded"
+	StatusFail     = "fail"
+	StatusUnknown  = "unknown"
+)
+
+// liveChecks are the checks of the liveness, the others only affect the readiness
+// since restarting the server does not help the unreachable dependencies.
+var liveChecks = map[string]bool{
+	Examiner: true,
+}
+
+// degradedChecks are the checks only degrading the service, their failures keep the server ready
+// since the contents are served from the database, such as the zendesk api only reached by the writes and the syncs.
+var degradedChecks = map[string]bool{
+	Zendesk: true,
+}
+
+// serviceChecks are the checks the gRPC service can not serve without, the failures of the others,
+// such as the zendesk api, are reported by their own statuses but keep the service serving.
+var serviceChecks = map[string]bool{
+	Postgres: true,
+	Redis:    true,
+}
+
+// ZendeskService is the gRPC service name, its status is only decided by the serviceChecks.
+const ZendeskService = "protobuf.Zendesk"
+
+// Report is the result of the checks, the status is ok only if all the checks are ok,
+// or degraded if only the degradedChecks failed.
+type Report struct {
+	Status string     `json:"status"`
+	Checks []*Result  `json:"checks"`
+	Syncs  []*SyncAge `json:"syncs,omitempty"`
+}
+
+// Result is the result of a check, CheckedAt is zero before the first check is done.
+type Result struct {
+	Name      string    `json:"name"`
+	Status    string    `json:"status"`
+	Error     string    `json:"error,omitempty"`
+	CheckedAt time.Time `json:"checked_at"`
+}
+
+// checkFunc checks a dependency is reachable.
+type checkFunc func(ctx context.Context) error
+
+// Checker checks the dependencies periodically and keeps their status in the gRPC health server.
+// The overall status, the empty service name, is serving only if all the dependencies but the degradedChecks are serving.
+type Checker struct {
+	logger   *zerolog.Logger
+	interval time.Duration
+	timeout  time.Duration
+	checks   map[string]checkFunc
+	server   *grpchealth.Server
+
+	mu      sync.RWMutex
+	errs    map[string]error
+	results map[string]*Result
+	syncs   *syncChecker
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// New returns a Checker instance checking Postgres, Redis, Zendesk, the examiner workers
+// and the last syncs of the countries, and starts checking.
+func New(conf *config.Config, logger *zerolog.Logger, service models.Service, zend *zendesk.ZenDesk, exam *examiner.Examiner) (*Checker, error) {
+	syncs := newSyncChecker(zend.CountryCodes(), time.Duration(conf.Health.MaxSyncAgeSec)*time.Second, service.GetLastSyncs)
+	c := newChecker(logger,
+		time.Duration(conf.Health.IntervalSec)*time.Second,
+		time.Duration(conf.Health.TimeoutSec)*time.Second,
+		map[string]checkFunc{
+			Postgres: service.PingDatabase,
+			Redis:    service.PingCache,
+			Zendesk:  zend.Ping,
+			Examiner: exam.Health,
+			Sync:     syncs.check,
+		},
+	)
+	c.syncs = syncs
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+	go c.run(ctx)
+
+	return c, nil
+}
+
+func newChecker(logger *zerolog.Logger, interval, timeout time.Duration, checks map[string]checkFunc) *Checker {
+	c := &Checker{
+		logger:   logger,
+		interval: interval,
+		timeout:  timeout,
+		checks:   checks,
+		server:   grpchealth.NewServer(),
+		errs:     make(map[string]error),
+		results:  make(map[string]*Result),
+		cancel:   func() {},
+		done:     make(chan struct{}),
+	}
+
+	// The status is unknown until the first check is done.
+	c.server.SetServingStatus("", healthpb.HealthCheckResponse_UNKNOWN)
+	c.server.SetServingStatus(ZendeskService, healthpb.HealthCheckResponse_UNKNOWN)
+	for name := range checks {
+		c.server.SetServingStatus(name, healthpb.HealthCheckResponse_UNKNOWN)
+		c.results[name] = &Result{Status: StatusUnknown}
+	}
+
+	return c
+}
+
+func (c *Checker) run(ctx context.Context) {
+	defer close(c.done)
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		c.check(ctx)
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// check runs all the checks concurrently and updates the status.
+func (c *Checker) check(ctx context.Context) {
+	errs := make(map[string]error, len(c.checks))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for name, fn := range c.checks {
+		wg.Add(1)
+		go func(name string, fn checkFunc) {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, c.timeout)
+			defer cancel()
+
+			err := fn(checkCtx)
+			mu.Lock()
+			errs[name] = err
+			mu.Unlock()
+		}(name, fn)
+	}
+	wg.Wait()
+
+	checkedAt := time.Now().UTC()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	overall := healthpb.HealthCheckResponse_SERVING
+	service := healthpb.HealthCheckResponse_SERVING
+	for name, err := range errs {
+		status := healthpb.HealthCheckResponse_SERVING
+		result := &Result{Status: StatusOK, CheckedAt: checkedAt}
+		if err != nil {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+			result.Status = StatusDegraded
+			if !degradedChecks[name] {
+				overall = healthpb.HealthCheckResponse_NOT_SERVING
+				result.Status = StatusFail
+			}
+			if serviceChecks[name] {
+				service = healthpb.HealthCheckResponse_NOT_SERVING
+			}
+			result.Error = redact.String(err.Error())
+			c.logger.Error().Err(err).Fields(map[string]interface{}{
+				"dependency": name,
+			}).Msgf("health: [check] dependency is not reachable")
+		} else if c.errs[name] != nil {
+			c.logger.Info().Fields(map[string]interface{}{
+				"dependency": name,
+			}).Msgf("health: [check] dependency is reachable again")
+		}
+		c.server.SetServingStatus(name, status)
+		c.results[name] = result
+	}
+	c.server.SetServingStatus("", overall)
+	c.server.SetServingStatus(ZendeskService, service)
+	c.errs = errs
+}
+
+// Live returns the report of the liveness checks, the unknown status is live
+// so that the server is not restarted before the first check is done.
+func (c *Checker) Live() *Report {
+	report := c.report(func(name string) bool { return liveChecks[name] })
+	if report.Status == StatusUnknown {
+		report.Status = StatusOK
+	}
+	return report
+}
+
+// Ready returns the report of all the checks and the last syncs of the countries.
+func (c *Checker) Ready() *Report {
+	report := c.report(func(string) bool { return true })
+	if c.syncs != nil {
+		report.Syncs = c.syncs.ages()
+	}
+	return report
+}
+
+// report returns the report of the checks matched by include, the status is failed if any check failed,
+// unknown if any check is not done yet, or degraded if any degradedChecks failed.
+func (c *Checker) report(include func(name string) bool) *Report {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	report := &Report{Status: StatusOK}
+	for name, result := range c.results {
+		if !include(name) {
+			continue
+		}
+		r := *result
+		r.Name = name
+		report.Checks = append(report.Checks, &r)
+
+		switch {
+		case r.Status == StatusFail:
+			report.Status = StatusFail
+		case r.Status == StatusUnknown && report.Status != StatusFail:
+			report.Status = StatusUnknown
+		case r.Status == StatusDegraded && report.Status == StatusOK:
+			report.Status = StatusDegraded
+		}
+	}
+	sort.Slice(report.Checks, func(i, j int) bool { return report.Checks[i].Name < report.Checks[j].Name })
+	return report
+}
+
+// Server returns the gRPC health server keeping the status.
+func (c *Checker) Server() healthpb.HealthServer {
+	return c.server
+}
+
+// Close stops checking the dependencies.
+func (c *Checker) Close() error {
+	c.cancel()
+	<-c.done
+	return nil
+}
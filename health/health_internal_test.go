@@ -0,0 +1,321 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+	"github.com/rs/zerolog"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+var logger = zerolog.New(ioutil.Discard)
+
+func checkReturn(err error) checkFunc {
+	return func(ctx context.Context) error { return err }
+}
+
+func statusOf(t *testing.T, c *Checker, service string) healthpb.HealthCheckResponse_ServingStatus {
+	res, err := c.Server().Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		t.Fatalf("check service:%q failed:%v", service, err)
+	}
+	return res.Status
+}
+
+func TestCheckerCheck(t *testing.T) {
+	testCases := []struct {
+		description string
+		checks      map[string]checkFunc
+		expect      map[string]healthpb.HealthCheckResponse_ServingStatus
+	}{
+		{
+			description: "testing all serving case",
+			checks: map[string]checkFunc{
+				Postgres: checkReturn(nil),
+				Redis:    checkReturn(nil),
+				Zendesk:  checkReturn(nil),
+			},
+			expect: map[string]healthpb.HealthCheckResponse_ServingStatus{
+				"":             healthpb.HealthCheckResponse_SERVING,
+				ZendeskService: healthpb.HealthCheckResponse_SERVING,
+				Postgres:       healthpb.HealthCheckResponse_SERVING,
+				Redis:          healthpb.HealthCheckResponse_SERVING,
+				Zendesk:        healthpb.HealthCheckResponse_SERVING,
+			},
+		},
+		{
+			description: "testing redis not serving case",
+			checks: map[string]checkFunc{
+				Postgres: checkReturn(nil),
+				Redis:    checkReturn(errors.New("redis down")),
+				Zendesk:  checkReturn(nil),
+			},
+			expect: map[string]healthpb.HealthCheckResponse_ServingStatus{
+				"":             healthpb.HealthCheckResponse_NOT_SERVING,
+				ZendeskService: healthpb.HealthCheckResponse_NOT_SERVING,
+				Postgres:       healthpb.HealthCheckResponse_SERVING,
+				Redis:          healthpb.HealthCheckResponse_NOT_SERVING,
+				Zendesk:        healthpb.HealthCheckResponse_SERVING,
+			},
+		},
+		{
+			description: "testing zendesk not serving case",
+			checks: map[string]checkFunc{
+				Postgres: checkReturn(nil),
+				Redis:    checkReturn(nil),
+				Zendesk:  checkReturn(errors.New("zendesk down")),
+				Sync:     checkReturn(errors.New("sync too old")),
+			},
+			expect: map[string]healthpb.HealthCheckResponse_ServingStatus{
+				"":             healthpb.HealthCheckResponse_NOT_SERVING,
+				ZendeskService: healthpb.HealthCheckResponse_SERVING,
+				Postgres:       healthpb.HealthCheckResponse_SERVING,
+				Redis:          healthpb.HealthCheckResponse_SERVING,
+				Zendesk:        healthpb.HealthCheckResponse_NOT_SERVING,
+				Sync:           healthpb.HealthCheckResponse_NOT_SERVING,
+			},
+		},
+		{
+			description: "testing only zendesk not serving case",
+			checks: map[string]checkFunc{
+				Postgres: checkReturn(nil),
+				Redis:    checkReturn(nil),
+				Zendesk:  checkReturn(errors.New("zendesk down")),
+			},
+			expect: map[string]healthpb.HealthCheckResponse_ServingStatus{
+				"":             healthpb.HealthCheckResponse_SERVING,
+				ZendeskService: healthpb.HealthCheckResponse_SERVING,
+				Postgres:       healthpb.HealthCheckResponse_SERVING,
+				Redis:          healthpb.HealthCheckResponse_SERVING,
+				Zendesk:        healthpb.HealthCheckResponse_NOT_SERVING,
+			},
+		},
+		{
+			description: "testing timeout case",
+			checks: map[string]checkFunc{
+				Postgres: func(ctx context.Context) error {
+					<-ctx.Done()
+					return ctx.Err()
+				},
+				Redis:   checkReturn(nil),
+				Zendesk: checkReturn(nil),
+			},
+			expect: map[string]healthpb.HealthCheckResponse_ServingStatus{
+				"":             healthpb.HealthCheckResponse_NOT_SERVING,
+				ZendeskService: healthpb.HealthCheckResponse_NOT_SERVING,
+				Postgres:       healthpb.HealthCheckResponse_NOT_SERVING,
+				Redis:          healthpb.HealthCheckResponse_SERVING,
+				Zendesk:        healthpb.HealthCheckResponse_SERVING,
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			c := newChecker(&logger, time.Minute, 10*time.Millisecond, tt.checks)
+			for service := range tt.expect {
+				if status := statusOf(t, c, service); status != healthpb.HealthCheckResponse_UNKNOWN {
+					t.Errorf("[%s] service:%q expect unknown before checking, actual:%v", tt.description, service, status)
+				}
+			}
+
+			c.check(context.Background())
+
+			actual := make(map[string]healthpb.HealthCheckResponse_ServingStatus)
+			for service := range tt.expect {
+				actual[service] = statusOf(t, c, service)
+			}
+			if diff := deep.Equal(tt.expect, actual); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+}
+
+func TestCheckerReport(t *testing.T) {
+	testCases := []struct {
+		description string
+		checks      map[string]checkFunc
+		check       bool
+		expectLive  string
+		expectReady string
+		expectCode  int
+	}{
+		{
+			description: "testing before checking case",
+			checks: map[string]checkFunc{
+				Postgres: checkReturn(nil),
+				Examiner: checkReturn(nil),
+			},
+			expectLive:  StatusOK,
+			expectReady: StatusUnknown,
+			expectCode:  http.StatusServiceUnavailable,
+		},
+		{
+			description: "testing all ok case",
+			checks: map[string]checkFunc{
+				Postgres: checkReturn(nil),
+				Examiner: checkReturn(nil),
+			},
+			check:       true,
+			expectLive:  StatusOK,
+			expectReady: StatusOK,
+			expectCode:  http.StatusOK,
+		},
+		{
+			description: "testing postgres failed case",
+			checks: map[string]checkFunc{
+				Postgres: checkReturn(errors.New("postgres down")),
+				Examiner: checkReturn(nil),
+			},
+			check:       true,
+			expectLive:  StatusOK,
+			expectReady: StatusFail,
+			expectCode:  http.StatusServiceUnavailable,
+		},
+		{
+			description: "testing zendesk degraded case",
+			checks: map[string]checkFunc{
+				Postgres: checkReturn(nil),
+				Zendesk:  checkReturn(errors.New("zendesk down")),
+				Examiner: checkReturn(nil),
+			},
+			check:       true,
+			expectLive:  StatusOK,
+			expectReady: StatusDegraded,
+			expectCode:  http.StatusOK,
+		},
+		{
+			description: "testing zendesk degraded and postgres failed case",
+			checks: map[string]checkFunc{
+				Postgres: checkReturn(errors.New("postgres down")),
+				Zendesk:  checkReturn(errors.New("zendesk down")),
+				Examiner: checkReturn(nil),
+			},
+			check:       true,
+			expectLive:  StatusOK,
+			expectReady: StatusFail,
+			expectCode:  http.StatusServiceUnavailable,
+		},
+		{
+			description: "testing examiner failed case",
+			checks: map[string]checkFunc{
+				Postgres: checkReturn(nil),
+				Examiner: checkReturn(errors.New("workers stopped")),
+			},
+			check:       true,
+			expectLive:  StatusFail,
+			expectReady: StatusFail,
+			expectCode:  http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			c := newChecker(&logger, time.Minute, 10*time.Millisecond, tt.checks)
+			if tt.check {
+				c.check(context.Background())
+			}
+
+			if live := c.Live(); live.Status != tt.expectLive {
+				t.Errorf("[%s] live expect:%s, actual:%s", tt.description, tt.expectLive, live.Status)
+			} else if len(live.Checks) != 1 || live.Checks[0].Name != Examiner {
+				t.Errorf("[%s] live expect only the examiner check, actual:%v", tt.description, live.Checks)
+			}
+
+			w := httptest.NewRecorder()
+			c.ReadyHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+			if w.Code != tt.expectCode {
+				t.Errorf("[%s] ready code expect:%d, actual:%d", tt.description, tt.expectCode, w.Code)
+			}
+			ready := new(Report)
+			if err := json.NewDecoder(w.Body).Decode(ready); err != nil {
+				t.Fatalf("[%s] decode ready report failed:%v", tt.description, err)
+			}
+			if ready.Status != tt.expectReady {
+				t.Errorf("[%s] ready expect:%s, actual:%s", tt.description, tt.expectReady, ready.Status)
+			}
+			if len(ready.Checks) != len(tt.checks) {
+				t.Errorf("[%s] ready checks expect:%d, actual:%d", tt.description, len(tt.checks), len(ready.Checks))
+			}
+		})
+	}
+}
+
+func TestSyncCheckerCheck(t *testing.T) {
+	now := time.Date(2018, 3, 3, 0, 0, 0, 0, time.UTC)
+	fresh := now.Add(-time.Minute)
+	old := now.Add(-2 * time.Hour)
+
+	testCases := []struct {
+		description string
+		maxAge      time.Duration
+		lastSyncs   lastSyncsFunc
+		expectErr   bool
+		expect      []*SyncAge
+	}{
+		{
+			description: "testing fresh and never synced case",
+			maxAge:      time.Hour,
+			lastSyncs: func(ctx context.Context) (map[string]time.Time, error) {
+				return map[string]time.Time{"sg": fresh}, nil
+			},
+			expect: []*SyncAge{
+				{CountryCode: "sg", SyncedAt: &fresh, AgeSec: 60},
+				{CountryCode: "tw"},
+			},
+		},
+		{
+			description: "testing stale case",
+			maxAge:      time.Hour,
+			lastSyncs: func(ctx context.Context) (map[string]time.Time, error) {
+				return map[string]time.Time{"sg": fresh, "tw": old}, nil
+			},
+			expectErr: true,
+			expect: []*SyncAge{
+				{CountryCode: "sg", SyncedAt: &fresh, AgeSec: 60},
+				{CountryCode: "tw", SyncedAt: &old, AgeSec: 7200, Stale: true},
+			},
+		},
+		{
+			description: "testing no max age case",
+			lastSyncs: func(ctx context.Context) (map[string]time.Time, error) {
+				return map[string]time.Time{"tw": old}, nil
+			},
+			expect: []*SyncAge{
+				{CountryCode: "sg"},
+				{CountryCode: "tw", SyncedAt: &old, AgeSec: 7200},
+			},
+		},
+		{
+			description: "testing get last syncs failed case",
+			maxAge:      time.Hour,
+			lastSyncs: func(ctx context.Context) (map[string]time.Time, error) {
+				return nil, errors.New("redis down")
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			s := newSyncChecker([]string{"sg", "tw"}, tt.maxAge, tt.lastSyncs)
+			s.now = func() time.Time { return now }
+
+			err := s.check(context.Background())
+			if (err != nil) != tt.expectErr {
+				t.Errorf("[%s] expectErr:%v, actual:%v", tt.description, tt.expectErr, err)
+			}
+			if diff := deep.Equal(tt.expect, s.ages()); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+}
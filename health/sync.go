@@ -0,0 +1,84 @@
+package health
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// SyncAge is the age of the last successful sync of a country, SyncedAt is nil if the country is never synced.
+type SyncAge struct {
+	CountryCode string     `json:"country_code"`
+	SyncedAt    *time.Time `json:"synced_at"`
+	AgeSec      int        `json:"age_sec"`
+	Stale       bool       `json:"stale"`
+}
+
+// lastSyncsFunc returns the last successful syncs by the country codes.
+type lastSyncsFunc func(ctx context.Context) (map[string]time.Time, error)
+
+// syncChecker checks the last successful syncs of the countries are not older than maxAge,
+// the syncs are triggered by the requests so the countries never synced are not stale.
+// The ages are not checked if maxAge is zero.
+type syncChecker struct {
+	countryCodes []string
+	maxAge       time.Duration
+	lastSyncs    lastSyncsFunc
+	now          func() time.Time
+
+	mu      sync.RWMutex
+	current []*SyncAge
+}
+
+func newSyncChecker(countryCodes []string, maxAge time.Duration, lastSyncs lastSyncsFunc) *syncChecker {
+	return &syncChecker{
+		countryCodes: countryCodes,
+		maxAge:       maxAge,
+		lastSyncs:    lastSyncs,
+		now:          time.Now,
+	}
+}
+
+// check keeps the ages of the last syncs and fails if any of them is stale.
+func (s *syncChecker) check(ctx context.Context) error {
+	syncs, err := s.lastSyncs(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "health: [syncChecker.check] get last syncs failed")
+	}
+
+	now := s.now()
+	ages := make([]*SyncAge, 0, len(s.countryCodes))
+	var stale []string
+	for _, countryCode := range s.countryCodes {
+		age := &SyncAge{CountryCode: countryCode}
+		if syncedAt, ok := syncs[countryCode]; ok {
+			age.SyncedAt = &syncedAt
+			age.AgeSec = int(now.Sub(syncedAt) / time.Second)
+			age.Stale = s.maxAge > 0 && now.Sub(syncedAt) > s.maxAge
+		}
+		if age.Stale {
+			stale = append(stale, countryCode)
+		}
+		ages = append(ages, age)
+	}
+
+	s.mu.Lock()
+	s.current = ages
+	s.mu.Unlock()
+
+	if len(stale) > 0 {
+		return errors.Errorf("health: [syncChecker.check] countries:%s are not synced in %v", strings.Join(stale, ","), s.maxAge)
+	}
+	return nil
+}
+
+// ages returns the ages kept by the last check.
+func (s *syncChecker) ages() []*SyncAge {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.current
+}
@@ -48,6 +48,9 @@ const (
 	voteDown = "down"
 )
 
+// MaxPerPage is the max page size of the listings.
+const MaxPerPage = maxPerPage
+
 const (
 	maxPerPage         = 100
 	minPerPage         = 1
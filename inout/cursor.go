@@ -0,0 +1,127 @@
+package inout
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/honestbee/Zen/models"
+)
+
+// ConnectionOut is the graphql connection state of a listing output.
+type ConnectionOut struct {
+	SortBy          string `json:"sort_by"`
+	SortOrder       string `json:"sort_order"`
+	Offset          int    `json:"offset"`
+	HasNextPage     bool   `json:"has_next_page"`
+	HasPreviousPage bool   `json:"has_previous_page"`
+}
+
+// keysetCursor is the content of an opaque keyset cursor, the sorting is kept
+// to reject the cursors which are used with another sorting.
+type keysetCursor struct {
+	SortBy    string `json:"sort_by"`
+	SortOrder string `json:"sort_order"`
+	models.Cursor
+}
+
+// offsetCursor is the content of an opaque offset cursor.
+type offsetCursor struct {
+	Offset int `json:"offset"`
+}
+
+// EncodeCursor returns the opaque cursor of the position in the list sorted by sortBy and sortOrder.
+func EncodeCursor(c *models.Cursor, sortBy, sortOrder string) string {
+	return encodeCursor(&keysetCursor{SortBy: sortBy, SortOrder: sortOrder, Cursor: *c})
+}
+
+// DecodeCursor returns the position of the opaque cursor, the cursor must be
+// encoded with the same sorting.
+func DecodeCursor(cursor, sortBy, sortOrder string) (*models.Cursor, error) {
+	c := new(keysetCursor)
+	if err := decodeCursor(cursor, c); err != nil {
+		return nil, errors.Wrapf(err, "inout: [DecodeCursor] decode cursor failed")
+	}
+	if c.SortBy != sortBy || c.SortOrder != sortOrder {
+		return nil, errors.Errorf("inout: [DecodeCursor] cursor is sorted by %s %s instead of %s %s",
+			c.SortBy, c.SortOrder, sortBy, sortOrder)
+	}
+	return &c.Cursor, nil
+}
+
+// EncodeOffsetCursor returns the opaque cursor of the offset in the list,
+// it is used by the lists which can not be paged by keys, such as the search results.
+func EncodeOffsetCursor(offset int) string {
+	return encodeCursor(&offsetCursor{Offset: offset})
+}
+
+// DecodeOffsetCursor returns the offset of the opaque cursor.
+func DecodeOffsetCursor(cursor string) (int, error) {
+	c := new(offsetCursor)
+	if err := decodeCursor(cursor, c); err != nil {
+		return 0, errors.Wrapf(err, "inout: [DecodeOffsetCursor] decode cursor failed")
+	}
+	if c.Offset < 0 {
+		return 0, errors.Errorf("inout: [DecodeOffsetCursor] offset:%d is negative", c.Offset)
+	}
+	return c.Offset, nil
+}
+
+// CurrentPage returns the page number of the offset, the first page is returned if perPage is invalid.
+func CurrentPage(offset, perPage int) int {
+	if perPage < minPerPage {
+		return minPage
+	}
+	return offset/perPage + 1
+}
+
+func encodeCursor(v interface{}) string {
+	b, _ := json.Marshal(v)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(cursor string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return errors.Wrapf(err, "inout: [decodeCursor] base64 decode failed")
+	}
+	return errors.Wrapf(json.Unmarshal(b, v), "inout: [decodeCursor] json unmarshal failed")
+}
+
+// processConnection processes the connection arguments first and after,
+// first overrides perPage and after replaces the offset paging by the keyset paging.
+func processConnection(first *int32, after *string, perPage, page int32, sortBy, sortOrder string) (int32, int32, *models.Cursor, error) {
+	if first != nil {
+		perPage = *first
+	}
+	perPage, page = ProcessPage(perPage, page)
+
+	if after == nil || *after == "" {
+		return perPage, page, nil, nil
+	}
+
+	cursor, err := DecodeCursor(*after, sortBy, sortOrder)
+	if err != nil {
+		return 0, 0, nil, errors.Wrapf(err, "inout: [processConnection] invalid after cursor")
+	}
+	return perPage, 0, cursor, nil
+}
+
+// processOffsetConnection processes the connection arguments first and after of the offset cursor lists.
+func processOffsetConnection(first *int32, after *string, perPage, page int32) (int32, int32, error) {
+	if first != nil {
+		perPage = *first
+	}
+	perPage, page = ProcessPage(perPage, page)
+
+	if after == nil || *after == "" {
+		return perPage, page, nil
+	}
+
+	offset, err := DecodeOffsetCursor(*after)
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "inout: [processOffsetConnection] invalid after cursor")
+	}
+	return perPage, int32(offset) + 1, nil
+}
@@ -0,0 +1,220 @@
+package inout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+
+	"github.com/honestbee/Zen/models"
+)
+
+func TestCursor(t *testing.T) {
+	cursor := &models.Cursor{
+		ID:        115015959148,
+		Position:  2,
+		CreatedAt: time.Date(2017, 12, 27, 2, 59, 48, 0, time.UTC),
+		UpdatedAt: time.Date(2018, 3, 6, 12, 39, 30, 0, time.UTC),
+	}
+	encoded := EncodeCursor(cursor, sortByCreatedAt, sortOrderDesc)
+
+	testCases := [...]struct {
+		description string
+		cursor      string
+		sortBy      string
+		sortOrder   string
+		expect      *models.Cursor
+		expectErr   bool
+	}{
+		{
+			description: "testing normal case",
+			cursor:      encoded,
+			sortBy:      sortByCreatedAt,
+			sortOrder:   sortOrderDesc,
+			expect:      cursor,
+		},
+		{
+			description: "testing sort by mismatched case",
+			cursor:      encoded,
+			sortBy:      sortByPosition,
+			sortOrder:   sortOrderDesc,
+			expectErr:   true,
+		},
+		{
+			description: "testing sort order mismatched case",
+			cursor:      encoded,
+			sortBy:      sortByCreatedAt,
+			sortOrder:   sortOrderAsc,
+			expectErr:   true,
+		},
+		{
+			description: "testing invalid base64 case",
+			cursor:      "!@#$",
+			sortBy:      sortByCreatedAt,
+			sortOrder:   sortOrderDesc,
+			expectErr:   true,
+		},
+		{
+			description: "testing invalid json case",
+			cursor:      "bm90IGpzb24",
+			sortBy:      sortByCreatedAt,
+			sortOrder:   sortOrderDesc,
+			expectErr:   true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			actual, err := DecodeCursor(tt.cursor, tt.sortBy, tt.sortOrder)
+			if tt.expectErr && err == nil {
+				t.Errorf("[%s] expect an error, actual == nil", tt.description)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("[%s] expect no error, actual:%v", tt.description, err)
+			} else if diff := deep.Equal(tt.expect, actual); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+}
+
+func TestOffsetCursor(t *testing.T) {
+	actual, err := DecodeOffsetCursor(EncodeOffsetCursor(29))
+	if err != nil || actual != 29 {
+		t.Errorf("expect:29, actual:%v, err:%v", actual, err)
+	}
+
+	if _, err := DecodeOffsetCursor(EncodeOffsetCursor(-1)); err == nil {
+		t.Errorf("expect an error of negative offset, actual == nil")
+	}
+}
+
+func TestProcessConnection(t *testing.T) {
+	first := int32(5)
+	empty := ""
+	after := EncodeCursor(&models.Cursor{ID: 3345678}, sortByPosition, sortOrderAsc)
+	invalid := "invalid"
+
+	testCases := [...]struct {
+		description   string
+		first         *int32
+		after         *string
+		perPage       int32
+		page          int32
+		expectPerPage int32
+		expectPage    int32
+		expectCursor  *models.Cursor
+		expectErr     bool
+	}{
+		{
+			description:   "testing offset paging case",
+			perPage:       10,
+			page:          3,
+			expectPerPage: 10,
+			expectPage:    20,
+		},
+		{
+			description:   "testing first overrides per page case",
+			first:         &first,
+			after:         &empty,
+			perPage:       10,
+			page:          1,
+			expectPerPage: 5,
+			expectPage:    0,
+		},
+		{
+			description:   "testing keyset paging case",
+			first:         &first,
+			after:         &after,
+			perPage:       10,
+			page:          3,
+			expectPerPage: 5,
+			expectPage:    0,
+			expectCursor:  &models.Cursor{ID: 3345678},
+		},
+		{
+			description: "testing invalid after case",
+			after:       &invalid,
+			perPage:     10,
+			page:        1,
+			expectErr:   true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			perPage, page, cursor, err := processConnection(tt.first, tt.after, tt.perPage, tt.page, sortByPosition, sortOrderAsc)
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("[%s] expect an error, actual == nil", tt.description)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("[%s] expect no error, actual:%v", tt.description, err)
+			}
+			if perPage != tt.expectPerPage || page != tt.expectPage {
+				t.Errorf("[%s] per page, page expect:%d, %d, actual:%d, %d",
+					tt.description, tt.expectPerPage, tt.expectPage, perPage, page)
+			}
+			if diff := deep.Equal(tt.expectCursor, cursor); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+}
+
+func TestConnectionParamsKeyset(t *testing.T) {
+	first := int32(5)
+	after := EncodeCursor(&models.Cursor{ID: 3345678}, sortByPosition, sortOrderAsc)
+
+	testCases := [...]struct {
+		description string
+		in          *QueryCategoriesIn
+		expect      bool
+	}{
+		{
+			description: "testing offset paging case",
+			in:          &QueryCategoriesIn{PerPage: 10, Page: 2},
+			expect:      false,
+		},
+		{
+			description: "testing first page of the connection case",
+			in:          &QueryCategoriesIn{First: &first},
+			expect:      true,
+		},
+		{
+			description: "testing after cursor case",
+			in:          &QueryCategoriesIn{After: &after},
+			expect:      true,
+		},
+	}
+
+	for _, tt := range testCases {
+		tt.in.SortBy, tt.in.SortOrder = graphqlEnumSortByPostion, graphqlEnumSortOrderAsc
+		if err := tt.in.ProcessConnectionParams(); err != nil {
+			t.Fatalf("[%s] expect no error, actual:%v", tt.description, err)
+		}
+		if tt.in.Keyset != tt.expect {
+			t.Errorf("[%s] expect keyset:%v, actual:%v", tt.description, tt.expect, tt.in.Keyset)
+		}
+	}
+}
+
+func TestCurrentPage(t *testing.T) {
+	testCases := [...]struct {
+		offset  int
+		perPage int
+		expect  int
+	}{
+		{offset: 0, perPage: 30, expect: 1},
+		{offset: 30, perPage: 30, expect: 2},
+		{offset: 45, perPage: 30, expect: 2},
+		{offset: 10, perPage: 0, expect: 1},
+	}
+
+	for _, tt := range testCases {
+		if actual := CurrentPage(tt.offset, tt.perPage); actual != tt.expect {
+			t.Errorf("offset:%d per page:%d expect:%d, actual:%d", tt.offset, tt.perPage, tt.expect, actual)
+		}
+	}
+}
@@ -1,8 +1,14 @@
 package inout
 
 import (
+	"strconv"
+	"strings"
+
 	gographql "github.com/graph-gophers/graphql-go"
 	"github.com/pkg/errors"
+
+	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/models"
 )
 
 const (
@@ -141,6 +147,12 @@ type QueryCategoriesIn struct {
 	Page        int32
 	SortBy      string
 	SortOrder   string
+	First       *int32
+	After       *string
+	// Cursor is the decoded after cursor.
+	Cursor *models.Cursor
+	// Keyset is set if the connection is paged by first or after, see models.GetArticlesParams.
+	Keyset bool
 }
 
 // ProcessInputParams process QueryCategoriesIn input parameters.
@@ -157,6 +169,13 @@ func (in *QueryCategoriesIn) ProcessInputParams() error {
 		return err
 	}
 
+	return in.ProcessConnectionParams()
+}
+
+// ProcessConnectionParams process QueryCategoriesIn sorting and paging parameters.
+func (in *QueryCategoriesIn) ProcessConnectionParams() error {
+	var err error
+
 	in.SortBy, err = processGraphQLSortBy(in.SortBy)
 	if err != nil {
 		return err
@@ -167,9 +186,9 @@ func (in *QueryCategoriesIn) ProcessInputParams() error {
 		return err
 	}
 
-	in.PerPage, in.Page = ProcessPage(in.PerPage, in.Page)
-
-	return nil
+	in.PerPage, in.Page, in.Cursor, err = processConnection(in.First, in.After, in.PerPage, in.Page, in.SortBy, in.SortOrder)
+	in.Keyset = in.First != nil || in.Cursor != nil
+	return err
 }
 
 // QueryCategoryIn are the arguments for the "oneCategory" query.
@@ -188,6 +207,11 @@ func (in *QueryCategoryIn) ProcessInputParams() error {
 		return err
 	}
 
+	in.CategoryIDOrKeyName, in.CountryCode, err = processGraphQLNodeID(in.CategoryIDOrKeyName, NodeTypeCategory, in.CountryCode)
+	if err != nil {
+		return err
+	}
+
 	in.Locale, err = processGraphQLLocale(in.Locale)
 	if err != nil {
 		return err
@@ -205,6 +229,12 @@ type QuerySectionsIn struct {
 	Page        int32
 	SortBy      string
 	SortOrder   string
+	First       *int32
+	After       *string
+	// Cursor is the decoded after cursor.
+	Cursor *models.Cursor
+	// Keyset is set if the connection is paged by first or after, see models.GetArticlesParams.
+	Keyset bool
 }
 
 // ProcessInputParams process QuerySectionsIn input parameters.
@@ -221,6 +251,14 @@ func (in *QuerySectionsIn) ProcessInputParams() error {
 		return err
 	}
 
+	return in.ProcessConnectionParams()
+}
+
+// ProcessConnectionParams process QuerySectionsIn sorting and paging parameters,
+// it is used alone by the connection fields whose country code and locale are inherited.
+func (in *QuerySectionsIn) ProcessConnectionParams() error {
+	var err error
+
 	in.SortBy, err = processGraphQLSortBy(in.SortBy)
 	if err != nil {
 		return err
@@ -231,9 +269,9 @@ func (in *QuerySectionsIn) ProcessInputParams() error {
 		return err
 	}
 
-	in.PerPage, in.Page = ProcessPage(in.PerPage, in.Page)
-
-	return nil
+	in.PerPage, in.Page, in.Cursor, err = processConnection(in.First, in.After, in.PerPage, in.Page, in.SortBy, in.SortOrder)
+	in.Keyset = in.First != nil || in.Cursor != nil
+	return err
 }
 
 // QuerySectionIn are the arguments for the "oneSection" query.
@@ -252,6 +290,11 @@ func (in *QuerySectionIn) ProcessInputParams() error {
 		return err
 	}
 
+	in.SectionID, in.CountryCode, err = processGraphQLNodeID(in.SectionID, NodeTypeSection, in.CountryCode)
+	if err != nil {
+		return err
+	}
+
 	in.Locale, err = processGraphQLLocale(in.Locale)
 	if err != nil {
 		return err
@@ -270,6 +313,12 @@ type QueryArticlesIn struct {
 	Page        int32
 	SortBy      string
 	SortOrder   string
+	First       *int32
+	After       *string
+	// Cursor is the decoded after cursor.
+	Cursor *models.Cursor
+	// Keyset is set if the connection is paged by first or after, see models.GetArticlesParams.
+	Keyset bool
 }
 
 // ProcessInputParams process QueryArticlesIn input parameters.
@@ -286,6 +335,14 @@ func (in *QueryArticlesIn) ProcessInputParams() error {
 		return err
 	}
 
+	return in.ProcessConnectionParams()
+}
+
+// ProcessConnectionParams process QueryArticlesIn sorting and paging parameters,
+// it is used alone by the connection fields whose country code and locale are inherited.
+func (in *QueryArticlesIn) ProcessConnectionParams() error {
+	var err error
+
 	in.SortBy, err = processGraphQLSortBy(in.SortBy)
 	if err != nil {
 		return err
@@ -296,9 +353,9 @@ func (in *QueryArticlesIn) ProcessInputParams() error {
 		return err
 	}
 
-	in.PerPage, in.Page = ProcessPage(in.PerPage, in.Page)
-
-	return nil
+	in.PerPage, in.Page, in.Cursor, err = processConnection(in.First, in.After, in.PerPage, in.Page, in.SortBy, in.SortOrder)
+	in.Keyset = in.First != nil || in.Cursor != nil
+	return err
 }
 
 // QueryTopArticlesIn are the arguments for the "topArticles" query.
@@ -306,6 +363,9 @@ type QueryTopArticlesIn struct {
 	TopN        int32
 	CountryCode string
 	Locale      string
+	WindowDays  int32
+	SectionID   *gographql.ID
+	CategoryID  *gographql.ID
 }
 
 // ProcessInputParams process QueryTopArticlesIn input parameters.
@@ -322,6 +382,26 @@ func (in *QueryTopArticlesIn) ProcessInputParams() error {
 		return err
 	}
 
+	if in.WindowDays < 0 || in.WindowDays > models.MaxTopNArticlesWindowDays {
+		return errors.Errorf("inout: [processGraphQL] windowDays:%d is not between 0 and %d", in.WindowDays, models.MaxTopNArticlesWindowDays)
+	}
+
+	if in.SectionID != nil {
+		id := *in.SectionID
+		if id, in.CountryCode, err = processGraphQLNodeID(id, NodeTypeSection, in.CountryCode); err != nil {
+			return err
+		}
+		in.SectionID = &id
+	}
+
+	if in.CategoryID != nil {
+		id := *in.CategoryID
+		if id, in.CountryCode, err = processGraphQLNodeID(id, NodeTypeCategory, in.CountryCode); err != nil {
+			return err
+		}
+		in.CategoryID = &id
+	}
+
 	return nil
 }
 
@@ -330,6 +410,8 @@ type QueryArticleIn struct {
 	ArticleID   gographql.ID
 	CountryCode string
 	Locale      string
+	// SearchQuery is the query of the search results the article is clicked from.
+	SearchQuery *string `json:",omitempty"`
 }
 
 // ProcessInputParams process QueryArticleIn input parameters.
@@ -341,6 +423,11 @@ func (in *QueryArticleIn) ProcessInputParams() error {
 		return err
 	}
 
+	in.ArticleID, in.CountryCode, err = processGraphQLNodeID(in.ArticleID, NodeTypeArticle, in.CountryCode)
+	if err != nil {
+		return err
+	}
+
 	in.Locale, err = processGraphQLLocale(in.Locale)
 	if err != nil {
 		return err
@@ -354,6 +441,18 @@ type QueryTicketFormIn struct {
 	FormID gographql.ID
 }
 
+// ProcessInputParams process QueryTicketFormIn input parameters.
+func (in *QueryTicketFormIn) ProcessInputParams() error {
+	var err error
+
+	in.FormID, _, err = processGraphQLNodeID(in.FormID, NodeTypeTicketForm, "")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // QueryTicketFieldsIn are the arguments for the "ticketField" query.
 type QueryTicketFieldsIn struct {
 	FormID *gographql.ID
@@ -372,6 +471,12 @@ func (in *QueryTicketFieldsIn) ProcessInputParams() error {
 	return nil
 }
 
+// QueryTicketFieldIn are the arguments for loading a ticket field by its id.
+type QueryTicketFieldIn struct {
+	FieldID gographql.ID
+	Locale  string
+}
+
 // QueryCustomFieldOptionsIn are the arguments for the "customFieldOptions" query.
 type QueryCustomFieldOptionsIn struct {
 	FieldID gographql.ID
@@ -414,6 +519,8 @@ type QuerySearchBodyArticlesIn struct {
 	PerPage     int32
 	Page        int32
 	SortOrder   string
+	First       *int32
+	After       *string
 }
 
 // ProcessInputParams process QuerySearchBodyArticlesIn input parameters.
@@ -435,15 +542,17 @@ func (in *QuerySearchBodyArticlesIn) ProcessInputParams() error {
 		return err
 	}
 
-	in.PerPage, in.Page = ProcessPage(in.PerPage, in.Page)
-
-	return nil
+	in.PerPage, in.Page, err = processOffsetConnection(in.First, in.After, in.PerPage, in.Page)
+	return err
 }
 
 // MutationRequestsIn are the arguments for the "requests" mutation.
 type MutationRequestsIn struct {
-	CountryCode string            `json:"country_code"`
-	Data        CreateRequestData `json:"data"`
+	CountryCode  string            `json:"country_code"`
+	Data         CreateRequestData `json:"data"`
+	CaptchaToken string            `json:"captcha_token"`
+	// Website is a honeypot argument which is hidden from the users.
+	Website string `json:"website"`
 }
 
 // CreateRequestData are the definition of create request data field.
@@ -489,6 +598,71 @@ func (in *MutationRequestsIn) ProcessInputParams() error {
 	return nil
 }
 
+// Secrets returns the values of the create request data which can not be detected by the patterns
+// of redact.String, they are the requester, subject, body and the configured custom field values.
+func (d *CreateRequestData) Secrets(conf *config.Config) []string {
+	secrets := []string{
+		d.Request.Requester.Name,
+		d.Request.Requester.Email,
+		d.Request.Subject,
+		d.Request.Comment.Body,
+	}
+
+	if conf == nil || conf.Redact == nil || d.Request.CustomFields == nil {
+		return secrets
+	}
+
+	ids := make(map[string]bool)
+	for _, id := range strings.Split(conf.Redact.CustomFieldIDs, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids[id] = true
+		}
+	}
+	for _, field := range *d.Request.CustomFields {
+		if ids[field.ID] {
+			secrets = append(secrets, field.Value)
+		}
+	}
+
+	return secrets
+}
+
+// AttachViewedArticles puts the articles viewed before filing the request into the custom field,
+// they are appended to the comment if fieldID is 0.
+func (d *CreateRequestData) AttachViewedArticles(fieldID int, articleIDs []int) {
+	if len(articleIDs) == 0 {
+		return
+	}
+	if fieldID == 0 {
+		d.Request.Comment.Body += viewedArticlesComment(articleIDs)
+		return
+	}
+
+	customFields := make([]CreateRequestDataRequestCustomField, 0)
+	if d.Request.CustomFields != nil {
+		customFields = append(customFields, *d.Request.CustomFields...)
+	}
+	customFields = append(customFields, CreateRequestDataRequestCustomField{
+		ID:    strconv.Itoa(fieldID),
+		Value: viewedArticlesValue(articleIDs),
+	})
+	d.Request.CustomFields = &customFields
+}
+
+// viewedArticlesValue returns the comma separated article ids.
+func viewedArticlesValue(articleIDs []int) string {
+	ids := make([]string, len(articleIDs))
+	for i, id := range articleIDs {
+		ids[i] = strconv.Itoa(id)
+	}
+	return strings.Join(ids, ",")
+}
+
+// viewedArticlesComment returns the paragraph of the viewed articles appended to the comment.
+func viewedArticlesComment(articleIDs []int) string {
+	return "\n\nViewed articles: " + strings.Replace(viewedArticlesValue(articleIDs), ",", ", ", -1)
+}
+
 // MutationVoteArticleIn are the arguments for the "voteArticle" mutation.
 type MutationVoteArticleIn struct {
 	ArticleID   gographql.ID
@@ -506,6 +680,11 @@ func (in *MutationVoteArticleIn) ProcessInputParams() error {
 		return err
 	}
 
+	in.ArticleID, in.CountryCode, err = processGraphQLNodeID(in.ArticleID, NodeTypeArticle, in.CountryCode)
+	if err != nil {
+		return err
+	}
+
 	in.Locale, err = processGraphQLLocale(in.Locale)
 	if err != nil {
 		return err
@@ -521,6 +700,6 @@ func (in *MutationVoteArticleIn) ProcessInputParams() error {
 
 // MutationForceSyncIn are the arguments for the "forceSync" mutation.
 type MutationForceSyncIn struct {
-	Username string
-	Password string
+	Username *string
+	Password *string
 }
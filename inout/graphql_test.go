@@ -2,6 +2,10 @@ package inout
 
 import (
 	"testing"
+
+	"github.com/go-test/deep"
+
+	"github.com/honestbee/Zen/config"
 )
 
 func TestQueryCategoriesIn(t *testing.T) {
@@ -904,6 +908,70 @@ func TestMutationRequestsIn(t *testing.T) {
 	}
 }
 
+func TestCreateRequestDataAttachViewedArticles(t *testing.T) {
+	orderField := []CreateRequestDataRequestCustomField{{ID: "360000654321", Value: "HB-1"}}
+
+	testCases := [...]struct {
+		description        string
+		customFields       *[]CreateRequestDataRequestCustomField
+		fieldID            int
+		articleIDs         []int
+		expectBody         string
+		expectCustomFields []CreateRequestDataRequestCustomField
+	}{
+		{
+			description:  "comment case",
+			fieldID:      0,
+			articleIDs:   []int{3345679, 3345680},
+			expectBody:   "where is my refund\n\nViewed articles: 3345679, 3345680",
+			customFields: nil,
+		},
+		{
+			description:  "custom field case",
+			customFields: &orderField,
+			fieldID:      360000123456,
+			articleIDs:   []int{3345679, 3345680},
+			expectBody:   "where is my refund",
+			expectCustomFields: []CreateRequestDataRequestCustomField{
+				{ID: "360000654321", Value: "HB-1"},
+				{ID: "360000123456", Value: "3345679,3345680"},
+			},
+		},
+		{
+			description: "no viewed articles case",
+			fieldID:     360000123456,
+			articleIDs:  nil,
+			expectBody:  "where is my refund",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			data := &CreateRequestData{}
+			data.Request.Comment.Body = "where is my refund"
+			data.Request.CustomFields = tt.customFields
+
+			data.AttachViewedArticles(tt.fieldID, tt.articleIDs)
+			if data.Request.Comment.Body != tt.expectBody {
+				t.Errorf("[%s] expect body %q, actual %q", tt.description, tt.expectBody, data.Request.Comment.Body)
+			}
+			if tt.expectCustomFields != nil {
+				if data.Request.CustomFields == nil {
+					t.Fatalf("[%s] expect custom fields, actual nil", tt.description)
+				}
+				if diff := deep.Equal(tt.expectCustomFields, *data.Request.CustomFields); diff != nil {
+					t.Errorf("[%s] %v", tt.description, diff)
+				}
+			}
+		})
+	}
+
+	// The custom fields of the caller are not changed.
+	if len(orderField) != 1 {
+		t.Errorf("expect the original custom fields kept, actual %v", orderField)
+	}
+}
+
 func TestMutationVoteArticleIn(t *testing.T) {
 	testCases := [...]struct {
 		description       string
@@ -984,3 +1052,60 @@ func TestMutationVoteArticleIn(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateRequestDataSecrets(t *testing.T) {
+	data := CreateRequestData{
+		Request: CreateRequestDataRequest{
+			Requester: CreateRequestDataRequestRequester{
+				Name:  "zen project tester",
+				Email: "zen.project.tester@honestbee.com",
+			},
+			Subject: "testing, please ignore",
+			Comment: CreateRequestDataRequestComment{
+				Body: "testing, please ignore!!!",
+			},
+			CustomFields: &[]CreateRequestDataRequestCustomField{
+				{ID: "360000123456", Value: "HB-ORDER-778899"},
+				{ID: "360000000001", Value: "express"},
+			},
+		},
+	}
+
+	testCases := [...]struct {
+		description string
+		conf        *config.Config
+		expect      []string
+	}{
+		{
+			description: "testing no redact config case",
+			conf:        &config.Config{},
+			expect: []string{
+				"zen project tester",
+				"zen.project.tester@honestbee.com",
+				"testing, please ignore",
+				"testing, please ignore!!!",
+			},
+		},
+		{
+			description: "testing configured custom fields case",
+			conf: &config.Config{
+				Redact: &config.Redact{CustomFieldIDs: " 360000123456 ,360000999999"},
+			},
+			expect: []string{
+				"zen project tester",
+				"zen.project.tester@honestbee.com",
+				"testing, please ignore",
+				"testing, please ignore!!!",
+				"HB-ORDER-778899",
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			if diff := deep.Equal(tt.expect, data.Secrets(tt.conf)); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package inout
 
 import (
+	"github.com/honestbee/Zen/models"
 	"github.com/honestbee/Zen/protobuf"
 )
 
@@ -44,3 +45,11 @@ var GRPCVoteMap = map[protobuf.Vote]string{
 	protobuf.Vote_VOTE_UP:   voteUp,
 	protobuf.Vote_VOTE_DOWN: voteDown,
 }
+
+// GRPCChangeTypeMap defines internal event type (string) to gRPC ChangeType (int32) mapping
+var GRPCChangeTypeMap = map[string]protobuf.ChangeType{
+	models.EventCategoriesChanged: protobuf.ChangeType_CHANGE_TYPE_CATEGORIES_CHANGED,
+	models.EventSectionsChanged:   protobuf.ChangeType_CHANGE_TYPE_SECTIONS_CHANGED,
+	models.EventArticleUpdated:    protobuf.ChangeType_CHANGE_TYPE_ARTICLE_UPDATED,
+	models.EventArticlesDeleted:   protobuf.ChangeType_CHANGE_TYPE_ARTICLES_DELETED,
+}
@@ -0,0 +1,122 @@
+package inout
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+
+	gographql "github.com/graph-gophers/graphql-go"
+	"github.com/pkg/errors"
+)
+
+// The types of the objects which can be refetched by their global ids.
+const (
+	NodeTypeCategory    = "Category"
+	NodeTypeSection     = "Section"
+	NodeTypeArticle     = "Article"
+	NodeTypeTicketForm  = "TicketForm"
+	NodeTypeTicketField = "TicketField"
+)
+
+const (
+	nodeIDSeparator = ":"
+	maxNodeIDs      = 100
+)
+
+var nodeTypes = map[string]bool{
+	NodeTypeCategory:    true,
+	NodeTypeSection:     true,
+	NodeTypeArticle:     true,
+	NodeTypeTicketForm:  true,
+	NodeTypeTicketField: true,
+}
+
+// NodeID is the content of an opaque global id, the country code is empty
+// for the objects which are shared by all the countries such as the ticket forms.
+type NodeID struct {
+	Type        string
+	CountryCode string
+	ID          int
+}
+
+// EncodeNodeID returns the opaque global id of the zendesk object.
+func EncodeNodeID(typ, countryCode string, id int) gographql.ID {
+	s := strings.Join([]string{typ, countryCode, strconv.Itoa(id)}, nodeIDSeparator)
+	return gographql.ID(base64.RawURLEncoding.EncodeToString([]byte(s)))
+}
+
+// DecodeNodeID returns the content of the opaque global id.
+func DecodeNodeID(id gographql.ID) (*NodeID, error) {
+	b, err := base64.RawURLEncoding.DecodeString(string(id))
+	if err != nil {
+		return nil, errors.Wrapf(err, "inout: [DecodeNodeID] base64 decode id:%s failed", id)
+	}
+
+	parts := strings.Split(string(b), nodeIDSeparator)
+	if len(parts) != 3 || !nodeTypes[parts[0]] {
+		return nil, errors.Errorf("inout: [DecodeNodeID] id:%s is not a global id", id)
+	}
+
+	zendeskID, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return nil, errors.Wrapf(err, "inout: [DecodeNodeID] id:%s has an invalid zendesk id", id)
+	}
+
+	return &NodeID{Type: parts[0], CountryCode: parts[1], ID: zendeskID}, nil
+}
+
+// processGraphQLNodeID returns the zendesk id and the country code of the id argument,
+// the argument is either a global id of the type or a raw zendesk id which is kept as it is.
+func processGraphQLNodeID(id gographql.ID, typ, countryCode string) (gographql.ID, string, error) {
+	node, err := DecodeNodeID(id)
+	if err != nil {
+		return id, countryCode, nil
+	}
+	if node.Type != typ {
+		return "", "", errors.Errorf("inout: [processGraphQLNodeID] id:%s is a %s instead of a %s", id, node.Type, typ)
+	}
+	if node.CountryCode != "" {
+		countryCode = node.CountryCode
+	}
+	return gographql.ID(strconv.Itoa(node.ID)), countryCode, nil
+}
+
+// QueryNodeIn are the arguments for the "node" query.
+type QueryNodeIn struct {
+	ID     gographql.ID
+	Locale string
+}
+
+// ProcessInputParams process QueryNodeIn input parameters.
+func (in *QueryNodeIn) ProcessInputParams() error {
+	var err error
+
+	in.Locale, err = processGraphQLLocale(in.Locale)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// QueryNodesIn are the arguments for the "nodes" query.
+type QueryNodesIn struct {
+	IDs    []gographql.ID
+	Locale string
+}
+
+// ProcessInputParams process QueryNodesIn input parameters.
+func (in *QueryNodesIn) ProcessInputParams() error {
+	var err error
+
+	if len(in.IDs) > maxNodeIDs {
+		return errors.Errorf("inout: [QueryNodesIn] ids count:%d is over %d", len(in.IDs), maxNodeIDs)
+	}
+
+	in.Locale, err = processGraphQLLocale(in.Locale)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package inout
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/go-test/deep"
+	gographql "github.com/graph-gophers/graphql-go"
+)
+
+func TestNodeID(t *testing.T) {
+	testCases := [...]struct {
+		description string
+		id          gographql.ID
+		expect      *NodeID
+		expectErr   bool
+	}{
+		{
+			description: "testing article case",
+			id:          EncodeNodeID(NodeTypeArticle, countryCodeTW, 115015959148),
+			expect:      &NodeID{Type: NodeTypeArticle, CountryCode: countryCodeTW, ID: 115015959148},
+		},
+		{
+			description: "testing ticket form without country code case",
+			id:          EncodeNodeID(NodeTypeTicketForm, "", 825847),
+			expect:      &NodeID{Type: NodeTypeTicketForm, ID: 825847},
+		},
+		{
+			description: "testing raw zendesk id case",
+			id:          gographql.ID("115015959148"),
+			expectErr:   true,
+		},
+		{
+			description: "testing invalid base64 case",
+			id:          gographql.ID("!@#$"),
+			expectErr:   true,
+		},
+		{
+			description: "testing unknown type case",
+			id:          gographql.ID(base64.RawURLEncoding.EncodeToString([]byte("User:tw:1"))),
+			expectErr:   true,
+		},
+		{
+			description: "testing invalid zendesk id case",
+			id:          gographql.ID(base64.RawURLEncoding.EncodeToString([]byte("Article:tw:abc"))),
+			expectErr:   true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			actual, err := DecodeNodeID(tt.id)
+			if tt.expectErr && err == nil {
+				t.Errorf("[%s] expect an error, actual == nil", tt.description)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("[%s] expect no error, actual:%v", tt.description, err)
+			} else if diff := deep.Equal(tt.expect, actual); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+}
+
+func TestProcessGraphQLNodeID(t *testing.T) {
+	testCases := [...]struct {
+		description       string
+		id                gographql.ID
+		typ               string
+		countryCode       string
+		expectID          gographql.ID
+		expectCountryCode string
+		expectErr         bool
+	}{
+		{
+			description:       "testing raw zendesk id case",
+			id:                gographql.ID("115015959148"),
+			typ:               NodeTypeArticle,
+			countryCode:       countryCodeSG,
+			expectID:          gographql.ID("115015959148"),
+			expectCountryCode: countryCodeSG,
+		},
+		{
+			description:       "testing category keyname case",
+			id:                gographql.ID("shopping"),
+			typ:               NodeTypeCategory,
+			countryCode:       countryCodeSG,
+			expectID:          gographql.ID("shopping"),
+			expectCountryCode: countryCodeSG,
+		},
+		{
+			description:       "testing global id overrides country code case",
+			id:                EncodeNodeID(NodeTypeArticle, countryCodeTW, 115015959148),
+			typ:               NodeTypeArticle,
+			countryCode:       countryCodeSG,
+			expectID:          gographql.ID("115015959148"),
+			expectCountryCode: countryCodeTW,
+		},
+		{
+			description:       "testing global id without country code case",
+			id:                EncodeNodeID(NodeTypeTicketForm, "", 825847),
+			typ:               NodeTypeTicketForm,
+			expectID:          gographql.ID("825847"),
+			expectCountryCode: "",
+		},
+		{
+			description: "testing global id of another type case",
+			id:          EncodeNodeID(NodeTypeSection, countryCodeTW, 115004118448),
+			typ:         NodeTypeArticle,
+			countryCode: countryCodeSG,
+			expectErr:   true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			id, countryCode, err := processGraphQLNodeID(tt.id, tt.typ, tt.countryCode)
+			if tt.expectErr && err == nil {
+				t.Errorf("[%s] expect an error, actual == nil", tt.description)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("[%s] expect no error, actual:%v", tt.description, err)
+			} else if id != tt.expectID || countryCode != tt.expectCountryCode {
+				t.Errorf("[%s] expect:%s %s, actual:%s %s", tt.description, tt.expectID, tt.expectCountryCode, id, countryCode)
+			}
+		})
+	}
+}
+
+func TestQueryNodesInProcessInputParams(t *testing.T) {
+	in := &QueryNodesIn{IDs: make([]gographql.ID, maxNodeIDs+1), Locale: graphqlEnumLocaleENUS}
+	if err := in.ProcessInputParams(); err == nil {
+		t.Errorf("expect an error of too many ids, actual == nil")
+	}
+
+	in = &QueryNodesIn{IDs: make([]gographql.ID, maxNodeIDs), Locale: graphqlEnumLocaleZHTW}
+	if err := in.ProcessInputParams(); err != nil {
+		t.Errorf("expect no error, actual:%v", err)
+	}
+	if in.Locale != localeZHTW {
+		t.Errorf("locale expect:%s, actual:%s", localeZHTW, in.Locale)
+	}
+}
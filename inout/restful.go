@@ -18,6 +18,11 @@ type BaseIn struct {
 	Page        int    `json:"page,omitempty"`
 	SortBy      string `json:"sort_by,omitempty"`
 	SortOrder   string `json:"sort_order,omitempty"`
+	// After selects the rows sorted after the cursor, Page should be zero if it is set.
+	// It is only set by the v2 listings which are paged by the cursors.
+	After *models.Cursor `json:"-"`
+	// Keyset is set by the v2 listings, see models.GetArticlesParams.
+	Keyset bool `json:"-"`
 }
 
 // BaseOut is the basic output parameters.
@@ -37,6 +42,7 @@ type GetCategoriesIn struct {
 type GetCategoriesOut struct {
 	Categories []*models.Category `json:"categories"`
 	*BaseOut
+	Connection *ConnectionOut `json:"connection,omitempty"`
 }
 
 // GetCategoryKeyNameToIDIn is the input parameters of GET category_key_name_to_id.
@@ -60,6 +66,7 @@ type GetSectionsIn struct {
 type GetSectionsOut struct {
 	Sections []*models.Section `json:"sections"`
 	*BaseOut
+	Connection *ConnectionOut `json:"connection,omitempty"`
 }
 
 // GetCategoriesArticlesIn is the input parameters of GET categories/articles.
@@ -90,6 +97,7 @@ type GetArticlesIn struct {
 type GetArticlesOut struct {
 	Articles []*models.Article `json:"articles"`
 	*BaseOut
+	Connection *ConnectionOut `json:"connection,omitempty"`
 }
 
 // GetArticleIn is the input parameters of GET article.
@@ -97,6 +105,8 @@ type GetArticleIn struct {
 	ArticleID   int    `json:"article_id,omitempty"`
 	Locale      string `json:"locale,omitempty"`
 	CountryCode string `json:"country_code,omitempty"`
+	// SearchQuery is the query of the search results the article is clicked from.
+	SearchQuery string `json:"search_query,omitempty"`
 }
 
 // GetArticleOut is the output parameters of GET article.
@@ -109,6 +119,10 @@ type GetTopNArticlesIn struct {
 	TopN        uint64 `json:"top_n,omitempty"`
 	Locale      string `json:"locale,omitempty"`
 	CountryCode string `json:"country_code,omitempty"`
+	// WindowDays ranks the articles by the views of the last days, zero means all time.
+	WindowDays int `json:"window_days,omitempty"`
+	SectionID  int `json:"section_id,omitempty"`
+	CategoryID int `json:"category_id,omitempty"`
 }
 
 // GetTopNArticlesOut is the output parameters of GET top_n articles.
@@ -116,10 +130,83 @@ type GetTopNArticlesOut struct {
 	Articles []*models.Article `json:"articles"`
 }
 
+// GetSearchQueryStatsIn is the input parameters of GET search query stats.
+type GetSearchQueryStatsIn struct {
+	Report      models.SearchQueryReport `json:"report,omitempty"`
+	Limit       uint64                   `json:"limit,omitempty"`
+	WindowDays  int                      `json:"window_days,omitempty"`
+	Locale      string                   `json:"locale,omitempty"`
+	CountryCode string                   `json:"country_code,omitempty"`
+}
+
+// GetSearchQueryStatsOut is the output parameters of GET search query stats.
+type GetSearchQueryStatsOut struct {
+	Queries []*models.SearchQueryStat `json:"queries"`
+}
+
+// GetDeflectionStatsIn is the input parameters of GET deflection stats.
+type GetDeflectionStatsIn struct {
+	Group       models.DeflectionGroup `json:"group,omitempty"`
+	Limit       uint64                 `json:"limit,omitempty"`
+	WindowDays  int                    `json:"window_days,omitempty"`
+	Locale      string                 `json:"locale,omitempty"`
+	CountryCode string                 `json:"country_code,omitempty"`
+}
+
+// GetDeflectionStatsOut is the output parameters of GET deflection stats.
+type GetDeflectionStatsOut struct {
+	Deflections []*models.DeflectionStat `json:"deflections"`
+}
+
+// GetArticleVoteHistoryIn is the input parameters of GET article vote history.
+type GetArticleVoteHistoryIn struct {
+	ArticleID   int    `json:"article_id,omitempty"`
+	WindowDays  int    `json:"window_days,omitempty"`
+	CountryCode string `json:"country_code,omitempty"`
+}
+
+// GetArticleVoteHistoryOut is the output parameters of GET article vote history.
+type GetArticleVoteHistoryOut struct {
+	Days []*models.ArticleVoteDay `json:"days"`
+}
+
 // CreateRequestIn is the input parameters of POST request.
 type CreateRequestIn struct {
-	CountryCode string                 `json:"country_code,omitempty"`
-	Data        map[string]interface{} `json:"data,omitempty"`
+	CountryCode  string                 `json:"country_code,omitempty"`
+	Data         map[string]interface{} `json:"data,omitempty"`
+	CaptchaToken string                 `json:"captcha_token,omitempty"`
+	// Website is a honeypot field which is hidden from the users.
+	Website  string `json:"website,omitempty"`
+	RemoteIP string `json:"-"`
+}
+
+// AttachViewedArticles puts the articles viewed before filing the request into the custom field of the data,
+// they are appended to the comment if fieldID is 0. The data not in the zendesk request shape is left as it is.
+func (in *CreateRequestIn) AttachViewedArticles(fieldID int, articleIDs []int) {
+	if len(articleIDs) == 0 {
+		return
+	}
+	request, ok := in.Data["request"].(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	if fieldID == 0 {
+		comment, ok := request["comment"].(map[string]interface{})
+		if !ok {
+			comment = make(map[string]interface{})
+			request["comment"] = comment
+		}
+		body, _ := comment["body"].(string)
+		comment["body"] = body + viewedArticlesComment(articleIDs)
+		return
+	}
+
+	customFields, _ := request["custom_fields"].([]interface{})
+	request["custom_fields"] = append(customFields, map[string]interface{}{
+		"id":    fieldID,
+		"value": viewedArticlesValue(articleIDs),
+	})
 }
 
 // GetTicketFormIn is the input parameters of GET ticket_form.
@@ -140,6 +227,8 @@ type CreateVoteIn struct {
 	Value       string `json:"value,omitempty"`
 	Locale      string `json:"locale,omitempty"`
 	CountryCode string `json:"country_code,omitempty"`
+	// RemoteIP identifies the voter if the client didn't send a device id.
+	RemoteIP string `json:"-"`
 }
 
 // CreateVoteOut is the output parameters of POST vote.
@@ -177,19 +266,17 @@ type GetSearchIn struct {
 type GetSearchOut struct {
 	Articles []*models.SearchArticle `json:"results"`
 	*BaseOut
-}
-
-// GetBasicAuthIn is the input parameters of basic auth.
-type GetBasicAuthIn struct {
-	User string
-	Pwd  string
+	Connection *ConnectionOut `json:"connection,omitempty"`
 }
 
 // GraphQLIn is the input parameters of GraphQL query.
 type GraphQLIn struct {
-	Ctx     context.Context
-	Queries []GraphQLQuery
-	IsBatch bool
+	Ctx      context.Context
+	Queries  []GraphQLQuery
+	IsBatch  bool
+	RemoteIP string
+	// ReadOnly only allows the query operations, it is set for the GET requests.
+	ReadOnly bool
 }
 
 // GraphQLQuery is the input parameters of GraphQL query.
@@ -197,6 +284,34 @@ type GraphQLQuery struct {
 	Query     string                 `json:"query"`
 	OpName    string                 `json:"operationName"`
 	Variables map[string]interface{} `json:"variables"`
+	// ID is the id of a pre-registered persisted query.
+	ID         string             `json:"id,omitempty"`
+	Extensions *GraphQLExtensions `json:"extensions,omitempty"`
+}
+
+// GraphQLExtensions is the extensions of GraphQL query.
+type GraphQLExtensions struct {
+	PersistedQuery *GraphQLPersistedQuery `json:"persistedQuery,omitempty"`
+}
+
+// GraphQLPersistedQuery is the automatic persisted query extension, the query is
+// identified by the sha256 hash of its text.
+type GraphQLPersistedQuery struct {
+	Version    int    `json:"version"`
+	Sha256Hash string `json:"sha256Hash"`
+}
+
+// PersistedQuery returns the automatic persisted query extension, nil is returned if it is not given.
+func (q *GraphQLQuery) PersistedQuery() *GraphQLPersistedQuery {
+	if q.Extensions == nil {
+		return nil
+	}
+	return q.Extensions.PersistedQuery
+}
+
+// IsPersisted returns true if the query is referred by the id or the sha256 hash.
+func (q *GraphQLQuery) IsPersisted() bool {
+	return q.ID != "" || q.PersistedQuery() != nil
 }
 
 // FetchBaseParams fetches RESTful base parameters.
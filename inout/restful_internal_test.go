@@ -468,3 +468,76 @@ func TestFetchBaseInParams(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateRequestInAttachViewedArticles(t *testing.T) {
+	newIn := func() *CreateRequestIn {
+		return &CreateRequestIn{
+			CountryCode: "tw",
+			Data: map[string]interface{}{
+				"request": map[string]interface{}{
+					"subject": "refund",
+					"comment": map[string]interface{}{"body": "where is my refund"},
+				},
+			},
+		}
+	}
+
+	testCases := [...]struct {
+		description string
+		input       *CreateRequestIn
+		fieldID     int
+		articleIDs  []int
+		expect      map[string]interface{}
+	}{
+		{
+			description: "testing comment case",
+			input:       newIn(),
+			fieldID:     0,
+			articleIDs:  []int{3345679, 3345680},
+			expect: map[string]interface{}{
+				"request": map[string]interface{}{
+					"subject": "refund",
+					"comment": map[string]interface{}{"body": "where is my refund\n\nViewed articles: 3345679, 3345680"},
+				},
+			},
+		},
+		{
+			description: "testing custom field case",
+			input:       newIn(),
+			fieldID:     360000123456,
+			articleIDs:  []int{3345679, 3345680},
+			expect: map[string]interface{}{
+				"request": map[string]interface{}{
+					"subject": "refund",
+					"comment": map[string]interface{}{"body": "where is my refund"},
+					"custom_fields": []interface{}{
+						map[string]interface{}{"id": 360000123456, "value": "3345679,3345680"},
+					},
+				},
+			},
+		},
+		{
+			description: "testing no viewed articles case",
+			input:       newIn(),
+			fieldID:     0,
+			articleIDs:  []int{},
+			expect:      newIn().Data,
+		},
+		{
+			description: "testing not request shape case",
+			input:       &CreateRequestIn{Data: map[string]interface{}{"subject": "refund"}},
+			fieldID:     0,
+			articleIDs:  []int{3345679},
+			expect:      map[string]interface{}{"subject": "refund"},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			tt.input.AttachViewedArticles(tt.fieldID, tt.articleIDs)
+			if diff := deep.Equal(tt.expect, tt.input.Data); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package inout
+
+import (
+	"encoding/json"
+	"strconv"
+
+	gographql "github.com/graph-gophers/graphql-go"
+	"github.com/pkg/errors"
+
+	"github.com/honestbee/Zen/models"
+)
+
+// The message types of the graphql-ws protocol.
+const (
+	GraphQLWSConnectionInit      = "connection_init"
+	GraphQLWSConnectionAck       = "connection_ack"
+	GraphQLWSConnectionError     = "connection_error"
+	GraphQLWSConnectionKeepAlive = "ka"
+	GraphQLWSConnectionTerminate = "connection_terminate"
+	GraphQLWSStart               = "start"
+	GraphQLWSData                = "data"
+	GraphQLWSError               = "error"
+	GraphQLWSComplete            = "complete"
+	GraphQLWSStop                = "stop"
+)
+
+// GraphQLWSMessage is the message of the graphql-ws protocol.
+type GraphQLWSMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// SubscriptionArticleUpdatedIn are the arguments for the "articleUpdated" subscription.
+type SubscriptionArticleUpdatedIn struct {
+	CountryCode string
+	Locale      string
+	SectionID   *gographql.ID
+	// SectionZendeskID is the processed section id, it is zero if all the sections are subscribed.
+	SectionZendeskID int
+}
+
+// ProcessInputParams process SubscriptionArticleUpdatedIn input parameters.
+func (in *SubscriptionArticleUpdatedIn) ProcessInputParams() error {
+	var err error
+
+	in.CountryCode, err = processGraphQLCountryCode(in.CountryCode)
+	if err != nil {
+		return err
+	}
+
+	in.Locale, err = processGraphQLLocale(in.Locale)
+	if err != nil {
+		return err
+	}
+
+	if in.SectionID == nil {
+		return nil
+	}
+
+	sectionID, countryCode, err := processGraphQLNodeID(*in.SectionID, NodeTypeSection, in.CountryCode)
+	if err != nil {
+		return err
+	}
+	if countryCode != in.CountryCode {
+		return errors.Errorf("inout: [SubscriptionArticleUpdatedIn] sectionId:%s is not in the country:%s", *in.SectionID, in.CountryCode)
+	}
+
+	in.SectionZendeskID, err = strconv.Atoi(string(sectionID))
+	if err != nil {
+		return errors.Wrapf(err, "inout: [SubscriptionArticleUpdatedIn] sectionId:%s is invalid", *in.SectionID)
+	}
+
+	return nil
+}
+
+// Match returns true if the event is an updated article of the subscription.
+func (in *SubscriptionArticleUpdatedIn) Match(event *models.Event) bool {
+	return event.Type == models.EventArticleUpdated &&
+		event.CountryCode == in.CountryCode &&
+		event.Locale == in.Locale &&
+		len(event.IDs) > 0 &&
+		(in.SectionZendeskID == 0 || event.SectionID == in.SectionZendeskID)
+}
+
+// SubscriptionCategoriesChangedIn are the arguments for the "categoriesChanged" subscription.
+type SubscriptionCategoriesChangedIn struct {
+	CountryCode string
+	Locale      string
+}
+
+// ProcessInputParams process SubscriptionCategoriesChangedIn input parameters.
+func (in *SubscriptionCategoriesChangedIn) ProcessInputParams() error {
+	var err error
+
+	in.CountryCode, err = processGraphQLCountryCode(in.CountryCode)
+	if err != nil {
+		return err
+	}
+
+	in.Locale, err = processGraphQLLocale(in.Locale)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Match returns true if the event is a categories change of the subscription.
+func (in *SubscriptionCategoriesChangedIn) Match(event *models.Event) bool {
+	return event.Type == models.EventCategoriesChanged &&
+		event.CountryCode == in.CountryCode &&
+		event.Locale == in.Locale
+}
+
+// GetCategoriesParams returns the params of the categories sent to the subscription,
+// they are the first page of the categories sorted by position.
+func (in *SubscriptionCategoriesChangedIn) GetCategoriesParams() *models.GetCategoriesParams {
+	return &models.GetCategoriesParams{
+		Locale:      in.Locale,
+		CountryCode: in.CountryCode,
+		PerPage:     maxPerPage,
+		SortBy:      sortByPosition,
+		SortOrder:   sortOrderAsc,
+	}
+}
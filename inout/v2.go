@@ -0,0 +1,116 @@
+package inout
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/honestbee/Zen/errs"
+)
+
+const (
+	// V2StatusCreated is the status of the created resources.
+	V2StatusCreated = "created"
+	// V2StatusAccepted is the status of the accepted jobs.
+	V2StatusAccepted = "accepted"
+)
+
+// V2Out is the envelope of the v2 RESTful responses, data is set for the succeeded
+// requests and errors is set for the failed ones.
+type V2Out struct {
+	Data   interface{}   `json:"data,omitempty"`
+	Meta   *V2MetaOut    `json:"meta,omitempty"`
+	Errors []*V2ErrorOut `json:"errors,omitempty"`
+	// Status is the http status code, 200 is used if it is zero.
+	Status int `json:"-"`
+}
+
+// V2MetaOut is the metadata of the v2 responses.
+type V2MetaOut struct {
+	Page *V2PageOut `json:"page,omitempty"`
+}
+
+// V2PageOut is the cursor paging state of a v2 listing.
+type V2PageOut struct {
+	Size            int  `json:"size"`
+	Total           int  `json:"total"`
+	HasNextPage     bool `json:"has_next_page"`
+	HasPreviousPage bool `json:"has_previous_page"`
+	// EndCursor is passed as the after parameter to get the next page, it is empty if the page is empty.
+	EndCursor string `json:"end_cursor,omitempty"`
+}
+
+// V2ErrorOut is an error of the v2 responses.
+type V2ErrorOut struct {
+	Code   string            `json:"code"`
+	Title  string            `json:"title"`
+	Detail string            `json:"detail,omitempty"`
+	Source *V2ErrorSourceOut `json:"source,omitempty"`
+}
+
+// V2ErrorSourceOut locates the request field which caused the error.
+type V2ErrorSourceOut struct {
+	Pointer   string `json:"pointer,omitempty"`
+	Parameter string `json:"parameter,omitempty"`
+}
+
+// V2StatusOut is the data of the v2 responses which have no resources.
+type V2StatusOut struct {
+	Status string `json:"status"`
+}
+
+// V2ListIn is the input parameters of the v2 listings, In is the input of the v1 listing
+// which is paged by the cursor instead of the page number.
+type V2ListIn struct {
+	In    interface{}
+	First int
+	// Offset is the offset of the first row selected by the after offset cursor.
+	Offset int
+}
+
+// NewV2ErrorOut returns the v2 error of the handler error.
+func NewV2ErrorOut(er *errs.Error) *V2ErrorOut {
+	out := &V2ErrorOut{
+		Code:   er.Reason,
+		Title:  er.OutputErr,
+		Detail: er.Detail,
+	}
+	if er.Parameter != "" || er.Pointer != "" {
+		out.Source = &V2ErrorSourceOut{Pointer: er.Pointer, Parameter: er.Parameter}
+	}
+	return out
+}
+
+// FetchV2PageParams fetches the v2 paging parameters first and after.
+func FetchV2PageParams(r *http.Request) (int, string, error) {
+	first := defaultPerPage
+	if s := r.FormValue("first"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < minPerPage {
+			return 0, "", errors.Errorf("inout: [FetchV2PageParams] first:%q is not a positive integer", s)
+		}
+		first = n
+	}
+	if first > maxPerPage {
+		first = maxPerPage
+	}
+	return first, r.FormValue("after"), nil
+}
+
+// FetchV2Fields fetches the sparse fieldset, nil is returned if all the fields are selected.
+func FetchV2Fields(r *http.Request) []string {
+	s := r.FormValue("fields")
+	if s == "" {
+		return nil
+	}
+
+	var fields []string
+	for _, field := range strings.Split(s, ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
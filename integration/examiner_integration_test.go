@@ -71,7 +71,8 @@ func TestExaminerTicketForms(t *testing.T) {
 
 func TestExaminerArticles(t *testing.T) {
 	cleaner := func(ts *tserver) error {
-		return ts.service.SyncWithArticles(context.Background(), make([]*models.Article, 0), "tw", "en-us")
+		_, err := ts.service.SyncWithArticles(context.Background(), make([]*models.Article, 0), "tw", "en-us")
+		return err
 	}
 	reacher := func(ts *tserver) error {
 		addr := ts.URL + "/api/sections/115004118448/articles?country_code=tw&locale=en-us"
@@ -119,7 +120,8 @@ func TestExaminerArticles(t *testing.T) {
 
 func TestExaminerSections(t *testing.T) {
 	cleaner := func(ts *tserver) error {
-		return ts.service.SyncWithSections(context.Background(), make([]*models.Section, 0), "tw", "en-us")
+		_, err := ts.service.SyncWithSections(context.Background(), make([]*models.Section, 0), "tw", "en-us")
+		return err
 	}
 	reacher := func(ts *tserver) error {
 		addr := ts.URL + "/api/categories/115002432448/sections?country_code=tw&locale=en-us"
@@ -167,7 +169,8 @@ func TestExaminerSections(t *testing.T) {
 
 func TestExaminerCategories(t *testing.T) {
 	cleaner := func(ts *tserver) error {
-		return ts.service.SyncWithCategories(context.Background(), make([]*models.Category, 0), "tw", "en-us")
+		_, err := ts.service.SyncWithCategories(context.Background(), make([]*models.Category, 0), "tw", "en-us")
+		return err
 	}
 	reacher := func(ts *tserver) error {
 		addr := ts.URL + "/api/categories?country_code=tw&locale=en-us"
@@ -6,6 +6,12 @@ DELETE FROM sections;
 DELETE FROM section_translates;
 DELETE FROM articles;
 DELETE FROM article_translates;
+DELETE FROM article_views;
+DELETE FROM search_queries;
+DELETE FROM search_clicks;
+DELETE FROM session_events;
+DELETE FROM article_votes;
+DELETE FROM article_vote_events;
 DELETE FROM ticket_forms;
 DELETE FROM ticket_fields;
 DELETE FROM dynamic_content_items;
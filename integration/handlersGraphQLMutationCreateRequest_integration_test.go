@@ -73,6 +73,8 @@ func TestHandlersGraphQLMutationCreateRequest(t *testing.T) {
 			if err = json.NewDecoder(resp.Body).Decode(&actual); err != nil {
 				t.Fatalf("[%s] json decoding failed:%v", tt.description, err)
 			}
+			// The cost of the query is reported in the extensions, it is not compared here.
+			delete(actual, "extensions")
 			// Converts integer to the same type.
 			expectData, err := json.Marshal(tt.expectBody)
 			if err != nil {
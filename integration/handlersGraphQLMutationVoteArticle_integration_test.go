@@ -43,6 +43,11 @@ func TestHandlersGraphQLMutationVoteArticle(t *testing.T) {
 			Value:       "down",
 		})
 
+	// The down vote of the same client changes its up vote, so the up vote is withdrawn first.
+	gock.New("https://honestbeehelp-tw.zendesk.com/").
+		Delete("/api/v2/help_center/votes/360002569612.json").
+		Reply(http.StatusNoContent)
+
 	ts := newTserver()
 	defer ts.closeAll()
 	testCases := []struct {
@@ -57,7 +62,7 @@ func TestHandlersGraphQLMutationVoteArticle(t *testing.T) {
 				"query": `mutation
 				{
 					voteArticle(articleId: "115015959188", vote: UP, countryCode: TW, locale: EN_US) {
-						id
+						zendeskId
 						authorId
 						commentsDisable
 						draft
@@ -86,7 +91,7 @@ func TestHandlersGraphQLMutationVoteArticle(t *testing.T) {
 			expectBody: map[string]interface{}{
 				"data": map[string]interface{}{
 					"voteArticle": map[string]interface{}{
-						"id":              "115015959188",
+						"zendeskId":       "115015959188",
 						"authorId":        "24400224208",
 						"commentsDisable": false,
 						"draft":           false,
@@ -118,7 +123,7 @@ func TestHandlersGraphQLMutationVoteArticle(t *testing.T) {
 				"query": `mutation
 				{
 					voteArticle(articleId: "115015959188", vote: DOWN, countryCode: TW, locale: EN_US) {
-						id
+						zendeskId
 						authorId
 						commentsDisable
 						draft
@@ -147,7 +152,7 @@ func TestHandlersGraphQLMutationVoteArticle(t *testing.T) {
 			expectBody: map[string]interface{}{
 				"data": map[string]interface{}{
 					"voteArticle": map[string]interface{}{
-						"id":              "115015959188",
+						"zendeskId":       "115015959188",
 						"authorId":        "24400224208",
 						"commentsDisable": false,
 						"draft":           false,
@@ -179,7 +184,7 @@ func TestHandlersGraphQLMutationVoteArticle(t *testing.T) {
 				"query": `mutation
 				{
 					voteArticle(articleId: "115015959188", vote: DOWN, countryCode: not_exist_country_code, locale: EN_US) {
-						id
+						zendeskId
 					}
 				}
 				`,
@@ -204,7 +209,7 @@ func TestHandlersGraphQLMutationVoteArticle(t *testing.T) {
 				"query": `mutation
 				{
 					voteArticle(articleId: "115015959188", vote: DOWN, countryCode: SG, locale: not_exist_locale) {
-						id
+						zendeskId
 					}
 				}
 				`,
@@ -248,6 +253,8 @@ func TestHandlersGraphQLMutationVoteArticle(t *testing.T) {
 			if err = json.NewDecoder(resp.Body).Decode(&actual); err != nil {
 				t.Fatalf("[%s] json decoding failed:%v", tt.description, err)
 			}
+			// The cost of the query is reported in the extensions, it is not compared here.
+			delete(actual, "extensions")
 			// Converts integer to the same type.
 			expectData, err := json.Marshal(tt.expectBody)
 			if err != nil {
@@ -33,7 +33,7 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 						pageCount
 						count
 						articles {
-							id
+							zendeskId
 							authorId
 							commentsDisable
 							draft
@@ -69,7 +69,7 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 						"count":     4,
 						"articles": []interface{}{
 							map[string]interface{}{
-								"id":              "115016053147",
+								"zendeskId":       "115016053147",
 								"authorId":        "7222048487",
 								"commentsDisable": false,
 								"draft":           false,
@@ -93,7 +93,7 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"locale":          "en-us",
 							},
 							map[string]interface{}{
-								"id":              "115016039687",
+								"zendeskId":       "115016039687",
 								"authorId":        "7222048487",
 								"commentsDisable": false,
 								"draft":           false,
@@ -117,7 +117,7 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"locale":          "en-us",
 							},
 							map[string]interface{}{
-								"id":              "115015447167",
+								"zendeskId":       "115015447167",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -141,7 +141,7 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"locale":          "en-us",
 							},
 							map[string]interface{}{
-								"id":              "115015433907",
+								"zendeskId":       "115015433907",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -181,7 +181,7 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 						count
 						articles
 						{
-							id
+							zendeskId
 							authorId
 							commentsDisable
 							draft
@@ -204,12 +204,12 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 							body
 							locale
 							categoryConnection {
-								id
+								zendeskId
 								name
 								keyName
 							}
 							sectionConnection {
-								id
+								zendeskId
 								name
 							}
 						}
@@ -226,7 +226,7 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 						"count":     5,
 						"articles": []interface{}{
 							map[string]interface{}{
-								"id":              "115015959188",
+								"zendeskId":       "115015959188",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -249,17 +249,17 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"body":            "<p>When there is an error completing your checkout,your cart will be temporarily locked to prevent further changes to your order. To unlock your cart,click ‘Yes,unlock my cart,’ when prompted.</p>",
 								"locale":          "en-us",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"sectionConnection": map[string]interface{}{
-									"id":   "115004118448",
-									"name": "I need help with my account",
+									"zendeskId": "115004118448",
+									"name":      "I need help with my account",
 								},
 							},
 							map[string]interface{}{
-								"id":              "115015885547",
+								"zendeskId":       "115015885547",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -282,17 +282,17 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"body":            `<p>honestbee takes your privacy seriously and complies with all the relevant laws to ensure your details are kept secure. Read our <a href="https://www.honestbee.tw/privacy-policy">Privacy Policy</a> for more information.</p>`,
 								"locale":          "en-us",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"sectionConnection": map[string]interface{}{
-									"id":   "115004118448",
-									"name": "I need help with my account",
+									"zendeskId": "115004118448",
+									"name":      "I need help with my account",
 								},
 							},
 							map[string]interface{}{
-								"id":              "115015885507",
+								"zendeskId":       "115015885507",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -315,17 +315,17 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"body":            `<p>Currently,it’s not possible to change your email address. To register with a different email address,please create a new account.</p>`,
 								"locale":          "en-us",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"sectionConnection": map[string]interface{}{
-									"id":   "115004118448",
-									"name": "I need help with my account",
+									"zendeskId": "115004118448",
+									"name":      "I need help with my account",
 								},
 							},
 							map[string]interface{}{
-								"id":              "115015959168",
+								"zendeskId":       "115015959168",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -348,17 +348,17 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"body":            `<p>Log in to your account and go to your profile icon at the top right corner. Select Settings from the dropdown menu to edit your details.</p>`,
 								"locale":          "en-us",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"sectionConnection": map[string]interface{}{
-									"id":   "115004118448",
-									"name": "I need help with my account",
+									"zendeskId": "115004118448",
+									"name":      "I need help with my account",
 								},
 							},
 							map[string]interface{}{
-								"id":              "115015959148",
+								"zendeskId":       "115015959148",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -381,13 +381,13 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"body":            "<p>Click on the Forgot Password link on the Login page and enter your registered email address. We’ll send you an email to reset your password. Occasionally emails end up in the junk/spam folder. Take a look there.</p>",
 								"locale":          "en-us",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"sectionConnection": map[string]interface{}{
-									"id":   "115004118448",
-									"name": "I need help with my account",
+									"zendeskId": "115004118448",
+									"name":      "I need help with my account",
 								},
 							},
 						},
@@ -406,7 +406,7 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 						pageCount
 						count
 						articles {
-							id
+							zendeskId
 							authorId
 							commentsDisable
 							draft
@@ -429,12 +429,12 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 							body
 							locale
 							categoryConnection {
-								id
+								zendeskId
 								name
 								keyName
 							}
 							sectionConnection {
-								id
+								zendeskId
 								name
 							}
 						}
@@ -451,7 +451,7 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 						"count":     5,
 						"articles": []interface{}{
 							map[string]interface{}{
-								"id":              "115015959188",
+								"zendeskId":       "115015959188",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -474,17 +474,17 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"body":            "<p>當結帳出現錯誤時，您的購物車會暫時被鎖住，以避免您的訂單出現異動。要解鎖您的購物車，請在出現提示時，點選「是的，解鎖我的購物車」。</p>",
 								"locale":          "zh-tw",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"sectionConnection": map[string]interface{}{
-									"id":   "115004118448",
-									"name": "I need help with my account",
+									"zendeskId": "115004118448",
+									"name":      "I need help with my account",
 								},
 							},
 							map[string]interface{}{
-								"id":              "115015885547",
+								"zendeskId":       "115015885547",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -507,17 +507,17 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"body":            `<p>honestbee 很重視您的隱私，並遵循所有相關法規以確保您的資訊安全。請閱讀我們的<a href="https://www.honestbee.tw/privacy-policy">隱私權政策</a>以了解更多資訊。</p>`,
 								"locale":          "zh-tw",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"sectionConnection": map[string]interface{}{
-									"id":   "115004118448",
-									"name": "I need help with my account",
+									"zendeskId": "115004118448",
+									"name":      "I need help with my account",
 								},
 							},
 							map[string]interface{}{
-								"id":              "115015885507",
+								"zendeskId":       "115015885507",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -540,17 +540,17 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"body":            `<p>您的電子信箱目前無法更改。若要以不同信箱進行註冊，請建立新帳號。</p>`,
 								"locale":          "zh-tw",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"sectionConnection": map[string]interface{}{
-									"id":   "115004118448",
-									"name": "I need help with my account",
+									"zendeskId": "115004118448",
+									"name":      "I need help with my account",
 								},
 							},
 							map[string]interface{}{
-								"id":              "115015959168",
+								"zendeskId":       "115015959168",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -573,17 +573,17 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"body":            `<p>登入您的帳號並前往右上角的個人資料圖示。在下拉式選單中選擇「設定」來編輯您的資料。</p>`,
 								"locale":          "zh-tw",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"sectionConnection": map[string]interface{}{
-									"id":   "115004118448",
-									"name": "I need help with my account",
+									"zendeskId": "115004118448",
+									"name":      "I need help with my account",
 								},
 							},
 							map[string]interface{}{
-								"id":              "115015959148",
+								"zendeskId":       "115015959148",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -606,13 +606,13 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"body":            "<p>請在登入頁面點選「忘記密碼」，並輸入您註冊時所使用的電子信箱。我們會寄給您一封信讓您重設密碼。有時候信件會跑到垃圾信件匣，請務必檢查看看。</p>",
 								"locale":          "zh-tw",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"sectionConnection": map[string]interface{}{
-									"id":   "115004118448",
-									"name": "I need help with my account",
+									"zendeskId": "115004118448",
+									"name":      "I need help with my account",
 								},
 							},
 						},
@@ -631,7 +631,7 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 						pageCount
 						count
 						articles {
-							id
+							zendeskId
 							authorId
 							commentsDisable
 							draft
@@ -654,12 +654,12 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 							body
 							locale
 							categoryConnection {
-								id
+								zendeskId
 								name
 								keyName
 							}
 							sectionConnection {
-								id
+								zendeskId
 								name
 							}
 						}
@@ -676,7 +676,7 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 						"count":     5,
 						"articles": []interface{}{
 							map[string]interface{}{
-								"id":              "115015959148",
+								"zendeskId":       "115015959148",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -699,17 +699,17 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"body":            "<p>Click on the Forgot Password link on the Login page and enter your registered email address. We’ll send you an email to reset your password. Occasionally emails end up in the junk/spam folder. Take a look there.</p>",
 								"locale":          "en-us",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"sectionConnection": map[string]interface{}{
-									"id":   "115004118448",
-									"name": "I need help with my account",
+									"zendeskId": "115004118448",
+									"name":      "I need help with my account",
 								},
 							},
 							map[string]interface{}{
-								"id":              "115015959168",
+								"zendeskId":       "115015959168",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -732,17 +732,17 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"body":            `<p>Log in to your account and go to your profile icon at the top right corner. Select Settings from the dropdown menu to edit your details.</p>`,
 								"locale":          "en-us",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"sectionConnection": map[string]interface{}{
-									"id":   "115004118448",
-									"name": "I need help with my account",
+									"zendeskId": "115004118448",
+									"name":      "I need help with my account",
 								},
 							},
 							map[string]interface{}{
-								"id":              "115015885507",
+								"zendeskId":       "115015885507",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -765,17 +765,17 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"body":            `<p>Currently,it’s not possible to change your email address. To register with a different email address,please create a new account.</p>`,
 								"locale":          "en-us",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"sectionConnection": map[string]interface{}{
-									"id":   "115004118448",
-									"name": "I need help with my account",
+									"zendeskId": "115004118448",
+									"name":      "I need help with my account",
 								},
 							},
 							map[string]interface{}{
-								"id":              "115015885547",
+								"zendeskId":       "115015885547",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -798,17 +798,17 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"body":            `<p>honestbee takes your privacy seriously and complies with all the relevant laws to ensure your details are kept secure. Read our <a href="https://www.honestbee.tw/privacy-policy">Privacy Policy</a> for more information.</p>`,
 								"locale":          "en-us",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"sectionConnection": map[string]interface{}{
-									"id":   "115004118448",
-									"name": "I need help with my account",
+									"zendeskId": "115004118448",
+									"name":      "I need help with my account",
 								},
 							},
 							map[string]interface{}{
-								"id":              "115015959188",
+								"zendeskId":       "115015959188",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -831,13 +831,13 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"body":            "<p>When there is an error completing your checkout,your cart will be temporarily locked to prevent further changes to your order. To unlock your cart,click ‘Yes,unlock my cart,’ when prompted.</p>",
 								"locale":          "en-us",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"sectionConnection": map[string]interface{}{
-									"id":   "115004118448",
-									"name": "I need help with my account",
+									"zendeskId": "115004118448",
+									"name":      "I need help with my account",
 								},
 							},
 						},
@@ -856,7 +856,7 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 						pageCount
 						count
 						articles {
-							id
+							zendeskId
 							authorId
 							commentsDisable
 							draft
@@ -879,12 +879,12 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 							body
 							locale
 							categoryConnection {
-								id
+								zendeskId
 								name
 								keyName
 							}
 							sectionConnection {
-								id
+								zendeskId
 								name
 							}
 						}
@@ -901,7 +901,7 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 						"count":     5,
 						"articles": []interface{}{
 							map[string]interface{}{
-								"id":              "115015885547",
+								"zendeskId":       "115015885547",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -924,17 +924,17 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"body":            `<p>honestbee takes your privacy seriously and complies with all the relevant laws to ensure your details are kept secure. Read our <a href="https://www.honestbee.tw/privacy-policy">Privacy Policy</a> for more information.</p>`,
 								"locale":          "en-us",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"sectionConnection": map[string]interface{}{
-									"id":   "115004118448",
-									"name": "I need help with my account",
+									"zendeskId": "115004118448",
+									"name":      "I need help with my account",
 								},
 							},
 							map[string]interface{}{
-								"id":              "115015959188",
+								"zendeskId":       "115015959188",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -957,17 +957,17 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"body":            "<p>When there is an error completing your checkout,your cart will be temporarily locked to prevent further changes to your order. To unlock your cart,click ‘Yes,unlock my cart,’ when prompted.</p>",
 								"locale":          "en-us",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"sectionConnection": map[string]interface{}{
-									"id":   "115004118448",
-									"name": "I need help with my account",
+									"zendeskId": "115004118448",
+									"name":      "I need help with my account",
 								},
 							},
 							map[string]interface{}{
-								"id":              "115015885507",
+								"zendeskId":       "115015885507",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -990,17 +990,17 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"body":            `<p>Currently,it’s not possible to change your email address. To register with a different email address,please create a new account.</p>`,
 								"locale":          "en-us",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"sectionConnection": map[string]interface{}{
-									"id":   "115004118448",
-									"name": "I need help with my account",
+									"zendeskId": "115004118448",
+									"name":      "I need help with my account",
 								},
 							},
 							map[string]interface{}{
-								"id":              "115015959148",
+								"zendeskId":       "115015959148",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -1023,17 +1023,17 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"body":            "<p>Click on the Forgot Password link on the Login page and enter your registered email address. We’ll send you an email to reset your password. Occasionally emails end up in the junk/spam folder. Take a look there.</p>",
 								"locale":          "en-us",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"sectionConnection": map[string]interface{}{
-									"id":   "115004118448",
-									"name": "I need help with my account",
+									"zendeskId": "115004118448",
+									"name":      "I need help with my account",
 								},
 							},
 							map[string]interface{}{
-								"id":              "115015959168",
+								"zendeskId":       "115015959168",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -1056,13 +1056,13 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"body":            `<p>Log in to your account and go to your profile icon at the top right corner. Select Settings from the dropdown menu to edit your details.</p>`,
 								"locale":          "en-us",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"sectionConnection": map[string]interface{}{
-									"id":   "115004118448",
-									"name": "I need help with my account",
+									"zendeskId": "115004118448",
+									"name":      "I need help with my account",
 								},
 							},
 						},
@@ -1081,7 +1081,7 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 						pageCount
 						count
 						articles {
-							id
+							zendeskId
 							authorId
 							commentsDisable
 							draft
@@ -1104,12 +1104,12 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 							body
 							locale
 							categoryConnection {
-								id
+								zendeskId
 								name
 								keyName
 							}
 							sectionConnection {
-								id
+								zendeskId
 								name
 							}
 						}
@@ -1126,7 +1126,7 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 						"count":     5,
 						"articles": []interface{}{
 							map[string]interface{}{
-								"id":              "115015959168",
+								"zendeskId":       "115015959168",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -1149,17 +1149,17 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"body":            `<p>Log in to your account and go to your profile icon at the top right corner. Select Settings from the dropdown menu to edit your details.</p>`,
 								"locale":          "en-us",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"sectionConnection": map[string]interface{}{
-									"id":   "115004118448",
-									"name": "I need help with my account",
+									"zendeskId": "115004118448",
+									"name":      "I need help with my account",
 								},
 							},
 							map[string]interface{}{
-								"id":              "115015959148",
+								"zendeskId":       "115015959148",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -1182,17 +1182,17 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"body":            "<p>Click on the Forgot Password link on the Login page and enter your registered email address. We’ll send you an email to reset your password. Occasionally emails end up in the junk/spam folder. Take a look there.</p>",
 								"locale":          "en-us",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"sectionConnection": map[string]interface{}{
-									"id":   "115004118448",
-									"name": "I need help with my account",
+									"zendeskId": "115004118448",
+									"name":      "I need help with my account",
 								},
 							},
 							map[string]interface{}{
-								"id":              "115015885507",
+								"zendeskId":       "115015885507",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -1215,17 +1215,17 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"body":            `<p>Currently,it’s not possible to change your email address. To register with a different email address,please create a new account.</p>`,
 								"locale":          "en-us",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"sectionConnection": map[string]interface{}{
-									"id":   "115004118448",
-									"name": "I need help with my account",
+									"zendeskId": "115004118448",
+									"name":      "I need help with my account",
 								},
 							},
 							map[string]interface{}{
-								"id":              "115015959188",
+								"zendeskId":       "115015959188",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -1248,17 +1248,17 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"body":            "<p>When there is an error completing your checkout,your cart will be temporarily locked to prevent further changes to your order. To unlock your cart,click ‘Yes,unlock my cart,’ when prompted.</p>",
 								"locale":          "en-us",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"sectionConnection": map[string]interface{}{
-									"id":   "115004118448",
-									"name": "I need help with my account",
+									"zendeskId": "115004118448",
+									"name":      "I need help with my account",
 								},
 							},
 							map[string]interface{}{
-								"id":              "115015885547",
+								"zendeskId":       "115015885547",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -1281,13 +1281,13 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"body":            `<p>honestbee takes your privacy seriously and complies with all the relevant laws to ensure your details are kept secure. Read our <a href="https://www.honestbee.tw/privacy-policy">Privacy Policy</a> for more information.</p>`,
 								"locale":          "en-us",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"sectionConnection": map[string]interface{}{
-									"id":   "115004118448",
-									"name": "I need help with my account",
+									"zendeskId": "115004118448",
+									"name":      "I need help with my account",
 								},
 							},
 						},
@@ -1306,7 +1306,7 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 						pageCount
 						count
 						articles {
-							id
+							zendeskId
 							authorId
 							commentsDisable
 							draft
@@ -1329,12 +1329,12 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 							body
 							locale
 							categoryConnection {
-								id
+								zendeskId
 								name
 								keyName
 							}
 							sectionConnection {
-								id
+								zendeskId
 								name
 							}
 						}
@@ -1351,7 +1351,7 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 						"count":     5,
 						"articles": []interface{}{
 							map[string]interface{}{
-								"id":              "115015959188",
+								"zendeskId":       "115015959188",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -1374,17 +1374,17 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"body":            "<p>When there is an error completing your checkout,your cart will be temporarily locked to prevent further changes to your order. To unlock your cart,click ‘Yes,unlock my cart,’ when prompted.</p>",
 								"locale":          "en-us",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"sectionConnection": map[string]interface{}{
-									"id":   "115004118448",
-									"name": "I need help with my account",
+									"zendeskId": "115004118448",
+									"name":      "I need help with my account",
 								},
 							},
 							map[string]interface{}{
-								"id":              "115015885547",
+								"zendeskId":       "115015885547",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -1407,13 +1407,13 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"body":            `<p>honestbee takes your privacy seriously and complies with all the relevant laws to ensure your details are kept secure. Read our <a href="https://www.honestbee.tw/privacy-policy">Privacy Policy</a> for more information.</p>`,
 								"locale":          "en-us",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"sectionConnection": map[string]interface{}{
-									"id":   "115004118448",
-									"name": "I need help with my account",
+									"zendeskId": "115004118448",
+									"name":      "I need help with my account",
 								},
 							},
 						},
@@ -1432,7 +1432,7 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 						pageCount
 						count
 						articles {
-							id
+							zendeskId
 							authorId
 							commentsDisable
 							draft
@@ -1455,12 +1455,12 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 							body
 							locale
 							categoryConnection {
-								id
+								zendeskId
 								name
 								keyName
 							}
 							sectionConnection {
-								id
+								zendeskId
 								name
 							}
 						}
@@ -1477,7 +1477,7 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 						"count":     5,
 						"articles": []interface{}{
 							map[string]interface{}{
-								"id":              "115015885507",
+								"zendeskId":       "115015885507",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -1500,17 +1500,17 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"body":            `<p>Currently,it’s not possible to change your email address. To register with a different email address,please create a new account.</p>`,
 								"locale":          "en-us",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"sectionConnection": map[string]interface{}{
-									"id":   "115004118448",
-									"name": "I need help with my account",
+									"zendeskId": "115004118448",
+									"name":      "I need help with my account",
 								},
 							},
 							map[string]interface{}{
-								"id":              "115015959168",
+								"zendeskId":       "115015959168",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -1533,13 +1533,13 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"body":            `<p>Log in to your account and go to your profile icon at the top right corner. Select Settings from the dropdown menu to edit your details.</p>`,
 								"locale":          "en-us",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"sectionConnection": map[string]interface{}{
-									"id":   "115004118448",
-									"name": "I need help with my account",
+									"zendeskId": "115004118448",
+									"name":      "I need help with my account",
 								},
 							},
 						},
@@ -1558,7 +1558,7 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 						pageCount
 						count
 						articles {
-							id
+							zendeskId
 							authorId
 							commentsDisable
 							draft
@@ -1581,12 +1581,12 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 							body
 							locale
 							categoryConnection {
-								id
+								zendeskId
 								name
 								keyName
 							}
 							sectionConnection {
-								id
+								zendeskId
 								name
 							}
 						}
@@ -1603,7 +1603,7 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 						"count":     5,
 						"articles": []interface{}{
 							map[string]interface{}{
-								"id":              "115015959148",
+								"zendeskId":       "115015959148",
 								"authorId":        "24400224208",
 								"commentsDisable": false,
 								"draft":           false,
@@ -1626,13 +1626,13 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 								"body":            "<p>Click on the Forgot Password link on the Login page and enter your registered email address. We’ll send you an email to reset your password. Occasionally emails end up in the junk/spam folder. Take a look there.</p>",
 								"locale":          "en-us",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"sectionConnection": map[string]interface{}{
-									"id":   "115004118448",
-									"name": "I need help with my account",
+									"zendeskId": "115004118448",
+									"name":      "I need help with my account",
 								},
 							},
 						},
@@ -1777,6 +1777,8 @@ func TestHandlersGraphQLQueryArticles(t *testing.T) {
 			if err = json.NewDecoder(resp.Body).Decode(&actual); err != nil {
 				t.Fatalf("[%s] json decoding failed:%v", tt.description, err)
 			}
+			// The cost of the query is reported in the extensions, it is not compared here.
+			delete(actual, "extensions")
 			// Converts integer to the same type.
 			expectData, err := json.Marshal(tt.expectBody)
 			if err != nil {
@@ -1809,7 +1811,7 @@ func TestHandlersGraphQLQueryTopArticles(t *testing.T) {
 				"query": `
 				{
 					topArticles(topN: 3, countryCode: TW, locale: EN_US) {
-						id
+						zendeskId
 						authorId
 						commentsDisable
 						draft
@@ -1832,12 +1834,12 @@ func TestHandlersGraphQLQueryTopArticles(t *testing.T) {
 						body
 						locale
 						categoryConnection {
-							id
+							zendeskId
 							name
 							keyName
 						}
 						sectionConnection {
-							id
+							zendeskId
 							name
 						}
 					}
@@ -1848,7 +1850,7 @@ func TestHandlersGraphQLQueryTopArticles(t *testing.T) {
 				"data": map[string]interface{}{
 					"topArticles": []interface{}{
 						map[string]interface{}{
-							"id":              "115015959188",
+							"zendeskId":       "115015959188",
 							"authorId":        "24400224208",
 							"commentsDisable": false,
 							"draft":           false,
@@ -1871,17 +1873,17 @@ func TestHandlersGraphQLQueryTopArticles(t *testing.T) {
 							"body":            "<p>When there is an error completing your checkout,your cart will be temporarily locked to prevent further changes to your order. To unlock your cart,click ‘Yes,unlock my cart,’ when prompted.</p>",
 							"locale":          "en-us",
 							"categoryConnection": map[string]interface{}{
-								"id":      "115002432448",
-								"name":    "My Account",
-								"keyName": "myAccount",
+								"zendeskId": "115002432448",
+								"name":      "My Account",
+								"keyName":   "myAccount",
 							},
 							"sectionConnection": map[string]interface{}{
-								"id":   "115004118448",
-								"name": "I need help with my account",
+								"zendeskId": "115004118448",
+								"name":      "I need help with my account",
 							},
 						},
 						map[string]interface{}{
-							"id":              "115015959148",
+							"zendeskId":       "115015959148",
 							"authorId":        "24400224208",
 							"commentsDisable": false,
 							"draft":           false,
@@ -1904,17 +1906,17 @@ func TestHandlersGraphQLQueryTopArticles(t *testing.T) {
 							"body":            "<p>Click on the Forgot Password link on the Login page and enter your registered email address. We’ll send you an email to reset your password. Occasionally emails end up in the junk/spam folder. Take a look there.</p>",
 							"locale":          "en-us",
 							"categoryConnection": map[string]interface{}{
-								"id":      "115002432448",
-								"name":    "My Account",
-								"keyName": "myAccount",
+								"zendeskId": "115002432448",
+								"name":      "My Account",
+								"keyName":   "myAccount",
 							},
 							"sectionConnection": map[string]interface{}{
-								"id":   "115004118448",
-								"name": "I need help with my account",
+								"zendeskId": "115004118448",
+								"name":      "I need help with my account",
 							},
 						},
 						map[string]interface{}{
-							"id":              "115015885547",
+							"zendeskId":       "115015885547",
 							"authorId":        "24400224208",
 							"commentsDisable": false,
 							"draft":           false,
@@ -1937,13 +1939,13 @@ func TestHandlersGraphQLQueryTopArticles(t *testing.T) {
 							"body":            `<p>honestbee takes your privacy seriously and complies with all the relevant laws to ensure your details are kept secure. Read our <a href="https://www.honestbee.tw/privacy-policy">Privacy Policy</a> for more information.</p>`,
 							"locale":          "en-us",
 							"categoryConnection": map[string]interface{}{
-								"id":      "115002432448",
-								"name":    "My Account",
-								"keyName": "myAccount",
+								"zendeskId": "115002432448",
+								"name":      "My Account",
+								"keyName":   "myAccount",
 							},
 							"sectionConnection": map[string]interface{}{
-								"id":   "115004118448",
-								"name": "I need help with my account",
+								"zendeskId": "115004118448",
+								"name":      "I need help with my account",
 							},
 						},
 					},
@@ -1956,7 +1958,7 @@ func TestHandlersGraphQLQueryTopArticles(t *testing.T) {
 				"query": `
 				{
 					topArticles(topN: 2, countryCode: TW, locale: EN_US) {
-						id
+						zendeskId
 						authorId
 						commentsDisable
 						draft
@@ -1979,12 +1981,12 @@ func TestHandlersGraphQLQueryTopArticles(t *testing.T) {
 						body
 						locale
 						categoryConnection {
-							id
+							zendeskId
 							name
 							keyName
 						}
 						sectionConnection {
-							id
+							zendeskId
 							name
 						}
 					}
@@ -1995,7 +1997,7 @@ func TestHandlersGraphQLQueryTopArticles(t *testing.T) {
 				"data": map[string]interface{}{
 					"topArticles": []interface{}{
 						map[string]interface{}{
-							"id":              "115015959188",
+							"zendeskId":       "115015959188",
 							"authorId":        "24400224208",
 							"commentsDisable": false,
 							"draft":           false,
@@ -2018,17 +2020,17 @@ func TestHandlersGraphQLQueryTopArticles(t *testing.T) {
 							"body":            "<p>When there is an error completing your checkout,your cart will be temporarily locked to prevent further changes to your order. To unlock your cart,click ‘Yes,unlock my cart,’ when prompted.</p>",
 							"locale":          "en-us",
 							"categoryConnection": map[string]interface{}{
-								"id":      "115002432448",
-								"name":    "My Account",
-								"keyName": "myAccount",
+								"zendeskId": "115002432448",
+								"name":      "My Account",
+								"keyName":   "myAccount",
 							},
 							"sectionConnection": map[string]interface{}{
-								"id":   "115004118448",
-								"name": "I need help with my account",
+								"zendeskId": "115004118448",
+								"name":      "I need help with my account",
 							},
 						},
 						map[string]interface{}{
-							"id":              "115015959148",
+							"zendeskId":       "115015959148",
 							"authorId":        "24400224208",
 							"commentsDisable": false,
 							"draft":           false,
@@ -2051,13 +2053,13 @@ func TestHandlersGraphQLQueryTopArticles(t *testing.T) {
 							"body":            "<p>Click on the Forgot Password link on the Login page and enter your registered email address. We’ll send you an email to reset your password. Occasionally emails end up in the junk/spam folder. Take a look there.</p>",
 							"locale":          "en-us",
 							"categoryConnection": map[string]interface{}{
-								"id":      "115002432448",
-								"name":    "My Account",
-								"keyName": "myAccount",
+								"zendeskId": "115002432448",
+								"name":      "My Account",
+								"keyName":   "myAccount",
 							},
 							"sectionConnection": map[string]interface{}{
-								"id":   "115004118448",
-								"name": "I need help with my account",
+								"zendeskId": "115004118448",
+								"name":      "I need help with my account",
 							},
 						},
 					},
@@ -2070,7 +2072,7 @@ func TestHandlersGraphQLQueryTopArticles(t *testing.T) {
 				"query": `
 				{
 					topArticles(topN: 3, countryCode: TW, locale: ZH_TW) {
-						id
+						zendeskId
 						authorId
 						commentsDisable
 						draft
@@ -2093,12 +2095,12 @@ func TestHandlersGraphQLQueryTopArticles(t *testing.T) {
 						body
 						locale
 						categoryConnection {
-							id
+							zendeskId
 							name
 							keyName
 						}
 						sectionConnection {
-							id
+							zendeskId
 							name
 						}
 					}
@@ -2109,7 +2111,7 @@ func TestHandlersGraphQLQueryTopArticles(t *testing.T) {
 				"data": map[string]interface{}{
 					"topArticles": []interface{}{
 						map[string]interface{}{
-							"id":              "115015959188",
+							"zendeskId":       "115015959188",
 							"authorId":        "24400224208",
 							"commentsDisable": false,
 							"draft":           false,
@@ -2132,17 +2134,17 @@ func TestHandlersGraphQLQueryTopArticles(t *testing.T) {
 							"body":            "<p>當結帳出現錯誤時，您的購物車會暫時被鎖住，以避免您的訂單出現異動。要解鎖您的購物車，請在出現提示時，點選「是的，解鎖我的購物車」。</p>",
 							"locale":          "zh-tw",
 							"categoryConnection": map[string]interface{}{
-								"id":      "115002432448",
-								"name":    "我的帳號",
-								"keyName": "myAccount",
+								"zendeskId": "115002432448",
+								"name":      "我的帳號",
+								"keyName":   "myAccount",
 							},
 							"sectionConnection": map[string]interface{}{
-								"id":   "115004118448",
-								"name": "我需要帳號相關的協助",
+								"zendeskId": "115004118448",
+								"name":      "我需要帳號相關的協助",
 							},
 						},
 						map[string]interface{}{
-							"id":              "115015959148",
+							"zendeskId":       "115015959148",
 							"authorId":        "24400224208",
 							"commentsDisable": false,
 							"draft":           false,
@@ -2165,17 +2167,17 @@ func TestHandlersGraphQLQueryTopArticles(t *testing.T) {
 							"body":            "<p>請在登入頁面點選「忘記密碼」，並輸入您註冊時所使用的電子信箱。我們會寄給您一封信讓您重設密碼。有時候信件會跑到垃圾信件匣，請務必檢查看看。</p>",
 							"locale":          "zh-tw",
 							"categoryConnection": map[string]interface{}{
-								"id":      "115002432448",
-								"name":    "我的帳號",
-								"keyName": "myAccount",
+								"zendeskId": "115002432448",
+								"name":      "我的帳號",
+								"keyName":   "myAccount",
 							},
 							"sectionConnection": map[string]interface{}{
-								"id":   "115004118448",
-								"name": "我需要帳號相關的協助",
+								"zendeskId": "115004118448",
+								"name":      "我需要帳號相關的協助",
 							},
 						},
 						map[string]interface{}{
-							"id":              "115015885547",
+							"zendeskId":       "115015885547",
 							"authorId":        "24400224208",
 							"commentsDisable": false,
 							"draft":           false,
@@ -2198,13 +2200,13 @@ func TestHandlersGraphQLQueryTopArticles(t *testing.T) {
 							"body":            `<p>honestbee 很重視您的隱私，並遵循所有相關法規以確保您的資訊安全。請閱讀我們的<a href="https://www.honestbee.tw/privacy-policy">隱私權政策</a>以了解更多資訊。</p>`,
 							"locale":          "zh-tw",
 							"categoryConnection": map[string]interface{}{
-								"id":      "115002432448",
-								"name":    "我的帳號",
-								"keyName": "myAccount",
+								"zendeskId": "115002432448",
+								"name":      "我的帳號",
+								"keyName":   "myAccount",
 							},
 							"sectionConnection": map[string]interface{}{
-								"id":   "115004118448",
-								"name": "我需要帳號相關的協助",
+								"zendeskId": "115004118448",
+								"name":      "我需要帳號相關的協助",
 							},
 						},
 					},
@@ -2217,7 +2219,7 @@ func TestHandlersGraphQLQueryTopArticles(t *testing.T) {
 				"query": `
 				{
 					topArticles(topN: -1, countryCode: TW, locale: EN_US) {
-						id
+						zendeskId
 						authorId
 						commentsDisable
 						draft
@@ -2240,12 +2242,12 @@ func TestHandlersGraphQLQueryTopArticles(t *testing.T) {
 						body
 						locale
 						categoryConnection {
-							id
+							zendeskId
 							name
 							keyName
 						}
 						sectionConnection {
-							id
+							zendeskId
 							name
 						}
 					}
@@ -2272,7 +2274,7 @@ func TestHandlersGraphQLQueryTopArticles(t *testing.T) {
 				"query": `
 				{
 					topArticles(topN: 0.0, countryCode: TW, locale: EN_US) {
-						id
+						zendeskId
 						authorId
 						commentsDisable
 						draft
@@ -2295,12 +2297,12 @@ func TestHandlersGraphQLQueryTopArticles(t *testing.T) {
 						body
 						locale
 						categoryConnection {
-							id
+							zendeskId
 							name
 							keyName
 						}
 						sectionConnection {
-							id
+							zendeskId
 							name
 						}
 					}
@@ -2327,7 +2329,7 @@ func TestHandlersGraphQLQueryTopArticles(t *testing.T) {
 				"query": `
 				{
 					topArticles(topN: 3, countryCode: not_exist_country_code) {
-						id
+						zendeskId
 						authorId
 						commentsDisable
 						draft
@@ -2350,12 +2352,12 @@ func TestHandlersGraphQLQueryTopArticles(t *testing.T) {
 						body
 						locale
 						categoryConnection {
-							id
+							zendeskId
 							name
 							keyName
 						}
 						sectionConnection {
-							id
+							zendeskId
 							name
 						}
 					}
@@ -2382,7 +2384,7 @@ func TestHandlersGraphQLQueryTopArticles(t *testing.T) {
 				"query": `
 				{
 					topArticles(topN: 3, locale: not_exist_locale) {
-						id
+						zendeskId
 						authorId
 						commentsDisable
 						draft
@@ -2405,12 +2407,12 @@ func TestHandlersGraphQLQueryTopArticles(t *testing.T) {
 						body
 						locale
 						categoryConnection {
-							id
+							zendeskId
 							name
 							keyName
 						}
 						sectionConnection {
-							id
+							zendeskId
 							name
 						}
 					}
@@ -2456,6 +2458,8 @@ func TestHandlersGraphQLQueryTopArticles(t *testing.T) {
 			if err = json.NewDecoder(resp.Body).Decode(&actual); err != nil {
 				t.Fatalf("[%s] json decoding failed:%v", tt.description, err)
 			}
+			// The cost of the query is reported in the extensions, it is not compared here.
+			delete(actual, "extensions")
 			// Converts integer to the same type.
 			expectData, err := json.Marshal(tt.expectBody)
 			if err != nil {
@@ -2488,7 +2492,7 @@ func TestHandlersGraphQLQueryArticle(t *testing.T) {
 				"query": `
 				{
 					oneArticle(articleId: "115015959188", countryCode: TW, locale: EN_US) {
-						id
+						zendeskId
 						authorId
 						commentsDisable
 						draft
@@ -2511,12 +2515,12 @@ func TestHandlersGraphQLQueryArticle(t *testing.T) {
 						body
 						locale
 						categoryConnection {
-							id
+							zendeskId
 							name
 							keyName
 						}
 						sectionConnection {
-							id
+							zendeskId
 							name
 						}
 					}
@@ -2526,7 +2530,7 @@ func TestHandlersGraphQLQueryArticle(t *testing.T) {
 			expectBody: map[string]interface{}{
 				"data": map[string]interface{}{
 					"oneArticle": map[string]interface{}{
-						"id":              "115015959188",
+						"zendeskId":       "115015959188",
 						"authorId":        "24400224208",
 						"commentsDisable": false,
 						"draft":           false,
@@ -2549,13 +2553,13 @@ func TestHandlersGraphQLQueryArticle(t *testing.T) {
 						"body":            "<p>When there is an error completing your checkout,your cart will be temporarily locked to prevent further changes to your order. To unlock your cart,click ‘Yes,unlock my cart,’ when prompted.</p>",
 						"locale":          "en-us",
 						"categoryConnection": map[string]interface{}{
-							"id":      "115002432448",
-							"name":    "My Account",
-							"keyName": "myAccount",
+							"zendeskId": "115002432448",
+							"name":      "My Account",
+							"keyName":   "myAccount",
 						},
 						"sectionConnection": map[string]interface{}{
-							"id":   "115004118448",
-							"name": "I need help with my account",
+							"zendeskId": "115004118448",
+							"name":      "I need help with my account",
 						},
 					},
 				},
@@ -2567,7 +2571,7 @@ func TestHandlersGraphQLQueryArticle(t *testing.T) {
 				"query": `
 				{
 					oneArticle(articleId: "115015959188", countryCode: TW, locale: ZH_TW) {
-						id
+						zendeskId
 						authorId
 						commentsDisable
 						draft
@@ -2590,12 +2594,12 @@ func TestHandlersGraphQLQueryArticle(t *testing.T) {
 						body
 						locale
 						categoryConnection {
-							id
+							zendeskId
 							name
 							keyName
 						}
 						sectionConnection {
-							id
+							zendeskId
 							name
 						}
 					}
@@ -2605,7 +2609,7 @@ func TestHandlersGraphQLQueryArticle(t *testing.T) {
 			expectBody: map[string]interface{}{
 				"data": map[string]interface{}{
 					"oneArticle": map[string]interface{}{
-						"id":              "115015959188",
+						"zendeskId":       "115015959188",
 						"authorId":        "24400224208",
 						"commentsDisable": false,
 						"draft":           false,
@@ -2628,13 +2632,13 @@ func TestHandlersGraphQLQueryArticle(t *testing.T) {
 						"body":            "<p>當結帳出現錯誤時，您的購物車會暫時被鎖住，以避免您的訂單出現異動。要解鎖您的購物車，請在出現提示時，點選「是的，解鎖我的購物車」。</p>",
 						"locale":          "zh-tw",
 						"categoryConnection": map[string]interface{}{
-							"id":      "115002432448",
-							"name":    "我的帳號",
-							"keyName": "myAccount",
+							"zendeskId": "115002432448",
+							"name":      "我的帳號",
+							"keyName":   "myAccount",
 						},
 						"sectionConnection": map[string]interface{}{
-							"id":   "115004118448",
-							"name": "我需要帳號相關的協助",
+							"zendeskId": "115004118448",
+							"name":      "我需要帳號相關的協助",
 						},
 					},
 				},
@@ -2646,7 +2650,7 @@ func TestHandlersGraphQLQueryArticle(t *testing.T) {
 				"query": `
 				{
 					oneArticle(articleId: "334567833", countryCode: TW, locale: ZH_TW) {
-						id
+						zendeskId
 						authorId
 						commentsDisable
 						draft
@@ -2669,12 +2673,12 @@ func TestHandlersGraphQLQueryArticle(t *testing.T) {
 						body
 						locale
 						categoryConnection {
-							id
+							zendeskId
 							name
 							keyName
 						}
 						sectionConnection {
-							id
+							zendeskId
 							name
 						}
 					}
@@ -2701,7 +2705,7 @@ func TestHandlersGraphQLQueryArticle(t *testing.T) {
 				"query": `
 				{
 					oneArticle(articleId: "115015959188", countryCode: not_exist_country_code) {
-						id
+						zendeskId
 						authorId
 						commentsDisable
 						draft
@@ -2724,12 +2728,12 @@ func TestHandlersGraphQLQueryArticle(t *testing.T) {
 						body
 						locale
 						categoryConnection {
-							id
+							zendeskId
 							name
 							keyName
 						}
 						sectionConnection {
-							id
+							zendeskId
 							name
 						}
 					}
@@ -2756,7 +2760,7 @@ func TestHandlersGraphQLQueryArticle(t *testing.T) {
 				"query": `
 				{
 					oneArticle(articleId: "115015959188", locale: not_exist_locale) {
-						id
+						zendeskId
 						authorId
 						commentsDisable
 						draft
@@ -2779,12 +2783,12 @@ func TestHandlersGraphQLQueryArticle(t *testing.T) {
 						body
 						locale
 						categoryConnection {
-						  id
+						  zendeskId
 						  name
 						  keyName
 						}
 						sectionConnection {
-						  id
+						  zendeskId
 						  name
 						}
 					}
@@ -2830,6 +2834,8 @@ func TestHandlersGraphQLQueryArticle(t *testing.T) {
 			if err = json.NewDecoder(resp.Body).Decode(&actual); err != nil {
 				t.Fatalf("[%s] json decoding failed:%v", tt.description, err)
 			}
+			// The cost of the query is reported in the extensions, it is not compared here.
+			delete(actual, "extensions")
 			// Converts integer to the same type.
 			expectData, err := json.Marshal(tt.expectBody)
 			if err != nil {
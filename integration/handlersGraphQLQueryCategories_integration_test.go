@@ -33,7 +33,7 @@ func TestHandlersGraphQLQueryCategories(t *testing.T) {
 						pageCount
 						count
 						categories {
-							id
+							zendeskId
 							position
 							createdAt
 							updatedAt
@@ -86,7 +86,7 @@ func TestHandlersGraphQLQueryCategories(t *testing.T) {
 						pageCount
 						count
 						categories {
-							id
+							zendeskId
 							position
 							createdAt
 							updatedAt
@@ -125,7 +125,7 @@ func TestHandlersGraphQLQueryCategories(t *testing.T) {
 						"count":     1,
 						"categories": []interface{}{
 							map[string]interface{}{
-								"id":           "115002432448",
+								"zendeskId":    "115002432448",
 								"position":     2,
 								"createdAt":    time.Date(2017, 12, 19, 6, 21, 45, 0, time.UTC),
 								"updatedAt":    time.Date(2018, 3, 6, 12, 39, 30, 0, time.UTC),
@@ -167,7 +167,7 @@ func TestHandlersGraphQLQueryCategories(t *testing.T) {
 						pageCount
 						count
 						categories {
-					    	id
+					    	zendeskId
 					    	position
 					    	createdAt
 					    	updatedAt
@@ -206,7 +206,7 @@ func TestHandlersGraphQLQueryCategories(t *testing.T) {
 						"count":     1,
 						"categories": []interface{}{
 							map[string]interface{}{
-								"id":           "115002432448",
+								"zendeskId":    "115002432448",
 								"position":     2,
 								"createdAt":    time.Date(2017, 12, 19, 6, 21, 45, 0, time.UTC),
 								"updatedAt":    time.Date(2018, 3, 6, 12, 39, 30, 0, time.UTC),
@@ -248,7 +248,7 @@ func TestHandlersGraphQLQueryCategories(t *testing.T) {
 						pageCount
 						count
 						categories {
-					    	id
+					    	zendeskId
 					    	position
 					    	createdAt
 					    	updatedAt
@@ -287,7 +287,7 @@ func TestHandlersGraphQLQueryCategories(t *testing.T) {
 						"count":     1,
 						"categories": []interface{}{
 							map[string]interface{}{
-								"id":           "115002432448",
+								"zendeskId":    "115002432448",
 								"position":     2,
 								"createdAt":    time.Date(2017, 12, 19, 6, 21, 45, 0, time.UTC),
 								"updatedAt":    time.Date(2018, 3, 6, 12, 39, 30, 0, time.UTC),
@@ -329,7 +329,7 @@ func TestHandlersGraphQLQueryCategories(t *testing.T) {
 						pageCount
 						count
 						categories {
-							id
+							zendeskId
 						    position
 						    createdAt
 						    updatedAt
@@ -368,7 +368,7 @@ func TestHandlersGraphQLQueryCategories(t *testing.T) {
 						"count":     1,
 						"categories": []interface{}{
 							map[string]interface{}{
-								"id":           "115002432448",
+								"zendeskId":    "115002432448",
 								"position":     2,
 								"createdAt":    time.Date(2017, 12, 19, 6, 21, 45, 0, time.UTC),
 								"updatedAt":    time.Date(2018, 3, 6, 12, 39, 30, 0, time.UTC),
@@ -410,7 +410,7 @@ func TestHandlersGraphQLQueryCategories(t *testing.T) {
 						pageCount
 						count
 						categories {
-							id
+							zendeskId
 							position
 						    createdAt
 						    updatedAt
@@ -449,7 +449,7 @@ func TestHandlersGraphQLQueryCategories(t *testing.T) {
 						"count":     1,
 						"categories": []interface{}{
 							map[string]interface{}{
-								"id":           "115002432448",
+								"zendeskId":    "115002432448",
 								"position":     2,
 								"createdAt":    time.Date(2017, 12, 19, 6, 21, 45, 0, time.UTC),
 								"updatedAt":    time.Date(2018, 3, 6, 12, 39, 30, 0, time.UTC),
@@ -617,6 +617,8 @@ func TestHandlersGraphQLQueryCategories(t *testing.T) {
 			if err = json.NewDecoder(resp.Body).Decode(&actual); err != nil {
 				t.Fatalf("[%s] json decoding failed:%v", tt.description, err)
 			}
+			// The cost of the query is reported in the extensions, it is not compared here.
+			delete(actual, "extensions")
 			// Converts integer to the same type.
 			expectData, err := json.Marshal(tt.expectBody)
 			if err != nil {
@@ -649,7 +651,7 @@ func TestHandlersGraphQLQueryCategory(t *testing.T) {
 				"query": `
 				{
 					oneCategory(categoryIdOrKeyname: "115002432448", countryCode: TW) {
-						id
+						zendeskId
 						position
 						createdAt
 						updatedAt
@@ -681,7 +683,7 @@ func TestHandlersGraphQLQueryCategory(t *testing.T) {
 			expectBody: map[string]interface{}{
 				"data": map[string]interface{}{
 					"oneCategory": map[string]interface{}{
-						"id":           "115002432448",
+						"zendeskId":    "115002432448",
 						"position":     2,
 						"createdAt":    time.Date(2017, 12, 19, 6, 21, 45, 0, time.UTC),
 						"updatedAt":    time.Date(2018, 3, 6, 12, 39, 30, 0, time.UTC),
@@ -716,7 +718,7 @@ func TestHandlersGraphQLQueryCategory(t *testing.T) {
 				"query": `
 				{
 					oneCategory(categoryIdOrKeyname: "myAccount", countryCode: TW) {
-						id
+						zendeskId
 						position
 						createdAt
 						updatedAt
@@ -748,7 +750,7 @@ func TestHandlersGraphQLQueryCategory(t *testing.T) {
 			expectBody: map[string]interface{}{
 				"data": map[string]interface{}{
 					"oneCategory": map[string]interface{}{
-						"id":           "115002432448",
+						"zendeskId":    "115002432448",
 						"position":     2,
 						"createdAt":    time.Date(2017, 12, 19, 6, 21, 45, 0, time.UTC),
 						"updatedAt":    time.Date(2018, 3, 6, 12, 39, 30, 0, time.UTC),
@@ -783,7 +785,7 @@ func TestHandlersGraphQLQueryCategory(t *testing.T) {
 				"query": `
 				{
 					oneCategory(categoryIdOrKeyname: "115002432448") {
-						id
+						zendeskId
 						position
 						createdAt
 						updatedAt
@@ -832,7 +834,7 @@ func TestHandlersGraphQLQueryCategory(t *testing.T) {
 				"query": `
 				{
 					oneCategory(categoryIdOrKeyname: "myAccount", countryCode: not_exist_country_code) {
-						id
+						zendeskId
 						position
 						createdAt
 						updatedAt
@@ -881,7 +883,7 @@ func TestHandlersGraphQLQueryCategory(t *testing.T) {
 				"query": `
 				{
 					oneCategory(categoryIdOrKeyname: "myAccount", locale: not_exist_locale) {
-						id
+						zendeskId
 						position
 						createdAt
 						updatedAt
@@ -949,6 +951,8 @@ func TestHandlersGraphQLQueryCategory(t *testing.T) {
 			if err = json.NewDecoder(resp.Body).Decode(&actual); err != nil {
 				t.Fatalf("[%s] json decoding failed:%v", tt.description, err)
 			}
+			// The cost of the query is reported in the extensions, it is not compared here.
+			delete(actual, "extensions")
 			// Converts integer to the same type.
 			expectData, err := json.Marshal(tt.expectBody)
 			if err != nil {
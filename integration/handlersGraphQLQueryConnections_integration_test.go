@@ -0,0 +1,111 @@
+// +build integration
+
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/go-test/deep"
+)
+
+type articlesConnection struct {
+	Data struct {
+		AllArticles struct {
+			Count int `json:"count"`
+			Edges []struct {
+				Cursor string `json:"cursor"`
+				Node   struct {
+					ID string `json:"id"`
+				} `json:"node"`
+			} `json:"edges"`
+			PageInfo struct {
+				HasNextPage     bool    `json:"hasNextPage"`
+				HasPreviousPage bool    `json:"hasPreviousPage"`
+				StartCursor     *string `json:"startCursor"`
+				EndCursor       *string `json:"endCursor"`
+			} `json:"pageInfo"`
+		} `json:"allArticles"`
+	} `json:"data"`
+	Errors []interface{} `json:"errors"`
+}
+
+func TestHandlersGraphQLQueryArticlesConnection(t *testing.T) {
+	ts := newTserver()
+	defer ts.closeAll()
+
+	query := func(args string) *articlesConnection {
+		b, err := json.Marshal(map[string]interface{}{
+			"query": fmt.Sprintf(`
+			{
+				allArticles(countryCode: TW, locale: EN_US, sortBy: CREATED_AT, sortOrder: DESC%s) {
+					count
+					edges {
+						cursor
+						node {
+							id
+						}
+					}
+					pageInfo {
+						hasNextPage
+						hasPreviousPage
+						startCursor
+						endCursor
+					}
+				}
+			}
+			`, args),
+		})
+		if err != nil {
+			t.Fatalf("json marshal failed:%v", err)
+		}
+		resp, err := ts.Client().Post(ts.URL+"/graphql", "application/json", ioutil.NopCloser(bytes.NewReader(b)))
+		if err != nil {
+			t.Fatalf("http client post failed:%v", err)
+		}
+		defer resp.Body.Close()
+
+		ret := new(articlesConnection)
+		if err = json.NewDecoder(resp.Body).Decode(ret); err != nil {
+			t.Fatalf("json decoding failed:%v", err)
+		}
+		if len(ret.Errors) > 0 {
+			t.Fatalf("query %s failed:%v", args, ret.Errors)
+		}
+		return ret
+	}
+
+	// Collects all the articles in one page.
+	all := query(", first: 100")
+	expect := make([]string, 0)
+	for _, edge := range all.Data.AllArticles.Edges {
+		expect = append(expect, edge.Node.ID)
+	}
+	if len(expect) != all.Data.AllArticles.Count || all.Data.AllArticles.PageInfo.HasNextPage {
+		t.Fatalf("expect all %d articles in one page, actual:%d", all.Data.AllArticles.Count, len(expect))
+	}
+
+	// Walks through the articles page by page.
+	actual := make([]string, 0)
+	after := ""
+	for page := 0; page <= len(expect); page++ {
+		ret := query(fmt.Sprintf(`, first: 2, after: %q`, after))
+		for _, edge := range ret.Data.AllArticles.Edges {
+			actual = append(actual, edge.Node.ID)
+		}
+		if page > 0 && !ret.Data.AllArticles.PageInfo.HasPreviousPage {
+			t.Errorf("page %d expect has previous page", page)
+		}
+		if !ret.Data.AllArticles.PageInfo.HasNextPage {
+			break
+		}
+		after = *ret.Data.AllArticles.PageInfo.EndCursor
+	}
+
+	if diff := deep.Equal(expect, actual); diff != nil {
+		t.Errorf("%v", diff)
+	}
+}
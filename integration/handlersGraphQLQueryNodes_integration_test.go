@@ -0,0 +1,169 @@
+// +build integration
+
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/go-test/deep"
+
+	"github.com/honestbee/Zen/inout"
+)
+
+func TestHandlersGraphQLQueryNodes(t *testing.T) {
+	ts := newTserver()
+	defer ts.closeAll()
+
+	categoryID := inout.EncodeNodeID(inout.NodeTypeCategory, "tw", 115002432448)
+	sectionID := inout.EncodeNodeID(inout.NodeTypeSection, "tw", 115004118448)
+	articleID := inout.EncodeNodeID(inout.NodeTypeArticle, "tw", 115015959188)
+	formID := inout.EncodeNodeID(inout.NodeTypeTicketForm, "", 825847)
+	fieldID := inout.EncodeNodeID(inout.NodeTypeTicketField, "", 24681488)
+	notFoundID := inout.EncodeNodeID(inout.NodeTypeArticle, "tw", 91293129847)
+
+	testCases := []struct {
+		description string
+		body        map[string]interface{}
+		expectBody  map[string]interface{}
+	}{
+		{
+			description: "testing node refetch article case",
+			body: map[string]interface{}{
+				"query": fmt.Sprintf(`
+				{
+					node(id: "%s") {
+						__typename
+						id
+						... on Article {
+							zendeskId
+							countryCode
+						}
+					}
+				}
+				`, articleID),
+			},
+			expectBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"node": map[string]interface{}{
+						"__typename":  "Article",
+						"id":          articleID,
+						"zendeskId":   "115015959188",
+						"countryCode": "tw",
+					},
+				},
+			},
+		},
+		{
+			description: "testing nodes of every type case",
+			body: map[string]interface{}{
+				"query": `
+				query nodes($ids: [ID!]!) {
+					nodes(ids: $ids) {
+						__typename
+						id
+						... on Category {
+							zendeskId
+						}
+						... on Section {
+							zendeskId
+						}
+						... on Article {
+							zendeskId
+						}
+						... on TicketForm {
+							zendeskId
+						}
+						... on TicketField {
+							zendeskId
+						}
+					}
+				}
+				`,
+				"variables": map[string]interface{}{
+					"ids": []interface{}{categoryID, sectionID, articleID, formID, fieldID, notFoundID},
+				},
+			},
+			expectBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"nodes": []interface{}{
+						map[string]interface{}{"__typename": "Category", "id": categoryID, "zendeskId": "115002432448"},
+						map[string]interface{}{"__typename": "Section", "id": sectionID, "zendeskId": "115004118448"},
+						map[string]interface{}{"__typename": "Article", "id": articleID, "zendeskId": "115015959188"},
+						map[string]interface{}{"__typename": "TicketForm", "id": formID, "zendeskId": "825847"},
+						map[string]interface{}{"__typename": "TicketField", "id": fieldID, "zendeskId": "24681488"},
+						nil,
+					},
+				},
+			},
+		},
+		{
+			description: "testing one article by global id case",
+			body: map[string]interface{}{
+				"query": fmt.Sprintf(`
+				{
+					oneArticle(articleId: "%s") {
+						id
+						zendeskId
+						countryCode
+					}
+				}
+				`, articleID),
+			},
+			expectBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"oneArticle": map[string]interface{}{
+						"id":          articleID,
+						"zendeskId":   "115015959188",
+						"countryCode": "tw",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			// Send requests.
+			b, err := json.Marshal(tt.body)
+			if err != nil {
+				t.Fatalf("[%s] json marshal failed:%v", tt.description, err)
+			}
+			resp, err := ts.Client().Post(ts.URL+"/graphql", "application/json", ioutil.NopCloser(bytes.NewReader(b)))
+			if err != nil {
+				t.Fatalf("[%s] http client get failed:%v", tt.description, err)
+			}
+			defer resp.Body.Close()
+
+			// Compare HTTP status code.
+			if http.StatusOK != resp.StatusCode {
+				t.Errorf("[%s] http status expect:%v != actual:%v", tt.description, http.StatusOK, resp.StatusCode)
+			}
+
+			// Compare HTTP body.
+			actual := make(map[string]interface{})
+			if err = json.NewDecoder(resp.Body).Decode(&actual); err != nil {
+				t.Fatalf("[%s] json decoding failed:%v", tt.description, err)
+			}
+			// The cost of the query is reported in the extensions, it is not compared here.
+			delete(actual, "extensions")
+			// Converts the ids to the same type.
+			expectData, err := json.Marshal(tt.expectBody)
+			if err != nil {
+				t.Fatalf("[%s] json marshal failed:%v", tt.description, err)
+			}
+			expect := make(map[string]interface{})
+			if err = json.Unmarshal(expectData, &expect); err != nil {
+				t.Fatalf("[%s] json unmarshal failed:%v", tt.description, err)
+			}
+			// Compares and prints difference.
+			if diff := deep.Equal(expect, actual); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+}
@@ -33,7 +33,7 @@ func TestHandlersGraphQLQuerySections(t *testing.T) {
 						pageCount
 						count
 						sections {
-							id
+							zendeskId
 							position
 							createdAt
 							updatedAt
@@ -46,7 +46,7 @@ func TestHandlersGraphQLQuerySections(t *testing.T) {
 							description
 							locale
 							categoryConnection {
-								id
+								zendeskId
 								name
 								keyName
 							}
@@ -84,7 +84,7 @@ func TestHandlersGraphQLQuerySections(t *testing.T) {
 						pageCount
 						count
 						sections {
-							id
+							zendeskId
 							position
 							createdAt
 							updatedAt
@@ -97,7 +97,7 @@ func TestHandlersGraphQLQuerySections(t *testing.T) {
 							description
 							locale
 							categoryConnection {
-								id
+								zendeskId
 								name
 								keyName
 							}
@@ -121,7 +121,7 @@ func TestHandlersGraphQLQuerySections(t *testing.T) {
 						"count":     1,
 						"sections": []interface{}{
 							map[string]interface{}{
-								"id":           "115004118448",
+								"zendeskId":    "115004118448",
 								"position":     0,
 								"createdAt":    time.Date(2017, 12, 19, 6, 23, 48, 0, time.UTC),
 								"updatedAt":    time.Date(2018, 3, 6, 12, 39, 30, 0, time.UTC),
@@ -134,9 +134,9 @@ func TestHandlersGraphQLQuerySections(t *testing.T) {
 								"description":  "",
 								"locale":       "en-us",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"articlesConnection": map[string]interface{}{
 									"page":      1,
@@ -161,7 +161,7 @@ func TestHandlersGraphQLQuerySections(t *testing.T) {
 						pageCount
 						count
 						sections {
-							id
+							zendeskId
 							position
 							createdAt
 							updatedAt
@@ -174,7 +174,7 @@ func TestHandlersGraphQLQuerySections(t *testing.T) {
 							description
 							locale
 							categoryConnection {
-								id
+								zendeskId
 								name
 								keyName
 							}
@@ -198,7 +198,7 @@ func TestHandlersGraphQLQuerySections(t *testing.T) {
 						"count":     1,
 						"sections": []interface{}{
 							map[string]interface{}{
-								"id":           "115004118448",
+								"zendeskId":    "115004118448",
 								"position":     0,
 								"createdAt":    time.Date(2017, 12, 19, 6, 23, 48, 0, time.UTC),
 								"updatedAt":    time.Date(2018, 3, 6, 12, 39, 30, 0, time.UTC),
@@ -211,9 +211,9 @@ func TestHandlersGraphQLQuerySections(t *testing.T) {
 								"description":  "",
 								"locale":       "zh-tw",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"articlesConnection": map[string]interface{}{
 									"page":      1,
@@ -238,7 +238,7 @@ func TestHandlersGraphQLQuerySections(t *testing.T) {
 						pageCount
 						count
 						sections {
-							id
+							zendeskId
 							position
 							createdAt
 							updatedAt
@@ -251,7 +251,7 @@ func TestHandlersGraphQLQuerySections(t *testing.T) {
 							description
 							locale
 							categoryConnection {
-								id
+								zendeskId
 								name
 								keyName
 							}
@@ -275,7 +275,7 @@ func TestHandlersGraphQLQuerySections(t *testing.T) {
 						"count":     1,
 						"sections": []interface{}{
 							map[string]interface{}{
-								"id":           "115004118448",
+								"zendeskId":    "115004118448",
 								"position":     0,
 								"createdAt":    time.Date(2017, 12, 19, 6, 23, 48, 0, time.UTC),
 								"updatedAt":    time.Date(2018, 3, 6, 12, 39, 30, 0, time.UTC),
@@ -288,9 +288,9 @@ func TestHandlersGraphQLQuerySections(t *testing.T) {
 								"description":  "",
 								"locale":       "en-us",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"articlesConnection": map[string]interface{}{
 									"page":      1,
@@ -315,7 +315,7 @@ func TestHandlersGraphQLQuerySections(t *testing.T) {
 						pageCount
 						count
 						sections {
-							id
+							zendeskId
 							position
 							createdAt
 							updatedAt
@@ -328,7 +328,7 @@ func TestHandlersGraphQLQuerySections(t *testing.T) {
 							description
 							locale
 							categoryConnection {
-								id
+								zendeskId
 								name
 								keyName
 							}
@@ -352,7 +352,7 @@ func TestHandlersGraphQLQuerySections(t *testing.T) {
 						"count":     1,
 						"sections": []interface{}{
 							map[string]interface{}{
-								"id":           "115004118448",
+								"zendeskId":    "115004118448",
 								"position":     0,
 								"createdAt":    time.Date(2017, 12, 19, 6, 23, 48, 0, time.UTC),
 								"updatedAt":    time.Date(2018, 3, 6, 12, 39, 30, 0, time.UTC),
@@ -365,9 +365,9 @@ func TestHandlersGraphQLQuerySections(t *testing.T) {
 								"description":  "",
 								"locale":       "en-us",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"articlesConnection": map[string]interface{}{
 									"page":      1,
@@ -392,7 +392,7 @@ func TestHandlersGraphQLQuerySections(t *testing.T) {
 						pageCount
 						count
 						sections {
-							id
+							zendeskId
 							position
 							createdAt
 							updatedAt
@@ -405,7 +405,7 @@ func TestHandlersGraphQLQuerySections(t *testing.T) {
 							description
 							locale
 							categoryConnection {
-								id
+								zendeskId
 								name
 								keyName
 							}
@@ -429,7 +429,7 @@ func TestHandlersGraphQLQuerySections(t *testing.T) {
 						"count":     1,
 						"sections": []interface{}{
 							map[string]interface{}{
-								"id":           "115004118448",
+								"zendeskId":    "115004118448",
 								"position":     0,
 								"createdAt":    time.Date(2017, 12, 19, 6, 23, 48, 0, time.UTC),
 								"updatedAt":    time.Date(2018, 3, 6, 12, 39, 30, 0, time.UTC),
@@ -442,9 +442,9 @@ func TestHandlersGraphQLQuerySections(t *testing.T) {
 								"description":  "",
 								"locale":       "en-us",
 								"categoryConnection": map[string]interface{}{
-									"id":      "115002432448",
-									"name":    "My Account",
-									"keyName": "myAccount",
+									"zendeskId": "115002432448",
+									"name":      "My Account",
+									"keyName":   "myAccount",
 								},
 								"articlesConnection": map[string]interface{}{
 									"page":      1,
@@ -595,6 +595,8 @@ func TestHandlersGraphQLQuerySections(t *testing.T) {
 			if err = json.NewDecoder(resp.Body).Decode(&actual); err != nil {
 				t.Fatalf("[%s] json decoding failed:%v", tt.description, err)
 			}
+			// The cost of the query is reported in the extensions, it is not compared here.
+			delete(actual, "extensions")
 			// Converts integer to the same type.
 			expectData, err := json.Marshal(tt.expectBody)
 			if err != nil {
@@ -627,7 +629,7 @@ func TestHandlersGraphQLQuerySection(t *testing.T) {
 				"query": `
 				{
 					oneSection(sectionId: "115004118448", countryCode: TW) {
-						id
+						zendeskId
 						position
 						createdAt
 						updatedAt
@@ -640,7 +642,7 @@ func TestHandlersGraphQLQuerySection(t *testing.T) {
 						description
 						locale
 						categoryConnection {
-							id
+							zendeskId
 							name
 							keyName
 						}
@@ -657,7 +659,7 @@ func TestHandlersGraphQLQuerySection(t *testing.T) {
 			expectBody: map[string]interface{}{
 				"data": map[string]interface{}{
 					"oneSection": map[string]interface{}{
-						"id":           "115004118448",
+						"zendeskId":    "115004118448",
 						"position":     0,
 						"createdAt":    time.Date(2017, 12, 19, 6, 23, 48, 0, time.UTC),
 						"updatedAt":    time.Date(2018, 3, 6, 12, 39, 30, 0, time.UTC),
@@ -670,9 +672,9 @@ func TestHandlersGraphQLQuerySection(t *testing.T) {
 						"description":  "",
 						"locale":       "en-us",
 						"categoryConnection": map[string]interface{}{
-							"id":      "115002432448",
-							"name":    "My Account",
-							"keyName": "myAccount",
+							"zendeskId": "115002432448",
+							"name":      "My Account",
+							"keyName":   "myAccount",
 						},
 						"articlesConnection": map[string]interface{}{
 							"page":      1,
@@ -690,7 +692,7 @@ func TestHandlersGraphQLQuerySection(t *testing.T) {
 				"query": `
 				{
 					oneSection(sectionId: "115004118448") {
-						id
+						zendeskId
 						position
 						createdAt
 						updatedAt
@@ -703,7 +705,7 @@ func TestHandlersGraphQLQuerySection(t *testing.T) {
 						description
 						locale
 						categoryConnection {
-							id
+							zendeskId
 							name
 							keyName
 						}
@@ -737,7 +739,7 @@ func TestHandlersGraphQLQuerySection(t *testing.T) {
 				"query": `
 				{
 					oneSection(sectionId: "sectionId", countryCode: not_exist_country_code) {
-						id
+						zendeskId
 						position
 						createdAt
 						updatedAt
@@ -750,7 +752,7 @@ func TestHandlersGraphQLQuerySection(t *testing.T) {
 						description
 						locale
 						categoryConnection {
-							id
+							zendeskId
 							name
 							keyName
 						}
@@ -784,7 +786,7 @@ func TestHandlersGraphQLQuerySection(t *testing.T) {
 				"query": `
 				{
 					oneSection(sectionId: "sectionId", locale: not_exist_locale) {
-						id
+						zendeskId
 						position
 						createdAt
 						updatedAt
@@ -797,7 +799,7 @@ func TestHandlersGraphQLQuerySection(t *testing.T) {
 						description
 						locale
 						categoryConnection {
-							id
+							zendeskId
 							name
 							keyName
 						}
@@ -850,6 +852,8 @@ func TestHandlersGraphQLQuerySection(t *testing.T) {
 			if err = json.NewDecoder(resp.Body).Decode(&actual); err != nil {
 				t.Fatalf("[%s] json decoding failed:%v", tt.description, err)
 			}
+			// The cost of the query is reported in the extensions, it is not compared here.
+			delete(actual, "extensions")
 			// Converts integer to the same type.
 			expectData, err := json.Marshal(tt.expectBody)
 			if err != nil {
@@ -28,7 +28,7 @@ func TestHandlersGraphQLQueryTicketForm(t *testing.T) {
 				"query": `
 				{
 					oneTicketForm(formId: "825847") {
-						id
+						zendeskId
 						url
 						name
 						rawName
@@ -42,7 +42,7 @@ func TestHandlersGraphQLQueryTicketForm(t *testing.T) {
 						createdAt
 						updatedAt
 						ticketFieldsConnection {
-							id
+							zendeskId
 							url
 							type
 							title
@@ -81,7 +81,7 @@ func TestHandlersGraphQLQueryTicketForm(t *testing.T) {
 			expectBody: map[string]interface{}{
 				"data": map[string]interface{}{
 					"oneTicketForm": map[string]interface{}{
-						"id":                 "825847",
+						"zendeskId":          "825847",
 						"url":                "https://honestbeehelp-tw.zendesk.com/api/v2/ticket_forms/825847.json",
 						"name":               "shin - wrong/defect item",
 						"rawName":            "shin - wrong/defect item",
@@ -96,7 +96,7 @@ func TestHandlersGraphQLQueryTicketForm(t *testing.T) {
 						"updatedAt":          time.Date(2018, 7, 13, 7, 40, 9, 0, time.UTC),
 						"ticketFieldsConnection": []map[string]interface{}{
 							map[string]interface{}{
-								"id":                  "24681488",
+								"zendeskId":           "24681488",
 								"url":                 "https://honestbee-ph.zendesk.com/api/v2/ticket_fields/24681488.json",
 								"type":                "subject",
 								"title":               "Subject",
@@ -121,7 +121,7 @@ func TestHandlersGraphQLQueryTicketForm(t *testing.T) {
 								"systemFieldOptions":  []map[string]interface{}{},
 							},
 							map[string]interface{}{
-								"id":                  "81469808",
+								"zendeskId":           "81469808",
 								"url":                 "https://honestbee-ph.zendesk.com/api/v2/ticket_fields/81469808.json",
 								"type":                "text",
 								"title":               "Order Number",
@@ -146,7 +146,7 @@ func TestHandlersGraphQLQueryTicketForm(t *testing.T) {
 								"systemFieldOptions":  []map[string]interface{}{},
 							},
 							map[string]interface{}{
-								"id":                  "81421968",
+								"zendeskId":           "81421968",
 								"url":                 "https://honestbeehelp-tw.zendesk.com/api/v2/ticket_fields/81421968.json",
 								"type":                "tagger",
 								"title":               "Type of service",
@@ -212,7 +212,7 @@ func TestHandlersGraphQLQueryTicketForm(t *testing.T) {
 				"query": `
 				{
 					oneTicketForm(formId: "825847") {
-						id
+						zendeskId
 						url
 						name
 						rawName
@@ -226,7 +226,7 @@ func TestHandlersGraphQLQueryTicketForm(t *testing.T) {
 						createdAt
 						updatedAt
 						ticketFieldsConnection(locale: ZH_TW) {
-							id
+							zendeskId
 							url
 							type
 							title
@@ -265,7 +265,7 @@ func TestHandlersGraphQLQueryTicketForm(t *testing.T) {
 			expectBody: map[string]interface{}{
 				"data": map[string]interface{}{
 					"oneTicketForm": map[string]interface{}{
-						"id":                 "825847",
+						"zendeskId":          "825847",
 						"url":                "https://honestbeehelp-tw.zendesk.com/api/v2/ticket_forms/825847.json",
 						"name":               "shin - wrong/defect item",
 						"rawName":            "shin - wrong/defect item",
@@ -280,7 +280,7 @@ func TestHandlersGraphQLQueryTicketForm(t *testing.T) {
 						"updatedAt":          time.Date(2018, 7, 13, 7, 40, 9, 0, time.UTC),
 						"ticketFieldsConnection": []map[string]interface{}{
 							map[string]interface{}{
-								"id":                  "24681488",
+								"zendeskId":           "24681488",
 								"url":                 "https://honestbee-ph.zendesk.com/api/v2/ticket_fields/24681488.json",
 								"type":                "subject",
 								"title":               "Subject",
@@ -305,7 +305,7 @@ func TestHandlersGraphQLQueryTicketForm(t *testing.T) {
 								"systemFieldOptions":  []map[string]interface{}{},
 							},
 							map[string]interface{}{
-								"id":                  "81469808",
+								"zendeskId":           "81469808",
 								"url":                 "https://honestbee-ph.zendesk.com/api/v2/ticket_fields/81469808.json",
 								"type":                "text",
 								"title":               "Order Number",
@@ -330,7 +330,7 @@ func TestHandlersGraphQLQueryTicketForm(t *testing.T) {
 								"systemFieldOptions":  []map[string]interface{}{},
 							},
 							map[string]interface{}{
-								"id":                  "81421968",
+								"zendeskId":           "81421968",
 								"url":                 "https://honestbeehelp-tw.zendesk.com/api/v2/ticket_fields/81421968.json",
 								"type":                "tagger",
 								"title":               "Type of service",
@@ -396,7 +396,7 @@ func TestHandlersGraphQLQueryTicketForm(t *testing.T) {
 				"query": `
 				{
 					oneTicketForm(formId: "825847") {
-						id
+						zendeskId
 						url
 						name
 						rawName
@@ -410,7 +410,7 @@ func TestHandlersGraphQLQueryTicketForm(t *testing.T) {
 						createdAt
 						updatedAt
 						ticketFieldsConnection(locale: ID) {
-							id
+							zendeskId
 							url
 							type
 							title
@@ -449,7 +449,7 @@ func TestHandlersGraphQLQueryTicketForm(t *testing.T) {
 			expectBody: map[string]interface{}{
 				"data": map[string]interface{}{
 					"oneTicketForm": map[string]interface{}{
-						"id":                 "825847",
+						"zendeskId":          "825847",
 						"url":                "https://honestbeehelp-tw.zendesk.com/api/v2/ticket_forms/825847.json",
 						"name":               "shin - wrong/defect item",
 						"rawName":            "shin - wrong/defect item",
@@ -464,7 +464,7 @@ func TestHandlersGraphQLQueryTicketForm(t *testing.T) {
 						"updatedAt":          time.Date(2018, 7, 13, 7, 40, 9, 0, time.UTC),
 						"ticketFieldsConnection": []map[string]interface{}{
 							map[string]interface{}{
-								"id":                  "24681488",
+								"zendeskId":           "24681488",
 								"url":                 "https://honestbee-ph.zendesk.com/api/v2/ticket_fields/24681488.json",
 								"type":                "subject",
 								"title":               "Subject",
@@ -489,7 +489,7 @@ func TestHandlersGraphQLQueryTicketForm(t *testing.T) {
 								"systemFieldOptions":  []map[string]interface{}{},
 							},
 							map[string]interface{}{
-								"id":                  "81469808",
+								"zendeskId":           "81469808",
 								"url":                 "https://honestbee-ph.zendesk.com/api/v2/ticket_fields/81469808.json",
 								"type":                "text",
 								"title":               "Order Number",
@@ -514,7 +514,7 @@ func TestHandlersGraphQLQueryTicketForm(t *testing.T) {
 								"systemFieldOptions":  []map[string]interface{}{},
 							},
 							map[string]interface{}{
-								"id":                  "81421968",
+								"zendeskId":           "81421968",
 								"url":                 "https://honestbeehelp-tw.zendesk.com/api/v2/ticket_fields/81421968.json",
 								"type":                "tagger",
 								"title":               "Type of service",
@@ -580,7 +580,7 @@ func TestHandlersGraphQLQueryTicketForm(t *testing.T) {
 				"query": `
 				{
 					oneTicketForm(formId: "91293129847") {
-						id
+						zendeskId
 						url
 						name
 						rawName
@@ -594,7 +594,7 @@ func TestHandlersGraphQLQueryTicketForm(t *testing.T) {
 						createdAt
 						updatedAt
 						ticketFieldsConnection(locale: ID) {
-							id
+							zendeskId
 							url
 							type
 							title
@@ -650,7 +650,7 @@ func TestHandlersGraphQLQueryTicketForm(t *testing.T) {
 				"query": `
 				{
 					oneTicketForm(formId: "91293129847") {
-						id
+						zendeskId
 						url
 						name
 						rawName
@@ -664,7 +664,7 @@ func TestHandlersGraphQLQueryTicketForm(t *testing.T) {
 						createdAt
 						updatedAt
 						ticketFieldsConnection(locale: non_exist_locale) {
-							id
+							zendeskId
 							url
 							type
 							title
@@ -739,6 +739,8 @@ func TestHandlersGraphQLQueryTicketForm(t *testing.T) {
 			if err = json.NewDecoder(resp.Body).Decode(&actual); err != nil {
 				t.Fatalf("[%s] json decoding failed:%v", tt.description, err)
 			}
+			// The cost of the query is reported in the extensions, it is not compared here.
+			delete(actual, "extensions")
 			// Converts integer to the same type.
 			expectData, err := json.Marshal(tt.expectBody)
 			if err != nil {
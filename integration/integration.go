@@ -10,11 +10,16 @@ import (
 
 	"github.com/rs/zerolog"
 
+	"github.com/honestbee/Zen/antispam"
+	"github.com/honestbee/Zen/auth"
 	"github.com/honestbee/Zen/config"
 	"github.com/honestbee/Zen/examiner"
 	"github.com/honestbee/Zen/models"
+	"github.com/honestbee/Zen/persisted"
+	"github.com/honestbee/Zen/purge"
 	"github.com/honestbee/Zen/resolvers"
 	"github.com/honestbee/Zen/router"
+	"github.com/honestbee/Zen/subscription"
 	"github.com/honestbee/Zen/zendesk"
 )
 
@@ -40,6 +45,8 @@ func init() {
 	conf.Examiner.SectionsRefreshLimit = sectionsRefreshLimit
 	conf.Examiner.ArticlesRefreshLimit = articlesRefreshLimit
 	conf.Examiner.TicketFormsRefreshLimit = ticketFormsRefreshLimit
+	// The same requests are created on every run, turn off the duplicate detector and rate limits.
+	conf.Antispam.Enable = false
 
 	if err = os.Setenv("PGPASSWORD", conf.Database.Password); err != nil {
 		log.Fatalf("set up postgres password failed:%v", err)
@@ -72,6 +79,7 @@ type tserver struct {
 	exam    *examiner.Examiner
 	service models.Service
 	zend    *zendesk.ZenDesk
+	broker  *subscription.Broker
 }
 
 func newTserver() *tserver {
@@ -84,15 +92,31 @@ func newTserver() *tserver {
 	if err != nil {
 		log.Fatalf("new models failed:%v", err)
 	}
-	exam, err := examiner.NewExaminer(conf, &logger, service, zend)
+	exam, err := examiner.NewExaminer(conf, &logger, service, zend, purge.NopPurger{})
 	if err != nil {
 		log.Fatalf("new examiner failed:%v", err)
 	}
-	resolver, err := resolvers.New(conf, &logger, service, exam, zend)
+	guard, err := antispam.New(conf, &logger, service, nil)
+	if err != nil {
+		log.Fatalf("new antispam guard failed:%v", err)
+	}
+	broker, err := subscription.New(conf, &logger, service)
+	if err != nil {
+		log.Fatalf("new subscription broker failed:%v", err)
+	}
+	authn, err := auth.New(conf)
+	if err != nil {
+		log.Fatalf("new authenticator failed:%v", err)
+	}
+	resolver, err := resolvers.New(conf, &logger, service, exam, zend, guard, broker, authn, nil)
 	if err != nil {
 		log.Fatalf("new graphql resolver failed")
 	}
-	h, err := router.New(conf, &logger, service, exam, zend, resolver)
+	store, err := persisted.New(conf, &logger, service)
+	if err != nil {
+		log.Fatalf("new persisted query store failed:%v", err)
+	}
+	h, err := router.New(conf, &logger, service, exam, zend, resolver, guard, store, authn, nil, nil)
 	if err != nil {
 		log.Fatalf("new router failed:%v", err)
 	}
@@ -102,6 +126,7 @@ func newTserver() *tserver {
 		exam:    exam,
 		service: service,
 		zend:    zend,
+		broker:  broker,
 	}
 }
 
@@ -144,6 +169,7 @@ func (t *tserver) closeAll() {
 	t.dataloaderCacheInvalidateAll()
 	t.resetAllCounter()
 	t.Server.Close()
+	t.broker.Close()
 	t.exam.Close()
 	t.service.Close()
 }
@@ -0,0 +1,82 @@
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+
+	"github.com/honestbee/Zen/models"
+)
+
+func TestModelsArticleVotes(t *testing.T) {
+	service := newService()
+	defer service.Close()
+	defer resetDB()
+
+	// The locks are kept in Redis which is not reset, so the voter is unique to the run.
+	voter := fmt.Sprintf("voter-%d", time.Now().UnixNano())
+
+	ctx := context.Background()
+	locked, err := service.LockArticleVote(ctx, 115015959188, "tw", voter)
+	if err != nil || !locked {
+		t.Fatalf("expect the vote is locked, actual:%v, err:%v", locked, err)
+	}
+	if locked, _ := service.LockArticleVote(ctx, 115015959188, "tw", voter); locked {
+		t.Errorf("expect the locked vote can't be locked again")
+	}
+	if err := service.UnlockArticleVote(ctx, 115015959188, "tw", voter); err != nil {
+		t.Fatalf("unlock article vote failed:%v", err)
+	}
+
+	if _, err := service.GetArticleVote(ctx, 115015959188, "tw", voter); err != models.ErrNotFound {
+		t.Errorf("expect ErrNotFound before the vote, actual:%v", err)
+	}
+
+	// Two voters vote up, then one of them changes the vote to down.
+	for _, vote := range []struct {
+		voter, value, previousValue string
+		zendeskVoteID               int64
+	}{
+		{voter, models.UpVote, "", 360002569612},
+		{"other", models.UpVote, "", 360002569613},
+		{voter, models.DownVote, models.UpVote, 360002569614},
+	} {
+		if err := service.SaveArticleVote(ctx, &models.ArticleVote{
+			ArticleID:     115015959188,
+			CountryCode:   "tw",
+			Voter:         vote.voter,
+			Value:         vote.value,
+			ZendeskVoteID: vote.zendeskVoteID,
+		}, vote.previousValue); err != nil {
+			t.Fatalf("save article vote failed:%v", err)
+		}
+	}
+
+	vote, err := service.GetArticleVote(ctx, 115015959188, "tw", voter)
+	if err != nil {
+		t.Fatalf("get article vote failed:%v", err)
+	}
+	if vote.Value != models.DownVote || vote.ZendeskVoteID != 360002569614 {
+		t.Errorf("expect the changed vote is kept, actual:%+v", vote)
+	}
+
+	history, err := service.GetArticleVoteHistory(ctx, &models.GetArticleVoteHistoryParams{
+		ArticleID:   115015959188,
+		WindowDays:  7,
+		CountryCode: "tw",
+	})
+	if err != nil {
+		t.Fatalf("get article vote history failed:%v", err)
+	}
+	expect := []*models.ArticleVoteDay{
+		{Day: time.Now().UTC().Format("2006-01-02"), Votes: 3, Upvotes: 1, Downvotes: 1, HelpfulnessRatio: 0.5},
+	}
+	if diff := deep.Equal(expect, history); diff != nil {
+		t.Errorf("[vote history] %v", diff)
+	}
+}
@@ -677,7 +677,7 @@ func TestModelsSyncWithArticles(t *testing.T) {
 
 	for _, tt := range testCases {
 		t.Run(tt.description, func(t *testing.T) {
-			err := service.SyncWithArticles(context.Background(), tt.inputArticles, tt.inputCountryCode, tt.inputLocale)
+			_, err := service.SyncWithArticles(context.Background(), tt.inputArticles, tt.inputCountryCode, tt.inputLocale)
 			defer resetDB()
 
 			if tt.expectError && err == nil {
@@ -816,7 +816,7 @@ func TestModelsSyncWithArticle(t *testing.T) {
 
 	for _, tt := range testCases {
 		t.Run(tt.description, func(t *testing.T) {
-			err := service.SyncWithArticle(context.Background(), tt.inputArticleID, tt.inputArticle, tt.inputCountryCode, tt.inputLocale)
+			_, err := service.SyncWithArticle(context.Background(), tt.inputArticleID, tt.inputArticle, tt.inputCountryCode, tt.inputLocale)
 			defer resetDB()
 
 			if tt.expectError && err == nil {
@@ -993,6 +993,8 @@ func TestModelsForceSyncWithArticle(t *testing.T) {
 		expectArticle1    *models.Article
 		expectError2      bool
 		expectArticle2    *models.Article
+		expectChangedIDs1 []int
+		expectChangedIDs2 []int
 	}{
 		{
 			description:    "testing sync with sg + en-us/zh-cn one mock article case",
@@ -1038,17 +1040,26 @@ func TestModelsForceSyncWithArticle(t *testing.T) {
 				Body:            "<p>force sync test 1</p>",
 				Locale:          "en-us",
 			},
-			expectError2:   true,
-			expectArticle2: &models.Article{},
+			expectError2:      true,
+			expectArticle2:    &models.Article{},
+			expectChangedIDs1: []int{978},
+			expectChangedIDs2: []int{},
 		},
 	}
 
 	for _, tt := range testCases {
 		t.Run(tt.description, func(t *testing.T) {
-			service.SyncWithArticle(context.Background(), tt.inputArticleID, tt.inputArticle1, tt.inputCountryCode1, tt.inputLocale1)
-			service.SyncWithArticle(context.Background(), tt.inputArticleID, tt.inputArticle1, tt.inputCountryCode1, tt.inputLocale1)
+			changedIDs1, _ := service.SyncWithArticle(context.Background(), tt.inputArticleID, tt.inputArticle1, tt.inputCountryCode1, tt.inputLocale1)
+			changedIDs2, _ := service.SyncWithArticle(context.Background(), tt.inputArticleID, tt.inputArticle1, tt.inputCountryCode1, tt.inputLocale1)
 			defer resetDB()
 
+			if diff := deep.Equal(tt.expectChangedIDs1, changedIDs1); diff != nil {
+				t.Errorf("[%s] first sync changed ids %v", tt.description, diff)
+			}
+			if diff := deep.Equal(tt.expectChangedIDs2, changedIDs2); diff != nil {
+				t.Errorf("[%s] second sync changed ids %v", tt.description, diff)
+			}
+
 			actualArticle1, err := service.GetArticleByArticleID(context.Background(), tt.inputArticleID, tt.inputLocale1, tt.inputCountryCode1)
 			if tt.expectError1 && err == nil {
 				t.Errorf("[%s] expect an error, actual none", tt.description)
@@ -3004,7 +3015,11 @@ func TestModelsGetTopNArticles(t *testing.T) {
 
 	for _, tt := range testCases {
 		t.Run(tt.description, func(t *testing.T) {
-			actualArticles, err := service.GetTopNArticles(context.Background(), tt.inputTopN, tt.inputLocale, tt.inputCountryCode)
+			actualArticles, err := service.GetTopNArticles(context.Background(), &models.GetTopNArticlesParams{
+				TopN:        tt.inputTopN,
+				Locale:      tt.inputLocale,
+				CountryCode: tt.inputCountryCode,
+			})
 			if tt.expectError && err == nil {
 				t.Errorf("[%s] expect an error, actual none", tt.description)
 			} else if !tt.expectError && err != nil {
@@ -3022,3 +3037,154 @@ func TestModelsGetTopNArticles(t *testing.T) {
 		})
 	}
 }
+
+func TestModelsGetTopNArticlesInWindow(t *testing.T) {
+	service := newService()
+	defer service.Close()
+	defer resetDB()
+
+	// The least clicked article of the section is the most viewed one of the window.
+	for i := 0; i < 3; i++ {
+		if err := service.RecordArticleView(context.Background(), 115015885547, "zh-tw", "tw"); err != nil {
+			t.Fatalf("record article view failed:%v", err)
+		}
+	}
+	if err := service.RecordArticleView(context.Background(), 115015959188, "en-us", "tw"); err != nil {
+		t.Fatalf("record article view failed:%v", err)
+	}
+	n, err := service.FlushArticleViews(context.Background())
+	if err != nil || n != 2 {
+		t.Fatalf("expect 2 flushed rows, actual:%d, err:%v", n, err)
+	}
+
+	testCases := []struct {
+		description string
+		params      *models.GetTopNArticlesParams
+		expectIDs   []int
+	}{
+		{
+			description: "testing window case",
+			params: &models.GetTopNArticlesParams{
+				TopN:        2,
+				Locale:      "zh-tw",
+				CountryCode: "tw",
+				WindowDays:  7,
+				SectionID:   115004118448,
+			},
+			expectIDs: []int{115015885547, 115015959188},
+		},
+		{
+			description: "testing other category case",
+			params: &models.GetTopNArticlesParams{
+				TopN:        2,
+				Locale:      "zh-tw",
+				CountryCode: "tw",
+				WindowDays:  7,
+				CategoryID:  1,
+			},
+			expectIDs: []int{},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			articles, err := service.GetTopNArticles(context.Background(), tt.params)
+			if err != nil {
+				t.Fatalf("[%s] expect no error, actual:%v", tt.description, err)
+			}
+			actualIDs := make([]int, 0, len(articles))
+			for _, article := range articles {
+				actualIDs = append(actualIDs, article.ID)
+			}
+			if diff := deep.Equal(tt.expectIDs, actualIDs); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+}
+
+func TestModelsExportArticles(t *testing.T) {
+	service := newService()
+	defer service.Close()
+	testCases := []struct {
+		description      string
+		inputLocale      string
+		inputCountryCode string
+		inputSince       time.Time
+		inputLimit       int
+		expectEmpty      bool
+	}{
+		{
+			description:      "testing paging by one case",
+			inputLocale:      "en-us",
+			inputCountryCode: "tw",
+			inputLimit:       1,
+		},
+		{
+			description:      "testing paging by three case",
+			inputLocale:      "zh-tw",
+			inputCountryCode: "tw",
+			inputLimit:       3,
+		},
+		{
+			description:      "testing since future case",
+			inputLocale:      "en-us",
+			inputCountryCode: "tw",
+			inputSince:       time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC),
+			inputLimit:       1,
+			expectEmpty:      true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			all, err := service.ExportArticles(context.Background(), &models.ExportArticlesParams{
+				Locale:      tt.inputLocale,
+				CountryCode: tt.inputCountryCode,
+				Since:       tt.inputSince,
+				Limit:       1000,
+			})
+			if err != nil {
+				t.Fatalf("[%s] expect no error, actual:%v", tt.description, err)
+			}
+			if tt.expectEmpty != (len(all) == 0) {
+				t.Fatalf("[%s] expect empty:%t, actual length:%d", tt.description, tt.expectEmpty, len(all))
+			}
+
+			expectIDs := make([]int, 0, len(all))
+			for i, article := range all {
+				if i > 0 && article.UpdatedAt.Before(all[i-1].UpdatedAt) {
+					t.Errorf("[%s] article:%d is not sorted by updated_at", tt.description, article.ID)
+				}
+				if article.Locale != tt.inputLocale || article.CountryCode != tt.inputCountryCode {
+					t.Errorf("[%s] article:%d locale:%s country code:%s", tt.description, article.ID, article.Locale, article.CountryCode)
+				}
+				expectIDs = append(expectIDs, article.ID)
+			}
+
+			params := &models.ExportArticlesParams{
+				Locale:      tt.inputLocale,
+				CountryCode: tt.inputCountryCode,
+				Since:       tt.inputSince,
+				Limit:       tt.inputLimit,
+			}
+			actualIDs := make([]int, 0, len(all))
+			for {
+				articles, err := service.ExportArticles(context.Background(), params)
+				if err != nil {
+					t.Fatalf("[%s] expect no error, actual:%v", tt.description, err)
+				}
+				for _, article := range articles {
+					actualIDs = append(actualIDs, article.ID)
+				}
+				if len(articles) < params.Limit {
+					break
+				}
+				params.After = articles[len(articles)-1].Cursor()
+			}
+			if diff := deep.Equal(expectIDs, actualIDs); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+}
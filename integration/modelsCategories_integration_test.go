@@ -363,7 +363,7 @@ func TestModelsSyncWithCategories(t *testing.T) {
 
 	for _, tt := range testCases {
 		t.Run(tt.description, func(t *testing.T) {
-			err := service.SyncWithCategories(context.Background(), tt.inputCategories, tt.inputCountryCode, tt.inputLocale)
+			_, err := service.SyncWithCategories(context.Background(), tt.inputCategories, tt.inputCountryCode, tt.inputLocale)
 			defer resetDB()
 
 			if tt.expectError && err == nil {
@@ -1013,3 +1013,114 @@ func TestModelsGetCategoryByCategoryIDOrKeyName(t *testing.T) {
 		})
 	}
 }
+
+func TestModelsGetCategoriesByIDs(t *testing.T) {
+	service := newService()
+	defer service.Close()
+	testCases := []struct {
+		description      string
+		inputIDs         []int
+		inputLocale      string
+		inputCountryCode string
+		expectCategories map[int]*models.Category
+	}{
+		{
+			description:      "testing normal en-us locale case",
+			inputIDs:         []int{115002432448, 123456789},
+			inputLocale:      "en-us",
+			inputCountryCode: "tw",
+			expectCategories: map[int]*models.Category{
+				115002432448: &models.Category{
+					ID:           115002432448,
+					Position:     2,
+					CreatedAt:    time.Date(2017, 12, 19, 6, 21, 45, 0, time.UTC),
+					UpdatedAt:    time.Date(2018, 3, 6, 12, 39, 30, 0, time.UTC),
+					SourceLocale: "en-us",
+					Outdated:     false,
+					CountryCode:  "tw",
+					URL:          "https://honestbeehelp-tw.zendesk.com/api/v2/help_center/en-us/categories/115002432448-My-Account.json",
+					HTMLURL:      "https://help.honestbee.tw/hc/en-us/categories/115002432448-My-Account",
+					Name:         "My Account",
+					Description:  "",
+					Locale:       "en-us",
+					KeyName:      "myAccount",
+				},
+			},
+		},
+		{
+			description:      "testing not exist locale case",
+			inputIDs:         []int{115002432448},
+			inputLocale:      "not-exist-locale",
+			inputCountryCode: "tw",
+			expectCategories: map[int]*models.Category{},
+		},
+		{
+			description:      "testing not exist country code case",
+			inputIDs:         []int{115002432448},
+			inputLocale:      "en-us",
+			inputCountryCode: "not-exist-country-code",
+			expectCategories: map[int]*models.Category{},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			actualCategories, err := service.GetCategoriesByIDs(context.Background(), tt.inputIDs, tt.inputLocale, tt.inputCountryCode)
+			if err != nil {
+				t.Errorf("[%s] expect no error, actual:%v", tt.description, err)
+			} else if diff := deep.Equal(tt.expectCategories, actualCategories); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+}
+
+func TestModelsGetCategoriesByArticleIDs(t *testing.T) {
+	service := newService()
+	defer service.Close()
+	testCases := []struct {
+		description      string
+		inputArticleIDs  []int
+		inputLocale      string
+		expectCategories map[int]*models.Category
+	}{
+		{
+			description:     "testing normal zh-tw locale case",
+			inputArticleIDs: []int{115015959148, 123456789},
+			inputLocale:     "zh-tw",
+			expectCategories: map[int]*models.Category{
+				115015959148: &models.Category{
+					ID:           115002432448,
+					Position:     2,
+					CreatedAt:    time.Date(2017, 12, 19, 6, 21, 45, 0, time.UTC),
+					UpdatedAt:    time.Date(2018, 3, 6, 12, 39, 30, 0, time.UTC),
+					SourceLocale: "en-us",
+					Outdated:     false,
+					CountryCode:  "tw",
+					URL:          "https://honestbeehelp-tw.zendesk.com/api/v2/help_center/zh-tw/categories/115002432448-%E6%88%91%E7%9A%84%E5%B8%B3%E8%99%9F.json",
+					HTMLURL:      "https://help.honestbee.tw/hc/zh-tw/categories/115002432448-%E6%88%91%E7%9A%84%E5%B8%B3%E8%99%9F",
+					Name:         "我的帳號",
+					Description:  "",
+					Locale:       "zh-tw",
+				},
+			},
+		},
+		{
+			description:      "testing not exist locale case",
+			inputArticleIDs:  []int{115015959148},
+			inputLocale:      "not-exist-locale",
+			expectCategories: map[int]*models.Category{},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			actualCategories, err := service.GetCategoriesByArticleIDs(context.Background(), tt.inputArticleIDs, tt.inputLocale)
+			if err != nil {
+				t.Errorf("[%s] expect no error, actual:%v", tt.description, err)
+			} else if diff := deep.Equal(tt.expectCategories, actualCategories); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+}
@@ -51,10 +51,97 @@ func TestModelsSyncWithDynamicContentItems(t *testing.T) {
 				t.Errorf("[%s] expect no error, actual:%v", tt.description, err)
 			} else if err == nil {
 				_, err := service.GetDynamicContentItem(context.Background(), tt.inputPlaceholder, "en-us")
-				if err == nil {
-					t.Errorf("[%s] expect an error, actual nil", tt.description)
+				if err != models.ErrNotFound {
+					t.Errorf("[%s] expect error:%v, actual:%v", tt.description, models.ErrNotFound, err)
 				}
 			}
 		})
 	}
 }
+
+func TestModelsRenderDynamicContent(t *testing.T) {
+	service := newService()
+	defer service.Close()
+
+	items := []*models.SyncDynamicContentItem{
+		&models.SyncDynamicContentItem{
+			ID:              3345679,
+			URL:             "testing-dc-items-url",
+			Name:            "greeting",
+			Placeholder:     "{{dc.greeting}}",
+			DefaultLocaleID: 1,
+			CreatedAt:       time.Date(2017, 12, 19, 6, 23, 48, 0, time.UTC),
+			UpdatedAt:       time.Date(2017, 12, 19, 6, 23, 48, 0, time.UTC),
+			Variants: []byte(`[
+				{"id":1,"content":"Hello","locale_id":1,"active":true},
+				{"id":2,"content":"您好","locale_id":9,"active":true}
+			]`),
+		},
+		&models.SyncDynamicContentItem{
+			ID:              3345680,
+			URL:             "testing-dc-items-url",
+			Name:            "farewell",
+			Placeholder:     "{{dc.farewell}}",
+			DefaultLocaleID: 1,
+			CreatedAt:       time.Date(2017, 12, 19, 6, 23, 48, 0, time.UTC),
+			UpdatedAt:       time.Date(2017, 12, 19, 6, 23, 48, 0, time.UTC),
+			Variants: []byte(`[
+				{"id":3,"content":"再見","locale_id":9,"active":true}
+			]`),
+		},
+	}
+	if err := service.SyncWithDynamicContentItems(context.Background(), items); err != nil {
+		t.Fatalf("sync dynamic content items failed:%v", err)
+	}
+	defer resetDB()
+	defer service.DynamicContentCacheInvalidate(context.Background())
+
+	testCases := []struct {
+		description string
+		inputText   string
+		inputLocale string
+		expectText  string
+	}{
+		{
+			description: "testing render with locale variant case",
+			inputText:   "<p>{{dc.greeting}}, world</p>",
+			inputLocale: "zh-tw",
+			expectText:  "<p>您好, world</p>",
+		},
+		{
+			description: "testing render fallback to default locale case",
+			inputText:   "{{dc.greeting}} {{ dc.greeting }}",
+			inputLocale: "ja",
+			expectText:  "Hello Hello",
+		},
+		{
+			description: "testing render keeps unknown placeholder case",
+			inputText:   "{{dc.unknown}}",
+			inputLocale: "en-us",
+			expectText:  "{{dc.unknown}}",
+		},
+		{
+			description: "testing render keeps placeholder without locale and default variants case",
+			inputText:   "{{dc.greeting}} and {{dc.farewell}}",
+			inputLocale: "en-us",
+			expectText:  "Hello and {{dc.farewell}}",
+		},
+		{
+			description: "testing render without placeholder case",
+			inputText:   "plain text",
+			inputLocale: "en-us",
+			expectText:  "plain text",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			text, err := service.RenderDynamicContent(context.Background(), tt.inputText, tt.inputLocale)
+			if err != nil {
+				t.Errorf("[%s] expect no error, actual:%v", tt.description, err)
+			} else if text != tt.expectText {
+				t.Errorf("[%s] expect text:%s, actual:%s", tt.description, tt.expectText, text)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-test/deep"
+
+	"github.com/honestbee/Zen/models"
+)
+
+func TestModelsSearchQueries(t *testing.T) {
+	service := newService()
+	defer service.Close()
+	defer resetDB()
+
+	ctx := context.Background()
+	for _, query := range []string{"Refund", "refund  ", "REFUND"} {
+		if err := service.RecordSearchQuery(ctx, query, "en-us", "tw", 3); err != nil {
+			t.Fatalf("record search query failed:%v", err)
+		}
+	}
+	if err := service.RecordSearchClick(ctx, "refund", 115015959188, "en-us", "tw"); err != nil {
+		t.Fatalf("record search click failed:%v", err)
+	}
+	if err := service.RecordSearchQuery(ctx, "gift card", "en-us", "tw", 0); err != nil {
+		t.Fatalf("record search query failed:%v", err)
+	}
+	// The queries of the other locales are not counted.
+	if err := service.RecordSearchQuery(ctx, "退款", "zh-tw", "tw", 0); err != nil {
+		t.Fatalf("record search query failed:%v", err)
+	}
+	n, err := service.FlushSearchQueries(ctx)
+	if err != nil || n != 4 {
+		t.Fatalf("expect 4 flushed rows, actual:%d, err:%v", n, err)
+	}
+
+	refund := &models.SearchQueryStat{Query: "refund", Searches: 3, Clicks: 1, ClickThroughRate: 1.0 / 3}
+	giftCard := &models.SearchQueryStat{Query: "gift card", Searches: 1, ZeroResults: 1}
+
+	testCases := []struct {
+		description string
+		report      models.SearchQueryReport
+		expect      []*models.SearchQueryStat
+	}{
+		{
+			description: "testing top queries case",
+			report:      models.TopSearchQueries,
+			expect:      []*models.SearchQueryStat{refund, giftCard},
+		},
+		{
+			description: "testing zero result queries case",
+			report:      models.ZeroResultSearchQueries,
+			expect:      []*models.SearchQueryStat{giftCard},
+		},
+		{
+			description: "testing click-through case",
+			report:      models.ClickThroughSearchQueries,
+			expect:      []*models.SearchQueryStat{giftCard, refund},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			stats, err := service.GetSearchQueryStats(ctx, &models.GetSearchQueryStatsParams{
+				Report:      tt.report,
+				Limit:       10,
+				WindowDays:  7,
+				Locale:      "en-us",
+				CountryCode: "tw",
+			})
+			if err != nil {
+				t.Fatalf("[%s] expect no error, actual:%v", tt.description, err)
+			}
+			if diff := deep.Equal(tt.expect, stats); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+}
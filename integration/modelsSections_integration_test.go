@@ -225,7 +225,7 @@ func TestModelsSyncWithSections(t *testing.T) {
 
 	for _, tt := range testCases {
 		t.Run(tt.description, func(t *testing.T) {
-			err := service.SyncWithSections(context.Background(), tt.inputSections, tt.inputCountryCode, tt.inputLocale)
+			_, err := service.SyncWithSections(context.Background(), tt.inputSections, tt.inputCountryCode, tt.inputLocale)
 			defer resetDB()
 
 			if tt.expectError && err == nil {
@@ -844,3 +844,57 @@ func TestModelsGetSectionBySectionID(t *testing.T) {
 		})
 	}
 }
+
+func TestModelsGetSectionsByIDs(t *testing.T) {
+	service := newService()
+	defer service.Close()
+	testCases := []struct {
+		description      string
+		inputIDs         []int
+		inputLocale      string
+		inputCountryCode string
+		expectSections   map[int]*models.Section
+	}{
+		{
+			description:      "testing normal zh-tw locale case",
+			inputIDs:         []int{115004118448, 123456789},
+			inputLocale:      "zh-tw",
+			inputCountryCode: "tw",
+			expectSections: map[int]*models.Section{
+				115004118448: &models.Section{
+					CategoryID:   115002432448,
+					ID:           115004118448,
+					Position:     0,
+					CreatedAt:    time.Date(2017, 12, 19, 6, 23, 48, 0, time.UTC),
+					UpdatedAt:    time.Date(2018, 3, 6, 12, 39, 30, 0, time.UTC),
+					SourceLocale: "en-us",
+					Outdated:     false,
+					CountryCode:  "tw",
+					URL:          "https://honestbeehelp-tw.zendesk.com/api/v2/help_center/zh-tw/sections/115004118448-%E6%88%91%E9%9C%80%E8%A6%81%E5%B8%B3%E8%99%9F%E7%9B%B8%E9%97%9C%E7%9A%84%E5%8D%94%E5%8A%A9.json",
+					HTMLURL:      "https://help.honestbee.tw/hc/zh-tw/sections/115004118448-%E6%88%91%E9%9C%80%E8%A6%81%E5%B8%B3%E8%99%9F%E7%9B%B8%E9%97%9C%E7%9A%84%E5%8D%94%E5%8A%A9",
+					Name:         "我需要帳號相關的協助",
+					Description:  "",
+					Locale:       "zh-tw",
+				},
+			},
+		},
+		{
+			description:      "testing not exist country code case",
+			inputIDs:         []int{115004118448},
+			inputLocale:      "zh-tw",
+			inputCountryCode: "not-exist-country-code",
+			expectSections:   map[int]*models.Section{},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			actualSections, err := service.GetSectionsByIDs(context.Background(), tt.inputIDs, tt.inputLocale, tt.inputCountryCode)
+			if err != nil {
+				t.Errorf("[%s] expect no error, actual:%v", tt.description, err)
+			} else if diff := deep.Equal(tt.expectSections, actualSections); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+}
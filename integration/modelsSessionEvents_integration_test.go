@@ -0,0 +1,93 @@
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+
+	"github.com/honestbee/Zen/models"
+)
+
+func TestModelsSessionEvents(t *testing.T) {
+	service := newService()
+	defer service.Close()
+	defer resetDB()
+
+	// The viewed articles are kept in Redis which is not reset, so the sessions are unique to the run.
+	filed := fmt.Sprintf("filed-%d", time.Now().UnixNano())
+	deflected := fmt.Sprintf("deflected-%d", time.Now().UnixNano())
+
+	ctx := context.Background()
+	for _, event := range []*models.SessionEvent{
+		{SessionID: filed, Kind: models.ViewSessionEvent, ArticleID: 115015959188, CountryCode: "tw", Locale: "en-us"},
+		{SessionID: filed, Kind: models.SearchSessionEvent, Query: "Cart  Locked", CountryCode: "tw", Locale: "en-us"},
+		{SessionID: deflected, Kind: models.ViewSessionEvent, ArticleID: 115015959188, CountryCode: "tw", Locale: "en-us"},
+		{SessionID: deflected, Kind: models.ViewSessionEvent, ArticleID: 115015959148, CountryCode: "tw", Locale: "en-us"},
+		{SessionID: deflected, Kind: models.VoteSessionEvent, ArticleID: 115015959148, CountryCode: "tw", Locale: "en-us"},
+		{SessionID: filed, Kind: models.RequestSessionEvent, CountryCode: "tw"},
+		// The events without a session are not tracked.
+		{Kind: models.ViewSessionEvent, ArticleID: 115015959148, CountryCode: "tw", Locale: "en-us"},
+	} {
+		if err := service.RecordSessionEvent(ctx, event); err != nil {
+			t.Fatalf("record session event failed:%v", err)
+		}
+	}
+
+	viewed, err := service.GetSessionViewedArticles(ctx, deflected)
+	if err != nil {
+		t.Fatalf("get session viewed articles failed:%v", err)
+	}
+	if diff := deep.Equal([]int{115015959148, 115015959188}, viewed); diff != nil {
+		t.Errorf("[viewed articles] %v", diff)
+	}
+
+	n, err := service.FlushSessionEvents(ctx)
+	if err != nil || n != 6 {
+		t.Fatalf("expect 6 flushed events, actual:%d, err:%v", n, err)
+	}
+
+	testCases := []struct {
+		description string
+		group       models.DeflectionGroup
+		expect      []*models.DeflectionStat
+	}{
+		{
+			description: "testing articles case",
+			group:       models.ArticleDeflection,
+			expect: []*models.DeflectionStat{
+				{ID: 115015959188, Sessions: 2, Deflected: 1, DeflectionRate: 0.5},
+				{ID: 115015959148, Sessions: 1, Deflected: 1, DeflectionRate: 1},
+			},
+		},
+		{
+			description: "testing categories case",
+			group:       models.CategoryDeflection,
+			expect: []*models.DeflectionStat{
+				{ID: 115002432448, Sessions: 2, Deflected: 1, DeflectionRate: 0.5},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			stats, err := service.GetDeflectionStats(ctx, &models.GetDeflectionStatsParams{
+				Group:       tt.group,
+				Limit:       10,
+				WindowDays:  7,
+				Locale:      "en-us",
+				CountryCode: "tw",
+			})
+			if err != nil {
+				t.Fatalf("[%s] expect no error, actual:%v", tt.description, err)
+			}
+			if diff := deep.Equal(tt.expect, stats); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+}
@@ -1,11 +1,14 @@
 package cache
 
+import "context"
+
 // Cache is the interface of defining all cache operations.
 type Cache interface {
-	IntDo(cmd string, args ...interface{}) (int, error)
-	StringDo(cmd string, args ...interface{}) (string, error)
-	StringsDo(cmd string, args ...interface{}) ([]string, error)
-	BoolDo(cmd string, args ...interface{}) (bool, error)
-	Float64Do(cmd string, args ...interface{}) (float64, error)
+	IntDo(ctx context.Context, cmd string, args ...interface{}) (int, error)
+	StringDo(ctx context.Context, cmd string, args ...interface{}) (string, error)
+	StringsDo(ctx context.Context, cmd string, args ...interface{}) ([]string, error)
+	BoolDo(ctx context.Context, cmd string, args ...interface{}) (bool, error)
+	Float64Do(ctx context.Context, cmd string, args ...interface{}) (float64, error)
+	Subscribe(ctx context.Context, channel string) (<-chan []byte, error)
 	Close() error
 }
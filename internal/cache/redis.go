@@ -1,17 +1,22 @@
 package cache
 
 import (
+	"context"
 	"time"
 
 	"github.com/garyburd/redigo/redis"
 	"github.com/pkg/errors"
-	redigotrace "gopkg.in/DataDog/dd-trace-go.v1/contrib/garyburd/redigo"
 
 	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/tracing"
 )
 
+// defaultHealthCheckPeriod is the ping period of the subscriptions if there is no read timeout.
+const defaultHealthCheckPeriod = time.Minute
+
 type redisPool struct {
-	pool *redis.Pool
+	pool        *redis.Pool
+	readTimeout time.Duration
 }
 
 // NewRedis returns a Redis instance.
@@ -22,13 +27,14 @@ func NewRedis(conf *config.Config, dbIndex int) (Cache, error) {
 	idleTimeout := time.Duration(conf.Cache.IdleTimeoutSec) * time.Second
 
 	return &redisPool{
+		readTimeout: readTimeout,
 		pool: &redis.Pool{
 			MaxIdle:     conf.Cache.MaxIdle,
 			MaxActive:   conf.Cache.MaxActive,
 			IdleTimeout: idleTimeout,
 			Wait:        conf.Cache.Wait,
 			Dial: func() (redis.Conn, error) {
-				c, err := redigotrace.Dial(
+				c, err := redis.Dial(
 					"tcp",
 					conf.Cache.Host+":"+conf.Cache.Port,
 					redis.DialConnectTimeout(connectTimeout),
@@ -36,7 +42,6 @@ func NewRedis(conf *config.Config, dbIndex int) (Cache, error) {
 					redis.DialWriteTimeout(writeTimeout),
 					redis.DialPassword(conf.Cache.Password),
 					redis.DialDatabase(dbIndex),
-					redigotrace.WithServiceName("helpcenter-zendesk-redis"),
 				)
 				if err != nil {
 					return nil, errors.Wrapf(
@@ -60,13 +65,22 @@ func NewRedis(conf *config.Config, dbIndex int) (Cache, error) {
 	}, nil
 }
 
-// do is wrapping pool usage of redigo do.
-func (r *redisPool) do(cmd string, args ...interface{}) (interface{}, error) {
+// do is wrapping pool usage of redigo do, the command is spanned as the child of the span of ctx.
+func (r *redisPool) do(ctx context.Context, cmd string, args ...interface{}) (interface{}, error) {
+	span, _ := tracing.StartSpan(ctx, "redis.command",
+		tracing.WithKind(tracing.KindClient),
+		tracing.WithService("redis"),
+		tracing.WithResource(cmd),
+		tracing.WithTag("db.system", "redis"),
+	)
+	defer span.Finish()
+
 	conn := r.pool.Get()
 	defer conn.Close()
 
 	reply, err := conn.Do(cmd, args...)
 	if err != nil {
+		span.SetError(err)
 		return nil, errors.Wrapf(err, "cache: [do] failed on cmd:%s, args:%v", cmd, args)
 	}
 
@@ -78,26 +92,91 @@ func (r *redisPool) Close() error {
 }
 
 // IntDo is a wrapper returns int type result.
-func (r *redisPool) IntDo(cmd string, args ...interface{}) (int, error) {
-	return redis.Int(r.do(cmd, args...))
+func (r *redisPool) IntDo(ctx context.Context, cmd string, args ...interface{}) (int, error) {
+	return redis.Int(r.do(ctx, cmd, args...))
 }
 
 // StringDo is a wrapper returns string type result.
-func (r *redisPool) StringDo(cmd string, args ...interface{}) (string, error) {
-	return redis.String(r.do(cmd, args...))
+func (r *redisPool) StringDo(ctx context.Context, cmd string, args ...interface{}) (string, error) {
+	return redis.String(r.do(ctx, cmd, args...))
 }
 
 // StringsDo is a wrapper returns string[] type result.
-func (r *redisPool) StringsDo(cmd string, args ...interface{}) ([]string, error) {
-	return redis.Strings(r.do(cmd, args...))
+func (r *redisPool) StringsDo(ctx context.Context, cmd string, args ...interface{}) ([]string, error) {
+	return redis.Strings(r.do(ctx, cmd, args...))
 }
 
 // BoolDo is a wrapper returns boolean type result.
-func (r *redisPool) BoolDo(cmd string, args ...interface{}) (bool, error) {
-	return redis.Bool(r.do(cmd, args...))
+func (r *redisPool) BoolDo(ctx context.Context, cmd string, args ...interface{}) (bool, error) {
+	return redis.Bool(r.do(ctx, cmd, args...))
 }
 
 // Float64Do is a wrapper returns float64 type result.
-func (r *redisPool) Float64Do(cmd string, args ...interface{}) (float64, error) {
-	return redis.Float64(r.do(cmd, args...))
+func (r *redisPool) Float64Do(ctx context.Context, cmd string, args ...interface{}) (float64, error) {
+	return redis.Float64(r.do(ctx, cmd, args...))
+}
+
+// Subscribe subscribes the channel on a dedicated connection until ctx is done,
+// the messages are sent to the returned channel which is closed when the subscription ends.
+func (r *redisPool) Subscribe(ctx context.Context, channel string) (<-chan []byte, error) {
+	conn, err := r.pool.Dial()
+	if err != nil {
+		return nil, errors.Wrapf(err, "cache: [Subscribe] dial failed")
+	}
+
+	psc := redis.PubSubConn{Conn: conn}
+	if err = psc.Subscribe(channel); err != nil {
+		psc.Close()
+		return nil, errors.Wrapf(err, "cache: [Subscribe] subscribe channel:%s failed", channel)
+	}
+
+	var (
+		messages = make(chan []byte)
+		done     = make(chan struct{})
+	)
+
+	go func() {
+		defer close(messages)
+		defer close(done)
+
+		for {
+			switch v := psc.Receive().(type) {
+			case redis.Message:
+				select {
+				case messages <- v.Data:
+				case <-ctx.Done():
+					return
+				}
+			case error:
+				return
+			}
+		}
+	}()
+
+	go func() {
+		defer psc.Close()
+
+		// Pings make the connection receive within the read timeout while the channel is quiet.
+		period := defaultHealthCheckPeriod
+		if r.readTimeout > 0 {
+			period = r.readTimeout / 2
+		}
+		ticker := time.NewTicker(period)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := psc.Ping(""); err != nil {
+					return
+				}
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return messages, nil
 }
@@ -18,10 +18,11 @@ var (
 // Database is the interface of defining all normal operations.
 type Database interface {
 	Close() error
-	Select(ctx context.Context, dest interface{}, query string) error
-	Get(ctx context.Context, dest interface{}, query string) error
+	Ping(ctx context.Context) error
+	Select(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	Get(ctx context.Context, dest interface{}, query string, args ...interface{}) error
 	NamedExec(ctx context.Context, query string, arg interface{}) (sql.Result, error)
-	Begin() (DatabaseTransaction, error)
+	Begin(ctx context.Context) (DatabaseTransaction, error)
 }
 
 // DatabaseTransaction is the interface of defining all transaction operations.
@@ -34,6 +35,12 @@ type DatabaseTransaction interface {
 	Commit()
 }
 
+// RowVersion is the id and updated_at columns of a synced table.
+type RowVersion struct {
+	ID        int       `db:"id"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
 // Categories is the categories table columns.
 type Categories struct {
 	SN           int       `db:"sn"`
@@ -59,6 +66,18 @@ type Category struct {
 	CategoryTranslates
 }
 
+// CategoryWithKey is the categories join categoryTranslates and categoryKey table columns.
+type CategoryWithKey struct {
+	Category
+	KeyName string `db:"key_name"`
+}
+
+// ArticleCategory is the categories join categoryTranslates table columns with the id of an article in the category.
+type ArticleCategory struct {
+	ArticleID int `db:"article_id"`
+	Category
+}
+
 // CategoryTranslates is the category_translates table columns.
 type CategoryTranslates struct {
 	SN          int    `db:"sn"`
@@ -93,6 +112,20 @@ type Sections struct {
 	CountryCode  string    `db:"country_code"`
 }
 
+// Section is the sections join sectionTranslates table columns.
+type Section struct {
+	CategoryID   int       `db:"category_id"`
+	ID           int       `db:"id"`
+	Position     int       `db:"position"`
+	CreatedAt    time.Time `db:"created_at"`
+	UpdatedAt    time.Time `db:"updated_at"`
+	SourceLocale string    `db:"source_locale"`
+	Outdated     bool      `db:"outdated"`
+	CountryCode  string    `db:"country_code"`
+
+	SectionTranslates
+}
+
 // SectionTranslates is the section_translates table columns.
 type SectionTranslates struct {
 	SN          int    `db:"sn"`
@@ -126,6 +159,12 @@ type Articles struct {
 	CountryCode     string         `db:"country_code"`
 }
 
+// Article is the articles join articleTranslates table columns.
+type Article struct {
+	Articles
+	ArticleTranslates
+}
+
 // ArticleTranslates is the article_translates table columns.
 type ArticleTranslates struct {
 	SN        int    `db:"sn"`
@@ -224,3 +263,105 @@ type Variant struct {
 	CreatedAt time.Time `db:"created_at" json:"created_at,omitempty"`
 	UpdatedAt time.Time `db:"updated_at" json:"updated_at,omitempty"`
 }
+
+// RejectedRequests is the rejected_requests table columns.
+type RejectedRequests struct {
+	SN          int       `db:"sn"`
+	Source      string    `db:"source"`
+	Reason      string    `db:"reason"`
+	RemoteIP    string    `db:"remote_ip"`
+	CountryCode string    `db:"country_code"`
+	Email       string    `db:"email"`
+	Subject     string    `db:"subject"`
+	Body        string    `db:"body"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+// ArticleViews is the article_views table columns.
+type ArticleViews struct {
+	ArticleID   int       `db:"article_id"`
+	CountryCode string    `db:"country_code"`
+	Locale      string    `db:"locale"`
+	Day         time.Time `db:"day"`
+	Views       int       `db:"views"`
+}
+
+// SearchQueries is the search_queries table columns.
+type SearchQueries struct {
+	Query       string    `db:"query"`
+	CountryCode string    `db:"country_code"`
+	Locale      string    `db:"locale"`
+	Day         time.Time `db:"day"`
+	Searches    int       `db:"searches"`
+	ZeroResults int       `db:"zero_results"`
+}
+
+// SearchClicks is the search_clicks table columns.
+type SearchClicks struct {
+	Query       string    `db:"query"`
+	ArticleID   int       `db:"article_id"`
+	CountryCode string    `db:"country_code"`
+	Locale      string    `db:"locale"`
+	Day         time.Time `db:"day"`
+	Clicks      int       `db:"clicks"`
+}
+
+// SearchQueryStats is the search_queries join search_clicks columns summed up by the query.
+type SearchQueryStats struct {
+	Query            string  `db:"query"`
+	Searches         int     `db:"searches"`
+	ZeroResults      int     `db:"zero_results"`
+	Clicks           int     `db:"clicks"`
+	ClickThroughRate float64 `db:"click_through_rate"`
+}
+
+// SessionEvents is the session_events table columns.
+type SessionEvents struct {
+	SN          int       `db:"sn"`
+	SessionID   string    `db:"session_id"`
+	Kind        string    `db:"kind"`
+	ArticleID   int       `db:"article_id"`
+	Query       string    `db:"query"`
+	CountryCode string    `db:"country_code"`
+	Locale      string    `db:"locale"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+// DeflectionStats is the session_events columns counted by the article or the category.
+type DeflectionStats struct {
+	ID             int     `db:"id"`
+	Sessions       int     `db:"sessions"`
+	Deflected      int     `db:"deflected"`
+	DeflectionRate float64 `db:"deflection_rate"`
+}
+
+// ArticleVotes is the article_votes table columns.
+type ArticleVotes struct {
+	ArticleID     int       `db:"article_id"`
+	CountryCode   string    `db:"country_code"`
+	Voter         string    `db:"voter"`
+	Value         string    `db:"value"`
+	ZendeskVoteID int64     `db:"zendesk_vote_id"`
+	CreatedAt     time.Time `db:"created_at"`
+	UpdatedAt     time.Time `db:"updated_at"`
+}
+
+// ArticleVoteEvents is the article_vote_events table columns.
+type ArticleVoteEvents struct {
+	SN            int       `db:"sn"`
+	ArticleID     int       `db:"article_id"`
+	CountryCode   string    `db:"country_code"`
+	Voter         string    `db:"voter"`
+	Value         string    `db:"value"`
+	PreviousValue string    `db:"previous_value"`
+	CreatedAt     time.Time `db:"created_at"`
+}
+
+// ArticleVoteDays is the article_vote_events columns summed up by the day.
+type ArticleVoteDays struct {
+	Day              time.Time `db:"day"`
+	Votes            int       `db:"votes"`
+	Upvotes          int       `db:"upvotes"`
+	Downvotes        int       `db:"downvotes"`
+	HelpfulnessRatio float64   `db:"helpfulness_ratio"`
+}
@@ -7,12 +7,12 @@ import (
 	"time"
 
 	"github.com/jmoiron/sqlx"
-	"github.com/lib/pq" // for sqlx.Connect usage
+	_ "github.com/lib/pq" // for sqlx.Connect usage
 	"github.com/pkg/errors"
-	sqltrace "gopkg.in/DataDog/dd-trace-go.v1/contrib/database/sql"
-	sqlxtrace "gopkg.in/DataDog/dd-trace-go.v1/contrib/jmoiron/sqlx"
 
 	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/metrics"
+	"github.com/honestbee/Zen/tracing"
 )
 
 type postgres struct {
@@ -24,8 +24,6 @@ type postgres struct {
 
 // NewPostgres returns a Database instance.
 func NewPostgres(conf *config.Config) (Database, error) {
-	sqltrace.Register("postgres", &pq.Driver{}, sqltrace.WithServiceName("helpcenter-zendesk-postgres"))
-
 	connSchema := fmt.Sprintf(
 		"user=%s dbname=%s password=%s host=%s port=%s sslmode=disable",
 		conf.Database.User,
@@ -41,7 +39,7 @@ func NewPostgres(conf *config.Config) (Database, error) {
 	)
 	defer cancel()
 
-	db, err := sqlxtrace.Connect("postgres", connSchema)
+	db, err := sqlx.Connect("postgres", connSchema)
 	if err != nil {
 		return nil, errors.Wrapf(err, "db: [NewPostgress] connect failed")
 	}
@@ -51,6 +49,7 @@ func NewPostgres(conf *config.Config) (Database, error) {
 
 	db.SetMaxIdleConns(conf.Database.MaxIdle)
 	db.SetMaxOpenConns(conf.Database.MaxActive)
+	metrics.SetDBStats(db.Stats)
 
 	return &postgres{
 		db:                    db,
@@ -65,38 +64,75 @@ func (p *postgres) Close() error {
 	return errors.Wrapf(p.db.Close(), "db: [Close] close database failed")
 }
 
+// Ping verifies the connection to the database is still alive.
+func (p *postgres) Ping(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, p.readTimeout)
+	defer cancel()
+
+	return errors.Wrapf(p.db.PingContext(ctx), "db: [Ping] ping database failed")
+}
+
+// startSpan starts the span of the query, the returned query carries the traceparent of the span
+// in a leading comment so that the query is traced in the database logs and pg_stat_activity.
+func startSpan(ctx context.Context, query string) (tracing.Span, context.Context, string) {
+	span, ctx := tracing.StartSpan(ctx, "postgres.query",
+		tracing.WithKind(tracing.KindClient),
+		tracing.WithService("postgres"),
+		tracing.WithResource(query),
+		tracing.WithTag("db.system", "postgresql"),
+	)
+	if sc := span.Context(); sc.IsValid() {
+		query = fmt.Sprintf("/*traceparent='%s'*/ %s", sc.Traceparent(), query)
+	}
+	return span, ctx, query
+}
+
+// finishSpan finishes the span of a query, the no rows error is not a failure.
+func finishSpan(span tracing.Span, err error) {
+	if err != sql.ErrNoRows {
+		span.SetError(err)
+	}
+	span.Finish()
+}
+
 // Select is the wrapper of sqlx SelectContext.
-func (p *postgres) Select(ctx context.Context, dest interface{}, query string) error {
+func (p *postgres) Select(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	span, ctx, traced := startSpan(ctx, query)
 	ctx, cancel := context.WithTimeout(ctx, p.readTimeout)
 	defer cancel()
 
-	err := p.db.SelectContext(ctx, dest, query)
+	err := p.db.SelectContext(ctx, dest, traced, args...)
+	finishSpan(span, err)
 	if err == sql.ErrNoRows {
 		return ErrNoRows
 	}
 
-	return errors.Wrapf(err, "db: [Select] failed on %q query", query)
+	return errors.Wrapf(err, "db: [Select] failed on %q query, args:%v", query, args)
 }
 
 // Get is the wrapper of sqlx GetContext.
-func (p *postgres) Get(ctx context.Context, dest interface{}, query string) error {
+func (p *postgres) Get(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	span, ctx, traced := startSpan(ctx, query)
 	ctx, cancel := context.WithTimeout(ctx, p.readTimeout)
 	defer cancel()
 
-	err := p.db.GetContext(ctx, dest, query)
+	err := p.db.GetContext(ctx, dest, traced, args...)
+	finishSpan(span, err)
 	if err == sql.ErrNoRows {
 		return ErrNoRows
 	}
 
-	return errors.Wrapf(err, "db: [Get] failed on %q query", query)
+	return errors.Wrapf(err, "db: [Get] failed on %q query, args:%v", query, args)
 }
 
 // NameExec is the wrapper of sqlx NamedExecContext.
 func (p *postgres) NamedExec(ctx context.Context, query string, arg interface{}) (sql.Result, error) {
+	span, ctx, traced := startSpan(ctx, query)
 	ctx, cancel := context.WithTimeout(ctx, p.writeTimeout)
 	defer cancel()
 
-	result, err := p.db.NamedExecContext(ctx, query, arg)
+	result, err := p.db.NamedExecContext(ctx, traced, arg)
+	finishSpan(span, err)
 	if err != nil {
 		return nil, errors.Wrapf(err, "db: [NamedExec] failed query:%q, arg:%v", query, arg)
 	}
@@ -109,16 +145,25 @@ type postgresTransaction struct {
 	tx     *sqlx.Tx
 	ctx    context.Context
 	cancel context.CancelFunc
+	span   tracing.Span
 	err    error
 }
 
-// Begin begins a transaction.
-func (p *postgres) Begin() (DatabaseTransaction, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), p.transactionMaxTimeout)
+// Begin begins a transaction, ctx only parents the span of the transaction,
+// the transaction is bounded by the max timeout instead.
+func (p *postgres) Begin(ctx context.Context) (DatabaseTransaction, error) {
+	span, _ := tracing.StartSpan(ctx, "postgres.transaction",
+		tracing.WithKind(tracing.KindClient),
+		tracing.WithService("postgres"),
+		tracing.WithTag("db.system", "postgresql"),
+	)
+	ctx, cancel := context.WithTimeout(tracing.ContextWithSpan(context.Background(), span), p.transactionMaxTimeout)
 
 	tx, err := p.db.BeginTxx(ctx, nil)
 	if err != nil {
 		cancel()
+		span.SetError(err)
+		span.Finish()
 		return nil, errors.Wrapf(err, "db: [Begin] BeginTxx failed")
 	}
 
@@ -126,6 +171,7 @@ func (p *postgres) Begin() (DatabaseTransaction, error) {
 		tx:     tx,
 		ctx:    ctx,
 		cancel: cancel,
+		span:   span,
 	}, nil
 }
 
@@ -136,9 +182,12 @@ func (p *postgresTransaction) Select(dest interface{}, query string) {
 		return
 	}
 
-	err := p.tx.SelectContext(p.ctx, dest, query)
+	span, ctx, traced := startSpan(p.ctx, query)
+	err := p.tx.SelectContext(ctx, dest, traced)
+	finishSpan(span, err)
 	if err != nil {
 		defer p.cancel()
+		defer p.finish(err)
 		if er := p.tx.Rollback(); er != nil {
 			err = errors.Wrapf(err, "db: [transaction Select] rollback failed:%v", er)
 		}
@@ -154,9 +203,12 @@ func (p *postgresTransaction) Get(dest interface{}, query string) {
 		return
 	}
 
-	err := p.tx.GetContext(p.ctx, dest, query)
+	span, ctx, traced := startSpan(p.ctx, query)
+	err := p.tx.GetContext(ctx, dest, traced)
+	finishSpan(span, err)
 	if err != nil {
 		defer p.cancel()
+		defer p.finish(err)
 		if er := p.tx.Rollback(); er != nil {
 			err = errors.Wrapf(err, "db: [transaction Get] rollback failed:%v", er)
 		}
@@ -172,9 +224,12 @@ func (p *postgresTransaction) NamedExec(query string, arg interface{}) sql.Resul
 		return nil
 	}
 
-	result, err := p.tx.NamedExecContext(p.ctx, query, arg)
+	span, ctx, traced := startSpan(p.ctx, query)
+	result, err := p.tx.NamedExecContext(ctx, traced, arg)
+	finishSpan(span, err)
 	if err != nil {
 		defer p.cancel()
+		defer p.finish(err)
 		if er := p.tx.Rollback(); er != nil {
 			err = errors.Wrapf(err, "db: [transaction NamedExec] rollback failed:%v", er)
 		}
@@ -195,4 +250,11 @@ func (p *postgresTransaction) Commit() {
 
 	defer p.cancel()
 	p.err = errors.Wrapf(p.tx.Commit(), "db: [transaction Commit] commit failed")
+	p.finish(p.err)
+}
+
+// finish finishes the span of the transaction, it is called once the transaction is committed or rolled back.
+func (p *postgresTransaction) finish(err error) {
+	p.span.SetError(err)
+	p.span.Finish()
 }
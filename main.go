@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"net"
 	"net/http"
 	"os"
@@ -10,24 +11,50 @@ import (
 	"time"
 
 	"github.com/rs/zerolog"
-	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 
+	"github.com/honestbee/Zen/analytics"
+	"github.com/honestbee/Zen/antispam"
+	"github.com/honestbee/Zen/auth"
+	"github.com/honestbee/Zen/certs"
 	"github.com/honestbee/Zen/config"
 	"github.com/honestbee/Zen/examiner"
+	"github.com/honestbee/Zen/gateway"
 	"github.com/honestbee/Zen/grpc"
+	"github.com/honestbee/Zen/handlers"
+	"github.com/honestbee/Zen/health"
 	"github.com/honestbee/Zen/models"
+	"github.com/honestbee/Zen/persisted"
+	"github.com/honestbee/Zen/purge"
+	"github.com/honestbee/Zen/redact"
 	"github.com/honestbee/Zen/resolvers"
 	"github.com/honestbee/Zen/router"
+	"github.com/honestbee/Zen/subscription"
+	"github.com/honestbee/Zen/tracing"
 	"github.com/honestbee/Zen/zendesk"
 )
 
 func main() {
-	logger := zerolog.New(os.Stderr).With().Timestamp().Logger().Level(zerolog.InfoLevel)
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+	logger := zerolog.New(redact.NewWriter(os.Stderr)).With().Timestamp().Logger()
 
 	conf, err := config.New()
 	if err != nil {
 		logger.Fatal().Err(err).Msgf("new config file failed")
 	}
+	setLogLevel(conf)
+	if conf.HTTP.BasicAuthPwd != "" {
+		logger.Warn().Msgf("http_basic_auth_pwd is deprecated, set the bcrypt hash of the password by http_basic_auth_pwd_hash instead")
+	}
+
+	// Start the tracer of the configured exporter, the spans are exported until the server is shut down.
+	tracer, err := tracing.New(conf, &logger)
+	if err != nil {
+		logger.Fatal().Err(err).Msgf("new tracer failed")
+	}
+	tracing.SetTracer(tracer)
+	defer tracer.Stop()
 
 	service, err := models.New(conf)
 	if err != nil {
@@ -39,61 +66,151 @@ func main() {
 		logger.Fatal().Err(err).Msgf("new zendesk failed")
 	}
 
-	exam, err := examiner.NewExaminer(conf, &logger, service, zend)
+	purger, err := purge.NewPurger(conf)
+	if err != nil {
+		logger.Fatal().Err(err).Msgf("new purger failed")
+	}
+
+	exam, err := examiner.NewExaminer(conf, &logger, service, zend, purger)
 	if err != nil {
 		logger.Fatal().Err(err).Msgf("new examiner failed")
 	}
 
-	grpcSvr, err := grpc.New(conf, &logger, service, exam, zend)
+	broker, err := subscription.New(conf, &logger, service)
+	if err != nil {
+		logger.Fatal().Err(err).Msgf("new subscription broker failed")
+	}
+
+	authn, err := auth.New(conf)
+	if err != nil {
+		logger.Fatal().Err(err).Msgf("new authenticator failed")
+	}
+
+	certStore, err := certs.New(conf, &logger)
+	if err != nil {
+		logger.Fatal().Err(err).Msgf("new certificate store failed")
+	}
+
+	checker, err := health.New(conf, &logger, service, zend, exam)
+	if err != nil {
+		logger.Fatal().Err(err).Msgf("new health checker failed")
+	}
+
+	flusher, err := analytics.New(conf, &logger, service)
+	if err != nil {
+		logger.Fatal().Err(err).Msgf("new analytics flusher failed")
+	}
+
+	grpcSvr, err := grpc.New(conf, &logger, service, exam, zend, broker, checker, authn)
 	if err != nil {
 		logger.Fatal().Err(err).Msgf("new grpc failed")
 	}
 
-	graphql, err := resolvers.New(conf, &logger, service, exam, zend)
+	var gw *gateway.Gateway
+	if conf.GRPC.GatewayEnable {
+		if gw, err = gateway.New(conf, &logger, certStore.MutualTLS()); err != nil {
+			logger.Fatal().Err(err).Msgf("new grpc gateway failed")
+		}
+	}
+
+	verifier, err := antispam.NewVerifier(conf)
+	if err != nil {
+		logger.Fatal().Err(err).Msgf("new antispam verifier failed")
+	}
+
+	guard, err := antispam.New(conf, &logger, service, verifier)
+	if err != nil {
+		logger.Fatal().Err(err).Msgf("new antispam guard failed")
+	}
+
+	// Apply the reloadable settings on SIGHUP or when the config file is changed.
+	watcher, err := config.NewWatcher(conf, &logger)
+	if err != nil {
+		logger.Fatal().Err(err).Msgf("new config watcher failed")
+	}
+	watcher.Subscribe(setLogLevel)
+	watcher.Subscribe(exam.Reload)
+	watcher.Subscribe(guard.Reload)
+	defer watcher.Close()
+
+	graphql, err := resolvers.New(conf, &logger, service, exam, zend, guard, broker, authn, checker)
 	if err != nil {
 		logger.Fatal().Err(err).Msgf("new graphql failed")
 	}
 
-	hmux, err := router.New(conf, &logger, service, exam, zend, graphql)
+	store, err := persisted.New(conf, &logger, service)
+	if err != nil {
+		logger.Fatal().Err(err).Msgf("new persisted query store failed")
+	}
+
+	hmux, err := router.New(conf, &logger, service, exam, zend, graphql, guard, store, authn, gw, checker)
 	if err != nil {
 		logger.Fatal().Err(err).Msgf("new router failed")
 	}
 
+	// The client ips are taken from X-Forwarded-For only as far as the trusted proxies added it.
+	proxies, err := conf.HTTP.TrustedProxyNets()
+	if err != nil {
+		logger.Fatal().Err(err).Msgf("parse trusted proxies failed")
+	}
+	readTimeout := time.Duration(conf.HTTP.ReadTimeoutSec) * time.Second
+	writeTimeout := time.Duration(conf.HTTP.WriteTimeoutSec) * time.Second
+	var handler http.Handler = handlers.RemoteIPMiddleware(proxies, hmux)
 	srv := &http.Server{
 		Addr:         conf.HTTP.ListenAddr,
-		Handler:      hmux,
-		ReadTimeout:  time.Duration(conf.HTTP.ReadTimeoutSec) * time.Second,
-		WriteTimeout: time.Duration(conf.HTTP.WriteTimeoutSec) * time.Second,
+		ReadTimeout:  readTimeout,
+		WriteTimeout: writeTimeout,
 		IdleTimeout:  time.Duration(conf.HTTP.IdleTimeoutSec) * time.Second,
 	}
+	if conf.GRPC.ShareHTTPPort {
+		// The server timeouts would cut off the gRPC streams, so only the headers are limited
+		// by the server and the http handlers are limited by their own deadlines.
+		handler = handlers.DeadlineMiddleware(readTimeout, writeTimeout, handler)
+		srv.ReadTimeout, srv.WriteTimeout, srv.ReadHeaderTimeout = 0, 0, readTimeout
 
-	if conf.Datadog.Enable {
-		// Start the datadog tracer with options.
-		var ddHost, ddPort string
-		if ddHost = os.Getenv("DATADOG_HOST"); ddHost == "" {
-			ddHost = conf.Datadog.Host
+		// The browsers sharing the listener are not asked for client certificates,
+		// the gRPC requests without a verified one are rejected by the handler instead.
+		handler = grpc.ShareHandler(grpcSvr, handler, certStore.MutualTLS())
+		if !certStore.Enabled() {
+			handler = h2c.NewHandler(handler, &http2.Server{})
 		}
-		if ddPort = os.Getenv("DATADOG_APM_PORT"); ddPort == "" {
-			ddPort = conf.Datadog.Port
+	}
+	srv.Handler = handler
+
+	if certStore.Enabled() {
+		clientAuth := tls.NoClientCert
+		if conf.GRPC.ShareHTTPPort || gw != nil {
+			// The gRPC requests and the gateway requests are verified by their client certificates.
+			clientAuth = tls.VerifyClientCertIfGiven
 		}
-		tracer.Start(
-			tracer.WithServiceName("helpcenter-zendesk"),
-			tracer.WithGlobalTag("env", conf.Datadog.Env),
-			tracer.WithAgentAddr(ddHost+":"+ddPort),
-			tracer.WithDebugMode(conf.Datadog.Debug),
-		)
-		defer tracer.Stop()
+		srv.TLSConfig = certStore.TLSConfig(clientAuth)
 	}
 
 	done := make(chan os.Signal, 1)
 	signal.Notify(done, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
 
+	if gw != nil {
+		go func() {
+			if err := grpcSvr.Serve(gw.Listener()); err != nil {
+				logger.Error().Err(err).Msgf("grpc gateway serve error")
+			}
+		}()
+	}
+
 	notify := make(chan struct{})
 	go func() {
+		if conf.GRPC.ShareHTTPPort {
+			close(notify)
+			return
+		}
+
 		grpcLis, err := net.Listen("tcp", conf.GRPC.ListenAddr)
 		if err != nil {
 			logger.Error().Err(err).Msgf("grpc server listen error")
 		}
+		if certStore.Enabled() {
+			grpcLis = tls.NewListener(grpcLis, certStore.TLSConfig(tls.RequireAndVerifyClientCert))
+		}
 
 		close(notify)
 
@@ -104,7 +221,12 @@ func main() {
 
 	go func() {
 		<-notify
-		if err := srv.ListenAndServe(); err != nil {
+		serve := srv.ListenAndServe
+		if certStore.Enabled() {
+			// The certificates are provided by the TLS config.
+			serve = func() error { return srv.ListenAndServeTLS("", "") }
+		}
+		if err := serve(); err != nil {
 			if err == http.ErrServerClosed {
 				logger.Info().Msgf("http server close")
 			} else {
@@ -125,8 +247,31 @@ func main() {
 		logger.Error().Err(err).Msgf("https server shutdown failed")
 	}
 
+	if gw != nil {
+		if err = gw.Close(); err != nil {
+			logger.Error().Err(err).Msgf("grpc gateway close failed")
+		}
+	}
+
 	grpcSvr.GracefulStop()
 
+	// Closing the broker ends the subscriptions of the hijacked websocket connections.
+	if err = broker.Close(); err != nil {
+		logger.Error().Err(err).Msgf("subscription broker close failed")
+	}
+
+	if err = certStore.Close(); err != nil {
+		logger.Error().Err(err).Msgf("certificate store close failed")
+	}
+
+	if err = checker.Close(); err != nil {
+		logger.Error().Err(err).Msgf("health checker close failed")
+	}
+
+	if err = flusher.Close(); err != nil {
+		logger.Error().Err(err).Msgf("analytics flusher close failed")
+	}
+
 	if err = service.Close(); err != nil {
 		logger.Error().Err(err).Msgf("service close failed")
 	}
@@ -137,3 +282,12 @@ func main() {
 
 	logger.Info().Msgf("server shutdown")
 }
+
+// setLogLevel sets the global log level by the config, the level is validated by the config.
+func setLogLevel(conf *config.Config) {
+	level, err := zerolog.ParseLevel(conf.Log.Level)
+	if err != nil {
+		return
+	}
+	zerolog.SetGlobalLevel(level)
+}
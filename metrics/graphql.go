@@ -0,0 +1,93 @@
+package metrics
+
+import (
+	"context"
+	"regexp"
+	"sync"
+	"time"
+
+	gographqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/graph-gophers/graphql-go/introspection"
+	"github.com/graph-gophers/graphql-go/trace"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// maxGraphQLOperations is the max number of the operation names observed, the operation names
+// are given by the clients so the later ones are observed as "other".
+const maxGraphQLOperations = 100
+
+// operationNameRegexp matches the graphql names.
+var operationNameRegexp = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]{0,63}$`)
+
+var (
+	graphqlOperations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "graphql",
+		Name:      "operations_total",
+		Help:      "The graphql operations by the operation name and the result.",
+	}, []string{"operation", "result"})
+
+	graphqlDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "graphql",
+		Name:      "operation_duration_seconds",
+		Help:      "The duration of the graphql operations by the operation name.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation"})
+
+	operationsMu sync.Mutex
+	operations   = make(map[string]bool)
+)
+
+// Tracer wraps a graphql tracer and observes the operations.
+type Tracer struct {
+	tracer trace.Tracer
+}
+
+// NewTracer returns a Tracer instance wrapping t.
+func NewTracer(t trace.Tracer) *Tracer {
+	return &Tracer{tracer: t}
+}
+
+// TraceQuery implements trace.Tracer.
+func (t *Tracer) TraceQuery(ctx context.Context, queryString string, operationName string, variables map[string]interface{}, varTypes map[string]*introspection.Type) (context.Context, trace.TraceQueryFinishFunc) {
+	start := time.Now()
+	operation := operationLabel(operationName)
+
+	ctx, finish := t.tracer.TraceQuery(ctx, queryString, operationName, variables, varTypes)
+	return ctx, func(errs []*gographqlerrors.QueryError) {
+		result := "ok"
+		if len(errs) > 0 {
+			result = "error"
+		}
+		graphqlOperations.WithLabelValues(operation, result).Inc()
+		graphqlDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+		finish(errs)
+	}
+}
+
+// TraceField implements trace.Tracer, the fields are not observed.
+func (t *Tracer) TraceField(ctx context.Context, label, typeName, fieldName string, trivial bool, args map[string]interface{}) (context.Context, trace.TraceFieldFinishFunc) {
+	return t.tracer.TraceField(ctx, label, typeName, fieldName, trivial, args)
+}
+
+// operationLabel returns the label of the operation name, the unnamed operations are "anonymous"
+// and the invalid names or the names over maxGraphQLOperations are "other".
+func operationLabel(name string) string {
+	if name == "" {
+		return "anonymous"
+	}
+	if !operationNameRegexp.MatchString(name) {
+		return "other"
+	}
+
+	operationsMu.Lock()
+	defer operationsMu.Unlock()
+	if !operations[name] {
+		if len(operations) >= maxGraphQLOperations {
+			return "other"
+		}
+		operations[name] = true
+	}
+	return name
+}
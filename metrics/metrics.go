@@ -0,0 +1,119 @@
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "zen"
+
+// registry keeps the metrics of the server only, so the metrics of the imported packages are not exposed by accident.
+var registry = prometheus.NewRegistry()
+
+var (
+	dataloaderCache = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "dataloader",
+		Name:      "cache_requests_total",
+		Help:      "The dataloader cache lookups by the loader and the result, hit or miss.",
+	}, []string{"loader", "result"})
+
+	examinerQueueDepth = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "examiner",
+		Name:      "queue_depth",
+		Help:      "The examiner tasks waiting for a worker.",
+	})
+
+	examinerSyncDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "examiner",
+		Name:      "sync_duration_seconds",
+		Help:      "The duration of the syncs with zendesk by the item and the result.",
+		Buckets:   []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120},
+	}, []string{"item", "result"})
+
+	zendeskRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "zendesk",
+		Name:      "requests_total",
+		Help:      "The zendesk api calls by the subdomain and the response status, error means no response.",
+	}, []string{"subdomain", "code"})
+
+	zendeskDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "zendesk",
+		Name:      "request_duration_seconds",
+		Help:      "The duration of the zendesk api calls by the subdomain.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"subdomain"})
+)
+
+func init() {
+	registry.MustRegister(
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+		httpRequests, httpDuration,
+		graphqlOperations, graphqlDuration,
+		grpcRequests, grpcDuration,
+		dataloaderCache,
+		examinerQueueDepth, examinerSyncDuration,
+		zendeskRequests, zendeskDuration,
+		dbStats,
+	)
+}
+
+// Handler returns the handler serving the metrics in the Prometheus text format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// DataloaderCache counts a dataloader cache lookup of the loader.
+func DataloaderCache(loader string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	dataloaderCache.WithLabelValues(loader, result).Inc()
+}
+
+// SetExaminerQueueDepth sets the number of the examiner tasks waiting for a worker.
+func SetExaminerQueueDepth(depth int) {
+	examinerQueueDepth.Set(float64(depth))
+}
+
+// ObserveExaminerSync observes the duration of a sync of the item since start.
+func ObserveExaminerSync(item string, start time.Time, err error) {
+	examinerSyncDuration.WithLabelValues(item, result(err)).Observe(time.Since(start).Seconds())
+}
+
+// ObserveZendeskRequest observes a zendesk api call to host since start, status is 0 if there's no response.
+func ObserveZendeskRequest(host string, status int, start time.Time) {
+	subdomain := strings.SplitN(host, ".", 2)[0]
+	code := "error"
+	if status != 0 {
+		code = strconv.Itoa(status)
+	}
+	zendeskRequests.WithLabelValues(subdomain, code).Inc()
+	zendeskDuration.WithLabelValues(subdomain).Observe(time.Since(start).Seconds())
+}
+
+// SetDBStats sets the function returning the stats of the database pool, the latest one is collected.
+func SetDBStats(stats func() sql.DBStats) {
+	dbStats.mu.Lock()
+	defer dbStats.mu.Unlock()
+	dbStats.stats = stats
+}
+
+func result(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "ok"
+}
@@ -0,0 +1,227 @@
+package metrics
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/honestbee/Zen/errs"
+)
+
+func TestHandle(t *testing.T) {
+	testCases := [...]struct {
+		description string
+		route       string
+		upgrade     string
+		status      int
+		expectCode  string
+		expectCount float64
+	}{
+		{
+			description: "testing default status case",
+			route:       "/api/default",
+			expectCode:  "200",
+			expectCount: 1,
+		},
+		{
+			description: "testing written status case",
+			route:       "/api/written",
+			status:      http.StatusNotFound,
+			expectCode:  "404",
+			expectCount: 1,
+		},
+		{
+			description: "testing websocket upgrade case",
+			route:       "/api/websocket",
+			upgrade:     "websocket",
+			expectCode:  "200",
+			expectCount: 0,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			h := Handle(tt.route, func(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+				if tt.status != 0 {
+					w.WriteHeader(tt.status)
+					w.WriteHeader(http.StatusTeapot)
+				}
+			})
+			r := httptest.NewRequest(http.MethodGet, tt.route, nil)
+			if tt.upgrade != "" {
+				r.Header.Set("Upgrade", tt.upgrade)
+			}
+			h(httptest.NewRecorder(), r, nil)
+
+			count := testutil.ToFloat64(httpRequests.WithLabelValues(http.MethodGet, tt.route, tt.expectCode))
+			if count != tt.expectCount {
+				t.Errorf("[%s] expect count:%v, actual:%v", tt.description, tt.expectCount, count)
+			}
+		})
+	}
+}
+
+func TestOperationLabel(t *testing.T) {
+	operationsMu.Lock()
+	operations = make(map[string]bool)
+	for i := 0; i < maxGraphQLOperations-1; i++ {
+		operations[fmt.Sprintf("op%d", i)] = true
+	}
+	operationsMu.Unlock()
+
+	testCases := [...]struct {
+		description string
+		name        string
+		expect      string
+	}{
+		{
+			description: "testing anonymous case",
+			name:        "",
+			expect:      "anonymous",
+		},
+		{
+			description: "testing invalid name case",
+			name:        "get articles",
+			expect:      "other",
+		},
+		{
+			description: "testing new name case",
+			name:        "getArticles",
+			expect:      "getArticles",
+		},
+		{
+			description: "testing known name case",
+			name:        "op1",
+			expect:      "op1",
+		},
+		{
+			description: "testing over limit case",
+			name:        "getSections",
+			expect:      "other",
+		},
+	}
+
+	for _, tt := range testCases {
+		if actual := operationLabel(tt.name); actual != tt.expect {
+			t.Errorf("[%s] expect label:%s, actual:%s", tt.description, tt.expect, actual)
+		}
+	}
+}
+
+func TestGRPCCode(t *testing.T) {
+	testCases := [...]struct {
+		description string
+		err         error
+		expect      codes.Code
+	}{
+		{
+			description: "testing nil error case",
+			err:         nil,
+			expect:      codes.OK,
+		},
+		{
+			description: "testing errs error case",
+			err:         errs.NewErr(errs.RecordNotFoundErrorCode, errors.New("not found")),
+			expect:      codes.NotFound,
+		},
+		{
+			description: "testing status error case",
+			err:         status.Error(codes.Unauthenticated, "unauthenticated"),
+			expect:      codes.Unauthenticated,
+		},
+		{
+			description: "testing plain error case",
+			err:         errors.New("failed"),
+			expect:      codes.Unknown,
+		},
+	}
+
+	for _, tt := range testCases {
+		if actual := grpcCode(tt.err); actual != tt.expect {
+			t.Errorf("[%s] expect code:%v, actual:%v", tt.description, tt.expect, actual)
+		}
+	}
+}
+
+func TestObserveZendeskRequest(t *testing.T) {
+	testCases := [...]struct {
+		description     string
+		host            string
+		status          int
+		expectSubdomain string
+		expectCode      string
+	}{
+		{
+			description:     "testing response case",
+			host:            "honestbee-sg.zendesk.com",
+			status:          http.StatusTooManyRequests,
+			expectSubdomain: "honestbee-sg",
+			expectCode:      "429",
+		},
+		{
+			description:     "testing no response case",
+			host:            "honestbee-tw.zendesk.com",
+			expectSubdomain: "honestbee-tw",
+			expectCode:      "error",
+		},
+	}
+
+	for _, tt := range testCases {
+		ObserveZendeskRequest(tt.host, tt.status, time.Now())
+		if count := testutil.ToFloat64(zendeskRequests.WithLabelValues(tt.expectSubdomain, tt.expectCode)); count != 1 {
+			t.Errorf("[%s] expect count:1, actual:%v", tt.description, count)
+		}
+	}
+}
+
+func TestDataloaderCache(t *testing.T) {
+	DataloaderCache("test", true)
+	DataloaderCache("test", false)
+	DataloaderCache("test", false)
+
+	if hit := testutil.ToFloat64(dataloaderCache.WithLabelValues("test", "hit")); hit != 1 {
+		t.Errorf("expect hit:1, actual:%v", hit)
+	}
+	if miss := testutil.ToFloat64(dataloaderCache.WithLabelValues("test", "miss")); miss != 2 {
+		t.Errorf("expect miss:2, actual:%v", miss)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	SetExaminerQueueDepth(3)
+	ObserveExaminerSync("category", time.Now(), errors.New("failed"))
+	SetDBStats(func() sql.DBStats {
+		return sql.DBStats{MaxOpenConnections: 10, OpenConnections: 4, InUse: 1, Idle: 3}
+	})
+	defer SetDBStats(nil)
+
+	w := httptest.NewRecorder()
+	Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expect status:%d, actual:%d", http.StatusOK, w.Code)
+	}
+
+	for _, expect := range []string{
+		"zen_examiner_queue_depth 3",
+		`zen_examiner_sync_duration_seconds_count{item="category",result="error"} 1`,
+		"zen_db_max_open_connections 10",
+		"zen_db_open_connections 4",
+		"zen_db_in_use_connections 1",
+		"zen_db_idle_connections 3",
+		"go_goroutines",
+	} {
+		if !strings.Contains(w.Body.String(), expect) {
+			t.Errorf("expect body contains:%s, actual:%s", expect, w.Body.String())
+		}
+	}
+}
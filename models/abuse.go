@@ -0,0 +1,101 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+	"github.com/pkg/errors"
+
+	"github.com/honestbee/Zen/internal/cache"
+	"github.com/honestbee/Zen/internal/db"
+)
+
+const (
+	requestRateCounterForm = "zen_request_rate_counter_%s_%s"
+	requestDigestForm      = "zen_request_digest_%s"
+)
+
+// incrWindowScript increases the counter and opens its window if the counter has no ttl, in one step,
+// so that a failure between the two never leaves a counter without the window.
+const incrWindowScript = `
+local count = redis.call("INCR", KEYS[1])
+if redis.call("TTL", KEYS[1]) < 0 then
+	redis.call("EXPIRE", KEYS[1], ARGV[1])
+end
+return count`
+
+type abuseService interface {
+	PlusOneRequestRateCounter(ctx context.Context, kind, identity string, windowSec int) (int, error)
+	MarkRequestDigest(ctx context.Context, digest string, windowSec int) (bool, error)
+	UnmarkRequestDigest(ctx context.Context, digest string) error
+	CreateRejectedRequest(ctx context.Context, request *RejectedRequest) error
+}
+
+// RejectedRequest is the rejected create request submission model.
+type RejectedRequest struct {
+	Source      string    `json:"source"`
+	Reason      string    `json:"reason"`
+	RemoteIP    string    `json:"remote_ip"`
+	CountryCode string    `json:"country_code"`
+	Email       string    `json:"email"`
+	Subject     string    `json:"subject"`
+	Body        string    `json:"body"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type abuseOps struct {
+	db    db.Database
+	cache cache.Cache
+}
+
+const (
+	insertRejectedRequestQuery = `
+	INSERT INTO rejected_requests (source, reason, remote_ip, country_code, email, subject, body, created_at)
+	VALUES (:source, :reason, :remote_ip, :country_code, :email, :subject, :body, :created_at)`
+)
+
+// PlusOneRequestRateCounter increases the request counter of the identity and
+// returns the count inside the current window.
+func (a *abuseOps) PlusOneRequestRateCounter(ctx context.Context, kind, identity string, windowSec int) (int, error) {
+	key := fmt.Sprintf(requestRateCounterForm, kind, identity)
+	reply, err := a.cache.IntDo(ctx, "EVAL", incrWindowScript, 1, key, windowSec)
+	if err != nil {
+		return 0, errors.Wrapf(err, "models: [PlusOneRequestRateCounter] cache IntDo failed")
+	}
+	return reply, nil
+}
+
+// MarkRequestDigest marks the request content digest inside the window,
+// returns false if the digest has already been marked.
+func (a *abuseOps) MarkRequestDigest(ctx context.Context, digest string, windowSec int) (bool, error) {
+	reply, err := a.cache.StringDo(ctx, "SET", fmt.Sprintf(requestDigestForm, digest), true, "EX", windowSec, "NX")
+	if err == redis.ErrNil {
+		// SET NX replies nil when the key exists.
+		return false, nil
+	}
+	return reply == "OK", errors.Wrapf(err, "models: [MarkRequestDigest] cache StringDo failed")
+}
+
+// UnmarkRequestDigest removes the mark of the request content digest,
+// so that the same content is accepted again.
+func (a *abuseOps) UnmarkRequestDigest(ctx context.Context, digest string) error {
+	_, err := a.cache.IntDo(ctx, "DEL", fmt.Sprintf(requestDigestForm, digest))
+	return errors.Wrapf(err, "models: [UnmarkRequestDigest] cache IntDo failed")
+}
+
+// CreateRejectedRequest records the rejected submission for review.
+func (a *abuseOps) CreateRejectedRequest(ctx context.Context, request *RejectedRequest) error {
+	_, err := a.db.NamedExec(ctx, insertRejectedRequestQuery, &db.RejectedRequests{
+		Source:      request.Source,
+		Reason:      request.Reason,
+		RemoteIP:    request.RemoteIP,
+		CountryCode: request.CountryCode,
+		Email:       request.Email,
+		Subject:     request.Subject,
+		Body:        request.Body,
+		CreatedAt:   request.CreatedAt,
+	})
+	return errors.Wrapf(err, "models: [CreateRejectedRequest] db named exec failed")
+}
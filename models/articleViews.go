@@ -0,0 +1,173 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/honestbee/Zen/internal/cache"
+	"github.com/honestbee/Zen/internal/db"
+)
+
+const (
+	// articleViewsBufferKey is the hash of the views not flushed yet,
+	// the fields are "day|country_code|locale|article_id" and the values are the views.
+	articleViewsBufferKey = "zen_article_views_buffer"
+	articleViewsDayLayout = "2006-01-02"
+)
+
+// takeHashScript returns all the fields and values of the hash and deletes it,
+// so the views recorded during the flush are kept for the next flush.
+const takeHashScript = `
+local fields = redis.call("HGETALL", KEYS[1])
+redis.call("DEL", KEYS[1])
+return fields`
+
+type articleViewsService interface {
+	RecordArticleView(ctx context.Context, articleID int, locale, countryCode string) error
+	FlushArticleViews(ctx context.Context) (int, error)
+}
+
+type articleViewsOps struct {
+	db    db.Database
+	cache cache.Cache
+}
+
+const (
+	upsertArticleViewsQuery = `
+	INSERT INTO article_views (article_id, country_code, locale, day, views)
+	VALUES (:article_id, :country_code, :locale, :day, :views)
+	ON CONFLICT (article_id, country_code, locale, day) DO UPDATE SET views = article_views.views + EXCLUDED.views`
+
+	// The all-time click_count is kept for ranking without a time window.
+	plusArticleClickCountQuery = `UPDATE articles SET click_count = click_count + :views WHERE id = :article_id AND country_code = :country_code`
+)
+
+// RecordArticleView buffers a view of the article on today in UTC, it is flushed by FlushArticleViews.
+func (a *articleViewsOps) RecordArticleView(ctx context.Context, articleID int, locale, countryCode string) error {
+	field := strings.Join([]string{
+		time.Now().UTC().Format(articleViewsDayLayout),
+		countryCode,
+		locale,
+		strconv.Itoa(articleID),
+	}, "|")
+	_, err := a.cache.IntDo(ctx, "HINCRBY", articleViewsBufferKey, field, 1)
+	return errors.Wrapf(err, "models: [RecordArticleView] cache IntDo failed")
+}
+
+// FlushArticleViews adds the buffered views into the daily rollup and the click count of the articles,
+// returns the number of the flushed rollup rows. The views are put back into the buffer if the flush failed.
+func (a *articleViewsOps) FlushArticleViews(ctx context.Context) (int, error) {
+	fields, err := a.cache.StringsDo(ctx, "EVAL", takeHashScript, 1, articleViewsBufferKey)
+	if err != nil {
+		return 0, errors.Wrapf(err, "models: [FlushArticleViews] cache StringsDo failed")
+	}
+	if len(fields) == 0 {
+		return 0, nil
+	}
+
+	rows := make([]*db.ArticleViews, 0, len(fields)/2)
+	for i := 0; i+1 < len(fields); i += 2 {
+		row, err := parseArticleViews(fields[i], fields[i+1])
+		if err != nil {
+			// The malformed field can't be flushed, so it's dropped instead of put back.
+			continue
+		}
+		rows = append(rows, row)
+	}
+
+	tx, err := a.db.Begin(ctx)
+	if err != nil {
+		restoreBuffer(ctx, a.cache, articleViewsBufferKey, fields)
+		return 0, errors.Wrapf(err, "models: [FlushArticleViews] db.Begin failed")
+	}
+	for _, row := range rows {
+		tx.NamedExec(upsertArticleViewsQuery, row)
+		tx.NamedExec(plusArticleClickCountQuery, row)
+	}
+	tx.Commit()
+
+	if err = tx.Err(); err != nil {
+		restoreBuffer(ctx, a.cache, articleViewsBufferKey, fields)
+		return 0, errors.Wrapf(err, "models: [FlushArticleViews] db transaction failed")
+	}
+	return len(rows), nil
+}
+
+// restoreBuffer puts the taken fields back into the buffer, the failure is ignored
+// since the analytics are not worth blocking the flush.
+func restoreBuffer(ctx context.Context, c cache.Cache, key string, fields []string) {
+	for i := 0; i+1 < len(fields); i += 2 {
+		c.IntDo(ctx, "HINCRBY", key, fields[i], fields[i+1])
+	}
+}
+
+func parseArticleViews(field, value string) (*db.ArticleViews, error) {
+	parts := strings.Split(field, "|")
+	if len(parts) != 4 {
+		return nil, errors.Errorf("models: [parseArticleViews] invalid field:%q", field)
+	}
+	day, err := time.Parse(articleViewsDayLayout, parts[0])
+	if err != nil {
+		return nil, errors.Wrapf(err, "models: [parseArticleViews] parse day failed")
+	}
+	articleID, err := strconv.Atoi(parts[3])
+	if err != nil {
+		return nil, errors.Wrapf(err, "models: [parseArticleViews] parse article id failed")
+	}
+	views, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, errors.Wrapf(err, "models: [parseArticleViews] parse views failed")
+	}
+	return &db.ArticleViews{
+		ArticleID:   articleID,
+		CountryCode: parts[1],
+		Locale:      parts[2],
+		Day:         day,
+		Views:       views,
+	}, nil
+}
+
+// topNArticlesQuery returns the query selecting the filtered articles ranked by the views of the window,
+// the articles are ranked by the all-time click count without a window or a view in the window.
+func topNArticlesQuery(params *GetTopNArticlesParams) string {
+	filter := ""
+	if params.SectionID != 0 {
+		filter += fmt.Sprintf(" AND articles.section_id = '%d'", params.SectionID)
+	}
+	if params.CategoryID != 0 {
+		filter += fmt.Sprintf(
+			" AND articles.section_id IN (SELECT id FROM sections WHERE category_id = '%d' AND country_code = '%s')",
+			params.CategoryID, params.CountryCode,
+		)
+	}
+
+	order := "articles.promoted DESC, articles.click_count DESC"
+	join := ""
+	if params.WindowDays > 0 {
+		order = "articles.promoted DESC, COALESCE(windowed.views, 0) DESC, articles.click_count DESC"
+		join = fmt.Sprintf(
+			`LEFT JOIN (SELECT article_id, SUM(views) AS views FROM article_views
+			WHERE country_code = '%s' AND locale = '%s' AND day > '%s'
+			GROUP BY article_id) windowed ON windowed.article_id = articles.id`,
+			params.CountryCode, params.Locale,
+			// The days are recorded in UTC, the window includes today.
+			time.Now().UTC().AddDate(0, 0, -params.WindowDays).Format(articleViewsDayLayout),
+		)
+	}
+
+	return fmt.Sprintf(
+		`SELECT articles.section_id,articles.id,articles.author_id,articles.comments_disable,articles.draft,
+		articles.promoted,articles.position,articles.vote_sum,articles.vote_count,articles.created_at,
+		articles.updated_at,articles.source_locale,articles.outdated,articles.outdated_locales,
+		articles.edited_at,articles.label_names,articles.country_code
+		FROM articles %s
+		WHERE articles.country_code = '%s'%s
+		ORDER BY %s LIMIT %d`,
+		join, params.CountryCode, filter, order, params.TopN,
+	)
+}
@@ -0,0 +1,204 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/honestbee/Zen/internal/cache"
+	"github.com/honestbee/Zen/internal/db"
+)
+
+const (
+	// articleVoteLockForm is the lock of a voter voting an article, the votes of a voter are forwarded one by one.
+	articleVoteLockForm = "zen_article_vote_lock_%s_%d_%s"
+	// articleVoteLockSec is the expiry of the lock in case the voting crashes.
+	articleVoteLockSec = 30
+	// articleVoteIPCounterForm is the counter of the votes from an ip on an article.
+	articleVoteIPCounterForm = "zen_article_vote_ip_%s_%d_%s"
+
+	// UpVote is the helpful vote.
+	UpVote = "up"
+	// DownVote is the unhelpful vote.
+	DownVote = "down"
+)
+
+type articleVotesService interface {
+	LockArticleVote(ctx context.Context, articleID int, countryCode, voter string) (bool, error)
+	UnlockArticleVote(ctx context.Context, articleID int, countryCode, voter string) error
+	PlusOneArticleVoteIPCounter(ctx context.Context, articleID int, countryCode, ip string, windowSec int) (int, error)
+	GetArticleVote(ctx context.Context, articleID int, countryCode, voter string) (*ArticleVote, error)
+	SaveArticleVote(ctx context.Context, vote *ArticleVote, previousValue string) error
+	GetArticleVoteHistory(ctx context.Context, params *GetArticleVoteHistoryParams) ([]*ArticleVoteDay, error)
+}
+
+// ArticleVote is the vote of a voter on an article kept in the local ledger.
+type ArticleVote struct {
+	ArticleID   int    `json:"article_id"`
+	CountryCode string `json:"country_code"`
+	Voter       string `json:"voter"`
+	Value       string `json:"value"`
+	// ZendeskVoteID is the id of the vote forwarded to zendesk, it is deleted when the vote is changed.
+	ZendeskVoteID int64     `json:"zendesk_vote_id"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// GetArticleVoteHistoryParams is the params of GetArticleVoteHistory, the days are in the last WindowDays days.
+type GetArticleVoteHistoryParams struct {
+	ArticleID   int
+	WindowDays  int
+	CountryCode string
+}
+
+// ArticleVoteDay is the votes of an article on a day in UTC. Votes is the number of the votes cast or changed on the day,
+// Upvotes and Downvotes are the ledger totals at the end of the day.
+type ArticleVoteDay struct {
+	Day              string  `json:"day"`
+	Votes            int     `json:"votes"`
+	Upvotes          int     `json:"upvotes"`
+	Downvotes        int     `json:"downvotes"`
+	HelpfulnessRatio float64 `json:"helpfulness_ratio"`
+}
+
+type articleVotesOps struct {
+	db    db.Database
+	cache cache.Cache
+}
+
+const (
+	upsertArticleVoteQuery = `
+	INSERT INTO article_votes (article_id, country_code, voter, value, zendesk_vote_id, created_at, updated_at)
+	VALUES (:article_id, :country_code, :voter, :value, :zendesk_vote_id, :created_at, :updated_at)
+	ON CONFLICT (article_id, country_code, voter) DO UPDATE SET
+	value = EXCLUDED.value, zendesk_vote_id = EXCLUDED.zendesk_vote_id, updated_at = EXCLUDED.updated_at`
+
+	insertArticleVoteEventQuery = `
+	INSERT INTO article_vote_events (article_id, country_code, voter, value, previous_value, created_at)
+	VALUES (:article_id, :country_code, :voter, :value, :previous_value, :created_at)`
+)
+
+// LockArticleVote locks the votes of the voter on the article, false is returned if it is locked by another vote.
+func (a *articleVotesOps) LockArticleVote(ctx context.Context, articleID int, countryCode, voter string) (bool, error) {
+	key := fmt.Sprintf(articleVoteLockForm, countryCode, articleID, voter)
+	reply, err := a.cache.StringDo(ctx, "SET", key, true, "EX", articleVoteLockSec, "NX")
+	return reply == "OK", errors.Wrapf(err, "models: [LockArticleVote] cache StringDo failed")
+}
+
+// UnlockArticleVote unlocks the votes of the voter on the article.
+func (a *articleVotesOps) UnlockArticleVote(ctx context.Context, articleID int, countryCode, voter string) error {
+	_, err := a.cache.IntDo(ctx, "DEL", fmt.Sprintf(articleVoteLockForm, countryCode, articleID, voter))
+	return errors.Wrapf(err, "models: [UnlockArticleVote] cache IntDo failed")
+}
+
+// PlusOneArticleVoteIPCounter increases the counter of the votes from the ip on the article and
+// returns the count inside the current window.
+func (a *articleVotesOps) PlusOneArticleVoteIPCounter(ctx context.Context, articleID int, countryCode, ip string, windowSec int) (int, error) {
+	key := fmt.Sprintf(articleVoteIPCounterForm, countryCode, articleID, ip)
+	reply, err := a.cache.IntDo(ctx, "EVAL", incrWindowScript, 1, key, windowSec)
+	if err != nil {
+		return 0, errors.Wrapf(err, "models: [PlusOneArticleVoteIPCounter] cache IntDo failed")
+	}
+	return reply, nil
+}
+
+// GetArticleVote returns the vote of the voter on the article, ErrNotFound is returned if the voter hasn't voted.
+func (a *articleVotesOps) GetArticleVote(ctx context.Context, articleID int, countryCode, voter string) (*ArticleVote, error) {
+	row := new(db.ArticleVotes)
+	query := `SELECT article_id, country_code, voter, value, zendesk_vote_id, created_at, updated_at
+		FROM article_votes WHERE article_id = $1 AND country_code = $2 AND voter = $3`
+	if err := a.db.Get(ctx, row, query, articleID, countryCode, voter); err != nil {
+		if err == db.ErrNoRows {
+			return nil, ErrNotFound
+		}
+		return nil, errors.Wrapf(err, "models: [GetArticleVote] db query failed")
+	}
+
+	return &ArticleVote{
+		ArticleID:     row.ArticleID,
+		CountryCode:   row.CountryCode,
+		Voter:         row.Voter,
+		Value:         row.Value,
+		ZendeskVoteID: row.ZendeskVoteID,
+		CreatedAt:     row.CreatedAt,
+		UpdatedAt:     row.UpdatedAt,
+	}, nil
+}
+
+// SaveArticleVote keeps the vote in the ledger and records the change from the previous value in the history,
+// the previous value is empty for the first vote of the voter.
+func (a *articleVotesOps) SaveArticleVote(ctx context.Context, vote *ArticleVote, previousValue string) error {
+	now := time.Now().UTC()
+	createdAt := vote.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = now
+	}
+
+	tx, err := a.db.Begin(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "models: [SaveArticleVote] db.Begin failed")
+	}
+	tx.NamedExec(upsertArticleVoteQuery, &db.ArticleVotes{
+		ArticleID:     vote.ArticleID,
+		CountryCode:   vote.CountryCode,
+		Voter:         vote.Voter,
+		Value:         vote.Value,
+		ZendeskVoteID: vote.ZendeskVoteID,
+		CreatedAt:     createdAt,
+		UpdatedAt:     now,
+	})
+	tx.NamedExec(insertArticleVoteEventQuery, &db.ArticleVoteEvents{
+		ArticleID:     vote.ArticleID,
+		CountryCode:   vote.CountryCode,
+		Voter:         vote.Voter,
+		Value:         vote.Value,
+		PreviousValue: previousValue,
+		CreatedAt:     now,
+	})
+	tx.Commit()
+
+	return errors.Wrapf(tx.Err(), "models: [SaveArticleVote] db transaction failed")
+}
+
+// GetArticleVoteHistory returns the votes of the article per day in the window, the earliest first.
+// The days without any vote are skipped.
+func (a *articleVotesOps) GetArticleVoteHistory(ctx context.Context, params *GetArticleVoteHistoryParams) ([]*ArticleVoteDay, error) {
+	// The totals are summed up from the first vote, so the votes before the window are counted.
+	since := time.Now().UTC().AddDate(0, 0, -params.WindowDays).Format(articleViewsDayLayout)
+	query := fmt.Sprintf(
+		`SELECT day, votes, upvotes, downvotes,
+		CASE WHEN upvotes + downvotes > 0 THEN upvotes::float / (upvotes + downvotes) ELSE 0 END AS helpfulness_ratio
+		FROM (
+			SELECT day, COUNT(*) AS votes,
+			SUM(SUM(CASE WHEN value = '%s' THEN 1 ELSE 0 END - CASE WHEN previous_value = '%s' THEN 1 ELSE 0 END)) OVER (ORDER BY day) AS upvotes,
+			SUM(SUM(CASE WHEN value = '%s' THEN 1 ELSE 0 END - CASE WHEN previous_value = '%s' THEN 1 ELSE 0 END)) OVER (ORDER BY day) AS downvotes
+			FROM (SELECT (created_at AT TIME ZONE 'UTC')::date AS day, value, previous_value FROM article_vote_events
+			WHERE article_id = %d AND country_code = '%s') events
+			GROUP BY day
+		) history
+		WHERE day > '%s'
+		ORDER BY day ASC`,
+		UpVote, UpVote, DownVote, DownVote,
+		params.ArticleID, params.CountryCode,
+		since,
+	)
+
+	days := make([]*db.ArticleVoteDays, 0)
+	if err := a.db.Select(ctx, &days, query); err != nil {
+		return nil, errors.Wrapf(err, "models: [GetArticleVoteHistory] db query failed")
+	}
+
+	ret := make([]*ArticleVoteDay, len(days))
+	for i, day := range days {
+		ret[i] = &ArticleVoteDay{
+			Day:              day.Day.Format(articleViewsDayLayout),
+			Votes:            day.Votes,
+			Upvotes:          day.Upvotes,
+			Downvotes:        day.Downvotes,
+			HelpfulnessRatio: day.HelpfulnessRatio,
+		}
+	}
+	return ret, nil
+}
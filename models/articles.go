@@ -12,14 +12,14 @@ import (
 )
 
 type articlesService interface {
-	SyncWithArticles(ctx context.Context, zendeskArticles []*Article, countryCode, locale string) error
-	SyncWithArticle(ctx context.Context, articleID int, zendeskArticle *Article, countryCode, locale string) error
+	SyncWithArticles(ctx context.Context, zendeskArticles []*Article, countryCode, locale string) ([]int, error)
+	SyncWithArticle(ctx context.Context, articleID int, zendeskArticle *Article, countryCode, locale string) ([]int, error)
 	GetArticles(ctx context.Context, params *GetArticlesParams) ([]*Article, int, error)
 	GetArticlesByCategoryID(ctx context.Context, params *GetArticlesParams, labels []string) ([]*Article, int, error)
 	GetArticlesBySectionID(ctx context.Context, params *GetArticlesParams) ([]*Article, int, error)
 	GetArticleByArticleID(ctx context.Context, articleID int, locale, countryCode string) (*Article, error)
-	GetTopNArticles(ctx context.Context, topN uint64, locale, countryCode string) ([]*Article, error)
-	PlusOneArticleClickCounter(ctx context.Context, articleID int, locale, countryCode string) error
+	GetTopNArticles(ctx context.Context, params *GetTopNArticlesParams) ([]*Article, error)
+	ExportArticles(ctx context.Context, params *ExportArticlesParams) ([]*Article, error)
 }
 
 // Article is the article model.
@@ -58,7 +58,8 @@ type SearchArticle struct {
 }
 
 type articlesOps struct {
-	db db.Database
+	db    db.Database
+	dcOps *dynamicContentOps
 }
 
 // GetArticlesParams is the params structure of requesting GetArticles method.
@@ -71,6 +72,37 @@ type GetArticlesParams struct {
 	Page        int
 	SortBy      string
 	SortOrder   string
+	// After selects the rows sorted after the cursor, Page should be zero if it is set.
+	After *Cursor
+	// Keyset sorts the rows by keysetOrder for the listings paged by the cursors, it is required by After.
+	Keyset bool
+}
+
+// MaxTopNArticlesWindowDays is the longest time window of ranking the top articles.
+const MaxTopNArticlesWindowDays = 365
+
+// GetTopNArticlesParams is the params structure of requesting GetTopNArticles method.
+type GetTopNArticlesParams struct {
+	TopN        uint64
+	Locale      string
+	CountryCode string
+	// WindowDays ranks the articles by the views of the last days including today,
+	// the all-time click count is used if it is zero.
+	WindowDays int
+	// SectionID and CategoryID filter the articles if they are not zero.
+	SectionID  int
+	CategoryID int
+}
+
+// ExportArticlesParams is the params structure of requesting ExportArticles method.
+type ExportArticlesParams struct {
+	Locale      string
+	CountryCode string
+	// Since selects the articles updated at or after it, all the articles are selected if it is zero.
+	Since time.Time
+	// After selects the articles sorted after the cursor by updated_at and id.
+	After *Cursor
+	Limit int
 }
 
 const (
@@ -150,21 +182,23 @@ const (
 	deleteArticleTranslatesQuery = "DELETE FROM article_translates WHERE article_id = :article_id AND locale = :locale"
 )
 
-// SyncWithArticles ensures the database data will be same as the input data.
-func (a *articlesOps) SyncWithArticles(ctx context.Context, zendeskArticles []*Article, countryCode, locale string) error {
-	tx, err := a.db.Begin()
+// SyncWithArticles ensures the database data will be same as the input data,
+// the ids of the created, updated and deleted articles are returned.
+func (a *articlesOps) SyncWithArticles(ctx context.Context, zendeskArticles []*Article, countryCode, locale string) ([]int, error) {
+	tx, err := a.db.Begin(ctx)
 	if err != nil {
-		return errors.Wrapf(err, "models: [SyncWithArticles] db.Begin failed")
+		return nil, errors.Wrapf(err, "models: [SyncWithArticles] db.Begin failed")
 	}
 
-	query := fmt.Sprintf(`SELECT id FROM articles WHERE country_code = '%s'`, countryCode)
-	ids := make([]int, 0)
-	tx.Select(&ids, query)
+	query := fmt.Sprintf(`SELECT id, updated_at FROM articles WHERE country_code = '%s'`, countryCode)
+	rows := make([]*db.RowVersion, 0)
+	tx.Select(&rows, query)
 
-	dbIDs := make(map[int]struct{})
-	for _, id := range ids {
-		dbIDs[id] = struct{}{}
+	dbIDs := make(map[int]time.Time)
+	for _, row := range rows {
+		dbIDs[row.ID] = row.UpdatedAt
 	}
+	changedIDs := make([]int, 0)
 
 	for _, zendeskArticle := range zendeskArticles {
 		dbArticle := &db.Articles{
@@ -196,27 +230,33 @@ func (a *articlesOps) SyncWithArticles(ctx context.Context, zendeskArticles []*A
 			URL:       zendeskArticle.URL,
 		}
 
-		if _, exist := dbIDs[zendeskArticle.ID]; exist {
+		if updatedAt, exist := dbIDs[zendeskArticle.ID]; exist {
 			tx.NamedExec(updateArticlesQuery, dbArticle)
 
-			tranIDs := make([]int, 0)
+			trans := make([]*db.ArticleTranslates, 0)
 			query = fmt.Sprintf(
-				`SELECT article_id FROM article_translates WHERE locale = '%s' AND article_id = '%d'`,
+				`SELECT article_id, name, title, body FROM article_translates WHERE locale = '%s' AND article_id = '%d'`,
 				locale,
 				zendeskArticle.ID,
 			)
-			tx.Select(&tranIDs, query)
+			tx.Select(&trans, query)
 
-			if len(tranIDs) > 0 {
+			if len(trans) > 0 {
 				tx.NamedExec(updateArticleTranslatesQuery, translates)
 			} else {
 				tx.NamedExec(insertArticleTranslatesQuery, translates)
 			}
 
+			if !updatedAt.Equal(zendeskArticle.UpdatedAt) || len(trans) == 0 ||
+				trans[0].Name != translates.Name || trans[0].Title != translates.Title || trans[0].Body != translates.Body {
+				changedIDs = append(changedIDs, zendeskArticle.ID)
+			}
+
 			delete(dbIDs, zendeskArticle.ID)
 		} else {
 			tx.NamedExec(insertArticlesQuery, dbArticle)
 			tx.NamedExec(insertArticleTranslatesQuery, translates)
+			changedIDs = append(changedIDs, zendeskArticle.ID)
 		}
 	}
 
@@ -230,27 +270,33 @@ func (a *articlesOps) SyncWithArticles(ctx context.Context, zendeskArticles []*A
 		if total == 0 {
 			tx.NamedExec(deleteArticlesQuery, map[string]interface{}{"id": id, "country_code": countryCode})
 		}
+		changedIDs = append(changedIDs, id)
 	}
 	tx.Commit()
 
-	return errors.Wrapf(tx.Err(), "models: [SyncWithArticles] db transaction failed")
+	if err = tx.Err(); err != nil {
+		return nil, errors.Wrapf(err, "models: [SyncWithArticles] db transaction failed")
+	}
+	return changedIDs, nil
 }
 
-// SyncWithArticle ensures the database data will be same as the input data.
-func (a *articlesOps) SyncWithArticle(ctx context.Context, articleID int, zendeskArticle *Article, countryCode, locale string) error {
-	tx, err := a.db.Begin()
+// SyncWithArticle ensures the database data will be same as the input data,
+// the ids of the created, updated and deleted articles are returned.
+func (a *articlesOps) SyncWithArticle(ctx context.Context, articleID int, zendeskArticle *Article, countryCode, locale string) ([]int, error) {
+	tx, err := a.db.Begin(ctx)
 	if err != nil {
-		return errors.Wrapf(err, "models: [SyncWithArticle] db.Begin failed")
+		return nil, errors.Wrapf(err, "models: [SyncWithArticle] db.Begin failed")
 	}
 
-	query := fmt.Sprintf(`SELECT id FROM articles WHERE id = %d AND country_code = '%s'`, articleID, countryCode)
-	ids := make([]int, 0)
-	tx.Select(&ids, query)
+	query := fmt.Sprintf(`SELECT id, updated_at FROM articles WHERE id = %d AND country_code = '%s'`, articleID, countryCode)
+	rows := make([]*db.RowVersion, 0)
+	tx.Select(&rows, query)
 
-	dbIDs := make(map[int]struct{})
-	for _, id := range ids {
-		dbIDs[id] = struct{}{}
+	dbIDs := make(map[int]time.Time)
+	for _, row := range rows {
+		dbIDs[row.ID] = row.UpdatedAt
 	}
+	changedIDs := make([]int, 0)
 
 	if zendeskArticle != nil {
 		dbArticle := &db.Articles{
@@ -282,27 +328,33 @@ func (a *articlesOps) SyncWithArticle(ctx context.Context, articleID int, zendes
 			URL:       zendeskArticle.URL,
 		}
 
-		if _, exist := dbIDs[zendeskArticle.ID]; exist {
+		if updatedAt, exist := dbIDs[zendeskArticle.ID]; exist {
 			tx.NamedExec(updateArticlesQuery, dbArticle)
 
-			tranIDs := make([]int, 0)
+			trans := make([]*db.ArticleTranslates, 0)
 			query = fmt.Sprintf(
-				`SELECT article_id FROM article_translates WHERE locale = '%s' AND article_id = '%d'`,
+				`SELECT article_id, name, title, body FROM article_translates WHERE locale = '%s' AND article_id = '%d'`,
 				locale,
 				zendeskArticle.ID,
 			)
-			tx.Select(&tranIDs, query)
+			tx.Select(&trans, query)
 
-			if len(tranIDs) > 0 {
+			if len(trans) > 0 {
 				tx.NamedExec(updateArticleTranslatesQuery, translates)
 			} else {
 				tx.NamedExec(insertArticleTranslatesQuery, translates)
 			}
 
+			if !updatedAt.Equal(zendeskArticle.UpdatedAt) || len(trans) == 0 ||
+				trans[0].Name != translates.Name || trans[0].Title != translates.Title || trans[0].Body != translates.Body {
+				changedIDs = append(changedIDs, zendeskArticle.ID)
+			}
+
 			delete(dbIDs, zendeskArticle.ID)
 		} else {
 			tx.NamedExec(insertArticlesQuery, dbArticle)
 			tx.NamedExec(insertArticleTranslatesQuery, translates)
+			changedIDs = append(changedIDs, zendeskArticle.ID)
 		}
 	}
 
@@ -316,10 +368,14 @@ func (a *articlesOps) SyncWithArticle(ctx context.Context, articleID int, zendes
 		if total == 0 {
 			tx.NamedExec(deleteArticlesQuery, map[string]interface{}{"id": id, "country_code": countryCode})
 		}
+		changedIDs = append(changedIDs, id)
 	}
 	tx.Commit()
 
-	return errors.Wrapf(tx.Err(), "models: [SyncWithArticle] db transaction failed")
+	if err = tx.Err(); err != nil {
+		return nil, errors.Wrapf(err, "models: [SyncWithArticle] db transaction failed")
+	}
+	return changedIDs, nil
 }
 
 func (a *articlesOps) GetArticles(ctx context.Context, params *GetArticlesParams) ([]*Article, int, error) {
@@ -329,10 +385,10 @@ func (a *articlesOps) GetArticles(ctx context.Context, params *GetArticlesParams
 		vote_sum,vote_count,created_at,updated_at,source_locale,outdated,
 		outdated_locales,edited_at,label_names,country_code 
 		FROM articles WHERE country_code = '%s' 
-		ORDER BY %s %s, created_at DESC LIMIT %d OFFSET %d`,
+		%s ORDER BY %s LIMIT %d OFFSET %d`,
 		params.CountryCode,
-		params.SortBy,
-		params.SortOrder,
+		keysetCondition(params.After, params.SortBy, params.SortOrder),
+		keysetOrder(params.Keyset, params.SortBy, params.SortOrder),
 		params.PerPage,
 		params.Page,
 	)
@@ -354,6 +410,9 @@ func (a *articlesOps) GetArticles(ctx context.Context, params *GetArticlesParams
 			}
 			return nil, 0, errors.Wrapf(err, "models: [GetArticles] db get translates failed")
 		}
+		if err := a.dcOps.renderFields(ctx, params.Locale, &translate.Name, &translate.Title, &translate.Body); err != nil {
+			return nil, 0, errors.Wrapf(err, "models: [GetArticles] render dynamic content failed")
+		}
 
 		ret = append(ret, &Article{
 			SectionID:       article.SectionID,
@@ -415,11 +474,11 @@ func (c *categoriesOps) GetArticlesByCategoryID(ctx context.Context, params *Get
 		vote_sum,vote_count,created_at,updated_at,source_locale,outdated,
 		outdated_locales,edited_at,label_names,country_code 
 		FROM articles WHERE %s country_code = '%s' 
-		ORDER BY %s %s, created_at DESC LIMIT %d OFFSET %d`,
+		%s ORDER BY %s LIMIT %d OFFSET %d`,
 		queryLabelNames,
 		params.CountryCode,
-		params.SortBy,
-		params.SortOrder,
+		keysetCondition(params.After, params.SortBy, params.SortOrder),
+		keysetOrder(params.Keyset, params.SortBy, params.SortOrder),
 		params.PerPage,
 		params.Page,
 	)
@@ -442,6 +501,9 @@ func (c *categoriesOps) GetArticlesByCategoryID(ctx context.Context, params *Get
 			}
 			return nil, 0, errors.Wrapf(err, "models: [GetArticlesByCategoryID] db get translates failed")
 		}
+		if err := c.dcOps.renderFields(ctx, params.Locale, &translates.Name, &translates.Title, &translates.Body); err != nil {
+			return nil, 0, errors.Wrapf(err, "models: [GetArticlesByCategoryID] render dynamic content failed")
+		}
 
 		ret = append(ret, &Article{
 			SectionID:       article.SectionID,
@@ -492,11 +554,11 @@ func (s *sectionsOps) GetArticlesBySectionID(ctx context.Context, params *GetArt
 		vote_sum,vote_count,created_at,updated_at,source_locale,outdated,
 		outdated_locales,edited_at,label_names,country_code 
 		FROM articles WHERE country_code = '%s' AND section_id = '%d' 
-		ORDER BY %s %s, created_at DESC LIMIT %d OFFSET %d`,
+		%s ORDER BY %s LIMIT %d OFFSET %d`,
 		params.CountryCode,
 		params.SectionID,
-		params.SortBy,
-		params.SortOrder,
+		keysetCondition(params.After, params.SortBy, params.SortOrder),
+		keysetOrder(params.Keyset, params.SortBy, params.SortOrder),
 		params.PerPage,
 		params.Page,
 	)
@@ -519,6 +581,9 @@ func (s *sectionsOps) GetArticlesBySectionID(ctx context.Context, params *GetArt
 			}
 			return nil, 0, errors.Wrapf(err, "models: [GetArticlesBySectionID] db get translates failed")
 		}
+		if err := s.dcOps.renderFields(ctx, params.Locale, &translates.Name, &translates.Title, &translates.Body); err != nil {
+			return nil, 0, errors.Wrapf(err, "models: [GetArticlesBySectionID] render dynamic content failed")
+		}
 
 		ret = append(ret, &Article{
 			SectionID:       article.SectionID,
@@ -595,6 +660,9 @@ func (a *articlesOps) GetArticleByArticleID(ctx context.Context, articleID int,
 			return nil, errors.Wrapf(err, "models: [GetArticle] db get translates failed")
 		}
 	}
+	if err := a.dcOps.renderFields(ctx, locale, &translates.Name, &translates.Title, &translates.Body); err != nil {
+		return nil, errors.Wrapf(err, "models: [GetArticleByArticleID] render dynamic content failed")
+	}
 
 	ret := &Article{
 		SectionID:       article.SectionID,
@@ -625,49 +693,22 @@ func (a *articlesOps) GetArticleByArticleID(ctx context.Context, articleID int,
 	return ret, nil
 }
 
-// PlusOneArticleClickCounter plus one on article click count.
-func (a *articlesOps) PlusOneArticleClickCounter(ctx context.Context, articleID int, locale, countryCode string) error {
-	dbArticle := &db.Articles{
-		CountryCode: countryCode,
-		ID:          articleID,
-	}
-
-	_, err := a.db.NamedExec(ctx,
-		`UPDATE articles SET click_count = click_count + 1 WHERE id = :id AND country_code = :country_code`,
-		dbArticle)
-
-	if err != nil {
-		return errors.Wrapf(err, "models: [PlusOneArticleClickCounter] update db article click count failed")
-	}
-	return nil
-}
-
 // GetTopNArticles get topN articles.
-func (a *articlesOps) GetTopNArticles(ctx context.Context, topN uint64, locale, countryCode string) ([]*Article, error) {
+func (a *articlesOps) GetTopNArticles(ctx context.Context, params *GetTopNArticlesParams) ([]*Article, error) {
 	articles := make([]*db.Articles, 0)
-	// Sorts with promoted=t and click_count descend order, also limit topN.
-	query := fmt.Sprintf(
-		`SELECT section_id,id,author_id,comments_disable,draft,promoted,position,
-		vote_sum,vote_count,created_at,updated_at,source_locale,outdated,outdated_locales,
-		edited_at,label_names,country_code 
-		FROM articles WHERE country_code = '%s' 
-		ORDER BY promoted DESC, click_count DESC LIMIT %d`,
-		countryCode,
-		topN,
-	)
-
-	if err := a.db.Select(ctx, &articles, query); err != nil {
+	// Sorts with promoted=t and the views descend order, also limit topN.
+	if err := a.db.Select(ctx, &articles, topNArticlesQuery(params)); err != nil {
 		return nil, errors.Wrapf(err, "models: [GetTopNArticles] db select articles failed")
 	}
 
 	ret := make([]*Article, 0)
 	for _, article := range articles {
 		translate := new(db.ArticleTranslates)
-		query = fmt.Sprintf(
+		query := fmt.Sprintf(
 			`SELECT url,html_url,name,title,body,locale 
 			FROM article_translates WHERE article_id = '%d' AND locale = '%s'`,
 			article.ID,
-			locale,
+			params.Locale,
 		)
 
 		if err := a.db.Get(ctx, translate, query); err != nil {
@@ -676,6 +717,9 @@ func (a *articlesOps) GetTopNArticles(ctx context.Context, topN uint64, locale,
 			}
 			return nil, errors.Wrapf(err, "models: [GetTopNArticles] db get translate failed")
 		}
+		if err := a.dcOps.renderFields(ctx, params.Locale, &translate.Name, &translate.Title, &translate.Body); err != nil {
+			return nil, errors.Wrapf(err, "models: [GetTopNArticles] render dynamic content failed")
+		}
 
 		ret = append(ret, &Article{
 			SectionID:       article.SectionID,
@@ -706,3 +750,62 @@ func (a *articlesOps) GetTopNArticles(ctx context.Context, topN uint64, locale,
 
 	return ret, nil
 }
+
+// ExportArticles returns the articles sorted by updated_at and id by one query,
+// the articles are paged by the cursor of the last article.
+func (a *articlesOps) ExportArticles(ctx context.Context, params *ExportArticlesParams) ([]*Article, error) {
+	afterUpdatedAt, afterID := params.Since, 0
+	if params.After != nil {
+		afterUpdatedAt, afterID = params.After.UpdatedAt, params.After.ID
+	}
+
+	rows := make([]*db.Article, 0)
+	query := `SELECT articles.section_id,articles.id,articles.author_id,articles.comments_disable,articles.draft,
+		articles.promoted,articles.position,articles.vote_sum,articles.vote_count,articles.created_at,
+		articles.updated_at,articles.source_locale,articles.outdated,articles.outdated_locales,
+		articles.edited_at,articles.label_names,articles.country_code,
+		article_translates.url,article_translates.html_url,article_translates.name,
+		article_translates.title,article_translates.body,article_translates.locale
+		FROM articles 
+		INNER JOIN article_translates ON article_translates.article_id = articles.id AND article_translates.locale = $2 
+		WHERE articles.country_code = $1 AND (articles.updated_at, articles.id) > ($3, $4) 
+		ORDER BY articles.updated_at ASC, articles.id ASC LIMIT $5`
+	if err := a.db.Select(ctx, &rows, query, params.CountryCode, params.Locale, afterUpdatedAt, afterID, params.Limit); err != nil && err != db.ErrNoRows {
+		return nil, errors.Wrapf(err, "models: [ExportArticles] db select articles failed")
+	}
+
+	ret := make([]*Article, 0, len(rows))
+	for _, row := range rows {
+		if err := a.dcOps.renderFields(ctx, params.Locale, &row.Name, &row.Title, &row.Body); err != nil {
+			return nil, errors.Wrapf(err, "models: [ExportArticles] render dynamic content failed")
+		}
+
+		ret = append(ret, &Article{
+			SectionID:       row.SectionID,
+			ID:              row.ID,
+			AuthorID:        row.AuthorID,
+			CommentsDisable: row.CommentsDisable,
+			Draft:           row.Draft,
+			Promoted:        row.Promoted,
+			Position:        row.Position,
+			VoteSum:         row.VoteSum,
+			VoteCount:       row.VoteCount,
+			CreatedAt:       row.CreatedAt,
+			UpdatedAt:       row.UpdatedAt,
+			SourceLocale:    row.SourceLocale,
+			Outdated:        row.Outdated,
+			OutdatedLocales: row.OutdatedLocales,
+			EditedAt:        row.EditedAt,
+			LabelNames:      row.LabelNames,
+			CountryCode:     row.CountryCode,
+			URL:             row.URL,
+			HTMLURL:         row.HTMLURL,
+			Name:            row.Name,
+			Title:           row.Title,
+			Body:            row.Body,
+			Locale:          row.Locale,
+		})
+	}
+
+	return ret, nil
+}
@@ -6,19 +6,22 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 
 	"github.com/honestbee/Zen/internal/db"
 )
 
 type categoriesService interface {
-	SyncWithCategories(ctx context.Context, zendeskCategories []*Category, countryCode, locale string) error
+	SyncWithCategories(ctx context.Context, zendeskCategories []*Category, countryCode, locale string) ([]int, error)
 	GetCategoriesID(ctx context.Context, countryCode string) ([]int, error)
 	GetCategories(ctx context.Context, params *GetCategoriesParams) ([]*Category, int, error)
 	GetCategoryKeyNameToID(ctx context.Context, keyName, countryCode string) (int, error)
 	GetCategoryByArticleID(ctx context.Context, articleID int, locale string) (*Category, error)
 	GetCategoryBySectionID(ctx context.Context, sectionID int, locale string) (*Category, error)
 	GetCategoryByCategoryIDOrKeyName(ctx context.Context, idOrKeyName, locale, countryCode string) (*Category, error)
+	GetCategoriesByIDs(ctx context.Context, categoryIDs []int, locale, countryCode string) (map[int]*Category, error)
+	GetCategoriesByArticleIDs(ctx context.Context, articleIDs []int, locale string) (map[int]*Category, error)
 }
 
 // Category is the Category model.
@@ -39,7 +42,8 @@ type Category struct {
 }
 
 type categoriesOps struct {
-	db db.Database
+	db    db.Database
+	dcOps *dynamicContentOps
 }
 
 // GetCategoriesParams is the params structure of requesting GetCategories method.
@@ -50,6 +54,10 @@ type GetCategoriesParams struct {
 	Page        int
 	SortBy      string
 	SortOrder   string
+	// After selects the rows sorted after the cursor, Page should be zero if it is set.
+	After *Cursor
+	// Keyset sorts the rows by keysetOrder for the listings paged by the cursors, it is required by After.
+	Keyset bool
 }
 
 const (
@@ -82,21 +90,23 @@ const (
 	deleteCategoryTranslatesQuery = "DELETE FROM category_translates WHERE category_id = :category_id AND locale = :locale"
 )
 
-// SyncWithCategories ensures the database data will be same as the input data.
-func (c *categoriesOps) SyncWithCategories(ctx context.Context, zendeskCategories []*Category, countryCode, locale string) error {
-	tx, err := c.db.Begin()
+// SyncWithCategories ensures the database data will be same as the input data,
+// the ids of the created, updated and deleted categories are returned.
+func (c *categoriesOps) SyncWithCategories(ctx context.Context, zendeskCategories []*Category, countryCode, locale string) ([]int, error) {
+	tx, err := c.db.Begin(ctx)
 	if err != nil {
-		return errors.Wrapf(err, "models: [SyncWithCategories] db.Begin failed")
+		return nil, errors.Wrapf(err, "models: [SyncWithCategories] db.Begin failed")
 	}
 
-	ids := make([]int, 0)
-	query := fmt.Sprintf(`SELECT id FROM categories WHERE country_code = '%s'`, countryCode)
-	tx.Select(&ids, query)
+	rows := make([]*db.RowVersion, 0)
+	query := fmt.Sprintf(`SELECT id, updated_at FROM categories WHERE country_code = '%s'`, countryCode)
+	tx.Select(&rows, query)
 
-	dbIDs := make(map[int]struct{})
-	for _, id := range ids {
-		dbIDs[id] = struct{}{}
+	dbIDs := make(map[int]time.Time)
+	for _, row := range rows {
+		dbIDs[row.ID] = row.UpdatedAt
 	}
+	changedIDs := make([]int, 0)
 
 	for _, zendeskCategory := range zendeskCategories {
 		dbCategory := &db.Categories{
@@ -117,27 +127,33 @@ func (c *categoriesOps) SyncWithCategories(ctx context.Context, zendeskCategorie
 			URL:         zendeskCategory.URL,
 		}
 
-		if _, exist := dbIDs[zendeskCategory.ID]; exist {
+		if updatedAt, exist := dbIDs[zendeskCategory.ID]; exist {
 			tx.NamedExec(updateCategoriesQuery, dbCategory)
 
-			tranIDs := make([]int, 0)
+			trans := make([]*db.CategoryTranslates, 0)
 			query = fmt.Sprintf(
-				`SELECT category_id FROM category_translates WHERE locale = '%s' AND category_id = '%d'`,
+				`SELECT category_id, name, description FROM category_translates WHERE locale = '%s' AND category_id = '%d'`,
 				locale,
 				zendeskCategory.ID,
 			)
-			tx.Select(&tranIDs, query)
+			tx.Select(&trans, query)
 
-			if len(tranIDs) > 0 {
+			if len(trans) > 0 {
 				tx.NamedExec(updateCategoryTranslates, translates)
 			} else {
 				tx.NamedExec(insertCategoryTranslatesQuery, translates)
 			}
 
+			if !updatedAt.Equal(zendeskCategory.UpdatedAt) || len(trans) == 0 ||
+				trans[0].Name != translates.Name || trans[0].Description != translates.Description {
+				changedIDs = append(changedIDs, zendeskCategory.ID)
+			}
+
 			delete(dbIDs, zendeskCategory.ID)
 		} else {
 			tx.NamedExec(insertCategoriesQuery, dbCategory)
 			tx.NamedExec(insertCategoryTranslatesQuery, translates)
+			changedIDs = append(changedIDs, zendeskCategory.ID)
 		}
 	}
 
@@ -151,10 +167,14 @@ func (c *categoriesOps) SyncWithCategories(ctx context.Context, zendeskCategorie
 		if total == 0 {
 			tx.NamedExec(deleteCategoriesQuery, map[string]interface{}{"id": id, "country_code": countryCode})
 		}
+		changedIDs = append(changedIDs, id)
 	}
 	tx.Commit()
 
-	return errors.Wrapf(tx.Err(), "models: [SyncWithCategories] db transaction failed")
+	if err = tx.Err(); err != nil {
+		return nil, errors.Wrapf(err, "models: [SyncWithCategories] db transaction failed")
+	}
+	return changedIDs, nil
 }
 
 func (c *categoriesOps) GetCategoriesID(ctx context.Context, countryCode string) ([]int, error) {
@@ -171,10 +191,10 @@ func (c *categoriesOps) GetCategories(ctx context.Context, params *GetCategories
 	query := fmt.Sprintf(
 		`SELECT id,position,created_at,updated_at,source_locale,outdated,country_code 
 		FROM categories WHERE country_code = '%s' 
-		ORDER BY %s %s, created_at DESC LIMIT %d OFFSET %d`,
+		%s ORDER BY %s LIMIT %d OFFSET %d`,
 		params.CountryCode,
-		params.SortBy,
-		params.SortOrder,
+		keysetCondition(params.After, params.SortBy, params.SortOrder),
+		keysetOrder(params.Keyset, params.SortBy, params.SortOrder),
 		params.PerPage,
 		params.Page,
 	)
@@ -197,6 +217,9 @@ func (c *categoriesOps) GetCategories(ctx context.Context, params *GetCategories
 			}
 			return nil, 0, errors.Wrapf(err, "models: [GetCategories] db get category translates failed ")
 		}
+		if err := c.dcOps.renderFields(ctx, params.Locale, &translates.Name, &translates.Description); err != nil {
+			return nil, 0, errors.Wrapf(err, "models: [GetCategories] render dynamic content failed")
+		}
 
 		categoryKey := new(db.CategoryKey)
 		query = fmt.Sprintf(
@@ -292,6 +315,9 @@ func (c *categoriesOps) GetCategoryByArticleID(ctx context.Context, articleID in
 	if err := c.db.Get(ctx, translate, query); err != nil {
 		return nil, errors.Wrapf(err, "models: [GetCategory] db get category_translates failed")
 	}
+	if err := c.dcOps.renderFields(ctx, locale, &translate.Name, &translate.Description); err != nil {
+		return nil, errors.Wrapf(err, "models: [GetCategoryByArticleID] render dynamic content failed")
+	}
 
 	return &Category{
 		ID:           category.ID,
@@ -334,6 +360,9 @@ func (c *categoriesOps) GetCategoryBySectionID(ctx context.Context, sectionID in
 	if err := c.db.Get(ctx, translate, query); err != nil {
 		return nil, errors.Wrapf(err, "models: [GetCategoryBySectionID] db get category_translates failed")
 	}
+	if err := c.dcOps.renderFields(ctx, locale, &translate.Name, &translate.Description); err != nil {
+		return nil, errors.Wrapf(err, "models: [GetCategoryBySectionID] render dynamic content failed")
+	}
 
 	return &Category{
 		ID:           category.ID,
@@ -404,6 +433,9 @@ func (c *categoriesOps) GetCategoryByCategoryIDOrKeyName(ctx context.Context, id
 			return nil, errors.Wrapf(err, "models: [GetCategoryByCategoryIDOrKeyName] db get category_translates failed")
 		}
 	}
+	if err := c.dcOps.renderFields(ctx, locale, &categoryTranslate.Name, &categoryTranslate.Description); err != nil {
+		return nil, errors.Wrapf(err, "models: [GetCategoryByCategoryIDOrKeyName] render dynamic content failed")
+	}
 
 	return &Category{
 		ID:           category.ID,
@@ -421,3 +453,87 @@ func (c *categoriesOps) GetCategoryByCategoryIDOrKeyName(ctx context.Context, id
 		Locale:       categoryTranslate.Locale,
 	}, nil
 }
+
+// GetCategoriesByIDs returns the categories of the ids by one query, the map is keyed by the category id.
+// The ids without category, category key or translates of the locale are not in the map.
+func (c *categoriesOps) GetCategoriesByIDs(ctx context.Context, categoryIDs []int, locale, countryCode string) (map[int]*Category, error) {
+	rows := make([]*db.CategoryWithKey, 0)
+	query := `SELECT categories.id,categories.position,categories.created_at,categories.updated_at,
+		categories.source_locale,categories.outdated,categories.country_code,
+		category_translates.url,category_translates.html_url,category_translates.name,
+		category_translates.description,category_translates.locale,category_key.key_name
+		FROM categories 
+		INNER JOIN category_key ON category_key.category_id = categories.id AND category_key.country_code = categories.country_code 
+		INNER JOIN category_translates ON category_translates.category_id = categories.id AND category_translates.locale = $2 
+		WHERE categories.id = ANY($1) AND categories.country_code = $3`
+	if err := c.db.Select(ctx, &rows, query, int64Array(categoryIDs), locale, countryCode); err != nil && err != db.ErrNoRows {
+		return nil, errors.Wrapf(err, "models: [GetCategoriesByIDs] db select categories failed")
+	}
+
+	ret := make(map[int]*Category, len(rows))
+	for _, row := range rows {
+		if err := c.dcOps.renderFields(ctx, locale, &row.Name, &row.Description); err != nil {
+			return nil, errors.Wrapf(err, "models: [GetCategoriesByIDs] render dynamic content failed")
+		}
+		category := newCategoryFromRow(&row.Category)
+		category.KeyName = row.KeyName
+		ret[row.ID] = category
+	}
+
+	return ret, nil
+}
+
+// GetCategoriesByArticleIDs returns the categories of the articles by one query, the map is keyed by the article id.
+// The articles without category or category translates of the locale are not in the map.
+func (c *categoriesOps) GetCategoriesByArticleIDs(ctx context.Context, articleIDs []int, locale string) (map[int]*Category, error) {
+	rows := make([]*db.ArticleCategory, 0)
+	query := `SELECT articles.id AS article_id,
+		categories.id,categories.position,categories.created_at,categories.updated_at,
+		categories.source_locale,categories.outdated,categories.country_code,
+		category_translates.url,category_translates.html_url,category_translates.name,
+		category_translates.description,category_translates.locale
+		FROM articles 
+		INNER JOIN sections ON sections.id = articles.section_id 
+		INNER JOIN categories ON categories.id = sections.category_id 
+		INNER JOIN category_translates ON category_translates.category_id = categories.id AND category_translates.locale = $2 
+		WHERE articles.id = ANY($1)`
+	if err := c.db.Select(ctx, &rows, query, int64Array(articleIDs), locale); err != nil && err != db.ErrNoRows {
+		return nil, errors.Wrapf(err, "models: [GetCategoriesByArticleIDs] db select categories failed")
+	}
+
+	ret := make(map[int]*Category, len(rows))
+	for _, row := range rows {
+		if err := c.dcOps.renderFields(ctx, locale, &row.Name, &row.Description); err != nil {
+			return nil, errors.Wrapf(err, "models: [GetCategoriesByArticleIDs] render dynamic content failed")
+		}
+		ret[row.ArticleID] = newCategoryFromRow(&row.Category)
+	}
+
+	return ret, nil
+}
+
+func newCategoryFromRow(row *db.Category) *Category {
+	return &Category{
+		ID:           row.ID,
+		Position:     row.Position,
+		CreatedAt:    row.CreatedAt,
+		UpdatedAt:    row.UpdatedAt,
+		SourceLocale: row.SourceLocale,
+		Outdated:     row.Outdated,
+		CountryCode:  row.CountryCode,
+		URL:          row.URL,
+		HTMLURL:      row.HTMLURL,
+		Name:         row.Name,
+		Description:  row.Description,
+		Locale:       row.Locale,
+	}
+}
+
+// int64Array converts the ids to the postgres array parameter of ANY.
+func int64Array(ids []int) pq.Int64Array {
+	ret := make(pq.Int64Array, 0, len(ids))
+	for _, id := range ids {
+		ret = append(ret, int64(id))
+	}
+	return ret
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/honestbee/Zen/internal/cache"
+)
+
+const (
+	costBudgetForm = "zen_cost_budget_%s"
+)
+
+// spendCostBudgetScript adds the cost into the spent cost of the window, the first spending opens
+// the window. The cost is taken back if the budget is exceeded, the spent cost including the cost is returned.
+const spendCostBudgetScript = `
+local spent = redis.call("INCRBY", KEYS[1], ARGV[1])
+if spent == tonumber(ARGV[1]) then
+	redis.call("EXPIRE", KEYS[1], ARGV[3])
+end
+if spent > tonumber(ARGV[2]) then
+	redis.call("DECRBY", KEYS[1], ARGV[1])
+end
+return spent`
+
+type costBudgetService interface {
+	SpendCostBudget(ctx context.Context, client string, cost, budget, windowSec int) (int, bool, error)
+}
+
+type costBudgetOps struct {
+	cache cache.Cache
+}
+
+// SpendCostBudget spends the cost from the budget of the client inside the current window,
+// returns the remaining budget and false if the budget is not enough for the cost.
+func (c *costBudgetOps) SpendCostBudget(ctx context.Context, client string, cost, budget, windowSec int) (int, bool, error) {
+	spent, err := c.cache.IntDo(ctx, "EVAL", spendCostBudgetScript, 1, fmt.Sprintf(costBudgetForm, client), cost, budget, windowSec)
+	if err != nil {
+		return 0, false, errors.Wrapf(err, "models: [SpendCostBudget] cache IntDo failed")
+	}
+
+	if spent > budget {
+		return budget - (spent - cost), false, nil
+	}
+	return budget - spent, true, nil
+}
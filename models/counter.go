@@ -49,83 +49,83 @@ type counterOps struct {
 
 func (c *counterOps) LockCategoriesCounter(ctx context.Context, countryCode, locale string) (bool, error) {
 	key := fmt.Sprintf(categoriesCounterLockForm, countryCode, locale)
-	reply, err := c.cache.StringDo("SET", key, true, "EX", maxLockTimeSec, "NX", ctx)
+	reply, err := c.cache.StringDo(ctx, "SET", key, true, "EX", maxLockTimeSec, "NX")
 	return reply == "OK", errors.Wrapf(err, "models: [LockCategoriesCounter] cache StringDo failed")
 }
 
 func (c *counterOps) LockSectionsCounter(ctx context.Context, countryCode, locale string) (bool, error) {
 	key := fmt.Sprintf(sectionsCounterLockForm, countryCode, locale)
-	reply, err := c.cache.StringDo("SET", key, true, "EX", maxLockTimeSec, "NX", ctx)
+	reply, err := c.cache.StringDo(ctx, "SET", key, true, "EX", maxLockTimeSec, "NX")
 	return reply == "OK", errors.Wrapf(err, "models: [LockSectionsCounter] cache StringDo failed")
 }
 
 func (c *counterOps) LockArticlesCounter(ctx context.Context, countryCode, locale string) (bool, error) {
 	key := fmt.Sprintf(articlesCounterLockForm, countryCode, locale)
-	reply, err := c.cache.StringDo("SET", key, true, "EX", maxLockTimeSec, "NX", ctx)
+	reply, err := c.cache.StringDo(ctx, "SET", key, true, "EX", maxLockTimeSec, "NX")
 	return reply == "OK", errors.Wrapf(err, "models: [LockArticlesCounter] cache StringDo failed")
 }
 
 func (c *counterOps) LockTicketFormsCounter(ctx context.Context) (bool, error) {
-	reply, err := c.cache.StringDo("SET", ticketFormsLockForm, true, "EX", maxLockTimeSec, "NX", ctx)
+	reply, err := c.cache.StringDo(ctx, "SET", ticketFormsLockForm, true, "EX", maxLockTimeSec, "NX")
 	return reply == "OK", errors.Wrapf(err, "models: [LockTicketFormsCounter] cache StringDo failed")
 }
 
 func (c *counterOps) UnlockCategoriesCounter(ctx context.Context, countryCode, locale string) error {
-	_, err := c.cache.BoolDo("DEL", fmt.Sprintf(categoriesCounterLockForm, countryCode, locale), ctx)
+	_, err := c.cache.BoolDo(ctx, "DEL", fmt.Sprintf(categoriesCounterLockForm, countryCode, locale))
 	return errors.Wrapf(err, "models: [UnlockCategoriesCounter] cache BoolDo failed")
 }
 
 func (c *counterOps) UnlockSectionsCounter(ctx context.Context, countryCode, locale string) error {
-	_, err := c.cache.BoolDo("DEL", fmt.Sprintf(sectionsCounterLockForm, countryCode, locale), ctx)
+	_, err := c.cache.BoolDo(ctx, "DEL", fmt.Sprintf(sectionsCounterLockForm, countryCode, locale))
 	return errors.Wrapf(err, "models: [UnlockSectionsCounter] cache BoolDo failed")
 }
 
 func (c *counterOps) UnlockArticlesCounter(ctx context.Context, countryCode, locale string) error {
-	_, err := c.cache.BoolDo("DEL", fmt.Sprintf(articlesCounterLockForm, countryCode, locale), ctx)
+	_, err := c.cache.BoolDo(ctx, "DEL", fmt.Sprintf(articlesCounterLockForm, countryCode, locale))
 	return errors.Wrapf(err, "models: [UnlockArticlesCounter] cache BoolDo failed")
 }
 
 func (c *counterOps) UnlockTicketFormsCounter(ctx context.Context) error {
-	_, err := c.cache.BoolDo("DEL", ticketFormsLockForm, ctx)
+	_, err := c.cache.BoolDo(ctx, "DEL", ticketFormsLockForm)
 	return errors.Wrapf(err, "models: [UnlockTicketFormsCounter] cache BoolDo failed")
 }
 
 func (c *counterOps) PlusOneCategoriesCounter(ctx context.Context, countryCode, locale string) (int, error) {
-	reply, err := c.cache.IntDo("INCR", fmt.Sprintf(categoriesCounterForm, countryCode, locale), ctx)
+	reply, err := c.cache.IntDo(ctx, "INCR", fmt.Sprintf(categoriesCounterForm, countryCode, locale))
 	return reply, errors.Wrapf(err, "models: [PlusOneCategoriesCounter] cache IntDo failed")
 }
 
 func (c *counterOps) PlusOneSectionsCounter(ctx context.Context, countryCode, locale string) (int, error) {
-	reply, err := c.cache.IntDo("INCR", fmt.Sprintf(sectionsCounterForm, countryCode, locale), ctx)
+	reply, err := c.cache.IntDo(ctx, "INCR", fmt.Sprintf(sectionsCounterForm, countryCode, locale))
 	return reply, errors.Wrapf(err, "models: [PlusOneSectionsCounter] cache IntDo failed")
 }
 
 func (c *counterOps) PlusOneArticlesCounter(ctx context.Context, countryCode, locale string) (int, error) {
-	reply, err := c.cache.IntDo("INCR", fmt.Sprintf(articlesCounterForm, countryCode, locale), ctx)
+	reply, err := c.cache.IntDo(ctx, "INCR", fmt.Sprintf(articlesCounterForm, countryCode, locale))
 	return reply, errors.Wrapf(err, "models: [PlusOneArticlesCounter] cache IntDo failed")
 }
 
 func (c *counterOps) PlusOneTicketFormsCounter(ctx context.Context) (int, error) {
-	reply, err := c.cache.IntDo("INCR", ticketFormsCounterForm, ctx)
+	reply, err := c.cache.IntDo(ctx, "INCR", ticketFormsCounterForm)
 	return reply, errors.Wrapf(err, "models: [PlusOneTicketFormsCounter] cache IntDo failed")
 }
 
 func (c *counterOps) ResetCategoriesCounter(ctx context.Context, countryCode, locale string) error {
-	_, err := c.cache.StringDo("SET", fmt.Sprintf(categoriesCounterForm, countryCode, locale), 0, ctx)
+	_, err := c.cache.StringDo(ctx, "SET", fmt.Sprintf(categoriesCounterForm, countryCode, locale), 0)
 	return errors.Wrapf(err, "models: [ResetCategoriesCounter] cache StringDo failed")
 }
 
 func (c *counterOps) ResetSectionsCounter(ctx context.Context, countryCode, locale string) error {
-	_, err := c.cache.StringDo("SET", fmt.Sprintf(sectionsCounterForm, countryCode, locale), 0, ctx)
+	_, err := c.cache.StringDo(ctx, "SET", fmt.Sprintf(sectionsCounterForm, countryCode, locale), 0)
 	return errors.Wrapf(err, "models: [ResetSectionsCounter] cache StringDo failed")
 }
 
 func (c *counterOps) ResetArticlesCounter(ctx context.Context, countryCode, locale string) error {
-	_, err := c.cache.StringDo("SET", fmt.Sprintf(articlesCounterForm, countryCode, locale), 0, ctx)
+	_, err := c.cache.StringDo(ctx, "SET", fmt.Sprintf(articlesCounterForm, countryCode, locale), 0)
 	return errors.Wrapf(err, "models: [ResetArticlesCounter] cache StringDo failed")
 }
 
 func (c *counterOps) ResetTicketFormsCounter(ctx context.Context) error {
-	_, err := c.cache.StringDo("SET", ticketFormsCounterForm, 0, ctx)
+	_, err := c.cache.StringDo(ctx, "SET", ticketFormsCounterForm, 0)
 	return errors.Wrapf(err, "models: [ResetTicketFormsCounter] cache StringDo failed")
 }
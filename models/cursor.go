@@ -0,0 +1,79 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Cursor is the keyset pagination position in a sorted list,
+// only the rows sorted after the cursor are selected.
+type Cursor struct {
+	ID        int       `json:"id"`
+	Position  int       `json:"position"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Cursor returns the cursor pointing to the category.
+func (c *Category) Cursor() *Cursor {
+	return &Cursor{ID: c.ID, Position: c.Position, CreatedAt: c.CreatedAt, UpdatedAt: c.UpdatedAt}
+}
+
+// Cursor returns the cursor pointing to the section.
+func (s *Section) Cursor() *Cursor {
+	return &Cursor{ID: s.ID, Position: s.Position, CreatedAt: s.CreatedAt, UpdatedAt: s.UpdatedAt}
+}
+
+// Cursor returns the cursor pointing to the article.
+func (a *Article) Cursor() *Cursor {
+	return &Cursor{ID: a.ID, Position: a.Position, CreatedAt: a.CreatedAt, UpdatedAt: a.UpdatedAt}
+}
+
+// keysetOrder returns the order by clause of the listings. The keyset listings are sorted in a total order,
+// the id breaks the ties so that the keyset condition never skips or repeats a row.
+func keysetOrder(keyset bool, sortBy, sortOrder string) string {
+	if !keyset {
+		return fmt.Sprintf("%s %s, created_at DESC", sortBy, sortOrder)
+	}
+	return fmt.Sprintf("%s %s, created_at DESC, id ASC", sortBy, sortOrder)
+}
+
+// keysetCondition returns the sql condition which selects the rows sorted after the cursor
+// by keysetOrder, an empty string is returned if there is no cursor.
+func keysetCondition(after *Cursor, sortBy, sortOrder string) string {
+	if after == nil {
+		return ""
+	}
+
+	var value string
+	switch sortBy {
+	case "created_at":
+		value = formatCursorTime(after.CreatedAt)
+	case "updated_at":
+		value = formatCursorTime(after.UpdatedAt)
+	default:
+		sortBy = "position"
+		value = fmt.Sprintf("'%d'", after.Position)
+	}
+
+	op := ">"
+	if strings.ToLower(sortOrder) == "desc" {
+		op = "<"
+	}
+	createdAt := formatCursorTime(after.CreatedAt)
+
+	return fmt.Sprintf(
+		`AND (%[1]s %[2]s %[3]s OR (%[1]s = %[3]s AND (created_at < %[4]s OR (created_at = %[4]s AND id > %[5]d))))`,
+		sortBy,
+		op,
+		value,
+		createdAt,
+		after.ID,
+	)
+}
+
+// formatCursorTime formats the time as a sql literal.
+func formatCursorTime(t time.Time) string {
+	return "'" + t.UTC().Format(time.RFC3339Nano) + "'"
+}
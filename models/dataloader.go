@@ -8,6 +8,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/honestbee/Zen/internal/cache"
+	"github.com/honestbee/Zen/metrics"
 )
 
 const (
@@ -18,6 +19,7 @@ const (
 	ticketFieldsDataloaderForm       = "zen_ticket_fields_dataloader_%s"
 	ticketFieldCustomFieldOptionForm = "zen_ticket_field_custom_field_option_%s"
 	ticketFieldSystemFieldOptionForm = "zen_ticket_field_system_field_option_%s"
+	dynamicContentRenderForm         = "zen_dynamic_content_render_%s_%s"
 )
 
 const (
@@ -46,6 +48,7 @@ type dataloaderService interface {
 	TicketFieldSystemFieldOptionCacheGet(ctx context.Context, key string) (string, bool)
 	TicketFieldSystemFieldOptionCacheSet(ctx context.Context, key, value string) (bool, error)
 	TicketFieldSystemFieldOptionCacheInvalidate(ctx context.Context) error
+	DynamicContentCacheInvalidate(ctx context.Context) error
 }
 
 type dataloaderOps struct {
@@ -54,203 +57,242 @@ type dataloaderOps struct {
 
 func (d *dataloaderOps) CategoriesCacheGet(ctx context.Context, key, countryCode, locale string) (string, bool) {
 	key = fmt.Sprintf(categoriesDataloaderForm, countryCode, locale, key)
-	reply, err := d.cache.StringDo("GET", key, ctx)
+	reply, err := d.cache.StringDo(ctx, "GET", key)
 	if err == redis.ErrNil {
+		metrics.DataloaderCache("categories", false)
 		return "", false
 	}
+	metrics.DataloaderCache("categories", true)
 
 	// Update TTL.
-	d.cache.StringDo("SETEX", key, dataloaderTTLSec, reply, ctx)
+	d.cache.StringDo(ctx, "SETEX", key, dataloaderTTLSec, reply)
 	return reply, true
 }
 
 func (d *dataloaderOps) CategoriesCacheSet(ctx context.Context, key, value, countryCode, locale string) (bool, error) {
-	reply, err := d.cache.StringDo("SET", fmt.Sprintf(categoriesDataloaderForm, countryCode, locale, key), value, "EX", dataloaderTTLSec, "NX", ctx)
+	reply, err := d.cache.StringDo(ctx, "SET", fmt.Sprintf(categoriesDataloaderForm, countryCode, locale, key), value, "EX", dataloaderTTLSec, "NX")
 	return reply == "OK", errors.Wrapf(err, "models: [CategoriesCacheSet] cache StringDo failed")
 }
 
 func (d *dataloaderOps) CategoriesCacheInvalidate(ctx context.Context, countryCode, locale string) error {
-	replys, err := d.cache.StringsDo("KEYS", fmt.Sprintf(categoriesDataloaderForm, countryCode, locale, "*"), ctx)
+	replys, err := d.cache.StringsDo(ctx, "KEYS", fmt.Sprintf(categoriesDataloaderForm, countryCode, locale, "*"))
 	if err != nil {
 		return err
 	}
 
 	for _, reply := range replys {
-		d.cache.StringDo("DEL", reply, ctx)
+		d.cache.StringDo(ctx, "DEL", reply)
 	}
 	return nil
 }
 
 func (d *dataloaderOps) SectionsCacheGet(ctx context.Context, key, countryCode, locale string) (string, bool) {
 	key = fmt.Sprintf(sectionsDataloaderForm, countryCode, locale, key)
-	reply, err := d.cache.StringDo("GET", key, ctx)
+	reply, err := d.cache.StringDo(ctx, "GET", key)
 	if err == redis.ErrNil {
+		metrics.DataloaderCache("sections", false)
 		return "", false
 	}
+	metrics.DataloaderCache("sections", true)
 
 	// Update TTL.
-	d.cache.StringDo("SETEX", key, dataloaderTTLSec, reply, ctx)
+	d.cache.StringDo(ctx, "SETEX", key, dataloaderTTLSec, reply)
 	return reply, true
 }
 
 func (d *dataloaderOps) SectionsCacheSet(ctx context.Context, key, value, countryCode, locale string) (bool, error) {
-	reply, err := d.cache.StringDo("SET", fmt.Sprintf(sectionsDataloaderForm, countryCode, locale, key), value, "EX", dataloaderTTLSec, "NX", ctx)
+	reply, err := d.cache.StringDo(ctx, "SET", fmt.Sprintf(sectionsDataloaderForm, countryCode, locale, key), value, "EX", dataloaderTTLSec, "NX")
 	return reply == "OK", errors.Wrapf(err, "models: [SectionsCacheSet] cache StringDo failed")
 }
 
 func (d *dataloaderOps) SectionsCacheInvalidate(ctx context.Context, countryCode, locale string) error {
-	replys, err := d.cache.StringsDo("KEYS", fmt.Sprintf(sectionsDataloaderForm, countryCode, locale, "*"), ctx)
+	replys, err := d.cache.StringsDo(ctx, "KEYS", fmt.Sprintf(sectionsDataloaderForm, countryCode, locale, "*"))
 	if err != nil {
 		return err
 	}
 
 	for _, reply := range replys {
-		d.cache.StringDo("DEL", reply, ctx)
+		d.cache.StringDo(ctx, "DEL", reply)
 	}
 	return nil
 }
 
 func (d *dataloaderOps) ArticlesCacheGet(ctx context.Context, key, countryCode, locale string) (string, bool) {
 	key = fmt.Sprintf(articlesDataloaderForm, countryCode, locale, key)
-	reply, err := d.cache.StringDo("GET", key, ctx)
+	reply, err := d.cache.StringDo(ctx, "GET", key)
 	if err == redis.ErrNil {
+		metrics.DataloaderCache("articles", false)
 		return "", false
 	}
+	metrics.DataloaderCache("articles", true)
 
 	// Update TTL.
-	d.cache.StringDo("SETEX", key, dataloaderTTLSec, reply, ctx)
+	d.cache.StringDo(ctx, "SETEX", key, dataloaderTTLSec, reply)
 	return reply, true
 }
 
 func (d *dataloaderOps) ArticlesCacheSet(ctx context.Context, key, value, countryCode, locale string) (bool, error) {
-	reply, err := d.cache.StringDo("SET", fmt.Sprintf(articlesDataloaderForm, countryCode, locale, key), value, "EX", dataloaderTTLSec, "NX", ctx)
+	reply, err := d.cache.StringDo(ctx, "SET", fmt.Sprintf(articlesDataloaderForm, countryCode, locale, key), value, "EX", dataloaderTTLSec, "NX")
 	return reply == "OK", errors.Wrapf(err, "models: [ArticlesCacheSet] cache StringDo failed")
 }
 
 func (d *dataloaderOps) ArticlesCacheInvalidate(ctx context.Context, countryCode, locale string) error {
-	replys, err := d.cache.StringsDo("KEYS", fmt.Sprintf(articlesDataloaderForm, countryCode, locale, "*"), ctx)
+	replys, err := d.cache.StringsDo(ctx, "KEYS", fmt.Sprintf(articlesDataloaderForm, countryCode, locale, "*"))
 	if err != nil {
 		return err
 	}
 
 	for _, reply := range replys {
-		d.cache.StringDo("DEL", reply, ctx)
+		d.cache.StringDo(ctx, "DEL", reply)
 	}
 	return nil
 }
 
 func (d *dataloaderOps) TicketFormCacheGet(ctx context.Context, key string) (string, bool) {
 	key = fmt.Sprintf(ticketFormDataloaderForm, key)
-	reply, err := d.cache.StringDo("GET", key, ctx)
+	reply, err := d.cache.StringDo(ctx, "GET", key)
 	if err == redis.ErrNil {
+		metrics.DataloaderCache("ticket_forms", false)
 		return "", false
 	}
+	metrics.DataloaderCache("ticket_forms", true)
 
 	// Update TTL.
-	d.cache.StringDo("SETEX", key, dataloaderTTLSec, reply, ctx)
+	d.cache.StringDo(ctx, "SETEX", key, dataloaderTTLSec, reply)
 	return reply, true
 }
 
 func (d *dataloaderOps) TicketFormCacheSet(ctx context.Context, key, value string) (bool, error) {
-	reply, err := d.cache.StringDo("SET", fmt.Sprintf(ticketFormDataloaderForm, key), value, "EX", dataloaderTTLSec, "NX", ctx)
+	reply, err := d.cache.StringDo(ctx, "SET", fmt.Sprintf(ticketFormDataloaderForm, key), value, "EX", dataloaderTTLSec, "NX")
 	return reply == "OK", errors.Wrapf(err, "models: [TicketFormCacheSet] cache StringDo failed")
 }
 
 func (d *dataloaderOps) TicketFormCacheInvalidate(ctx context.Context) error {
-	replys, err := d.cache.StringsDo("KEYS", fmt.Sprintf(ticketFormDataloaderForm, "*"), ctx)
+	replys, err := d.cache.StringsDo(ctx, "KEYS", fmt.Sprintf(ticketFormDataloaderForm, "*"))
 	if err != nil {
 		return err
 	}
 
 	for _, reply := range replys {
-		d.cache.StringDo("DEL", reply, ctx)
+		d.cache.StringDo(ctx, "DEL", reply)
 	}
 	return nil
 }
 
 func (d *dataloaderOps) TicketFieldCacheGet(ctx context.Context, key string) (string, bool) {
 	key = fmt.Sprintf(ticketFieldsDataloaderForm, key)
-	reply, err := d.cache.StringDo("GET", key, ctx)
+	reply, err := d.cache.StringDo(ctx, "GET", key)
 	if err == redis.ErrNil {
+		metrics.DataloaderCache("ticket_fields", false)
 		return "", false
 	}
+	metrics.DataloaderCache("ticket_fields", true)
 
 	// Update TTL.
-	d.cache.StringDo("SETEX", key, dataloaderTTLSec, reply, ctx)
+	d.cache.StringDo(ctx, "SETEX", key, dataloaderTTLSec, reply)
 	return reply, true
 }
 
 func (d *dataloaderOps) TicketFieldCacheSet(ctx context.Context, key, value string) (bool, error) {
-	reply, err := d.cache.StringDo("SET", fmt.Sprintf(ticketFieldsDataloaderForm, key), value, "EX", dataloaderTTLSec, "NX", ctx)
+	reply, err := d.cache.StringDo(ctx, "SET", fmt.Sprintf(ticketFieldsDataloaderForm, key), value, "EX", dataloaderTTLSec, "NX")
 	return reply == "OK", errors.Wrapf(err, "models: [TicketFieldCacheSet] cache StringDo failed")
 }
 
 func (d *dataloaderOps) TicketFieldCacheInvalidate(ctx context.Context) error {
-	replys, err := d.cache.StringsDo("KEYS", fmt.Sprintf(ticketFieldsDataloaderForm, "*"), ctx)
+	replys, err := d.cache.StringsDo(ctx, "KEYS", fmt.Sprintf(ticketFieldsDataloaderForm, "*"))
 	if err != nil {
 		return err
 	}
 
 	for _, reply := range replys {
-		d.cache.StringDo("DEL", reply, ctx)
+		d.cache.StringDo(ctx, "DEL", reply)
 	}
 	return nil
 }
 
 func (d *dataloaderOps) TicketFieldCustomFieldOptionCacheGet(ctx context.Context, key string) (string, bool) {
 	key = fmt.Sprintf(ticketFieldCustomFieldOptionForm, key)
-	reply, err := d.cache.StringDo("GET", key, ctx)
+	reply, err := d.cache.StringDo(ctx, "GET", key)
 	if err == redis.ErrNil {
+		metrics.DataloaderCache("ticket_field_custom_field_options", false)
 		return "", false
 	}
+	metrics.DataloaderCache("ticket_field_custom_field_options", true)
 
 	// Update TTL.
-	d.cache.StringDo("SETEX", key, dataloaderTTLSec, reply, ctx)
+	d.cache.StringDo(ctx, "SETEX", key, dataloaderTTLSec, reply)
 	return reply, true
 }
 
 func (d *dataloaderOps) TicketFieldCustomFieldOptionCacheSet(ctx context.Context, key, value string) (bool, error) {
-	reply, err := d.cache.StringDo("SET", fmt.Sprintf(ticketFieldCustomFieldOptionForm, key), value, "EX", dataloaderTTLSec, "NX", ctx)
+	reply, err := d.cache.StringDo(ctx, "SET", fmt.Sprintf(ticketFieldCustomFieldOptionForm, key), value, "EX", dataloaderTTLSec, "NX")
 	return reply == "OK", errors.Wrapf(err, "models: [TicketFieldCustomFieldOptionCacheSet] cache StringDo failed")
 }
 
 func (d *dataloaderOps) TicketFieldCustomFieldOptionCacheInvalidate(ctx context.Context) error {
-	replys, err := d.cache.StringsDo("KEYS", fmt.Sprintf(ticketFieldCustomFieldOptionForm, "*"), ctx)
+	replys, err := d.cache.StringsDo(ctx, "KEYS", fmt.Sprintf(ticketFieldCustomFieldOptionForm, "*"))
 	if err != nil {
 		return err
 	}
 
 	for _, reply := range replys {
-		d.cache.StringDo("DEL", reply, ctx)
+		d.cache.StringDo(ctx, "DEL", reply)
 	}
 	return nil
 }
 
 func (d *dataloaderOps) TicketFieldSystemFieldOptionCacheGet(ctx context.Context, key string) (string, bool) {
 	key = fmt.Sprintf(ticketFieldSystemFieldOptionForm, key)
-	reply, err := d.cache.StringDo("GET", key, ctx)
+	reply, err := d.cache.StringDo(ctx, "GET", key)
 	if err == redis.ErrNil {
+		metrics.DataloaderCache("ticket_field_system_field_options", false)
 		return "", false
 	}
+	metrics.DataloaderCache("ticket_field_system_field_options", true)
 
 	// Update TTL.
-	d.cache.StringDo("SETEX", key, dataloaderTTLSec, reply, ctx)
+	d.cache.StringDo(ctx, "SETEX", key, dataloaderTTLSec, reply)
 	return reply, true
 }
 
 func (d *dataloaderOps) TicketFieldSystemFieldOptionCacheSet(ctx context.Context, key, value string) (bool, error) {
-	reply, err := d.cache.StringDo("SET", fmt.Sprintf(ticketFieldSystemFieldOptionForm, key), value, "EX", dataloaderTTLSec, "NX", ctx)
+	reply, err := d.cache.StringDo(ctx, "SET", fmt.Sprintf(ticketFieldSystemFieldOptionForm, key), value, "EX", dataloaderTTLSec, "NX")
 	return reply == "OK", errors.Wrapf(err, "models: [TicketFieldSystemFieldOptionCacheSet] cache StringDo failed")
 }
 
 func (d *dataloaderOps) TicketFieldSystemFieldOptionCacheInvalidate(ctx context.Context) error {
-	replys, err := d.cache.StringsDo("KEYS", fmt.Sprintf(ticketFieldSystemFieldOptionForm, "*"), ctx)
+	replys, err := d.cache.StringsDo(ctx, "KEYS", fmt.Sprintf(ticketFieldSystemFieldOptionForm, "*"))
 	if err != nil {
 		return err
 	}
 
 	for _, reply := range replys {
-		d.cache.StringDo("DEL", reply, ctx)
+		d.cache.StringDo(ctx, "DEL", reply)
+	}
+	return nil
+}
+
+// DynamicContentCacheInvalidate removes the rendered dynamic content and all the cached content
+// which may contain rendered dynamic content, so they will be re-rendered on the next request.
+func (d *dataloaderOps) DynamicContentCacheInvalidate(ctx context.Context) error {
+	forms := []string{
+		fmt.Sprintf(dynamicContentRenderForm, "*", "*"),
+		fmt.Sprintf(categoriesDataloaderForm, "*", "*", "*"),
+		fmt.Sprintf(sectionsDataloaderForm, "*", "*", "*"),
+		fmt.Sprintf(articlesDataloaderForm, "*", "*", "*"),
+		fmt.Sprintf(ticketFormDataloaderForm, "*"),
+		fmt.Sprintf(ticketFieldsDataloaderForm, "*"),
+	}
+
+	for _, form := range forms {
+		replys, err := d.cache.StringsDo(ctx, "KEYS", form)
+		if err != nil {
+			return errors.Wrapf(err, "models: [DynamicContentCacheInvalidate] cache StringsDo keys:%s failed", form)
+		}
+
+		for _, reply := range replys {
+			d.cache.StringDo(ctx, "DEL", reply)
+		}
 	}
 	return nil
 }
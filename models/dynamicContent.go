@@ -4,11 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/jmoiron/sqlx/types"
 	"github.com/pkg/errors"
 
+	"github.com/honestbee/Zen/internal/cache"
 	"github.com/honestbee/Zen/internal/db"
 )
 
@@ -22,11 +25,15 @@ var (
 		"zh-tw": 9,
 		"th":    81,
 	}
+
+	// dcPlaceholderRegexp matches zendesk dynamic content placeholders e.g. {{dc.welcome_message}}.
+	dcPlaceholderRegexp = regexp.MustCompile(`{{\s*(dc\.[\w\-]+)\s*}}`)
 )
 
 type dynamicContentService interface {
 	GetDynamicContentItem(ctx context.Context, placeholder, locale string) (*DynamicContentItem, error)
 	SyncWithDynamicContentItems(ctx context.Context, zendeskDCItems []*SyncDynamicContentItem) error
+	RenderDynamicContent(ctx context.Context, text, locale string) (string, error)
 }
 
 // DynamicContentItem is dynamic content item field model.
@@ -58,7 +65,8 @@ type SyncDynamicContentItem struct {
 }
 
 type dynamicContentOps struct {
-	db db.Database
+	db    db.Database
+	cache cache.Cache
 }
 
 const (
@@ -102,7 +110,7 @@ const (
 )
 
 func (d *dynamicContentOps) SyncWithDynamicContentItems(ctx context.Context, zendeskDCItems []*SyncDynamicContentItem) error {
-	tx, err := d.db.Begin()
+	tx, err := d.db.Begin(ctx)
 	if err != nil {
 		return errors.Wrapf(err, "models: [SyncWithDynamicContentItems] db.Begin failed")
 	}
@@ -144,6 +152,8 @@ func (d *dynamicContentOps) SyncWithDynamicContentItems(ctx context.Context, zen
 	return errors.Wrapf(tx.Err(), "models: [SyncWithDynamicContentItems] db transaction failed")
 }
 
+// GetDynamicContentItem returns the item of the placeholder with the variant of the locale, or of the default locale,
+// ErrNotFound is returned if the item or both of the variants don't exist.
 func (d *dynamicContentOps) GetDynamicContentItem(ctx context.Context, placeholder, locale string) (*DynamicContentItem, error) {
 	item := new(db.DynamicContentItems)
 	query := fmt.Sprintf(
@@ -201,11 +211,62 @@ func (d *dynamicContentOps) GetDynamicContentItem(ctx context.Context, placehold
 		ret.VariantsUpdatedAt = vmaper[ret.DefaultLocaleID].UpdatedAt
 
 	} else {
-		return nil, errors.Errorf(
-			"modes: [GetDynamicContentItem] placeholder:%s, locale:%s, defaultLocaleID:%d not found",
-			placeholder, locale, ret.DefaultLocaleID,
-		)
+		// neither the locale nor the default locale has a variant
+		return nil, ErrNotFound
 	}
 
 	return ret, nil
 }
+
+// RenderDynamicContent expands all the dynamic content placeholders inside the text
+// with the variant of the locale, the placeholder will be kept if it does not exist.
+func (d *dynamicContentOps) RenderDynamicContent(ctx context.Context, text, locale string) (string, error) {
+	if !strings.Contains(text, "{{") {
+		return text, nil
+	}
+
+	var renderErr error
+	rendered := dcPlaceholderRegexp.ReplaceAllStringFunc(text, func(match string) string {
+		if renderErr != nil {
+			return match
+		}
+
+		placeholder := fmt.Sprintf("{{%s}}", dcPlaceholderRegexp.FindStringSubmatch(match)[1])
+		key := fmt.Sprintf(dynamicContentRenderForm, locale, placeholder)
+		if content, err := d.cache.StringDo(ctx, "GET", key); err == nil {
+			return content
+		}
+
+		dc, err := d.GetDynamicContentItem(ctx, placeholder, locale)
+		if err != nil {
+			if err != ErrNotFound {
+				renderErr = err
+			}
+			return match
+		}
+
+		d.cache.StringDo(ctx, "SET", key, dc.VariantsContent, "EX", dataloaderTTLSec)
+		return dc.VariantsContent
+	})
+	if renderErr != nil {
+		return "", errors.Wrapf(
+			renderErr,
+			"models: [RenderDynamicContent] render locale:%s failed",
+			locale,
+		)
+	}
+
+	return rendered, nil
+}
+
+// renderFields renders the dynamic content placeholders of the text fields in place.
+func (d *dynamicContentOps) renderFields(ctx context.Context, locale string, fields ...*string) error {
+	for _, field := range fields {
+		rendered, err := d.RenderDynamicContent(ctx, *field, locale)
+		if err != nil {
+			return err
+		}
+		*field = rendered
+	}
+	return nil
+}
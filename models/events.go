@@ -0,0 +1,158 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/honestbee/Zen/internal/cache"
+)
+
+const (
+	contentEventsChannel = "zen_content_events"
+	contentEventsSeqKey  = "zen_content_events_seq"
+	contentEventsLogKey  = "zen_content_events_log"
+	// contentEventsLogSize is the number of the latest events kept in the log for resuming.
+	contentEventsLogSize = 10000
+)
+
+// The types of the content change events.
+const (
+	EventArticleUpdated    = "article_updated"
+	EventArticlesDeleted   = "articles_deleted"
+	EventCategoriesChanged = "categories_changed"
+	EventSectionsChanged   = "sections_changed"
+)
+
+var (
+	// ErrEventsExpired means the events after the sequence number have been dropped from the log.
+	ErrEventsExpired = errors.New("models: events are expired")
+)
+
+// publishEventScript numbers the event, appends it into the log which keeps the latest events
+// and publishes it, the sequence number of the event is returned.
+const publishEventScript = `
+local seq = redis.call("INCR", KEYS[1])
+local record = '{"seq":' .. seq .. ',"event":' .. ARGV[1] .. '}'
+redis.call("ZADD", KEYS[2], seq, record)
+redis.call("ZREMRANGEBYRANK", KEYS[2], 0, -tonumber(ARGV[2]) - 1)
+redis.call("PUBLISH", ARGV[3], record)
+return seq`
+
+type eventsService interface {
+	PublishEvent(ctx context.Context, event *Event) error
+	SubscribeEvents(ctx context.Context) (<-chan *Event, error)
+	GetEventsAfter(ctx context.Context, seq int64, limit int) ([]*Event, error)
+}
+
+// Event is the content change event which is published to all the replicas.
+type Event struct {
+	Type        string `json:"type"`
+	CountryCode string `json:"country_code"`
+	Locale      string `json:"locale"`
+	// IDs are the zendesk ids of the changed rows.
+	IDs []int `json:"ids"`
+	// SectionID is the section of the updated article.
+	SectionID int `json:"section_id,omitempty"`
+	// Seq is the sequence number of the published event, the events are numbered in the publishing order.
+	Seq int64 `json:"-"`
+}
+
+// eventRecord is the form of the event in the log and the channel.
+type eventRecord struct {
+	Seq   int64  `json:"seq"`
+	Event *Event `json:"event"`
+}
+
+func (r *eventRecord) decode(b []byte) (*Event, error) {
+	if err := json.Unmarshal(b, r); err != nil {
+		return nil, err
+	}
+	if r.Event == nil {
+		return nil, errors.New("models: event record has no event")
+	}
+	r.Event.Seq = r.Seq
+	return r.Event, nil
+}
+
+type eventsOps struct {
+	cache cache.Cache
+}
+
+// PublishEvent publishes the event to the subscribers of all the replicas,
+// the event is kept in the log for the subscribers resuming from an earlier event.
+func (e *eventsOps) PublishEvent(ctx context.Context, event *Event) error {
+	b, err := json.Marshal(event)
+	if err != nil {
+		return errors.Wrapf(err, "models: [PublishEvent] json marshal failed")
+	}
+	seq, err := e.cache.IntDo(ctx, "EVAL", publishEventScript, 2, contentEventsSeqKey, contentEventsLogKey, b, contentEventsLogSize, contentEventsChannel)
+	if err != nil {
+		return errors.Wrapf(err, "models: [PublishEvent] cache IntDo failed")
+	}
+	event.Seq = int64(seq)
+	return nil
+}
+
+// GetEventsAfter returns at most limit events published after the event of the sequence number in order,
+// ErrEventsExpired is returned if some of the events have been dropped from the log.
+func (e *eventsOps) GetEventsAfter(ctx context.Context, seq int64, limit int) ([]*Event, error) {
+	oldest, err := e.cache.StringsDo(ctx, "ZRANGE", contentEventsLogKey, 0, 0, "WITHSCORES")
+	if err != nil {
+		return nil, errors.Wrapf(err, "models: [GetEventsAfter] cache StringsDo oldest event failed")
+	}
+	if len(oldest) == 2 {
+		oldestSeq, err := strconv.ParseInt(oldest[1], 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "models: [GetEventsAfter] parse oldest sequence number failed")
+		}
+		if oldestSeq > seq+1 {
+			return nil, ErrEventsExpired
+		}
+	}
+
+	records, err := e.cache.StringsDo(ctx, "ZRANGEBYSCORE", contentEventsLogKey, fmt.Sprintf("(%d", seq), "+inf", "LIMIT", 0, limit)
+	if err != nil {
+		return nil, errors.Wrapf(err, "models: [GetEventsAfter] cache StringsDo events failed")
+	}
+
+	events := make([]*Event, 0, len(records))
+	for _, record := range records {
+		event, err := new(eventRecord).decode([]byte(record))
+		if err != nil {
+			return nil, errors.Wrapf(err, "models: [GetEventsAfter] decode event failed")
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// SubscribeEvents returns the events published after subscribing until ctx is done,
+// the returned channel is closed when the subscription ends.
+func (e *eventsOps) SubscribeEvents(ctx context.Context) (<-chan *Event, error) {
+	messages, err := e.cache.Subscribe(ctx, contentEventsChannel)
+	if err != nil {
+		return nil, errors.Wrapf(err, "models: [SubscribeEvents] cache Subscribe failed")
+	}
+
+	events := make(chan *Event)
+	go func() {
+		defer close(events)
+		for message := range messages {
+			event, err := new(eventRecord).decode(message)
+			if err != nil {
+				continue
+			}
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
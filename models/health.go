@@ -0,0 +1,69 @@
+package models
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/honestbee/Zen/internal/cache"
+	"github.com/honestbee/Zen/internal/db"
+)
+
+// lastSyncsKey is the hash of the last successful sync unix time by the country codes.
+const lastSyncsKey = "zen_last_syncs"
+
+type healthService interface {
+	PingDatabase(ctx context.Context) error
+	PingCache(ctx context.Context) error
+	SetLastSync(ctx context.Context, countryCode string, syncedAt time.Time) error
+	GetLastSyncs(ctx context.Context) (map[string]time.Time, error)
+}
+
+type healthOps struct {
+	db     db.Database
+	caches map[string]cache.Cache
+	// cache keeps the last syncs, they are shared by all the instances.
+	cache cache.Cache
+}
+
+// PingDatabase checks the database is reachable.
+func (h *healthOps) PingDatabase(ctx context.Context) error {
+	return errors.Wrapf(h.db.Ping(ctx), "models: [PingDatabase] db ping failed")
+}
+
+// PingCache checks all the caches are reachable.
+func (h *healthOps) PingCache(ctx context.Context) error {
+	for name, c := range h.caches {
+		if _, err := c.StringDo(ctx, "PING"); err != nil {
+			return errors.Wrapf(err, "models: [PingCache] cache:%s ping failed", name)
+		}
+	}
+	return nil
+}
+
+// SetLastSync keeps syncedAt as the last successful sync of the country.
+func (h *healthOps) SetLastSync(ctx context.Context, countryCode string, syncedAt time.Time) error {
+	_, err := h.cache.IntDo(ctx, "HSET", lastSyncsKey, countryCode, syncedAt.Unix())
+	return errors.Wrapf(err, "models: [SetLastSync] cache IntDo failed")
+}
+
+// GetLastSyncs returns the last successful syncs by the country codes,
+// the countries never synced are absent.
+func (h *healthOps) GetLastSyncs(ctx context.Context) (map[string]time.Time, error) {
+	reply, err := h.cache.StringsDo(ctx, "HGETALL", lastSyncsKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "models: [GetLastSyncs] cache StringsDo failed")
+	}
+
+	syncs := make(map[string]time.Time, len(reply)/2)
+	for i := 0; i+1 < len(reply); i += 2 {
+		sec, err := strconv.ParseInt(reply[i+1], 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "models: [GetLastSyncs] country code:%s parse failed", reply[i])
+		}
+		syncs[reply[i]] = time.Unix(sec, 0).UTC()
+	}
+	return syncs, nil
+}
@@ -2,7 +2,11 @@ package models
 
 import (
 	"context"
+	"fmt"
 	"reflect"
+	"sort"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
@@ -18,6 +22,8 @@ const (
 	ModelsReturnErrorLocale = "zh-cn"
 	// ModelsReturnNotFoundLocale is a mock locale for return not found.
 	ModelsReturnNotFoundLocale = "id"
+	// EventsExpiredSeq is a mock event sequence for GetEventsAfter return ErrEventsExpired.
+	EventsExpiredSeq = -1
 	// PlusCounterReturnErrorCountryCode is a mock country code for plus counter return return error.
 	PlusCounterReturnErrorCountryCode = "not_exist_country_code_1"
 	// PlusCounterReturnSmallerCountryCode is a mock country code for plus counter return smaller counter.
@@ -32,6 +38,14 @@ const (
 	UnlockCounterFailedCountryCode = "hk"
 	// SyncDBFailedLocale is a mock for locale for sync db return failed.
 	SyncDBFailedLocale = "ja"
+	// RateLimitedRemoteIP is a mock remote ip for plus request rate counter return over limit counter.
+	RateLimitedRemoteIP = "10.0.0.99"
+	// RateLimitedEmail is a mock email for plus request rate counter return over limit counter.
+	RateLimitedEmail = "rate.limited@honestbee.com"
+	// RateCounterReturnErrorRemoteIP is a mock remote ip for plus request rate counter return error.
+	RateCounterReturnErrorRemoteIP = "10.0.0.254"
+	// RateLimitedCount is the counter returned for the rate limited identities.
+	RateLimitedCount = 1000
 )
 
 var (
@@ -55,6 +69,30 @@ var (
 // MockModels is a mock service.
 type MockModels struct {
 	Sequence map[string]bool
+	// PingDatabaseErr and PingCacheErr are returned by PingDatabase and PingCache.
+	PingDatabaseErr error
+	PingCacheErr    error
+
+	mu          sync.Mutex
+	digests     map[string]bool
+	events      []*Event
+	subscribers map[chan *Event]struct{}
+	queries     map[string]string
+	spent       map[string]int
+	views       []int
+	searches    map[string]*SearchQueryStat
+	sessions    []*SessionEvent
+	voteLocks   map[string]bool
+	voteIPs     map[string]int
+	votes       map[string]*ArticleVote
+	voteEvents  []*mockArticleVoteEvent
+	lastSyncs   map[string]time.Time
+}
+
+type mockArticleVoteEvent struct {
+	vote          ArticleVote
+	previousValue string
+	day           string
 }
 
 // NewMockService return a new mock service with sequece initialized.
@@ -129,50 +167,62 @@ func (m *MockModels) UnlockTicketFormsCounter(ctx context.Context) error {
 }
 
 // SyncWithArticles is the mock function of SyncWithArticles.
-func (m *MockModels) SyncWithArticles(ctx context.Context, zendeskArticles []*Article, countryCode, locale string) error {
+func (m *MockModels) SyncWithArticles(ctx context.Context, zendeskArticles []*Article, countryCode, locale string) ([]int, error) {
 	if m.Sequence != nil {
 		m.Sequence["SyncWithArticles"] = true
 	}
 	if len(zendeskArticles) > 0 {
 		if zendeskArticles[0].Locale == SyncDBFailedLocale {
-			return errors.Errorf("return error")
+			return nil, errors.Errorf("return error")
 		}
 	}
-	return nil
+	ids := make([]int, 0, len(zendeskArticles))
+	for _, article := range zendeskArticles {
+		ids = append(ids, article.ID)
+	}
+	return ids, nil
 }
 
 // SyncWithArticle is the mock function of SyncWithArticle.
-func (m *MockModels) SyncWithArticle(ctx context.Context, articleID int, zendeskArticle *Article, countryCode, locale string) error {
+func (m *MockModels) SyncWithArticle(ctx context.Context, articleID int, zendeskArticle *Article, countryCode, locale string) ([]int, error) {
 	if m.Sequence != nil {
 		m.Sequence["SyncWithArticle"] = true
 	}
-	return nil
+	return []int{articleID}, nil
 }
 
 // SyncWithSections is the mock function of SyncWithSections.
-func (m *MockModels) SyncWithSections(ctx context.Context, zendeskSections []*Section, countryCode, locale string) error {
+func (m *MockModels) SyncWithSections(ctx context.Context, zendeskSections []*Section, countryCode, locale string) ([]int, error) {
 	if m.Sequence != nil {
 		m.Sequence["SyncWithSections"] = true
 	}
 	if len(zendeskSections) > 0 {
 		if zendeskSections[0].Locale == SyncDBFailedLocale {
-			return errors.Errorf("return error")
+			return nil, errors.Errorf("return error")
 		}
 	}
-	return nil
+	ids := make([]int, 0, len(zendeskSections))
+	for _, section := range zendeskSections {
+		ids = append(ids, section.ID)
+	}
+	return ids, nil
 }
 
 // SyncWithCategories is the mock function of SyncWithCategories.
-func (m *MockModels) SyncWithCategories(ctx context.Context, zendeskCategories []*Category, countryCode, locale string) error {
+func (m *MockModels) SyncWithCategories(ctx context.Context, zendeskCategories []*Category, countryCode, locale string) ([]int, error) {
 	if m.Sequence != nil {
 		m.Sequence["SyncWithCategories"] = true
 	}
 	if len(zendeskCategories) > 0 {
 		if zendeskCategories[0].Locale == SyncDBFailedLocale {
-			return errors.Errorf("return error")
+			return nil, errors.Errorf("return error")
 		}
 	}
-	return nil
+	ids := make([]int, 0, len(zendeskCategories))
+	for _, category := range zendeskCategories {
+		ids = append(ids, category.ID)
+	}
+	return ids, nil
 }
 
 // PlusOneCategoriesCounter is the mock function of PlusOneCategoriesCounter.
@@ -511,6 +561,14 @@ func (m *MockModels) TicketFieldSystemFieldOptionCacheInvalidate(ctx context.Con
 	return nil
 }
 
+// DynamicContentCacheInvalidate is the mock function of DynamicContentCacheInvalidate.
+func (m *MockModels) DynamicContentCacheInvalidate(ctx context.Context) error {
+	if m.Sequence != nil {
+		m.Sequence["DynamicContentCacheInvalidate"] = true
+	}
+	return nil
+}
+
 // GetCategories is the mock function of GetCategories.
 func (m *MockModels) GetCategories(ctx context.Context, params *GetCategoriesParams) ([]*Category, int, error) {
 	switch params.CountryCode {
@@ -639,6 +697,65 @@ func (m *MockModels) GetCategoryByCategoryIDOrKeyName(ctx context.Context, idOrK
 	}, nil
 }
 
+// GetCategoriesByIDs is the mock function of GetCategoriesByIDs.
+func (m *MockModels) GetCategoriesByIDs(ctx context.Context, categoryIDs []int, locale, countryCode string) (map[int]*Category, error) {
+	switch countryCode {
+	case ModelsReturnErrorCountryCode:
+		return nil, errors.New("MockModels GetCategoriesByIDs return error")
+	case ModelsReturnNotFoundCountryCode:
+		return map[int]*Category{}, nil
+	}
+
+	ret := make(map[int]*Category, len(categoryIDs))
+	for _, id := range categoryIDs {
+		ret[id] = &Category{
+			ID:           id,
+			Position:     0,
+			CreatedAt:    FixCreatedAt1,
+			UpdatedAt:    FixUpdatedAt1,
+			SourceLocale: "en-us",
+			Outdated:     false,
+			CountryCode:  "tw",
+			URL:          "www.honestbee.com",
+			HTMLURL:      "www.honestbee.com",
+			Name:         "testing category 1",
+			Description:  "",
+			Locale:       "en-us",
+			KeyName:      "food",
+		}
+	}
+	return ret, nil
+}
+
+// GetCategoriesByArticleIDs is the mock function of GetCategoriesByArticleIDs.
+func (m *MockModels) GetCategoriesByArticleIDs(ctx context.Context, articleIDs []int, locale string) (map[int]*Category, error) {
+	switch locale {
+	case ModelsReturnErrorLocale:
+		return nil, errors.New("MockModels GetCategoriesByArticleIDs return error")
+	case ModelsReturnNotFoundLocale:
+		return map[int]*Category{}, nil
+	}
+
+	ret := make(map[int]*Category, len(articleIDs))
+	for _, id := range articleIDs {
+		ret[id] = &Category{
+			ID:           3345678,
+			Position:     0,
+			CreatedAt:    FixCreatedAt1,
+			UpdatedAt:    FixUpdatedAt1,
+			SourceLocale: "en-us",
+			Outdated:     false,
+			CountryCode:  "tw",
+			URL:          "www.honestbee.com",
+			HTMLURL:      "www.honestbee.com",
+			Name:         "testing category 1",
+			Description:  "",
+			Locale:       "en-us",
+		}
+	}
+	return ret, nil
+}
+
 // GetSections is the mock function of GetSections.
 func (m *MockModels) GetSections(ctx context.Context, params *GetSectionsParams) ([]*Section, int, error) {
 	switch params.CountryCode {
@@ -721,6 +838,36 @@ func (m *MockModels) GetSectionBySectionID(ctx context.Context, sectionID int, l
 	}, nil
 }
 
+// GetSectionsByIDs is the mock function of GetSectionsByIDs.
+func (m *MockModels) GetSectionsByIDs(ctx context.Context, sectionIDs []int, locale, countryCode string) (map[int]*Section, error) {
+	switch countryCode {
+	case ModelsReturnErrorCountryCode:
+		return nil, errors.New("MockModels GetSectionsByIDs return error")
+	case ModelsReturnNotFoundCountryCode:
+		return map[int]*Section{}, nil
+	}
+
+	ret := make(map[int]*Section, len(sectionIDs))
+	for _, id := range sectionIDs {
+		ret[id] = &Section{
+			ID:           id,
+			Position:     0,
+			CreatedAt:    FixCreatedAt1,
+			UpdatedAt:    FixUpdatedAt1,
+			SourceLocale: "en-us",
+			Outdated:     false,
+			CountryCode:  "sg",
+			URL:          "www.honestbee.com",
+			HTMLURL:      "www.honestbee.com",
+			Name:         "testing section 1",
+			Description:  "",
+			Locale:       "en-us",
+			CategoryID:   3345678,
+		}
+	}
+	return ret, nil
+}
+
 // GetSectionByArticleID is the mock function of GetSectionByArticleID.
 func (m *MockModels) GetSectionByArticleID(ctx context.Context, articleID int, locale, countryCode string) (*Section, error) {
 	switch countryCode {
@@ -1096,28 +1243,439 @@ func (m *MockModels) GetArticleByArticleID(ctx context.Context, articleID int, l
 	}, nil
 }
 
-// PlusOneArticleClickCounter is the mock function of PlusOneArticleClickCounter.
-func (m *MockModels) PlusOneArticleClickCounter(ctx context.Context, articleID int, locale, countryCode string) error {
+// ExportArticles is the mock function of ExportArticles, the first page has one article.
+func (m *MockModels) ExportArticles(ctx context.Context, params *ExportArticlesParams) ([]*Article, error) {
+	switch params.CountryCode {
+	case ModelsReturnErrorCountryCode:
+		return nil, errors.New("MockModels ExportArticles return error")
+	}
+	if params.After != nil {
+		return []*Article{}, nil
+	}
+
+	article, err := m.GetArticleByArticleID(ctx, 33456710, params.Locale, params.CountryCode)
+	if err != nil {
+		return nil, err
+	}
+	return []*Article{article}, nil
+}
+
+// RecordArticleView is the mock function of RecordArticleView.
+func (m *MockModels) RecordArticleView(ctx context.Context, articleID int, locale, countryCode string) error {
 	switch countryCode {
 	case ModelsReturnErrorCountryCode:
-		return errors.New("MockModels PlusOneArticleClickCounter return error")
+		return errors.New("MockModels RecordArticleView return error")
 	case ModelsReturnNotFoundCountryCode:
 		return ErrNotFound
 	}
 
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.views = append(m.views, articleID)
+	return nil
+}
+
+// FlushArticleViews is the mock function of FlushArticleViews, it returns the number of the recorded views.
+func (m *MockModels) FlushArticleViews(ctx context.Context) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	n := len(m.views)
+	m.views = nil
+	return n, nil
+}
+
+// RecordedArticleViews returns the ids of the viewed articles not flushed yet.
+func (m *MockModels) RecordedArticleViews() []int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]int(nil), m.views...)
+}
+
+// RecordSearchQuery is the mock function of RecordSearchQuery, the searches are summed up immediately.
+func (m *MockModels) RecordSearchQuery(ctx context.Context, query, locale, countryCode string, results int) error {
+	if countryCode == ModelsReturnErrorCountryCode {
+		return errors.New("MockModels RecordSearchQuery return error")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stat := m.searchQueryStat(query)
+	if stat == nil {
+		return nil
+	}
+	stat.Searches++
+	if results == 0 {
+		stat.ZeroResults++
+	}
+	stat.ClickThroughRate = float64(stat.Clicks) / float64(stat.Searches)
+	return nil
+}
+
+// RecordSearchClick is the mock function of RecordSearchClick, the clicks are summed up immediately.
+func (m *MockModels) RecordSearchClick(ctx context.Context, query string, articleID int, locale, countryCode string) error {
+	if countryCode == ModelsReturnErrorCountryCode {
+		return errors.New("MockModels RecordSearchClick return error")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stat := m.searchQueryStat(query)
+	if stat == nil {
+		return nil
+	}
+	stat.Clicks++
+	if stat.Searches > 0 {
+		stat.ClickThroughRate = float64(stat.Clicks) / float64(stat.Searches)
+	}
+	return nil
+}
+
+// searchQueryStat returns the stat of the normalized query, nil for the blank query.
+func (m *MockModels) searchQueryStat(query string) *SearchQueryStat {
+	query = NormalizeSearchQuery(query)
+	if query == "" {
+		return nil
+	}
+	if m.searches == nil {
+		m.searches = make(map[string]*SearchQueryStat)
+	}
+	if _, ok := m.searches[query]; !ok {
+		m.searches[query] = &SearchQueryStat{Query: query}
+	}
+	return m.searches[query]
+}
+
+// FlushSearchQueries is the mock function of FlushSearchQueries, it returns the number of the recorded queries.
+func (m *MockModels) FlushSearchQueries(ctx context.Context) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return len(m.searches), nil
+}
+
+// DeleteSearchQueriesBefore is the mock function of DeleteSearchQueriesBefore, the recorded queries have no days so none is deleted.
+func (m *MockModels) DeleteSearchQueriesBefore(ctx context.Context, before time.Time) (int, error) {
+	return 0, nil
+}
+
+// GetSearchQueryStats is the mock function of GetSearchQueryStats, the window is ignored.
+func (m *MockModels) GetSearchQueryStats(ctx context.Context, params *GetSearchQueryStatsParams) ([]*SearchQueryStat, error) {
+	if params.CountryCode == ModelsReturnErrorCountryCode {
+		return nil, errors.New("MockModels GetSearchQueryStats return error")
+	}
+
+	stats := m.RecordedSearchQueries()
+	var less func(a, b *SearchQueryStat) bool
+	switch params.Report {
+	case TopSearchQueries:
+		less = func(a, b *SearchQueryStat) bool { return a.Searches > b.Searches }
+	case ZeroResultSearchQueries:
+		filtered := make([]*SearchQueryStat, 0)
+		for _, stat := range stats {
+			if stat.ZeroResults > 0 {
+				filtered = append(filtered, stat)
+			}
+		}
+		stats = filtered
+		less = func(a, b *SearchQueryStat) bool { return a.ZeroResults > b.ZeroResults }
+	case ClickThroughSearchQueries:
+		less = func(a, b *SearchQueryStat) bool { return a.ClickThroughRate < b.ClickThroughRate }
+	default:
+		return nil, errors.Errorf("MockModels GetSearchQueryStats unknown report:%q", params.Report)
+	}
+	sort.SliceStable(stats, func(i, j int) bool { return less(stats[i], stats[j]) })
+
+	if uint64(len(stats)) > params.Limit {
+		stats = stats[:params.Limit]
+	}
+	return stats, nil
+}
+
+// RecordedSearchQueries returns the copies of the recorded query stats ordered by the query.
+func (m *MockModels) RecordedSearchQueries() []*SearchQueryStat {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stats := make([]*SearchQueryStat, 0, len(m.searches))
+	for _, stat := range m.searches {
+		s := *stat
+		stats = append(stats, &s)
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Query < stats[j].Query })
+	return stats
+}
+
+// RecordSessionEvent is the mock function of RecordSessionEvent, the events are kept in order.
+func (m *MockModels) RecordSessionEvent(ctx context.Context, event *SessionEvent) error {
+	if event.CountryCode == ModelsReturnErrorCountryCode {
+		return errors.New("MockModels RecordSessionEvent return error")
+	}
+	if event.SessionID == "" {
+		return nil
+	}
+
+	e := *event
+	if e.Kind == SearchSessionEvent {
+		e.Query = NormalizeSearchQuery(e.Query)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sessions = append(m.sessions, &e)
+	return nil
+}
+
+// GetSessionViewedArticles is the mock function of GetSessionViewedArticles.
+func (m *MockModels) GetSessionViewedArticles(ctx context.Context, sessionID string) ([]int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	values := make([]string, 0)
+	for i := len(m.sessions) - 1; i >= 0; i-- {
+		if e := m.sessions[i]; e.SessionID == sessionID && e.Kind == ViewSessionEvent {
+			values = append(values, strconv.Itoa(e.ArticleID))
+		}
+	}
+	ids := distinctArticleIDs(values)
+	if len(ids) > MaxSessionViews {
+		ids = ids[:MaxSessionViews]
+	}
+	return ids, nil
+}
+
+// FlushSessionEvents is the mock function of FlushSessionEvents, it returns the number of the recorded events.
+func (m *MockModels) FlushSessionEvents(ctx context.Context) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return len(m.sessions), nil
+}
+
+// DeleteSessionEventsBefore is the mock function of DeleteSessionEventsBefore.
+func (m *MockModels) DeleteSessionEventsBefore(ctx context.Context, before time.Time) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	kept := make([]*SessionEvent, 0, len(m.sessions))
+	for _, e := range m.sessions {
+		if !e.CreatedAt.Before(before) {
+			kept = append(kept, e)
+		}
+	}
+	deleted := len(m.sessions) - len(kept)
+	m.sessions = kept
+	return deleted, nil
+}
+
+// GetDeflectionStats is the mock function of GetDeflectionStats, the window is ignored and
+// all the articles are in the category of GetCategoryByArticleID.
+func (m *MockModels) GetDeflectionStats(ctx context.Context, params *GetDeflectionStatsParams) ([]*DeflectionStat, error) {
+	if params.CountryCode == ModelsReturnErrorCountryCode {
+		return nil, errors.New("MockModels GetDeflectionStats return error")
+	}
+
+	var groupOf func(articleID int) int
+	switch params.Group {
+	case ArticleDeflection:
+		groupOf = func(articleID int) int { return articleID }
+	case CategoryDeflection:
+		groupOf = func(articleID int) int { return 3345678 }
+	default:
+		return nil, errors.Errorf("MockModels GetDeflectionStats unknown group:%q", params.Group)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// The session is deflected from the group unless a request is submitted after a view of the group.
+	viewed := make(map[int]map[string]bool)
+	for i, e := range m.sessions {
+		if e.Kind != ViewSessionEvent || e.CountryCode != params.CountryCode || e.Locale != params.Locale {
+			continue
+		}
+		id := groupOf(e.ArticleID)
+		if viewed[id] == nil {
+			viewed[id] = make(map[string]bool)
+		}
+		filed := false
+		for _, later := range m.sessions[i+1:] {
+			if later.SessionID == e.SessionID && later.Kind == RequestSessionEvent && later.CountryCode == params.CountryCode {
+				filed = true
+			}
+		}
+		viewed[id][e.SessionID] = viewed[id][e.SessionID] || filed
+	}
+
+	stats := make([]*DeflectionStat, 0, len(viewed))
+	for id, sessions := range viewed {
+		stat := &DeflectionStat{ID: id, Sessions: len(sessions)}
+		for _, filed := range sessions {
+			if !filed {
+				stat.Deflected++
+			}
+		}
+		stat.DeflectionRate = float64(stat.Deflected) / float64(stat.Sessions)
+		stats = append(stats, stat)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Sessions != stats[j].Sessions {
+			return stats[i].Sessions > stats[j].Sessions
+		}
+		return stats[i].ID < stats[j].ID
+	})
+
+	if uint64(len(stats)) > params.Limit {
+		stats = stats[:params.Limit]
+	}
+	return stats, nil
+}
+
+// RecordedSessionEvents returns the copies of the recorded session events in order.
+func (m *MockModels) RecordedSessionEvents() []*SessionEvent {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	events := make([]*SessionEvent, len(m.sessions))
+	for i, e := range m.sessions {
+		c := *e
+		events[i] = &c
+	}
+	return events
+}
+
+func mockArticleVoteKey(articleID int, countryCode, voter string) string {
+	return fmt.Sprintf("%s_%d_%s", countryCode, articleID, voter)
+}
+
+// LockArticleVote is the mock function of LockArticleVote.
+func (m *MockModels) LockArticleVote(ctx context.Context, articleID int, countryCode, voter string) (bool, error) {
+	if countryCode == ModelsReturnErrorCountryCode {
+		return false, errors.New("MockModels LockArticleVote return error")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.voteLocks == nil {
+		m.voteLocks = make(map[string]bool)
+	}
+	key := mockArticleVoteKey(articleID, countryCode, voter)
+	if m.voteLocks[key] {
+		return false, nil
+	}
+	m.voteLocks[key] = true
+	return true, nil
+}
+
+// PlusOneArticleVoteIPCounter is the mock function of PlusOneArticleVoteIPCounter, the window never ends.
+func (m *MockModels) PlusOneArticleVoteIPCounter(ctx context.Context, articleID int, countryCode, ip string, windowSec int) (int, error) {
+	if countryCode == ModelsReturnErrorCountryCode {
+		return 0, errors.New("MockModels PlusOneArticleVoteIPCounter return error")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.voteIPs == nil {
+		m.voteIPs = make(map[string]int)
+	}
+	key := mockArticleVoteKey(articleID, countryCode, ip)
+	m.voteIPs[key]++
+	return m.voteIPs[key], nil
+}
+
+// UnlockArticleVote is the mock function of UnlockArticleVote.
+func (m *MockModels) UnlockArticleVote(ctx context.Context, articleID int, countryCode, voter string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.voteLocks, mockArticleVoteKey(articleID, countryCode, voter))
+	return nil
+}
+
+// GetArticleVote is the mock function of GetArticleVote.
+func (m *MockModels) GetArticleVote(ctx context.Context, articleID int, countryCode, voter string) (*ArticleVote, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	vote, ok := m.votes[mockArticleVoteKey(articleID, countryCode, voter)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	ret := *vote
+	return &ret, nil
+}
+
+// SaveArticleVote is the mock function of SaveArticleVote, the votes are recorded on today.
+func (m *MockModels) SaveArticleVote(ctx context.Context, vote *ArticleVote, previousValue string) error {
+	if vote.CountryCode == ModelsReturnErrorCountryCode {
+		return errors.New("MockModels SaveArticleVote return error")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.votes == nil {
+		m.votes = make(map[string]*ArticleVote)
+	}
+	saved := *vote
+	m.votes[mockArticleVoteKey(vote.ArticleID, vote.CountryCode, vote.Voter)] = &saved
+	m.voteEvents = append(m.voteEvents, &mockArticleVoteEvent{
+		vote:          saved,
+		previousValue: previousValue,
+		day:           time.Now().UTC().Format(articleViewsDayLayout),
+	})
 	return nil
 }
 
+// GetArticleVoteHistory is the mock function of GetArticleVoteHistory, the window is ignored.
+func (m *MockModels) GetArticleVoteHistory(ctx context.Context, params *GetArticleVoteHistoryParams) ([]*ArticleVoteDay, error) {
+	if params.CountryCode == ModelsReturnErrorCountryCode {
+		return nil, errors.New("MockModels GetArticleVoteHistory return error")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ret := make([]*ArticleVoteDay, 0)
+	upvotes, downvotes := 0, 0
+	for _, e := range m.voteEvents {
+		if e.vote.ArticleID != params.ArticleID || e.vote.CountryCode != params.CountryCode {
+			continue
+		}
+		if len(ret) == 0 || ret[len(ret)-1].Day != e.day {
+			ret = append(ret, &ArticleVoteDay{Day: e.day})
+		}
+		day := ret[len(ret)-1]
+		day.Votes++
+		switch e.vote.Value {
+		case UpVote:
+			upvotes++
+		case DownVote:
+			downvotes++
+		}
+		switch e.previousValue {
+		case UpVote:
+			upvotes--
+		case DownVote:
+			downvotes--
+		}
+		day.Upvotes, day.Downvotes = upvotes, downvotes
+		if upvotes+downvotes > 0 {
+			day.HelpfulnessRatio = float64(upvotes) / float64(upvotes+downvotes)
+		}
+	}
+	return ret, nil
+}
+
 // GetTopNArticles is the mock function of GetTopNArticles.
-func (m *MockModels) GetTopNArticles(ctx context.Context, topN uint64, locale, countryCode string) ([]*Article, error) {
-	switch countryCode {
+func (m *MockModels) GetTopNArticles(ctx context.Context, params *GetTopNArticlesParams) ([]*Article, error) {
+	switch params.CountryCode {
 	case ModelsReturnErrorCountryCode:
 		return nil, errors.New("MockModels GetTopNArticles return error")
 	case ModelsReturnNotFoundCountryCode:
 		return nil, ErrNotFound
 	}
 
-	switch topN {
+	switch params.TopN {
 	case 4:
 		return []*Article{
 			&Article{
@@ -1405,6 +1963,13 @@ func (m *MockModels) GetTicketFormGraphQL(ctx context.Context, formID int) (*Syn
 
 // GetTicketFieldByFieldID is the mock function of GetTicketFieldByFieldID.
 func (m *MockModels) GetTicketFieldByFieldID(ctx context.Context, id int, locale string) (*TicketField, error) {
+	switch locale {
+	case ModelsReturnErrorLocale:
+		return nil, errors.New("MockModels GetTicketFieldByFieldID return error")
+	case ModelsReturnNotFoundLocale:
+		return nil, ErrNotFound
+	}
+
 	return &TicketField{
 		ID:                  81469808,
 		Type:                "text",
@@ -1604,3 +2169,192 @@ func (m *MockModels) GetDynamicContentItem(ctx context.Context, placeholder, loc
 		VariantsUpdatedAt: FixUpdatedAt1,
 	}, nil
 }
+
+// RenderDynamicContent is the mock function of RenderDynamicContent.
+func (m *MockModels) RenderDynamicContent(ctx context.Context, text, locale string) (string, error) {
+	return text, nil
+}
+
+// PlusOneRequestRateCounter is the mock function of PlusOneRequestRateCounter.
+func (m *MockModels) PlusOneRequestRateCounter(ctx context.Context, kind, identity string, windowSec int) (int, error) {
+	switch identity {
+	case RateLimitedRemoteIP, RateLimitedEmail:
+		return RateLimitedCount, nil
+	case RateCounterReturnErrorRemoteIP:
+		return 0, errors.New("MockModels PlusOneRequestRateCounter return error")
+	}
+	return 1, nil
+}
+
+// MarkRequestDigest is the mock function of MarkRequestDigest.
+func (m *MockModels) MarkRequestDigest(ctx context.Context, digest string, windowSec int) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.digests == nil {
+		m.digests = make(map[string]bool)
+	}
+	if m.digests[digest] {
+		return false, nil
+	}
+	m.digests[digest] = true
+	return true, nil
+}
+
+// UnmarkRequestDigest is the mock function of UnmarkRequestDigest.
+func (m *MockModels) UnmarkRequestDigest(ctx context.Context, digest string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.digests, digest)
+	return nil
+}
+
+// CreateRejectedRequest is the mock function of CreateRejectedRequest.
+func (m *MockModels) CreateRejectedRequest(ctx context.Context, request *RejectedRequest) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.Sequence != nil {
+		m.Sequence["CreateRejectedRequest"] = true
+	}
+	return nil
+}
+
+// PublishEvent is the mock function of PublishEvent, the event is kept for PublishedEvents
+// and sent to the subscribers of the mock.
+func (m *MockModels) PublishEvent(ctx context.Context, event *Event) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	event.Seq = int64(len(m.events) + 1)
+	m.events = append(m.events, event)
+	for subscriber := range m.subscribers {
+		select {
+		case subscriber <- event:
+		default:
+		}
+	}
+	return nil
+}
+
+// SubscribeEvents is the mock function of SubscribeEvents.
+func (m *MockModels) SubscribeEvents(ctx context.Context) (<-chan *Event, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.subscribers == nil {
+		m.subscribers = make(map[chan *Event]struct{})
+	}
+	events := make(chan *Event, 16)
+	m.subscribers[events] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		delete(m.subscribers, events)
+		close(events)
+	}()
+
+	return events, nil
+}
+
+// GetEventsAfter is the mock function of GetEventsAfter.
+func (m *MockModels) GetEventsAfter(ctx context.Context, seq int64, limit int) ([]*Event, error) {
+	if seq == EventsExpiredSeq {
+		return nil, ErrEventsExpired
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ret := make([]*Event, 0)
+	for _, event := range m.events {
+		if event.Seq > seq && len(ret) < limit {
+			ret = append(ret, event)
+		}
+	}
+	return ret, nil
+}
+
+// PublishedEvents returns the events published to the mock.
+func (m *MockModels) PublishedEvents() []*Event {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]*Event(nil), m.events...)
+}
+
+// GetPersistedQuery is the mock function of GetPersistedQuery.
+func (m *MockModels) GetPersistedQuery(ctx context.Context, hash string, ttlSec int) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	query, ok := m.queries[hash]
+	if !ok {
+		return "", ErrNotFound
+	}
+	return query, nil
+}
+
+// SetPersistedQuery is the mock function of SetPersistedQuery.
+func (m *MockModels) SetPersistedQuery(ctx context.Context, hash, query string, ttlSec int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.queries == nil {
+		m.queries = make(map[string]string)
+	}
+	m.queries[hash] = query
+	return nil
+}
+
+// SpendCostBudget is the mock function of SpendCostBudget, the window never ends.
+func (m *MockModels) SpendCostBudget(ctx context.Context, client string, cost, budget, windowSec int) (int, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.spent == nil {
+		m.spent = make(map[string]int)
+	}
+	if m.spent[client]+cost > budget {
+		return budget - m.spent[client], false, nil
+	}
+	m.spent[client] += cost
+	return budget - m.spent[client], true, nil
+}
+
+// PingDatabase is the mock function of PingDatabase.
+func (m *MockModels) PingDatabase(ctx context.Context) error {
+	return m.PingDatabaseErr
+}
+
+// PingCache is the mock function of PingCache.
+func (m *MockModels) PingCache(ctx context.Context) error {
+	return m.PingCacheErr
+}
+
+// SetLastSync is the mock function of SetLastSync.
+func (m *MockModels) SetLastSync(ctx context.Context, countryCode string, syncedAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.lastSyncs == nil {
+		m.lastSyncs = make(map[string]time.Time)
+	}
+	m.lastSyncs[countryCode] = syncedAt
+	return nil
+}
+
+// GetLastSyncs is the mock function of GetLastSyncs.
+func (m *MockModels) GetLastSyncs(ctx context.Context) (map[string]time.Time, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	syncs := make(map[string]time.Time, len(m.lastSyncs))
+	for countryCode, syncedAt := range m.lastSyncs {
+		syncs[countryCode] = syncedAt
+	}
+	return syncs, nil
+}
@@ -17,12 +17,21 @@ var (
 type Service interface {
 	categoriesService
 	articlesService
+	articleViewsService
+	searchQueriesService
+	sessionEventsService
+	articleVotesService
 	sectionsService
 	ticketFormsService
 	ticketFieldsService
 	dynamicContentService
 	counterService
 	dataloaderService
+	abuseService
+	eventsService
+	persistedQueriesService
+	costBudgetService
+	healthService
 	Close() error
 }
 
@@ -31,6 +40,10 @@ type Service interface {
 type HelpDeskService interface {
 	categoriesService
 	articlesService
+	articleViewsService
+	searchQueriesService
+	sessionEventsService
+	articleVotesService
 	sectionsService
 	ticketFormsService
 	ticketFieldsService
@@ -41,11 +54,20 @@ type service struct {
 	*categoriesOps
 	*sectionsOps
 	*articlesOps
+	*articleViewsOps
+	*searchQueriesOps
+	*sessionEventsOps
+	*articleVotesOps
 	*ticketFormsOps
 	*ticketFieldsOps
 	*dynamicContentOps
 	*counterOps
 	*dataloaderOps
+	*abuseOps
+	*eventsOps
+	*persistedQueriesOps
+	*costBudgetOps
+	*healthOps
 	close func() error
 }
 
@@ -70,18 +92,27 @@ func New(conf *config.Config) (Service, error) {
 		return nil, errors.Wrapf(err, "model: [New] new redis failed")
 	}
 
-	dcOps := &dynamicContentOps{d}
+	dcOps := &dynamicContentOps{db: d, cache: dlc}
 	fieldsOps := &ticketFieldsOps{db: d, dcOps: dcOps}
 
 	return &service{
-		categoriesOps:     &categoriesOps{d},
-		sectionsOps:       &sectionsOps{d},
-		articlesOps:       &articlesOps{d},
-		counterOps:        &counterOps{cc},
-		dataloaderOps:     &dataloaderOps{dlc},
-		ticketFormsOps:    &ticketFormsOps{db: d, fieldsOps: fieldsOps, dcOps: dcOps},
-		ticketFieldsOps:   fieldsOps,
-		dynamicContentOps: dcOps,
+		categoriesOps:       &categoriesOps{db: d, dcOps: dcOps},
+		sectionsOps:         &sectionsOps{db: d, dcOps: dcOps},
+		articlesOps:         &articlesOps{db: d, dcOps: dcOps},
+		articleViewsOps:     &articleViewsOps{db: d, cache: cc},
+		searchQueriesOps:    &searchQueriesOps{db: d, cache: cc},
+		sessionEventsOps:    &sessionEventsOps{db: d, cache: cc, ttlSec: conf.Analytics.SessionTTLSec},
+		articleVotesOps:     &articleVotesOps{db: d, cache: cc},
+		counterOps:          &counterOps{cc},
+		dataloaderOps:       &dataloaderOps{dlc},
+		ticketFormsOps:      &ticketFormsOps{db: d, fieldsOps: fieldsOps, dcOps: dcOps},
+		ticketFieldsOps:     fieldsOps,
+		dynamicContentOps:   dcOps,
+		abuseOps:            &abuseOps{db: d, cache: cc},
+		eventsOps:           &eventsOps{cache: cc},
+		persistedQueriesOps: &persistedQueriesOps{cache: dlc},
+		costBudgetOps:       &costBudgetOps{cache: cc},
+		healthOps:           &healthOps{db: d, caches: map[string]cache.Cache{"counter": cc, "dataloader": dlc}, cache: cc},
 		close: func() error {
 			derr := errors.Wrapf(d.Close(), "db close failed")
 			ccerr := errors.Wrapf(cc.Close(), "counter cache close failed")
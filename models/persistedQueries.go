@@ -0,0 +1,55 @@
+package models
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/garyburd/redigo/redis"
+	"github.com/pkg/errors"
+
+	"github.com/honestbee/Zen/internal/cache"
+)
+
+const (
+	persistedQueryForm = "zen_persisted_query_%s"
+)
+
+type persistedQueriesService interface {
+	GetPersistedQuery(ctx context.Context, hash string, ttlSec int) (string, error)
+	SetPersistedQuery(ctx context.Context, hash, query string, ttlSec int) error
+}
+
+type persistedQueriesOps struct {
+	cache cache.Cache
+}
+
+// GetPersistedQuery returns the automatic persisted query of the sha256 hash and refreshes its TTL,
+// ErrNotFound is returned if the query is not registered or has expired.
+func (p *persistedQueriesOps) GetPersistedQuery(ctx context.Context, hash string, ttlSec int) (string, error) {
+	key := fmt.Sprintf(persistedQueryForm, hash)
+	reply, err := p.cache.StringDo(ctx, "GET", key)
+	if err == redis.ErrNil {
+		return "", ErrNotFound
+	} else if err != nil {
+		return "", errors.Wrapf(err, "models: [GetPersistedQuery] cache StringDo failed")
+	}
+
+	// Update TTL.
+	if ttlSec > 0 {
+		p.cache.BoolDo(ctx, "EXPIRE", key, ttlSec)
+	}
+	return reply, nil
+}
+
+// SetPersistedQuery registers the automatic persisted query of the sha256 hash, 0 ttlSec keeps it forever.
+func (p *persistedQueriesOps) SetPersistedQuery(ctx context.Context, hash, query string, ttlSec int) error {
+	key := fmt.Sprintf(persistedQueryForm, hash)
+
+	var err error
+	if ttlSec > 0 {
+		_, err = p.cache.StringDo(ctx, "SETEX", key, ttlSec, query)
+	} else {
+		_, err = p.cache.StringDo(ctx, "SET", key, query)
+	}
+	return errors.Wrapf(err, "models: [SetPersistedQuery] cache StringDo failed")
+}
@@ -0,0 +1,315 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/honestbee/Zen/internal/cache"
+	"github.com/honestbee/Zen/internal/db"
+	"github.com/honestbee/Zen/redact"
+)
+
+const (
+	// searchQueriesBufferKey is the hash of the search queries not flushed yet, the fields are
+	// "day|country_code|locale|kind|article_id|query" and the values are the counts.
+	// The query is the last part since it may contain the separator.
+	searchQueriesBufferKey = "zen_search_queries_buffer"
+
+	searchKind      = "search"
+	zeroResultsKind = "zero_results"
+	clickKind       = "click"
+
+	// MaxSearchQueryLength is the max runes of the recorded query, the longer queries are truncated.
+	MaxSearchQueryLength = 200
+)
+
+// SearchQueryReport is the kind of the search query report.
+type SearchQueryReport string
+
+const (
+	// TopSearchQueries ranks the queries by the searches.
+	TopSearchQueries SearchQueryReport = "top"
+	// ZeroResultSearchQueries ranks the queries found nothing by the zero result searches.
+	ZeroResultSearchQueries SearchQueryReport = "zero_results"
+	// ClickThroughSearchQueries ranks the queries by the click-through rate, the least clicked first.
+	ClickThroughSearchQueries SearchQueryReport = "click_through"
+)
+
+type searchQueriesService interface {
+	RecordSearchQuery(ctx context.Context, query, locale, countryCode string, results int) error
+	RecordSearchClick(ctx context.Context, query string, articleID int, locale, countryCode string) error
+	FlushSearchQueries(ctx context.Context) (int, error)
+	DeleteSearchQueriesBefore(ctx context.Context, before time.Time) (int, error)
+	GetSearchQueryStats(ctx context.Context, params *GetSearchQueryStatsParams) ([]*SearchQueryStat, error)
+}
+
+// GetSearchQueryStatsParams is the params of GetSearchQueryStats, the stats are summed up in the last WindowDays days.
+type GetSearchQueryStatsParams struct {
+	Report      SearchQueryReport
+	Limit       uint64
+	WindowDays  int
+	Locale      string
+	CountryCode string
+}
+
+// SearchQueryStat is the stat of a normalized search query.
+type SearchQueryStat struct {
+	Query            string  `json:"query"`
+	Searches         int     `json:"searches"`
+	ZeroResults      int     `json:"zero_results"`
+	Clicks           int     `json:"clicks"`
+	ClickThroughRate float64 `json:"click_through_rate"`
+}
+
+type searchQueriesOps struct {
+	db    db.Database
+	cache cache.Cache
+}
+
+const (
+	upsertSearchQueriesQuery = `
+	INSERT INTO search_queries (query, country_code, locale, day, searches, zero_results)
+	VALUES (:query, :country_code, :locale, :day, :searches, :zero_results)
+	ON CONFLICT (query, country_code, locale, day) DO UPDATE SET
+	searches = search_queries.searches + EXCLUDED.searches,
+	zero_results = search_queries.zero_results + EXCLUDED.zero_results`
+
+	upsertSearchClicksQuery = `
+	INSERT INTO search_clicks (query, article_id, country_code, locale, day, clicks)
+	VALUES (:query, :article_id, :country_code, :locale, :day, :clicks)
+	ON CONFLICT (query, article_id, country_code, locale, day) DO UPDATE SET clicks = search_clicks.clicks + EXCLUDED.clicks`
+
+	deleteSearchQueriesQuery = `DELETE FROM search_queries WHERE day < :before`
+	deleteSearchClicksQuery  = `DELETE FROM search_clicks WHERE day < :before`
+)
+
+// NormalizeSearchQuery scrubs the personal data by redact.String, lowercases the query, collapses the whitespaces
+// and truncates it to MaxSearchQueryLength runes, so the same questions typed differently are counted together.
+// The recorded searches, clicks and session events all keep the normalized query only.
+func NormalizeSearchQuery(query string) string {
+	query = strings.Join(strings.Fields(strings.ToLower(redact.String(query))), " ")
+	if runes := []rune(query); len(runes) > MaxSearchQueryLength {
+		query = strings.TrimSpace(string(runes[:MaxSearchQueryLength]))
+	}
+	return query
+}
+
+// RecordSearchQuery buffers a search of the query on today in UTC with the number of the results,
+// it is flushed by FlushSearchQueries. The blank query is ignored.
+func (s *searchQueriesOps) RecordSearchQuery(ctx context.Context, query, locale, countryCode string, results int) error {
+	query = NormalizeSearchQuery(query)
+	if query == "" {
+		return nil
+	}
+
+	if _, err := s.cache.IntDo(ctx, "HINCRBY", searchQueriesBufferKey, searchQueryField(searchKind, 0, query, locale, countryCode), 1); err != nil {
+		return errors.Wrapf(err, "models: [RecordSearchQuery] cache IntDo failed")
+	}
+	if results == 0 {
+		_, err := s.cache.IntDo(ctx, "HINCRBY", searchQueriesBufferKey, searchQueryField(zeroResultsKind, 0, query, locale, countryCode), 1)
+		return errors.Wrapf(err, "models: [RecordSearchQuery] cache IntDo failed")
+	}
+	return nil
+}
+
+// RecordSearchClick buffers a click of the article from the results of the query on today in UTC,
+// it is flushed by FlushSearchQueries. The blank query is ignored.
+func (s *searchQueriesOps) RecordSearchClick(ctx context.Context, query string, articleID int, locale, countryCode string) error {
+	query = NormalizeSearchQuery(query)
+	if query == "" {
+		return nil
+	}
+
+	_, err := s.cache.IntDo(ctx, "HINCRBY", searchQueriesBufferKey, searchQueryField(clickKind, articleID, query, locale, countryCode), 1)
+	return errors.Wrapf(err, "models: [RecordSearchClick] cache IntDo failed")
+}
+
+// FlushSearchQueries adds the buffered searches and clicks into the daily rollups, returns the number of the flushed
+// rollup rows. The searches and clicks are put back into the buffer if the flush failed.
+func (s *searchQueriesOps) FlushSearchQueries(ctx context.Context) (int, error) {
+	fields, err := s.cache.StringsDo(ctx, "EVAL", takeHashScript, 1, searchQueriesBufferKey)
+	if err != nil {
+		return 0, errors.Wrapf(err, "models: [FlushSearchQueries] cache StringsDo failed")
+	}
+	if len(fields) == 0 {
+		return 0, nil
+	}
+
+	queries, clicks := parseSearchQueries(fields)
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		restoreBuffer(ctx, s.cache, searchQueriesBufferKey, fields)
+		return 0, errors.Wrapf(err, "models: [FlushSearchQueries] db.Begin failed")
+	}
+	for _, row := range queries {
+		tx.NamedExec(upsertSearchQueriesQuery, row)
+	}
+	for _, row := range clicks {
+		tx.NamedExec(upsertSearchClicksQuery, row)
+	}
+	tx.Commit()
+
+	if err = tx.Err(); err != nil {
+		restoreBuffer(ctx, s.cache, searchQueriesBufferKey, fields)
+		return 0, errors.Wrapf(err, "models: [FlushSearchQueries] db transaction failed")
+	}
+	return len(queries) + len(clicks), nil
+}
+
+// DeleteSearchQueriesBefore deletes the daily rollups of the searches and clicks of the days before the time,
+// returns the number of the deleted rows. It keeps the free text queries only as long as they are reported.
+func (s *searchQueriesOps) DeleteSearchQueriesBefore(ctx context.Context, before time.Time) (int, error) {
+	arg := map[string]interface{}{"before": before.UTC().Format(articleViewsDayLayout)}
+
+	deleted := 0
+	for _, query := range []string{deleteSearchQueriesQuery, deleteSearchClicksQuery} {
+		result, err := s.db.NamedExec(ctx, query, arg)
+		if err != nil {
+			return deleted, errors.Wrapf(err, "models: [DeleteSearchQueriesBefore] db.NamedExec failed")
+		}
+		n, err := result.RowsAffected()
+		if err != nil {
+			return deleted, errors.Wrapf(err, "models: [DeleteSearchQueriesBefore] result.RowsAffected failed")
+		}
+		deleted += int(n)
+	}
+	return deleted, nil
+}
+
+// GetSearchQueryStats returns the stats of the queries searched in the window ranked by the report.
+func (s *searchQueriesOps) GetSearchQueryStats(ctx context.Context, params *GetSearchQueryStatsParams) ([]*SearchQueryStat, error) {
+	query, err := searchQueryStatsQuery(params)
+	if err != nil {
+		return nil, errors.Wrapf(err, "models: [GetSearchQueryStats] searchQueryStatsQuery failed")
+	}
+
+	stats := make([]*db.SearchQueryStats, 0)
+	if err := s.db.Select(ctx, &stats, query); err != nil {
+		return nil, errors.Wrapf(err, "models: [GetSearchQueryStats] db query failed")
+	}
+
+	ret := make([]*SearchQueryStat, len(stats))
+	for i, stat := range stats {
+		ret[i] = &SearchQueryStat{
+			Query:            stat.Query,
+			Searches:         stat.Searches,
+			ZeroResults:      stat.ZeroResults,
+			Clicks:           stat.Clicks,
+			ClickThroughRate: stat.ClickThroughRate,
+		}
+	}
+	return ret, nil
+}
+
+func searchQueryField(kind string, articleID int, query, locale, countryCode string) string {
+	return strings.Join([]string{
+		time.Now().UTC().Format(articleViewsDayLayout),
+		countryCode,
+		locale,
+		kind,
+		strconv.Itoa(articleID),
+		query,
+	}, "|")
+}
+
+// parseSearchQueries sums up the buffered fields into the rollup rows, the malformed fields can't be
+// flushed, so they are dropped instead of put back.
+func parseSearchQueries(fields []string) ([]*db.SearchQueries, []*db.SearchClicks) {
+	queries := make(map[string]*db.SearchQueries)
+	clicks := make([]*db.SearchClicks, 0)
+	for i := 0; i+1 < len(fields); i += 2 {
+		parts := strings.SplitN(fields[i], "|", 6)
+		if len(parts) != 6 {
+			continue
+		}
+		day, err := time.Parse(articleViewsDayLayout, parts[0])
+		if err != nil {
+			continue
+		}
+		articleID, err := strconv.Atoi(parts[4])
+		if err != nil {
+			continue
+		}
+		count, err := strconv.Atoi(fields[i+1])
+		if err != nil {
+			continue
+		}
+
+		if parts[3] == clickKind {
+			clicks = append(clicks, &db.SearchClicks{
+				Query:       parts[5],
+				ArticleID:   articleID,
+				CountryCode: parts[1],
+				Locale:      parts[2],
+				Day:         day,
+				Clicks:      count,
+			})
+			continue
+		}
+
+		// The searches and the zero result searches of a query are in the same rollup row.
+		key := strings.Join([]string{parts[0], parts[1], parts[2], parts[5]}, "|")
+		row, ok := queries[key]
+		if !ok {
+			row = &db.SearchQueries{
+				Query:       parts[5],
+				CountryCode: parts[1],
+				Locale:      parts[2],
+				Day:         day,
+			}
+			queries[key] = row
+		}
+		switch parts[3] {
+		case searchKind:
+			row.Searches += count
+		case zeroResultsKind:
+			row.ZeroResults += count
+		}
+	}
+
+	ret := make([]*db.SearchQueries, 0, len(queries))
+	for _, row := range queries {
+		ret = append(ret, row)
+	}
+	return ret, clicks
+}
+
+// searchQueryStatsQuery returns the query summing up the searches and clicks of the window ranked by the report.
+func searchQueryStatsQuery(params *GetSearchQueryStatsParams) (string, error) {
+	filter, order := "", ""
+	switch params.Report {
+	case TopSearchQueries:
+		order = "searches DESC, query ASC"
+	case ZeroResultSearchQueries:
+		filter = "WHERE zero_results > 0"
+		order = "zero_results DESC, searches DESC, query ASC"
+	case ClickThroughSearchQueries:
+		order = "click_through_rate ASC, searches DESC, query ASC"
+	default:
+		return "", errors.Errorf("models: [searchQueryStatsQuery] unknown report:%q", params.Report)
+	}
+
+	// The days are recorded in UTC, the window includes today.
+	since := time.Now().UTC().AddDate(0, 0, -params.WindowDays).Format(articleViewsDayLayout)
+	return fmt.Sprintf(
+		`SELECT * FROM (
+			SELECT searched.query, searched.searches, searched.zero_results, COALESCE(clicked.clicks, 0) AS clicks,
+			COALESCE(clicked.clicks, 0)::float / searched.searches AS click_through_rate
+			FROM (SELECT query, SUM(searches) AS searches, SUM(zero_results) AS zero_results FROM search_queries
+			WHERE country_code = '%s' AND locale = '%s' AND day > '%s' GROUP BY query) searched
+			LEFT JOIN (SELECT query, SUM(clicks) AS clicks FROM search_clicks
+			WHERE country_code = '%s' AND locale = '%s' AND day > '%s' GROUP BY query) clicked
+			ON clicked.query = searched.query
+		) stats %s
+		ORDER BY %s LIMIT %d`,
+		params.CountryCode, params.Locale, since,
+		params.CountryCode, params.Locale, since,
+		filter, order, params.Limit,
+	), nil
+}
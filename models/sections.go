@@ -11,11 +11,12 @@ import (
 )
 
 type sectionsService interface {
-	SyncWithSections(ctx context.Context, zendeskSections []*Section, countryCode, locale string) error
+	SyncWithSections(ctx context.Context, zendeskSections []*Section, countryCode, locale string) ([]int, error)
 	GetSections(ctx context.Context, params *GetSectionsParams) ([]*Section, int, error)
 	GetSectionsByCategoryID(ctx context.Context, params *GetSectionsParams) ([]*Section, int, error)
 	GetSectionBySectionID(ctx context.Context, sectionID int, locale, countryCode string) (*Section, error)
 	GetSectionByArticleID(ctx context.Context, articleID int, locale, countryCode string) (*Section, error)
+	GetSectionsByIDs(ctx context.Context, sectionIDs []int, locale, countryCode string) (map[int]*Section, error)
 }
 
 // Section is the section model.
@@ -36,7 +37,8 @@ type Section struct {
 }
 
 type sectionsOps struct {
-	db db.Database
+	db    db.Database
+	dcOps *dynamicContentOps
 }
 
 // GetSectionsParams is the params structure of requesting GetSections method.
@@ -48,6 +50,10 @@ type GetSectionsParams struct {
 	Page        int
 	SortBy      string
 	SortOrder   string
+	// After selects the rows sorted after the cursor, Page should be zero if it is set.
+	After *Cursor
+	// Keyset sorts the rows by keysetOrder for the listings paged by the cursors, it is required by After.
+	Keyset bool
 }
 
 const (
@@ -81,21 +87,23 @@ const (
 	deleteSectionTranslatesQuery = "DELETE FROM section_translates WHERE section_id = :section_id AND locale = :locale"
 )
 
-// SyncWithSections ensures the database data will be same as the input data.
-func (s *sectionsOps) SyncWithSections(ctx context.Context, zendeskSections []*Section, countryCode, locale string) error {
-	tx, err := s.db.Begin()
+// SyncWithSections ensures the database data will be same as the input data,
+// the ids of the created, updated and deleted sections are returned.
+func (s *sectionsOps) SyncWithSections(ctx context.Context, zendeskSections []*Section, countryCode, locale string) ([]int, error) {
+	tx, err := s.db.Begin(ctx)
 	if err != nil {
-		return errors.Wrapf(err, "models: [SyncWithSections] db.Begin failed")
+		return nil, errors.Wrapf(err, "models: [SyncWithSections] db.Begin failed")
 	}
 
-	ids := make([]int, 0)
-	query := fmt.Sprintf(`SELECT id FROM sections WHERE country_code = '%s'`, countryCode)
-	tx.Select(&ids, query)
+	rows := make([]*db.RowVersion, 0)
+	query := fmt.Sprintf(`SELECT id, updated_at FROM sections WHERE country_code = '%s'`, countryCode)
+	tx.Select(&rows, query)
 
-	dbIDs := make(map[int]struct{})
-	for _, id := range ids {
-		dbIDs[id] = struct{}{}
+	dbIDs := make(map[int]time.Time)
+	for _, row := range rows {
+		dbIDs[row.ID] = row.UpdatedAt
 	}
+	changedIDs := make([]int, 0)
 
 	for _, zendeskSection := range zendeskSections {
 		dbSection := &db.Sections{
@@ -117,27 +125,33 @@ func (s *sectionsOps) SyncWithSections(ctx context.Context, zendeskSections []*S
 			URL:         zendeskSection.URL,
 		}
 
-		if _, exist := dbIDs[zendeskSection.ID]; exist {
+		if updatedAt, exist := dbIDs[zendeskSection.ID]; exist {
 			tx.NamedExec(updateSectionsQuery, dbSection)
 
-			tranIDs := make([]int, 0)
+			trans := make([]*db.SectionTranslates, 0)
 			query = fmt.Sprintf(
-				`SELECT section_id FROM section_translates WHERE locale = '%s' AND section_id = '%d'`,
+				`SELECT section_id, name, description FROM section_translates WHERE locale = '%s' AND section_id = '%d'`,
 				locale,
 				zendeskSection.ID,
 			)
-			tx.Select(&tranIDs, query)
+			tx.Select(&trans, query)
 
-			if len(tranIDs) > 0 {
+			if len(trans) > 0 {
 				tx.NamedExec(updateSectionTranslates, translates)
 			} else {
 				tx.NamedExec(insertSectionTranslatesQuery, translates)
 			}
 
+			if !updatedAt.Equal(zendeskSection.UpdatedAt) || len(trans) == 0 ||
+				trans[0].Name != translates.Name || trans[0].Description != translates.Description {
+				changedIDs = append(changedIDs, zendeskSection.ID)
+			}
+
 			delete(dbIDs, zendeskSection.ID)
 		} else {
 			tx.NamedExec(insertSectionsQuery, dbSection)
 			tx.NamedExec(insertSectionTranslatesQuery, translates)
+			changedIDs = append(changedIDs, zendeskSection.ID)
 		}
 	}
 
@@ -151,20 +165,24 @@ func (s *sectionsOps) SyncWithSections(ctx context.Context, zendeskSections []*S
 		if total == 0 {
 			tx.NamedExec(deleteSectionsQuery, map[string]interface{}{"id": id, "country_code": countryCode})
 		}
+		changedIDs = append(changedIDs, id)
 	}
 	tx.Commit()
 
-	return errors.Wrapf(tx.Err(), "models: [SyncWithSections] db transaction failed")
+	if err = tx.Err(); err != nil {
+		return nil, errors.Wrapf(err, "models: [SyncWithSections] db transaction failed")
+	}
+	return changedIDs, nil
 }
 
 func (c *categoriesOps) GetSections(ctx context.Context, params *GetSectionsParams) ([]*Section, int, error) {
 	sections := make([]*db.Sections, 0)
 	query := fmt.Sprintf(
 		`SELECT category_id,id,position,created_at,updated_at,source_locale,outdated,country_code 
-		FROM sections WHERE country_code = '%s' ORDER BY %s %s, created_at DESC LIMIT %d OFFSET %d`,
+		FROM sections WHERE country_code = '%s' %s ORDER BY %s LIMIT %d OFFSET %d`,
 		params.CountryCode,
-		params.SortBy,
-		params.SortOrder,
+		keysetCondition(params.After, params.SortBy, params.SortOrder),
+		keysetOrder(params.Keyset, params.SortBy, params.SortOrder),
 		params.PerPage,
 		params.Page,
 	)
@@ -187,6 +205,9 @@ func (c *categoriesOps) GetSections(ctx context.Context, params *GetSectionsPara
 			}
 			return nil, 0, errors.Wrapf(err, "models: [GetSections] db get translates failed")
 		}
+		if err := c.dcOps.renderFields(ctx, params.Locale, &translates.Name, &translates.Description); err != nil {
+			return nil, 0, errors.Wrapf(err, "models: [GetSections] render dynamic content failed")
+		}
 
 		ret = append(ret, &Section{
 			CategoryID:   section.CategoryID,
@@ -224,11 +245,11 @@ func (c *categoriesOps) GetSectionsByCategoryID(ctx context.Context, params *Get
 	query := fmt.Sprintf(
 		`SELECT category_id,id,position,created_at,updated_at,source_locale,outdated,country_code 
 		FROM sections WHERE country_code = '%s' AND category_id = '%d' 
-		ORDER BY %s %s, created_at DESC LIMIT %d OFFSET %d`,
+		%s ORDER BY %s LIMIT %d OFFSET %d`,
 		params.CountryCode,
 		params.CategoryID,
-		params.SortBy,
-		params.SortOrder,
+		keysetCondition(params.After, params.SortBy, params.SortOrder),
+		keysetOrder(params.Keyset, params.SortBy, params.SortOrder),
 		params.PerPage,
 		params.Page,
 	)
@@ -251,6 +272,9 @@ func (c *categoriesOps) GetSectionsByCategoryID(ctx context.Context, params *Get
 			}
 			return nil, 0, errors.Wrapf(err, "models: [GetSectionsByCategoryID] db get translates failed")
 		}
+		if err := c.dcOps.renderFields(ctx, params.Locale, &translates.Name, &translates.Description); err != nil {
+			return nil, 0, errors.Wrapf(err, "models: [GetSectionsByCategoryID] render dynamic content failed")
+		}
 
 		ret = append(ret, &Section{
 			CategoryID:   section.CategoryID,
@@ -312,6 +336,9 @@ func (s *sectionsOps) GetSectionBySectionID(ctx context.Context, sectionID int,
 			return nil, errors.Wrapf(err, "models: [GetSection] db get translates failed")
 		}
 	}
+	if err := s.dcOps.renderFields(ctx, locale, &translates.Name, &translates.Description); err != nil {
+		return nil, errors.Wrapf(err, "models: [GetSectionBySectionID] render dynamic content failed")
+	}
 
 	ret := &Section{
 		ID:           section.ID,
@@ -367,6 +394,9 @@ func (s *sectionsOps) GetSectionByArticleID(ctx context.Context, articleID int,
 			return nil, errors.Wrapf(err, "models: [GetSectionByArticleID] db get translates failed")
 		}
 	}
+	if err := s.dcOps.renderFields(ctx, locale, &translates.Name, &translates.Description); err != nil {
+		return nil, errors.Wrapf(err, "models: [GetSectionByArticleID] render dynamic content failed")
+	}
 
 	ret := &Section{
 		ID:           section.ID,
@@ -386,3 +416,45 @@ func (s *sectionsOps) GetSectionByArticleID(ctx context.Context, articleID int,
 
 	return ret, nil
 }
+
+// GetSectionsByIDs returns the sections of the ids by one query, the map is keyed by the section id.
+// The ids without section are not in the map, the section without translates of the locale
+// has the empty translated fields as GetSectionBySectionID.
+func (s *sectionsOps) GetSectionsByIDs(ctx context.Context, sectionIDs []int, locale, countryCode string) (map[int]*Section, error) {
+	rows := make([]*db.Section, 0)
+	query := `SELECT sections.category_id,sections.id,sections.position,sections.created_at,sections.updated_at,
+		sections.source_locale,sections.outdated,sections.country_code,
+		COALESCE(section_translates.url, '') AS url,COALESCE(section_translates.html_url, '') AS html_url,
+		COALESCE(section_translates.name, '') AS name,COALESCE(section_translates.description, '') AS description,
+		COALESCE(section_translates.locale, '') AS locale
+		FROM sections 
+		LEFT JOIN section_translates ON section_translates.section_id = sections.id AND section_translates.locale = $2 
+		WHERE sections.id = ANY($1) AND sections.country_code = $3`
+	if err := s.db.Select(ctx, &rows, query, int64Array(sectionIDs), locale, countryCode); err != nil && err != db.ErrNoRows {
+		return nil, errors.Wrapf(err, "models: [GetSectionsByIDs] db select sections failed")
+	}
+
+	ret := make(map[int]*Section, len(rows))
+	for _, row := range rows {
+		if err := s.dcOps.renderFields(ctx, locale, &row.Name, &row.Description); err != nil {
+			return nil, errors.Wrapf(err, "models: [GetSectionsByIDs] render dynamic content failed")
+		}
+		ret[row.ID] = &Section{
+			ID:           row.ID,
+			CategoryID:   row.CategoryID,
+			CountryCode:  row.CountryCode,
+			CreatedAt:    row.CreatedAt,
+			Description:  row.Description,
+			Outdated:     row.Outdated,
+			Position:     row.Position,
+			SourceLocale: row.SourceLocale,
+			UpdatedAt:    row.UpdatedAt,
+			HTMLURL:      row.HTMLURL,
+			Locale:       row.Locale,
+			Name:         row.Name,
+			URL:          row.URL,
+		}
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,302 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/honestbee/Zen/internal/cache"
+	"github.com/honestbee/Zen/internal/db"
+)
+
+const (
+	// sessionEventsBufferKey is the list of the json encoded session events not flushed yet.
+	sessionEventsBufferKey = "zen_session_events_buffer"
+	// sessionViewsForm is the list of the articles viewed in a session, the latest first.
+	sessionViewsForm = "zen_session_views_%s"
+	// MaxSessionViews is the max number of the viewed articles kept for a session.
+	MaxSessionViews = 10
+)
+
+// takeListScript returns all the elements of the list and deletes it,
+// so the events recorded during the flush are kept for the next flush.
+const takeListScript = `
+local elements = redis.call("LRANGE", KEYS[1], 0, -1)
+redis.call("DEL", KEYS[1])
+return elements`
+
+// SessionEventKind is the kind of the session funnel event.
+type SessionEventKind string
+
+const (
+	// ViewSessionEvent is an article view.
+	ViewSessionEvent SessionEventKind = "view"
+	// SearchSessionEvent is a search.
+	SearchSessionEvent SessionEventKind = "search"
+	// VoteSessionEvent is an article vote.
+	VoteSessionEvent SessionEventKind = "vote"
+	// RequestSessionEvent is a submitted request, the session is not deflected.
+	RequestSessionEvent SessionEventKind = "request"
+)
+
+// DeflectionGroup is the grouping of the deflection report.
+type DeflectionGroup string
+
+const (
+	// ArticleDeflection groups the sessions by the viewed articles.
+	ArticleDeflection DeflectionGroup = "articles"
+	// CategoryDeflection groups the sessions by the categories of the viewed articles.
+	CategoryDeflection DeflectionGroup = "categories"
+)
+
+type sessionEventsService interface {
+	RecordSessionEvent(ctx context.Context, event *SessionEvent) error
+	GetSessionViewedArticles(ctx context.Context, sessionID string) ([]int, error)
+	FlushSessionEvents(ctx context.Context) (int, error)
+	DeleteSessionEventsBefore(ctx context.Context, before time.Time) (int, error)
+	GetDeflectionStats(ctx context.Context, params *GetDeflectionStatsParams) ([]*DeflectionStat, error)
+}
+
+// SessionEvent is an event of the anonymous session funnel.
+type SessionEvent struct {
+	SessionID   string           `json:"session_id"`
+	Kind        SessionEventKind `json:"kind"`
+	ArticleID   int              `json:"article_id,omitempty"`
+	Query       string           `json:"query,omitempty"`
+	CountryCode string           `json:"country_code"`
+	Locale      string           `json:"locale,omitempty"`
+	CreatedAt   time.Time        `json:"created_at"`
+}
+
+// GetDeflectionStatsParams is the params of GetDeflectionStats, the sessions are counted in the last WindowDays days.
+type GetDeflectionStatsParams struct {
+	Group       DeflectionGroup
+	Limit       uint64
+	WindowDays  int
+	Locale      string
+	CountryCode string
+}
+
+// DeflectionStat is the deflection of the sessions viewed an article or a category,
+// the session is deflected if no request is submitted after the view.
+type DeflectionStat struct {
+	ID             int     `json:"id"`
+	Sessions       int     `json:"sessions"`
+	Deflected      int     `json:"deflected"`
+	DeflectionRate float64 `json:"deflection_rate"`
+}
+
+type sessionEventsOps struct {
+	db    db.Database
+	cache cache.Cache
+	// ttlSec is the expiry of the articles viewed in a session.
+	ttlSec int
+}
+
+const (
+	insertSessionEventQuery = `
+	INSERT INTO session_events (session_id, kind, article_id, query, country_code, locale, created_at)
+	VALUES (:session_id, :kind, :article_id, :query, :country_code, :locale, :created_at)`
+
+	deleteSessionEventsQuery = `DELETE FROM session_events WHERE created_at < :before`
+)
+
+// RecordSessionEvent buffers the event, it is flushed by FlushSessionEvents. The event without
+// a session id is ignored. The viewed articles are kept for GetSessionViewedArticles.
+func (s *sessionEventsOps) RecordSessionEvent(ctx context.Context, event *SessionEvent) error {
+	if event.SessionID == "" {
+		return nil
+	}
+
+	e := *event
+	if e.CreatedAt.IsZero() {
+		e.CreatedAt = time.Now().UTC()
+	}
+	if e.Kind == SearchSessionEvent {
+		e.Query = NormalizeSearchQuery(e.Query)
+	}
+	b, err := json.Marshal(&e)
+	if err != nil {
+		return errors.Wrapf(err, "models: [RecordSessionEvent] json marshal failed")
+	}
+	if _, err := s.cache.IntDo(ctx, "RPUSH", sessionEventsBufferKey, b); err != nil {
+		return errors.Wrapf(err, "models: [RecordSessionEvent] cache IntDo failed")
+	}
+
+	if e.Kind != ViewSessionEvent {
+		return nil
+	}
+	key := fmt.Sprintf(sessionViewsForm, e.SessionID)
+	if _, err := s.cache.IntDo(ctx, "LPUSH", key, e.ArticleID); err != nil {
+		return errors.Wrapf(err, "models: [RecordSessionEvent] cache IntDo failed")
+	}
+	if _, err := s.cache.StringDo(ctx, "LTRIM", key, 0, MaxSessionViews-1); err != nil {
+		return errors.Wrapf(err, "models: [RecordSessionEvent] cache StringDo failed")
+	}
+	_, err = s.cache.BoolDo(ctx, "EXPIRE", key, s.ttlSec)
+	return errors.Wrapf(err, "models: [RecordSessionEvent] cache BoolDo failed")
+}
+
+// GetSessionViewedArticles returns the distinct articles viewed in the session, the latest first.
+func (s *sessionEventsOps) GetSessionViewedArticles(ctx context.Context, sessionID string) ([]int, error) {
+	if sessionID == "" {
+		return []int{}, nil
+	}
+
+	values, err := s.cache.StringsDo(ctx, "LRANGE", fmt.Sprintf(sessionViewsForm, sessionID), 0, -1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "models: [GetSessionViewedArticles] cache StringsDo failed")
+	}
+	return distinctArticleIDs(values), nil
+}
+
+// FlushSessionEvents inserts the buffered events, returns the number of the flushed events.
+// The events are put back into the buffer if the flush failed.
+func (s *sessionEventsOps) FlushSessionEvents(ctx context.Context) (int, error) {
+	values, err := s.cache.StringsDo(ctx, "EVAL", takeListScript, 1, sessionEventsBufferKey)
+	if err != nil {
+		return 0, errors.Wrapf(err, "models: [FlushSessionEvents] cache StringsDo failed")
+	}
+	if len(values) == 0 {
+		return 0, nil
+	}
+
+	rows := make([]*db.SessionEvents, 0, len(values))
+	for _, value := range values {
+		e := new(SessionEvent)
+		if err := json.Unmarshal([]byte(value), e); err != nil {
+			// The malformed event can't be flushed, so it's dropped instead of put back.
+			continue
+		}
+		rows = append(rows, &db.SessionEvents{
+			SessionID:   e.SessionID,
+			Kind:        string(e.Kind),
+			ArticleID:   e.ArticleID,
+			Query:       e.Query,
+			CountryCode: e.CountryCode,
+			Locale:      e.Locale,
+			CreatedAt:   e.CreatedAt,
+		})
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		s.restoreSessionEvents(ctx, values)
+		return 0, errors.Wrapf(err, "models: [FlushSessionEvents] db.Begin failed")
+	}
+	for _, row := range rows {
+		tx.NamedExec(insertSessionEventQuery, row)
+	}
+	tx.Commit()
+
+	if err = tx.Err(); err != nil {
+		s.restoreSessionEvents(ctx, values)
+		return 0, errors.Wrapf(err, "models: [FlushSessionEvents] db transaction failed")
+	}
+	return len(rows), nil
+}
+
+// restoreSessionEvents puts the taken events back into the buffer, the failure is ignored
+// since the events are not worth blocking the flush.
+func (s *sessionEventsOps) restoreSessionEvents(ctx context.Context, values []string) {
+	for _, value := range values {
+		s.cache.IntDo(ctx, "RPUSH", sessionEventsBufferKey, value)
+	}
+}
+
+// DeleteSessionEventsBefore deletes the events created before the time, returns the number of the deleted rows.
+func (s *sessionEventsOps) DeleteSessionEventsBefore(ctx context.Context, before time.Time) (int, error) {
+	result, err := s.db.NamedExec(ctx, deleteSessionEventsQuery, map[string]interface{}{"before": before.UTC()})
+	if err != nil {
+		return 0, errors.Wrapf(err, "models: [DeleteSessionEventsBefore] db.NamedExec failed")
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrapf(err, "models: [DeleteSessionEventsBefore] result.RowsAffected failed")
+	}
+	return int(n), nil
+}
+
+// GetDeflectionStats returns the deflection of the sessions in the window grouped by the articles or the categories,
+// the most viewed first.
+func (s *sessionEventsOps) GetDeflectionStats(ctx context.Context, params *GetDeflectionStatsParams) ([]*DeflectionStat, error) {
+	query, err := deflectionStatsQuery(params)
+	if err != nil {
+		return nil, errors.Wrapf(err, "models: [GetDeflectionStats] deflectionStatsQuery failed")
+	}
+
+	stats := make([]*db.DeflectionStats, 0)
+	if err := s.db.Select(ctx, &stats, query); err != nil {
+		return nil, errors.Wrapf(err, "models: [GetDeflectionStats] db query failed")
+	}
+
+	ret := make([]*DeflectionStat, len(stats))
+	for i, stat := range stats {
+		ret[i] = &DeflectionStat{
+			ID:             stat.ID,
+			Sessions:       stat.Sessions,
+			Deflected:      stat.Deflected,
+			DeflectionRate: stat.DeflectionRate,
+		}
+	}
+	return ret, nil
+}
+
+func distinctArticleIDs(values []string) []int {
+	seen := make(map[int]bool, len(values))
+	ids := make([]int, 0, len(values))
+	for _, value := range values {
+		id, err := strconv.Atoi(value)
+		if err != nil || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// deflectionStatsQuery returns the query counting the sessions viewed the articles and the sessions
+// submitted a request after the view, grouped by the articles or the categories of the articles.
+func deflectionStatsQuery(params *GetDeflectionStatsParams) (string, error) {
+	group, join := "", ""
+	switch params.Group {
+	case ArticleDeflection:
+		group = "viewed.article_id"
+	case CategoryDeflection:
+		group = "sections.category_id"
+		join = fmt.Sprintf(
+			`JOIN articles ON articles.id = viewed.article_id AND articles.country_code = '%s'
+			JOIN sections ON sections.id = articles.section_id AND sections.country_code = '%s'`,
+			params.CountryCode, params.CountryCode,
+		)
+	default:
+		return "", errors.Errorf("models: [deflectionStatsQuery] unknown group:%q", params.Group)
+	}
+
+	// The requests are not localized, so they are matched by the country only.
+	since := time.Now().UTC().AddDate(0, 0, -params.WindowDays).Format(time.RFC3339)
+	return fmt.Sprintf(
+		`SELECT %s AS id, COUNT(DISTINCT viewed.session_id) AS sessions,
+		COUNT(DISTINCT viewed.session_id) - COUNT(DISTINCT filed.session_id) AS deflected,
+		(COUNT(DISTINCT viewed.session_id) - COUNT(DISTINCT filed.session_id))::float / COUNT(DISTINCT viewed.session_id) AS deflection_rate
+		FROM (SELECT session_id, article_id, MIN(created_at) AS viewed_at FROM session_events
+		WHERE kind = '%s' AND country_code = '%s' AND locale = '%s' AND created_at > '%s'
+		GROUP BY session_id, article_id) viewed
+		LEFT JOIN (SELECT session_id, MAX(created_at) AS filed_at FROM session_events
+		WHERE kind = '%s' AND country_code = '%s' AND created_at > '%s'
+		GROUP BY session_id) filed
+		ON filed.session_id = viewed.session_id AND filed.filed_at >= viewed.viewed_at
+		%s
+		GROUP BY %s
+		ORDER BY sessions DESC, id ASC LIMIT %d`,
+		group,
+		ViewSessionEvent, params.CountryCode, params.Locale, since,
+		RequestSessionEvent, params.CountryCode, since,
+		join, group, params.Limit,
+	), nil
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"fmt"
+)
+
+// SurrogateKey returns the CDN cache key of the responses containing the category.
+func (c *Category) SurrogateKey() string {
+	return fmt.Sprintf("category-%d", c.ID)
+}
+
+// SurrogateKey returns the CDN cache key of the responses containing the section.
+func (s *Section) SurrogateKey() string {
+	return fmt.Sprintf("section-%d", s.ID)
+}
+
+// SurrogateKey returns the CDN cache key of the responses containing the article.
+func (a *Article) SurrogateKey() string {
+	return fmt.Sprintf("article-%d", a.ID)
+}
+
+// SurrogateKey returns the CDN cache key of the responses containing the ticket form.
+func (t *TicketForm) SurrogateKey() string {
+	return fmt.Sprintf("ticket-form-%d", t.ID)
+}
+
+// ListSurrogateKey returns the CDN cache key of the listings of the kind (categories, sections or articles)
+// in the country and locale, which have to be purged when a row is added or removed.
+func ListSurrogateKey(kind, countryCode, locale string) string {
+	return fmt.Sprintf("%s-%s-%s", kind, countryCode, locale)
+}
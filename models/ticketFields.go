@@ -177,7 +177,7 @@ const (
 )
 
 func (t *ticketFieldsOps) SyncWithTicketFields(ctx context.Context, zendeskTicketFields []*SyncTicketField) error {
-	tx, err := t.db.Begin()
+	tx, err := t.db.Begin(ctx)
 	if err != nil {
 		return errors.Wrapf(err, "models: [SyncWithTicketFields] db.Begin failed")
 	}
@@ -114,7 +114,7 @@ const (
 )
 
 func (t *ticketFormsOps) SyncWithTicketForms(ctx context.Context, zendeskTicketForms []*SyncTicketForm) error {
-	tx, err := t.db.Begin()
+	tx, err := t.db.Begin(ctx)
 	if err != nil {
 		return errors.Wrapf(err, "models: [SyncWithTicketForms] db.Begin failed")
 	}
@@ -205,6 +205,15 @@ func (t *ticketFormsOps) GetTicketForm(ctx context.Context, formID int, locale s
 		TicketFields:       make([]*TicketField, 0),
 	}
 
+	// Render the display name with the locale variant if the raw display name has dynamic content.
+	if dcPlaceholderRegexp.MatchString(ret.RawDisplayName) {
+		displayName, err := t.dcOps.RenderDynamicContent(ctx, ret.RawDisplayName, locale)
+		if err != nil {
+			return nil, errors.Wrapf(err, "models: [GetTicketForm] render display name failed")
+		}
+		ret.DisplayName = displayName
+	}
+
 	for _, fieldID := range form.TicketFieldIDs {
 		field, err := t.fieldsOps.GetTicketFieldByFieldID(ctx, int(fieldID), locale)
 		if err != nil {
@@ -0,0 +1,235 @@
+// Code generated by go-bindata.
+// sources:
+// openapi.json
+// DO NOT EDIT!
+
+package openapi
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+func bindataRead(data []byte, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, gz)
+	clErr := gz.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+	if clErr != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+type asset struct {
+	bytes []byte
+	info  os.FileInfo
+}
+
+type bindataFileInfo struct {
+	name    string
+	size    int64
+	mode    os.FileMode
+	modTime time.Time
+}
+
+func (fi bindataFileInfo) Name() string {
+	return fi.name
+}
+func (fi bindataFileInfo) Size() int64 {
+	return fi.size
+}
+func (fi bindataFileInfo) Mode() os.FileMode {
+	return fi.mode
+}
+func (fi bindataFileInfo) ModTime() time.Time {
+	return fi.modTime
+}
+func (fi bindataFileInfo) IsDir() bool {
+	return false
+}
+func (fi bindataFileInfo) Sys() interface{} {
+	return nil
+}
+
+var _openapiJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\xdd\x93\xdb\x36\x92\x7f\xe7\x5f\x81\xe2\xdd\xa3\xec\x19\xcb\x7b\xf7\xe0\xb7\x5d\x67\x93\x73\xed\x6e\x72\x67\xbb\xa6\xae\xee\x2a\xa5\xc5\x90\x90\x44\x5b\xfc\x08\x00\xcd\x44\x99\xe2\xff\xbe\x05\x12\x20\x01\xb2\xc1\x2f\x71\x24\xcd\x08\x19\x57\x45\xc4\x47\x13\xdd\xe8\xfe\x75\x03\x04\xd9\x4f\x1e\x42\x7e\x9a\x91\x04\x67\x91\xff\x01\xf9\xef\xdf\xde\xbe\x7d\xef\x2f\x44\x69\x94\xac\x53\xff\x03\x12\x2d\x10\xf2\x79\xc4\x77\x44\xb4\xf8\x3f\x92\xa0\x2d\xd9\x65\x28\x20\x09\x27\x14\xfd\xf9\xbf\x3f\x15\xed\x11\xf2\x43\xc2\x02\x1a\x65\x3c\x4a\x13\xd1\xf2\xeb\x96\xa0\xcf\x7f\xfd\xf2\x75\xbd\xdf\x89\x56\x0c\xa5\x6b\xc4\xb7\x04\xfd\x41\x92\x90\xb0\xef\x06\x95\x20\x4d\x38\x49\x38\x7b\xab\x68\x3d\x10\xca\x24\x9d\x77\x6f\x6f\xdf\xde\xfa\x1e\x42\xb9\xa8\xf3\x33\xcc\xb7\xac\x1e\xd8\x0d\xce\xa2\x9b\x00\x73\xb2\x49\x69\x44\xea\x0a\x84\xfc\x0d\xe1\xda\xa5\x60\x02\x6f\x44\x83\xff\xaf\x4a\x10\xf2\xb5\xae\x55\xf1\xaf\x8b\xea\xa7\xcf\xf6\x71\x8c\xe9\x41\x30\xf4\xf7\x88\x71\x56\xb0\x50\x77\x52\x03\x16\x7f\x42\x90\x14\x0b\xf6\x3f\x85\xa2\xfd\x86\xf0\x8f\x35\x75\xad\x5d\x86\x29\x8e\x09\x27\xb4\x39\x9a\x7a\xac\xe2\xcf\xff\x77\x4a\xd6\x82\xd0\xbf\xdd\x04\x69\x9c\xa5\x89\x90\xd0\x4d\xdd\xf9\x66\x97\x06\x78\x47\x7c\xad\x53\xbe\x98\x4e\x2d\x48\xf7\x09\xa7\x87\x55\x90\x86\xb3\xd1\xcc\x08\x5d\x65\x78\x33\x1f\xbd\x19\x69\xb1\x94\xf2\xd5\xfd\x61\x56\x72\x29\x0d\x09\x35\x28\x82\x4a\x45\x09\xcb\xd2\x84\x19\xea\x2a\xfe\xf9\xcb\xdb\xdb\x46\x11\x6c\x57\xb0\x02\x8a\x3f\x5f\x9a\x52\x8b\x0c\x42\x3e\xce\xb2\x5d\x14\x14\x1a\x7a\xf3\x8d\xa5\x09\xd0\x46\x68\x7c\xb0\x25\x31\x06\xeb\x6c\x62\x28\xbb\xb0\x9b\x9f\x74\x8d\xff\x65\xcf\x75\x49\x34\xe5\x01\x5d\xe7\xb6\xb9\xf0\xff\x04\x09\x06\x1c\x4b\x25\xdb\x9b\xbf\xe0\xf0\x33\xf9\x6d\x4f\x18\xf7\xad\x74\x43\xb2\xc6\xfb\x1d\x1f\x4d\xfb\xaf\x94\xa6\xd4\x4e\xf6\xfd\xed\x9f\x46\x93\xfc\x39\xe5\xff\x48\xc3\x68\x1d\x91\xd0\x20\xec\x35\xa5\x93\x7b\xda\x0d\x9b\x00\x78\xf3\x24\x7f\x1f\x56\x51\x98\xdf\x30\x12\x88\xe9\x1e\x8f\x8b\x55\x47\x50\x81\x41\x54\x54\x5d\x04\xd0\x63\xa5\xa2\x87\x1e\x84\xfc\xa2\xee\xa3\xb5\x9a\x0b\x1f\x35\x49\x58\xa7\xca\x41\xae\x83\xdc\x41\x90\xab\xb4\xfb\xd2\x00\x57\x19\x90\x83\xdb\x0b\x80\x5b\x4c\x79\x14\xec\xc8\x78\xb8\xad\x3a\x82\xca\x0b\xc2\xad\xea\x32\x0a\x6e\x6b\xf7\xfc\x67\x75\x47\xad\xfd\x19\x81\x37\xc1\x71\xb1\xbe\xd8\xe1\x7b\xb2\x5b\x89\x2b\x7d\x64\xe2\xcf\x8f\x0a\x5b\xfc\x6d\x4f\xe8\xc1\x5f\x74\xda\xeb\xc7\x34\x8e\x31\x62\x44\xf0\xc3\x49\x88\x0a\xa2\xa8\x20\x6a\x8a\x0e\x53\x52\x56\x92\x10\x3d\x46\x7c\xdb\x32\x6e\xab\x6d\xfa\xfc\x90\x15\x03\x66\x9c\x46\xc9\x46\x67\xb3\x56\x9d\x3e\xa6\x9d\xb7\x71\xde\x06\xf6\x36\x4a\x43\x2f\xcd\xdb\x28\xd4\x70\xde\xe6\xe4\xde\xe6\xa0\xf9\x9a\xef\xe4\x50\x60\x64\x3e\xda\xd1\x8c\xd8\xef\xf8\x31\x4a\xc2\x72\xbf\x23\x0a\x4d\x27\x83\xee\x0f\x28\xe2\x0c\x7d\x27\x87\x02\x55\x87\x39\x9d\xc3\xdf\xc8\xe1\x67\x1c\x93\xaf\xe9\xa7\x1f\xf4\x0e\x43\xbd\x8e\x72\x11\x6a\x14\x95\x14\x60\x47\x21\x36\x8a\xfc\x45\xaf\xa5\x29\x1e\xd4\xf6\x94\xa2\xde\xb2\x3c\x4a\x7e\xdb\x47\x94\x88\xad\x1d\x4e\xf7\x64\xe1\x0d\x33\xab\xd7\xe4\x28\x40\x8d\xa9\x94\x7c\x2a\xd6\x29\x89\xa3\x28\xbc\x34\xb8\x03\x34\xf7\xca\x90\xcf\x6b\x32\x01\x00\x94\x5a\x1a\xdd\x3c\xc9\x5f\x67\x8e\x85\xe5\x28\x7a\x50\xe9\x39\x03\xe0\x5a\x0e\x86\x30\x17\xd3\x29\xba\x50\xd0\x85\x82\x2e\x14\xbc\xf6\x50\x10\x44\xda\xd1\x00\xab\xa8\xf8\xa0\xc2\x6a\x00\xfb\x13\xe1\x6c\x30\x9e\xca\x8d\x28\xbd\xd1\xd5\xc3\x29\x28\xe0\x4a\x15\xa6\x22\x02\x30\x1f\x17\xb4\x13\xe9\xf0\xe0\x74\x78\xa0\x7c\xc3\xcd\x93\xfc\x35\x09\x0f\x14\x15\x1f\x54\xd7\x16\x1e\x24\xca\x25\x0d\x0b\xb0\xf4\x46\x73\x01\x82\x1c\xc0\xab\x8a\xaf\xd4\xf2\x96\x11\x4c\x83\xed\x0a\xdc\xe7\x1c\xbe\x05\x2a\x70\xa2\x68\xa7\xd6\xb5\x25\x59\x44\x09\xdb\xef\xcc\xa8\x19\x45\x0c\x05\xbb\x28\xf8\x4e\x42\xb4\xa6\x69\xbc\x28\x2a\x8b\x92\xa2\x4a\xf0\x22\xaa\x52\x5a\x54\x14\x54\x5b\xd8\x33\xc3\xfa\x17\xd4\xbe\xca\x4e\xa6\x82\x25\xa0\xab\x17\x14\x3d\x39\xb0\x3c\x1d\x58\xf2\x34\x93\xca\xc0\x6e\x9e\x78\x9a\xad\x92\xfc\x14\x6b\xd3\x38\x65\x1c\x3d\x44\xe4\x91\x84\x60\x2c\x0f\x20\xe7\xd7\x34\xfb\xf9\x98\xe5\xa9\x82\x92\x82\x49\x18\x43\x06\xee\x8e\x25\xfb\xf8\x9e\x50\x85\x21\xd0\xf0\x67\xd9\x1b\x8b\x12\x4e\x36\x84\x36\xc8\x22\xe4\xc7\x51\x12\xc5\xfb\xd8\xff\x80\x6e\x8d\xaa\xdc\xa6\x55\x43\xd4\xe9\x22\x61\xff\x31\x4a\xc2\xf4\x71\x15\xe2\x03\x83\x67\x6c\x08\xea\x7f\xc6\xc9\xf7\xc6\x8e\xc8\xfd\xa1\xb8\x16\x0a\x58\x1d\xc1\xdb\x61\xc6\x91\xb8\xd3\x02\xdd\xa2\x98\xe0\x84\x21\xbc\xdb\x21\x1e\x99\x5b\xb9\x33\x4e\x5e\xbb\x0e\xff\x2e\xeb\xde\xff\xe7\x7f\x4c\x9a\x5a\x25\x38\x6d\x71\x30\x59\x6e\x5f\xc8\x8e\x04\xa6\x57\xac\x84\x65\x8b\xb6\xcf\xa8\xd5\x8a\x75\xb5\x73\xfa\x6c\xbc\xab\x1b\x9c\x9c\x79\x10\x5e\x2b\x37\x70\x64\x2c\xc0\x2e\x2d\x18\xd0\xf1\xde\x45\x04\x27\x8c\x08\x44\xd0\xcb\x57\xeb\x94\xc6\xec\xe6\x49\xfc\x6f\xd2\xfa\xa9\x24\x83\x0a\x32\x3e\xa8\xb9\xad\x35\x14\xd2\xfa\x20\x9c\x84\xc5\xe3\xb4\x75\x44\x76\x61\x6f\x6c\x50\x74\xfc\x31\xa5\xb1\xde\x6e\x6c\x64\x20\x79\x85\x51\x63\x60\x6c\x50\x3e\x15\x14\xce\x45\x63\xe6\xd9\x42\x03\xa3\x3e\xb7\xa9\xd0\x10\xdd\x39\xbd\xfb\x07\x75\xa2\x52\xe1\xa9\x68\xd6\x25\xf5\x73\x03\x5a\xa5\xa4\x0e\xce\x4e\x07\x67\x51\xc2\x38\x4e\xf8\xaa\x5c\xea\x8f\x86\x31\xd9\x0d\x54\x56\x0d\xc0\xbe\x14\xcd\xcc\x93\x54\xa8\x78\x3f\xa4\x0f\xba\x3e\x95\xe3\x2b\x09\xe8\x4d\x87\xa2\x57\x9f\x01\x96\x21\xb2\x6d\x3e\xae\x09\x1b\x62\xcc\x83\x2d\x09\x81\x79\xb9\x04\x78\x30\x14\xe1\xca\x10\xc2\x6b\x32\x01\x18\xf2\xe9\x0d\xb8\xcf\x74\x9d\xcd\xea\x36\xeb\x9e\x92\x5f\xdc\x53\x72\x05\x78\x90\x3e\x5f\x02\xe4\x39\xac\xb3\x61\x1d\xc7\x7c\xcf\xc6\x63\x5d\xd9\x0d\x54\x26\x0d\xeb\x8a\x27\xd8\x62\x89\xc2\x08\x7d\x20\x14\x95\xdd\xfa\xc0\xae\x68\xa4\xb7\xa9\x98\x9c\xaa\x9e\xd6\xdb\x9f\x5d\x37\x25\xb3\xad\x7e\xb9\xd7\x75\x9d\x5b\xb5\xe7\x75\x68\xa5\x7c\x27\xfa\x6d\x43\xe4\xcf\xa1\x9b\x11\x43\x61\x1a\xec\x63\x92\xf0\x1e\xbd\xfc\x25\x23\x49\xfd\xbe\xf5\x3c\x8a\x29\x69\x82\x43\x38\x85\x6e\xaa\x65\x7e\x7a\xff\x8d\x04\xc6\xf4\x9b\x73\x04\x5f\xe7\x56\x75\x79\x1d\x6a\x28\xce\x1c\x13\xc6\x0d\x78\xcc\x52\x36\x40\x07\xab\x9e\x7d\x5a\xf8\x91\x12\xcc\x89\xd8\x92\x52\x2f\xe6\xcb\xae\x5d\xca\x18\x14\x9d\x94\xb0\x16\x5e\x97\x86\x95\xdb\x14\xec\x43\xd9\x07\xb1\x20\xcd\x88\x78\xae\xac\x36\x86\x50\xb4\x46\xff\xc4\x7b\xbe\x5d\xc9\x96\xab\xa2\xc9\x4a\xd5\xff\x53\x34\x66\xc4\x34\x0e\x46\x82\x3d\x8d\xf8\xa1\xc1\xf6\x93\x31\x59\x4f\x5e\x43\x5d\xa3\xbf\x91\xa2\xc7\xaf\x5a\x45\x57\x8f\x7b\x82\x29\xa1\xa3\x7a\x60\x16\x05\xad\x0e\xe0\x1c\x48\x31\xff\x25\x0d\x0f\xc6\x74\x76\xef\x99\x59\xed\x71\x88\x35\x76\xd9\x62\xb7\x97\xf8\xa8\x4f\xf8\xa7\x44\x57\x64\x93\xc3\xe6\x55\xee\x01\x62\xeb\x82\xad\x77\x83\x60\x4b\x0a\x4f\xa8\x46\xa9\x57\x97\x75\x74\xbc\x65\xed\x4d\x61\x40\xd7\xb9\x4d\xc7\x5e\x09\x98\x3d\xa4\x9c\x18\x07\x95\x6e\x9e\x1e\xf0\x6e\x4f\xf2\xf1\xe8\xa6\xa2\x7c\x1f\xb4\x2c\x0d\xdd\xee\x52\x4e\x86\x1e\x59\x2a\x15\x49\xf4\xd0\x1b\xd5\x0b\x9a\x26\xd6\x0c\x10\x99\xb6\x1a\x1a\x7f\x64\x49\xed\xd5\x17\x42\x3a\x6a\xa7\x5e\x48\xbe\x65\x1f\x1d\x20\x33\x68\x63\x5e\x9e\xe7\x31\xfb\x21\xe4\x93\x64\x1f\x37\x44\x25\x6b\xf6\x59\x63\x0c\xe2\x9f\x1f\xa6\x8f\x2d\x3c\xd1\xd1\xb3\xd6\xa9\x3e\x81\x5d\xc8\xee\x00\xa8\x93\x8d\x59\xf9\x25\x29\x67\x45\x3c\x41\xc1\xc5\x2f\x2a\xb0\xec\x3b\xc9\x78\x79\xfc\xaa\x2a\x8a\x42\x92\xf0\x62\x3f\x58\x3d\xca\xff\xdf\x37\x3f\x90\x87\x28\x20\x6f\x3e\x85\x68\x4b\x70\x28\xce\x67\x50\x75\x66\x8b\x24\x1c\x45\xd9\x5b\x24\x3c\x2f\xc3\xb1\xbc\x4b\x20\x1e\xf8\xe3\x0d\x8e\x12\x71\x97\x24\x2d\x9e\x1a\x3c\x62\x1a\x8a\x2d\xc2\x54\x79\x7e\xc3\x38\x2a\x6b\x9f\x1a\x59\x8a\x1b\x57\x0f\x92\x01\xf3\x3b\x3b\x48\x7f\xac\xec\xdd\x2d\xcb\x5b\xcb\xf2\x75\x4a\x03\xc2\x0e\x49\x30\x1e\x9c\x8b\x5e\xa0\x11\x68\xc0\xfc\x95\x46\x9b\x0d\xa1\x0c\x89\xd6\x51\xb2\x29\x0f\xa0\x6c\x49\xf5\x29\xa8\xe2\x7d\x6c\x50\x33\x41\xd8\xfe\x51\x8c\xf7\x8b\xb8\xf3\xc2\xeb\xd2\x4a\x71\xbb\x0f\x8f\x34\x82\x22\xd1\x41\x11\xe6\x85\xc7\x94\x72\xd6\xa7\x1a\xad\x10\x8f\x10\x09\x2f\xa7\x87\x84\xe7\x5a\x0f\x5a\xbc\x4b\xb7\x87\x91\x4a\x16\x04\x84\x55\x2c\x08\xa4\x0b\x24\x63\xdf\xd2\x7b\xdf\x6b\xf5\x40\xba\x5c\x9b\xd2\x85\xae\x73\xdb\xc4\xbd\x12\xdb\xc7\x09\xde\x1d\x78\x14\xb0\x1b\xed\x2c\x72\x24\x4e\x99\x53\x92\xa5\x94\x8f\x3f\x21\x51\x51\xf4\x41\xb5\xd5\x70\xe1\x73\x71\x07\xb5\x67\x27\xee\x8e\xe4\xdd\xbb\x30\xa0\x7a\x42\xf1\x3f\xe2\x09\xa0\xd8\xd2\x3a\xea\xdc\x64\xc9\xe6\x51\x21\x57\x49\x62\x51\x9d\x98\x8e\xe4\xd7\x26\x28\x4e\xbe\xd7\xae\x9c\xc9\x47\x31\x0b\xf9\x65\x3c\x9a\xca\x03\xda\x92\x77\xf1\x75\x0f\x5a\x1f\xc7\x7e\xc3\xb7\x34\xdd\x6f\xb6\x48\x7c\xd6\xa2\x38\xab\x5d\xd4\xed\xd2\xc7\xc6\x7a\xfd\x4c\x01\x1e\x4f\xc1\x08\x4f\xf0\xb5\x92\x07\xcf\xa1\xfa\x82\xb7\x95\xe4\xcd\xf7\xba\x8c\x33\xb7\x99\xc8\x10\xbb\x38\x7d\x28\xb8\xe8\xd7\xb5\x59\xce\x7d\x7e\xd9\xc7\x0c\xed\x33\xf3\x70\xe7\x5b\x7f\xf4\x94\x0f\x39\xb4\xd7\x7d\x94\xb3\x55\x5b\x43\xd7\xfb\xe3\x0e\x3b\xee\xa2\x38\xe2\xd3\x45\xd4\x3e\xcd\x0c\xe0\xca\x69\xc4\xf4\xee\xf6\xb6\x43\x4c\xcb\xb1\xc7\x22\x01\x46\x2b\xbc\xfd\x40\x09\x0e\x5d\xa4\x03\x44\x3a\x62\xf6\x0f\xc5\x03\x19\x76\xea\x18\xa7\x73\x55\xf2\x53\xdb\x95\xb9\xe5\x49\x6b\x79\x52\x29\xf8\x4d\x48\xd6\xe2\xec\xb6\x88\x39\x9f\x36\x34\xdd\x67\xa7\x8b\x4e\x76\xeb\x37\xe2\xb9\x5e\x14\x10\x54\x8f\xa2\x27\x4c\xf9\xa1\x6a\x78\x74\x94\x52\x70\x0b\x03\xe2\xc0\x20\xa5\xa0\x20\x16\x5f\x12\x11\x19\x61\xe2\xab\xbb\x6c\xa1\xe2\x93\xc6\x6b\x2b\x32\x18\x89\xa8\xfa\x66\x07\x04\x9f\xa7\x0f\x3b\xd4\xf0\x5a\x7d\x2c\xdf\xbb\x91\x53\x6c\x5c\xe7\x36\xc5\x1e\xa2\xcd\xaf\x37\xb0\xf8\x28\x06\xa0\xf4\xbd\x54\x8e\xd6\x4b\x24\x6f\xfd\xd1\x73\x7c\x55\x71\x86\x6e\x3d\x1d\x76\xe3\xc2\x8e\x6b\x08\x3b\x6a\x47\x71\x99\xb1\x47\xc3\x3f\xb9\xd0\xa3\x23\xf4\x28\x76\xb8\x8f\x7c\xf5\x5e\x11\xf3\x41\x75\xb3\x84\x1e\xd5\xd6\x7a\xfd\x60\x0b\x65\x84\x0a\x34\xee\x09\x3f\xe4\x5b\x46\x62\xd3\xfb\xbf\x22\xc6\x53\x7a\x98\x12\x81\xf4\xb9\xad\x5a\x22\x86\x74\x17\xd3\x29\x9e\xdd\x11\xea\xe2\x17\x2e\xf5\x45\x7b\x41\x50\xd7\x1c\x9e\x4f\xc1\x73\x61\x8a\x68\x5b\x9a\xd2\xa5\x61\x79\xdb\xd8\x1d\x9c\xb7\xe0\xfc\x61\x79\x4c\xfa\x90\x87\xa5\x31\xe7\xe0\x72\xc3\x02\xe8\xf5\x17\x02\xea\x4e\x3d\xe8\x7d\xb7\xbc\xf6\x84\x22\xeb\x88\x32\x3e\x17\x31\xbc\xe6\x84\xce\x45\xec\x34\x87\xe7\x8f\xa0\x58\xbe\x5d\x6a\x50\x03\x55\xb4\x32\xa8\xa9\x90\x08\xab\xf3\x29\x00\xb1\x71\x98\x74\x01\xb5\x51\xbe\xab\x61\x29\xf5\x9f\x1f\x62\x8e\xfd\x05\x5c\x17\x13\x8e\x7d\xaf\x55\xae\x0b\xb0\xfe\xcf\xcf\xa8\x78\x56\xcd\x4d\x60\x31\xff\x2b\xef\x66\xab\xd5\x78\xc2\x94\xe2\x66\x90\x52\xff\xe7\x47\x9c\xc4\xf6\xbb\xf4\xbb\x8b\x0a\x5a\x0c\x0d\x46\x08\x42\x53\x84\x2c\x1a\x59\xff\xf9\x31\xe9\x66\xab\x67\x30\xff\xb0\xc8\x19\x1e\x46\xee\xf5\x95\xe4\x9e\xed\xea\x78\x9f\x76\xb7\x7c\x4e\xaf\x76\xb7\x6c\xfb\xb5\xb3\xbe\x65\xfa\xb0\xb4\xe7\x3f\x90\xdf\xca\x38\xde\x8f\x56\x84\x3c\xc0\xc2\x60\x2f\xaa\xba\x8c\xca\x87\x70\xb7\xfc\xa2\xee\xa4\xb5\xab\x51\xb3\x31\xce\x21\x52\xd6\x20\x57\x93\x8d\x75\xfe\x9c\x8b\x76\x2e\xfa\xe4\x2e\x5a\xd9\x8a\x73\xd0\x2f\xc8\x41\x4b\xa4\x82\xb8\x33\x55\x05\x21\x8b\x3a\x3a\xff\x7c\xe5\xfe\x59\x6e\xcc\x1d\xef\x9f\x2b\x42\x1e\x60\x60\xb0\x7f\x56\x5d\x46\xfa\xe7\x7a\xc5\x2b\xb7\x33\x2e\xc5\x53\x57\xcf\x8b\x5c\xc6\xa2\x97\x94\xb1\xc8\x85\x27\x97\x1f\x9e\x28\x6d\x77\xe1\xc9\x0b\x0a\x4f\x24\x3c\x43\xdc\x35\x11\xc6\xa2\x8e\x2e\x3c\xb9\xaa\xf0\x64\x9e\x84\x56\xcd\xc8\x64\xc4\x0e\xfc\x6c\x09\xae\xee\x96\x40\xa2\x20\xbd\x4b\x0d\xa2\x8d\xe1\x5b\x62\x8a\x96\x5c\xe0\xc8\xc2\xa5\xb8\x9a\x3d\xc5\xd5\x4b\x70\x8f\x67\x4c\x98\x35\xa3\x87\x04\xaa\x34\x29\xcd\xec\x05\x3b\xfd\xc5\x88\x44\x5f\x4d\x8d\xb6\x97\xe5\x5e\xd7\x75\x6e\xd3\xe3\x17\xe2\x42\xbc\x26\x23\x30\xd2\x83\xf9\x6a\xd4\xf1\x10\x76\x34\xd6\x57\x84\x3c\x40\x83\x86\xac\x42\xe5\xa8\x7a\xe1\xfd\x39\x97\x9e\xb5\x64\xac\x53\xf7\x0a\xa1\xd2\x2d\xc2\xdc\x22\xcc\x2d\xc2\xdc\x22\xcc\x2d\xc2\xce\xb4\x08\x03\x5d\xf3\xd1\x1e\x59\x51\xf5\x3d\xc0\xa8\x80\xcf\xa0\x0f\x75\xc0\xea\xd9\x87\xd6\xac\xc6\xc4\xc6\xa0\x86\x88\x51\x03\xd4\xeb\xf4\xbf\x27\x72\x22\xc0\xfc\xba\x65\xca\xc4\x65\x4a\xcf\x13\xc0\xdc\x6b\x14\x80\x65\xb9\xd7\x75\x9d\xdb\xf4\xcb\x81\xea\x20\x50\x55\x31\xd3\x71\x2f\x05\x4c\x5e\xe6\x8c\xcb\xcf\x57\x47\x0b\x5a\xb3\x1a\x24\x1a\xa3\x1a\x22\x47\x0d\x61\xe4\x10\x5e\x15\xaa\x5e\x7d\x86\xbe\x0e\xd9\x5c\x8c\xc7\x01\x74\xdf\x79\x9c\xc9\x1e\xa7\x73\x3d\x91\x7b\xad\x22\x43\x5f\xe0\x92\xdc\xb3\x5d\x39\x8f\x33\xde\xe3\xcc\x91\xd4\x70\x86\x6d\xb5\x09\x49\x0e\xef\x96\x2e\xcd\xa1\x4b\x73\xe8\xd2\x1c\xba\x34\x87\xd7\x9b\xe6\xb0\x83\xf9\x4b\x0b\xa8\x98\x8b\xa8\x86\x45\x54\x17\xbe\xd7\xeb\x0d\x69\x99\x7b\x5d\xd7\x56\x15\x76\x31\xdb\xb0\x98\x6d\x9e\xb4\x93\xcd\xb0\x4d\xcb\x07\x38\x78\xff\x75\x72\x1a\xca\xbb\x65\x9d\xe3\x4f\x6f\x59\x23\x54\x63\xb8\x16\x6c\x77\x89\x28\x4f\x99\x88\xf2\x25\xf8\x9b\xae\x39\x74\x2e\x07\x74\x39\x9d\x8e\x42\xb3\x53\xb0\x7f\xee\xb5\x8a\x0c\xb5\x86\x4b\x72\xcf\x76\xe5\x3c\xc2\x78\x8f\x70\x64\xe6\xce\xa6\x27\x90\x64\x3c\x40\xf3\xfa\x12\x01\x0e\xcb\xe4\x79\xb7\x74\xb9\x3c\x81\x5c\x9e\x2f\x01\x5e\xcf\x9b\x19\xf4\xa5\x22\xec\xc9\x82\x7a\xc3\xae\x3e\x17\xdf\x4c\x86\x38\x35\x15\xa4\xaf\x34\xf7\xfa\x4a\x72\xcf\x76\xf5\x12\xe1\xdc\x6b\xfe\x82\x51\xf7\xfc\x68\xdb\x8f\xb3\x0e\x60\x67\x03\x58\x77\xe4\xf0\xd2\x8f\x1c\x2a\xdf\x04\x59\xc7\x0b\xf3\x4e\x5d\x47\xea\x80\x2a\x4d\x8c\x2f\xd5\x73\x95\x48\x35\x6d\x53\xca\x1d\x40\x3c\xfd\x01\x44\xaf\xf9\x0b\x76\x92\x32\xd3\x1e\x1b\x9f\x07\xa8\xe9\x27\x2b\x4a\x1e\xa0\xf5\x33\xa5\xa4\xbc\x5b\x2a\x19\x6a\x2d\x6b\x28\x6b\x0c\x71\x88\x10\x27\xe0\xa0\x4b\x82\xe9\x92\x60\x5e\x47\x12\xcc\x19\x1d\x26\x50\xa5\x29\xca\xcc\x4e\xb1\x73\x9a\xef\x96\xb6\x9c\xd8\xcd\x79\xb5\x97\xe5\x5e\xd7\x75\x6e\x33\xa4\xd7\xe5\x3a\x66\x4c\xf8\xd9\xf4\x25\x2a\x42\xf4\x3d\x40\x59\x8e\x4c\x00\xba\x74\x29\x40\x5d\x0a\xd0\xe3\x52\x80\x2e\x4e\x1e\x64\xb8\x84\xa2\x27\x49\x28\xfa\x4a\x1d\x5e\x81\x78\x60\xdf\xdc\x6b\x15\x19\xc6\x08\x97\xe4\x9e\xed\xea\x15\xbb\xbb\x23\x52\xa6\x36\xdd\xdb\x59\x52\xa8\xde\x2d\xc1\x24\xaa\x35\x36\x35\x46\x3d\x44\xb0\xc7\x03\xdb\x55\x27\x6d\x5d\x0e\x02\x46\x95\xb4\x15\x07\x01\xc9\xdc\x2a\xc0\xad\x02\x2e\x6a\x15\x30\x7f\x36\xd9\xd6\x62\x40\xdd\xc1\xf7\x00\xa5\xb1\x25\x51\x91\xaf\xdb\xc8\xd1\x74\xc1\xa3\xf6\x20\xc6\xe5\x97\x75\xf9\x65\x5d\x7e\x59\x97\x5f\xf6\x95\xe6\x97\xed\x10\xd3\x33\x86\x77\x2e\x5b\xed\x25\x67\xab\x7d\xa9\xd1\xdd\x89\x1f\x7c\x56\x91\x01\xc4\xa5\xa9\x19\x7d\xa5\xb9\xd7\x57\x92\x7b\xb6\xab\xab\x08\x24\x67\xc8\xf9\x3b\x57\x0c\x39\x25\x07\xf0\xdd\xb2\x91\x65\x51\x6f\x5e\x43\x6a\x63\xc8\x16\xb7\xe5\xb2\x00\xbb\x2c\xc0\x2e\x0b\xb0\xcb\x02\xfc\xfc\x59\x80\x3b\xa4\xe6\x82\xc3\x73\x06\x87\xe7\xce\x29\xec\x22\xc4\x9e\x08\xd1\x74\xf7\x10\x93\xa6\x76\xf4\x95\xe6\x5e\x5f\x49\xee\xd9\xae\xae\x22\x40\x9c\x21\x33\xf3\x3c\x01\xe2\xf4\x4c\xcd\x77\xcb\x76\xfa\x56\xbd\x47\x8d\xae\x8d\x91\x0f\x11\xb7\x06\xcd\xb5\x8c\x0c\x91\x2f\xa6\x53\x3c\x7b\xb8\xf2\xaa\x72\x35\x77\x08\xcd\x79\xdd\x73\x7a\xdd\x73\x66\x7e\x76\x1e\xb7\xc7\xe3\x6a\xd8\xf9\x03\x36\x5e\xad\x40\x08\xd2\x8c\xbe\xd2\xdc\xeb\x2b\xc9\x3d\xdb\xd5\x6b\xf2\xb8\x9e\xbc\xa9\x5f\x53\xaa\xee\x67\xba\x24\x35\x06\x5f\xae\x85\xeb\x12\x7d\x95\x55\xd6\x2d\xbc\x1e\x94\x87\xec\xaf\xa4\xab\xb0\x5d\x1a\x9c\x01\xed\xb0\x11\x75\x6d\x31\xc0\xdb\x0b\x3e\x49\xde\xec\x5b\xfe\xe8\x8f\xed\x1b\xfe\x08\x14\x06\xad\x4f\x51\x7d\x6b\xbe\x0e\xe2\xb7\xf7\x65\x4c\xf7\xfb\xab\x65\x62\xe5\x48\xda\xb3\xa3\xda\xfb\x86\xf3\x05\x65\x6e\xb4\x98\x24\x79\x49\xe1\x24\xa2\x67\x66\x2b\x84\xfc\xed\xf7\x66\x49\x7b\x1a\xbe\x65\xfd\x12\x8f\x0f\xc0\x1c\x34\x4a\xb2\xed\xa0\x59\x61\x9b\xae\x29\xc9\x08\x5d\x65\x78\x63\x99\x8e\xaa\x76\xd2\x54\x88\x9e\x88\x45\x7f\x90\x45\xb1\x1b\x53\x9c\x13\x65\x68\x53\x9c\xe7\x11\xbb\x74\x38\x11\x4f\x83\x8a\x27\xc3\x01\xce\x32\x12\x8e\x98\x24\x28\xe4\xd1\xc2\x9d\x77\x16\x69\xbc\xbf\xed\x92\x85\x5d\x0e\xc7\xc9\x40\x6e\xb6\x30\x2e\xe2\xd9\x64\x53\x3e\xbc\x7e\xf7\xfc\xdc\xbe\xeb\x60\x56\xbd\xd8\x07\xf2\xab\x2a\x27\xb1\x2c\x3a\x8b\x13\x5f\x45\x84\xf7\x2c\x76\x97\xa5\x2c\x6a\x7c\x71\x5b\xfc\x93\x47\xc5\xc2\x15\x6e\x46\x1d\xfe\x3e\x0b\x55\xcd\x10\x9b\xa9\x6e\xd0\x27\xc0\xf2\x55\x46\xbb\x0c\xcb\xfa\xa3\xc4\x58\x90\x78\x16\x31\x62\xa6\x9f\xa5\xab\xc6\x31\x48\x42\x98\x05\x5d\xc2\x29\x13\x19\x82\x72\x29\xab\x26\x89\xa4\x02\x14\x85\xee\x0f\x4b\xb4\x8b\x98\x10\x13\x7b\x21\x18\x53\xe6\x8c\x06\x05\x53\x56\x4d\x12\x0c\x49\xc2\x55\xb0\xa7\x2c\xad\x76\x74\x33\x4a\x1e\xa2\x74\xcf\x4a\x00\x6a\x8b\x6b\xbc\x42\x75\x30\x25\xd7\x72\x20\x57\xb2\x6e\x34\x5b\xcd\xa4\xa3\x25\x1d\x8d\x13\x11\xce\xa3\x72\x8d\xc1\xc4\xb1\xee\x7b\xf1\xce\x11\xdf\xd3\x84\x84\x8b\xea\xb4\xa9\xec\x85\x69\x5d\x89\xa2\x35\x8a\x8a\xf7\x92\x48\x9c\xf1\xc3\xac\x82\x28\x77\xdf\x41\x39\xb4\xf8\x1d\x3e\xbb\xf2\x30\x18\x27\xbf\x9b\xaf\xd8\x59\x1f\x63\x0d\xe3\xa3\x6e\x5f\xaa\xf5\xdf\x49\xb2\xe1\xdb\x1e\xb7\x21\x9f\x0e\x14\xb9\x68\x41\x3e\xf5\x06\xad\x49\x6f\x3c\xf4\xeb\xfe\x5e\x96\xa2\x34\x0b\xcf\xca\x96\x3b\x58\xd3\x3e\x62\x09\x72\xa6\xd5\x1f\xc7\x98\x24\x74\x2a\xbe\xb4\x4d\x34\x90\x2f\xad\xfe\x38\xbe\x24\xa1\x67\xe6\xcb\xd8\x5d\x95\x8b\x6a\x8d\x8a\x5f\xae\x17\x75\xb2\xd0\xa8\x89\x68\xa5\x06\x2e\xdf\x55\x5c\xe8\xb8\x50\x7d\xb0\x5c\x06\x15\xa8\x5a\x93\x1a\xfc\x59\x37\x3b\x74\xce\x75\xbf\xeb\x13\x73\x3d\xab\x79\x58\xfb\x2e\x86\x4f\x5a\x4c\x41\x16\xad\x55\xe6\x6d\xc1\xa9\x1b\xf9\x1f\xd3\x24\x91\xc9\x1a\xfa\xc4\x24\x9d\x4a\x86\x37\x51\x52\xec\x0a\x09\x91\x61\xe5\x45\x8e\x94\x44\x3b\xca\x34\xc3\x2a\xa3\x3c\x5d\xaf\x19\xd1\xc9\x8a\xd5\x16\x66\xab\x84\xfc\xce\x9b\x6b\x14\x59\xa5\x7c\x60\x59\x3d\x52\xe2\x6a\x70\x63\x64\x6e\x63\x64\x32\x0d\xc9\xb4\xb5\x7f\xcb\x4a\x9a\x04\x4c\x09\x59\xe9\xdc\xa7\xe9\x8e\xe0\xa4\x9b\x8e\x29\xce\x51\xb4\xba\x94\x51\xa2\xbc\x41\x70\x82\x32\x19\xe8\x65\x5b\x23\xd8\x56\x08\xfa\xfa\xc0\x28\x67\xe9\x9e\x06\x64\xd5\xda\x0a\x12\x73\xb3\xe7\xc5\x9a\xc2\x2c\xb5\x6c\x60\x88\x5b\xd0\x9d\x59\xb0\xe5\xf1\x6e\xd5\x2a\x6d\x25\x54\x35\xcc\xd2\xa8\x80\x46\x55\xe5\x64\x1d\xa9\xee\x51\x68\x9f\xd1\x5e\x2d\xab\x64\x3d\x9d\x84\x36\x31\x7d\xd6\xa2\xf7\x13\xc1\x67\x4a\xe3\xa2\x97\x78\xa5\x82\xbc\xe1\x51\x4c\xec\xb7\xd1\xe6\xf9\x39\x6f\x63\xaa\x4d\xdf\x9d\xac\x64\x2a\x15\x1b\x65\x6c\x76\x7d\x9c\x3c\x10\xa1\xa5\x93\x3b\x57\x7a\x3e\x99\x82\x8c\x54\x26\xf6\x36\xdd\xda\x44\x22\xc7\x4e\x65\x65\x97\x63\x28\x78\xcd\x5f\x15\x4d\xff\x0b\xe4\xc0\xc7\xa3\x26\x1c\xad\x5f\x35\x9c\x8e\x04\x4e\x5d\x82\xd6\xb9\xed\x85\x3f\x07\xbf\x0e\x7e\x1d\xfc\xce\x0a\xbf\x1d\xe0\x29\x1f\xc0\x1a\xe4\x26\x80\x27\xb8\x21\x00\x60\xa7\xf8\x74\x52\x4a\x5b\xcd\x82\x34\x8e\xc5\xa3\xa9\x55\x18\x31\x7c\xdf\xc4\xc3\x90\xe2\x75\x03\x3d\x33\x9a\xc6\x29\x27\x83\x90\x59\x1c\x00\x58\xb1\x7d\x0c\x94\x16\x3a\xe9\xdb\xcc\xdf\x66\xaf\x1d\x06\x06\x1b\x0d\x58\x2a\xbb\x98\xcf\x2b\x7d\x12\x46\xd0\x5d\x76\xf8\x9e\xec\x0a\xaf\xc9\x7c\xbb\x55\xcd\xe3\x15\x8a\x2f\xce\x9a\x45\xf7\x69\x78\xf0\x21\x2d\x1c\xe9\x21\x34\x35\xb1\xea\xef\xf3\x3a\x88\x5a\x01\xa7\xd3\x68\x69\xeb\x74\x50\x2c\x55\xbb\xaf\xff\xbf\xd8\xbb\xb6\xdd\xb6\x71\x26\x7c\xaf\xa7\x30\x74\x5d\xff\x3f\xe0\x37\xc8\x1e\x5a\xa4\x45\x93\x02\x09\xb2\x0b\x2c\x0a\x83\x91\xe9\x98\xbb\x8a\x24\x88\xb2\xb3\x59\xc0\xef\xbe\xa0\x44\x52\x3c\x4b\x24\x15\xdb\xe9\x0a\xe8\x85\x2a\x87\x1f\x39\x33\xfc\x78\xd2\x70\xc6\x5e\x9e\xf3\x20\x02\x22\x7e\xca\xe4\x0c\x8b\x84\xe8\xe8\x38\x4f\xdd\x97\x36\x75\x6b\x43\x96\x15\xca\xe4\x30\x94\x16\xfb\x3c\xa7\x3c\x91\x0f\x3e\x9d\x4e\x44\x0e\x01\xc5\x29\x4d\x6d\x6b\x3f\x80\x0e\x69\x2c\xc6\x36\xe2\x78\x7c\xc1\xca\xf8\x8f\xaf\xb9\xc8\x9a\xab\x9b\xcd\x82\x8b\x3f\xea\x11\x0f\x3d\x4a\x07\x70\x37\x51\xad\xca\x11\x69\x64\x6a\xe3\x8a\x0d\xe4\xf9\xed\x56\x59\x94\x8d\xf1\x2e\x63\x9e\x78\x0c\xd4\x26\x89\xa5\xfd\xda\x02\xd1\xb5\x48\x74\xee\xb2\xe5\x1f\xb5\x45\x09\xf9\x97\xe2\x02\x55\x15\x94\xdc\x16\xa4\x85\x87\x7b\xf1\xa1\x55\xaf\xff\xec\x9e\x6b\x34\xa5\x18\xda\xec\xc4\xd4\xad\x6d\x86\x64\x72\x86\x80\x25\xb6\xff\xf5\xcf\xdf\x13\xa5\xe2\x54\x48\x3d\x22\xd6\x69\x37\xb3\x63\x89\x17\xb5\xb2\x8a\xa3\x7a\x0d\x5e\xd6\x71\x08\x1b\x84\xab\x1c\xbc\x46\xa2\x90\x76\x4c\x83\x34\xc1\x71\x06\x4b\x62\xa5\xfb\x25\x0c\xcf\x55\xd6\xd9\x68\x4c\x22\x1b\x52\x61\x6a\xef\x8e\x3f\xd6\xc2\x2d\x51\x9f\x34\x72\xb5\xea\x10\x6b\x3d\x3d\xbb\xa2\x66\x61\xfa\x77\xe7\x99\x85\x09\xa3\x22\x21\xd8\xd9\x4b\x5c\x23\xe2\x51\x26\xa0\x34\xc8\x1a\x74\x80\x11\xeb\x79\x61\x82\x0e\x85\xc8\xca\x3c\x07\x15\x86\x9b\xf5\xb6\xac\xd7\xe0\x49\xf2\x30\xf7\x87\xab\xe1\x13\xfc\xbb\x6a\xb1\x0e\x20\x47\x1b\xe0\x56\xd1\x90\x92\xdb\xbe\xb2\x46\xc5\x9a\x5c\x30\x02\xf9\x04\x1d\x6f\x0a\xb0\x03\xc2\xe8\x71\x1c\xd4\xa0\xc2\xc8\x36\x07\x4c\x05\xc6\xfa\xc3\x24\x60\x0d\x78\x0a\x57\xd1\x8f\xb5\x83\xaf\xe1\x73\x79\xa0\x9b\xbd\x50\x75\x66\x7b\xdc\x94\xcf\xdd\xfc\xbd\x2e\xdb\x4f\x77\xa7\x99\xc6\x7f\x6e\x2b\xfe\x48\xea\xbd\xad\x64\x0f\x60\x79\xca\x53\x5b\x8c\x5f\x71\x03\xcf\xd1\xe2\xbb\xb6\xe2\x71\x2d\x4e\xd4\x27\x2e\x43\xaa\x0b\x2e\x36\xe4\xf4\xd3\x76\xfc\x62\x34\x0e\xa1\xbd\xac\xe0\x55\xdc\xa1\x5c\xdd\x46\x22\x70\x90\x72\x2f\x48\xba\x4f\xb0\xa1\x4e\x3c\x08\xe2\xdb\xbd\x3c\xba\x28\x9f\x7f\xd2\x2b\xc9\x21\xd9\x1c\x6b\xc2\xa1\x10\x61\x12\xff\x23\xd1\x37\xa4\x48\xfd\x52\xa0\x3b\x86\x19\xae\xb4\xd0\xbf\x33\x7e\x18\x69\x5f\xf1\x37\xdf\x47\xd9\x46\x68\x8c\x55\xc5\x53\x1e\x93\xb9\x47\x34\xba\x55\x17\xad\x29\xda\x53\xed\x1a\x6e\xe7\xae\x41\xde\x72\xf5\x46\x40\xf4\xb6\x08\x07\x89\x2f\x6f\xf4\x91\x1c\xa1\xef\xbe\x60\x62\xd2\xf7\x31\x51\xaa\x4b\x3f\xc1\x86\x7a\x73\x78\xb2\x87\x7e\x58\x8a\xe5\x0e\x83\x49\x35\x2b\xa4\x66\xd3\xda\xac\x25\xbd\x0f\x62\x0e\x6f\x8a\xd5\x70\x27\xe3\x0d\x73\xb0\x91\x0a\x1d\xad\xfd\x65\xa6\xcd\x19\x68\x43\x0f\x70\x3d\x69\x43\xdd\xd4\x63\x69\xc3\x60\x52\xcd\x0a\xa9\xd9\xb4\x36\x6b\xc5\xd3\x86\x37\xc5\x6a\xb8\x93\xd1\xc6\x70\xa6\x2e\x9a\x71\xa6\xcd\x05\xd0\xa6\xfb\xa4\xe2\x47\x1a\x57\xf6\xc3\x00\xf2\x98\x02\x22\x9f\x87\x3b\xac\x25\x56\xe3\x9d\x70\xc6\xb1\xe6\x28\x3c\x5a\x7b\xce\x4c\xa0\xb3\x10\xa8\xad\x4a\x63\x90\x3f\x0f\xb0\x5a\xb7\xd7\x3a\xc9\x53\xd6\x3b\x6f\x41\x69\x67\x9c\x40\x50\x3a\x6e\xf8\x0a\xca\x8a\xf9\x09\x6a\xe0\xd0\x80\xa0\xf7\x65\x75\x63\x5d\x4d\x04\x4b\x8b\x03\xc5\x55\xdf\x9f\x63\x34\x32\xe8\x50\xd4\xe2\xa0\x46\xd9\xbe\xf3\x0b\x7c\xbd\x01\xcf\xf0\xbe\xbc\xfe\xc5\x43\xb1\x83\x9b\x6b\x7f\x7f\xeb\x44\x7d\x92\x3b\x00\xff\x1a\x3c\x81\xf9\xd9\xe7\xc7\xb2\x7e\xf6\xed\x01\x62\x51\xbf\x4e\xdf\x4b\x30\x56\xec\x2e\x65\x24\x49\xaf\x34\x81\xcc\x83\x0e\xa7\x9e\x8a\x38\xa3\x7b\x9d\x43\x65\xa6\x0c\xf8\x22\x76\x80\xe2\x0c\x9e\xa7\xbc\x8f\x1b\x7e\x23\xdf\x33\x3d\x55\x19\xf9\x11\x51\x69\x4d\x30\x8e\xef\x97\xd8\x44\x7d\xe2\x70\x64\x6a\x92\x2c\x31\x41\xff\x65\xeb\x40\x4f\xdd\x5e\xce\xf2\xd1\xd4\x35\x25\x80\xe3\x48\xe5\xaa\x21\xe3\x23\x15\xab\x46\x38\x68\x8f\x79\x48\x0d\xea\xd6\xd9\x9a\x29\xa5\xcb\x91\x62\x7a\xc7\xf2\xa6\xac\x49\x8c\x08\x5f\xd3\xe9\xc1\x1a\x86\x7a\xa4\x59\x08\xaf\xb1\xc5\x2e\x71\x38\x0c\x55\x4f\x24\x80\xac\x4b\x2b\x58\x17\xcb\x48\xc2\x72\xf4\x25\xbe\xdb\xe4\xdd\x69\x8a\xe5\x15\xb1\x1c\x82\xde\x5c\x65\xc5\xac\xb2\x9d\x8c\xab\x2a\xc5\xa4\xc2\xc7\xc4\xf4\xac\xeb\x56\x09\xdc\x1b\xa9\x53\xf5\x76\x0a\x8b\xa5\x2e\xbf\xa5\xd1\x9c\xe1\xc6\xf8\x9a\xdc\x72\x08\x61\x62\xd4\x97\x42\xde\xd0\x70\x88\x5e\xaa\x68\x0c\xae\x82\xe9\x08\x24\xdb\x79\x0a\xfe\xf4\x6d\xf5\xe6\x90\x58\xd4\x2a\xe2\xc9\x78\xa4\x50\x40\x2a\x7b\x1c\xa9\x61\x25\x1a\x67\xac\x6a\x15\xc1\xdb\x45\xa8\x42\xa2\x7d\x65\x78\xb9\x29\x5f\x0a\xc3\xeb\x1d\xcc\xab\xed\x3e\x2f\x20\xc6\x64\x60\x46\xa5\xb7\xc1\xc0\xab\xdd\x50\x43\x73\x5c\xd7\xa0\x70\x52\xec\xab\x58\x84\x5e\x2d\xe1\x18\xba\x0e\xa7\x23\xa7\x1e\x07\x7b\x0a\x7e\x92\x08\xd3\xfe\x76\xbe\x04\x46\x2a\x6c\x92\xca\x1e\x47\xaa\xb5\xdb\x97\xd2\x90\xb2\xd7\x45\xac\x36\xa5\xfb\x20\x9e\x5a\xf5\xba\x4b\xd2\x23\x3a\x42\x6a\x09\x82\xda\xa3\x12\xab\x67\xf1\xf7\x7d\x90\xa2\x45\x05\x30\x96\x12\x59\x2f\x00\xee\x02\x17\x89\x67\xf2\x26\x5d\x59\xdb\x90\x81\xaa\xc9\x76\x60\xdd\x94\x7f\xc1\x62\x50\x4c\x2b\xcc\x0b\x7c\xc4\xa8\x81\x5e\x00\x8e\x6e\x40\x53\x9c\x8a\x68\xaa\x44\x23\xac\xff\x54\x2e\x0f\xb0\xc6\xda\x05\x59\x50\x55\xe6\x1f\x30\xac\x0f\xb0\x56\x9c\xdc\xc6\xf5\x16\xa1\x2e\x1f\x1d\x58\xdb\x15\x0c\x22\xca\xe0\x03\x92\xa8\x4f\x1c\x36\x7d\x58\x71\x4f\x12\x11\xd1\x61\x91\x37\x5a\xef\x4d\xe0\x4b\x3c\x5f\x99\x7c\x9b\x2b\x93\x5e\xa3\xa5\x15\xe5\x5d\xdf\xbc\x93\x07\xee\xd3\xdd\xa0\x7b\xd3\x60\x33\x0f\x2b\xf6\xe9\x48\x04\x0c\xa2\x3e\x3f\x4c\x8c\x1a\x03\xe6\x01\x64\x1e\x40\xe6\x01\x64\xd2\x01\xc4\x49\x7f\xba\xaa\x97\x00\x83\xe8\x4f\x3f\x5c\x7b\x7f\xbc\x9b\x8e\xfd\x73\x2c\x8c\x39\x16\xc6\x1c\x0b\x63\x8e\x85\x31\xc7\xc2\x98\x63\x61\xbc\xb7\x58\x18\x2c\x9d\xf7\x3c\x1d\xcf\xd3\xf1\x3c\x1d\xcf\xd3\xf1\x3c\x1d\xcf\xd3\xf1\x3c\x1d\xbf\xed\x74\xfc\x61\xfa\xd3\x2b\x8e\x12\xa7\x49\x5b\xc4\x24\x17\x40\xa2\x3e\x71\xc8\xf4\x61\x65\xf2\x20\x13\xc1\x83\x96\x18\x91\xf6\xe6\xba\x8a\xc4\xf1\xed\xf1\x89\xfa\xc4\xe1\xd2\x87\x15\x71\x99\x95\xd0\x82\x34\x73\xc6\x59\xda\x29\x9d\xea\x9c\x24\x22\x07\x09\x3a\x3b\xda\xbd\xbd\xa3\x9d\x96\xc7\x5d\xc4\x0d\xb2\xda\xec\x94\x65\x75\xca\xd2\x52\xf8\x8a\xb8\x41\xca\x9e\xbd\x74\xde\xce\x4b\xe7\x61\xe5\xe7\x49\x60\xb7\x12\xd6\x71\x4c\x86\xea\xb1\xec\xc9\x17\xfb\xdd\x91\xf2\xe7\x64\x4f\x9c\x65\xb0\x22\x1d\x5f\xfa\xe1\xfb\x68\x71\xbf\xa9\x17\xfa\x86\x32\x3b\x3d\x91\x5b\x9e\x40\x48\x0f\x18\x79\xbb\x13\xa3\x7f\x94\x5b\x0b\x4d\xd9\x80\x3c\x75\xa4\x26\x1a\xc8\x36\xe4\xe9\x88\xd1\x36\xc0\x6a\xa6\xc1\xae\xd8\xb5\xf6\xdd\x67\x5d\x92\xb0\xfa\x14\x91\x3a\x88\xa1\x7b\xb4\xc9\x28\x17\x3c\x69\x36\xbb\x06\x4c\xa4\x22\x7d\x06\xb2\x5c\x69\xe0\x11\xc3\xa2\x21\x39\x15\x1b\x96\xa0\xd3\x94\x59\xd1\x44\x93\xd1\xfd\xf9\x2b\x54\x5c\xa4\x1c\x5d\xd2\xde\x27\xcc\xfa\x73\x3a\xb3\x51\x2a\x8d\x6e\xe8\x70\xea\xb9\xab\x42\xce\x3c\x77\x58\x2d\xae\xbe\x5d\x7f\x58\x90\xf3\x59\xa2\x4e\xf2\xee\x19\x64\x3b\x54\x10\x87\x2f\xb0\x21\x3b\x53\xf2\x80\x95\x74\x81\xfe\x9c\xd4\x63\xe5\x77\x2b\x6a\x4f\x66\xc5\xed\x5c\x23\x17\xf1\x1b\xd8\x00\x94\x87\x97\xef\x4e\x70\xec\xe5\x35\x6d\xba\x95\x41\x8f\xeb\xc8\x70\xa2\x93\xca\x64\x7d\x32\xee\x7e\xbe\xbb\xbd\x59\xd0\x42\xac\x17\xa0\xa2\x8d\xff\xc7\x5d\xfc\xc8\x99\xb3\x9e\x3e\x59\x6b\xae\x2e\xae\x26\xb2\x9c\xfa\x7e\x74\x23\xc9\xc6\x54\x6d\x1c\xc9\xdd\xb9\x28\xeb\x45\xbb\x9a\xef\x47\x86\x90\x16\x26\xb6\xff\x1d\x13\xf5\x49\xa3\x97\x6c\x04\xbb\xe9\xac\x44\x68\xf9\xe7\xed\x5e\x0b\xf5\xba\x87\xcf\x96\xc2\x5c\x68\xd9\x38\x22\x15\x1a\xd0\x92\x94\x14\x93\x67\xaa\x14\xaa\x4e\x7f\x02\x1b\xea\x51\x2b\x35\xc8\x64\x7d\x6e\x5b\xdc\xe6\x4d\x2e\xca\x86\xe4\x55\x46\x72\xea\x64\x9a\x58\x5f\x02\x23\x6b\x97\xaa\xca\x51\x46\x1c\xd5\x8b\xff\xff\x89\x4d\xa7\xd8\xc6\xbc\x9f\xc3\x5a\xf1\xd7\xc9\x07\xbf\x8c\xa0\x8c\x7c\x5b\x80\x72\xf8\x6e\x65\xbd\x29\x9b\xaf\xe5\x06\x6d\x11\xdc\x0c\x4a\x9c\x81\x6c\x27\x24\x36\x65\x84\xbf\xde\x2e\x6f\xca\x02\x2e\xbf\x92\x90\x1f\x8b\x5f\xef\xc1\xd3\x82\xa6\x42\xbd\xde\x2e\x19\xf8\xf2\x0e\x15\x19\x5c\x90\x53\x59\x32\x6f\x6d\x6b\x88\x77\xff\x4b\xb5\xe6\x3c\xac\xde\x7b\xbf\xe3\xc3\x8e\x54\x6a\xac\x39\x18\x97\x87\x44\xbf\xa0\xce\x17\x24\xb0\x9c\x94\x17\x66\xfb\x1a\x35\xaf\x77\x04\x51\x1e\x85\x40\x85\xbe\x40\xcb\x7e\x99\xfe\x26\x88\xde\xa5\xc8\xde\x41\xa0\x24\xd1\xa7\xe7\xa6\xe9\xef\xcb\xab\x0a\x2d\x09\xa0\xa6\xf8\x47\x08\x6a\x58\x9b\x2b\xda\x35\x4d\x25\xc2\xb5\x8a\x6a\x9b\x40\x4b\x09\xbf\x75\x6f\x3e\xf2\x6f\x11\x9f\x7f\xbb\x37\x54\x06\x30\xca\xbc\xeb\x6a\x0b\xd1\x5f\x3a\x45\x1e\x93\xc5\xe2\x98\x1c\x93\x7f\x07\x00\xa6\x5d\x1c\x1d\xfa\x66\x01\x00")
+
+func openapiJsonBytes() ([]byte, error) {
+	return bindataRead(
+		_openapiJson,
+		"openapi.json",
+	)
+}
+
+func openapiJson() (*asset, error) {
+	bytes, err := openapiJsonBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "openapi.json", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+// Asset loads and returns the asset for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func Asset(name string) ([]byte, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
+		}
+		return a.bytes, nil
+	}
+	return nil, fmt.Errorf("Asset %s not found", name)
+}
+
+// MustAsset is like Asset but panics when Asset would return an error.
+// It simplifies safe initialization of global variables.
+func MustAsset(name string) []byte {
+	a, err := Asset(name)
+	if err != nil {
+		panic("asset: Asset(" + name + "): " + err.Error())
+	}
+
+	return a
+}
+
+// AssetInfo loads and returns the asset info for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func AssetInfo(name string) (os.FileInfo, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
+		}
+		return a.info, nil
+	}
+	return nil, fmt.Errorf("AssetInfo %s not found", name)
+}
+
+// AssetNames returns the names of the assets.
+func AssetNames() []string {
+	names := make([]string, 0, len(_bindata))
+	for name := range _bindata {
+		names = append(names, name)
+	}
+	return names
+}
+
+// _bindata is a table, holding each asset generator, mapped to its name.
+var _bindata = map[string]func() (*asset, error){
+	"openapi.json": openapiJson,
+}
+
+// AssetDir returns the file names below a certain
+// directory embedded in the file by go-bindata.
+// For example if you run go-bindata on data/... and data contains the
+// following hierarchy:
+//     data/
+//       foo.txt
+//       img/
+//         a.png
+//         b.png
+// then AssetDir("data") would return []string{"foo.txt", "img"}
+// AssetDir("data/img") would return []string{"a.png", "b.png"}
+// AssetDir("foo.txt") and AssetDir("notexist") would return an error
+// AssetDir("") will return []string{"data"}.
+func AssetDir(name string) ([]string, error) {
+	node := _bintree
+	if len(name) != 0 {
+		cannonicalName := strings.Replace(name, "\\", "/", -1)
+		pathList := strings.Split(cannonicalName, "/")
+		for _, p := range pathList {
+			node = node.Children[p]
+			if node == nil {
+				return nil, fmt.Errorf("Asset %s not found", name)
+			}
+		}
+	}
+	if node.Func != nil {
+		return nil, fmt.Errorf("Asset %s not found", name)
+	}
+	rv := make([]string, 0, len(node.Children))
+	for childName := range node.Children {
+		rv = append(rv, childName)
+	}
+	return rv, nil
+}
+
+type bintree struct {
+	Func     func() (*asset, error)
+	Children map[string]*bintree
+}
+var _bintree = &bintree{nil, map[string]*bintree{
+	"openapi.json": &bintree{openapiJson, map[string]*bintree{}},
+}}
+
+// RestoreAsset restores an asset under the given directory
+func RestoreAsset(dir, name string) error {
+	data, err := Asset(name)
+	if err != nil {
+		return err
+	}
+	info, err := AssetInfo(name)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
+	if err != nil {
+		return err
+	}
+	err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// RestoreAssets restores an asset under the given directory recursively
+func RestoreAssets(dir, name string) error {
+	children, err := AssetDir(name)
+	// File
+	if err != nil {
+		return RestoreAsset(dir, name)
+	}
+	// Dir
+	for _, child := range children {
+		err = RestoreAssets(dir, filepath.Join(name, child))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func _filePath(dir, name string) string {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
+}
+
@@ -0,0 +1,108 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/errs"
+	"github.com/honestbee/Zen/redact"
+)
+
+// Validator validates the requests of the RESTful APIs against the Spec, the responses are
+// validated as well if it is enabled, which is meant for the testing environments.
+type Validator struct {
+	spec      *Spec
+	logger    *zerolog.Logger
+	responses bool
+}
+
+// NewValidator returns a Validator instance.
+func NewValidator(spec *Spec, conf *config.Config, logger *zerolog.Logger) *Validator {
+	return &Validator{
+		spec:      spec,
+		logger:    logger,
+		responses: conf.HTTP.ValidateResponses,
+	}
+}
+
+// ErrorWriter writes the response of the failed validation.
+type ErrorWriter func(w http.ResponseWriter, r *http.Request, er *errs.Error)
+
+// WriteError writes the error as the {"error": "..."} body.
+func WriteError(w http.ResponseWriter, r *http.Request, er *errs.Error) {
+	w.Header().Set("Content-Type", jsonMediaType)
+	w.WriteHeader(er.Status)
+	json.NewEncoder(w).Encode(er)
+}
+
+// Handle wraps the handle of the method and the httprouter route, the failed validations are
+// written by write. An error is returned if the operation is not documented.
+func (v *Validator) Handle(method, route string, h httprouter.Handle, write ErrorWriter) (httprouter.Handle, error) {
+	op := v.spec.Operation(method, route)
+	if op == nil {
+		return nil, errors.Errorf("openapi: [Handle] %s %s is not documented", method, route)
+	}
+
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		if err := v.spec.ValidateRequest(op, r, ps); err != nil {
+			er := errs.NewErr(errs.InvalidAttributeErrorCode, err)
+			if fe, ok := err.(*FieldError); ok {
+				er.Parameter, er.Pointer, er.Detail = fe.Parameter, fe.Pointer, fe.Reason
+			}
+			v.fail(w, r, er, write)
+			return
+		}
+		if !v.responses {
+			h(w, r, ps)
+			return
+		}
+
+		rec := &recorder{ResponseWriter: w, status: http.StatusOK}
+		h(rec, r, ps)
+		if err := v.spec.ValidateResponse(op, rec.status, rec.body.Bytes()); err != nil {
+			v.fail(w, r, errs.NewErr(errs.ServerInternalErrorCode, err), write)
+			return
+		}
+		w.WriteHeader(rec.status)
+		w.Write(rec.body.Bytes())
+	}, nil
+}
+
+func (v *Validator) fail(w http.ResponseWriter, r *http.Request, er *errs.Error, write ErrorWriter) {
+	v.logger.Error().Fields(map[string]interface{}{
+		"from":   r.RemoteAddr,
+		"path":   redact.String(r.URL.Path),
+		"method": r.Method,
+		"agent":  r.UserAgent(),
+		"error":  redact.String(er.Error()),
+	}).Msgf("openapi validation failed")
+
+	write(w, r, er)
+}
+
+// recorder buffers the response to be validated before it is written.
+type recorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (rec *recorder) WriteHeader(status int) {
+	if rec.wroteHeader {
+		return
+	}
+	rec.status = status
+	rec.wroteHeader = true
+}
+
+func (rec *recorder) Write(b []byte) (int, error) {
+	rec.wroteHeader = true
+	return rec.body.Write(b)
+}
@@ -0,0 +1,234 @@
+package openapi
+
+//go:generate go-bindata -ignore=\.go -nometadata -pkg=openapi -o=bindata.go ./...
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	specAssetName = "openapi.json"
+	refPrefix     = "#/components/"
+	jsonMediaType = "application/json"
+)
+
+// routeParam matches the httprouter named parameters of the routes.
+var routeParam = regexp.MustCompile(`:([a-zA-Z_]+)`)
+
+// Spec is the OpenAPI 3 document of the RESTful APIs, only the parts used for
+// validating the requests and the responses are decoded.
+type Spec struct {
+	raw        []byte
+	Paths      map[string]map[string]*Operation `json:"paths"`
+	Components *Components                      `json:"components"`
+}
+
+// Components is the reusable objects of the document.
+type Components struct {
+	Parameters map[string]*Parameter `json:"parameters"`
+	Schemas    map[string]*Schema    `json:"schemas"`
+	Responses  map[string]*Response  `json:"responses"`
+}
+
+// Operation is a single API operation of a path.
+type Operation struct {
+	OperationID string               `json:"operationId"`
+	Parameters  []*Parameter         `json:"parameters"`
+	RequestBody *RequestBody         `json:"requestBody"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+// Parameter is a path or query parameter of an operation.
+type Parameter struct {
+	Ref      string  `json:"$ref"`
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *Schema `json:"schema"`
+}
+
+// RequestBody is the request body of an operation.
+type RequestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+// Response is a response of an operation.
+type Response struct {
+	Ref     string                `json:"$ref"`
+	Content map[string]*MediaType `json:"content"`
+}
+
+// MediaType is the schema of a content type.
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Schema is the subset of the JSON schema supported by the validator.
+type Schema struct {
+	Ref        string             `json:"$ref"`
+	Type       string             `json:"type"`
+	Format     string             `json:"format"`
+	Nullable   bool               `json:"nullable"`
+	Enum       []interface{}      `json:"enum"`
+	Minimum    *float64           `json:"minimum"`
+	MinLength  int                `json:"minLength"`
+	Required   []string           `json:"required"`
+	Properties map[string]*Schema `json:"properties"`
+	Items      *Schema            `json:"items"`
+	AllOf      []*Schema          `json:"allOf"`
+}
+
+// Load returns the Spec of the embedded document, all the references are checked.
+//
+// If this method complains about not finding function MustAsset(),
+// run `go generate` against this package to generate the function.
+func Load() (*Spec, error) {
+	return parse(MustAsset(specAssetName))
+}
+
+func parse(b []byte) (*Spec, error) {
+	s := &Spec{raw: b}
+	if err := json.Unmarshal(b, s); err != nil {
+		return nil, errors.Wrapf(err, "openapi: [parse] json unmarshal failed")
+	}
+	if s.Components == nil {
+		s.Components = new(Components)
+	}
+
+	for path, ops := range s.Paths {
+		for method, op := range ops {
+			if err := s.check(op); err != nil {
+				return nil, errors.Wrapf(err, "openapi: [parse] check %s %s failed", strings.ToUpper(method), path)
+			}
+		}
+	}
+	return s, nil
+}
+
+// check resolves the references of the operation.
+func (s *Spec) check(op *Operation) error {
+	for _, p := range op.Parameters {
+		p, err := s.parameter(p)
+		if err != nil {
+			return err
+		}
+		if err = s.checkSchema(p.Schema, map[*Schema]bool{}); err != nil {
+			return err
+		}
+	}
+	if op.RequestBody != nil {
+		for _, mt := range op.RequestBody.Content {
+			if err := s.checkSchema(mt.Schema, map[*Schema]bool{}); err != nil {
+				return err
+			}
+		}
+	}
+	for _, resp := range op.Responses {
+		resp, err := s.response(resp)
+		if err != nil {
+			return err
+		}
+		for _, mt := range resp.Content {
+			if err = s.checkSchema(mt.Schema, map[*Schema]bool{}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Spec) checkSchema(sc *Schema, visited map[*Schema]bool) error {
+	sc, err := s.schema(sc)
+	if err != nil || sc == nil || visited[sc] {
+		return err
+	}
+	visited[sc] = true
+
+	children := append([]*Schema{sc.Items}, sc.AllOf...)
+	for _, p := range sc.Properties {
+		children = append(children, p)
+	}
+	for _, child := range children {
+		if err = s.checkSchema(child, visited); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// refName returns the component name of the reference of the kind.
+func refName(ref, kind string) (string, error) {
+	prefix := refPrefix + kind + "/"
+	if !strings.HasPrefix(ref, prefix) {
+		return "", errors.Errorf("openapi: [refName] ref:%q is not a %s reference", ref, kind)
+	}
+	return strings.TrimPrefix(ref, prefix), nil
+}
+
+func (s *Spec) schema(sc *Schema) (*Schema, error) {
+	for sc != nil && sc.Ref != "" {
+		name, err := refName(sc.Ref, "schemas")
+		if err != nil {
+			return nil, err
+		}
+		ref, ok := s.Components.Schemas[name]
+		if !ok {
+			return nil, errors.Errorf("openapi: [schema] schema:%q not found", name)
+		}
+		sc = ref
+	}
+	return sc, nil
+}
+
+func (s *Spec) parameter(p *Parameter) (*Parameter, error) {
+	if p.Ref == "" {
+		return p, nil
+	}
+	name, err := refName(p.Ref, "parameters")
+	if err != nil {
+		return nil, err
+	}
+	ref, ok := s.Components.Parameters[name]
+	if !ok {
+		return nil, errors.Errorf("openapi: [parameter] parameter:%q not found", name)
+	}
+	return ref, nil
+}
+
+func (s *Spec) response(resp *Response) (*Response, error) {
+	if resp.Ref == "" {
+		return resp, nil
+	}
+	name, err := refName(resp.Ref, "responses")
+	if err != nil {
+		return nil, err
+	}
+	ref, ok := s.Components.Responses[name]
+	if !ok {
+		return nil, errors.Errorf("openapi: [response] response:%q not found", name)
+	}
+	return ref, nil
+}
+
+// Path converts the httprouter route to the path template of the document,
+// for example /api/articles/:article_id is converted to /api/articles/{article_id}.
+func Path(route string) string {
+	return routeParam.ReplaceAllString(route, "{$1}")
+}
+
+// Operation returns the operation of the method and the httprouter route, nil is returned if it is not documented.
+func (s *Spec) Operation(method, route string) *Operation {
+	return s.Paths[Path(route)][strings.ToLower(method)]
+}
+
+// ServeHTTP serves the document.
+func (s *Spec) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", jsonMediaType)
+	w.Write(s.raw)
+}
@@ -0,0 +1,455 @@
+package openapi
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/rs/zerolog"
+
+	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/errs"
+	"github.com/honestbee/Zen/inout"
+	"github.com/honestbee/Zen/models"
+)
+
+var logger = zerolog.New(ioutil.Discard)
+
+func mustLoad(t *testing.T) *Spec {
+	s, err := Load()
+	if err != nil {
+		t.Fatalf("load spec failed:%v", err)
+	}
+	return s
+}
+
+func TestParse(t *testing.T) {
+	testCases := [...]struct {
+		description string
+		doc         string
+		expectErr   bool
+	}{
+		{
+			description: "testing normal case",
+			doc:         `{"paths":{"/a":{"get":{"parameters":[{"$ref":"#/components/parameters/p"}]}}},"components":{"parameters":{"p":{"name":"p","in":"query"}}}}`,
+		},
+		{
+			description: "testing missing parameter case",
+			doc:         `{"paths":{"/a":{"get":{"parameters":[{"$ref":"#/components/parameters/q"}]}}}}`,
+			expectErr:   true,
+		},
+		{
+			description: "testing missing schema case",
+			doc:         `{"paths":{"/a":{"get":{"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/s"}}}}}}}}}}`,
+			expectErr:   true,
+		},
+		{
+			description: "testing wrong reference kind case",
+			doc:         `{"paths":{"/a":{"get":{"responses":{"200":{"$ref":"#/components/schemas/s"}}}}},"components":{"schemas":{"s":{}}}}`,
+			expectErr:   true,
+		},
+		{
+			description: "testing invalid json case",
+			doc:         `{"paths":`,
+			expectErr:   true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			_, err := parse([]byte(tt.doc))
+			if tt.expectErr && err == nil {
+				t.Errorf("[%s] expect an error, actual nil", tt.description)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("[%s] expect no error, actual:%v", tt.description, err)
+			}
+		})
+	}
+}
+
+func TestPath(t *testing.T) {
+	testCases := [...]struct {
+		description string
+		route       string
+		expect      string
+	}{
+		{
+			description: "testing static route case",
+			route:       "/api/categories",
+			expect:      "/api/categories",
+		},
+		{
+			description: "testing named parameters case",
+			route:       "/api/vote/:article_id/:value",
+			expect:      "/api/vote/{article_id}/{value}",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			if actual := Path(tt.route); actual != tt.expect {
+				t.Errorf("[%s] expect:%s, actual:%s", tt.description, tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestValidateRequest(t *testing.T) {
+	s := mustLoad(t)
+
+	testCases := [...]struct {
+		description string
+		method      string
+		route       string
+		target      string
+		body        string
+		params      httprouter.Params
+		expectErr   bool
+	}{
+		{
+			description: "testing normal case",
+			method:      http.MethodGet,
+			route:       "/api/categories/:category_id/articles",
+			target:      "/api/categories/1/articles?locale=zh-tw&country_code=tw&per_page=500&sort_by=created_at&label_names=a,b",
+			params:      httprouter.Params{{Key: "category_id", Value: "1"}},
+		},
+		{
+			description: "testing empty values case",
+			method:      http.MethodGet,
+			route:       "/api/categories",
+			target:      "/api/categories?locale=&page=",
+		},
+		{
+			description: "testing invalid path parameter case",
+			method:      http.MethodGet,
+			route:       "/api/articles/:article_id",
+			target:      "/api/articles/abc",
+			params:      httprouter.Params{{Key: "article_id", Value: "abc"}},
+			expectErr:   true,
+		},
+		{
+			description: "testing path parameter not in the list case",
+			method:      http.MethodPost,
+			route:       "/api/vote/:article_id/:value",
+			target:      "/api/vote/1/sideways",
+			params:      httprouter.Params{{Key: "article_id", Value: "1"}, {Key: "value", Value: "sideways"}},
+			expectErr:   true,
+		},
+		{
+			description: "testing locale not in the list case",
+			method:      http.MethodGet,
+			route:       "/api/categories",
+			target:      "/api/categories?locale=fr",
+			expectErr:   true,
+		},
+		{
+			description: "testing page less than minimum case",
+			method:      http.MethodGet,
+			route:       "/api/categories",
+			target:      "/api/categories?page=0",
+			expectErr:   true,
+		},
+		{
+			description: "testing missing required query case",
+			method:      http.MethodGet,
+			route:       "/api/search",
+			target:      "/api/search?locale=en-us",
+			expectErr:   true,
+		},
+		{
+			description: "testing valid body case",
+			method:      http.MethodPost,
+			route:       "/api/requests",
+			target:      "/api/requests",
+			body:        `{"country_code":"sg","data":{"request":{"subject":"hi"}}}`,
+		},
+		{
+			description: "testing missing required property case",
+			method:      http.MethodPost,
+			route:       "/api/requests",
+			target:      "/api/requests",
+			body:        `{"data":{}}`,
+			expectErr:   true,
+		},
+		{
+			description: "testing wrong property type case",
+			method:      http.MethodPost,
+			route:       "/api/requests",
+			target:      "/api/requests",
+			body:        `{"country_code":"sg","data":[]}`,
+			expectErr:   true,
+		},
+		{
+			description: "testing missing body case",
+			method:      http.MethodPost,
+			route:       "/api/requests",
+			target:      "/api/requests",
+			expectErr:   true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			r := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			err := s.ValidateRequest(s.Operation(tt.method, tt.route), r, tt.params)
+			if tt.expectErr && err == nil {
+				t.Errorf("[%s] expect an error, actual nil", tt.description)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("[%s] expect no error, actual:%v", tt.description, err)
+			}
+
+			// The body is kept for the handlers.
+			if b, _ := ioutil.ReadAll(r.Body); string(b) != tt.body {
+				t.Errorf("[%s] expect body:%s, actual:%s", tt.description, tt.body, b)
+			}
+		})
+	}
+}
+
+func TestValidateResponse(t *testing.T) {
+	s := mustLoad(t)
+	article := &models.Article{ID: 1, Title: "title", LabelNames: []string{"a"}}
+
+	testCases := [...]struct {
+		description string
+		method      string
+		route       string
+		status      int
+		body        interface{}
+		expectErr   bool
+	}{
+		{
+			description: "testing categories case",
+			method:      http.MethodGet,
+			route:       "/api/categories",
+			status:      http.StatusOK,
+			body: &inout.GetCategoriesOut{
+				Categories: []*models.Category{{ID: 1, Name: "name"}},
+				BaseOut:    &inout.BaseOut{Page: 1, PerPage: 30, PageCount: 1, Count: 1},
+				Connection: &inout.ConnectionOut{SortBy: "position", SortOrder: "asc"},
+			},
+		},
+		{
+			description: "testing empty articles case",
+			method:      http.MethodGet,
+			route:       "/api/sections/:section_id/articles",
+			status:      http.StatusOK,
+			body:        &inout.GetArticlesOut{BaseOut: &inout.BaseOut{}},
+		},
+		{
+			description: "testing search case",
+			method:      http.MethodGet,
+			route:       "/api/search",
+			status:      http.StatusOK,
+			body: &inout.GetSearchOut{
+				Articles: []*models.SearchArticle{{Article: article, CategoryID: 2, Snippet: "snippet"}},
+				BaseOut:  &inout.BaseOut{},
+			},
+		},
+		{
+			description: "testing ticket form case",
+			method:      http.MethodGet,
+			route:       "/api/ticket_forms/:form_id",
+			status:      http.StatusOK,
+			body: &inout.GetTicketFormOut{TicketForm: &models.TicketForm{
+				ID:           1,
+				TicketFields: []*models.TicketField{{ID: 2, CustomFieldOptions: []*models.CustomFieldOption{{Name: "a"}}}},
+			}},
+		},
+		{
+			description: "testing force sync case",
+			method:      http.MethodPost,
+			route:       "/api/forcesync",
+			status:      http.StatusOK,
+			body:        inout.SuccessForceSync,
+		},
+		{
+			description: "testing created case",
+			method:      http.MethodPost,
+			route:       "/api/requests",
+			status:      http.StatusCreated,
+			body:        errs.NewErr(errs.SuccessCreatedCode, nil),
+		},
+		{
+			description: "testing default error case",
+			method:      http.MethodGet,
+			route:       "/api/articles/:article_id",
+			status:      http.StatusNotFound,
+			body:        errs.NewErr(errs.RecordNotFoundErrorCode, nil),
+		},
+		{
+			description: "testing v2 search case",
+			method:      http.MethodGet,
+			route:       "/api/v2/search",
+			status:      http.StatusOK,
+			body: &inout.V2Out{
+				Data: []*models.SearchArticle{{Article: article, CategoryID: 2, Snippet: "snippet"}},
+				Meta: &inout.V2MetaOut{Page: &inout.V2PageOut{Size: 1, Total: 1, EndCursor: inout.EncodeOffsetCursor(0)}},
+			},
+		},
+		{
+			description: "testing v2 sparse fieldset case",
+			method:      http.MethodGet,
+			route:       "/api/v2/articles/:article_id",
+			status:      http.StatusOK,
+			body:        map[string]interface{}{"data": map[string]interface{}{"id": 1}},
+		},
+		{
+			description: "testing v2 accepted case",
+			method:      http.MethodPost,
+			route:       "/api/v2/forcesync",
+			status:      http.StatusAccepted,
+			body:        &inout.V2Out{Data: &inout.V2StatusOut{Status: inout.V2StatusAccepted}},
+		},
+		{
+			description: "testing v2 error case",
+			method:      http.MethodGet,
+			route:       "/api/v2/articles/:article_id",
+			status:      http.StatusNotFound,
+			body: &inout.V2Out{Errors: []*inout.V2ErrorOut{
+				inout.NewV2ErrorOut(errs.NewErr(errs.RecordNotFoundErrorCode, nil)),
+			}},
+		},
+		{
+			description: "testing v2 listing without meta case",
+			method:      http.MethodGet,
+			route:       "/api/v2/categories",
+			status:      http.StatusOK,
+			body:        &inout.V2Out{Data: []*models.Category{}},
+			expectErr:   true,
+		},
+		{
+			description: "testing missing property case",
+			method:      http.MethodGet,
+			route:       "/api/articles/:article_id",
+			status:      http.StatusOK,
+			body:        map[string]interface{}{"article": map[string]interface{}{"id": 1}},
+			expectErr:   true,
+		},
+		{
+			description: "testing null object case",
+			method:      http.MethodGet,
+			route:       "/api/articles/:article_id",
+			status:      http.StatusOK,
+			body:        &inout.GetArticleOut{},
+			expectErr:   true,
+		},
+		{
+			description: "testing wrong item type case",
+			method:      http.MethodGet,
+			route:       "/api/instant_search",
+			status:      http.StatusOK,
+			body:        map[string]interface{}{"results": []interface{}{"title"}},
+			expectErr:   true,
+		},
+		{
+			description: "testing not an integer case",
+			method:      http.MethodPost,
+			route:       "/api/vote/:article_id/:value",
+			status:      http.StatusOK,
+			body:        map[string]interface{}{"vote_sum": 1.5, "vote_count": 1},
+			expectErr:   true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			b, err := json.Marshal(tt.body)
+			if err != nil {
+				t.Fatalf("[%s] json marshal failed:%v", tt.description, err)
+			}
+			err = s.ValidateResponse(s.Operation(tt.method, tt.route), tt.status, b)
+			if tt.expectErr && err == nil {
+				t.Errorf("[%s] expect an error, actual nil", tt.description)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("[%s] expect no error, actual:%v", tt.description, err)
+			}
+		})
+	}
+}
+
+func TestHandle(t *testing.T) {
+	s := mustLoad(t)
+	valid := func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		json.NewEncoder(w).Encode(&inout.CreateVoteOut{VoteSum: 1, VoteCount: 1})
+	}
+	invalid := func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		w.Write([]byte(`{"vote_sum":"1"}`))
+	}
+
+	testCases := [...]struct {
+		description  string
+		responses    bool
+		target       string
+		handle       httprouter.Handle
+		expectStatus int
+		expectBody   string
+	}{
+		{
+			description:  "testing normal case",
+			target:       "/api/vote/1/up",
+			handle:       valid,
+			expectStatus: http.StatusOK,
+			expectBody:   `{"vote_sum":1,"vote_count":1}`,
+		},
+		{
+			description:  "testing invalid request case",
+			target:       "/api/vote/1/sideways",
+			handle:       valid,
+			expectStatus: http.StatusBadRequest,
+			expectBody:   `{"error":"You passed an invalid value for the attributes."}`,
+		},
+		{
+			description:  "testing invalid response not validated case",
+			target:       "/api/vote/1/up",
+			handle:       invalid,
+			expectStatus: http.StatusOK,
+			expectBody:   `{"vote_sum":"1"}`,
+		},
+		{
+			description:  "testing valid response validated case",
+			responses:    true,
+			target:       "/api/vote/1/up",
+			handle:       valid,
+			expectStatus: http.StatusOK,
+			expectBody:   `{"vote_sum":1,"vote_count":1}`,
+		},
+		{
+			description:  "testing invalid response validated case",
+			responses:    true,
+			target:       "/api/vote/1/up",
+			handle:       invalid,
+			expectStatus: http.StatusInternalServerError,
+			expectBody:   `{"error":"Internal Server Error"}`,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			conf := &config.Config{HTTP: &config.HTTP{ValidateResponses: tt.responses}}
+			h, err := NewValidator(s, conf, &logger).Handle(http.MethodPost, "/api/vote/:article_id/:value", tt.handle, WriteError)
+			if err != nil {
+				t.Fatalf("[%s] handle failed:%v", tt.description, err)
+			}
+			mux := httprouter.New()
+			mux.POST("/api/vote/:article_id/:value", h)
+
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, httptest.NewRequest(http.MethodPost, tt.target, nil))
+			if w.Code != tt.expectStatus {
+				t.Errorf("[%s] expect status:%d, actual:%d", tt.description, tt.expectStatus, w.Code)
+			}
+			if actual := strings.TrimSpace(w.Body.String()); actual != tt.expectBody {
+				t.Errorf("[%s] expect body:%s, actual:%s", tt.description, tt.expectBody, actual)
+			}
+		})
+	}
+
+	if _, err := NewValidator(s, &config.Config{HTTP: &config.HTTP{}}, &logger).Handle(http.MethodGet, "/api/undocumented", nil, WriteError); err == nil {
+		t.Errorf("expect an error for the undocumented route, actual nil")
+	}
+}
@@ -0,0 +1,282 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/pkg/errors"
+)
+
+const defaultResponse = "default"
+
+// FieldError is the validation error of a request or response field.
+type FieldError struct {
+	// Parameter is the name of the invalid path or query parameter.
+	Parameter string
+	// Pointer is the JSON pointer of the invalid body field, it is empty for the parameters.
+	Pointer string
+	// Reason describes why the field is invalid.
+	Reason string
+}
+
+// Error implements the error interface.
+func (e *FieldError) Error() string {
+	if e.Parameter != "" {
+		return fmt.Sprintf("openapi: [validate] parameter:%s %s", e.Parameter, e.Reason)
+	}
+	return fmt.Sprintf("openapi: [validate] body:%q %s", e.Pointer, e.Reason)
+}
+
+func fieldErr(pointer, format string, args ...interface{}) *FieldError {
+	return &FieldError{Pointer: pointer, Reason: fmt.Sprintf(format, args...)}
+}
+
+// ValidateRequest validates the path and query parameters and the JSON body of the request,
+// the body is restored to be read by the handler.
+func (s *Spec) ValidateRequest(op *Operation, r *http.Request, ps httprouter.Params) error {
+	query := r.URL.Query()
+	for _, p := range op.Parameters {
+		p, err := s.parameter(p)
+		if err != nil {
+			return errors.Wrapf(err, "openapi: [ValidateRequest] resolve parameter failed")
+		}
+
+		var value string
+		switch p.In {
+		case "path":
+			value = ps.ByName(p.Name)
+		case "query":
+			value = query.Get(p.Name)
+		default:
+			continue
+		}
+		// The empty values are served as the defaults by the handlers.
+		if value == "" {
+			if p.Required {
+				return &FieldError{Parameter: p.Name, Reason: "is required"}
+			}
+			continue
+		}
+
+		sc, err := s.schema(p.Schema)
+		if err != nil {
+			return errors.Wrapf(err, "openapi: [ValidateRequest] resolve schema of parameter:%s failed", p.Name)
+		}
+		v, err := parseParam(value, sc)
+		if err != nil {
+			return &FieldError{Parameter: p.Name, Reason: fmt.Sprintf("is not a %s", sc.Type)}
+		}
+		if err = s.validate("", sc, v); err != nil {
+			if fe, ok := err.(*FieldError); ok {
+				return &FieldError{Parameter: p.Name, Reason: fe.Reason}
+			}
+			return err
+		}
+	}
+
+	if op.RequestBody == nil {
+		return nil
+	}
+	mt, ok := op.RequestBody.Content[jsonMediaType]
+	if !ok {
+		return nil
+	}
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return errors.Wrapf(err, "openapi: [ValidateRequest] read body failed")
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(b))
+	if len(bytes.TrimSpace(b)) == 0 {
+		if op.RequestBody.Required {
+			return fieldErr("", "is required")
+		}
+		return nil
+	}
+
+	v, err := decode(b)
+	if err != nil {
+		return fieldErr("", "is not a valid json")
+	}
+	return s.validate("", mt.Schema, v)
+}
+
+// ValidateResponse validates the JSON body of the response of the status code,
+// the default response is used if the status code is not documented.
+func (s *Spec) ValidateResponse(op *Operation, status int, body []byte) error {
+	resp, ok := op.Responses[strconv.Itoa(status)]
+	if !ok {
+		resp, ok = op.Responses[defaultResponse]
+	}
+	if !ok {
+		return errors.Errorf("openapi: [ValidateResponse] status:%d is not documented", status)
+	}
+	resp, err := s.response(resp)
+	if err != nil {
+		return errors.Wrapf(err, "openapi: [ValidateResponse] resolve response failed")
+	}
+
+	mt, ok := resp.Content[jsonMediaType]
+	if !ok {
+		if len(body) != 0 {
+			return errors.Errorf("openapi: [ValidateResponse] status:%d has no body documented", status)
+		}
+		return nil
+	}
+	v, err := decode(body)
+	if err != nil {
+		return errors.Wrapf(err, "openapi: [ValidateResponse] decode body failed")
+	}
+	return s.validate("", mt.Schema, v)
+}
+
+// decode decodes the JSON keeping the numbers as json.Number, so that the integers are told from the numbers.
+func decode(b []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// parseParam converts the parameter value into the JSON value of the schema type.
+func parseParam(value string, sc *Schema) (interface{}, error) {
+	if sc == nil {
+		return value, nil
+	}
+	switch sc.Type {
+	case "integer":
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return nil, err
+		}
+		return json.Number(value), nil
+	case "number":
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return nil, err
+		}
+		return json.Number(value), nil
+	case "boolean":
+		return strconv.ParseBool(value)
+	}
+	return value, nil
+}
+
+// validate validates the JSON value against the schema, the pointer locates the value.
+func (s *Spec) validate(pointer string, sc *Schema, v interface{}) error {
+	sc, err := s.schema(sc)
+	if err != nil {
+		return errors.Wrapf(err, "openapi: [validate] resolve schema of %q failed", pointer)
+	}
+	if sc == nil {
+		return nil
+	}
+
+	for _, sub := range sc.AllOf {
+		if err = s.validate(pointer, sub, v); err != nil {
+			return err
+		}
+	}
+
+	if v == nil {
+		if sc.Type != "" && !sc.Nullable {
+			return fieldErr(pointer, "is null")
+		}
+		return nil
+	}
+
+	switch sc.Type {
+	case "object":
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return fieldErr(pointer, "is not an object")
+		}
+		for _, name := range sc.Required {
+			if _, ok := m[name]; !ok {
+				return fieldErr(pointer+"/"+escape(name), "is required")
+			}
+		}
+		// The properties are validated in order, so that the same error is reported for the same value.
+		names := make([]string, 0, len(sc.Properties))
+		for name := range sc.Properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if pv, ok := m[name]; ok {
+				if err = s.validate(pointer+"/"+escape(name), sc.Properties[name], pv); err != nil {
+					return err
+				}
+			}
+		}
+	case "array":
+		a, ok := v.([]interface{})
+		if !ok {
+			return fieldErr(pointer, "is not an array")
+		}
+		for i, item := range a {
+			if err = s.validate(fmt.Sprintf("%s/%d", pointer, i), sc.Items, item); err != nil {
+				return err
+			}
+		}
+	case "string":
+		str, ok := v.(string)
+		if !ok {
+			return fieldErr(pointer, "is not a string")
+		}
+		if utf8.RuneCountInString(str) < sc.MinLength {
+			return fieldErr(pointer, "is shorter than %d", sc.MinLength)
+		}
+		if sc.Format == "date-time" {
+			if _, err = time.Parse(time.RFC3339Nano, str); err != nil {
+				return fieldErr(pointer, "is not a date-time")
+			}
+		}
+	case "integer", "number":
+		n, ok := v.(json.Number)
+		if !ok {
+			return fieldErr(pointer, "is not a %s", sc.Type)
+		}
+		if sc.Type == "integer" {
+			if _, err = n.Int64(); err != nil {
+				return fieldErr(pointer, "is not an integer")
+			}
+		}
+		f, err := n.Float64()
+		if err != nil {
+			return fieldErr(pointer, "is not a number")
+		}
+		if sc.Minimum != nil && f < *sc.Minimum {
+			return fieldErr(pointer, "is less than %v", *sc.Minimum)
+		}
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			return fieldErr(pointer, "is not a boolean")
+		}
+	}
+
+	if len(sc.Enum) == 0 {
+		return nil
+	}
+	for _, e := range sc.Enum {
+		if fmt.Sprint(e) == fmt.Sprint(v) {
+			return nil
+		}
+	}
+	return fieldErr(pointer, "is not in the list")
+}
+
+// escape escapes the name as a JSON pointer reference token.
+func escape(name string) string {
+	return strings.Replace(strings.Replace(name, "~", "~0", -1), "/", "~1", -1)
+}
@@ -0,0 +1,134 @@
+package persisted
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/inout"
+	"github.com/honestbee/Zen/models"
+)
+
+// The messages of the errors are the ones the apollo clients look for.
+var (
+	// ErrQueryNotFound means the persisted query is not registered, the client
+	// should send the query text with its hash to register it.
+	ErrQueryNotFound = errors.New("PersistedQueryNotFound")
+	// ErrQueryNotSupported means the automatic persisted queries are disabled.
+	ErrQueryNotSupported = errors.New("PersistedQueryNotSupported")
+	// ErrQueryHashMismatch means the sha256 hash does not match the query text.
+	ErrQueryHashMismatch = errors.New("provided sha does not match query")
+	// ErrQueryVersion means the version of the persisted query extension is not supported.
+	ErrQueryVersion = errors.New("unsupported persisted query version")
+	// ErrQueryNotAllowed means the query is not in the allow list.
+	ErrQueryNotAllowed = errors.New("only persisted queries of the allow list are executed")
+)
+
+const supportedVersion = 1
+
+// Store resolves the persisted queries, the queries are the pre-registered ones of the manifest
+// and the automatic persisted queries registered by the clients which are kept in the cache.
+type Store struct {
+	conf     *config.PersistedQuery
+	logger   *zerolog.Logger
+	service  models.Service
+	manifest map[string]string
+}
+
+// New returns a Store instance, the manifest is a json object of the queries keyed by their ids.
+func New(conf *config.Config, logger *zerolog.Logger, service models.Service) (*Store, error) {
+	s := &Store{
+		conf:     conf.PersistedQuery,
+		logger:   logger,
+		service:  service,
+		manifest: make(map[string]string),
+	}
+
+	if s.conf.ManifestPath != "" {
+		b, err := ioutil.ReadFile(s.conf.ManifestPath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "persisted: [New] ioutil read path:%q failed", s.conf.ManifestPath)
+		}
+		if err := json.Unmarshal(b, &s.manifest); err != nil {
+			return nil, errors.Wrapf(err, "persisted: [New] json unmarshal manifest failed")
+		}
+	}
+
+	return s, nil
+}
+
+// Resolve fills the query text of the persisted query, the query text given with an unknown
+// sha256 hash is registered as an automatic persisted query.
+// The query which is not persisted is left untouched unless only the allow list is executed.
+func (s *Store) Resolve(ctx context.Context, q *inout.GraphQLQuery) error {
+	if q.ID != "" {
+		return s.resolveManifest(q, q.ID)
+	}
+
+	pq := q.PersistedQuery()
+	if pq == nil {
+		if s.conf.AllowListOnly {
+			return ErrQueryNotAllowed
+		}
+		return nil
+	}
+	if pq.Version != supportedVersion {
+		return ErrQueryVersion
+	}
+
+	hash := strings.ToLower(pq.Sha256Hash)
+	if q.Query != "" && hash != Hash(q.Query) {
+		return ErrQueryHashMismatch
+	}
+
+	// The manifest may be keyed by the hashes as well.
+	if _, ok := s.manifest[hash]; ok || s.conf.AllowListOnly {
+		return s.resolveManifest(q, hash)
+	}
+	if !s.conf.Enable {
+		return ErrQueryNotSupported
+	}
+
+	if q.Query != "" {
+		if err := s.service.SetPersistedQuery(ctx, hash, q.Query, s.conf.TTLSec); err != nil {
+			// The query is executed anyway, the client sends it again after it is not found.
+			s.logger.Error().Err(err).Msgf("persisted: [Resolve] service.SetPersistedQuery failed")
+		}
+		return nil
+	}
+
+	query, err := s.service.GetPersistedQuery(ctx, hash, s.conf.TTLSec)
+	if err != nil {
+		if err != models.ErrNotFound {
+			s.logger.Error().Err(err).Msgf("persisted: [Resolve] service.GetPersistedQuery failed")
+		}
+		return ErrQueryNotFound
+	}
+	q.Query = query
+	return nil
+}
+
+func (s *Store) resolveManifest(q *inout.GraphQLQuery, id string) error {
+	query, ok := s.manifest[id]
+	if !ok {
+		if s.conf.AllowListOnly {
+			return ErrQueryNotAllowed
+		}
+		return ErrQueryNotFound
+	}
+	q.Query = query
+	return nil
+}
+
+// Hash returns the hex encoded sha256 hash of the query text.
+func Hash(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,126 @@
+package persisted
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/rs/zerolog"
+
+	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/inout"
+	"github.com/honestbee/Zen/models"
+)
+
+const (
+	testQuery         = `{ nodes(ids: []) { id } }`
+	testManifestQuery = `{ allCategories { zendeskId } }`
+)
+
+func newTestStore(t *testing.T, conf *config.PersistedQuery) *Store {
+	f, err := ioutil.TempFile("", "manifest")
+	if err != nil {
+		t.Fatalf("create manifest failed:%v", err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString(`{"categories":"` + testManifestQuery + `","` + Hash(testManifestQuery) + `":"` + testManifestQuery + `"}`)
+	f.Close()
+
+	conf.ManifestPath = f.Name()
+	logger := zerolog.New(ioutil.Discard)
+	s, err := New(&config.Config{PersistedQuery: conf}, &logger, models.NewMockService())
+	if err != nil {
+		t.Fatalf("new store failed:%v", err)
+	}
+	return s
+}
+
+func persistedQuery(hash string) *inout.GraphQLExtensions {
+	return &inout.GraphQLExtensions{PersistedQuery: &inout.GraphQLPersistedQuery{Version: 1, Sha256Hash: hash}}
+}
+
+func TestResolve(t *testing.T) {
+	testCases := [...]struct {
+		description string
+		conf        *config.PersistedQuery
+		input       []*inout.GraphQLQuery
+		expect      []string
+		expectErr   []error
+	}{
+		{
+			description: "testing not persisted query case",
+			conf:        &config.PersistedQuery{Enable: true},
+			input:       []*inout.GraphQLQuery{{Query: testQuery}},
+			expect:      []string{testQuery},
+			expectErr:   []error{nil},
+		},
+		{
+			description: "testing automatic persisted query registered case",
+			conf:        &config.PersistedQuery{Enable: true},
+			input: []*inout.GraphQLQuery{
+				{Extensions: persistedQuery(Hash(testQuery))},
+				{Query: testQuery, Extensions: persistedQuery(Hash(testQuery))},
+				{Extensions: persistedQuery(Hash(testQuery))},
+			},
+			expect:    []string{"", testQuery, testQuery},
+			expectErr: []error{ErrQueryNotFound, nil, nil},
+		},
+		{
+			description: "testing automatic persisted query failed case",
+			conf:        &config.PersistedQuery{Enable: true},
+			input: []*inout.GraphQLQuery{
+				{Query: testQuery, Extensions: persistedQuery(Hash("other"))},
+				{Query: testQuery, Extensions: &inout.GraphQLExtensions{PersistedQuery: &inout.GraphQLPersistedQuery{Version: 2, Sha256Hash: Hash(testQuery)}}},
+			},
+			expect:    []string{testQuery, testQuery},
+			expectErr: []error{ErrQueryHashMismatch, ErrQueryVersion},
+		},
+		{
+			description: "testing automatic persisted query disabled case",
+			conf:        &config.PersistedQuery{Enable: false},
+			input: []*inout.GraphQLQuery{
+				{Query: testQuery, Extensions: persistedQuery(Hash(testQuery))},
+				{Extensions: persistedQuery(Hash(testManifestQuery))},
+			},
+			expect:    []string{testQuery, testManifestQuery},
+			expectErr: []error{ErrQueryNotSupported, nil},
+		},
+		{
+			description: "testing manifest query case",
+			conf:        &config.PersistedQuery{Enable: true},
+			input:       []*inout.GraphQLQuery{{ID: "categories"}, {ID: "unknown"}},
+			expect:      []string{testManifestQuery, ""},
+			expectErr:   []error{nil, ErrQueryNotFound},
+		},
+		{
+			description: "testing allow list only case",
+			conf:        &config.PersistedQuery{Enable: true, AllowListOnly: true},
+			input: []*inout.GraphQLQuery{
+				{Query: testQuery},
+				{Query: testQuery, Extensions: persistedQuery(Hash(testQuery))},
+				{ID: "unknown"},
+				{ID: "categories"},
+				{Extensions: persistedQuery(Hash(testManifestQuery))},
+			},
+			expect:    []string{testQuery, testQuery, "", testManifestQuery, testManifestQuery},
+			expectErr: []error{ErrQueryNotAllowed, ErrQueryNotAllowed, ErrQueryNotAllowed, nil, nil},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			s := newTestStore(t, tt.conf)
+			for i, q := range tt.input {
+				err := s.Resolve(context.Background(), q)
+				if err != tt.expectErr[i] {
+					t.Errorf("[%s] query %d expect error:%v, actual:%v", tt.description, i, tt.expectErr[i], err)
+				}
+				if diff := deep.Equal(tt.expect[i], q.Query); diff != nil {
+					t.Errorf("[%s] query %d %v", tt.description, i, diff)
+				}
+			}
+		})
+	}
+}
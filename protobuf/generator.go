@@ -1,3 +1,3 @@
-//go:generate protoc zendesk.proto --go_out=plugins=grpc:.
+//go:generate protoc -I . -I $GOOGLEAPIS_DIR zendesk.proto --go_out=paths=source_relative:. --go-grpc_out=paths=source_relative,require_unimplemented_servers=false:. --grpc-gateway_out=paths=source_relative:.
 
 package protobuf
@@ -1,28 +1,27 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
 // source: zendesk.proto
 
 package protobuf
 
-import proto "github.com/golang/protobuf/proto"
-import fmt "fmt"
-import math "math"
-import timestamp "github.com/golang/protobuf/ptypes/timestamp"
-
 import (
-	context "golang.org/x/net/context"
-	grpc "google.golang.org/grpc"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
 )
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ = proto.Marshal
-var _ = fmt.Errorf
-var _ = math.Inf
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the proto package it is being compiled against.
-// A compilation error at this line likely means your copy of the
-// proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
 
 type CountryCode int32
 
@@ -37,32 +36,55 @@ const (
 	CountryCode_COUNTRY_CODE_PH CountryCode = 7
 )
 
-var CountryCode_name = map[int32]string{
-	0: "COUNTRY_CODE_SG",
-	1: "COUNTRY_CODE_HK",
-	2: "COUNTRY_CODE_TW",
-	3: "COUNTRY_CODE_JP",
-	4: "COUNTRY_CODE_TH",
-	5: "COUNTRY_CODE_MY",
-	6: "COUNTRY_CODE_ID",
-	7: "COUNTRY_CODE_PH",
-}
-var CountryCode_value = map[string]int32{
-	"COUNTRY_CODE_SG": 0,
-	"COUNTRY_CODE_HK": 1,
-	"COUNTRY_CODE_TW": 2,
-	"COUNTRY_CODE_JP": 3,
-	"COUNTRY_CODE_TH": 4,
-	"COUNTRY_CODE_MY": 5,
-	"COUNTRY_CODE_ID": 6,
-	"COUNTRY_CODE_PH": 7,
+// Enum value maps for CountryCode.
+var (
+	CountryCode_name = map[int32]string{
+		0: "COUNTRY_CODE_SG",
+		1: "COUNTRY_CODE_HK",
+		2: "COUNTRY_CODE_TW",
+		3: "COUNTRY_CODE_JP",
+		4: "COUNTRY_CODE_TH",
+		5: "COUNTRY_CODE_MY",
+		6: "COUNTRY_CODE_ID",
+		7: "COUNTRY_CODE_PH",
+	}
+	CountryCode_value = map[string]int32{
+		"COUNTRY_CODE_SG": 0,
+		"COUNTRY_CODE_HK": 1,
+		"COUNTRY_CODE_TW": 2,
+		"COUNTRY_CODE_JP": 3,
+		"COUNTRY_CODE_TH": 4,
+		"COUNTRY_CODE_MY": 5,
+		"COUNTRY_CODE_ID": 6,
+		"COUNTRY_CODE_PH": 7,
+	}
+)
+
+func (x CountryCode) Enum() *CountryCode {
+	p := new(CountryCode)
+	*p = x
+	return p
 }
 
 func (x CountryCode) String() string {
-	return proto.EnumName(CountryCode_name, int32(x))
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CountryCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_zendesk_proto_enumTypes[0].Descriptor()
+}
+
+func (CountryCode) Type() protoreflect.EnumType {
+	return &file_zendesk_proto_enumTypes[0]
+}
+
+func (x CountryCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
+
+// Deprecated: Use CountryCode.Descriptor instead.
 func (CountryCode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{0}
+	return file_zendesk_proto_rawDescGZIP(), []int{0}
 }
 
 type Locale int32
@@ -76,28 +98,51 @@ const (
 	Locale_LOCALE_ID    Locale = 5
 )
 
-var Locale_name = map[int32]string{
-	0: "LOCALE_EN_US",
-	1: "LOCALE_ZH_TW",
-	2: "LOCALE_ZH_CN",
-	3: "LOCALE_JA",
-	4: "LOCALE_TH",
-	5: "LOCALE_ID",
-}
-var Locale_value = map[string]int32{
-	"LOCALE_EN_US": 0,
-	"LOCALE_ZH_TW": 1,
-	"LOCALE_ZH_CN": 2,
-	"LOCALE_JA":    3,
-	"LOCALE_TH":    4,
-	"LOCALE_ID":    5,
+// Enum value maps for Locale.
+var (
+	Locale_name = map[int32]string{
+		0: "LOCALE_EN_US",
+		1: "LOCALE_ZH_TW",
+		2: "LOCALE_ZH_CN",
+		3: "LOCALE_JA",
+		4: "LOCALE_TH",
+		5: "LOCALE_ID",
+	}
+	Locale_value = map[string]int32{
+		"LOCALE_EN_US": 0,
+		"LOCALE_ZH_TW": 1,
+		"LOCALE_ZH_CN": 2,
+		"LOCALE_JA":    3,
+		"LOCALE_TH":    4,
+		"LOCALE_ID":    5,
+	}
+)
+
+func (x Locale) Enum() *Locale {
+	p := new(Locale)
+	*p = x
+	return p
 }
 
 func (x Locale) String() string {
-	return proto.EnumName(Locale_name, int32(x))
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Locale) Descriptor() protoreflect.EnumDescriptor {
+	return file_zendesk_proto_enumTypes[1].Descriptor()
+}
+
+func (Locale) Type() protoreflect.EnumType {
+	return &file_zendesk_proto_enumTypes[1]
+}
+
+func (x Locale) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
+
+// Deprecated: Use Locale.Descriptor instead.
 func (Locale) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{1}
+	return file_zendesk_proto_rawDescGZIP(), []int{1}
 }
 
 type SortBy int32
@@ -108,22 +153,45 @@ const (
 	SortBy_SORT_BY_UPDATED_AT SortBy = 2
 )
 
-var SortBy_name = map[int32]string{
-	0: "SORT_BY_POSITION",
-	1: "SORT_BY_CREATED_AT",
-	2: "SORT_BY_UPDATED_AT",
-}
-var SortBy_value = map[string]int32{
-	"SORT_BY_POSITION":   0,
-	"SORT_BY_CREATED_AT": 1,
-	"SORT_BY_UPDATED_AT": 2,
+// Enum value maps for SortBy.
+var (
+	SortBy_name = map[int32]string{
+		0: "SORT_BY_POSITION",
+		1: "SORT_BY_CREATED_AT",
+		2: "SORT_BY_UPDATED_AT",
+	}
+	SortBy_value = map[string]int32{
+		"SORT_BY_POSITION":   0,
+		"SORT_BY_CREATED_AT": 1,
+		"SORT_BY_UPDATED_AT": 2,
+	}
+)
+
+func (x SortBy) Enum() *SortBy {
+	p := new(SortBy)
+	*p = x
+	return p
 }
 
 func (x SortBy) String() string {
-	return proto.EnumName(SortBy_name, int32(x))
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_zendesk_proto_enumTypes[2].Descriptor()
 }
+
+func (SortBy) Type() protoreflect.EnumType {
+	return &file_zendesk_proto_enumTypes[2]
+}
+
+func (x SortBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortBy.Descriptor instead.
 func (SortBy) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{2}
+	return file_zendesk_proto_rawDescGZIP(), []int{2}
 }
 
 type SortOrder int32
@@ -133,20 +201,43 @@ const (
 	SortOrder_SORT_ORDER_DESC SortOrder = 1
 )
 
-var SortOrder_name = map[int32]string{
-	0: "SORT_ORDER_ASC",
-	1: "SORT_ORDER_DESC",
-}
-var SortOrder_value = map[string]int32{
-	"SORT_ORDER_ASC":  0,
-	"SORT_ORDER_DESC": 1,
+// Enum value maps for SortOrder.
+var (
+	SortOrder_name = map[int32]string{
+		0: "SORT_ORDER_ASC",
+		1: "SORT_ORDER_DESC",
+	}
+	SortOrder_value = map[string]int32{
+		"SORT_ORDER_ASC":  0,
+		"SORT_ORDER_DESC": 1,
+	}
+)
+
+func (x SortOrder) Enum() *SortOrder {
+	p := new(SortOrder)
+	*p = x
+	return p
 }
 
 func (x SortOrder) String() string {
-	return proto.EnumName(SortOrder_name, int32(x))
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_zendesk_proto_enumTypes[3].Descriptor()
+}
+
+func (SortOrder) Type() protoreflect.EnumType {
+	return &file_zendesk_proto_enumTypes[3]
+}
+
+func (x SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
+
+// Deprecated: Use SortOrder.Descriptor instead.
 func (SortOrder) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{3}
+	return file_zendesk_proto_rawDescGZIP(), []int{3}
 }
 
 type Vote int32
@@ -156,4212 +247,4399 @@ const (
 	Vote_VOTE_DOWN Vote = 1
 )
 
-var Vote_name = map[int32]string{
-	0: "VOTE_UP",
-	1: "VOTE_DOWN",
-}
-var Vote_value = map[string]int32{
-	"VOTE_UP":   0,
-	"VOTE_DOWN": 1,
+// Enum value maps for Vote.
+var (
+	Vote_name = map[int32]string{
+		0: "VOTE_UP",
+		1: "VOTE_DOWN",
+	}
+	Vote_value = map[string]int32{
+		"VOTE_UP":   0,
+		"VOTE_DOWN": 1,
+	}
+)
+
+func (x Vote) Enum() *Vote {
+	p := new(Vote)
+	*p = x
+	return p
 }
 
 func (x Vote) String() string {
-	return proto.EnumName(Vote_name, int32(x))
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Vote) Descriptor() protoreflect.EnumDescriptor {
+	return file_zendesk_proto_enumTypes[4].Descriptor()
 }
+
+func (Vote) Type() protoreflect.EnumType {
+	return &file_zendesk_proto_enumTypes[4]
+}
+
+func (x Vote) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Vote.Descriptor instead.
 func (Vote) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{4}
+	return file_zendesk_proto_rawDescGZIP(), []int{4}
 }
 
-type Category struct {
-	Id                   string               `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
-	Position             int32                `protobuf:"varint,2,opt,name=position" json:"position,omitempty"`
-	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=createdAt" json:"createdAt,omitempty"`
-	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=updatedAt" json:"updatedAt,omitempty"`
-	SourceLocale         string               `protobuf:"bytes,5,opt,name=sourceLocale" json:"sourceLocale,omitempty"`
-	Outdated             bool                 `protobuf:"varint,6,opt,name=outdated" json:"outdated,omitempty"`
-	CountryCode          string               `protobuf:"bytes,7,opt,name=countryCode" json:"countryCode,omitempty"`
-	KeyName              string               `protobuf:"bytes,8,opt,name=keyName" json:"keyName,omitempty"`
-	Url                  string               `protobuf:"bytes,9,opt,name=url" json:"url,omitempty"`
-	HtmlUrl              string               `protobuf:"bytes,10,opt,name=htmlUrl" json:"htmlUrl,omitempty"`
-	Name                 string               `protobuf:"bytes,11,opt,name=name" json:"name,omitempty"`
-	Description          string               `protobuf:"bytes,12,opt,name=description" json:"description,omitempty"`
-	Locale               string               `protobuf:"bytes,13,opt,name=locale" json:"locale,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
-}
-
-func (m *Category) Reset()         { *m = Category{} }
-func (m *Category) String() string { return proto.CompactTextString(m) }
-func (*Category) ProtoMessage()    {}
-func (*Category) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{0}
+type ChangeType int32
+
+const (
+	ChangeType_CHANGE_TYPE_CATEGORIES_CHANGED ChangeType = 0
+	ChangeType_CHANGE_TYPE_SECTIONS_CHANGED   ChangeType = 1
+	ChangeType_CHANGE_TYPE_ARTICLE_UPDATED    ChangeType = 2
+	ChangeType_CHANGE_TYPE_ARTICLES_DELETED   ChangeType = 3
+)
+
+// Enum value maps for ChangeType.
+var (
+	ChangeType_name = map[int32]string{
+		0: "CHANGE_TYPE_CATEGORIES_CHANGED",
+		1: "CHANGE_TYPE_SECTIONS_CHANGED",
+		2: "CHANGE_TYPE_ARTICLE_UPDATED",
+		3: "CHANGE_TYPE_ARTICLES_DELETED",
+	}
+	ChangeType_value = map[string]int32{
+		"CHANGE_TYPE_CATEGORIES_CHANGED": 0,
+		"CHANGE_TYPE_SECTIONS_CHANGED":   1,
+		"CHANGE_TYPE_ARTICLE_UPDATED":    2,
+		"CHANGE_TYPE_ARTICLES_DELETED":   3,
+	}
+)
+
+func (x ChangeType) Enum() *ChangeType {
+	p := new(ChangeType)
+	*p = x
+	return p
+}
+
+func (x ChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
-func (m *Category) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Category.Unmarshal(m, b)
+
+func (ChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_zendesk_proto_enumTypes[5].Descriptor()
 }
-func (m *Category) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Category.Marshal(b, m, deterministic)
+
+func (ChangeType) Type() protoreflect.EnumType {
+	return &file_zendesk_proto_enumTypes[5]
 }
-func (dst *Category) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Category.Merge(dst, src)
+
+func (x ChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
-func (m *Category) XXX_Size() int {
-	return xxx_messageInfo_Category.Size(m)
+
+// Deprecated: Use ChangeType.Descriptor instead.
+func (ChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{5}
 }
-func (m *Category) XXX_DiscardUnknown() {
-	xxx_messageInfo_Category.DiscardUnknown(m)
+
+type Category struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Position      int32                  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	SourceLocale  string                 `protobuf:"bytes,5,opt,name=sourceLocale,proto3" json:"sourceLocale,omitempty"`
+	Outdated      bool                   `protobuf:"varint,6,opt,name=outdated,proto3" json:"outdated,omitempty"`
+	CountryCode   string                 `protobuf:"bytes,7,opt,name=countryCode,proto3" json:"countryCode,omitempty"`
+	KeyName       string                 `protobuf:"bytes,8,opt,name=keyName,proto3" json:"keyName,omitempty"`
+	Url           string                 `protobuf:"bytes,9,opt,name=url,proto3" json:"url,omitempty"`
+	HtmlUrl       string                 `protobuf:"bytes,10,opt,name=htmlUrl,proto3" json:"htmlUrl,omitempty"`
+	Name          string                 `protobuf:"bytes,11,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,12,opt,name=description,proto3" json:"description,omitempty"`
+	Locale        string                 `protobuf:"bytes,13,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_zendesk_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_Category proto.InternalMessageInfo
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{0}
+}
 
-func (m *Category) GetId() string {
-	if m != nil {
-		return m.Id
+func (x *Category) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (m *Category) GetPosition() int32 {
-	if m != nil {
-		return m.Position
+func (x *Category) GetPosition() int32 {
+	if x != nil {
+		return x.Position
 	}
 	return 0
 }
 
-func (m *Category) GetCreatedAt() *timestamp.Timestamp {
-	if m != nil {
-		return m.CreatedAt
+func (x *Category) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (m *Category) GetUpdatedAt() *timestamp.Timestamp {
-	if m != nil {
-		return m.UpdatedAt
+func (x *Category) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-func (m *Category) GetSourceLocale() string {
-	if m != nil {
-		return m.SourceLocale
+func (x *Category) GetSourceLocale() string {
+	if x != nil {
+		return x.SourceLocale
 	}
 	return ""
 }
 
-func (m *Category) GetOutdated() bool {
-	if m != nil {
-		return m.Outdated
+func (x *Category) GetOutdated() bool {
+	if x != nil {
+		return x.Outdated
 	}
 	return false
 }
 
-func (m *Category) GetCountryCode() string {
-	if m != nil {
-		return m.CountryCode
+func (x *Category) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
 	}
 	return ""
 }
 
-func (m *Category) GetKeyName() string {
-	if m != nil {
-		return m.KeyName
+func (x *Category) GetKeyName() string {
+	if x != nil {
+		return x.KeyName
 	}
 	return ""
 }
 
-func (m *Category) GetUrl() string {
-	if m != nil {
-		return m.Url
+func (x *Category) GetUrl() string {
+	if x != nil {
+		return x.Url
 	}
 	return ""
 }
 
-func (m *Category) GetHtmlUrl() string {
-	if m != nil {
-		return m.HtmlUrl
+func (x *Category) GetHtmlUrl() string {
+	if x != nil {
+		return x.HtmlUrl
 	}
 	return ""
 }
 
-func (m *Category) GetName() string {
-	if m != nil {
-		return m.Name
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (m *Category) GetDescription() string {
-	if m != nil {
-		return m.Description
+func (x *Category) GetDescription() string {
+	if x != nil {
+		return x.Description
 	}
 	return ""
 }
 
-func (m *Category) GetLocale() string {
-	if m != nil {
-		return m.Locale
+func (x *Category) GetLocale() string {
+	if x != nil {
+		return x.Locale
 	}
 	return ""
 }
 
 type Section struct {
-	Id                   string               `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
-	Position             int32                `protobuf:"varint,2,opt,name=position" json:"position,omitempty"`
-	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=createdAt" json:"createdAt,omitempty"`
-	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=updatedAt" json:"updatedAt,omitempty"`
-	SourceLocale         string               `protobuf:"bytes,5,opt,name=sourceLocale" json:"sourceLocale,omitempty"`
-	Outdated             bool                 `protobuf:"varint,6,opt,name=outdated" json:"outdated,omitempty"`
-	CountryCode          string               `protobuf:"bytes,7,opt,name=countryCode" json:"countryCode,omitempty"`
-	Url                  string               `protobuf:"bytes,8,opt,name=url" json:"url,omitempty"`
-	HtmlUrl              string               `protobuf:"bytes,9,opt,name=htmlUrl" json:"htmlUrl,omitempty"`
-	Name                 string               `protobuf:"bytes,10,opt,name=name" json:"name,omitempty"`
-	Description          string               `protobuf:"bytes,11,opt,name=description" json:"description,omitempty"`
-	Locale               string               `protobuf:"bytes,12,opt,name=locale" json:"locale,omitempty"`
-	CategoryId           string               `protobuf:"bytes,13,opt,name=categoryId" json:"categoryId,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
-}
-
-func (m *Section) Reset()         { *m = Section{} }
-func (m *Section) String() string { return proto.CompactTextString(m) }
-func (*Section) ProtoMessage()    {}
-func (*Section) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{1}
-}
-func (m *Section) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Section.Unmarshal(m, b)
-}
-func (m *Section) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Section.Marshal(b, m, deterministic)
-}
-func (dst *Section) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Section.Merge(dst, src)
-}
-func (m *Section) XXX_Size() int {
-	return xxx_messageInfo_Section.Size(m)
-}
-func (m *Section) XXX_DiscardUnknown() {
-	xxx_messageInfo_Section.DiscardUnknown(m)
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Position      int32                  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	SourceLocale  string                 `protobuf:"bytes,5,opt,name=sourceLocale,proto3" json:"sourceLocale,omitempty"`
+	Outdated      bool                   `protobuf:"varint,6,opt,name=outdated,proto3" json:"outdated,omitempty"`
+	CountryCode   string                 `protobuf:"bytes,7,opt,name=countryCode,proto3" json:"countryCode,omitempty"`
+	Url           string                 `protobuf:"bytes,8,opt,name=url,proto3" json:"url,omitempty"`
+	HtmlUrl       string                 `protobuf:"bytes,9,opt,name=htmlUrl,proto3" json:"htmlUrl,omitempty"`
+	Name          string                 `protobuf:"bytes,10,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,11,opt,name=description,proto3" json:"description,omitempty"`
+	Locale        string                 `protobuf:"bytes,12,opt,name=locale,proto3" json:"locale,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,13,opt,name=categoryId,proto3" json:"categoryId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Section) Reset() {
+	*x = Section{}
+	mi := &file_zendesk_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Section) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Section) ProtoMessage() {}
+
+func (x *Section) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_Section proto.InternalMessageInfo
+// Deprecated: Use Section.ProtoReflect.Descriptor instead.
+func (*Section) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{1}
+}
 
-func (m *Section) GetId() string {
-	if m != nil {
-		return m.Id
+func (x *Section) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (m *Section) GetPosition() int32 {
-	if m != nil {
-		return m.Position
+func (x *Section) GetPosition() int32 {
+	if x != nil {
+		return x.Position
 	}
 	return 0
 }
 
-func (m *Section) GetCreatedAt() *timestamp.Timestamp {
-	if m != nil {
-		return m.CreatedAt
+func (x *Section) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (m *Section) GetUpdatedAt() *timestamp.Timestamp {
-	if m != nil {
-		return m.UpdatedAt
+func (x *Section) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-func (m *Section) GetSourceLocale() string {
-	if m != nil {
-		return m.SourceLocale
+func (x *Section) GetSourceLocale() string {
+	if x != nil {
+		return x.SourceLocale
 	}
 	return ""
 }
 
-func (m *Section) GetOutdated() bool {
-	if m != nil {
-		return m.Outdated
+func (x *Section) GetOutdated() bool {
+	if x != nil {
+		return x.Outdated
 	}
 	return false
 }
 
-func (m *Section) GetCountryCode() string {
-	if m != nil {
-		return m.CountryCode
+func (x *Section) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
 	}
 	return ""
 }
 
-func (m *Section) GetUrl() string {
-	if m != nil {
-		return m.Url
+func (x *Section) GetUrl() string {
+	if x != nil {
+		return x.Url
 	}
 	return ""
 }
 
-func (m *Section) GetHtmlUrl() string {
-	if m != nil {
-		return m.HtmlUrl
+func (x *Section) GetHtmlUrl() string {
+	if x != nil {
+		return x.HtmlUrl
 	}
 	return ""
 }
 
-func (m *Section) GetName() string {
-	if m != nil {
-		return m.Name
+func (x *Section) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (m *Section) GetDescription() string {
-	if m != nil {
-		return m.Description
+func (x *Section) GetDescription() string {
+	if x != nil {
+		return x.Description
 	}
 	return ""
 }
 
-func (m *Section) GetLocale() string {
-	if m != nil {
-		return m.Locale
+func (x *Section) GetLocale() string {
+	if x != nil {
+		return x.Locale
 	}
 	return ""
 }
 
-func (m *Section) GetCategoryId() string {
-	if m != nil {
-		return m.CategoryId
+func (x *Section) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
 	}
 	return ""
 }
 
 type Article struct {
-	Id                   string               `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
-	AuthorId             string               `protobuf:"bytes,2,opt,name=authorId" json:"authorId,omitempty"`
-	CommentsDisable      bool                 `protobuf:"varint,3,opt,name=commentsDisable" json:"commentsDisable,omitempty"`
-	Draft                bool                 `protobuf:"varint,4,opt,name=draft" json:"draft,omitempty"`
-	Promoted             bool                 `protobuf:"varint,5,opt,name=promoted" json:"promoted,omitempty"`
-	Position             int32                `protobuf:"varint,6,opt,name=position" json:"position,omitempty"`
-	VoteSum              int32                `protobuf:"varint,7,opt,name=voteSum" json:"voteSum,omitempty"`
-	VoteCount            int32                `protobuf:"varint,8,opt,name=voteCount" json:"voteCount,omitempty"`
-	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,9,opt,name=createdAt" json:"createdAt,omitempty"`
-	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,10,opt,name=updatedAt" json:"updatedAt,omitempty"`
-	SourceLocale         string               `protobuf:"bytes,11,opt,name=sourceLocale" json:"sourceLocale,omitempty"`
-	Outdated             bool                 `protobuf:"varint,12,opt,name=outdated" json:"outdated,omitempty"`
-	OutdatedLocales      []string             `protobuf:"bytes,13,rep,name=outdatedLocales" json:"outdatedLocales,omitempty"`
-	EditedAt             *timestamp.Timestamp `protobuf:"bytes,14,opt,name=editedAt" json:"editedAt,omitempty"`
-	LabelNames           []string             `protobuf:"bytes,15,rep,name=labelNames" json:"labelNames,omitempty"`
-	CountryCode          string               `protobuf:"bytes,16,opt,name=countryCode" json:"countryCode,omitempty"`
-	Url                  string               `protobuf:"bytes,17,opt,name=url" json:"url,omitempty"`
-	HtmlUrl              string               `protobuf:"bytes,18,opt,name=htmlUrl" json:"htmlUrl,omitempty"`
-	Name                 string               `protobuf:"bytes,19,opt,name=name" json:"name,omitempty"`
-	Title                string               `protobuf:"bytes,20,opt,name=title" json:"title,omitempty"`
-	Body                 string               `protobuf:"bytes,21,opt,name=body" json:"body,omitempty"`
-	Locale               string               `protobuf:"bytes,22,opt,name=locale" json:"locale,omitempty"`
-	SectionId            string               `protobuf:"bytes,23,opt,name=sectionId" json:"sectionId,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
-}
-
-func (m *Article) Reset()         { *m = Article{} }
-func (m *Article) String() string { return proto.CompactTextString(m) }
-func (*Article) ProtoMessage()    {}
-func (*Article) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{2}
-}
-func (m *Article) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Article.Unmarshal(m, b)
-}
-func (m *Article) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Article.Marshal(b, m, deterministic)
-}
-func (dst *Article) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Article.Merge(dst, src)
-}
-func (m *Article) XXX_Size() int {
-	return xxx_messageInfo_Article.Size(m)
-}
-func (m *Article) XXX_DiscardUnknown() {
-	xxx_messageInfo_Article.DiscardUnknown(m)
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,2,opt,name=authorId,proto3" json:"authorId,omitempty"`
+	CommentsDisable bool                   `protobuf:"varint,3,opt,name=commentsDisable,proto3" json:"commentsDisable,omitempty"`
+	Draft           bool                   `protobuf:"varint,4,opt,name=draft,proto3" json:"draft,omitempty"`
+	Promoted        bool                   `protobuf:"varint,5,opt,name=promoted,proto3" json:"promoted,omitempty"`
+	Position        int32                  `protobuf:"varint,6,opt,name=position,proto3" json:"position,omitempty"`
+	VoteSum         int32                  `protobuf:"varint,7,opt,name=voteSum,proto3" json:"voteSum,omitempty"`
+	VoteCount       int32                  `protobuf:"varint,8,opt,name=voteCount,proto3" json:"voteCount,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	SourceLocale    string                 `protobuf:"bytes,11,opt,name=sourceLocale,proto3" json:"sourceLocale,omitempty"`
+	Outdated        bool                   `protobuf:"varint,12,opt,name=outdated,proto3" json:"outdated,omitempty"`
+	OutdatedLocales []string               `protobuf:"bytes,13,rep,name=outdatedLocales,proto3" json:"outdatedLocales,omitempty"`
+	EditedAt        *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=editedAt,proto3" json:"editedAt,omitempty"`
+	LabelNames      []string               `protobuf:"bytes,15,rep,name=labelNames,proto3" json:"labelNames,omitempty"`
+	CountryCode     string                 `protobuf:"bytes,16,opt,name=countryCode,proto3" json:"countryCode,omitempty"`
+	Url             string                 `protobuf:"bytes,17,opt,name=url,proto3" json:"url,omitempty"`
+	HtmlUrl         string                 `protobuf:"bytes,18,opt,name=htmlUrl,proto3" json:"htmlUrl,omitempty"`
+	Name            string                 `protobuf:"bytes,19,opt,name=name,proto3" json:"name,omitempty"`
+	Title           string                 `protobuf:"bytes,20,opt,name=title,proto3" json:"title,omitempty"`
+	Body            string                 `protobuf:"bytes,21,opt,name=body,proto3" json:"body,omitempty"`
+	Locale          string                 `protobuf:"bytes,22,opt,name=locale,proto3" json:"locale,omitempty"`
+	SectionId       string                 `protobuf:"bytes,23,opt,name=sectionId,proto3" json:"sectionId,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Article) Reset() {
+	*x = Article{}
+	mi := &file_zendesk_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Article) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Article) ProtoMessage() {}
+
+func (x *Article) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_Article proto.InternalMessageInfo
+// Deprecated: Use Article.ProtoReflect.Descriptor instead.
+func (*Article) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{2}
+}
 
-func (m *Article) GetId() string {
-	if m != nil {
-		return m.Id
+func (x *Article) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (m *Article) GetAuthorId() string {
-	if m != nil {
-		return m.AuthorId
+func (x *Article) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
 	}
 	return ""
 }
 
-func (m *Article) GetCommentsDisable() bool {
-	if m != nil {
-		return m.CommentsDisable
+func (x *Article) GetCommentsDisable() bool {
+	if x != nil {
+		return x.CommentsDisable
 	}
 	return false
 }
 
-func (m *Article) GetDraft() bool {
-	if m != nil {
-		return m.Draft
+func (x *Article) GetDraft() bool {
+	if x != nil {
+		return x.Draft
 	}
 	return false
 }
 
-func (m *Article) GetPromoted() bool {
-	if m != nil {
-		return m.Promoted
+func (x *Article) GetPromoted() bool {
+	if x != nil {
+		return x.Promoted
 	}
 	return false
 }
 
-func (m *Article) GetPosition() int32 {
-	if m != nil {
-		return m.Position
+func (x *Article) GetPosition() int32 {
+	if x != nil {
+		return x.Position
 	}
 	return 0
 }
 
-func (m *Article) GetVoteSum() int32 {
-	if m != nil {
-		return m.VoteSum
+func (x *Article) GetVoteSum() int32 {
+	if x != nil {
+		return x.VoteSum
 	}
 	return 0
 }
 
-func (m *Article) GetVoteCount() int32 {
-	if m != nil {
-		return m.VoteCount
+func (x *Article) GetVoteCount() int32 {
+	if x != nil {
+		return x.VoteCount
 	}
 	return 0
 }
 
-func (m *Article) GetCreatedAt() *timestamp.Timestamp {
-	if m != nil {
-		return m.CreatedAt
+func (x *Article) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (m *Article) GetUpdatedAt() *timestamp.Timestamp {
-	if m != nil {
-		return m.UpdatedAt
+func (x *Article) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-func (m *Article) GetSourceLocale() string {
-	if m != nil {
-		return m.SourceLocale
+func (x *Article) GetSourceLocale() string {
+	if x != nil {
+		return x.SourceLocale
 	}
 	return ""
 }
 
-func (m *Article) GetOutdated() bool {
-	if m != nil {
-		return m.Outdated
+func (x *Article) GetOutdated() bool {
+	if x != nil {
+		return x.Outdated
 	}
 	return false
 }
 
-func (m *Article) GetOutdatedLocales() []string {
-	if m != nil {
-		return m.OutdatedLocales
+func (x *Article) GetOutdatedLocales() []string {
+	if x != nil {
+		return x.OutdatedLocales
 	}
 	return nil
 }
 
-func (m *Article) GetEditedAt() *timestamp.Timestamp {
-	if m != nil {
-		return m.EditedAt
+func (x *Article) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
 	}
 	return nil
 }
 
-func (m *Article) GetLabelNames() []string {
-	if m != nil {
-		return m.LabelNames
+func (x *Article) GetLabelNames() []string {
+	if x != nil {
+		return x.LabelNames
 	}
 	return nil
 }
 
-func (m *Article) GetCountryCode() string {
-	if m != nil {
-		return m.CountryCode
+func (x *Article) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
 	}
 	return ""
 }
 
-func (m *Article) GetUrl() string {
-	if m != nil {
-		return m.Url
+func (x *Article) GetUrl() string {
+	if x != nil {
+		return x.Url
 	}
 	return ""
 }
 
-func (m *Article) GetHtmlUrl() string {
-	if m != nil {
-		return m.HtmlUrl
+func (x *Article) GetHtmlUrl() string {
+	if x != nil {
+		return x.HtmlUrl
 	}
 	return ""
 }
 
-func (m *Article) GetName() string {
-	if m != nil {
-		return m.Name
+func (x *Article) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (m *Article) GetTitle() string {
-	if m != nil {
-		return m.Title
+func (x *Article) GetTitle() string {
+	if x != nil {
+		return x.Title
 	}
 	return ""
 }
 
-func (m *Article) GetBody() string {
-	if m != nil {
-		return m.Body
+func (x *Article) GetBody() string {
+	if x != nil {
+		return x.Body
 	}
 	return ""
 }
 
-func (m *Article) GetLocale() string {
-	if m != nil {
-		return m.Locale
+func (x *Article) GetLocale() string {
+	if x != nil {
+		return x.Locale
 	}
 	return ""
 }
 
-func (m *Article) GetSectionId() string {
-	if m != nil {
-		return m.SectionId
+func (x *Article) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
 	}
 	return ""
 }
 
 type TicketField struct {
-	Id                   string               `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
-	Url                  string               `protobuf:"bytes,2,opt,name=url" json:"url,omitempty"`
-	Type                 string               `protobuf:"bytes,3,opt,name=type" json:"type,omitempty"`
-	Title                string               `protobuf:"bytes,4,opt,name=title" json:"title,omitempty"`
-	RawTitle             string               `protobuf:"bytes,5,opt,name=rawTitle" json:"rawTitle,omitempty"`
-	Description          string               `protobuf:"bytes,6,opt,name=description" json:"description,omitempty"`
-	RawDescription       string               `protobuf:"bytes,7,opt,name=rawDescription" json:"rawDescription,omitempty"`
-	Position             int32                `protobuf:"varint,8,opt,name=position" json:"position,omitempty"`
-	Active               bool                 `protobuf:"varint,9,opt,name=active" json:"active,omitempty"`
-	Required             bool                 `protobuf:"varint,10,opt,name=required" json:"required,omitempty"`
-	CollapsedForAgents   bool                 `protobuf:"varint,11,opt,name=collapsedForAgents" json:"collapsedForAgents,omitempty"`
-	RegexpForValidation  string               `protobuf:"bytes,12,opt,name=regexpForValidation" json:"regexpForValidation,omitempty"`
-	TitleInPortal        string               `protobuf:"bytes,13,opt,name=titleInPortal" json:"titleInPortal,omitempty"`
-	RawTitleInPortal     string               `protobuf:"bytes,14,opt,name=rawTitleInPortal" json:"rawTitleInPortal,omitempty"`
-	VisibleInPortal      bool                 `protobuf:"varint,15,opt,name=visibleInPortal" json:"visibleInPortal,omitempty"`
-	EditableInPortal     bool                 `protobuf:"varint,16,opt,name=editableInPortal" json:"editableInPortal,omitempty"`
-	RequiredInPortal     bool                 `protobuf:"varint,17,opt,name=requiredInPortal" json:"requiredInPortal,omitempty"`
-	Tag                  string               `protobuf:"bytes,18,opt,name=tag" json:"tag,omitempty"`
-	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,19,opt,name=createdAt" json:"createdAt,omitempty"`
-	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,20,opt,name=updatedAt" json:"updatedAt,omitempty"`
-	Removable            bool                 `protobuf:"varint,21,opt,name=removable" json:"removable,omitempty"`
-	CustomFieldOptions   []*CustomFieldOption `protobuf:"bytes,22,rep,name=customFieldOptions" json:"customFieldOptions,omitempty"`
-	SystemFieldOptions   []*SystemFieldOption `protobuf:"bytes,23,rep,name=systemFieldOptions" json:"systemFieldOptions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
-}
-
-func (m *TicketField) Reset()         { *m = TicketField{} }
-func (m *TicketField) String() string { return proto.CompactTextString(m) }
-func (*TicketField) ProtoMessage()    {}
-func (*TicketField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{3}
-}
-func (m *TicketField) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TicketField.Unmarshal(m, b)
-}
-func (m *TicketField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TicketField.Marshal(b, m, deterministic)
-}
-func (dst *TicketField) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TicketField.Merge(dst, src)
-}
-func (m *TicketField) XXX_Size() int {
-	return xxx_messageInfo_TicketField.Size(m)
-}
-func (m *TicketField) XXX_DiscardUnknown() {
-	xxx_messageInfo_TicketField.DiscardUnknown(m)
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url                 string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Type                string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Title               string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	RawTitle            string                 `protobuf:"bytes,5,opt,name=rawTitle,proto3" json:"rawTitle,omitempty"`
+	Description         string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	RawDescription      string                 `protobuf:"bytes,7,opt,name=rawDescription,proto3" json:"rawDescription,omitempty"`
+	Position            int32                  `protobuf:"varint,8,opt,name=position,proto3" json:"position,omitempty"`
+	Active              bool                   `protobuf:"varint,9,opt,name=active,proto3" json:"active,omitempty"`
+	Required            bool                   `protobuf:"varint,10,opt,name=required,proto3" json:"required,omitempty"`
+	CollapsedForAgents  bool                   `protobuf:"varint,11,opt,name=collapsedForAgents,proto3" json:"collapsedForAgents,omitempty"`
+	RegexpForValidation string                 `protobuf:"bytes,12,opt,name=regexpForValidation,proto3" json:"regexpForValidation,omitempty"`
+	TitleInPortal       string                 `protobuf:"bytes,13,opt,name=titleInPortal,proto3" json:"titleInPortal,omitempty"`
+	RawTitleInPortal    string                 `protobuf:"bytes,14,opt,name=rawTitleInPortal,proto3" json:"rawTitleInPortal,omitempty"`
+	VisibleInPortal     bool                   `protobuf:"varint,15,opt,name=visibleInPortal,proto3" json:"visibleInPortal,omitempty"`
+	EditableInPortal    bool                   `protobuf:"varint,16,opt,name=editableInPortal,proto3" json:"editableInPortal,omitempty"`
+	RequiredInPortal    bool                   `protobuf:"varint,17,opt,name=requiredInPortal,proto3" json:"requiredInPortal,omitempty"`
+	Tag                 string                 `protobuf:"bytes,18,opt,name=tag,proto3" json:"tag,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Removable           bool                   `protobuf:"varint,21,opt,name=removable,proto3" json:"removable,omitempty"`
+	CustomFieldOptions  []*CustomFieldOption   `protobuf:"bytes,22,rep,name=customFieldOptions,proto3" json:"customFieldOptions,omitempty"`
+	SystemFieldOptions  []*SystemFieldOption   `protobuf:"bytes,23,rep,name=systemFieldOptions,proto3" json:"systemFieldOptions,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *TicketField) Reset() {
+	*x = TicketField{}
+	mi := &file_zendesk_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketField) ProtoMessage() {}
+
+func (x *TicketField) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_TicketField proto.InternalMessageInfo
+// Deprecated: Use TicketField.ProtoReflect.Descriptor instead.
+func (*TicketField) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{3}
+}
 
-func (m *TicketField) GetId() string {
-	if m != nil {
-		return m.Id
+func (x *TicketField) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (m *TicketField) GetUrl() string {
-	if m != nil {
-		return m.Url
+func (x *TicketField) GetUrl() string {
+	if x != nil {
+		return x.Url
 	}
 	return ""
 }
 
-func (m *TicketField) GetType() string {
-	if m != nil {
-		return m.Type
+func (x *TicketField) GetType() string {
+	if x != nil {
+		return x.Type
 	}
 	return ""
 }
 
-func (m *TicketField) GetTitle() string {
-	if m != nil {
-		return m.Title
+func (x *TicketField) GetTitle() string {
+	if x != nil {
+		return x.Title
 	}
 	return ""
 }
 
-func (m *TicketField) GetRawTitle() string {
-	if m != nil {
-		return m.RawTitle
+func (x *TicketField) GetRawTitle() string {
+	if x != nil {
+		return x.RawTitle
 	}
 	return ""
 }
 
-func (m *TicketField) GetDescription() string {
-	if m != nil {
-		return m.Description
+func (x *TicketField) GetDescription() string {
+	if x != nil {
+		return x.Description
 	}
 	return ""
 }
 
-func (m *TicketField) GetRawDescription() string {
-	if m != nil {
-		return m.RawDescription
+func (x *TicketField) GetRawDescription() string {
+	if x != nil {
+		return x.RawDescription
 	}
 	return ""
 }
 
-func (m *TicketField) GetPosition() int32 {
-	if m != nil {
-		return m.Position
+func (x *TicketField) GetPosition() int32 {
+	if x != nil {
+		return x.Position
 	}
 	return 0
 }
 
-func (m *TicketField) GetActive() bool {
-	if m != nil {
-		return m.Active
+func (x *TicketField) GetActive() bool {
+	if x != nil {
+		return x.Active
 	}
 	return false
 }
 
-func (m *TicketField) GetRequired() bool {
-	if m != nil {
-		return m.Required
+func (x *TicketField) GetRequired() bool {
+	if x != nil {
+		return x.Required
 	}
 	return false
 }
 
-func (m *TicketField) GetCollapsedForAgents() bool {
-	if m != nil {
-		return m.CollapsedForAgents
+func (x *TicketField) GetCollapsedForAgents() bool {
+	if x != nil {
+		return x.CollapsedForAgents
 	}
 	return false
 }
 
-func (m *TicketField) GetRegexpForValidation() string {
-	if m != nil {
-		return m.RegexpForValidation
+func (x *TicketField) GetRegexpForValidation() string {
+	if x != nil {
+		return x.RegexpForValidation
 	}
 	return ""
 }
 
-func (m *TicketField) GetTitleInPortal() string {
-	if m != nil {
-		return m.TitleInPortal
+func (x *TicketField) GetTitleInPortal() string {
+	if x != nil {
+		return x.TitleInPortal
 	}
 	return ""
 }
 
-func (m *TicketField) GetRawTitleInPortal() string {
-	if m != nil {
-		return m.RawTitleInPortal
+func (x *TicketField) GetRawTitleInPortal() string {
+	if x != nil {
+		return x.RawTitleInPortal
 	}
 	return ""
 }
 
-func (m *TicketField) GetVisibleInPortal() bool {
-	if m != nil {
-		return m.VisibleInPortal
+func (x *TicketField) GetVisibleInPortal() bool {
+	if x != nil {
+		return x.VisibleInPortal
 	}
 	return false
 }
 
-func (m *TicketField) GetEditableInPortal() bool {
-	if m != nil {
-		return m.EditableInPortal
+func (x *TicketField) GetEditableInPortal() bool {
+	if x != nil {
+		return x.EditableInPortal
 	}
 	return false
 }
 
-func (m *TicketField) GetRequiredInPortal() bool {
-	if m != nil {
-		return m.RequiredInPortal
+func (x *TicketField) GetRequiredInPortal() bool {
+	if x != nil {
+		return x.RequiredInPortal
 	}
 	return false
 }
 
-func (m *TicketField) GetTag() string {
-	if m != nil {
-		return m.Tag
+func (x *TicketField) GetTag() string {
+	if x != nil {
+		return x.Tag
 	}
 	return ""
 }
 
-func (m *TicketField) GetCreatedAt() *timestamp.Timestamp {
-	if m != nil {
-		return m.CreatedAt
+func (x *TicketField) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (m *TicketField) GetUpdatedAt() *timestamp.Timestamp {
-	if m != nil {
-		return m.UpdatedAt
+func (x *TicketField) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-func (m *TicketField) GetRemovable() bool {
-	if m != nil {
-		return m.Removable
+func (x *TicketField) GetRemovable() bool {
+	if x != nil {
+		return x.Removable
 	}
 	return false
 }
 
-func (m *TicketField) GetCustomFieldOptions() []*CustomFieldOption {
-	if m != nil {
-		return m.CustomFieldOptions
+func (x *TicketField) GetCustomFieldOptions() []*CustomFieldOption {
+	if x != nil {
+		return x.CustomFieldOptions
 	}
 	return nil
 }
 
-func (m *TicketField) GetSystemFieldOptions() []*SystemFieldOption {
-	if m != nil {
-		return m.SystemFieldOptions
+func (x *TicketField) GetSystemFieldOptions() []*SystemFieldOption {
+	if x != nil {
+		return x.SystemFieldOptions
 	}
 	return nil
 }
 
 type CustomFieldOption struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
-	RawName              string   `protobuf:"bytes,3,opt,name=rawName" json:"rawName,omitempty"`
-	Value                string   `protobuf:"bytes,4,opt,name=value" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *CustomFieldOption) Reset()         { *m = CustomFieldOption{} }
-func (m *CustomFieldOption) String() string { return proto.CompactTextString(m) }
-func (*CustomFieldOption) ProtoMessage()    {}
-func (*CustomFieldOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{4}
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	RawName       string                 `protobuf:"bytes,3,opt,name=rawName,proto3" json:"rawName,omitempty"`
+	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
-func (m *CustomFieldOption) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CustomFieldOption.Unmarshal(m, b)
-}
-func (m *CustomFieldOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CustomFieldOption.Marshal(b, m, deterministic)
-}
-func (dst *CustomFieldOption) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CustomFieldOption.Merge(dst, src)
+
+func (x *CustomFieldOption) Reset() {
+	*x = CustomFieldOption{}
+	mi := &file_zendesk_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (m *CustomFieldOption) XXX_Size() int {
-	return xxx_messageInfo_CustomFieldOption.Size(m)
+
+func (x *CustomFieldOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *CustomFieldOption) XXX_DiscardUnknown() {
-	xxx_messageInfo_CustomFieldOption.DiscardUnknown(m)
+
+func (*CustomFieldOption) ProtoMessage() {}
+
+func (x *CustomFieldOption) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_CustomFieldOption proto.InternalMessageInfo
+// Deprecated: Use CustomFieldOption.ProtoReflect.Descriptor instead.
+func (*CustomFieldOption) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{4}
+}
 
-func (m *CustomFieldOption) GetId() string {
-	if m != nil {
-		return m.Id
+func (x *CustomFieldOption) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (m *CustomFieldOption) GetName() string {
-	if m != nil {
-		return m.Name
+func (x *CustomFieldOption) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (m *CustomFieldOption) GetRawName() string {
-	if m != nil {
-		return m.RawName
+func (x *CustomFieldOption) GetRawName() string {
+	if x != nil {
+		return x.RawName
 	}
 	return ""
 }
 
-func (m *CustomFieldOption) GetValue() string {
-	if m != nil {
-		return m.Value
+func (x *CustomFieldOption) GetValue() string {
+	if x != nil {
+		return x.Value
 	}
 	return ""
 }
 
 type SystemFieldOption struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	Value                string   `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (m *SystemFieldOption) Reset()         { *m = SystemFieldOption{} }
-func (m *SystemFieldOption) String() string { return proto.CompactTextString(m) }
-func (*SystemFieldOption) ProtoMessage()    {}
-func (*SystemFieldOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{5}
-}
-func (m *SystemFieldOption) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SystemFieldOption.Unmarshal(m, b)
+func (x *SystemFieldOption) Reset() {
+	*x = SystemFieldOption{}
+	mi := &file_zendesk_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (m *SystemFieldOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SystemFieldOption.Marshal(b, m, deterministic)
-}
-func (dst *SystemFieldOption) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SystemFieldOption.Merge(dst, src)
-}
-func (m *SystemFieldOption) XXX_Size() int {
-	return xxx_messageInfo_SystemFieldOption.Size(m)
+
+func (x *SystemFieldOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *SystemFieldOption) XXX_DiscardUnknown() {
-	xxx_messageInfo_SystemFieldOption.DiscardUnknown(m)
+
+func (*SystemFieldOption) ProtoMessage() {}
+
+func (x *SystemFieldOption) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_SystemFieldOption proto.InternalMessageInfo
+// Deprecated: Use SystemFieldOption.ProtoReflect.Descriptor instead.
+func (*SystemFieldOption) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{5}
+}
 
-func (m *SystemFieldOption) GetName() string {
-	if m != nil {
-		return m.Name
+func (x *SystemFieldOption) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (m *SystemFieldOption) GetValue() string {
-	if m != nil {
-		return m.Value
+func (x *SystemFieldOption) GetValue() string {
+	if x != nil {
+		return x.Value
 	}
 	return ""
 }
 
 type SearchTitleArticle struct {
-	Title                string   `protobuf:"bytes,1,opt,name=title" json:"title,omitempty"`
-	CategoryTitle        string   `protobuf:"bytes,2,opt,name=categoryTitle" json:"categoryTitle,omitempty"`
-	Url                  string   `protobuf:"bytes,3,opt,name=url" json:"url,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	CategoryTitle string                 `protobuf:"bytes,2,opt,name=categoryTitle,proto3" json:"categoryTitle,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (m *SearchTitleArticle) Reset()         { *m = SearchTitleArticle{} }
-func (m *SearchTitleArticle) String() string { return proto.CompactTextString(m) }
-func (*SearchTitleArticle) ProtoMessage()    {}
-func (*SearchTitleArticle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{6}
-}
-func (m *SearchTitleArticle) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SearchTitleArticle.Unmarshal(m, b)
-}
-func (m *SearchTitleArticle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SearchTitleArticle.Marshal(b, m, deterministic)
+func (x *SearchTitleArticle) Reset() {
+	*x = SearchTitleArticle{}
+	mi := &file_zendesk_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (dst *SearchTitleArticle) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SearchTitleArticle.Merge(dst, src)
-}
-func (m *SearchTitleArticle) XXX_Size() int {
-	return xxx_messageInfo_SearchTitleArticle.Size(m)
+
+func (x *SearchTitleArticle) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *SearchTitleArticle) XXX_DiscardUnknown() {
-	xxx_messageInfo_SearchTitleArticle.DiscardUnknown(m)
+
+func (*SearchTitleArticle) ProtoMessage() {}
+
+func (x *SearchTitleArticle) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_SearchTitleArticle proto.InternalMessageInfo
+// Deprecated: Use SearchTitleArticle.ProtoReflect.Descriptor instead.
+func (*SearchTitleArticle) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{6}
+}
 
-func (m *SearchTitleArticle) GetTitle() string {
-	if m != nil {
-		return m.Title
+func (x *SearchTitleArticle) GetTitle() string {
+	if x != nil {
+		return x.Title
 	}
 	return ""
 }
 
-func (m *SearchTitleArticle) GetCategoryTitle() string {
-	if m != nil {
-		return m.CategoryTitle
+func (x *SearchTitleArticle) GetCategoryTitle() string {
+	if x != nil {
+		return x.CategoryTitle
 	}
 	return ""
 }
 
-func (m *SearchTitleArticle) GetUrl() string {
-	if m != nil {
-		return m.Url
+func (x *SearchTitleArticle) GetUrl() string {
+	if x != nil {
+		return x.Url
 	}
 	return ""
 }
 
 type SearchBodyArticle struct {
-	Id                   string               `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
-	AuthorId             string               `protobuf:"bytes,2,opt,name=authorId" json:"authorId,omitempty"`
-	CommentsDisable      bool                 `protobuf:"varint,3,opt,name=commentsDisable" json:"commentsDisable,omitempty"`
-	Draft                bool                 `protobuf:"varint,4,opt,name=draft" json:"draft,omitempty"`
-	Promoted             bool                 `protobuf:"varint,5,opt,name=promoted" json:"promoted,omitempty"`
-	Position             int32                `protobuf:"varint,6,opt,name=position" json:"position,omitempty"`
-	VoteSum              int32                `protobuf:"varint,7,opt,name=voteSum" json:"voteSum,omitempty"`
-	VoteCount            int32                `protobuf:"varint,8,opt,name=voteCount" json:"voteCount,omitempty"`
-	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,9,opt,name=createdAt" json:"createdAt,omitempty"`
-	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,10,opt,name=updatedAt" json:"updatedAt,omitempty"`
-	SourceLocale         string               `protobuf:"bytes,11,opt,name=sourceLocale" json:"sourceLocale,omitempty"`
-	Outdated             bool                 `protobuf:"varint,12,opt,name=outdated" json:"outdated,omitempty"`
-	OutdatedLocales      []string             `protobuf:"bytes,13,rep,name=outdatedLocales" json:"outdatedLocales,omitempty"`
-	EditedAt             *timestamp.Timestamp `protobuf:"bytes,14,opt,name=editedAt" json:"editedAt,omitempty"`
-	LabelNames           []string             `protobuf:"bytes,15,rep,name=labelNames" json:"labelNames,omitempty"`
-	CountryCode          string               `protobuf:"bytes,16,opt,name=countryCode" json:"countryCode,omitempty"`
-	Url                  string               `protobuf:"bytes,17,opt,name=url" json:"url,omitempty"`
-	HtmlUrl              string               `protobuf:"bytes,18,opt,name=htmlUrl" json:"htmlUrl,omitempty"`
-	Name                 string               `protobuf:"bytes,19,opt,name=name" json:"name,omitempty"`
-	Title                string               `protobuf:"bytes,20,opt,name=title" json:"title,omitempty"`
-	Body                 string               `protobuf:"bytes,21,opt,name=body" json:"body,omitempty"`
-	Locale               string               `protobuf:"bytes,22,opt,name=locale" json:"locale,omitempty"`
-	Snippet              string               `protobuf:"bytes,23,opt,name=snippet" json:"snippet,omitempty"`
-	SectionId            string               `protobuf:"bytes,24,opt,name=sectionId" json:"sectionId,omitempty"`
-	CategoryId           string               `protobuf:"bytes,25,opt,name=categoryId" json:"categoryId,omitempty"`
-	CategoryName         string               `protobuf:"bytes,26,opt,name=categoryName" json:"categoryName,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
-}
-
-func (m *SearchBodyArticle) Reset()         { *m = SearchBodyArticle{} }
-func (m *SearchBodyArticle) String() string { return proto.CompactTextString(m) }
-func (*SearchBodyArticle) ProtoMessage()    {}
-func (*SearchBodyArticle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{7}
-}
-func (m *SearchBodyArticle) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SearchBodyArticle.Unmarshal(m, b)
-}
-func (m *SearchBodyArticle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SearchBodyArticle.Marshal(b, m, deterministic)
-}
-func (dst *SearchBodyArticle) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SearchBodyArticle.Merge(dst, src)
-}
-func (m *SearchBodyArticle) XXX_Size() int {
-	return xxx_messageInfo_SearchBodyArticle.Size(m)
-}
-func (m *SearchBodyArticle) XXX_DiscardUnknown() {
-	xxx_messageInfo_SearchBodyArticle.DiscardUnknown(m)
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,2,opt,name=authorId,proto3" json:"authorId,omitempty"`
+	CommentsDisable bool                   `protobuf:"varint,3,opt,name=commentsDisable,proto3" json:"commentsDisable,omitempty"`
+	Draft           bool                   `protobuf:"varint,4,opt,name=draft,proto3" json:"draft,omitempty"`
+	Promoted        bool                   `protobuf:"varint,5,opt,name=promoted,proto3" json:"promoted,omitempty"`
+	Position        int32                  `protobuf:"varint,6,opt,name=position,proto3" json:"position,omitempty"`
+	VoteSum         int32                  `protobuf:"varint,7,opt,name=voteSum,proto3" json:"voteSum,omitempty"`
+	VoteCount       int32                  `protobuf:"varint,8,opt,name=voteCount,proto3" json:"voteCount,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	SourceLocale    string                 `protobuf:"bytes,11,opt,name=sourceLocale,proto3" json:"sourceLocale,omitempty"`
+	Outdated        bool                   `protobuf:"varint,12,opt,name=outdated,proto3" json:"outdated,omitempty"`
+	OutdatedLocales []string               `protobuf:"bytes,13,rep,name=outdatedLocales,proto3" json:"outdatedLocales,omitempty"`
+	EditedAt        *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=editedAt,proto3" json:"editedAt,omitempty"`
+	LabelNames      []string               `protobuf:"bytes,15,rep,name=labelNames,proto3" json:"labelNames,omitempty"`
+	CountryCode     string                 `protobuf:"bytes,16,opt,name=countryCode,proto3" json:"countryCode,omitempty"`
+	Url             string                 `protobuf:"bytes,17,opt,name=url,proto3" json:"url,omitempty"`
+	HtmlUrl         string                 `protobuf:"bytes,18,opt,name=htmlUrl,proto3" json:"htmlUrl,omitempty"`
+	Name            string                 `protobuf:"bytes,19,opt,name=name,proto3" json:"name,omitempty"`
+	Title           string                 `protobuf:"bytes,20,opt,name=title,proto3" json:"title,omitempty"`
+	Body            string                 `protobuf:"bytes,21,opt,name=body,proto3" json:"body,omitempty"`
+	Locale          string                 `protobuf:"bytes,22,opt,name=locale,proto3" json:"locale,omitempty"`
+	Snippet         string                 `protobuf:"bytes,23,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	SectionId       string                 `protobuf:"bytes,24,opt,name=sectionId,proto3" json:"sectionId,omitempty"`
+	CategoryId      string                 `protobuf:"bytes,25,opt,name=categoryId,proto3" json:"categoryId,omitempty"`
+	CategoryName    string                 `protobuf:"bytes,26,opt,name=categoryName,proto3" json:"categoryName,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SearchBodyArticle) Reset() {
+	*x = SearchBodyArticle{}
+	mi := &file_zendesk_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchBodyArticle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchBodyArticle) ProtoMessage() {}
+
+func (x *SearchBodyArticle) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_SearchBodyArticle proto.InternalMessageInfo
+// Deprecated: Use SearchBodyArticle.ProtoReflect.Descriptor instead.
+func (*SearchBodyArticle) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{7}
+}
 
-func (m *SearchBodyArticle) GetId() string {
-	if m != nil {
-		return m.Id
+func (x *SearchBodyArticle) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (m *SearchBodyArticle) GetAuthorId() string {
-	if m != nil {
-		return m.AuthorId
+func (x *SearchBodyArticle) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
 	}
 	return ""
 }
 
-func (m *SearchBodyArticle) GetCommentsDisable() bool {
-	if m != nil {
-		return m.CommentsDisable
+func (x *SearchBodyArticle) GetCommentsDisable() bool {
+	if x != nil {
+		return x.CommentsDisable
 	}
 	return false
 }
 
-func (m *SearchBodyArticle) GetDraft() bool {
-	if m != nil {
-		return m.Draft
+func (x *SearchBodyArticle) GetDraft() bool {
+	if x != nil {
+		return x.Draft
 	}
 	return false
 }
 
-func (m *SearchBodyArticle) GetPromoted() bool {
-	if m != nil {
-		return m.Promoted
+func (x *SearchBodyArticle) GetPromoted() bool {
+	if x != nil {
+		return x.Promoted
 	}
 	return false
 }
 
-func (m *SearchBodyArticle) GetPosition() int32 {
-	if m != nil {
-		return m.Position
+func (x *SearchBodyArticle) GetPosition() int32 {
+	if x != nil {
+		return x.Position
 	}
 	return 0
 }
 
-func (m *SearchBodyArticle) GetVoteSum() int32 {
-	if m != nil {
-		return m.VoteSum
+func (x *SearchBodyArticle) GetVoteSum() int32 {
+	if x != nil {
+		return x.VoteSum
 	}
 	return 0
 }
 
-func (m *SearchBodyArticle) GetVoteCount() int32 {
-	if m != nil {
-		return m.VoteCount
+func (x *SearchBodyArticle) GetVoteCount() int32 {
+	if x != nil {
+		return x.VoteCount
 	}
 	return 0
 }
 
-func (m *SearchBodyArticle) GetCreatedAt() *timestamp.Timestamp {
-	if m != nil {
-		return m.CreatedAt
+func (x *SearchBodyArticle) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (m *SearchBodyArticle) GetUpdatedAt() *timestamp.Timestamp {
-	if m != nil {
-		return m.UpdatedAt
+func (x *SearchBodyArticle) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-func (m *SearchBodyArticle) GetSourceLocale() string {
-	if m != nil {
-		return m.SourceLocale
+func (x *SearchBodyArticle) GetSourceLocale() string {
+	if x != nil {
+		return x.SourceLocale
 	}
 	return ""
 }
 
-func (m *SearchBodyArticle) GetOutdated() bool {
-	if m != nil {
-		return m.Outdated
+func (x *SearchBodyArticle) GetOutdated() bool {
+	if x != nil {
+		return x.Outdated
 	}
 	return false
 }
 
-func (m *SearchBodyArticle) GetOutdatedLocales() []string {
-	if m != nil {
-		return m.OutdatedLocales
+func (x *SearchBodyArticle) GetOutdatedLocales() []string {
+	if x != nil {
+		return x.OutdatedLocales
 	}
 	return nil
 }
 
-func (m *SearchBodyArticle) GetEditedAt() *timestamp.Timestamp {
-	if m != nil {
-		return m.EditedAt
+func (x *SearchBodyArticle) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
 	}
 	return nil
 }
 
-func (m *SearchBodyArticle) GetLabelNames() []string {
-	if m != nil {
-		return m.LabelNames
+func (x *SearchBodyArticle) GetLabelNames() []string {
+	if x != nil {
+		return x.LabelNames
 	}
 	return nil
 }
 
-func (m *SearchBodyArticle) GetCountryCode() string {
-	if m != nil {
-		return m.CountryCode
+func (x *SearchBodyArticle) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
 	}
 	return ""
 }
 
-func (m *SearchBodyArticle) GetUrl() string {
-	if m != nil {
-		return m.Url
+func (x *SearchBodyArticle) GetUrl() string {
+	if x != nil {
+		return x.Url
 	}
 	return ""
 }
 
-func (m *SearchBodyArticle) GetHtmlUrl() string {
-	if m != nil {
-		return m.HtmlUrl
+func (x *SearchBodyArticle) GetHtmlUrl() string {
+	if x != nil {
+		return x.HtmlUrl
 	}
 	return ""
 }
 
-func (m *SearchBodyArticle) GetName() string {
-	if m != nil {
-		return m.Name
+func (x *SearchBodyArticle) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (m *SearchBodyArticle) GetTitle() string {
-	if m != nil {
-		return m.Title
+func (x *SearchBodyArticle) GetTitle() string {
+	if x != nil {
+		return x.Title
 	}
 	return ""
 }
 
-func (m *SearchBodyArticle) GetBody() string {
-	if m != nil {
-		return m.Body
+func (x *SearchBodyArticle) GetBody() string {
+	if x != nil {
+		return x.Body
 	}
 	return ""
 }
 
-func (m *SearchBodyArticle) GetLocale() string {
-	if m != nil {
-		return m.Locale
+func (x *SearchBodyArticle) GetLocale() string {
+	if x != nil {
+		return x.Locale
 	}
 	return ""
 }
 
-func (m *SearchBodyArticle) GetSnippet() string {
-	if m != nil {
-		return m.Snippet
+func (x *SearchBodyArticle) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
 	}
 	return ""
 }
 
-func (m *SearchBodyArticle) GetSectionId() string {
-	if m != nil {
-		return m.SectionId
+func (x *SearchBodyArticle) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
 	}
 	return ""
 }
 
-func (m *SearchBodyArticle) GetCategoryId() string {
-	if m != nil {
-		return m.CategoryId
+func (x *SearchBodyArticle) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
 	}
 	return ""
 }
 
-func (m *SearchBodyArticle) GetCategoryName() string {
-	if m != nil {
-		return m.CategoryName
+func (x *SearchBodyArticle) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
 	}
 	return ""
 }
 
 type PageInfo struct {
-	PerPage              int32    `protobuf:"varint,1,opt,name=perPage" json:"perPage,omitempty"`
-	Page                 int32    `protobuf:"varint,2,opt,name=page" json:"page,omitempty"`
-	PageCount            int32    `protobuf:"varint,3,opt,name=pageCount" json:"pageCount,omitempty"`
-	Count                int32    `protobuf:"varint,4,opt,name=count" json:"count,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *PageInfo) Reset()         { *m = PageInfo{} }
-func (m *PageInfo) String() string { return proto.CompactTextString(m) }
-func (*PageInfo) ProtoMessage()    {}
-func (*PageInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{8}
-}
-func (m *PageInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PageInfo.Unmarshal(m, b)
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PerPage       int32                  `protobuf:"varint,1,opt,name=perPage,proto3" json:"perPage,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageCount     int32                  `protobuf:"varint,3,opt,name=pageCount,proto3" json:"pageCount,omitempty"`
+	Count         int32                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
-func (m *PageInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PageInfo.Marshal(b, m, deterministic)
-}
-func (dst *PageInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PageInfo.Merge(dst, src)
+
+func (x *PageInfo) Reset() {
+	*x = PageInfo{}
+	mi := &file_zendesk_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (m *PageInfo) XXX_Size() int {
-	return xxx_messageInfo_PageInfo.Size(m)
+
+func (x *PageInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *PageInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_PageInfo.DiscardUnknown(m)
+
+func (*PageInfo) ProtoMessage() {}
+
+func (x *PageInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_PageInfo proto.InternalMessageInfo
+// Deprecated: Use PageInfo.ProtoReflect.Descriptor instead.
+func (*PageInfo) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{8}
+}
 
-func (m *PageInfo) GetPerPage() int32 {
-	if m != nil {
-		return m.PerPage
+func (x *PageInfo) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
 	}
 	return 0
 }
 
-func (m *PageInfo) GetPage() int32 {
-	if m != nil {
-		return m.Page
+func (x *PageInfo) GetPage() int32 {
+	if x != nil {
+		return x.Page
 	}
 	return 0
 }
 
-func (m *PageInfo) GetPageCount() int32 {
-	if m != nil {
-		return m.PageCount
+func (x *PageInfo) GetPageCount() int32 {
+	if x != nil {
+		return x.PageCount
 	}
 	return 0
 }
 
-func (m *PageInfo) GetCount() int32 {
-	if m != nil {
-		return m.Count
+func (x *PageInfo) GetCount() int32 {
+	if x != nil {
+		return x.Count
 	}
 	return 0
 }
 
 type GetCategoriesRequest struct {
-	CountryCode          CountryCode `protobuf:"varint,1,opt,name=countryCode,enum=protobuf.CountryCode" json:"countryCode,omitempty"`
-	Locale               Locale      `protobuf:"varint,2,opt,name=locale,enum=protobuf.Locale" json:"locale,omitempty"`
-	SortBy               SortBy      `protobuf:"varint,3,opt,name=sortBy,enum=protobuf.SortBy" json:"sortBy,omitempty"`
-	SortOrder            SortOrder   `protobuf:"varint,4,opt,name=sortOrder,enum=protobuf.SortOrder" json:"sortOrder,omitempty"`
-	PerPage              int32       `protobuf:"varint,5,opt,name=perPage" json:"perPage,omitempty"`
-	Page                 int32       `protobuf:"varint,6,opt,name=page" json:"page,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
-}
-
-func (m *GetCategoriesRequest) Reset()         { *m = GetCategoriesRequest{} }
-func (m *GetCategoriesRequest) String() string { return proto.CompactTextString(m) }
-func (*GetCategoriesRequest) ProtoMessage()    {}
-func (*GetCategoriesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{9}
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CountryCode   CountryCode            `protobuf:"varint,1,opt,name=countryCode,proto3,enum=protobuf.CountryCode" json:"countryCode,omitempty"`
+	Locale        Locale                 `protobuf:"varint,2,opt,name=locale,proto3,enum=protobuf.Locale" json:"locale,omitempty"`
+	SortBy        SortBy                 `protobuf:"varint,3,opt,name=sortBy,proto3,enum=protobuf.SortBy" json:"sortBy,omitempty"`
+	SortOrder     SortOrder              `protobuf:"varint,4,opt,name=sortOrder,proto3,enum=protobuf.SortOrder" json:"sortOrder,omitempty"`
+	PerPage       int32                  `protobuf:"varint,5,opt,name=perPage,proto3" json:"perPage,omitempty"`
+	Page          int32                  `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
-func (m *GetCategoriesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCategoriesRequest.Unmarshal(m, b)
-}
-func (m *GetCategoriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCategoriesRequest.Marshal(b, m, deterministic)
-}
-func (dst *GetCategoriesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCategoriesRequest.Merge(dst, src)
+
+func (x *GetCategoriesRequest) Reset() {
+	*x = GetCategoriesRequest{}
+	mi := &file_zendesk_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (m *GetCategoriesRequest) XXX_Size() int {
-	return xxx_messageInfo_GetCategoriesRequest.Size(m)
+
+func (x *GetCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *GetCategoriesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCategoriesRequest.DiscardUnknown(m)
+
+func (*GetCategoriesRequest) ProtoMessage() {}
+
+func (x *GetCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_GetCategoriesRequest proto.InternalMessageInfo
+// Deprecated: Use GetCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{9}
+}
 
-func (m *GetCategoriesRequest) GetCountryCode() CountryCode {
-	if m != nil {
-		return m.CountryCode
+func (x *GetCategoriesRequest) GetCountryCode() CountryCode {
+	if x != nil {
+		return x.CountryCode
 	}
 	return CountryCode_COUNTRY_CODE_SG
 }
 
-func (m *GetCategoriesRequest) GetLocale() Locale {
-	if m != nil {
-		return m.Locale
+func (x *GetCategoriesRequest) GetLocale() Locale {
+	if x != nil {
+		return x.Locale
 	}
 	return Locale_LOCALE_EN_US
 }
 
-func (m *GetCategoriesRequest) GetSortBy() SortBy {
-	if m != nil {
-		return m.SortBy
+func (x *GetCategoriesRequest) GetSortBy() SortBy {
+	if x != nil {
+		return x.SortBy
 	}
 	return SortBy_SORT_BY_POSITION
 }
 
-func (m *GetCategoriesRequest) GetSortOrder() SortOrder {
-	if m != nil {
-		return m.SortOrder
+func (x *GetCategoriesRequest) GetSortOrder() SortOrder {
+	if x != nil {
+		return x.SortOrder
 	}
 	return SortOrder_SORT_ORDER_ASC
 }
 
-func (m *GetCategoriesRequest) GetPerPage() int32 {
-	if m != nil {
-		return m.PerPage
+func (x *GetCategoriesRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
 	}
 	return 0
 }
 
-func (m *GetCategoriesRequest) GetPage() int32 {
-	if m != nil {
-		return m.Page
+func (x *GetCategoriesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
 	}
 	return 0
 }
 
 type GetCategoriesResponse struct {
-	PageInfo             *PageInfo   `protobuf:"bytes,1,opt,name=pageInfo" json:"pageInfo,omitempty"`
-	Categories           []*Category `protobuf:"bytes,2,rep,name=categories" json:"categories,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageInfo      *PageInfo              `protobuf:"bytes,1,opt,name=pageInfo,proto3" json:"pageInfo,omitempty"`
+	Categories    []*Category            `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (m *GetCategoriesResponse) Reset()         { *m = GetCategoriesResponse{} }
-func (m *GetCategoriesResponse) String() string { return proto.CompactTextString(m) }
-func (*GetCategoriesResponse) ProtoMessage()    {}
-func (*GetCategoriesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{10}
-}
-func (m *GetCategoriesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCategoriesResponse.Unmarshal(m, b)
+func (x *GetCategoriesResponse) Reset() {
+	*x = GetCategoriesResponse{}
+	mi := &file_zendesk_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (m *GetCategoriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCategoriesResponse.Marshal(b, m, deterministic)
-}
-func (dst *GetCategoriesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCategoriesResponse.Merge(dst, src)
-}
-func (m *GetCategoriesResponse) XXX_Size() int {
-	return xxx_messageInfo_GetCategoriesResponse.Size(m)
+
+func (x *GetCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *GetCategoriesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCategoriesResponse.DiscardUnknown(m)
+
+func (*GetCategoriesResponse) ProtoMessage() {}
+
+func (x *GetCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_GetCategoriesResponse proto.InternalMessageInfo
+// Deprecated: Use GetCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{10}
+}
 
-func (m *GetCategoriesResponse) GetPageInfo() *PageInfo {
-	if m != nil {
-		return m.PageInfo
+func (x *GetCategoriesResponse) GetPageInfo() *PageInfo {
+	if x != nil {
+		return x.PageInfo
 	}
 	return nil
 }
 
-func (m *GetCategoriesResponse) GetCategories() []*Category {
-	if m != nil {
-		return m.Categories
+func (x *GetCategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
 	}
 	return nil
 }
 
 type GetCategoryRequest struct {
-	CountryCode CountryCode `protobuf:"varint,1,opt,name=countryCode,enum=protobuf.CountryCode" json:"countryCode,omitempty"`
-	Locale      Locale      `protobuf:"varint,2,opt,name=locale,enum=protobuf.Locale" json:"locale,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CountryCode CountryCode            `protobuf:"varint,1,opt,name=countryCode,proto3,enum=protobuf.CountryCode" json:"countryCode,omitempty"`
+	Locale      Locale                 `protobuf:"varint,2,opt,name=locale,proto3,enum=protobuf.Locale" json:"locale,omitempty"`
 	// Types that are valid to be assigned to Id:
+	//
 	//	*GetCategoryRequest_CategoryIdOrKeyname
 	//	*GetCategoryRequest_SectionId
 	//	*GetCategoryRequest_ArticleId
-	Id                   isGetCategoryRequest_Id `protobuf_oneof:"Id"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
-}
-
-func (m *GetCategoryRequest) Reset()         { *m = GetCategoryRequest{} }
-func (m *GetCategoryRequest) String() string { return proto.CompactTextString(m) }
-func (*GetCategoryRequest) ProtoMessage()    {}
-func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{11}
-}
-func (m *GetCategoryRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCategoryRequest.Unmarshal(m, b)
-}
-func (m *GetCategoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCategoryRequest.Marshal(b, m, deterministic)
-}
-func (dst *GetCategoryRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCategoryRequest.Merge(dst, src)
-}
-func (m *GetCategoryRequest) XXX_Size() int {
-	return xxx_messageInfo_GetCategoryRequest.Size(m)
+	Id            isGetCategoryRequest_Id `protobuf_oneof:"Id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
-func (m *GetCategoryRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCategoryRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GetCategoryRequest proto.InternalMessageInfo
 
-type isGetCategoryRequest_Id interface {
-	isGetCategoryRequest_Id()
+func (x *GetCategoryRequest) Reset() {
+	*x = GetCategoryRequest{}
+	mi := &file_zendesk_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type GetCategoryRequest_CategoryIdOrKeyname struct {
-	CategoryIdOrKeyname string `protobuf:"bytes,3,opt,name=categoryIdOrKeyname,oneof"`
-}
-type GetCategoryRequest_SectionId struct {
-	SectionId string `protobuf:"bytes,4,opt,name=sectionId,oneof"`
-}
-type GetCategoryRequest_ArticleId struct {
-	ArticleId string `protobuf:"bytes,5,opt,name=articleId,oneof"`
+func (x *GetCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCategoryRequest_CategoryIdOrKeyname) isGetCategoryRequest_Id() {}
-func (*GetCategoryRequest_SectionId) isGetCategoryRequest_Id()           {}
-func (*GetCategoryRequest_ArticleId) isGetCategoryRequest_Id()           {}
+func (*GetCategoryRequest) ProtoMessage() {}
 
-func (m *GetCategoryRequest) GetId() isGetCategoryRequest_Id {
-	if m != nil {
-		return m.Id
+func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (m *GetCategoryRequest) GetCountryCode() CountryCode {
-	if m != nil {
-		return m.CountryCode
+// Deprecated: Use GetCategoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetCategoryRequest) GetCountryCode() CountryCode {
+	if x != nil {
+		return x.CountryCode
 	}
 	return CountryCode_COUNTRY_CODE_SG
 }
 
-func (m *GetCategoryRequest) GetLocale() Locale {
-	if m != nil {
-		return m.Locale
+func (x *GetCategoryRequest) GetLocale() Locale {
+	if x != nil {
+		return x.Locale
 	}
 	return Locale_LOCALE_EN_US
 }
 
-func (m *GetCategoryRequest) GetCategoryIdOrKeyname() string {
-	if x, ok := m.GetId().(*GetCategoryRequest_CategoryIdOrKeyname); ok {
-		return x.CategoryIdOrKeyname
+func (x *GetCategoryRequest) GetId() isGetCategoryRequest_Id {
+	if x != nil {
+		return x.Id
 	}
-	return ""
+	return nil
 }
 
-func (m *GetCategoryRequest) GetSectionId() string {
-	if x, ok := m.GetId().(*GetCategoryRequest_SectionId); ok {
-		return x.SectionId
+func (x *GetCategoryRequest) GetCategoryIdOrKeyname() string {
+	if x != nil {
+		if x, ok := x.Id.(*GetCategoryRequest_CategoryIdOrKeyname); ok {
+			return x.CategoryIdOrKeyname
+		}
 	}
 	return ""
 }
 
-func (m *GetCategoryRequest) GetArticleId() string {
-	if x, ok := m.GetId().(*GetCategoryRequest_ArticleId); ok {
-		return x.ArticleId
+func (x *GetCategoryRequest) GetSectionId() string {
+	if x != nil {
+		if x, ok := x.Id.(*GetCategoryRequest_SectionId); ok {
+			return x.SectionId
+		}
 	}
 	return ""
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*GetCategoryRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _GetCategoryRequest_OneofMarshaler, _GetCategoryRequest_OneofUnmarshaler, _GetCategoryRequest_OneofSizer, []interface{}{
-		(*GetCategoryRequest_CategoryIdOrKeyname)(nil),
-		(*GetCategoryRequest_SectionId)(nil),
-		(*GetCategoryRequest_ArticleId)(nil),
+func (x *GetCategoryRequest) GetArticleId() string {
+	if x != nil {
+		if x, ok := x.Id.(*GetCategoryRequest_ArticleId); ok {
+			return x.ArticleId
+		}
 	}
+	return ""
 }
 
-func _GetCategoryRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*GetCategoryRequest)
-	// Id
-	switch x := m.Id.(type) {
-	case *GetCategoryRequest_CategoryIdOrKeyname:
-		b.EncodeVarint(3<<3 | proto.WireBytes)
-		b.EncodeStringBytes(x.CategoryIdOrKeyname)
-	case *GetCategoryRequest_SectionId:
-		b.EncodeVarint(4<<3 | proto.WireBytes)
-		b.EncodeStringBytes(x.SectionId)
-	case *GetCategoryRequest_ArticleId:
-		b.EncodeVarint(5<<3 | proto.WireBytes)
-		b.EncodeStringBytes(x.ArticleId)
-	case nil:
-	default:
-		return fmt.Errorf("GetCategoryRequest.Id has unexpected type %T", x)
-	}
-	return nil
+type isGetCategoryRequest_Id interface {
+	isGetCategoryRequest_Id()
 }
 
-func _GetCategoryRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*GetCategoryRequest)
-	switch tag {
-	case 3: // Id.categoryIdOrKeyname
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		x, err := b.DecodeStringBytes()
-		m.Id = &GetCategoryRequest_CategoryIdOrKeyname{x}
-		return true, err
-	case 4: // Id.sectionId
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		x, err := b.DecodeStringBytes()
-		m.Id = &GetCategoryRequest_SectionId{x}
-		return true, err
-	case 5: // Id.articleId
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		x, err := b.DecodeStringBytes()
-		m.Id = &GetCategoryRequest_ArticleId{x}
-		return true, err
-	default:
-		return false, nil
-	}
-}
-
-func _GetCategoryRequest_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*GetCategoryRequest)
-	// Id
-	switch x := m.Id.(type) {
-	case *GetCategoryRequest_CategoryIdOrKeyname:
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(len(x.CategoryIdOrKeyname)))
-		n += len(x.CategoryIdOrKeyname)
-	case *GetCategoryRequest_SectionId:
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(len(x.SectionId)))
-		n += len(x.SectionId)
-	case *GetCategoryRequest_ArticleId:
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(len(x.ArticleId)))
-		n += len(x.ArticleId)
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
-	}
-	return n
+type GetCategoryRequest_CategoryIdOrKeyname struct {
+	CategoryIdOrKeyname string `protobuf:"bytes,3,opt,name=categoryIdOrKeyname,proto3,oneof"`
 }
 
-type GetCategoryResponse struct {
-	Category             *Category `protobuf:"bytes,1,opt,name=category" json:"category,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+type GetCategoryRequest_SectionId struct {
+	SectionId string `protobuf:"bytes,4,opt,name=sectionId,proto3,oneof"`
 }
 
-func (m *GetCategoryResponse) Reset()         { *m = GetCategoryResponse{} }
-func (m *GetCategoryResponse) String() string { return proto.CompactTextString(m) }
-func (*GetCategoryResponse) ProtoMessage()    {}
-func (*GetCategoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{12}
-}
-func (m *GetCategoryResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCategoryResponse.Unmarshal(m, b)
+type GetCategoryRequest_ArticleId struct {
+	ArticleId string `protobuf:"bytes,5,opt,name=articleId,proto3,oneof"`
 }
-func (m *GetCategoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCategoryResponse.Marshal(b, m, deterministic)
+
+func (*GetCategoryRequest_CategoryIdOrKeyname) isGetCategoryRequest_Id() {}
+
+func (*GetCategoryRequest_SectionId) isGetCategoryRequest_Id() {}
+
+func (*GetCategoryRequest_ArticleId) isGetCategoryRequest_Id() {}
+
+type GetCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
-func (dst *GetCategoryResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCategoryResponse.Merge(dst, src)
+
+func (x *GetCategoryResponse) Reset() {
+	*x = GetCategoryResponse{}
+	mi := &file_zendesk_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (m *GetCategoryResponse) XXX_Size() int {
-	return xxx_messageInfo_GetCategoryResponse.Size(m)
+
+func (x *GetCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *GetCategoryResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCategoryResponse.DiscardUnknown(m)
+
+func (*GetCategoryResponse) ProtoMessage() {}
+
+func (x *GetCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_GetCategoryResponse proto.InternalMessageInfo
+// Deprecated: Use GetCategoryResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{12}
+}
 
-func (m *GetCategoryResponse) GetCategory() *Category {
-	if m != nil {
-		return m.Category
+func (x *GetCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
 	}
 	return nil
 }
 
 type GetSectionsRequest struct {
-	CountryCode CountryCode `protobuf:"varint,1,opt,name=countryCode,enum=protobuf.CountryCode" json:"countryCode,omitempty"`
-	Locale      Locale      `protobuf:"varint,2,opt,name=locale,enum=protobuf.Locale" json:"locale,omitempty"`
-	SortBy      SortBy      `protobuf:"varint,3,opt,name=sortBy,enum=protobuf.SortBy" json:"sortBy,omitempty"`
-	SortOrder   SortOrder   `protobuf:"varint,4,opt,name=sortOrder,enum=protobuf.SortOrder" json:"sortOrder,omitempty"`
-	PerPage     int32       `protobuf:"varint,5,opt,name=perPage" json:"perPage,omitempty"`
-	Page        int32       `protobuf:"varint,6,opt,name=page" json:"page,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CountryCode CountryCode            `protobuf:"varint,1,opt,name=countryCode,proto3,enum=protobuf.CountryCode" json:"countryCode,omitempty"`
+	Locale      Locale                 `protobuf:"varint,2,opt,name=locale,proto3,enum=protobuf.Locale" json:"locale,omitempty"`
+	SortBy      SortBy                 `protobuf:"varint,3,opt,name=sortBy,proto3,enum=protobuf.SortBy" json:"sortBy,omitempty"`
+	SortOrder   SortOrder              `protobuf:"varint,4,opt,name=sortOrder,proto3,enum=protobuf.SortOrder" json:"sortOrder,omitempty"`
+	PerPage     int32                  `protobuf:"varint,5,opt,name=perPage,proto3" json:"perPage,omitempty"`
+	Page        int32                  `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
 	// Types that are valid to be assigned to Id:
+	//
 	//	*GetSectionsRequest_All
 	//	*GetSectionsRequest_CategoryId
-	Id                   isGetSectionsRequest_Id `protobuf_oneof:"Id"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	Id            isGetSectionsRequest_Id `protobuf_oneof:"Id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (m *GetSectionsRequest) Reset()         { *m = GetSectionsRequest{} }
-func (m *GetSectionsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetSectionsRequest) ProtoMessage()    {}
-func (*GetSectionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{13}
+func (x *GetSectionsRequest) Reset() {
+	*x = GetSectionsRequest{}
+	mi := &file_zendesk_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (m *GetSectionsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetSectionsRequest.Unmarshal(m, b)
-}
-func (m *GetSectionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetSectionsRequest.Marshal(b, m, deterministic)
+
+func (x *GetSectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (dst *GetSectionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetSectionsRequest.Merge(dst, src)
-}
-func (m *GetSectionsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetSectionsRequest.Size(m)
-}
-func (m *GetSectionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetSectionsRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GetSectionsRequest proto.InternalMessageInfo
 
-type isGetSectionsRequest_Id interface {
-	isGetSectionsRequest_Id()
-}
+func (*GetSectionsRequest) ProtoMessage() {}
 
-type GetSectionsRequest_All struct {
-	All bool `protobuf:"varint,7,opt,name=all,oneof"`
-}
-type GetSectionsRequest_CategoryId struct {
-	CategoryId string `protobuf:"bytes,8,opt,name=categoryId,oneof"`
+func (x *GetSectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*GetSectionsRequest_All) isGetSectionsRequest_Id()        {}
-func (*GetSectionsRequest_CategoryId) isGetSectionsRequest_Id() {}
-
-func (m *GetSectionsRequest) GetId() isGetSectionsRequest_Id {
-	if m != nil {
-		return m.Id
-	}
-	return nil
+// Deprecated: Use GetSectionsRequest.ProtoReflect.Descriptor instead.
+func (*GetSectionsRequest) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{13}
 }
 
-func (m *GetSectionsRequest) GetCountryCode() CountryCode {
-	if m != nil {
-		return m.CountryCode
+func (x *GetSectionsRequest) GetCountryCode() CountryCode {
+	if x != nil {
+		return x.CountryCode
 	}
 	return CountryCode_COUNTRY_CODE_SG
 }
 
-func (m *GetSectionsRequest) GetLocale() Locale {
-	if m != nil {
-		return m.Locale
+func (x *GetSectionsRequest) GetLocale() Locale {
+	if x != nil {
+		return x.Locale
 	}
 	return Locale_LOCALE_EN_US
 }
 
-func (m *GetSectionsRequest) GetSortBy() SortBy {
-	if m != nil {
-		return m.SortBy
+func (x *GetSectionsRequest) GetSortBy() SortBy {
+	if x != nil {
+		return x.SortBy
 	}
 	return SortBy_SORT_BY_POSITION
 }
 
-func (m *GetSectionsRequest) GetSortOrder() SortOrder {
-	if m != nil {
-		return m.SortOrder
+func (x *GetSectionsRequest) GetSortOrder() SortOrder {
+	if x != nil {
+		return x.SortOrder
 	}
 	return SortOrder_SORT_ORDER_ASC
 }
 
-func (m *GetSectionsRequest) GetPerPage() int32 {
-	if m != nil {
-		return m.PerPage
+func (x *GetSectionsRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
 	}
 	return 0
 }
 
-func (m *GetSectionsRequest) GetPage() int32 {
-	if m != nil {
-		return m.Page
+func (x *GetSectionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
 	}
 	return 0
 }
 
-func (m *GetSectionsRequest) GetAll() bool {
-	if x, ok := m.GetId().(*GetSectionsRequest_All); ok {
-		return x.All
+func (x *GetSectionsRequest) GetId() isGetSectionsRequest_Id {
+	if x != nil {
+		return x.Id
 	}
-	return false
+	return nil
 }
 
-func (m *GetSectionsRequest) GetCategoryId() string {
-	if x, ok := m.GetId().(*GetSectionsRequest_CategoryId); ok {
-		return x.CategoryId
+func (x *GetSectionsRequest) GetAll() bool {
+	if x != nil {
+		if x, ok := x.Id.(*GetSectionsRequest_All); ok {
+			return x.All
+		}
 	}
-	return ""
+	return false
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*GetSectionsRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _GetSectionsRequest_OneofMarshaler, _GetSectionsRequest_OneofUnmarshaler, _GetSectionsRequest_OneofSizer, []interface{}{
-		(*GetSectionsRequest_All)(nil),
-		(*GetSectionsRequest_CategoryId)(nil),
+func (x *GetSectionsRequest) GetCategoryId() string {
+	if x != nil {
+		if x, ok := x.Id.(*GetSectionsRequest_CategoryId); ok {
+			return x.CategoryId
+		}
 	}
+	return ""
 }
 
-func _GetSectionsRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*GetSectionsRequest)
-	// Id
-	switch x := m.Id.(type) {
-	case *GetSectionsRequest_All:
-		t := uint64(0)
-		if x.All {
-			t = 1
-		}
-		b.EncodeVarint(7<<3 | proto.WireVarint)
-		b.EncodeVarint(t)
-	case *GetSectionsRequest_CategoryId:
-		b.EncodeVarint(8<<3 | proto.WireBytes)
-		b.EncodeStringBytes(x.CategoryId)
-	case nil:
-	default:
-		return fmt.Errorf("GetSectionsRequest.Id has unexpected type %T", x)
-	}
-	return nil
+type isGetSectionsRequest_Id interface {
+	isGetSectionsRequest_Id()
 }
 
-func _GetSectionsRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*GetSectionsRequest)
-	switch tag {
-	case 7: // Id.all
-		if wire != proto.WireVarint {
-			return true, proto.ErrInternalBadWireType
-		}
-		x, err := b.DecodeVarint()
-		m.Id = &GetSectionsRequest_All{x != 0}
-		return true, err
-	case 8: // Id.categoryId
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		x, err := b.DecodeStringBytes()
-		m.Id = &GetSectionsRequest_CategoryId{x}
-		return true, err
-	default:
-		return false, nil
-	}
+type GetSectionsRequest_All struct {
+	All bool `protobuf:"varint,7,opt,name=all,proto3,oneof"`
 }
 
-func _GetSectionsRequest_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*GetSectionsRequest)
-	// Id
-	switch x := m.Id.(type) {
-	case *GetSectionsRequest_All:
-		n += 1 // tag and wire
-		n += 1
-	case *GetSectionsRequest_CategoryId:
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(len(x.CategoryId)))
-		n += len(x.CategoryId)
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
-	}
-	return n
+type GetSectionsRequest_CategoryId struct {
+	CategoryId string `protobuf:"bytes,8,opt,name=categoryId,proto3,oneof"`
 }
 
+func (*GetSectionsRequest_All) isGetSectionsRequest_Id() {}
+
+func (*GetSectionsRequest_CategoryId) isGetSectionsRequest_Id() {}
+
 type GetSectionsResponse struct {
-	PageInfo             *PageInfo  `protobuf:"bytes,1,opt,name=pageInfo" json:"pageInfo,omitempty"`
-	Sections             []*Section `protobuf:"bytes,2,rep,name=sections" json:"sections,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageInfo      *PageInfo              `protobuf:"bytes,1,opt,name=pageInfo,proto3" json:"pageInfo,omitempty"`
+	Sections      []*Section             `protobuf:"bytes,2,rep,name=sections,proto3" json:"sections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (m *GetSectionsResponse) Reset()         { *m = GetSectionsResponse{} }
-func (m *GetSectionsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetSectionsResponse) ProtoMessage()    {}
-func (*GetSectionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{14}
-}
-func (m *GetSectionsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetSectionsResponse.Unmarshal(m, b)
-}
-func (m *GetSectionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetSectionsResponse.Marshal(b, m, deterministic)
+func (x *GetSectionsResponse) Reset() {
+	*x = GetSectionsResponse{}
+	mi := &file_zendesk_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (dst *GetSectionsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetSectionsResponse.Merge(dst, src)
-}
-func (m *GetSectionsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetSectionsResponse.Size(m)
+
+func (x *GetSectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *GetSectionsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetSectionsResponse.DiscardUnknown(m)
+
+func (*GetSectionsResponse) ProtoMessage() {}
+
+func (x *GetSectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_GetSectionsResponse proto.InternalMessageInfo
+// Deprecated: Use GetSectionsResponse.ProtoReflect.Descriptor instead.
+func (*GetSectionsResponse) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{14}
+}
 
-func (m *GetSectionsResponse) GetPageInfo() *PageInfo {
-	if m != nil {
-		return m.PageInfo
+func (x *GetSectionsResponse) GetPageInfo() *PageInfo {
+	if x != nil {
+		return x.PageInfo
 	}
 	return nil
 }
 
-func (m *GetSectionsResponse) GetSections() []*Section {
-	if m != nil {
-		return m.Sections
+func (x *GetSectionsResponse) GetSections() []*Section {
+	if x != nil {
+		return x.Sections
 	}
 	return nil
 }
 
 type GetSectionRequest struct {
-	CountryCode CountryCode `protobuf:"varint,1,opt,name=countryCode,enum=protobuf.CountryCode" json:"countryCode,omitempty"`
-	Locale      Locale      `protobuf:"varint,2,opt,name=locale,enum=protobuf.Locale" json:"locale,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CountryCode CountryCode            `protobuf:"varint,1,opt,name=countryCode,proto3,enum=protobuf.CountryCode" json:"countryCode,omitempty"`
+	Locale      Locale                 `protobuf:"varint,2,opt,name=locale,proto3,enum=protobuf.Locale" json:"locale,omitempty"`
 	// Types that are valid to be assigned to Id:
+	//
 	//	*GetSectionRequest_SectionId
 	//	*GetSectionRequest_ArticleId
-	Id                   isGetSectionRequest_Id `protobuf_oneof:"Id"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
-}
-
-func (m *GetSectionRequest) Reset()         { *m = GetSectionRequest{} }
-func (m *GetSectionRequest) String() string { return proto.CompactTextString(m) }
-func (*GetSectionRequest) ProtoMessage()    {}
-func (*GetSectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{15}
-}
-func (m *GetSectionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetSectionRequest.Unmarshal(m, b)
-}
-func (m *GetSectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetSectionRequest.Marshal(b, m, deterministic)
-}
-func (dst *GetSectionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetSectionRequest.Merge(dst, src)
-}
-func (m *GetSectionRequest) XXX_Size() int {
-	return xxx_messageInfo_GetSectionRequest.Size(m)
-}
-func (m *GetSectionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetSectionRequest.DiscardUnknown(m)
+	Id            isGetSectionRequest_Id `protobuf_oneof:"Id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-var xxx_messageInfo_GetSectionRequest proto.InternalMessageInfo
-
-type isGetSectionRequest_Id interface {
-	isGetSectionRequest_Id()
+func (x *GetSectionRequest) Reset() {
+	*x = GetSectionRequest{}
+	mi := &file_zendesk_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type GetSectionRequest_SectionId struct {
-	SectionId string `protobuf:"bytes,3,opt,name=sectionId,oneof"`
-}
-type GetSectionRequest_ArticleId struct {
-	ArticleId string `protobuf:"bytes,4,opt,name=articleId,oneof"`
+func (x *GetSectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSectionRequest_SectionId) isGetSectionRequest_Id() {}
-func (*GetSectionRequest_ArticleId) isGetSectionRequest_Id() {}
+func (*GetSectionRequest) ProtoMessage() {}
 
-func (m *GetSectionRequest) GetId() isGetSectionRequest_Id {
-	if m != nil {
-		return m.Id
+func (x *GetSectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSectionRequest.ProtoReflect.Descriptor instead.
+func (*GetSectionRequest) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{15}
 }
 
-func (m *GetSectionRequest) GetCountryCode() CountryCode {
-	if m != nil {
-		return m.CountryCode
+func (x *GetSectionRequest) GetCountryCode() CountryCode {
+	if x != nil {
+		return x.CountryCode
 	}
 	return CountryCode_COUNTRY_CODE_SG
 }
 
-func (m *GetSectionRequest) GetLocale() Locale {
-	if m != nil {
-		return m.Locale
+func (x *GetSectionRequest) GetLocale() Locale {
+	if x != nil {
+		return x.Locale
 	}
 	return Locale_LOCALE_EN_US
 }
 
-func (m *GetSectionRequest) GetSectionId() string {
-	if x, ok := m.GetId().(*GetSectionRequest_SectionId); ok {
-		return x.SectionId
+func (x *GetSectionRequest) GetId() isGetSectionRequest_Id {
+	if x != nil {
+		return x.Id
 	}
-	return ""
+	return nil
 }
 
-func (m *GetSectionRequest) GetArticleId() string {
-	if x, ok := m.GetId().(*GetSectionRequest_ArticleId); ok {
-		return x.ArticleId
+func (x *GetSectionRequest) GetSectionId() string {
+	if x != nil {
+		if x, ok := x.Id.(*GetSectionRequest_SectionId); ok {
+			return x.SectionId
+		}
 	}
 	return ""
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*GetSectionRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _GetSectionRequest_OneofMarshaler, _GetSectionRequest_OneofUnmarshaler, _GetSectionRequest_OneofSizer, []interface{}{
-		(*GetSectionRequest_SectionId)(nil),
-		(*GetSectionRequest_ArticleId)(nil),
+func (x *GetSectionRequest) GetArticleId() string {
+	if x != nil {
+		if x, ok := x.Id.(*GetSectionRequest_ArticleId); ok {
+			return x.ArticleId
+		}
 	}
+	return ""
 }
 
-func _GetSectionRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*GetSectionRequest)
-	// Id
-	switch x := m.Id.(type) {
-	case *GetSectionRequest_SectionId:
-		b.EncodeVarint(3<<3 | proto.WireBytes)
-		b.EncodeStringBytes(x.SectionId)
-	case *GetSectionRequest_ArticleId:
-		b.EncodeVarint(4<<3 | proto.WireBytes)
-		b.EncodeStringBytes(x.ArticleId)
-	case nil:
-	default:
-		return fmt.Errorf("GetSectionRequest.Id has unexpected type %T", x)
-	}
-	return nil
+type isGetSectionRequest_Id interface {
+	isGetSectionRequest_Id()
 }
 
-func _GetSectionRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*GetSectionRequest)
-	switch tag {
-	case 3: // Id.sectionId
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		x, err := b.DecodeStringBytes()
-		m.Id = &GetSectionRequest_SectionId{x}
-		return true, err
-	case 4: // Id.articleId
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		x, err := b.DecodeStringBytes()
-		m.Id = &GetSectionRequest_ArticleId{x}
-		return true, err
-	default:
-		return false, nil
-	}
+type GetSectionRequest_SectionId struct {
+	SectionId string `protobuf:"bytes,3,opt,name=sectionId,proto3,oneof"`
 }
 
-func _GetSectionRequest_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*GetSectionRequest)
-	// Id
-	switch x := m.Id.(type) {
-	case *GetSectionRequest_SectionId:
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(len(x.SectionId)))
-		n += len(x.SectionId)
-	case *GetSectionRequest_ArticleId:
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(len(x.ArticleId)))
-		n += len(x.ArticleId)
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
-	}
-	return n
+type GetSectionRequest_ArticleId struct {
+	ArticleId string `protobuf:"bytes,4,opt,name=articleId,proto3,oneof"`
 }
 
+func (*GetSectionRequest_SectionId) isGetSectionRequest_Id() {}
+
+func (*GetSectionRequest_ArticleId) isGetSectionRequest_Id() {}
+
 type GetSectionResponse struct {
-	Section              *Section `protobuf:"bytes,1,opt,name=section" json:"section,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Section       *Section               `protobuf:"bytes,1,opt,name=section,proto3" json:"section,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (m *GetSectionResponse) Reset()         { *m = GetSectionResponse{} }
-func (m *GetSectionResponse) String() string { return proto.CompactTextString(m) }
-func (*GetSectionResponse) ProtoMessage()    {}
-func (*GetSectionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{16}
-}
-func (m *GetSectionResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetSectionResponse.Unmarshal(m, b)
-}
-func (m *GetSectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetSectionResponse.Marshal(b, m, deterministic)
-}
-func (dst *GetSectionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetSectionResponse.Merge(dst, src)
+func (x *GetSectionResponse) Reset() {
+	*x = GetSectionResponse{}
+	mi := &file_zendesk_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (m *GetSectionResponse) XXX_Size() int {
-	return xxx_messageInfo_GetSectionResponse.Size(m)
+
+func (x *GetSectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *GetSectionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetSectionResponse.DiscardUnknown(m)
+
+func (*GetSectionResponse) ProtoMessage() {}
+
+func (x *GetSectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_GetSectionResponse proto.InternalMessageInfo
+// Deprecated: Use GetSectionResponse.ProtoReflect.Descriptor instead.
+func (*GetSectionResponse) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{16}
+}
 
-func (m *GetSectionResponse) GetSection() *Section {
-	if m != nil {
-		return m.Section
+func (x *GetSectionResponse) GetSection() *Section {
+	if x != nil {
+		return x.Section
 	}
 	return nil
 }
 
 type GetArticlesRequest struct {
-	CountryCode CountryCode `protobuf:"varint,1,opt,name=countryCode,enum=protobuf.CountryCode" json:"countryCode,omitempty"`
-	Locale      Locale      `protobuf:"varint,2,opt,name=locale,enum=protobuf.Locale" json:"locale,omitempty"`
-	SortBy      SortBy      `protobuf:"varint,3,opt,name=sortBy,enum=protobuf.SortBy" json:"sortBy,omitempty"`
-	SortOrder   SortOrder   `protobuf:"varint,4,opt,name=sortOrder,enum=protobuf.SortOrder" json:"sortOrder,omitempty"`
-	PerPage     int32       `protobuf:"varint,5,opt,name=perPage" json:"perPage,omitempty"`
-	Page        int32       `protobuf:"varint,6,opt,name=page" json:"page,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CountryCode CountryCode            `protobuf:"varint,1,opt,name=countryCode,proto3,enum=protobuf.CountryCode" json:"countryCode,omitempty"`
+	Locale      Locale                 `protobuf:"varint,2,opt,name=locale,proto3,enum=protobuf.Locale" json:"locale,omitempty"`
+	SortBy      SortBy                 `protobuf:"varint,3,opt,name=sortBy,proto3,enum=protobuf.SortBy" json:"sortBy,omitempty"`
+	SortOrder   SortOrder              `protobuf:"varint,4,opt,name=sortOrder,proto3,enum=protobuf.SortOrder" json:"sortOrder,omitempty"`
+	PerPage     int32                  `protobuf:"varint,5,opt,name=perPage,proto3" json:"perPage,omitempty"`
+	Page        int32                  `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
 	// Types that are valid to be assigned to Id:
+	//
 	//	*GetArticlesRequest_All
 	//	*GetArticlesRequest_CategoryId
 	//	*GetArticlesRequest_SectionId
-	Id                   isGetArticlesRequest_Id `protobuf_oneof:"Id"`
-	LabelNames           []string                `protobuf:"bytes,10,rep,name=labelNames" json:"labelNames,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
-}
-
-func (m *GetArticlesRequest) Reset()         { *m = GetArticlesRequest{} }
-func (m *GetArticlesRequest) String() string { return proto.CompactTextString(m) }
-func (*GetArticlesRequest) ProtoMessage()    {}
-func (*GetArticlesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{17}
-}
-func (m *GetArticlesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetArticlesRequest.Unmarshal(m, b)
-}
-func (m *GetArticlesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetArticlesRequest.Marshal(b, m, deterministic)
+	Id            isGetArticlesRequest_Id `protobuf_oneof:"Id"`
+	LabelNames    []string                `protobuf:"bytes,10,rep,name=labelNames,proto3" json:"labelNames,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
-func (dst *GetArticlesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetArticlesRequest.Merge(dst, src)
-}
-func (m *GetArticlesRequest) XXX_Size() int {
-	return xxx_messageInfo_GetArticlesRequest.Size(m)
-}
-func (m *GetArticlesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetArticlesRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GetArticlesRequest proto.InternalMessageInfo
 
-type isGetArticlesRequest_Id interface {
-	isGetArticlesRequest_Id()
+func (x *GetArticlesRequest) Reset() {
+	*x = GetArticlesRequest{}
+	mi := &file_zendesk_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type GetArticlesRequest_All struct {
-	All bool `protobuf:"varint,7,opt,name=all,oneof"`
-}
-type GetArticlesRequest_CategoryId struct {
-	CategoryId string `protobuf:"bytes,8,opt,name=categoryId,oneof"`
-}
-type GetArticlesRequest_SectionId struct {
-	SectionId string `protobuf:"bytes,9,opt,name=sectionId,oneof"`
+func (x *GetArticlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetArticlesRequest_All) isGetArticlesRequest_Id()        {}
-func (*GetArticlesRequest_CategoryId) isGetArticlesRequest_Id() {}
-func (*GetArticlesRequest_SectionId) isGetArticlesRequest_Id()  {}
+func (*GetArticlesRequest) ProtoMessage() {}
 
-func (m *GetArticlesRequest) GetId() isGetArticlesRequest_Id {
-	if m != nil {
-		return m.Id
+func (x *GetArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArticlesRequest.ProtoReflect.Descriptor instead.
+func (*GetArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{17}
 }
 
-func (m *GetArticlesRequest) GetCountryCode() CountryCode {
-	if m != nil {
-		return m.CountryCode
+func (x *GetArticlesRequest) GetCountryCode() CountryCode {
+	if x != nil {
+		return x.CountryCode
 	}
 	return CountryCode_COUNTRY_CODE_SG
 }
 
-func (m *GetArticlesRequest) GetLocale() Locale {
-	if m != nil {
-		return m.Locale
+func (x *GetArticlesRequest) GetLocale() Locale {
+	if x != nil {
+		return x.Locale
 	}
 	return Locale_LOCALE_EN_US
 }
 
-func (m *GetArticlesRequest) GetSortBy() SortBy {
-	if m != nil {
-		return m.SortBy
+func (x *GetArticlesRequest) GetSortBy() SortBy {
+	if x != nil {
+		return x.SortBy
 	}
 	return SortBy_SORT_BY_POSITION
 }
 
-func (m *GetArticlesRequest) GetSortOrder() SortOrder {
-	if m != nil {
-		return m.SortOrder
+func (x *GetArticlesRequest) GetSortOrder() SortOrder {
+	if x != nil {
+		return x.SortOrder
 	}
 	return SortOrder_SORT_ORDER_ASC
 }
 
-func (m *GetArticlesRequest) GetPerPage() int32 {
-	if m != nil {
-		return m.PerPage
+func (x *GetArticlesRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
 	}
 	return 0
 }
 
-func (m *GetArticlesRequest) GetPage() int32 {
-	if m != nil {
-		return m.Page
+func (x *GetArticlesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
 	}
 	return 0
 }
 
-func (m *GetArticlesRequest) GetAll() bool {
-	if x, ok := m.GetId().(*GetArticlesRequest_All); ok {
-		return x.All
+func (x *GetArticlesRequest) GetId() isGetArticlesRequest_Id {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *GetArticlesRequest) GetAll() bool {
+	if x != nil {
+		if x, ok := x.Id.(*GetArticlesRequest_All); ok {
+			return x.All
+		}
 	}
 	return false
 }
 
-func (m *GetArticlesRequest) GetCategoryId() string {
-	if x, ok := m.GetId().(*GetArticlesRequest_CategoryId); ok {
-		return x.CategoryId
+func (x *GetArticlesRequest) GetCategoryId() string {
+	if x != nil {
+		if x, ok := x.Id.(*GetArticlesRequest_CategoryId); ok {
+			return x.CategoryId
+		}
 	}
 	return ""
 }
 
-func (m *GetArticlesRequest) GetSectionId() string {
-	if x, ok := m.GetId().(*GetArticlesRequest_SectionId); ok {
-		return x.SectionId
+func (x *GetArticlesRequest) GetSectionId() string {
+	if x != nil {
+		if x, ok := x.Id.(*GetArticlesRequest_SectionId); ok {
+			return x.SectionId
+		}
 	}
 	return ""
 }
 
-func (m *GetArticlesRequest) GetLabelNames() []string {
-	if m != nil {
-		return m.LabelNames
+func (x *GetArticlesRequest) GetLabelNames() []string {
+	if x != nil {
+		return x.LabelNames
 	}
 	return nil
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*GetArticlesRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _GetArticlesRequest_OneofMarshaler, _GetArticlesRequest_OneofUnmarshaler, _GetArticlesRequest_OneofSizer, []interface{}{
-		(*GetArticlesRequest_All)(nil),
-		(*GetArticlesRequest_CategoryId)(nil),
-		(*GetArticlesRequest_SectionId)(nil),
-	}
+type isGetArticlesRequest_Id interface {
+	isGetArticlesRequest_Id()
 }
 
-func _GetArticlesRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*GetArticlesRequest)
-	// Id
-	switch x := m.Id.(type) {
-	case *GetArticlesRequest_All:
-		t := uint64(0)
-		if x.All {
-			t = 1
-		}
-		b.EncodeVarint(7<<3 | proto.WireVarint)
-		b.EncodeVarint(t)
-	case *GetArticlesRequest_CategoryId:
-		b.EncodeVarint(8<<3 | proto.WireBytes)
-		b.EncodeStringBytes(x.CategoryId)
-	case *GetArticlesRequest_SectionId:
-		b.EncodeVarint(9<<3 | proto.WireBytes)
-		b.EncodeStringBytes(x.SectionId)
-	case nil:
-	default:
-		return fmt.Errorf("GetArticlesRequest.Id has unexpected type %T", x)
-	}
-	return nil
+type GetArticlesRequest_All struct {
+	All bool `protobuf:"varint,7,opt,name=all,proto3,oneof"`
 }
 
-func _GetArticlesRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*GetArticlesRequest)
-	switch tag {
-	case 7: // Id.all
-		if wire != proto.WireVarint {
-			return true, proto.ErrInternalBadWireType
-		}
-		x, err := b.DecodeVarint()
-		m.Id = &GetArticlesRequest_All{x != 0}
-		return true, err
-	case 8: // Id.categoryId
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		x, err := b.DecodeStringBytes()
-		m.Id = &GetArticlesRequest_CategoryId{x}
-		return true, err
-	case 9: // Id.sectionId
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		x, err := b.DecodeStringBytes()
-		m.Id = &GetArticlesRequest_SectionId{x}
-		return true, err
-	default:
-		return false, nil
-	}
-}
-
-func _GetArticlesRequest_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*GetArticlesRequest)
-	// Id
-	switch x := m.Id.(type) {
-	case *GetArticlesRequest_All:
-		n += 1 // tag and wire
-		n += 1
-	case *GetArticlesRequest_CategoryId:
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(len(x.CategoryId)))
-		n += len(x.CategoryId)
-	case *GetArticlesRequest_SectionId:
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(len(x.SectionId)))
-		n += len(x.SectionId)
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
-	}
-	return n
+type GetArticlesRequest_CategoryId struct {
+	CategoryId string `protobuf:"bytes,8,opt,name=categoryId,proto3,oneof"`
 }
 
-type GetArticlesResponse struct {
-	PageInfo             *PageInfo  `protobuf:"bytes,1,opt,name=pageInfo" json:"pageInfo,omitempty"`
-	Articles             []*Article `protobuf:"bytes,2,rep,name=articles" json:"articles,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type GetArticlesRequest_SectionId struct {
+	SectionId string `protobuf:"bytes,9,opt,name=sectionId,proto3,oneof"`
 }
 
-func (m *GetArticlesResponse) Reset()         { *m = GetArticlesResponse{} }
-func (m *GetArticlesResponse) String() string { return proto.CompactTextString(m) }
-func (*GetArticlesResponse) ProtoMessage()    {}
-func (*GetArticlesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{18}
-}
-func (m *GetArticlesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetArticlesResponse.Unmarshal(m, b)
-}
-func (m *GetArticlesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetArticlesResponse.Marshal(b, m, deterministic)
+func (*GetArticlesRequest_All) isGetArticlesRequest_Id() {}
+
+func (*GetArticlesRequest_CategoryId) isGetArticlesRequest_Id() {}
+
+func (*GetArticlesRequest_SectionId) isGetArticlesRequest_Id() {}
+
+type GetArticlesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageInfo      *PageInfo              `protobuf:"bytes,1,opt,name=pageInfo,proto3" json:"pageInfo,omitempty"`
+	Articles      []*Article             `protobuf:"bytes,2,rep,name=articles,proto3" json:"articles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
-func (dst *GetArticlesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetArticlesResponse.Merge(dst, src)
+
+func (x *GetArticlesResponse) Reset() {
+	*x = GetArticlesResponse{}
+	mi := &file_zendesk_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (m *GetArticlesResponse) XXX_Size() int {
-	return xxx_messageInfo_GetArticlesResponse.Size(m)
+
+func (x *GetArticlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *GetArticlesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetArticlesResponse.DiscardUnknown(m)
+
+func (*GetArticlesResponse) ProtoMessage() {}
+
+func (x *GetArticlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_GetArticlesResponse proto.InternalMessageInfo
+// Deprecated: Use GetArticlesResponse.ProtoReflect.Descriptor instead.
+func (*GetArticlesResponse) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{18}
+}
 
-func (m *GetArticlesResponse) GetPageInfo() *PageInfo {
-	if m != nil {
-		return m.PageInfo
+func (x *GetArticlesResponse) GetPageInfo() *PageInfo {
+	if x != nil {
+		return x.PageInfo
 	}
 	return nil
 }
 
-func (m *GetArticlesResponse) GetArticles() []*Article {
-	if m != nil {
-		return m.Articles
+func (x *GetArticlesResponse) GetArticles() []*Article {
+	if x != nil {
+		return x.Articles
 	}
 	return nil
 }
 
 type GetTopArticlesRequest struct {
-	CountryCode          CountryCode `protobuf:"varint,1,opt,name=countryCode,enum=protobuf.CountryCode" json:"countryCode,omitempty"`
-	Locale               Locale      `protobuf:"varint,2,opt,name=locale,enum=protobuf.Locale" json:"locale,omitempty"`
-	TopN                 int32       `protobuf:"varint,3,opt,name=topN" json:"topN,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CountryCode   CountryCode            `protobuf:"varint,1,opt,name=countryCode,proto3,enum=protobuf.CountryCode" json:"countryCode,omitempty"`
+	Locale        Locale                 `protobuf:"varint,2,opt,name=locale,proto3,enum=protobuf.Locale" json:"locale,omitempty"`
+	TopN          int32                  `protobuf:"varint,3,opt,name=topN,proto3" json:"topN,omitempty"`
+	WindowDays    int32                  `protobuf:"varint,4,opt,name=windowDays,proto3" json:"windowDays,omitempty"`
+	SectionId     string                 `protobuf:"bytes,5,opt,name=sectionId,proto3" json:"sectionId,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,6,opt,name=categoryId,proto3" json:"categoryId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (m *GetTopArticlesRequest) Reset()         { *m = GetTopArticlesRequest{} }
-func (m *GetTopArticlesRequest) String() string { return proto.CompactTextString(m) }
-func (*GetTopArticlesRequest) ProtoMessage()    {}
-func (*GetTopArticlesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{19}
-}
-func (m *GetTopArticlesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetTopArticlesRequest.Unmarshal(m, b)
+func (x *GetTopArticlesRequest) Reset() {
+	*x = GetTopArticlesRequest{}
+	mi := &file_zendesk_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (m *GetTopArticlesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetTopArticlesRequest.Marshal(b, m, deterministic)
-}
-func (dst *GetTopArticlesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTopArticlesRequest.Merge(dst, src)
-}
-func (m *GetTopArticlesRequest) XXX_Size() int {
-	return xxx_messageInfo_GetTopArticlesRequest.Size(m)
+
+func (x *GetTopArticlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *GetTopArticlesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTopArticlesRequest.DiscardUnknown(m)
+
+func (*GetTopArticlesRequest) ProtoMessage() {}
+
+func (x *GetTopArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_GetTopArticlesRequest proto.InternalMessageInfo
+// Deprecated: Use GetTopArticlesRequest.ProtoReflect.Descriptor instead.
+func (*GetTopArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{19}
+}
 
-func (m *GetTopArticlesRequest) GetCountryCode() CountryCode {
-	if m != nil {
-		return m.CountryCode
+func (x *GetTopArticlesRequest) GetCountryCode() CountryCode {
+	if x != nil {
+		return x.CountryCode
 	}
 	return CountryCode_COUNTRY_CODE_SG
 }
 
-func (m *GetTopArticlesRequest) GetLocale() Locale {
-	if m != nil {
-		return m.Locale
+func (x *GetTopArticlesRequest) GetLocale() Locale {
+	if x != nil {
+		return x.Locale
 	}
 	return Locale_LOCALE_EN_US
 }
 
-func (m *GetTopArticlesRequest) GetTopN() int32 {
-	if m != nil {
-		return m.TopN
+func (x *GetTopArticlesRequest) GetTopN() int32 {
+	if x != nil {
+		return x.TopN
 	}
 	return 0
 }
 
-type GetTopArticlesResponse struct {
-	Articles             []*Article `protobuf:"bytes,1,rep,name=articles" json:"articles,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+func (x *GetTopArticlesRequest) GetWindowDays() int32 {
+	if x != nil {
+		return x.WindowDays
+	}
+	return 0
 }
 
-func (m *GetTopArticlesResponse) Reset()         { *m = GetTopArticlesResponse{} }
-func (m *GetTopArticlesResponse) String() string { return proto.CompactTextString(m) }
-func (*GetTopArticlesResponse) ProtoMessage()    {}
-func (*GetTopArticlesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{20}
+func (x *GetTopArticlesRequest) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
 }
-func (m *GetTopArticlesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetTopArticlesResponse.Unmarshal(m, b)
+
+func (x *GetTopArticlesRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
 }
-func (m *GetTopArticlesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetTopArticlesResponse.Marshal(b, m, deterministic)
+
+type GetTopArticlesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Articles      []*Article             `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
-func (dst *GetTopArticlesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTopArticlesResponse.Merge(dst, src)
+
+func (x *GetTopArticlesResponse) Reset() {
+	*x = GetTopArticlesResponse{}
+	mi := &file_zendesk_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (m *GetTopArticlesResponse) XXX_Size() int {
-	return xxx_messageInfo_GetTopArticlesResponse.Size(m)
+
+func (x *GetTopArticlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *GetTopArticlesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTopArticlesResponse.DiscardUnknown(m)
+
+func (*GetTopArticlesResponse) ProtoMessage() {}
+
+func (x *GetTopArticlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_GetTopArticlesResponse proto.InternalMessageInfo
+// Deprecated: Use GetTopArticlesResponse.ProtoReflect.Descriptor instead.
+func (*GetTopArticlesResponse) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{20}
+}
 
-func (m *GetTopArticlesResponse) GetArticles() []*Article {
-	if m != nil {
-		return m.Articles
+func (x *GetTopArticlesResponse) GetArticles() []*Article {
+	if x != nil {
+		return x.Articles
 	}
 	return nil
 }
 
 type GetArticleRequest struct {
-	CountryCode          CountryCode `protobuf:"varint,1,opt,name=countryCode,enum=protobuf.CountryCode" json:"countryCode,omitempty"`
-	Locale               Locale      `protobuf:"varint,2,opt,name=locale,enum=protobuf.Locale" json:"locale,omitempty"`
-	ArticleId            string      `protobuf:"bytes,3,opt,name=articleId" json:"articleId,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CountryCode   CountryCode            `protobuf:"varint,1,opt,name=countryCode,proto3,enum=protobuf.CountryCode" json:"countryCode,omitempty"`
+	Locale        Locale                 `protobuf:"varint,2,opt,name=locale,proto3,enum=protobuf.Locale" json:"locale,omitempty"`
+	ArticleId     string                 `protobuf:"bytes,3,opt,name=articleId,proto3" json:"articleId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (m *GetArticleRequest) Reset()         { *m = GetArticleRequest{} }
-func (m *GetArticleRequest) String() string { return proto.CompactTextString(m) }
-func (*GetArticleRequest) ProtoMessage()    {}
-func (*GetArticleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{21}
-}
-func (m *GetArticleRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetArticleRequest.Unmarshal(m, b)
+func (x *GetArticleRequest) Reset() {
+	*x = GetArticleRequest{}
+	mi := &file_zendesk_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (m *GetArticleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetArticleRequest.Marshal(b, m, deterministic)
-}
-func (dst *GetArticleRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetArticleRequest.Merge(dst, src)
-}
-func (m *GetArticleRequest) XXX_Size() int {
-	return xxx_messageInfo_GetArticleRequest.Size(m)
+
+func (x *GetArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *GetArticleRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetArticleRequest.DiscardUnknown(m)
+
+func (*GetArticleRequest) ProtoMessage() {}
+
+func (x *GetArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_GetArticleRequest proto.InternalMessageInfo
+// Deprecated: Use GetArticleRequest.ProtoReflect.Descriptor instead.
+func (*GetArticleRequest) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{21}
+}
 
-func (m *GetArticleRequest) GetCountryCode() CountryCode {
-	if m != nil {
-		return m.CountryCode
+func (x *GetArticleRequest) GetCountryCode() CountryCode {
+	if x != nil {
+		return x.CountryCode
 	}
 	return CountryCode_COUNTRY_CODE_SG
 }
 
-func (m *GetArticleRequest) GetLocale() Locale {
-	if m != nil {
-		return m.Locale
+func (x *GetArticleRequest) GetLocale() Locale {
+	if x != nil {
+		return x.Locale
 	}
 	return Locale_LOCALE_EN_US
 }
 
-func (m *GetArticleRequest) GetArticleId() string {
-	if m != nil {
-		return m.ArticleId
+func (x *GetArticleRequest) GetArticleId() string {
+	if x != nil {
+		return x.ArticleId
 	}
 	return ""
 }
 
 type GetArticleResponse struct {
-	Article              *Article `protobuf:"bytes,1,opt,name=article" json:"article,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Article       *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (m *GetArticleResponse) Reset()         { *m = GetArticleResponse{} }
-func (m *GetArticleResponse) String() string { return proto.CompactTextString(m) }
-func (*GetArticleResponse) ProtoMessage()    {}
-func (*GetArticleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{22}
-}
-func (m *GetArticleResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetArticleResponse.Unmarshal(m, b)
+func (x *GetArticleResponse) Reset() {
+	*x = GetArticleResponse{}
+	mi := &file_zendesk_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (m *GetArticleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetArticleResponse.Marshal(b, m, deterministic)
-}
-func (dst *GetArticleResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetArticleResponse.Merge(dst, src)
-}
-func (m *GetArticleResponse) XXX_Size() int {
-	return xxx_messageInfo_GetArticleResponse.Size(m)
+
+func (x *GetArticleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *GetArticleResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetArticleResponse.DiscardUnknown(m)
+
+func (*GetArticleResponse) ProtoMessage() {}
+
+func (x *GetArticleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_GetArticleResponse proto.InternalMessageInfo
+// Deprecated: Use GetArticleResponse.ProtoReflect.Descriptor instead.
+func (*GetArticleResponse) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{22}
+}
 
-func (m *GetArticleResponse) GetArticle() *Article {
-	if m != nil {
-		return m.Article
+func (x *GetArticleResponse) GetArticle() *Article {
+	if x != nil {
+		return x.Article
 	}
 	return nil
 }
 
 type GetTicketFormRequest struct {
-	FormId               string   `protobuf:"bytes,1,opt,name=formId" json:"formId,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FormId        string                 `protobuf:"bytes,1,opt,name=formId,proto3" json:"formId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (m *GetTicketFormRequest) Reset()         { *m = GetTicketFormRequest{} }
-func (m *GetTicketFormRequest) String() string { return proto.CompactTextString(m) }
-func (*GetTicketFormRequest) ProtoMessage()    {}
-func (*GetTicketFormRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{23}
-}
-func (m *GetTicketFormRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetTicketFormRequest.Unmarshal(m, b)
-}
-func (m *GetTicketFormRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetTicketFormRequest.Marshal(b, m, deterministic)
+func (x *GetTicketFormRequest) Reset() {
+	*x = GetTicketFormRequest{}
+	mi := &file_zendesk_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (dst *GetTicketFormRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTicketFormRequest.Merge(dst, src)
-}
-func (m *GetTicketFormRequest) XXX_Size() int {
-	return xxx_messageInfo_GetTicketFormRequest.Size(m)
+
+func (x *GetTicketFormRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *GetTicketFormRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTicketFormRequest.DiscardUnknown(m)
+
+func (*GetTicketFormRequest) ProtoMessage() {}
+
+func (x *GetTicketFormRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_GetTicketFormRequest proto.InternalMessageInfo
+// Deprecated: Use GetTicketFormRequest.ProtoReflect.Descriptor instead.
+func (*GetTicketFormRequest) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{23}
+}
 
-func (m *GetTicketFormRequest) GetFormId() string {
-	if m != nil {
-		return m.FormId
+func (x *GetTicketFormRequest) GetFormId() string {
+	if x != nil {
+		return x.FormId
 	}
 	return ""
 }
 
 type GetTicketFormResponse struct {
-	Id                   string               `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
-	Url                  string               `protobuf:"bytes,2,opt,name=url" json:"url,omitempty"`
-	Name                 string               `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
-	RawName              string               `protobuf:"bytes,4,opt,name=rawName" json:"rawName,omitempty"`
-	DisplayName          string               `protobuf:"bytes,5,opt,name=displayName" json:"displayName,omitempty"`
-	RawDisplayName       string               `protobuf:"bytes,6,opt,name=rawDisplayName" json:"rawDisplayName,omitempty"`
-	EndUserVisible       bool                 `protobuf:"varint,7,opt,name=endUserVisible" json:"endUserVisible,omitempty"`
-	Position             int32                `protobuf:"varint,8,opt,name=position" json:"position,omitempty"`
-	Active               bool                 `protobuf:"varint,9,opt,name=active" json:"active,omitempty"`
-	InAllBrands          bool                 `protobuf:"varint,10,opt,name=inAllBrands" json:"inAllBrands,omitempty"`
-	RestrictedBrandIds   []int32              `protobuf:"varint,11,rep,packed,name=restrictedBrandIds" json:"restrictedBrandIds,omitempty"`
-	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,12,opt,name=createdAt" json:"createdAt,omitempty"`
-	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,13,opt,name=updatedAt" json:"updatedAt,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
-}
-
-func (m *GetTicketFormResponse) Reset()         { *m = GetTicketFormResponse{} }
-func (m *GetTicketFormResponse) String() string { return proto.CompactTextString(m) }
-func (*GetTicketFormResponse) ProtoMessage()    {}
-func (*GetTicketFormResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{24}
-}
-func (m *GetTicketFormResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetTicketFormResponse.Unmarshal(m, b)
-}
-func (m *GetTicketFormResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetTicketFormResponse.Marshal(b, m, deterministic)
-}
-func (dst *GetTicketFormResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTicketFormResponse.Merge(dst, src)
-}
-func (m *GetTicketFormResponse) XXX_Size() int {
-	return xxx_messageInfo_GetTicketFormResponse.Size(m)
-}
-func (m *GetTicketFormResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTicketFormResponse.DiscardUnknown(m)
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url                string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Name               string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	RawName            string                 `protobuf:"bytes,4,opt,name=rawName,proto3" json:"rawName,omitempty"`
+	DisplayName        string                 `protobuf:"bytes,5,opt,name=displayName,proto3" json:"displayName,omitempty"`
+	RawDisplayName     string                 `protobuf:"bytes,6,opt,name=rawDisplayName,proto3" json:"rawDisplayName,omitempty"`
+	EndUserVisible     bool                   `protobuf:"varint,7,opt,name=endUserVisible,proto3" json:"endUserVisible,omitempty"`
+	Position           int32                  `protobuf:"varint,8,opt,name=position,proto3" json:"position,omitempty"`
+	Active             bool                   `protobuf:"varint,9,opt,name=active,proto3" json:"active,omitempty"`
+	InAllBrands        bool                   `protobuf:"varint,10,opt,name=inAllBrands,proto3" json:"inAllBrands,omitempty"`
+	RestrictedBrandIds []int32                `protobuf:"varint,11,rep,packed,name=restrictedBrandIds,proto3" json:"restrictedBrandIds,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetTicketFormResponse) Reset() {
+	*x = GetTicketFormResponse{}
+	mi := &file_zendesk_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTicketFormResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTicketFormResponse) ProtoMessage() {}
+
+func (x *GetTicketFormResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_GetTicketFormResponse proto.InternalMessageInfo
+// Deprecated: Use GetTicketFormResponse.ProtoReflect.Descriptor instead.
+func (*GetTicketFormResponse) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{24}
+}
 
-func (m *GetTicketFormResponse) GetId() string {
-	if m != nil {
-		return m.Id
+func (x *GetTicketFormResponse) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (m *GetTicketFormResponse) GetUrl() string {
-	if m != nil {
-		return m.Url
+func (x *GetTicketFormResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
 	}
 	return ""
 }
 
-func (m *GetTicketFormResponse) GetName() string {
-	if m != nil {
-		return m.Name
+func (x *GetTicketFormResponse) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (m *GetTicketFormResponse) GetRawName() string {
-	if m != nil {
-		return m.RawName
+func (x *GetTicketFormResponse) GetRawName() string {
+	if x != nil {
+		return x.RawName
 	}
 	return ""
 }
 
-func (m *GetTicketFormResponse) GetDisplayName() string {
-	if m != nil {
-		return m.DisplayName
+func (x *GetTicketFormResponse) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
 	}
 	return ""
 }
 
-func (m *GetTicketFormResponse) GetRawDisplayName() string {
-	if m != nil {
-		return m.RawDisplayName
+func (x *GetTicketFormResponse) GetRawDisplayName() string {
+	if x != nil {
+		return x.RawDisplayName
 	}
 	return ""
 }
 
-func (m *GetTicketFormResponse) GetEndUserVisible() bool {
-	if m != nil {
-		return m.EndUserVisible
+func (x *GetTicketFormResponse) GetEndUserVisible() bool {
+	if x != nil {
+		return x.EndUserVisible
 	}
 	return false
 }
 
-func (m *GetTicketFormResponse) GetPosition() int32 {
-	if m != nil {
-		return m.Position
+func (x *GetTicketFormResponse) GetPosition() int32 {
+	if x != nil {
+		return x.Position
 	}
 	return 0
 }
 
-func (m *GetTicketFormResponse) GetActive() bool {
-	if m != nil {
-		return m.Active
+func (x *GetTicketFormResponse) GetActive() bool {
+	if x != nil {
+		return x.Active
 	}
 	return false
 }
 
-func (m *GetTicketFormResponse) GetInAllBrands() bool {
-	if m != nil {
-		return m.InAllBrands
+func (x *GetTicketFormResponse) GetInAllBrands() bool {
+	if x != nil {
+		return x.InAllBrands
 	}
 	return false
 }
 
-func (m *GetTicketFormResponse) GetRestrictedBrandIds() []int32 {
-	if m != nil {
-		return m.RestrictedBrandIds
+func (x *GetTicketFormResponse) GetRestrictedBrandIds() []int32 {
+	if x != nil {
+		return x.RestrictedBrandIds
 	}
 	return nil
 }
 
-func (m *GetTicketFormResponse) GetCreatedAt() *timestamp.Timestamp {
-	if m != nil {
-		return m.CreatedAt
+func (x *GetTicketFormResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (m *GetTicketFormResponse) GetUpdatedAt() *timestamp.Timestamp {
-	if m != nil {
-		return m.UpdatedAt
+func (x *GetTicketFormResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
 	}
 	return nil
 }
 
 type GetTicketFieldsRequest struct {
-	FormId               string   `protobuf:"bytes,1,opt,name=formId" json:"formId,omitempty"`
-	Locale               Locale   `protobuf:"varint,2,opt,name=locale,enum=protobuf.Locale" json:"locale,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FormId        string                 `protobuf:"bytes,1,opt,name=formId,proto3" json:"formId,omitempty"`
+	Locale        Locale                 `protobuf:"varint,2,opt,name=locale,proto3,enum=protobuf.Locale" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (m *GetTicketFieldsRequest) Reset()         { *m = GetTicketFieldsRequest{} }
-func (m *GetTicketFieldsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetTicketFieldsRequest) ProtoMessage()    {}
-func (*GetTicketFieldsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{25}
-}
-func (m *GetTicketFieldsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetTicketFieldsRequest.Unmarshal(m, b)
+func (x *GetTicketFieldsRequest) Reset() {
+	*x = GetTicketFieldsRequest{}
+	mi := &file_zendesk_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (m *GetTicketFieldsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetTicketFieldsRequest.Marshal(b, m, deterministic)
-}
-func (dst *GetTicketFieldsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTicketFieldsRequest.Merge(dst, src)
-}
-func (m *GetTicketFieldsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetTicketFieldsRequest.Size(m)
-}
-func (m *GetTicketFieldsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTicketFieldsRequest.DiscardUnknown(m)
+
+func (x *GetTicketFieldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var xxx_messageInfo_GetTicketFieldsRequest proto.InternalMessageInfo
+func (*GetTicketFieldsRequest) ProtoMessage() {}
 
-func (m *GetTicketFieldsRequest) GetFormId() string {
-	if m != nil {
-		return m.FormId
+func (x *GetTicketFieldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (m *GetTicketFieldsRequest) GetLocale() Locale {
-	if m != nil {
-		return m.Locale
-	}
-	return Locale_LOCALE_EN_US
+// Deprecated: Use GetTicketFieldsRequest.ProtoReflect.Descriptor instead.
+func (*GetTicketFieldsRequest) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{25}
 }
 
-type GetTicketFieldsResponse struct {
-	TicketFields         []*TicketField `protobuf:"bytes,1,rep,name=ticketFields" json:"ticketFields,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+func (x *GetTicketFieldsRequest) GetFormId() string {
+	if x != nil {
+		return x.FormId
+	}
+	return ""
 }
 
-func (m *GetTicketFieldsResponse) Reset()         { *m = GetTicketFieldsResponse{} }
-func (m *GetTicketFieldsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetTicketFieldsResponse) ProtoMessage()    {}
-func (*GetTicketFieldsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{26}
-}
-func (m *GetTicketFieldsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetTicketFieldsResponse.Unmarshal(m, b)
+func (x *GetTicketFieldsRequest) GetLocale() Locale {
+	if x != nil {
+		return x.Locale
+	}
+	return Locale_LOCALE_EN_US
 }
-func (m *GetTicketFieldsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetTicketFieldsResponse.Marshal(b, m, deterministic)
+
+type GetTicketFieldsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketFields  []*TicketField         `protobuf:"bytes,1,rep,name=ticketFields,proto3" json:"ticketFields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
-func (dst *GetTicketFieldsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTicketFieldsResponse.Merge(dst, src)
+
+func (x *GetTicketFieldsResponse) Reset() {
+	*x = GetTicketFieldsResponse{}
+	mi := &file_zendesk_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (m *GetTicketFieldsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetTicketFieldsResponse.Size(m)
+
+func (x *GetTicketFieldsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *GetTicketFieldsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTicketFieldsResponse.DiscardUnknown(m)
+
+func (*GetTicketFieldsResponse) ProtoMessage() {}
+
+func (x *GetTicketFieldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_GetTicketFieldsResponse proto.InternalMessageInfo
+// Deprecated: Use GetTicketFieldsResponse.ProtoReflect.Descriptor instead.
+func (*GetTicketFieldsResponse) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{26}
+}
 
-func (m *GetTicketFieldsResponse) GetTicketFields() []*TicketField {
-	if m != nil {
-		return m.TicketFields
+func (x *GetTicketFieldsResponse) GetTicketFields() []*TicketField {
+	if x != nil {
+		return x.TicketFields
 	}
 	return nil
 }
 
 type GetSearchTitleArticlesRequest struct {
-	CountryCode          CountryCode `protobuf:"varint,1,opt,name=countryCode,enum=protobuf.CountryCode" json:"countryCode,omitempty"`
-	Locale               Locale      `protobuf:"varint,2,opt,name=locale,enum=protobuf.Locale" json:"locale,omitempty"`
-	Query                string      `protobuf:"bytes,3,opt,name=query" json:"query,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CountryCode   CountryCode            `protobuf:"varint,1,opt,name=countryCode,proto3,enum=protobuf.CountryCode" json:"countryCode,omitempty"`
+	Locale        Locale                 `protobuf:"varint,2,opt,name=locale,proto3,enum=protobuf.Locale" json:"locale,omitempty"`
+	Query         string                 `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (m *GetSearchTitleArticlesRequest) Reset()         { *m = GetSearchTitleArticlesRequest{} }
-func (m *GetSearchTitleArticlesRequest) String() string { return proto.CompactTextString(m) }
-func (*GetSearchTitleArticlesRequest) ProtoMessage()    {}
-func (*GetSearchTitleArticlesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{27}
-}
-func (m *GetSearchTitleArticlesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetSearchTitleArticlesRequest.Unmarshal(m, b)
-}
-func (m *GetSearchTitleArticlesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetSearchTitleArticlesRequest.Marshal(b, m, deterministic)
+func (x *GetSearchTitleArticlesRequest) Reset() {
+	*x = GetSearchTitleArticlesRequest{}
+	mi := &file_zendesk_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (dst *GetSearchTitleArticlesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetSearchTitleArticlesRequest.Merge(dst, src)
-}
-func (m *GetSearchTitleArticlesRequest) XXX_Size() int {
-	return xxx_messageInfo_GetSearchTitleArticlesRequest.Size(m)
+
+func (x *GetSearchTitleArticlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *GetSearchTitleArticlesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetSearchTitleArticlesRequest.DiscardUnknown(m)
+
+func (*GetSearchTitleArticlesRequest) ProtoMessage() {}
+
+func (x *GetSearchTitleArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_GetSearchTitleArticlesRequest proto.InternalMessageInfo
+// Deprecated: Use GetSearchTitleArticlesRequest.ProtoReflect.Descriptor instead.
+func (*GetSearchTitleArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{27}
+}
 
-func (m *GetSearchTitleArticlesRequest) GetCountryCode() CountryCode {
-	if m != nil {
-		return m.CountryCode
+func (x *GetSearchTitleArticlesRequest) GetCountryCode() CountryCode {
+	if x != nil {
+		return x.CountryCode
 	}
 	return CountryCode_COUNTRY_CODE_SG
 }
 
-func (m *GetSearchTitleArticlesRequest) GetLocale() Locale {
-	if m != nil {
-		return m.Locale
+func (x *GetSearchTitleArticlesRequest) GetLocale() Locale {
+	if x != nil {
+		return x.Locale
 	}
 	return Locale_LOCALE_EN_US
 }
 
-func (m *GetSearchTitleArticlesRequest) GetQuery() string {
-	if m != nil {
-		return m.Query
+func (x *GetSearchTitleArticlesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
 	}
 	return ""
 }
 
 type GetSearchTitleArticlesResponse struct {
-	Articles             []*SearchTitleArticle `protobuf:"bytes,1,rep,name=articles" json:"articles,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Articles      []*SearchTitleArticle  `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (m *GetSearchTitleArticlesResponse) Reset()         { *m = GetSearchTitleArticlesResponse{} }
-func (m *GetSearchTitleArticlesResponse) String() string { return proto.CompactTextString(m) }
-func (*GetSearchTitleArticlesResponse) ProtoMessage()    {}
-func (*GetSearchTitleArticlesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{28}
-}
-func (m *GetSearchTitleArticlesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetSearchTitleArticlesResponse.Unmarshal(m, b)
-}
-func (m *GetSearchTitleArticlesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetSearchTitleArticlesResponse.Marshal(b, m, deterministic)
+func (x *GetSearchTitleArticlesResponse) Reset() {
+	*x = GetSearchTitleArticlesResponse{}
+	mi := &file_zendesk_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (dst *GetSearchTitleArticlesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetSearchTitleArticlesResponse.Merge(dst, src)
-}
-func (m *GetSearchTitleArticlesResponse) XXX_Size() int {
-	return xxx_messageInfo_GetSearchTitleArticlesResponse.Size(m)
+
+func (x *GetSearchTitleArticlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *GetSearchTitleArticlesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetSearchTitleArticlesResponse.DiscardUnknown(m)
+
+func (*GetSearchTitleArticlesResponse) ProtoMessage() {}
+
+func (x *GetSearchTitleArticlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_GetSearchTitleArticlesResponse proto.InternalMessageInfo
+// Deprecated: Use GetSearchTitleArticlesResponse.ProtoReflect.Descriptor instead.
+func (*GetSearchTitleArticlesResponse) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{28}
+}
 
-func (m *GetSearchTitleArticlesResponse) GetArticles() []*SearchTitleArticle {
-	if m != nil {
-		return m.Articles
+func (x *GetSearchTitleArticlesResponse) GetArticles() []*SearchTitleArticle {
+	if x != nil {
+		return x.Articles
 	}
 	return nil
 }
 
 type GetSearchBodyArticlesRequest struct {
-	CountryCode          CountryCode `protobuf:"varint,1,opt,name=countryCode,enum=protobuf.CountryCode" json:"countryCode,omitempty"`
-	Locale               Locale      `protobuf:"varint,2,opt,name=locale,enum=protobuf.Locale" json:"locale,omitempty"`
-	SortOrder            SortOrder   `protobuf:"varint,3,opt,name=sortOrder,enum=protobuf.SortOrder" json:"sortOrder,omitempty"`
-	PerPage              int32       `protobuf:"varint,4,opt,name=perPage" json:"perPage,omitempty"`
-	Page                 int32       `protobuf:"varint,5,opt,name=page" json:"page,omitempty"`
-	Query                string      `protobuf:"bytes,6,opt,name=query" json:"query,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
-}
-
-func (m *GetSearchBodyArticlesRequest) Reset()         { *m = GetSearchBodyArticlesRequest{} }
-func (m *GetSearchBodyArticlesRequest) String() string { return proto.CompactTextString(m) }
-func (*GetSearchBodyArticlesRequest) ProtoMessage()    {}
-func (*GetSearchBodyArticlesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{29}
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CountryCode   CountryCode            `protobuf:"varint,1,opt,name=countryCode,proto3,enum=protobuf.CountryCode" json:"countryCode,omitempty"`
+	Locale        Locale                 `protobuf:"varint,2,opt,name=locale,proto3,enum=protobuf.Locale" json:"locale,omitempty"`
+	SortOrder     SortOrder              `protobuf:"varint,3,opt,name=sortOrder,proto3,enum=protobuf.SortOrder" json:"sortOrder,omitempty"`
+	PerPage       int32                  `protobuf:"varint,4,opt,name=perPage,proto3" json:"perPage,omitempty"`
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	Query         string                 `protobuf:"bytes,6,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
-func (m *GetSearchBodyArticlesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetSearchBodyArticlesRequest.Unmarshal(m, b)
-}
-func (m *GetSearchBodyArticlesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetSearchBodyArticlesRequest.Marshal(b, m, deterministic)
-}
-func (dst *GetSearchBodyArticlesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetSearchBodyArticlesRequest.Merge(dst, src)
+
+func (x *GetSearchBodyArticlesRequest) Reset() {
+	*x = GetSearchBodyArticlesRequest{}
+	mi := &file_zendesk_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (m *GetSearchBodyArticlesRequest) XXX_Size() int {
-	return xxx_messageInfo_GetSearchBodyArticlesRequest.Size(m)
+
+func (x *GetSearchBodyArticlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *GetSearchBodyArticlesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetSearchBodyArticlesRequest.DiscardUnknown(m)
+
+func (*GetSearchBodyArticlesRequest) ProtoMessage() {}
+
+func (x *GetSearchBodyArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_GetSearchBodyArticlesRequest proto.InternalMessageInfo
+// Deprecated: Use GetSearchBodyArticlesRequest.ProtoReflect.Descriptor instead.
+func (*GetSearchBodyArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{29}
+}
 
-func (m *GetSearchBodyArticlesRequest) GetCountryCode() CountryCode {
-	if m != nil {
-		return m.CountryCode
+func (x *GetSearchBodyArticlesRequest) GetCountryCode() CountryCode {
+	if x != nil {
+		return x.CountryCode
 	}
 	return CountryCode_COUNTRY_CODE_SG
 }
 
-func (m *GetSearchBodyArticlesRequest) GetLocale() Locale {
-	if m != nil {
-		return m.Locale
+func (x *GetSearchBodyArticlesRequest) GetLocale() Locale {
+	if x != nil {
+		return x.Locale
 	}
 	return Locale_LOCALE_EN_US
 }
 
-func (m *GetSearchBodyArticlesRequest) GetSortOrder() SortOrder {
-	if m != nil {
-		return m.SortOrder
+func (x *GetSearchBodyArticlesRequest) GetSortOrder() SortOrder {
+	if x != nil {
+		return x.SortOrder
 	}
 	return SortOrder_SORT_ORDER_ASC
 }
 
-func (m *GetSearchBodyArticlesRequest) GetPerPage() int32 {
-	if m != nil {
-		return m.PerPage
+func (x *GetSearchBodyArticlesRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
 	}
 	return 0
 }
 
-func (m *GetSearchBodyArticlesRequest) GetPage() int32 {
-	if m != nil {
-		return m.Page
+func (x *GetSearchBodyArticlesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
 	}
 	return 0
 }
 
-func (m *GetSearchBodyArticlesRequest) GetQuery() string {
-	if m != nil {
-		return m.Query
+func (x *GetSearchBodyArticlesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
 	}
 	return ""
 }
 
 type GetSearchBodyArticlesResponse struct {
-	PageInfo             *PageInfo            `protobuf:"bytes,1,opt,name=pageInfo" json:"pageInfo,omitempty"`
-	Articles             []*SearchBodyArticle `protobuf:"bytes,2,rep,name=articles" json:"articles,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageInfo      *PageInfo              `protobuf:"bytes,1,opt,name=pageInfo,proto3" json:"pageInfo,omitempty"`
+	Articles      []*SearchBodyArticle   `protobuf:"bytes,2,rep,name=articles,proto3" json:"articles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (m *GetSearchBodyArticlesResponse) Reset()         { *m = GetSearchBodyArticlesResponse{} }
-func (m *GetSearchBodyArticlesResponse) String() string { return proto.CompactTextString(m) }
-func (*GetSearchBodyArticlesResponse) ProtoMessage()    {}
-func (*GetSearchBodyArticlesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{30}
+func (x *GetSearchBodyArticlesResponse) Reset() {
+	*x = GetSearchBodyArticlesResponse{}
+	mi := &file_zendesk_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (m *GetSearchBodyArticlesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetSearchBodyArticlesResponse.Unmarshal(m, b)
-}
-func (m *GetSearchBodyArticlesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetSearchBodyArticlesResponse.Marshal(b, m, deterministic)
-}
-func (dst *GetSearchBodyArticlesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetSearchBodyArticlesResponse.Merge(dst, src)
-}
-func (m *GetSearchBodyArticlesResponse) XXX_Size() int {
-	return xxx_messageInfo_GetSearchBodyArticlesResponse.Size(m)
+
+func (x *GetSearchBodyArticlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *GetSearchBodyArticlesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetSearchBodyArticlesResponse.DiscardUnknown(m)
+
+func (*GetSearchBodyArticlesResponse) ProtoMessage() {}
+
+func (x *GetSearchBodyArticlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_GetSearchBodyArticlesResponse proto.InternalMessageInfo
+// Deprecated: Use GetSearchBodyArticlesResponse.ProtoReflect.Descriptor instead.
+func (*GetSearchBodyArticlesResponse) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{30}
+}
 
-func (m *GetSearchBodyArticlesResponse) GetPageInfo() *PageInfo {
-	if m != nil {
-		return m.PageInfo
+func (x *GetSearchBodyArticlesResponse) GetPageInfo() *PageInfo {
+	if x != nil {
+		return x.PageInfo
 	}
 	return nil
 }
 
-func (m *GetSearchBodyArticlesResponse) GetArticles() []*SearchBodyArticle {
-	if m != nil {
-		return m.Articles
+func (x *GetSearchBodyArticlesResponse) GetArticles() []*SearchBodyArticle {
+	if x != nil {
+		return x.Articles
 	}
 	return nil
 }
 
 type GetStatusRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (m *GetStatusRequest) Reset()         { *m = GetStatusRequest{} }
-func (m *GetStatusRequest) String() string { return proto.CompactTextString(m) }
-func (*GetStatusRequest) ProtoMessage()    {}
-func (*GetStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{31}
-}
-func (m *GetStatusRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetStatusRequest.Unmarshal(m, b)
+func (x *GetStatusRequest) Reset() {
+	*x = GetStatusRequest{}
+	mi := &file_zendesk_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (m *GetStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetStatusRequest.Marshal(b, m, deterministic)
-}
-func (dst *GetStatusRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetStatusRequest.Merge(dst, src)
-}
-func (m *GetStatusRequest) XXX_Size() int {
-	return xxx_messageInfo_GetStatusRequest.Size(m)
-}
-func (m *GetStatusRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetStatusRequest.DiscardUnknown(m)
+
+func (x *GetStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var xxx_messageInfo_GetStatusRequest proto.InternalMessageInfo
+func (*GetStatusRequest) ProtoMessage() {}
 
-type GetStatusResponse struct {
-	GoVersion            string               `protobuf:"bytes,1,opt,name=goVersion" json:"goVersion,omitempty"`
-	AppVersion           string               `protobuf:"bytes,2,opt,name=appVersion" json:"appVersion,omitempty"`
-	ServerTime           *timestamp.Timestamp `protobuf:"bytes,3,opt,name=serverTime" json:"serverTime,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+func (x *GetStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (m *GetStatusResponse) Reset()         { *m = GetStatusResponse{} }
-func (m *GetStatusResponse) String() string { return proto.CompactTextString(m) }
-func (*GetStatusResponse) ProtoMessage()    {}
-func (*GetStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{32}
-}
-func (m *GetStatusResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetStatusResponse.Unmarshal(m, b)
+// Deprecated: Use GetStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetStatusRequest) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{31}
 }
-func (m *GetStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetStatusResponse.Marshal(b, m, deterministic)
+
+type GetStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoVersion     string                 `protobuf:"bytes,1,opt,name=goVersion,proto3" json:"goVersion,omitempty"`
+	AppVersion    string                 `protobuf:"bytes,2,opt,name=appVersion,proto3" json:"appVersion,omitempty"`
+	ServerTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=serverTime,proto3" json:"serverTime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
-func (dst *GetStatusResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetStatusResponse.Merge(dst, src)
+
+func (x *GetStatusResponse) Reset() {
+	*x = GetStatusResponse{}
+	mi := &file_zendesk_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (m *GetStatusResponse) XXX_Size() int {
-	return xxx_messageInfo_GetStatusResponse.Size(m)
+
+func (x *GetStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *GetStatusResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetStatusResponse.DiscardUnknown(m)
+
+func (*GetStatusResponse) ProtoMessage() {}
+
+func (x *GetStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_GetStatusResponse proto.InternalMessageInfo
+// Deprecated: Use GetStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetStatusResponse) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{32}
+}
 
-func (m *GetStatusResponse) GetGoVersion() string {
-	if m != nil {
-		return m.GoVersion
+func (x *GetStatusResponse) GetGoVersion() string {
+	if x != nil {
+		return x.GoVersion
 	}
 	return ""
 }
 
-func (m *GetStatusResponse) GetAppVersion() string {
-	if m != nil {
-		return m.AppVersion
+func (x *GetStatusResponse) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
 	}
 	return ""
 }
 
-func (m *GetStatusResponse) GetServerTime() *timestamp.Timestamp {
-	if m != nil {
-		return m.ServerTime
+func (x *GetStatusResponse) GetServerTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ServerTime
 	}
 	return nil
 }
 
 type SetCreateRequestRequest struct {
-	CountryCode          CountryCode                   `protobuf:"varint,1,opt,name=countryCode,enum=protobuf.CountryCode" json:"countryCode,omitempty"`
-	Data                 *SetCreateRequestRequest_Data `protobuf:"bytes,2,opt,name=data" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
-	XXX_unrecognized     []byte                        `json:"-"`
-	XXX_sizecache        int32                         `json:"-"`
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	CountryCode   CountryCode                   `protobuf:"varint,1,opt,name=countryCode,proto3,enum=protobuf.CountryCode" json:"countryCode,omitempty"`
+	Data          *SetCreateRequestRequest_Data `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (m *SetCreateRequestRequest) Reset()         { *m = SetCreateRequestRequest{} }
-func (m *SetCreateRequestRequest) String() string { return proto.CompactTextString(m) }
-func (*SetCreateRequestRequest) ProtoMessage()    {}
-func (*SetCreateRequestRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{33}
-}
-func (m *SetCreateRequestRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SetCreateRequestRequest.Unmarshal(m, b)
+func (x *SetCreateRequestRequest) Reset() {
+	*x = SetCreateRequestRequest{}
+	mi := &file_zendesk_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (m *SetCreateRequestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SetCreateRequestRequest.Marshal(b, m, deterministic)
-}
-func (dst *SetCreateRequestRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetCreateRequestRequest.Merge(dst, src)
-}
-func (m *SetCreateRequestRequest) XXX_Size() int {
-	return xxx_messageInfo_SetCreateRequestRequest.Size(m)
+
+func (x *SetCreateRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *SetCreateRequestRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetCreateRequestRequest.DiscardUnknown(m)
+
+func (*SetCreateRequestRequest) ProtoMessage() {}
+
+func (x *SetCreateRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_SetCreateRequestRequest proto.InternalMessageInfo
+// Deprecated: Use SetCreateRequestRequest.ProtoReflect.Descriptor instead.
+func (*SetCreateRequestRequest) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{33}
+}
 
-func (m *SetCreateRequestRequest) GetCountryCode() CountryCode {
-	if m != nil {
-		return m.CountryCode
+func (x *SetCreateRequestRequest) GetCountryCode() CountryCode {
+	if x != nil {
+		return x.CountryCode
 	}
 	return CountryCode_COUNTRY_CODE_SG
 }
 
-func (m *SetCreateRequestRequest) GetData() *SetCreateRequestRequest_Data {
-	if m != nil {
-		return m.Data
+func (x *SetCreateRequestRequest) GetData() *SetCreateRequestRequest_Data {
+	if x != nil {
+		return x.Data
 	}
 	return nil
 }
 
-type SetCreateRequestRequest_Data struct {
-	Request              *SetCreateRequestRequest_Data_Request `protobuf:"bytes,1,opt,name=request" json:"request,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
-	XXX_unrecognized     []byte                                `json:"-"`
-	XXX_sizecache        int32                                 `json:"-"`
+type SetCreateRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (m *SetCreateRequestRequest_Data) Reset()         { *m = SetCreateRequestRequest_Data{} }
-func (m *SetCreateRequestRequest_Data) String() string { return proto.CompactTextString(m) }
-func (*SetCreateRequestRequest_Data) ProtoMessage()    {}
-func (*SetCreateRequestRequest_Data) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{33, 0}
-}
-func (m *SetCreateRequestRequest_Data) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SetCreateRequestRequest_Data.Unmarshal(m, b)
-}
-func (m *SetCreateRequestRequest_Data) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SetCreateRequestRequest_Data.Marshal(b, m, deterministic)
+func (x *SetCreateRequestResponse) Reset() {
+	*x = SetCreateRequestResponse{}
+	mi := &file_zendesk_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (dst *SetCreateRequestRequest_Data) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetCreateRequestRequest_Data.Merge(dst, src)
-}
-func (m *SetCreateRequestRequest_Data) XXX_Size() int {
-	return xxx_messageInfo_SetCreateRequestRequest_Data.Size(m)
-}
-func (m *SetCreateRequestRequest_Data) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetCreateRequestRequest_Data.DiscardUnknown(m)
+
+func (x *SetCreateRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var xxx_messageInfo_SetCreateRequestRequest_Data proto.InternalMessageInfo
+func (*SetCreateRequestResponse) ProtoMessage() {}
 
-func (m *SetCreateRequestRequest_Data) GetRequest() *SetCreateRequestRequest_Data_Request {
-	if m != nil {
-		return m.Request
+func (x *SetCreateRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type SetCreateRequestRequest_Data_Request struct {
-	Comment              *SetCreateRequestRequest_Data_Request_Comment       `protobuf:"bytes,2,opt,name=comment" json:"comment,omitempty"`
-	Requester            *SetCreateRequestRequest_Data_Request_Requester     `protobuf:"bytes,3,opt,name=requester" json:"requester,omitempty"`
-	Subject              string                                              `protobuf:"bytes,4,opt,name=subject" json:"subject,omitempty"`
-	TicketFormId         string                                              `protobuf:"bytes,5,opt,name=ticketFormId" json:"ticketFormId,omitempty"`
-	CustomFields         []*SetCreateRequestRequest_Data_Request_CustomField `protobuf:"bytes,6,rep,name=customFields" json:"customFields,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                            `json:"-"`
-	XXX_unrecognized     []byte                                              `json:"-"`
-	XXX_sizecache        int32                                               `json:"-"`
-}
-
-func (m *SetCreateRequestRequest_Data_Request) Reset()         { *m = SetCreateRequestRequest_Data_Request{} }
-func (m *SetCreateRequestRequest_Data_Request) String() string { return proto.CompactTextString(m) }
-func (*SetCreateRequestRequest_Data_Request) ProtoMessage()    {}
-func (*SetCreateRequestRequest_Data_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{33, 0, 0}
-}
-func (m *SetCreateRequestRequest_Data_Request) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SetCreateRequestRequest_Data_Request.Unmarshal(m, b)
+// Deprecated: Use SetCreateRequestResponse.ProtoReflect.Descriptor instead.
+func (*SetCreateRequestResponse) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{34}
 }
-func (m *SetCreateRequestRequest_Data_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SetCreateRequestRequest_Data_Request.Marshal(b, m, deterministic)
+
+func (x *SetCreateRequestResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
-func (dst *SetCreateRequestRequest_Data_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetCreateRequestRequest_Data_Request.Merge(dst, src)
+
+type SetVoteArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CountryCode   CountryCode            `protobuf:"varint,1,opt,name=countryCode,proto3,enum=protobuf.CountryCode" json:"countryCode,omitempty"`
+	Locale        Locale                 `protobuf:"varint,2,opt,name=locale,proto3,enum=protobuf.Locale" json:"locale,omitempty"`
+	ArticleId     string                 `protobuf:"bytes,3,opt,name=articleId,proto3" json:"articleId,omitempty"`
+	Vote          Vote                   `protobuf:"varint,4,opt,name=vote,proto3,enum=protobuf.Vote" json:"vote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
-func (m *SetCreateRequestRequest_Data_Request) XXX_Size() int {
-	return xxx_messageInfo_SetCreateRequestRequest_Data_Request.Size(m)
+
+func (x *SetVoteArticleRequest) Reset() {
+	*x = SetVoteArticleRequest{}
+	mi := &file_zendesk_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (m *SetCreateRequestRequest_Data_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetCreateRequestRequest_Data_Request.DiscardUnknown(m)
+
+func (x *SetVoteArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var xxx_messageInfo_SetCreateRequestRequest_Data_Request proto.InternalMessageInfo
+func (*SetVoteArticleRequest) ProtoMessage() {}
 
-func (m *SetCreateRequestRequest_Data_Request) GetComment() *SetCreateRequestRequest_Data_Request_Comment {
-	if m != nil {
-		return m.Comment
+func (x *SetVoteArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVoteArticleRequest.ProtoReflect.Descriptor instead.
+func (*SetVoteArticleRequest) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{35}
 }
 
-func (m *SetCreateRequestRequest_Data_Request) GetRequester() *SetCreateRequestRequest_Data_Request_Requester {
-	if m != nil {
-		return m.Requester
+func (x *SetVoteArticleRequest) GetCountryCode() CountryCode {
+	if x != nil {
+		return x.CountryCode
 	}
-	return nil
+	return CountryCode_COUNTRY_CODE_SG
 }
 
-func (m *SetCreateRequestRequest_Data_Request) GetSubject() string {
-	if m != nil {
-		return m.Subject
+func (x *SetVoteArticleRequest) GetLocale() Locale {
+	if x != nil {
+		return x.Locale
 	}
-	return ""
+	return Locale_LOCALE_EN_US
 }
 
-func (m *SetCreateRequestRequest_Data_Request) GetTicketFormId() string {
-	if m != nil {
-		return m.TicketFormId
+func (x *SetVoteArticleRequest) GetArticleId() string {
+	if x != nil {
+		return x.ArticleId
 	}
 	return ""
 }
 
-func (m *SetCreateRequestRequest_Data_Request) GetCustomFields() []*SetCreateRequestRequest_Data_Request_CustomField {
-	if m != nil {
-		return m.CustomFields
+func (x *SetVoteArticleRequest) GetVote() Vote {
+	if x != nil {
+		return x.Vote
 	}
-	return nil
+	return Vote_VOTE_UP
 }
 
-type SetCreateRequestRequest_Data_Request_Comment struct {
-	Body                 string   `protobuf:"bytes,1,opt,name=body" json:"body,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type SetVoteArticleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Article       *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (m *SetCreateRequestRequest_Data_Request_Comment) Reset() {
-	*m = SetCreateRequestRequest_Data_Request_Comment{}
-}
-func (m *SetCreateRequestRequest_Data_Request_Comment) String() string {
-	return proto.CompactTextString(m)
-}
-func (*SetCreateRequestRequest_Data_Request_Comment) ProtoMessage() {}
-func (*SetCreateRequestRequest_Data_Request_Comment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{33, 0, 0, 0}
-}
-func (m *SetCreateRequestRequest_Data_Request_Comment) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SetCreateRequestRequest_Data_Request_Comment.Unmarshal(m, b)
-}
-func (m *SetCreateRequestRequest_Data_Request_Comment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SetCreateRequestRequest_Data_Request_Comment.Marshal(b, m, deterministic)
-}
-func (dst *SetCreateRequestRequest_Data_Request_Comment) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetCreateRequestRequest_Data_Request_Comment.Merge(dst, src)
+func (x *SetVoteArticleResponse) Reset() {
+	*x = SetVoteArticleResponse{}
+	mi := &file_zendesk_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (m *SetCreateRequestRequest_Data_Request_Comment) XXX_Size() int {
-	return xxx_messageInfo_SetCreateRequestRequest_Data_Request_Comment.Size(m)
-}
-func (m *SetCreateRequestRequest_Data_Request_Comment) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetCreateRequestRequest_Data_Request_Comment.DiscardUnknown(m)
+
+func (x *SetVoteArticleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var xxx_messageInfo_SetCreateRequestRequest_Data_Request_Comment proto.InternalMessageInfo
+func (*SetVoteArticleResponse) ProtoMessage() {}
 
-func (m *SetCreateRequestRequest_Data_Request_Comment) GetBody() string {
-	if m != nil {
-		return m.Body
+func (x *SetVoteArticleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type SetCreateRequestRequest_Data_Request_CustomField struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
-	Value                string   `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// Deprecated: Use SetVoteArticleResponse.ProtoReflect.Descriptor instead.
+func (*SetVoteArticleResponse) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{36}
 }
 
-func (m *SetCreateRequestRequest_Data_Request_CustomField) Reset() {
-	*m = SetCreateRequestRequest_Data_Request_CustomField{}
-}
-func (m *SetCreateRequestRequest_Data_Request_CustomField) String() string {
-	return proto.CompactTextString(m)
-}
-func (*SetCreateRequestRequest_Data_Request_CustomField) ProtoMessage() {}
-func (*SetCreateRequestRequest_Data_Request_CustomField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{33, 0, 0, 1}
-}
-func (m *SetCreateRequestRequest_Data_Request_CustomField) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SetCreateRequestRequest_Data_Request_CustomField.Unmarshal(m, b)
+func (x *SetVoteArticleResponse) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
 }
-func (m *SetCreateRequestRequest_Data_Request_CustomField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SetCreateRequestRequest_Data_Request_CustomField.Marshal(b, m, deterministic)
+
+type SetForceSyncRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
-func (dst *SetCreateRequestRequest_Data_Request_CustomField) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetCreateRequestRequest_Data_Request_CustomField.Merge(dst, src)
+
+func (x *SetForceSyncRequest) Reset() {
+	*x = SetForceSyncRequest{}
+	mi := &file_zendesk_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (m *SetCreateRequestRequest_Data_Request_CustomField) XXX_Size() int {
-	return xxx_messageInfo_SetCreateRequestRequest_Data_Request_CustomField.Size(m)
+
+func (x *SetForceSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *SetCreateRequestRequest_Data_Request_CustomField) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetCreateRequestRequest_Data_Request_CustomField.DiscardUnknown(m)
+
+func (*SetForceSyncRequest) ProtoMessage() {}
+
+func (x *SetForceSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_SetCreateRequestRequest_Data_Request_CustomField proto.InternalMessageInfo
+// Deprecated: Use SetForceSyncRequest.ProtoReflect.Descriptor instead.
+func (*SetForceSyncRequest) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{37}
+}
 
-func (m *SetCreateRequestRequest_Data_Request_CustomField) GetId() string {
-	if m != nil {
-		return m.Id
+func (x *SetForceSyncRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
 	}
 	return ""
 }
 
-func (m *SetCreateRequestRequest_Data_Request_CustomField) GetValue() string {
-	if m != nil {
-		return m.Value
+func (x *SetForceSyncRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
 	}
 	return ""
 }
 
-type SetCreateRequestRequest_Data_Request_Requester struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	Email                string   `protobuf:"bytes,2,opt,name=email" json:"email,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type SetForceSyncResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (m *SetCreateRequestRequest_Data_Request_Requester) Reset() {
-	*m = SetCreateRequestRequest_Data_Request_Requester{}
+func (x *SetForceSyncResponse) Reset() {
+	*x = SetForceSyncResponse{}
+	mi := &file_zendesk_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (m *SetCreateRequestRequest_Data_Request_Requester) String() string {
-	return proto.CompactTextString(m)
+
+func (x *SetForceSyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (*SetCreateRequestRequest_Data_Request_Requester) ProtoMessage() {}
-func (*SetCreateRequestRequest_Data_Request_Requester) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{33, 0, 0, 2}
+
+func (*SetForceSyncResponse) ProtoMessage() {}
+
+func (x *SetForceSyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-func (m *SetCreateRequestRequest_Data_Request_Requester) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SetCreateRequestRequest_Data_Request_Requester.Unmarshal(m, b)
+
+// Deprecated: Use SetForceSyncResponse.ProtoReflect.Descriptor instead.
+func (*SetForceSyncResponse) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{38}
 }
-func (m *SetCreateRequestRequest_Data_Request_Requester) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SetCreateRequestRequest_Data_Request_Requester.Marshal(b, m, deterministic)
+
+func (x *SetForceSyncResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
-func (dst *SetCreateRequestRequest_Data_Request_Requester) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetCreateRequestRequest_Data_Request_Requester.Merge(dst, src)
+
+type StreamArticlesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CountryCode   CountryCode            `protobuf:"varint,1,opt,name=countryCode,proto3,enum=protobuf.CountryCode" json:"countryCode,omitempty"`
+	Locale        Locale                 `protobuf:"varint,2,opt,name=locale,proto3,enum=protobuf.Locale" json:"locale,omitempty"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
-func (m *SetCreateRequestRequest_Data_Request_Requester) XXX_Size() int {
-	return xxx_messageInfo_SetCreateRequestRequest_Data_Request_Requester.Size(m)
+
+func (x *StreamArticlesRequest) Reset() {
+	*x = StreamArticlesRequest{}
+	mi := &file_zendesk_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (m *SetCreateRequestRequest_Data_Request_Requester) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetCreateRequestRequest_Data_Request_Requester.DiscardUnknown(m)
+
+func (x *StreamArticlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var xxx_messageInfo_SetCreateRequestRequest_Data_Request_Requester proto.InternalMessageInfo
+func (*StreamArticlesRequest) ProtoMessage() {}
 
-func (m *SetCreateRequestRequest_Data_Request_Requester) GetName() string {
-	if m != nil {
-		return m.Name
+func (x *StreamArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (m *SetCreateRequestRequest_Data_Request_Requester) GetEmail() string {
-	if m != nil {
-		return m.Email
-	}
-	return ""
+// Deprecated: Use StreamArticlesRequest.ProtoReflect.Descriptor instead.
+func (*StreamArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{39}
 }
 
-type SetCreateRequestResponse struct {
-	Status               string   `protobuf:"bytes,1,opt,name=status" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (x *StreamArticlesRequest) GetCountryCode() CountryCode {
+	if x != nil {
+		return x.CountryCode
+	}
+	return CountryCode_COUNTRY_CODE_SG
 }
 
-func (m *SetCreateRequestResponse) Reset()         { *m = SetCreateRequestResponse{} }
-func (m *SetCreateRequestResponse) String() string { return proto.CompactTextString(m) }
-func (*SetCreateRequestResponse) ProtoMessage()    {}
-func (*SetCreateRequestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{34}
-}
-func (m *SetCreateRequestResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SetCreateRequestResponse.Unmarshal(m, b)
+func (x *StreamArticlesRequest) GetLocale() Locale {
+	if x != nil {
+		return x.Locale
+	}
+	return Locale_LOCALE_EN_US
 }
-func (m *SetCreateRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SetCreateRequestResponse.Marshal(b, m, deterministic)
+
+func (x *StreamArticlesRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
 }
-func (dst *SetCreateRequestResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetCreateRequestResponse.Merge(dst, src)
+
+type StreamArticlesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Article       *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
-func (m *SetCreateRequestResponse) XXX_Size() int {
-	return xxx_messageInfo_SetCreateRequestResponse.Size(m)
+
+func (x *StreamArticlesResponse) Reset() {
+	*x = StreamArticlesResponse{}
+	mi := &file_zendesk_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (m *SetCreateRequestResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetCreateRequestResponse.DiscardUnknown(m)
+
+func (x *StreamArticlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var xxx_messageInfo_SetCreateRequestResponse proto.InternalMessageInfo
+func (*StreamArticlesResponse) ProtoMessage() {}
 
-func (m *SetCreateRequestResponse) GetStatus() string {
-	if m != nil {
-		return m.Status
+func (x *StreamArticlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type SetVoteArticleRequest struct {
-	CountryCode          CountryCode `protobuf:"varint,1,opt,name=countryCode,enum=protobuf.CountryCode" json:"countryCode,omitempty"`
-	Locale               Locale      `protobuf:"varint,2,opt,name=locale,enum=protobuf.Locale" json:"locale,omitempty"`
-	ArticleId            string      `protobuf:"bytes,3,opt,name=articleId" json:"articleId,omitempty"`
-	Vote                 Vote        `protobuf:"varint,4,opt,name=vote,enum=protobuf.Vote" json:"vote,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
-}
-
-func (m *SetVoteArticleRequest) Reset()         { *m = SetVoteArticleRequest{} }
-func (m *SetVoteArticleRequest) String() string { return proto.CompactTextString(m) }
-func (*SetVoteArticleRequest) ProtoMessage()    {}
-func (*SetVoteArticleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{35}
+// Deprecated: Use StreamArticlesResponse.ProtoReflect.Descriptor instead.
+func (*StreamArticlesResponse) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{40}
 }
-func (m *SetVoteArticleRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SetVoteArticleRequest.Unmarshal(m, b)
+
+func (x *StreamArticlesResponse) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
 }
-func (m *SetVoteArticleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SetVoteArticleRequest.Marshal(b, m, deterministic)
+
+type WatchChangesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CountryCode   CountryCode            `protobuf:"varint,1,opt,name=countryCode,proto3,enum=protobuf.CountryCode" json:"countryCode,omitempty"`
+	Locale        Locale                 `protobuf:"varint,2,opt,name=locale,proto3,enum=protobuf.Locale" json:"locale,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,3,opt,name=resumeToken,proto3" json:"resumeToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
-func (dst *SetVoteArticleRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetVoteArticleRequest.Merge(dst, src)
+
+func (x *WatchChangesRequest) Reset() {
+	*x = WatchChangesRequest{}
+	mi := &file_zendesk_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (m *SetVoteArticleRequest) XXX_Size() int {
-	return xxx_messageInfo_SetVoteArticleRequest.Size(m)
+
+func (x *WatchChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *SetVoteArticleRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetVoteArticleRequest.DiscardUnknown(m)
+
+func (*WatchChangesRequest) ProtoMessage() {}
+
+func (x *WatchChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_SetVoteArticleRequest proto.InternalMessageInfo
+// Deprecated: Use WatchChangesRequest.ProtoReflect.Descriptor instead.
+func (*WatchChangesRequest) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{41}
+}
 
-func (m *SetVoteArticleRequest) GetCountryCode() CountryCode {
-	if m != nil {
-		return m.CountryCode
+func (x *WatchChangesRequest) GetCountryCode() CountryCode {
+	if x != nil {
+		return x.CountryCode
 	}
 	return CountryCode_COUNTRY_CODE_SG
 }
 
-func (m *SetVoteArticleRequest) GetLocale() Locale {
-	if m != nil {
-		return m.Locale
+func (x *WatchChangesRequest) GetLocale() Locale {
+	if x != nil {
+		return x.Locale
 	}
 	return Locale_LOCALE_EN_US
 }
 
-func (m *SetVoteArticleRequest) GetArticleId() string {
-	if m != nil {
-		return m.ArticleId
+func (x *WatchChangesRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
 	}
 	return ""
 }
 
-func (m *SetVoteArticleRequest) GetVote() Vote {
-	if m != nil {
-		return m.Vote
-	}
-	return Vote_VOTE_UP
+type WatchChangesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResumeToken   string                 `protobuf:"bytes,1,opt,name=resumeToken,proto3" json:"resumeToken,omitempty"`
+	Type          ChangeType             `protobuf:"varint,2,opt,name=type,proto3,enum=protobuf.ChangeType" json:"type,omitempty"`
+	CountryCode   string                 `protobuf:"bytes,3,opt,name=countryCode,proto3" json:"countryCode,omitempty"`
+	Locale        string                 `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"`
+	Ids           []string               `protobuf:"bytes,5,rep,name=ids,proto3" json:"ids,omitempty"`
+	SectionId     string                 `protobuf:"bytes,6,opt,name=sectionId,proto3" json:"sectionId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type SetVoteArticleResponse struct {
-	Article              *Article `protobuf:"bytes,1,opt,name=article" json:"article,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (x *WatchChangesResponse) Reset() {
+	*x = WatchChangesResponse{}
+	mi := &file_zendesk_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (m *SetVoteArticleResponse) Reset()         { *m = SetVoteArticleResponse{} }
-func (m *SetVoteArticleResponse) String() string { return proto.CompactTextString(m) }
-func (*SetVoteArticleResponse) ProtoMessage()    {}
-func (*SetVoteArticleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{36}
-}
-func (m *SetVoteArticleResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SetVoteArticleResponse.Unmarshal(m, b)
-}
-func (m *SetVoteArticleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SetVoteArticleResponse.Marshal(b, m, deterministic)
-}
-func (dst *SetVoteArticleResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetVoteArticleResponse.Merge(dst, src)
-}
-func (m *SetVoteArticleResponse) XXX_Size() int {
-	return xxx_messageInfo_SetVoteArticleResponse.Size(m)
-}
-func (m *SetVoteArticleResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetVoteArticleResponse.DiscardUnknown(m)
+func (x *WatchChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var xxx_messageInfo_SetVoteArticleResponse proto.InternalMessageInfo
+func (*WatchChangesResponse) ProtoMessage() {}
 
-func (m *SetVoteArticleResponse) GetArticle() *Article {
-	if m != nil {
-		return m.Article
+func (x *WatchChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type SetForceSyncRequest struct {
-	Username             string   `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
-	Password             string   `protobuf:"bytes,2,opt,name=password" json:"password,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// Deprecated: Use WatchChangesResponse.ProtoReflect.Descriptor instead.
+func (*WatchChangesResponse) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{42}
 }
 
-func (m *SetForceSyncRequest) Reset()         { *m = SetForceSyncRequest{} }
-func (m *SetForceSyncRequest) String() string { return proto.CompactTextString(m) }
-func (*SetForceSyncRequest) ProtoMessage()    {}
-func (*SetForceSyncRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{37}
-}
-func (m *SetForceSyncRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SetForceSyncRequest.Unmarshal(m, b)
-}
-func (m *SetForceSyncRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SetForceSyncRequest.Marshal(b, m, deterministic)
-}
-func (dst *SetForceSyncRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetForceSyncRequest.Merge(dst, src)
-}
-func (m *SetForceSyncRequest) XXX_Size() int {
-	return xxx_messageInfo_SetForceSyncRequest.Size(m)
-}
-func (m *SetForceSyncRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetForceSyncRequest.DiscardUnknown(m)
+func (x *WatchChangesResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
 }
 
-var xxx_messageInfo_SetForceSyncRequest proto.InternalMessageInfo
+func (x *WatchChangesResponse) GetType() ChangeType {
+	if x != nil {
+		return x.Type
+	}
+	return ChangeType_CHANGE_TYPE_CATEGORIES_CHANGED
+}
 
-func (m *SetForceSyncRequest) GetUsername() string {
-	if m != nil {
-		return m.Username
+func (x *WatchChangesResponse) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
 	}
 	return ""
 }
 
-func (m *SetForceSyncRequest) GetPassword() string {
-	if m != nil {
-		return m.Password
+func (x *WatchChangesResponse) GetLocale() string {
+	if x != nil {
+		return x.Locale
 	}
 	return ""
 }
 
-type SetForceSyncResponse struct {
-	Status               string   `protobuf:"bytes,1,opt,name=status" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (x *WatchChangesResponse) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
 }
 
-func (m *SetForceSyncResponse) Reset()         { *m = SetForceSyncResponse{} }
-func (m *SetForceSyncResponse) String() string { return proto.CompactTextString(m) }
-func (*SetForceSyncResponse) ProtoMessage()    {}
-func (*SetForceSyncResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_zendesk_5b1f35beb19b3f1c, []int{38}
-}
-func (m *SetForceSyncResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SetForceSyncResponse.Unmarshal(m, b)
-}
-func (m *SetForceSyncResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SetForceSyncResponse.Marshal(b, m, deterministic)
+func (x *WatchChangesResponse) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
 }
-func (dst *SetForceSyncResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetForceSyncResponse.Merge(dst, src)
+
+type SetCreateRequestRequest_Data struct {
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	Request       *SetCreateRequestRequest_Data_Request `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
-func (m *SetForceSyncResponse) XXX_Size() int {
-	return xxx_messageInfo_SetForceSyncResponse.Size(m)
+
+func (x *SetCreateRequestRequest_Data) Reset() {
+	*x = SetCreateRequestRequest_Data{}
+	mi := &file_zendesk_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (m *SetForceSyncResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetForceSyncResponse.DiscardUnknown(m)
+
+func (x *SetCreateRequestRequest_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var xxx_messageInfo_SetForceSyncResponse proto.InternalMessageInfo
+func (*SetCreateRequestRequest_Data) ProtoMessage() {}
 
-func (m *SetForceSyncResponse) GetStatus() string {
-	if m != nil {
-		return m.Status
+func (x *SetCreateRequestRequest_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func init() {
-	proto.RegisterType((*Category)(nil), "protobuf.Category")
-	proto.RegisterType((*Section)(nil), "protobuf.Section")
-	proto.RegisterType((*Article)(nil), "protobuf.Article")
-	proto.RegisterType((*TicketField)(nil), "protobuf.TicketField")
-	proto.RegisterType((*CustomFieldOption)(nil), "protobuf.CustomFieldOption")
-	proto.RegisterType((*SystemFieldOption)(nil), "protobuf.SystemFieldOption")
-	proto.RegisterType((*SearchTitleArticle)(nil), "protobuf.SearchTitleArticle")
-	proto.RegisterType((*SearchBodyArticle)(nil), "protobuf.SearchBodyArticle")
-	proto.RegisterType((*PageInfo)(nil), "protobuf.PageInfo")
-	proto.RegisterType((*GetCategoriesRequest)(nil), "protobuf.GetCategoriesRequest")
-	proto.RegisterType((*GetCategoriesResponse)(nil), "protobuf.GetCategoriesResponse")
-	proto.RegisterType((*GetCategoryRequest)(nil), "protobuf.GetCategoryRequest")
-	proto.RegisterType((*GetCategoryResponse)(nil), "protobuf.GetCategoryResponse")
-	proto.RegisterType((*GetSectionsRequest)(nil), "protobuf.GetSectionsRequest")
-	proto.RegisterType((*GetSectionsResponse)(nil), "protobuf.GetSectionsResponse")
-	proto.RegisterType((*GetSectionRequest)(nil), "protobuf.GetSectionRequest")
-	proto.RegisterType((*GetSectionResponse)(nil), "protobuf.GetSectionResponse")
-	proto.RegisterType((*GetArticlesRequest)(nil), "protobuf.GetArticlesRequest")
-	proto.RegisterType((*GetArticlesResponse)(nil), "protobuf.GetArticlesResponse")
-	proto.RegisterType((*GetTopArticlesRequest)(nil), "protobuf.GetTopArticlesRequest")
-	proto.RegisterType((*GetTopArticlesResponse)(nil), "protobuf.GetTopArticlesResponse")
-	proto.RegisterType((*GetArticleRequest)(nil), "protobuf.GetArticleRequest")
-	proto.RegisterType((*GetArticleResponse)(nil), "protobuf.GetArticleResponse")
-	proto.RegisterType((*GetTicketFormRequest)(nil), "protobuf.GetTicketFormRequest")
-	proto.RegisterType((*GetTicketFormResponse)(nil), "protobuf.GetTicketFormResponse")
-	proto.RegisterType((*GetTicketFieldsRequest)(nil), "protobuf.GetTicketFieldsRequest")
-	proto.RegisterType((*GetTicketFieldsResponse)(nil), "protobuf.GetTicketFieldsResponse")
-	proto.RegisterType((*GetSearchTitleArticlesRequest)(nil), "protobuf.GetSearchTitleArticlesRequest")
-	proto.RegisterType((*GetSearchTitleArticlesResponse)(nil), "protobuf.GetSearchTitleArticlesResponse")
-	proto.RegisterType((*GetSearchBodyArticlesRequest)(nil), "protobuf.GetSearchBodyArticlesRequest")
-	proto.RegisterType((*GetSearchBodyArticlesResponse)(nil), "protobuf.GetSearchBodyArticlesResponse")
-	proto.RegisterType((*GetStatusRequest)(nil), "protobuf.GetStatusRequest")
-	proto.RegisterType((*GetStatusResponse)(nil), "protobuf.GetStatusResponse")
-	proto.RegisterType((*SetCreateRequestRequest)(nil), "protobuf.SetCreateRequestRequest")
-	proto.RegisterType((*SetCreateRequestRequest_Data)(nil), "protobuf.SetCreateRequestRequest.Data")
-	proto.RegisterType((*SetCreateRequestRequest_Data_Request)(nil), "protobuf.SetCreateRequestRequest.Data.Request")
-	proto.RegisterType((*SetCreateRequestRequest_Data_Request_Comment)(nil), "protobuf.SetCreateRequestRequest.Data.Request.Comment")
-	proto.RegisterType((*SetCreateRequestRequest_Data_Request_CustomField)(nil), "protobuf.SetCreateRequestRequest.Data.Request.CustomField")
-	proto.RegisterType((*SetCreateRequestRequest_Data_Request_Requester)(nil), "protobuf.SetCreateRequestRequest.Data.Request.Requester")
-	proto.RegisterType((*SetCreateRequestResponse)(nil), "protobuf.SetCreateRequestResponse")
-	proto.RegisterType((*SetVoteArticleRequest)(nil), "protobuf.SetVoteArticleRequest")
-	proto.RegisterType((*SetVoteArticleResponse)(nil), "protobuf.SetVoteArticleResponse")
-	proto.RegisterType((*SetForceSyncRequest)(nil), "protobuf.SetForceSyncRequest")
-	proto.RegisterType((*SetForceSyncResponse)(nil), "protobuf.SetForceSyncResponse")
-	proto.RegisterEnum("protobuf.CountryCode", CountryCode_name, CountryCode_value)
-	proto.RegisterEnum("protobuf.Locale", Locale_name, Locale_value)
-	proto.RegisterEnum("protobuf.SortBy", SortBy_name, SortBy_value)
-	proto.RegisterEnum("protobuf.SortOrder", SortOrder_name, SortOrder_value)
-	proto.RegisterEnum("protobuf.Vote", Vote_name, Vote_value)
-}
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// Client API for Zendesk service
-
-type ZendeskClient interface {
-	GetCategories(ctx context.Context, in *GetCategoriesRequest, opts ...grpc.CallOption) (*GetCategoriesResponse, error)
-	GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...grpc.CallOption) (*GetCategoryResponse, error)
-	GetSections(ctx context.Context, in *GetSectionsRequest, opts ...grpc.CallOption) (*GetSectionsResponse, error)
-	GetSection(ctx context.Context, in *GetSectionRequest, opts ...grpc.CallOption) (*GetSectionResponse, error)
-	GetArticles(ctx context.Context, in *GetArticlesRequest, opts ...grpc.CallOption) (*GetArticlesResponse, error)
-	GetTopArticles(ctx context.Context, in *GetTopArticlesRequest, opts ...grpc.CallOption) (*GetTopArticlesResponse, error)
-	GetArticle(ctx context.Context, in *GetArticleRequest, opts ...grpc.CallOption) (*GetArticleResponse, error)
-	GetTicketForm(ctx context.Context, in *GetTicketFormRequest, opts ...grpc.CallOption) (*GetTicketFormResponse, error)
-	GetTicketFields(ctx context.Context, in *GetTicketFieldsRequest, opts ...grpc.CallOption) (*GetTicketFieldsResponse, error)
-	GetSearchTitleArticles(ctx context.Context, in *GetSearchTitleArticlesRequest, opts ...grpc.CallOption) (*GetSearchTitleArticlesResponse, error)
-	GetSearchBodyArticles(ctx context.Context, in *GetSearchBodyArticlesRequest, opts ...grpc.CallOption) (*GetSearchBodyArticlesResponse, error)
-	GetStatus(ctx context.Context, in *GetStatusRequest, opts ...grpc.CallOption) (*GetStatusResponse, error)
-	SetCreateRequest(ctx context.Context, in *SetCreateRequestRequest, opts ...grpc.CallOption) (*SetCreateRequestResponse, error)
-	SetVoteArticle(ctx context.Context, in *SetVoteArticleRequest, opts ...grpc.CallOption) (*SetVoteArticleResponse, error)
-	SetForceSync(ctx context.Context, in *SetForceSyncRequest, opts ...grpc.CallOption) (*SetForceSyncResponse, error)
-}
-
-type zendeskClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewZendeskClient(cc *grpc.ClientConn) ZendeskClient {
-	return &zendeskClient{cc}
-}
-
-func (c *zendeskClient) GetCategories(ctx context.Context, in *GetCategoriesRequest, opts ...grpc.CallOption) (*GetCategoriesResponse, error) {
-	out := new(GetCategoriesResponse)
-	err := grpc.Invoke(ctx, "/protobuf.Zendesk/GetCategories", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// Deprecated: Use SetCreateRequestRequest_Data.ProtoReflect.Descriptor instead.
+func (*SetCreateRequestRequest_Data) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{33, 0}
 }
 
-func (c *zendeskClient) GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...grpc.CallOption) (*GetCategoryResponse, error) {
-	out := new(GetCategoryResponse)
-	err := grpc.Invoke(ctx, "/protobuf.Zendesk/GetCategory", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (x *SetCreateRequestRequest_Data) GetRequest() *SetCreateRequestRequest_Data_Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
 }
 
-func (c *zendeskClient) GetSections(ctx context.Context, in *GetSectionsRequest, opts ...grpc.CallOption) (*GetSectionsResponse, error) {
-	out := new(GetSectionsResponse)
-	err := grpc.Invoke(ctx, "/protobuf.Zendesk/GetSections", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type SetCreateRequestRequest_Data_Request struct {
+	state         protoimpl.MessageState                              `protogen:"open.v1"`
+	Comment       *SetCreateRequestRequest_Data_Request_Comment       `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	Requester     *SetCreateRequestRequest_Data_Request_Requester     `protobuf:"bytes,3,opt,name=requester,proto3" json:"requester,omitempty"`
+	Subject       string                                              `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	TicketFormId  string                                              `protobuf:"bytes,5,opt,name=ticketFormId,proto3" json:"ticketFormId,omitempty"`
+	CustomFields  []*SetCreateRequestRequest_Data_Request_CustomField `protobuf:"bytes,6,rep,name=customFields,proto3" json:"customFields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (c *zendeskClient) GetSection(ctx context.Context, in *GetSectionRequest, opts ...grpc.CallOption) (*GetSectionResponse, error) {
-	out := new(GetSectionResponse)
-	err := grpc.Invoke(ctx, "/protobuf.Zendesk/GetSection", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (x *SetCreateRequestRequest_Data_Request) Reset() {
+	*x = SetCreateRequestRequest_Data_Request{}
+	mi := &file_zendesk_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (c *zendeskClient) GetArticles(ctx context.Context, in *GetArticlesRequest, opts ...grpc.CallOption) (*GetArticlesResponse, error) {
-	out := new(GetArticlesResponse)
-	err := grpc.Invoke(ctx, "/protobuf.Zendesk/GetArticles", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (x *SetCreateRequestRequest_Data_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (c *zendeskClient) GetTopArticles(ctx context.Context, in *GetTopArticlesRequest, opts ...grpc.CallOption) (*GetTopArticlesResponse, error) {
-	out := new(GetTopArticlesResponse)
-	err := grpc.Invoke(ctx, "/protobuf.Zendesk/GetTopArticles", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (*SetCreateRequestRequest_Data_Request) ProtoMessage() {}
+
+func (x *SetCreateRequestRequest_Data_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return out, nil
+	return mi.MessageOf(x)
 }
 
-func (c *zendeskClient) GetArticle(ctx context.Context, in *GetArticleRequest, opts ...grpc.CallOption) (*GetArticleResponse, error) {
-	out := new(GetArticleResponse)
-	err := grpc.Invoke(ctx, "/protobuf.Zendesk/GetArticle", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// Deprecated: Use SetCreateRequestRequest_Data_Request.ProtoReflect.Descriptor instead.
+func (*SetCreateRequestRequest_Data_Request) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{33, 0, 0}
 }
 
-func (c *zendeskClient) GetTicketForm(ctx context.Context, in *GetTicketFormRequest, opts ...grpc.CallOption) (*GetTicketFormResponse, error) {
-	out := new(GetTicketFormResponse)
-	err := grpc.Invoke(ctx, "/protobuf.Zendesk/GetTicketForm", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (x *SetCreateRequestRequest_Data_Request) GetComment() *SetCreateRequestRequest_Data_Request_Comment {
+	if x != nil {
+		return x.Comment
 	}
-	return out, nil
+	return nil
 }
 
-func (c *zendeskClient) GetTicketFields(ctx context.Context, in *GetTicketFieldsRequest, opts ...grpc.CallOption) (*GetTicketFieldsResponse, error) {
-	out := new(GetTicketFieldsResponse)
-	err := grpc.Invoke(ctx, "/protobuf.Zendesk/GetTicketFields", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (x *SetCreateRequestRequest_Data_Request) GetRequester() *SetCreateRequestRequest_Data_Request_Requester {
+	if x != nil {
+		return x.Requester
 	}
-	return out, nil
+	return nil
 }
 
-func (c *zendeskClient) GetSearchTitleArticles(ctx context.Context, in *GetSearchTitleArticlesRequest, opts ...grpc.CallOption) (*GetSearchTitleArticlesResponse, error) {
-	out := new(GetSearchTitleArticlesResponse)
-	err := grpc.Invoke(ctx, "/protobuf.Zendesk/GetSearchTitleArticles", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (x *SetCreateRequestRequest_Data_Request) GetSubject() string {
+	if x != nil {
+		return x.Subject
 	}
-	return out, nil
+	return ""
 }
 
-func (c *zendeskClient) GetSearchBodyArticles(ctx context.Context, in *GetSearchBodyArticlesRequest, opts ...grpc.CallOption) (*GetSearchBodyArticlesResponse, error) {
-	out := new(GetSearchBodyArticlesResponse)
-	err := grpc.Invoke(ctx, "/protobuf.Zendesk/GetSearchBodyArticles", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (x *SetCreateRequestRequest_Data_Request) GetTicketFormId() string {
+	if x != nil {
+		return x.TicketFormId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *zendeskClient) GetStatus(ctx context.Context, in *GetStatusRequest, opts ...grpc.CallOption) (*GetStatusResponse, error) {
-	out := new(GetStatusResponse)
-	err := grpc.Invoke(ctx, "/protobuf.Zendesk/GetStatus", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (x *SetCreateRequestRequest_Data_Request) GetCustomFields() []*SetCreateRequestRequest_Data_Request_CustomField {
+	if x != nil {
+		return x.CustomFields
 	}
-	return out, nil
+	return nil
 }
 
-func (c *zendeskClient) SetCreateRequest(ctx context.Context, in *SetCreateRequestRequest, opts ...grpc.CallOption) (*SetCreateRequestResponse, error) {
-	out := new(SetCreateRequestResponse)
-	err := grpc.Invoke(ctx, "/protobuf.Zendesk/SetCreateRequest", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type SetCreateRequestRequest_Data_Request_Comment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Body          string                 `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (c *zendeskClient) SetVoteArticle(ctx context.Context, in *SetVoteArticleRequest, opts ...grpc.CallOption) (*SetVoteArticleResponse, error) {
-	out := new(SetVoteArticleResponse)
-	err := grpc.Invoke(ctx, "/protobuf.Zendesk/SetVoteArticle", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (x *SetCreateRequestRequest_Data_Request_Comment) Reset() {
+	*x = SetCreateRequestRequest_Data_Request_Comment{}
+	mi := &file_zendesk_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (c *zendeskClient) SetForceSync(ctx context.Context, in *SetForceSyncRequest, opts ...grpc.CallOption) (*SetForceSyncResponse, error) {
-	out := new(SetForceSyncResponse)
-	err := grpc.Invoke(ctx, "/protobuf.Zendesk/SetForceSync", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (x *SetCreateRequestRequest_Data_Request_Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// Server API for Zendesk service
+func (*SetCreateRequestRequest_Data_Request_Comment) ProtoMessage() {}
 
-type ZendeskServer interface {
-	GetCategories(context.Context, *GetCategoriesRequest) (*GetCategoriesResponse, error)
-	GetCategory(context.Context, *GetCategoryRequest) (*GetCategoryResponse, error)
-	GetSections(context.Context, *GetSectionsRequest) (*GetSectionsResponse, error)
-	GetSection(context.Context, *GetSectionRequest) (*GetSectionResponse, error)
-	GetArticles(context.Context, *GetArticlesRequest) (*GetArticlesResponse, error)
-	GetTopArticles(context.Context, *GetTopArticlesRequest) (*GetTopArticlesResponse, error)
-	GetArticle(context.Context, *GetArticleRequest) (*GetArticleResponse, error)
-	GetTicketForm(context.Context, *GetTicketFormRequest) (*GetTicketFormResponse, error)
-	GetTicketFields(context.Context, *GetTicketFieldsRequest) (*GetTicketFieldsResponse, error)
-	GetSearchTitleArticles(context.Context, *GetSearchTitleArticlesRequest) (*GetSearchTitleArticlesResponse, error)
-	GetSearchBodyArticles(context.Context, *GetSearchBodyArticlesRequest) (*GetSearchBodyArticlesResponse, error)
-	GetStatus(context.Context, *GetStatusRequest) (*GetStatusResponse, error)
-	SetCreateRequest(context.Context, *SetCreateRequestRequest) (*SetCreateRequestResponse, error)
-	SetVoteArticle(context.Context, *SetVoteArticleRequest) (*SetVoteArticleResponse, error)
-	SetForceSync(context.Context, *SetForceSyncRequest) (*SetForceSyncResponse, error)
+func (x *SetCreateRequestRequest_Data_Request_Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func RegisterZendeskServer(s *grpc.Server, srv ZendeskServer) {
-	s.RegisterService(&_Zendesk_serviceDesc, srv)
+// Deprecated: Use SetCreateRequestRequest_Data_Request_Comment.ProtoReflect.Descriptor instead.
+func (*SetCreateRequestRequest_Data_Request_Comment) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{33, 0, 0, 0}
 }
 
-func _Zendesk_GetCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCategoriesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ZendeskServer).GetCategories(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/protobuf.Zendesk/GetCategories",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZendeskServer).GetCategories(ctx, req.(*GetCategoriesRequest))
+func (x *SetCreateRequestRequest_Data_Request_Comment) GetBody() string {
+	if x != nil {
+		return x.Body
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Zendesk_GetCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCategoryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ZendeskServer).GetCategory(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/protobuf.Zendesk/GetCategory",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZendeskServer).GetCategory(ctx, req.(*GetCategoryRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type SetCreateRequestRequest_Data_Request_CustomField struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func _Zendesk_GetSections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSectionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ZendeskServer).GetSections(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/protobuf.Zendesk/GetSections",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZendeskServer).GetSections(ctx, req.(*GetSectionsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (x *SetCreateRequestRequest_Data_Request_CustomField) Reset() {
+	*x = SetCreateRequestRequest_Data_Request_CustomField{}
+	mi := &file_zendesk_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func _Zendesk_GetSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSectionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ZendeskServer).GetSection(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/protobuf.Zendesk/GetSection",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZendeskServer).GetSection(ctx, req.(*GetSectionRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (x *SetCreateRequestRequest_Data_Request_CustomField) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func _Zendesk_GetArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetArticlesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ZendeskServer).GetArticles(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/protobuf.Zendesk/GetArticles",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZendeskServer).GetArticles(ctx, req.(*GetArticlesRequest))
+func (*SetCreateRequestRequest_Data_Request_CustomField) ProtoMessage() {}
+
+func (x *SetCreateRequestRequest_Data_Request_CustomField) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return interceptor(ctx, in, info, handler)
+	return mi.MessageOf(x)
 }
 
-func _Zendesk_GetTopArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTopArticlesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ZendeskServer).GetTopArticles(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/protobuf.Zendesk/GetTopArticles",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZendeskServer).GetTopArticles(ctx, req.(*GetTopArticlesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// Deprecated: Use SetCreateRequestRequest_Data_Request_CustomField.ProtoReflect.Descriptor instead.
+func (*SetCreateRequestRequest_Data_Request_CustomField) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{33, 0, 0, 1}
 }
 
-func _Zendesk_GetArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetArticleRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ZendeskServer).GetArticle(ctx, in)
+func (x *SetCreateRequestRequest_Data_Request_CustomField) GetId() string {
+	if x != nil {
+		return x.Id
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/protobuf.Zendesk/GetArticle",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZendeskServer).GetArticle(ctx, req.(*GetArticleRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Zendesk_GetTicketForm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTicketFormRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (x *SetCreateRequestRequest_Data_Request_CustomField) GetValue() string {
+	if x != nil {
+		return x.Value
 	}
-	if interceptor == nil {
-		return srv.(ZendeskServer).GetTicketForm(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/protobuf.Zendesk/GetTicketForm",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZendeskServer).GetTicketForm(ctx, req.(*GetTicketFormRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Zendesk_GetTicketFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTicketFieldsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ZendeskServer).GetTicketFields(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/protobuf.Zendesk/GetTicketFields",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZendeskServer).GetTicketFields(ctx, req.(*GetTicketFieldsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type SetCreateRequestRequest_Data_Request_Requester struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func _Zendesk_GetSearchTitleArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSearchTitleArticlesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ZendeskServer).GetSearchTitleArticles(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/protobuf.Zendesk/GetSearchTitleArticles",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZendeskServer).GetSearchTitleArticles(ctx, req.(*GetSearchTitleArticlesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (x *SetCreateRequestRequest_Data_Request_Requester) Reset() {
+	*x = SetCreateRequestRequest_Data_Request_Requester{}
+	mi := &file_zendesk_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func _Zendesk_GetSearchBodyArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSearchBodyArticlesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ZendeskServer).GetSearchBodyArticles(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/protobuf.Zendesk/GetSearchBodyArticles",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZendeskServer).GetSearchBodyArticles(ctx, req.(*GetSearchBodyArticlesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (x *SetCreateRequestRequest_Data_Request_Requester) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func _Zendesk_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetStatusRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ZendeskServer).GetStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/protobuf.Zendesk/GetStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZendeskServer).GetStatus(ctx, req.(*GetStatusRequest))
+func (*SetCreateRequestRequest_Data_Request_Requester) ProtoMessage() {}
+
+func (x *SetCreateRequestRequest_Data_Request_Requester) ProtoReflect() protoreflect.Message {
+	mi := &file_zendesk_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return interceptor(ctx, in, info, handler)
+	return mi.MessageOf(x)
 }
 
-func _Zendesk_SetCreateRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetCreateRequestRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ZendeskServer).SetCreateRequest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/protobuf.Zendesk/SetCreateRequest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZendeskServer).SetCreateRequest(ctx, req.(*SetCreateRequestRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// Deprecated: Use SetCreateRequestRequest_Data_Request_Requester.ProtoReflect.Descriptor instead.
+func (*SetCreateRequestRequest_Data_Request_Requester) Descriptor() ([]byte, []int) {
+	return file_zendesk_proto_rawDescGZIP(), []int{33, 0, 0, 2}
 }
 
-func _Zendesk_SetVoteArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetVoteArticleRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ZendeskServer).SetVoteArticle(ctx, in)
+func (x *SetCreateRequestRequest_Data_Request_Requester) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/protobuf.Zendesk/SetVoteArticle",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZendeskServer).SetVoteArticle(ctx, req.(*SetVoteArticleRequest))
+	return ""
+}
+
+func (x *SetCreateRequestRequest_Data_Request_Requester) GetEmail() string {
+	if x != nil {
+		return x.Email
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Zendesk_SetForceSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetForceSyncRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var File_zendesk_proto protoreflect.FileDescriptor
+
+const file_zendesk_proto_rawDesc = "" +
+	"\n" +
+	"\rzendesk.proto\x12\bprotobuf\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa0\x03\n" +
+	"\bCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\x128\n" +
+	"\tcreatedAt\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\tupdatedAt\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\"\n" +
+	"\fsourceLocale\x18\x05 \x01(\tR\fsourceLocale\x12\x1a\n" +
+	"\boutdated\x18\x06 \x01(\bR\boutdated\x12 \n" +
+	"\vcountryCode\x18\a \x01(\tR\vcountryCode\x12\x18\n" +
+	"\akeyName\x18\b \x01(\tR\akeyName\x12\x10\n" +
+	"\x03url\x18\t \x01(\tR\x03url\x12\x18\n" +
+	"\ahtmlUrl\x18\n" +
+	" \x01(\tR\ahtmlUrl\x12\x12\n" +
+	"\x04name\x18\v \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\f \x01(\tR\vdescription\x12\x16\n" +
+	"\x06locale\x18\r \x01(\tR\x06locale\"\xa5\x03\n" +
+	"\aSection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\x128\n" +
+	"\tcreatedAt\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\tupdatedAt\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\"\n" +
+	"\fsourceLocale\x18\x05 \x01(\tR\fsourceLocale\x12\x1a\n" +
+	"\boutdated\x18\x06 \x01(\bR\boutdated\x12 \n" +
+	"\vcountryCode\x18\a \x01(\tR\vcountryCode\x12\x10\n" +
+	"\x03url\x18\b \x01(\tR\x03url\x12\x18\n" +
+	"\ahtmlUrl\x18\t \x01(\tR\ahtmlUrl\x12\x12\n" +
+	"\x04name\x18\n" +
+	" \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\v \x01(\tR\vdescription\x12\x16\n" +
+	"\x06locale\x18\f \x01(\tR\x06locale\x12\x1e\n" +
+	"\n" +
+	"categoryId\x18\r \x01(\tR\n" +
+	"categoryId\"\xdd\x05\n" +
+	"\aArticle\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bauthorId\x18\x02 \x01(\tR\bauthorId\x12(\n" +
+	"\x0fcommentsDisable\x18\x03 \x01(\bR\x0fcommentsDisable\x12\x14\n" +
+	"\x05draft\x18\x04 \x01(\bR\x05draft\x12\x1a\n" +
+	"\bpromoted\x18\x05 \x01(\bR\bpromoted\x12\x1a\n" +
+	"\bposition\x18\x06 \x01(\x05R\bposition\x12\x18\n" +
+	"\avoteSum\x18\a \x01(\x05R\avoteSum\x12\x1c\n" +
+	"\tvoteCount\x18\b \x01(\x05R\tvoteCount\x128\n" +
+	"\tcreatedAt\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\tupdatedAt\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\"\n" +
+	"\fsourceLocale\x18\v \x01(\tR\fsourceLocale\x12\x1a\n" +
+	"\boutdated\x18\f \x01(\bR\boutdated\x12(\n" +
+	"\x0foutdatedLocales\x18\r \x03(\tR\x0foutdatedLocales\x126\n" +
+	"\beditedAt\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt\x12\x1e\n" +
+	"\n" +
+	"labelNames\x18\x0f \x03(\tR\n" +
+	"labelNames\x12 \n" +
+	"\vcountryCode\x18\x10 \x01(\tR\vcountryCode\x12\x10\n" +
+	"\x03url\x18\x11 \x01(\tR\x03url\x12\x18\n" +
+	"\ahtmlUrl\x18\x12 \x01(\tR\ahtmlUrl\x12\x12\n" +
+	"\x04name\x18\x13 \x01(\tR\x04name\x12\x14\n" +
+	"\x05title\x18\x14 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x15 \x01(\tR\x04body\x12\x16\n" +
+	"\x06locale\x18\x16 \x01(\tR\x06locale\x12\x1c\n" +
+	"\tsectionId\x18\x17 \x01(\tR\tsectionId\"\x83\a\n" +
+	"\vTicketField\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x1a\n" +
+	"\brawTitle\x18\x05 \x01(\tR\brawTitle\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12&\n" +
+	"\x0erawDescription\x18\a \x01(\tR\x0erawDescription\x12\x1a\n" +
+	"\bposition\x18\b \x01(\x05R\bposition\x12\x16\n" +
+	"\x06active\x18\t \x01(\bR\x06active\x12\x1a\n" +
+	"\brequired\x18\n" +
+	" \x01(\bR\brequired\x12.\n" +
+	"\x12collapsedForAgents\x18\v \x01(\bR\x12collapsedForAgents\x120\n" +
+	"\x13regexpForValidation\x18\f \x01(\tR\x13regexpForValidation\x12$\n" +
+	"\rtitleInPortal\x18\r \x01(\tR\rtitleInPortal\x12*\n" +
+	"\x10rawTitleInPortal\x18\x0e \x01(\tR\x10rawTitleInPortal\x12(\n" +
+	"\x0fvisibleInPortal\x18\x0f \x01(\bR\x0fvisibleInPortal\x12*\n" +
+	"\x10editableInPortal\x18\x10 \x01(\bR\x10editableInPortal\x12*\n" +
+	"\x10requiredInPortal\x18\x11 \x01(\bR\x10requiredInPortal\x12\x10\n" +
+	"\x03tag\x18\x12 \x01(\tR\x03tag\x128\n" +
+	"\tcreatedAt\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\tupdatedAt\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1c\n" +
+	"\tremovable\x18\x15 \x01(\bR\tremovable\x12K\n" +
+	"\x12customFieldOptions\x18\x16 \x03(\v2\x1b.protobuf.CustomFieldOptionR\x12customFieldOptions\x12K\n" +
+	"\x12systemFieldOptions\x18\x17 \x03(\v2\x1b.protobuf.SystemFieldOptionR\x12systemFieldOptions\"g\n" +
+	"\x11CustomFieldOption\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\arawName\x18\x03 \x01(\tR\arawName\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\tR\x05value\"=\n" +
+	"\x11SystemFieldOption\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"b\n" +
+	"\x12SearchTitleArticle\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12$\n" +
+	"\rcategoryTitle\x18\x02 \x01(\tR\rcategoryTitle\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\"\xc5\x06\n" +
+	"\x11SearchBodyArticle\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bauthorId\x18\x02 \x01(\tR\bauthorId\x12(\n" +
+	"\x0fcommentsDisable\x18\x03 \x01(\bR\x0fcommentsDisable\x12\x14\n" +
+	"\x05draft\x18\x04 \x01(\bR\x05draft\x12\x1a\n" +
+	"\bpromoted\x18\x05 \x01(\bR\bpromoted\x12\x1a\n" +
+	"\bposition\x18\x06 \x01(\x05R\bposition\x12\x18\n" +
+	"\avoteSum\x18\a \x01(\x05R\avoteSum\x12\x1c\n" +
+	"\tvoteCount\x18\b \x01(\x05R\tvoteCount\x128\n" +
+	"\tcreatedAt\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\tupdatedAt\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\"\n" +
+	"\fsourceLocale\x18\v \x01(\tR\fsourceLocale\x12\x1a\n" +
+	"\boutdated\x18\f \x01(\bR\boutdated\x12(\n" +
+	"\x0foutdatedLocales\x18\r \x03(\tR\x0foutdatedLocales\x126\n" +
+	"\beditedAt\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt\x12\x1e\n" +
+	"\n" +
+	"labelNames\x18\x0f \x03(\tR\n" +
+	"labelNames\x12 \n" +
+	"\vcountryCode\x18\x10 \x01(\tR\vcountryCode\x12\x10\n" +
+	"\x03url\x18\x11 \x01(\tR\x03url\x12\x18\n" +
+	"\ahtmlUrl\x18\x12 \x01(\tR\ahtmlUrl\x12\x12\n" +
+	"\x04name\x18\x13 \x01(\tR\x04name\x12\x14\n" +
+	"\x05title\x18\x14 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x15 \x01(\tR\x04body\x12\x16\n" +
+	"\x06locale\x18\x16 \x01(\tR\x06locale\x12\x18\n" +
+	"\asnippet\x18\x17 \x01(\tR\asnippet\x12\x1c\n" +
+	"\tsectionId\x18\x18 \x01(\tR\tsectionId\x12\x1e\n" +
+	"\n" +
+	"categoryId\x18\x19 \x01(\tR\n" +
+	"categoryId\x12\"\n" +
+	"\fcategoryName\x18\x1a \x01(\tR\fcategoryName\"l\n" +
+	"\bPageInfo\x12\x18\n" +
+	"\aperPage\x18\x01 \x01(\x05R\aperPage\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1c\n" +
+	"\tpageCount\x18\x03 \x01(\x05R\tpageCount\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x05R\x05count\"\x84\x02\n" +
+	"\x14GetCategoriesRequest\x127\n" +
+	"\vcountryCode\x18\x01 \x01(\x0e2\x15.protobuf.CountryCodeR\vcountryCode\x12(\n" +
+	"\x06locale\x18\x02 \x01(\x0e2\x10.protobuf.LocaleR\x06locale\x12(\n" +
+	"\x06sortBy\x18\x03 \x01(\x0e2\x10.protobuf.SortByR\x06sortBy\x121\n" +
+	"\tsortOrder\x18\x04 \x01(\x0e2\x13.protobuf.SortOrderR\tsortOrder\x12\x18\n" +
+	"\aperPage\x18\x05 \x01(\x05R\aperPage\x12\x12\n" +
+	"\x04page\x18\x06 \x01(\x05R\x04page\"{\n" +
+	"\x15GetCategoriesResponse\x12.\n" +
+	"\bpageInfo\x18\x01 \x01(\v2\x12.protobuf.PageInfoR\bpageInfo\x122\n" +
+	"\n" +
+	"categories\x18\x02 \x03(\v2\x12.protobuf.CategoryR\n" +
+	"categories\"\xf1\x01\n" +
+	"\x12GetCategoryRequest\x127\n" +
+	"\vcountryCode\x18\x01 \x01(\x0e2\x15.protobuf.CountryCodeR\vcountryCode\x12(\n" +
+	"\x06locale\x18\x02 \x01(\x0e2\x10.protobuf.LocaleR\x06locale\x122\n" +
+	"\x13categoryIdOrKeyname\x18\x03 \x01(\tH\x00R\x13categoryIdOrKeyname\x12\x1e\n" +
+	"\tsectionId\x18\x04 \x01(\tH\x00R\tsectionId\x12\x1e\n" +
+	"\tarticleId\x18\x05 \x01(\tH\x00R\tarticleIdB\x04\n" +
+	"\x02Id\"E\n" +
+	"\x13GetCategoryResponse\x12.\n" +
+	"\bcategory\x18\x01 \x01(\v2\x12.protobuf.CategoryR\bcategory\"\xbe\x02\n" +
+	"\x12GetSectionsRequest\x127\n" +
+	"\vcountryCode\x18\x01 \x01(\x0e2\x15.protobuf.CountryCodeR\vcountryCode\x12(\n" +
+	"\x06locale\x18\x02 \x01(\x0e2\x10.protobuf.LocaleR\x06locale\x12(\n" +
+	"\x06sortBy\x18\x03 \x01(\x0e2\x10.protobuf.SortByR\x06sortBy\x121\n" +
+	"\tsortOrder\x18\x04 \x01(\x0e2\x13.protobuf.SortOrderR\tsortOrder\x12\x18\n" +
+	"\aperPage\x18\x05 \x01(\x05R\aperPage\x12\x12\n" +
+	"\x04page\x18\x06 \x01(\x05R\x04page\x12\x12\n" +
+	"\x03all\x18\a \x01(\bH\x00R\x03all\x12 \n" +
+	"\n" +
+	"categoryId\x18\b \x01(\tH\x00R\n" +
+	"categoryIdB\x04\n" +
+	"\x02Id\"t\n" +
+	"\x13GetSectionsResponse\x12.\n" +
+	"\bpageInfo\x18\x01 \x01(\v2\x12.protobuf.PageInfoR\bpageInfo\x12-\n" +
+	"\bsections\x18\x02 \x03(\v2\x11.protobuf.SectionR\bsections\"\xbc\x01\n" +
+	"\x11GetSectionRequest\x127\n" +
+	"\vcountryCode\x18\x01 \x01(\x0e2\x15.protobuf.CountryCodeR\vcountryCode\x12(\n" +
+	"\x06locale\x18\x02 \x01(\x0e2\x10.protobuf.LocaleR\x06locale\x12\x1e\n" +
+	"\tsectionId\x18\x03 \x01(\tH\x00R\tsectionId\x12\x1e\n" +
+	"\tarticleId\x18\x04 \x01(\tH\x00R\tarticleIdB\x04\n" +
+	"\x02Id\"A\n" +
+	"\x12GetSectionResponse\x12+\n" +
+	"\asection\x18\x01 \x01(\v2\x11.protobuf.SectionR\asection\"\xfe\x02\n" +
+	"\x12GetArticlesRequest\x127\n" +
+	"\vcountryCode\x18\x01 \x01(\x0e2\x15.protobuf.CountryCodeR\vcountryCode\x12(\n" +
+	"\x06locale\x18\x02 \x01(\x0e2\x10.protobuf.LocaleR\x06locale\x12(\n" +
+	"\x06sortBy\x18\x03 \x01(\x0e2\x10.protobuf.SortByR\x06sortBy\x121\n" +
+	"\tsortOrder\x18\x04 \x01(\x0e2\x13.protobuf.SortOrderR\tsortOrder\x12\x18\n" +
+	"\aperPage\x18\x05 \x01(\x05R\aperPage\x12\x12\n" +
+	"\x04page\x18\x06 \x01(\x05R\x04page\x12\x12\n" +
+	"\x03all\x18\a \x01(\bH\x00R\x03all\x12 \n" +
+	"\n" +
+	"categoryId\x18\b \x01(\tH\x00R\n" +
+	"categoryId\x12\x1e\n" +
+	"\tsectionId\x18\t \x01(\tH\x00R\tsectionId\x12\x1e\n" +
+	"\n" +
+	"labelNames\x18\n" +
+	" \x03(\tR\n" +
+	"labelNamesB\x04\n" +
+	"\x02Id\"t\n" +
+	"\x13GetArticlesResponse\x12.\n" +
+	"\bpageInfo\x18\x01 \x01(\v2\x12.protobuf.PageInfoR\bpageInfo\x12-\n" +
+	"\barticles\x18\x02 \x03(\v2\x11.protobuf.ArticleR\barticles\"\xec\x01\n" +
+	"\x15GetTopArticlesRequest\x127\n" +
+	"\vcountryCode\x18\x01 \x01(\x0e2\x15.protobuf.CountryCodeR\vcountryCode\x12(\n" +
+	"\x06locale\x18\x02 \x01(\x0e2\x10.protobuf.LocaleR\x06locale\x12\x12\n" +
+	"\x04topN\x18\x03 \x01(\x05R\x04topN\x12\x1e\n" +
+	"\n" +
+	"windowDays\x18\x04 \x01(\x05R\n" +
+	"windowDays\x12\x1c\n" +
+	"\tsectionId\x18\x05 \x01(\tR\tsectionId\x12\x1e\n" +
+	"\n" +
+	"categoryId\x18\x06 \x01(\tR\n" +
+	"categoryId\"G\n" +
+	"\x16GetTopArticlesResponse\x12-\n" +
+	"\barticles\x18\x01 \x03(\v2\x11.protobuf.ArticleR\barticles\"\x94\x01\n" +
+	"\x11GetArticleRequest\x127\n" +
+	"\vcountryCode\x18\x01 \x01(\x0e2\x15.protobuf.CountryCodeR\vcountryCode\x12(\n" +
+	"\x06locale\x18\x02 \x01(\x0e2\x10.protobuf.LocaleR\x06locale\x12\x1c\n" +
+	"\tarticleId\x18\x03 \x01(\tR\tarticleId\"A\n" +
+	"\x12GetArticleResponse\x12+\n" +
+	"\aarticle\x18\x01 \x01(\v2\x11.protobuf.ArticleR\aarticle\".\n" +
+	"\x14GetTicketFormRequest\x12\x16\n" +
+	"\x06formId\x18\x01 \x01(\tR\x06formId\"\xd3\x03\n" +
+	"\x15GetTicketFormResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
+	"\arawName\x18\x04 \x01(\tR\arawName\x12 \n" +
+	"\vdisplayName\x18\x05 \x01(\tR\vdisplayName\x12&\n" +
+	"\x0erawDisplayName\x18\x06 \x01(\tR\x0erawDisplayName\x12&\n" +
+	"\x0eendUserVisible\x18\a \x01(\bR\x0eendUserVisible\x12\x1a\n" +
+	"\bposition\x18\b \x01(\x05R\bposition\x12\x16\n" +
+	"\x06active\x18\t \x01(\bR\x06active\x12 \n" +
+	"\vinAllBrands\x18\n" +
+	" \x01(\bR\vinAllBrands\x12.\n" +
+	"\x12restrictedBrandIds\x18\v \x03(\x05R\x12restrictedBrandIds\x128\n" +
+	"\tcreatedAt\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\tupdatedAt\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"Z\n" +
+	"\x16GetTicketFieldsRequest\x12\x16\n" +
+	"\x06formId\x18\x01 \x01(\tR\x06formId\x12(\n" +
+	"\x06locale\x18\x02 \x01(\x0e2\x10.protobuf.LocaleR\x06locale\"T\n" +
+	"\x17GetTicketFieldsResponse\x129\n" +
+	"\fticketFields\x18\x01 \x03(\v2\x15.protobuf.TicketFieldR\fticketFields\"\x98\x01\n" +
+	"\x1dGetSearchTitleArticlesRequest\x127\n" +
+	"\vcountryCode\x18\x01 \x01(\x0e2\x15.protobuf.CountryCodeR\vcountryCode\x12(\n" +
+	"\x06locale\x18\x02 \x01(\x0e2\x10.protobuf.LocaleR\x06locale\x12\x14\n" +
+	"\x05query\x18\x03 \x01(\tR\x05query\"Z\n" +
+	"\x1eGetSearchTitleArticlesResponse\x128\n" +
+	"\barticles\x18\x01 \x03(\v2\x1c.protobuf.SearchTitleArticleR\barticles\"\xf8\x01\n" +
+	"\x1cGetSearchBodyArticlesRequest\x127\n" +
+	"\vcountryCode\x18\x01 \x01(\x0e2\x15.protobuf.CountryCodeR\vcountryCode\x12(\n" +
+	"\x06locale\x18\x02 \x01(\x0e2\x10.protobuf.LocaleR\x06locale\x121\n" +
+	"\tsortOrder\x18\x03 \x01(\x0e2\x13.protobuf.SortOrderR\tsortOrder\x12\x18\n" +
+	"\aperPage\x18\x04 \x01(\x05R\aperPage\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05query\x18\x06 \x01(\tR\x05query\"\x88\x01\n" +
+	"\x1dGetSearchBodyArticlesResponse\x12.\n" +
+	"\bpageInfo\x18\x01 \x01(\v2\x12.protobuf.PageInfoR\bpageInfo\x127\n" +
+	"\barticles\x18\x02 \x03(\v2\x1b.protobuf.SearchBodyArticleR\barticles\"\x12\n" +
+	"\x10GetStatusRequest\"\x8d\x01\n" +
+	"\x11GetStatusResponse\x12\x1c\n" +
+	"\tgoVersion\x18\x01 \x01(\tR\tgoVersion\x12\x1e\n" +
+	"\n" +
+	"appVersion\x18\x02 \x01(\tR\n" +
+	"appVersion\x12:\n" +
+	"\n" +
+	"serverTime\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"serverTime\"\xc0\x05\n" +
+	"\x17SetCreateRequestRequest\x127\n" +
+	"\vcountryCode\x18\x01 \x01(\x0e2\x15.protobuf.CountryCodeR\vcountryCode\x12:\n" +
+	"\x04data\x18\x02 \x01(\v2&.protobuf.SetCreateRequestRequest.DataR\x04data\x1a\xaf\x04\n" +
+	"\x04Data\x12H\n" +
+	"\arequest\x18\x01 \x01(\v2..protobuf.SetCreateRequestRequest.Data.RequestR\arequest\x1a\xdc\x03\n" +
+	"\aRequest\x12P\n" +
+	"\acomment\x18\x02 \x01(\v26.protobuf.SetCreateRequestRequest.Data.Request.CommentR\acomment\x12V\n" +
+	"\trequester\x18\x03 \x01(\v28.protobuf.SetCreateRequestRequest.Data.Request.RequesterR\trequester\x12\x18\n" +
+	"\asubject\x18\x04 \x01(\tR\asubject\x12\"\n" +
+	"\fticketFormId\x18\x05 \x01(\tR\fticketFormId\x12^\n" +
+	"\fcustomFields\x18\x06 \x03(\v2:.protobuf.SetCreateRequestRequest.Data.Request.CustomFieldR\fcustomFields\x1a\x1d\n" +
+	"\aComment\x12\x12\n" +
+	"\x04body\x18\x01 \x01(\tR\x04body\x1a3\n" +
+	"\vCustomField\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x1a5\n" +
+	"\tRequester\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\"2\n" +
+	"\x18SetCreateRequestResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"\xbc\x01\n" +
+	"\x15SetVoteArticleRequest\x127\n" +
+	"\vcountryCode\x18\x01 \x01(\x0e2\x15.protobuf.CountryCodeR\vcountryCode\x12(\n" +
+	"\x06locale\x18\x02 \x01(\x0e2\x10.protobuf.LocaleR\x06locale\x12\x1c\n" +
+	"\tarticleId\x18\x03 \x01(\tR\tarticleId\x12\"\n" +
+	"\x04vote\x18\x04 \x01(\x0e2\x0e.protobuf.VoteR\x04vote\"E\n" +
+	"\x16SetVoteArticleResponse\x12+\n" +
+	"\aarticle\x18\x01 \x01(\v2\x11.protobuf.ArticleR\aarticle\"M\n" +
+	"\x13SetForceSyncRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\".\n" +
+	"\x14SetForceSyncResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"\xac\x01\n" +
+	"\x15StreamArticlesRequest\x127\n" +
+	"\vcountryCode\x18\x01 \x01(\x0e2\x15.protobuf.CountryCodeR\vcountryCode\x12(\n" +
+	"\x06locale\x18\x02 \x01(\x0e2\x10.protobuf.LocaleR\x06locale\x120\n" +
+	"\x05since\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\"E\n" +
+	"\x16StreamArticlesResponse\x12+\n" +
+	"\aarticle\x18\x01 \x01(\v2\x11.protobuf.ArticleR\aarticle\"\x9a\x01\n" +
+	"\x13WatchChangesRequest\x127\n" +
+	"\vcountryCode\x18\x01 \x01(\x0e2\x15.protobuf.CountryCodeR\vcountryCode\x12(\n" +
+	"\x06locale\x18\x02 \x01(\x0e2\x10.protobuf.LocaleR\x06locale\x12 \n" +
+	"\vresumeToken\x18\x03 \x01(\tR\vresumeToken\"\xcc\x01\n" +
+	"\x14WatchChangesResponse\x12 \n" +
+	"\vresumeToken\x18\x01 \x01(\tR\vresumeToken\x12(\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x14.protobuf.ChangeTypeR\x04type\x12 \n" +
+	"\vcountryCode\x18\x03 \x01(\tR\vcountryCode\x12\x16\n" +
+	"\x06locale\x18\x04 \x01(\tR\x06locale\x12\x10\n" +
+	"\x03ids\x18\x05 \x03(\tR\x03ids\x12\x1c\n" +
+	"\tsectionId\x18\x06 \x01(\tR\tsectionId*\xb5\x01\n" +
+	"\vCountryCode\x12\x13\n" +
+	"\x0fCOUNTRY_CODE_SG\x10\x00\x12\x13\n" +
+	"\x0fCOUNTRY_CODE_HK\x10\x01\x12\x13\n" +
+	"\x0fCOUNTRY_CODE_TW\x10\x02\x12\x13\n" +
+	"\x0fCOUNTRY_CODE_JP\x10\x03\x12\x13\n" +
+	"\x0fCOUNTRY_CODE_TH\x10\x04\x12\x13\n" +
+	"\x0fCOUNTRY_CODE_MY\x10\x05\x12\x13\n" +
+	"\x0fCOUNTRY_CODE_ID\x10\x06\x12\x13\n" +
+	"\x0fCOUNTRY_CODE_PH\x10\a*k\n" +
+	"\x06Locale\x12\x10\n" +
+	"\fLOCALE_EN_US\x10\x00\x12\x10\n" +
+	"\fLOCALE_ZH_TW\x10\x01\x12\x10\n" +
+	"\fLOCALE_ZH_CN\x10\x02\x12\r\n" +
+	"\tLOCALE_JA\x10\x03\x12\r\n" +
+	"\tLOCALE_TH\x10\x04\x12\r\n" +
+	"\tLOCALE_ID\x10\x05*N\n" +
+	"\x06SortBy\x12\x14\n" +
+	"\x10SORT_BY_POSITION\x10\x00\x12\x16\n" +
+	"\x12SORT_BY_CREATED_AT\x10\x01\x12\x16\n" +
+	"\x12SORT_BY_UPDATED_AT\x10\x02*4\n" +
+	"\tSortOrder\x12\x12\n" +
+	"\x0eSORT_ORDER_ASC\x10\x00\x12\x13\n" +
+	"\x0fSORT_ORDER_DESC\x10\x01*\"\n" +
+	"\x04Vote\x12\v\n" +
+	"\aVOTE_UP\x10\x00\x12\r\n" +
+	"\tVOTE_DOWN\x10\x01*\x95\x01\n" +
+	"\n" +
+	"ChangeType\x12\"\n" +
+	"\x1eCHANGE_TYPE_CATEGORIES_CHANGED\x10\x00\x12 \n" +
+	"\x1cCHANGE_TYPE_SECTIONS_CHANGED\x10\x01\x12\x1f\n" +
+	"\x1bCHANGE_TYPE_ARTICLE_UPDATED\x10\x02\x12 \n" +
+	"\x1cCHANGE_TYPE_ARTICLES_DELETED\x10\x032\x90\x0f\n" +
+	"\aZendesk\x12h\n" +
+	"\rGetCategories\x12\x1e.protobuf.GetCategoriesRequest\x1a\x1f.protobuf.GetCategoriesResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/categories\x12x\n" +
+	"\vGetCategory\x12\x1c.protobuf.GetCategoryRequest\x1a\x1d.protobuf.GetCategoryResponse\",\x82\xd3\xe4\x93\x02&\x12$/v1/categories/{categoryIdOrKeyname}\x12`\n" +
+	"\vGetSections\x12\x1c.protobuf.GetSectionsRequest\x1a\x1d.protobuf.GetSectionsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/sections\x12i\n" +
+	"\n" +
+	"GetSection\x12\x1b.protobuf.GetSectionRequest\x1a\x1c.protobuf.GetSectionResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/sections/{sectionId}\x12`\n" +
+	"\vGetArticles\x12\x1c.protobuf.GetArticlesRequest\x1a\x1d.protobuf.GetArticlesResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/articles\x12s\n" +
+	"\x0eGetTopArticles\x12\x1f.protobuf.GetTopArticlesRequest\x1a .protobuf.GetTopArticlesResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/toparticles/{topN}\x12i\n" +
+	"\n" +
+	"GetArticle\x12\x1b.protobuf.GetArticleRequest\x1a\x1c.protobuf.GetArticleResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/articles/{articleId}\x12s\n" +
+	"\rGetTicketForm\x12\x1e.protobuf.GetTicketFormRequest\x1a\x1f.protobuf.GetTicketFormResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/ticket_forms/{formId}\x12\x80\x01\n" +
+	"\x0fGetTicketFields\x12 .protobuf.GetTicketFieldsRequest\x1a!.protobuf.GetTicketFieldsResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/ticket_forms/{formId}/fields\x12\x85\x01\n" +
+	"\x16GetSearchTitleArticles\x12'.protobuf.GetSearchTitleArticlesRequest\x1a(.protobuf.GetSearchTitleArticlesResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/search/title\x12\x81\x01\n" +
+	"\x15GetSearchBodyArticles\x12&.protobuf.GetSearchBodyArticlesRequest\x1a'.protobuf.GetSearchBodyArticlesResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/search/body\x12X\n" +
+	"\tGetStatus\x12\x1a.protobuf.GetStatusRequest\x1a\x1b.protobuf.GetStatusResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/status\x12r\n" +
+	"\x10SetCreateRequest\x12!.protobuf.SetCreateRequestRequest\x1a\".protobuf.SetCreateRequestResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/requests\x12}\n" +
+	"\x0eSetVoteArticle\x12\x1f.protobuf.SetVoteArticleRequest\x1a .protobuf.SetVoteArticleResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/articles/{articleId}/vote\x12g\n" +
+	"\fSetForceSync\x12\x1d.protobuf.SetForceSyncRequest\x1a\x1e.protobuf.SetForceSyncResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/forcesync\x12r\n" +
+	"\x0eStreamArticles\x12\x1f.protobuf.StreamArticlesRequest\x1a .protobuf.StreamArticlesResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/export/articles0\x01\x12d\n" +
+	"\fWatchChanges\x12\x1d.protobuf.WatchChangesRequest\x1a\x1e.protobuf.WatchChangesResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/changes0\x01B#Z!github.com/honestbee/Zen/protobufb\x06proto3"
+
+var (
+	file_zendesk_proto_rawDescOnce sync.Once
+	file_zendesk_proto_rawDescData []byte
+)
+
+func file_zendesk_proto_rawDescGZIP() []byte {
+	file_zendesk_proto_rawDescOnce.Do(func() {
+		file_zendesk_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_zendesk_proto_rawDesc), len(file_zendesk_proto_rawDesc)))
+	})
+	return file_zendesk_proto_rawDescData
+}
+
+var file_zendesk_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_zendesk_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
+var file_zendesk_proto_goTypes = []any{
+	(CountryCode)(0),                                         // 0: protobuf.CountryCode
+	(Locale)(0),                                              // 1: protobuf.Locale
+	(SortBy)(0),                                              // 2: protobuf.SortBy
+	(SortOrder)(0),                                           // 3: protobuf.SortOrder
+	(Vote)(0),                                                // 4: protobuf.Vote
+	(ChangeType)(0),                                          // 5: protobuf.ChangeType
+	(*Category)(nil),                                         // 6: protobuf.Category
+	(*Section)(nil),                                          // 7: protobuf.Section
+	(*Article)(nil),                                          // 8: protobuf.Article
+	(*TicketField)(nil),                                      // 9: protobuf.TicketField
+	(*CustomFieldOption)(nil),                                // 10: protobuf.CustomFieldOption
+	(*SystemFieldOption)(nil),                                // 11: protobuf.SystemFieldOption
+	(*SearchTitleArticle)(nil),                               // 12: protobuf.SearchTitleArticle
+	(*SearchBodyArticle)(nil),                                // 13: protobuf.SearchBodyArticle
+	(*PageInfo)(nil),                                         // 14: protobuf.PageInfo
+	(*GetCategoriesRequest)(nil),                             // 15: protobuf.GetCategoriesRequest
+	(*GetCategoriesResponse)(nil),                            // 16: protobuf.GetCategoriesResponse
+	(*GetCategoryRequest)(nil),                               // 17: protobuf.GetCategoryRequest
+	(*GetCategoryResponse)(nil),                              // 18: protobuf.GetCategoryResponse
+	(*GetSectionsRequest)(nil),                               // 19: protobuf.GetSectionsRequest
+	(*GetSectionsResponse)(nil),                              // 20: protobuf.GetSectionsResponse
+	(*GetSectionRequest)(nil),                                // 21: protobuf.GetSectionRequest
+	(*GetSectionResponse)(nil),                               // 22: protobuf.GetSectionResponse
+	(*GetArticlesRequest)(nil),                               // 23: protobuf.GetArticlesRequest
+	(*GetArticlesResponse)(nil),                              // 24: protobuf.GetArticlesResponse
+	(*GetTopArticlesRequest)(nil),                            // 25: protobuf.GetTopArticlesRequest
+	(*GetTopArticlesResponse)(nil),                           // 26: protobuf.GetTopArticlesResponse
+	(*GetArticleRequest)(nil),                                // 27: protobuf.GetArticleRequest
+	(*GetArticleResponse)(nil),                               // 28: protobuf.GetArticleResponse
+	(*GetTicketFormRequest)(nil),                             // 29: protobuf.GetTicketFormRequest
+	(*GetTicketFormResponse)(nil),                            // 30: protobuf.GetTicketFormResponse
+	(*GetTicketFieldsRequest)(nil),                           // 31: protobuf.GetTicketFieldsRequest
+	(*GetTicketFieldsResponse)(nil),                          // 32: protobuf.GetTicketFieldsResponse
+	(*GetSearchTitleArticlesRequest)(nil),                    // 33: protobuf.GetSearchTitleArticlesRequest
+	(*GetSearchTitleArticlesResponse)(nil),                   // 34: protobuf.GetSearchTitleArticlesResponse
+	(*GetSearchBodyArticlesRequest)(nil),                     // 35: protobuf.GetSearchBodyArticlesRequest
+	(*GetSearchBodyArticlesResponse)(nil),                    // 36: protobuf.GetSearchBodyArticlesResponse
+	(*GetStatusRequest)(nil),                                 // 37: protobuf.GetStatusRequest
+	(*GetStatusResponse)(nil),                                // 38: protobuf.GetStatusResponse
+	(*SetCreateRequestRequest)(nil),                          // 39: protobuf.SetCreateRequestRequest
+	(*SetCreateRequestResponse)(nil),                         // 40: protobuf.SetCreateRequestResponse
+	(*SetVoteArticleRequest)(nil),                            // 41: protobuf.SetVoteArticleRequest
+	(*SetVoteArticleResponse)(nil),                           // 42: protobuf.SetVoteArticleResponse
+	(*SetForceSyncRequest)(nil),                              // 43: protobuf.SetForceSyncRequest
+	(*SetForceSyncResponse)(nil),                             // 44: protobuf.SetForceSyncResponse
+	(*StreamArticlesRequest)(nil),                            // 45: protobuf.StreamArticlesRequest
+	(*StreamArticlesResponse)(nil),                           // 46: protobuf.StreamArticlesResponse
+	(*WatchChangesRequest)(nil),                              // 47: protobuf.WatchChangesRequest
+	(*WatchChangesResponse)(nil),                             // 48: protobuf.WatchChangesResponse
+	(*SetCreateRequestRequest_Data)(nil),                     // 49: protobuf.SetCreateRequestRequest.Data
+	(*SetCreateRequestRequest_Data_Request)(nil),             // 50: protobuf.SetCreateRequestRequest.Data.Request
+	(*SetCreateRequestRequest_Data_Request_Comment)(nil),     // 51: protobuf.SetCreateRequestRequest.Data.Request.Comment
+	(*SetCreateRequestRequest_Data_Request_CustomField)(nil), // 52: protobuf.SetCreateRequestRequest.Data.Request.CustomField
+	(*SetCreateRequestRequest_Data_Request_Requester)(nil),   // 53: protobuf.SetCreateRequestRequest.Data.Request.Requester
+	(*timestamppb.Timestamp)(nil),                            // 54: google.protobuf.Timestamp
+}
+var file_zendesk_proto_depIdxs = []int32{
+	54, // 0: protobuf.Category.createdAt:type_name -> google.protobuf.Timestamp
+	54, // 1: protobuf.Category.updatedAt:type_name -> google.protobuf.Timestamp
+	54, // 2: protobuf.Section.createdAt:type_name -> google.protobuf.Timestamp
+	54, // 3: protobuf.Section.updatedAt:type_name -> google.protobuf.Timestamp
+	54, // 4: protobuf.Article.createdAt:type_name -> google.protobuf.Timestamp
+	54, // 5: protobuf.Article.updatedAt:type_name -> google.protobuf.Timestamp
+	54, // 6: protobuf.Article.editedAt:type_name -> google.protobuf.Timestamp
+	54, // 7: protobuf.TicketField.createdAt:type_name -> google.protobuf.Timestamp
+	54, // 8: protobuf.TicketField.updatedAt:type_name -> google.protobuf.Timestamp
+	10, // 9: protobuf.TicketField.customFieldOptions:type_name -> protobuf.CustomFieldOption
+	11, // 10: protobuf.TicketField.systemFieldOptions:type_name -> protobuf.SystemFieldOption
+	54, // 11: protobuf.SearchBodyArticle.createdAt:type_name -> google.protobuf.Timestamp
+	54, // 12: protobuf.SearchBodyArticle.updatedAt:type_name -> google.protobuf.Timestamp
+	54, // 13: protobuf.SearchBodyArticle.editedAt:type_name -> google.protobuf.Timestamp
+	0,  // 14: protobuf.GetCategoriesRequest.countryCode:type_name -> protobuf.CountryCode
+	1,  // 15: protobuf.GetCategoriesRequest.locale:type_name -> protobuf.Locale
+	2,  // 16: protobuf.GetCategoriesRequest.sortBy:type_name -> protobuf.SortBy
+	3,  // 17: protobuf.GetCategoriesRequest.sortOrder:type_name -> protobuf.SortOrder
+	14, // 18: protobuf.GetCategoriesResponse.pageInfo:type_name -> protobuf.PageInfo
+	6,  // 19: protobuf.GetCategoriesResponse.categories:type_name -> protobuf.Category
+	0,  // 20: protobuf.GetCategoryRequest.countryCode:type_name -> protobuf.CountryCode
+	1,  // 21: protobuf.GetCategoryRequest.locale:type_name -> protobuf.Locale
+	6,  // 22: protobuf.GetCategoryResponse.category:type_name -> protobuf.Category
+	0,  // 23: protobuf.GetSectionsRequest.countryCode:type_name -> protobuf.CountryCode
+	1,  // 24: protobuf.GetSectionsRequest.locale:type_name -> protobuf.Locale
+	2,  // 25: protobuf.GetSectionsRequest.sortBy:type_name -> protobuf.SortBy
+	3,  // 26: protobuf.GetSectionsRequest.sortOrder:type_name -> protobuf.SortOrder
+	14, // 27: protobuf.GetSectionsResponse.pageInfo:type_name -> protobuf.PageInfo
+	7,  // 28: protobuf.GetSectionsResponse.sections:type_name -> protobuf.Section
+	0,  // 29: protobuf.GetSectionRequest.countryCode:type_name -> protobuf.CountryCode
+	1,  // 30: protobuf.GetSectionRequest.locale:type_name -> protobuf.Locale
+	7,  // 31: protobuf.GetSectionResponse.section:type_name -> protobuf.Section
+	0,  // 32: protobuf.GetArticlesRequest.countryCode:type_name -> protobuf.CountryCode
+	1,  // 33: protobuf.GetArticlesRequest.locale:type_name -> protobuf.Locale
+	2,  // 34: protobuf.GetArticlesRequest.sortBy:type_name -> protobuf.SortBy
+	3,  // 35: protobuf.GetArticlesRequest.sortOrder:type_name -> protobuf.SortOrder
+	14, // 36: protobuf.GetArticlesResponse.pageInfo:type_name -> protobuf.PageInfo
+	8,  // 37: protobuf.GetArticlesResponse.articles:type_name -> protobuf.Article
+	0,  // 38: protobuf.GetTopArticlesRequest.countryCode:type_name -> protobuf.CountryCode
+	1,  // 39: protobuf.GetTopArticlesRequest.locale:type_name -> protobuf.Locale
+	8,  // 40: protobuf.GetTopArticlesResponse.articles:type_name -> protobuf.Article
+	0,  // 41: protobuf.GetArticleRequest.countryCode:type_name -> protobuf.CountryCode
+	1,  // 42: protobuf.GetArticleRequest.locale:type_name -> protobuf.Locale
+	8,  // 43: protobuf.GetArticleResponse.article:type_name -> protobuf.Article
+	54, // 44: protobuf.GetTicketFormResponse.createdAt:type_name -> google.protobuf.Timestamp
+	54, // 45: protobuf.GetTicketFormResponse.updatedAt:type_name -> google.protobuf.Timestamp
+	1,  // 46: protobuf.GetTicketFieldsRequest.locale:type_name -> protobuf.Locale
+	9,  // 47: protobuf.GetTicketFieldsResponse.ticketFields:type_name -> protobuf.TicketField
+	0,  // 48: protobuf.GetSearchTitleArticlesRequest.countryCode:type_name -> protobuf.CountryCode
+	1,  // 49: protobuf.GetSearchTitleArticlesRequest.locale:type_name -> protobuf.Locale
+	12, // 50: protobuf.GetSearchTitleArticlesResponse.articles:type_name -> protobuf.SearchTitleArticle
+	0,  // 51: protobuf.GetSearchBodyArticlesRequest.countryCode:type_name -> protobuf.CountryCode
+	1,  // 52: protobuf.GetSearchBodyArticlesRequest.locale:type_name -> protobuf.Locale
+	3,  // 53: protobuf.GetSearchBodyArticlesRequest.sortOrder:type_name -> protobuf.SortOrder
+	14, // 54: protobuf.GetSearchBodyArticlesResponse.pageInfo:type_name -> protobuf.PageInfo
+	13, // 55: protobuf.GetSearchBodyArticlesResponse.articles:type_name -> protobuf.SearchBodyArticle
+	54, // 56: protobuf.GetStatusResponse.serverTime:type_name -> google.protobuf.Timestamp
+	0,  // 57: protobuf.SetCreateRequestRequest.countryCode:type_name -> protobuf.CountryCode
+	49, // 58: protobuf.SetCreateRequestRequest.data:type_name -> protobuf.SetCreateRequestRequest.Data
+	0,  // 59: protobuf.SetVoteArticleRequest.countryCode:type_name -> protobuf.CountryCode
+	1,  // 60: protobuf.SetVoteArticleRequest.locale:type_name -> protobuf.Locale
+	4,  // 61: protobuf.SetVoteArticleRequest.vote:type_name -> protobuf.Vote
+	8,  // 62: protobuf.SetVoteArticleResponse.article:type_name -> protobuf.Article
+	0,  // 63: protobuf.StreamArticlesRequest.countryCode:type_name -> protobuf.CountryCode
+	1,  // 64: protobuf.StreamArticlesRequest.locale:type_name -> protobuf.Locale
+	54, // 65: protobuf.StreamArticlesRequest.since:type_name -> google.protobuf.Timestamp
+	8,  // 66: protobuf.StreamArticlesResponse.article:type_name -> protobuf.Article
+	0,  // 67: protobuf.WatchChangesRequest.countryCode:type_name -> protobuf.CountryCode
+	1,  // 68: protobuf.WatchChangesRequest.locale:type_name -> protobuf.Locale
+	5,  // 69: protobuf.WatchChangesResponse.type:type_name -> protobuf.ChangeType
+	50, // 70: protobuf.SetCreateRequestRequest.Data.request:type_name -> protobuf.SetCreateRequestRequest.Data.Request
+	51, // 71: protobuf.SetCreateRequestRequest.Data.Request.comment:type_name -> protobuf.SetCreateRequestRequest.Data.Request.Comment
+	53, // 72: protobuf.SetCreateRequestRequest.Data.Request.requester:type_name -> protobuf.SetCreateRequestRequest.Data.Request.Requester
+	52, // 73: protobuf.SetCreateRequestRequest.Data.Request.customFields:type_name -> protobuf.SetCreateRequestRequest.Data.Request.CustomField
+	15, // 74: protobuf.Zendesk.GetCategories:input_type -> protobuf.GetCategoriesRequest
+	17, // 75: protobuf.Zendesk.GetCategory:input_type -> protobuf.GetCategoryRequest
+	19, // 76: protobuf.Zendesk.GetSections:input_type -> protobuf.GetSectionsRequest
+	21, // 77: protobuf.Zendesk.GetSection:input_type -> protobuf.GetSectionRequest
+	23, // 78: protobuf.Zendesk.GetArticles:input_type -> protobuf.GetArticlesRequest
+	25, // 79: protobuf.Zendesk.GetTopArticles:input_type -> protobuf.GetTopArticlesRequest
+	27, // 80: protobuf.Zendesk.GetArticle:input_type -> protobuf.GetArticleRequest
+	29, // 81: protobuf.Zendesk.GetTicketForm:input_type -> protobuf.GetTicketFormRequest
+	31, // 82: protobuf.Zendesk.GetTicketFields:input_type -> protobuf.GetTicketFieldsRequest
+	33, // 83: protobuf.Zendesk.GetSearchTitleArticles:input_type -> protobuf.GetSearchTitleArticlesRequest
+	35, // 84: protobuf.Zendesk.GetSearchBodyArticles:input_type -> protobuf.GetSearchBodyArticlesRequest
+	37, // 85: protobuf.Zendesk.GetStatus:input_type -> protobuf.GetStatusRequest
+	39, // 86: protobuf.Zendesk.SetCreateRequest:input_type -> protobuf.SetCreateRequestRequest
+	41, // 87: protobuf.Zendesk.SetVoteArticle:input_type -> protobuf.SetVoteArticleRequest
+	43, // 88: protobuf.Zendesk.SetForceSync:input_type -> protobuf.SetForceSyncRequest
+	45, // 89: protobuf.Zendesk.StreamArticles:input_type -> protobuf.StreamArticlesRequest
+	47, // 90: protobuf.Zendesk.WatchChanges:input_type -> protobuf.WatchChangesRequest
+	16, // 91: protobuf.Zendesk.GetCategories:output_type -> protobuf.GetCategoriesResponse
+	18, // 92: protobuf.Zendesk.GetCategory:output_type -> protobuf.GetCategoryResponse
+	20, // 93: protobuf.Zendesk.GetSections:output_type -> protobuf.GetSectionsResponse
+	22, // 94: protobuf.Zendesk.GetSection:output_type -> protobuf.GetSectionResponse
+	24, // 95: protobuf.Zendesk.GetArticles:output_type -> protobuf.GetArticlesResponse
+	26, // 96: protobuf.Zendesk.GetTopArticles:output_type -> protobuf.GetTopArticlesResponse
+	28, // 97: protobuf.Zendesk.GetArticle:output_type -> protobuf.GetArticleResponse
+	30, // 98: protobuf.Zendesk.GetTicketForm:output_type -> protobuf.GetTicketFormResponse
+	32, // 99: protobuf.Zendesk.GetTicketFields:output_type -> protobuf.GetTicketFieldsResponse
+	34, // 100: protobuf.Zendesk.GetSearchTitleArticles:output_type -> protobuf.GetSearchTitleArticlesResponse
+	36, // 101: protobuf.Zendesk.GetSearchBodyArticles:output_type -> protobuf.GetSearchBodyArticlesResponse
+	38, // 102: protobuf.Zendesk.GetStatus:output_type -> protobuf.GetStatusResponse
+	40, // 103: protobuf.Zendesk.SetCreateRequest:output_type -> protobuf.SetCreateRequestResponse
+	42, // 104: protobuf.Zendesk.SetVoteArticle:output_type -> protobuf.SetVoteArticleResponse
+	44, // 105: protobuf.Zendesk.SetForceSync:output_type -> protobuf.SetForceSyncResponse
+	46, // 106: protobuf.Zendesk.StreamArticles:output_type -> protobuf.StreamArticlesResponse
+	48, // 107: protobuf.Zendesk.WatchChanges:output_type -> protobuf.WatchChangesResponse
+	91, // [91:108] is the sub-list for method output_type
+	74, // [74:91] is the sub-list for method input_type
+	74, // [74:74] is the sub-list for extension type_name
+	74, // [74:74] is the sub-list for extension extendee
+	0,  // [0:74] is the sub-list for field type_name
+}
+
+func init() { file_zendesk_proto_init() }
+func file_zendesk_proto_init() {
+	if File_zendesk_proto != nil {
+		return
+	}
+	file_zendesk_proto_msgTypes[11].OneofWrappers = []any{
+		(*GetCategoryRequest_CategoryIdOrKeyname)(nil),
+		(*GetCategoryRequest_SectionId)(nil),
+		(*GetCategoryRequest_ArticleId)(nil),
 	}
-	if interceptor == nil {
-		return srv.(ZendeskServer).SetForceSync(ctx, in)
+	file_zendesk_proto_msgTypes[13].OneofWrappers = []any{
+		(*GetSectionsRequest_All)(nil),
+		(*GetSectionsRequest_CategoryId)(nil),
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/protobuf.Zendesk/SetForceSync",
+	file_zendesk_proto_msgTypes[15].OneofWrappers = []any{
+		(*GetSectionRequest_SectionId)(nil),
+		(*GetSectionRequest_ArticleId)(nil),
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZendeskServer).SetForceSync(ctx, req.(*SetForceSyncRequest))
+	file_zendesk_proto_msgTypes[17].OneofWrappers = []any{
+		(*GetArticlesRequest_All)(nil),
+		(*GetArticlesRequest_CategoryId)(nil),
+		(*GetArticlesRequest_SectionId)(nil),
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _Zendesk_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "protobuf.Zendesk",
-	HandlerType: (*ZendeskServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetCategories",
-			Handler:    _Zendesk_GetCategories_Handler,
-		},
-		{
-			MethodName: "GetCategory",
-			Handler:    _Zendesk_GetCategory_Handler,
-		},
-		{
-			MethodName: "GetSections",
-			Handler:    _Zendesk_GetSections_Handler,
-		},
-		{
-			MethodName: "GetSection",
-			Handler:    _Zendesk_GetSection_Handler,
-		},
-		{
-			MethodName: "GetArticles",
-			Handler:    _Zendesk_GetArticles_Handler,
-		},
-		{
-			MethodName: "GetTopArticles",
-			Handler:    _Zendesk_GetTopArticles_Handler,
-		},
-		{
-			MethodName: "GetArticle",
-			Handler:    _Zendesk_GetArticle_Handler,
-		},
-		{
-			MethodName: "GetTicketForm",
-			Handler:    _Zendesk_GetTicketForm_Handler,
-		},
-		{
-			MethodName: "GetTicketFields",
-			Handler:    _Zendesk_GetTicketFields_Handler,
-		},
-		{
-			MethodName: "GetSearchTitleArticles",
-			Handler:    _Zendesk_GetSearchTitleArticles_Handler,
-		},
-		{
-			MethodName: "GetSearchBodyArticles",
-			Handler:    _Zendesk_GetSearchBodyArticles_Handler,
-		},
-		{
-			MethodName: "GetStatus",
-			Handler:    _Zendesk_GetStatus_Handler,
-		},
-		{
-			MethodName: "SetCreateRequest",
-			Handler:    _Zendesk_SetCreateRequest_Handler,
-		},
-		{
-			MethodName: "SetVoteArticle",
-			Handler:    _Zendesk_SetVoteArticle_Handler,
-		},
-		{
-			MethodName: "SetForceSync",
-			Handler:    _Zendesk_SetForceSync_Handler,
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_zendesk_proto_rawDesc), len(file_zendesk_proto_rawDesc)),
+			NumEnums:      6,
+			NumMessages:   48,
+			NumExtensions: 0,
+			NumServices:   1,
 		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "zendesk.proto",
-}
-
-func init() { proto.RegisterFile("zendesk.proto", fileDescriptor_zendesk_5b1f35beb19b3f1c) }
-
-var fileDescriptor_zendesk_5b1f35beb19b3f1c = []byte{
-	// 2513 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcd, 0x6f, 0x1c, 0x49,
-	0x15, 0x9f, 0xef, 0x8f, 0x37, 0xfe, 0x68, 0x97, 0x3f, 0xd2, 0x74, 0x9c, 0xec, 0x6c, 0x6b, 0x95,
-	0x1d, 0x19, 0x31, 0x0b, 0xb3, 0xb0, 0x1b, 0x22, 0x71, 0x70, 0x66, 0x9c, 0x78, 0x36, 0x59, 0x8f,
-	0x55, 0x33, 0xf6, 0x92, 0x20, 0x61, 0xb5, 0xa7, 0x2b, 0x4e, 0x6f, 0x7a, 0xa6, 0x27, 0xdd, 0x3d,
-	0x0e, 0x03, 0x47, 0x38, 0x20, 0x24, 0x38, 0x71, 0xe0, 0xc8, 0x85, 0x33, 0x27, 0x6e, 0x08, 0x71,
-	0xe1, 0x2f, 0xe0, 0x0a, 0x7f, 0x04, 0x37, 0x4e, 0x08, 0x75, 0x7d, 0x74, 0x57, 0x7f, 0x38, 0x8e,
-	0xbd, 0x52, 0x00, 0x91, 0x93, 0xfb, 0xbd, 0x7a, 0xf5, 0xea, 0xd5, 0x7b, 0xbf, 0xf7, 0x5e, 0x55,
-	0x8d, 0x61, 0xf9, 0xc7, 0x64, 0x6a, 0x12, 0xef, 0x45, 0x7b, 0xe6, 0x3a, 0xbe, 0x83, 0x6a, 0xf4,
-	0xcf, 0xe9, 0xfc, 0x99, 0xf6, 0xde, 0x99, 0xe3, 0x9c, 0xd9, 0xe4, 0x23, 0xc1, 0xf8, 0xc8, 0xb7,
-	0x26, 0xc4, 0xf3, 0x8d, 0xc9, 0x8c, 0x89, 0xea, 0xbf, 0x2d, 0x42, 0xad, 0x6b, 0xf8, 0xe4, 0xcc,
-	0x71, 0x17, 0x68, 0x05, 0x0a, 0x96, 0xa9, 0xe6, 0x9b, 0xf9, 0x56, 0x1d, 0x17, 0x2c, 0x13, 0x69,
-	0x50, 0x9b, 0x39, 0x9e, 0xe5, 0x5b, 0xce, 0x54, 0x2d, 0x34, 0xf3, 0xad, 0x32, 0x0e, 0x69, 0x74,
-	0x17, 0xea, 0x63, 0x97, 0x18, 0x3e, 0x31, 0x77, 0x7d, 0xb5, 0xd8, 0xcc, 0xb7, 0x1a, 0x1d, 0xad,
-	0xcd, 0x56, 0x6b, 0x8b, 0xd5, 0xda, 0x23, 0xb1, 0x1a, 0x8e, 0x84, 0x83, 0x99, 0xf3, 0x99, 0xc9,
-	0x67, 0x96, 0x2e, 0x9f, 0x19, 0x0a, 0x23, 0x1d, 0x96, 0x3c, 0x67, 0xee, 0x8e, 0xc9, 0x63, 0x67,
-	0x6c, 0xd8, 0x44, 0x2d, 0x53, 0x4b, 0x63, 0xbc, 0xc0, 0x66, 0x67, 0xee, 0xd3, 0x19, 0x6a, 0xa5,
-	0x99, 0x6f, 0xd5, 0x70, 0x48, 0xa3, 0x26, 0x34, 0xc6, 0xce, 0x7c, 0xea, 0xbb, 0x8b, 0xae, 0x63,
-	0x12, 0xb5, 0x4a, 0xa7, 0xcb, 0x2c, 0xa4, 0x42, 0xf5, 0x05, 0x59, 0x1c, 0x18, 0x13, 0xa2, 0xd6,
-	0xe8, 0xa8, 0x20, 0x91, 0x02, 0xc5, 0xb9, 0x6b, 0xab, 0x75, 0xca, 0x0d, 0x3e, 0x03, 0xd9, 0xe7,
-	0xfe, 0xc4, 0x3e, 0x72, 0x6d, 0x15, 0x98, 0x2c, 0x27, 0x11, 0x82, 0xd2, 0x34, 0x50, 0xd1, 0xa0,
-	0x6c, 0xfa, 0x1d, 0xac, 0x6d, 0x12, 0x6f, 0xec, 0x5a, 0x33, 0xea, 0xce, 0x25, 0xb6, 0xb6, 0xc4,
-	0x42, 0x5b, 0x50, 0xb1, 0xd9, 0xbe, 0x96, 0xe9, 0x20, 0xa7, 0xf4, 0xdf, 0x15, 0xa1, 0x3a, 0x24,
-	0x63, 0x2a, 0xf3, 0x2e, 0x42, 0x3c, 0x0e, 0xb5, 0xcc, 0x38, 0xd4, 0xb3, 0xe3, 0x00, 0x17, 0xc7,
-	0xa1, 0xf1, 0xba, 0x38, 0x2c, 0xc9, 0x71, 0x40, 0xb7, 0x01, 0xc6, 0x3c, 0x53, 0xfa, 0x26, 0x8f,
-	0x91, 0xc4, 0xd1, 0xff, 0x5e, 0x86, 0xea, 0xae, 0xeb, 0x5b, 0x63, 0x9b, 0x64, 0xc5, 0xc9, 0x98,
-	0xfb, 0xcf, 0x1d, 0xb7, 0x6f, 0xd2, 0x38, 0xd5, 0x71, 0x48, 0xa3, 0x16, 0xac, 0x8e, 0x9d, 0xc9,
-	0x84, 0x4c, 0x7d, 0xaf, 0x67, 0x79, 0xc6, 0xa9, 0x4d, 0x68, 0xb4, 0x6a, 0x38, 0xc9, 0x46, 0x1b,
-	0x50, 0x36, 0x5d, 0xe3, 0x19, 0x8b, 0x49, 0x0d, 0x33, 0x82, 0x62, 0xc0, 0x75, 0x26, 0x4e, 0xe0,
-	0xcf, 0x32, 0xf3, 0xa7, 0xa0, 0x63, 0xf8, 0xa8, 0x24, 0xf0, 0xa1, 0x42, 0xf5, 0xdc, 0xf1, 0xc9,
-	0x70, 0x3e, 0xa1, 0x7e, 0x2e, 0x63, 0x41, 0xa2, 0x6d, 0xa8, 0x07, 0x9f, 0xdd, 0xc0, 0xed, 0xd4,
-	0xd3, 0x65, 0x1c, 0x31, 0xe2, 0xb8, 0xaa, 0x5f, 0x1b, 0x57, 0xf0, 0x55, 0x70, 0xd5, 0xb8, 0x04,
-	0x57, 0x4b, 0x09, 0x5c, 0xb5, 0x60, 0x55, 0x7c, 0x33, 0x69, 0x4f, 0x5d, 0x6e, 0x16, 0x5b, 0x75,
-	0x9c, 0x64, 0xa3, 0x4f, 0xa0, 0x46, 0x4c, 0x8b, 0x99, 0xb8, 0x72, 0xa9, 0x89, 0xa1, 0x6c, 0x80,
-	0x0e, 0xdb, 0x38, 0x25, 0x76, 0x50, 0x2c, 0x3c, 0x75, 0x95, 0x2a, 0x97, 0x38, 0x49, 0x64, 0x2b,
-	0x17, 0x22, 0x7b, 0x2d, 0x13, 0xd9, 0x28, 0x1b, 0xd9, 0xeb, 0x12, 0xb2, 0x37, 0xa0, 0xec, 0x5b,
-	0xbe, 0x4d, 0xd4, 0x0d, 0xca, 0x64, 0x44, 0x20, 0x79, 0xea, 0x98, 0x0b, 0x75, 0x93, 0x49, 0x06,
-	0xdf, 0x12, 0xc2, 0xb7, 0x62, 0x08, 0xdf, 0x86, 0xba, 0xc7, 0x0a, 0x4d, 0xdf, 0x54, 0x6f, 0xd0,
-	0xa1, 0x88, 0xa1, 0xff, 0xb4, 0x0a, 0x8d, 0x91, 0x35, 0x7e, 0x41, 0xfc, 0x07, 0x16, 0xb1, 0xcd,
-	0x14, 0xc6, 0xb9, 0xfd, 0x85, 0xc8, 0x7e, 0x04, 0x25, 0x7f, 0x31, 0x63, 0x70, 0xae, 0x63, 0xfa,
-	0x1d, 0x59, 0x59, 0x92, 0xad, 0xd4, 0xa0, 0xe6, 0x1a, 0xaf, 0x46, 0x74, 0x80, 0xd5, 0x8c, 0x90,
-	0x4e, 0x66, 0x6c, 0x25, 0x9d, 0xb1, 0x77, 0x60, 0xc5, 0x35, 0x5e, 0xf5, 0x24, 0x21, 0x56, 0x38,
-	0x12, 0xdc, 0x58, 0x36, 0xd4, 0x12, 0xd9, 0xb0, 0x05, 0x15, 0x63, 0xec, 0x5b, 0xe7, 0x84, 0x42,
-	0xba, 0x86, 0x39, 0x45, 0x2d, 0x23, 0x2f, 0xe7, 0x96, 0x4b, 0x4c, 0x0a, 0xd9, 0x1a, 0x0e, 0x69,
-	0xd4, 0x06, 0x34, 0x76, 0x6c, 0xdb, 0x98, 0x79, 0xc4, 0x7c, 0xe0, 0xb8, 0xbb, 0x67, 0x41, 0xb2,
-	0x52, 0x6c, 0xd6, 0x70, 0xc6, 0x08, 0xfa, 0x26, 0xac, 0xbb, 0xe4, 0x8c, 0xfc, 0x68, 0xf6, 0xc0,
-	0x71, 0x8f, 0x0d, 0xdb, 0x32, 0x0d, 0xa9, 0x17, 0x64, 0x0d, 0xa1, 0x0f, 0x60, 0x99, 0x3a, 0xa8,
-	0x3f, 0x3d, 0x74, 0x5c, 0xdf, 0xb0, 0x79, 0xd9, 0x89, 0x33, 0xd1, 0x0e, 0x28, 0xc2, 0x5b, 0xa1,
-	0xe0, 0x0a, 0x15, 0x4c, 0xf1, 0x83, 0x4c, 0x38, 0xb7, 0x3c, 0xeb, 0x54, 0x12, 0x5d, 0x65, 0xd5,
-	0x26, 0xc1, 0x0e, 0xb4, 0x06, 0xe8, 0x36, 0x64, 0x51, 0x85, 0x8a, 0xa6, 0xf8, 0xd4, 0x02, 0xee,
-	0x95, 0x50, 0x76, 0x8d, 0xc9, 0x26, 0xf9, 0x01, 0x4e, 0x7c, 0xe3, 0x8c, 0x23, 0x3a, 0xf8, 0x8c,
-	0x57, 0x94, 0xf5, 0x6b, 0x57, 0x94, 0x8d, 0xab, 0x54, 0x94, 0x6d, 0xa8, 0xbb, 0x64, 0xe2, 0x9c,
-	0xd3, 0x7a, 0xbb, 0x49, 0x4d, 0x8d, 0x18, 0xe8, 0x11, 0xa0, 0xf1, 0xdc, 0xf3, 0x9d, 0x09, 0x85,
-	0xfa, 0x80, 0xc2, 0xc7, 0x53, 0xb7, 0x9a, 0xc5, 0x56, 0xa3, 0x73, 0x33, 0xd2, 0xdc, 0x4d, 0xca,
-	0xe0, 0x8c, 0x69, 0x81, 0x32, 0x6f, 0xe1, 0xf9, 0x24, 0xae, 0xec, 0x46, 0x52, 0xd9, 0x30, 0x29,
-	0x83, 0x33, 0xa6, 0xe9, 0x67, 0xb0, 0x96, 0x5a, 0x35, 0x95, 0x8a, 0xa2, 0x3c, 0x14, 0xa4, 0xf2,
-	0xa0, 0x42, 0xd5, 0x35, 0x5e, 0xd1, 0xa3, 0x0d, 0xcb, 0x47, 0x41, 0x06, 0x29, 0x79, 0x6e, 0xd8,
-	0xf3, 0x30, 0x25, 0x29, 0xa1, 0x7f, 0x0f, 0xd6, 0x52, 0x16, 0x85, 0x8a, 0xf3, 0xf1, 0xba, 0xc3,
-	0xa6, 0x17, 0xe4, 0xe9, 0xa7, 0x80, 0x86, 0xc4, 0x70, 0xc7, 0xcf, 0x29, 0xfc, 0x44, 0x5f, 0x0c,
-	0xb3, 0x3f, 0x2f, 0x67, 0xff, 0x07, 0xb0, 0x2c, 0xfa, 0x28, 0x2b, 0x01, 0x4c, 0x53, 0x9c, 0x29,
-	0xea, 0x4b, 0x31, 0xac, 0x2f, 0xfa, 0x5f, 0x2a, 0xb0, 0xc6, 0x16, 0xb9, 0xef, 0x98, 0x8b, 0x77,
-	0xbd, 0xf7, 0x5d, 0xef, 0xfd, 0xdf, 0xed, 0xbd, 0x2a, 0x54, 0xbd, 0xa9, 0x35, 0x9b, 0x11, 0x9f,
-	0x77, 0x5e, 0x41, 0xc6, 0xbb, 0xb2, 0x9a, 0xe8, 0xca, 0x89, 0x53, 0xe9, 0xd7, 0x92, 0xa7, 0xd2,
-	0x20, 0x7a, 0x82, 0xa2, 0xb9, 0xaf, 0xb1, 0xe8, 0xc9, 0x3c, 0xdd, 0x86, 0xda, 0xa1, 0x71, 0x46,
-	0xfa, 0xd3, 0x67, 0x4e, 0x60, 0xc7, 0x8c, 0xb8, 0x01, 0x49, 0x53, 0xa8, 0x8c, 0x05, 0x19, 0xec,
-	0x66, 0x16, 0xb0, 0xd9, 0x3d, 0x83, 0x7e, 0x07, 0xb6, 0x05, 0x7f, 0x19, 0x5a, 0x8b, 0x0c, 0xad,
-	0x21, 0x23, 0xf0, 0x0a, 0x75, 0x32, 0xcd, 0x99, 0x32, 0x66, 0x84, 0xfe, 0xb3, 0x02, 0x6c, 0x3c,
-	0x24, 0x3e, 0xbf, 0x75, 0x5a, 0xc4, 0xc3, 0xe4, 0xe5, 0x9c, 0x78, 0x3e, 0xfa, 0x34, 0x1e, 0xa6,
-	0x60, 0xf9, 0x95, 0xce, 0xa6, 0x54, 0x6d, 0xa3, 0xc1, 0x78, 0xf4, 0x5a, 0xa1, 0x4f, 0x0b, 0x74,
-	0x8e, 0x12, 0xcd, 0x61, 0xd0, 0x0a, 0xbd, 0xdc, 0x82, 0x8a, 0xe7, 0xb8, 0xfe, 0xfd, 0x05, 0x35,
-	0x36, 0x26, 0x39, 0xa4, 0x7c, 0xcc, 0xc7, 0xd1, 0xb7, 0xa0, 0x1e, 0x7c, 0x0d, 0x5c, 0x93, 0xb8,
-	0xd4, 0xfe, 0x95, 0xce, 0x7a, 0x5c, 0x98, 0x0e, 0xe1, 0x48, 0x4a, 0x76, 0x5d, 0x39, 0xdb, 0x75,
-	0x95, 0xc8, 0x75, 0xfa, 0x4f, 0x60, 0x33, 0xe1, 0x05, 0x6f, 0xe6, 0x4c, 0x3d, 0x82, 0xda, 0x50,
-	0x9b, 0xf1, 0x68, 0x50, 0x1f, 0x34, 0x3a, 0x28, 0x5a, 0x58, 0xc4, 0x09, 0x87, 0x32, 0xa8, 0x13,
-	0x22, 0xc0, 0x22, 0x9e, 0x5a, 0xa0, 0x6d, 0x45, 0x9a, 0x21, 0x6e, 0xf7, 0x58, 0x92, 0xd2, 0xff,
-	0x91, 0x07, 0x14, 0xad, 0xbe, 0x78, 0x8b, 0x11, 0xe8, 0xc0, 0x7a, 0x84, 0xce, 0x81, 0xfb, 0x88,
-	0x2c, 0xa6, 0x61, 0x4b, 0xda, 0xcf, 0xe1, 0xac, 0x41, 0x74, 0x5b, 0xce, 0x80, 0x12, 0x97, 0x8c,
-	0xe5, 0x40, 0xdd, 0x60, 0xc5, 0xbf, 0xcf, 0xca, 0x30, 0x1d, 0x0f, 0x59, 0xf7, 0x4b, 0x50, 0xe8,
-	0x9b, 0xfa, 0x1e, 0xac, 0xc7, 0xb6, 0x1c, 0xb9, 0x5b, 0xac, 0x99, 0x76, 0x77, 0x28, 0x1d, 0xca,
-	0xe8, 0x7f, 0x2a, 0x50, 0xd7, 0xf1, 0x1b, 0xf9, 0xff, 0x27, 0x78, 0x11, 0x82, 0xa2, 0x61, 0xdb,
-	0xb4, 0x77, 0xd5, 0xf6, 0x73, 0x38, 0x20, 0x50, 0x33, 0x56, 0x89, 0x6a, 0x3c, 0x0c, 0x12, 0x8f,
-	0xc7, 0xc1, 0xa7, 0x71, 0x88, 0xfc, 0x77, 0x4d, 0xd8, 0x7f, 0x03, 0x6a, 0x1c, 0x01, 0x02, 0xf4,
-	0x6b, 0xd2, 0x16, 0xd9, 0x08, 0x0e, 0x45, 0xf4, 0x3f, 0xe6, 0x61, 0x2d, 0x5a, 0xf6, 0x2d, 0x46,
-	0x2d, 0x06, 0xde, 0xe2, 0x25, 0xe0, 0x2d, 0x5d, 0x04, 0xde, 0x5d, 0x19, 0x74, 0xa1, 0xcf, 0xbe,
-	0x0e, 0x55, 0xae, 0x88, 0xbb, 0x2c, 0xc3, 0x05, 0x42, 0x42, 0xff, 0x17, 0x03, 0x2e, 0x3f, 0x26,
-	0xbd, 0x03, 0xee, 0x9b, 0x03, 0x37, 0x1e, 0xc3, 0x7a, 0x56, 0x0c, 0xe5, 0x03, 0x08, 0x24, 0x0f,
-	0x20, 0x31, 0xe0, 0x47, 0xfe, 0xbf, 0x3e, 0xf0, 0x39, 0x3a, 0x32, 0x80, 0xcf, 0xb5, 0xe3, 0x50,
-	0x44, 0xff, 0x55, 0x9e, 0x36, 0x9a, 0x91, 0x33, 0xfb, 0x0f, 0x44, 0x1e, 0x41, 0xc9, 0x77, 0x66,
-	0x07, 0xfc, 0x68, 0x40, 0xbf, 0xf5, 0x87, 0xb0, 0x95, 0xb4, 0x87, 0x7b, 0x42, 0xde, 0x59, 0xfe,
-	0xf2, 0x9d, 0xfd, 0x9a, 0xa5, 0xb4, 0x18, 0x78, 0x7b, 0xbb, 0xda, 0x96, 0x53, 0x96, 0xdd, 0x47,
-	0x22, 0x06, 0x4f, 0xd5, 0xd0, 0xaa, 0x28, 0x55, 0xb9, 0x48, 0x3a, 0x55, 0x85, 0xac, 0x90, 0xd0,
-	0xdb, 0xf4, 0x84, 0xc4, 0x1f, 0x5b, 0x1c, 0x77, 0x22, 0xf6, 0xb6, 0x05, 0x95, 0x67, 0x8e, 0x3b,
-	0xe9, 0x8b, 0xeb, 0x0d, 0xa7, 0xf4, 0xbf, 0x16, 0x59, 0x8c, 0xa5, 0x09, 0x7c, 0xd9, 0x37, 0x7a,
-	0xa4, 0x89, 0x3a, 0x70, 0xfa, 0xae, 0x58, 0x8a, 0xdf, 0x15, 0x9b, 0xd0, 0x30, 0x2d, 0x6f, 0x66,
-	0x1b, 0xec, 0x34, 0x59, 0xe6, 0x8f, 0x31, 0x11, 0x4b, 0x3c, 0xc6, 0x48, 0x42, 0x95, 0xe8, 0x31,
-	0x26, 0x2e, 0x47, 0xa6, 0xe6, 0x91, 0x47, 0xdc, 0x63, 0xf6, 0xf0, 0xc0, 0x92, 0x12, 0x27, 0xb8,
-	0xd7, 0x7a, 0xb4, 0x69, 0x42, 0xc3, 0x9a, 0xee, 0xda, 0xf6, 0x7d, 0xd7, 0x98, 0x9a, 0x1e, 0x7f,
-	0xb7, 0x91, 0x59, 0xa8, 0x0d, 0xc8, 0x25, 0x9e, 0xef, 0x5a, 0x63, 0x9f, 0x98, 0x94, 0xd7, 0x37,
-	0x3d, 0xb5, 0xd1, 0x2c, 0xb6, 0xca, 0x38, 0x63, 0x24, 0x7e, 0xf1, 0x5a, 0xba, 0xf6, 0xc5, 0x6b,
-	0xf9, 0x0a, 0x17, 0x2f, 0xfd, 0x29, 0x4b, 0x94, 0xe8, 0xc9, 0xcd, 0xbb, 0x04, 0x07, 0x6f, 0x0e,
-	0x61, 0x7d, 0x04, 0x37, 0x52, 0xba, 0x39, 0x64, 0xbe, 0x0b, 0x4b, 0xbe, 0xc4, 0xe7, 0x99, 0xb8,
-	0x29, 0x1b, 0x1b, 0x8e, 0xe2, 0x98, 0xa8, 0xfe, 0x9b, 0x3c, 0xdc, 0xa2, 0x6d, 0x2a, 0x79, 0xf1,
-	0x7f, 0x9b, 0x35, 0x67, 0x03, 0xca, 0x2f, 0xe7, 0xc4, 0x5d, 0x70, 0x44, 0x33, 0x42, 0x7f, 0x0a,
-	0xb7, 0x2f, 0xb2, 0x8c, 0xef, 0xfb, 0x6e, 0xaa, 0xfa, 0x6c, 0xcb, 0xdd, 0x34, 0x39, 0x51, 0x2a,
-	0x44, 0xff, 0xcc, 0xc3, 0x76, 0xa8, 0x5c, 0x7a, 0x8a, 0x78, 0x9b, 0xbb, 0x8e, 0x75, 0xce, 0xe2,
-	0x55, 0x3b, 0x67, 0x29, 0xbb, 0x73, 0x96, 0xa5, 0xce, 0x19, 0xba, 0xb5, 0x22, 0xbb, 0xf5, 0xe7,
-	0x72, 0xc4, 0xe3, 0x5b, 0xbf, 0x66, 0x7b, 0xfb, 0x34, 0xd5, 0xde, 0x6e, 0x26, 0xc3, 0x20, 0xad,
-	0x23, 0x45, 0x01, 0x81, 0x12, 0x58, 0xe2, 0x1b, 0xfe, 0x5c, 0x38, 0x5e, 0xff, 0x25, 0x3f, 0xf5,
-	0x71, 0x26, 0x37, 0x69, 0x1b, 0xea, 0x67, 0xce, 0x31, 0x71, 0x3d, 0x71, 0x70, 0xaa, 0xe3, 0x88,
-	0x11, 0x34, 0x73, 0x63, 0x36, 0x13, 0xc3, 0xac, 0x52, 0x4a, 0x1c, 0x74, 0x0f, 0xc0, 0x23, 0xee,
-	0x39, 0x71, 0x83, 0xa4, 0x7d, 0x83, 0x1f, 0xd6, 0x24, 0x69, 0xfd, 0xcf, 0x65, 0xb8, 0x31, 0x24,
-	0x7e, 0x97, 0x56, 0x07, 0x6e, 0xe4, 0x57, 0x06, 0xc9, 0x3d, 0x28, 0x99, 0x86, 0x6f, 0x50, 0x53,
-	0x1b, 0x9d, 0x3b, 0xb2, 0xb7, 0x32, 0x57, 0x6a, 0xf7, 0x0c, 0xdf, 0xc0, 0x74, 0x8e, 0xf6, 0xfb,
-	0x12, 0x94, 0x02, 0x12, 0xed, 0x43, 0xd5, 0x65, 0xc3, 0x3c, 0x4a, 0xed, 0x37, 0xd3, 0xd3, 0x16,
-	0x3c, 0x31, 0x5d, 0xfb, 0x5b, 0x11, 0xaa, 0x62, 0x4f, 0x87, 0x50, 0xe5, 0x0f, 0x69, 0xdc, 0xba,
-	0x4f, 0xae, 0xa6, 0xb5, 0xdd, 0x65, 0xb3, 0xb1, 0x50, 0x83, 0x8e, 0xa1, 0xce, 0x17, 0xe2, 0x38,
-	0x6f, 0x74, 0xee, 0x5e, 0x51, 0x27, 0x16, 0xf3, 0x71, 0xa4, 0x8a, 0xbe, 0xbf, 0xcc, 0x4f, 0xbf,
-	0x24, 0x63, 0x5f, 0xb4, 0x3c, 0x4e, 0x22, 0x3d, 0xac, 0x87, 0xac, 0xe4, 0xf2, 0xdf, 0x34, 0x65,
-	0x1e, 0xfa, 0x21, 0x2c, 0x49, 0x0f, 0xbf, 0x9e, 0x5a, 0xa1, 0xc0, 0xbd, 0x77, 0xd5, 0xcd, 0x46,
-	0x2a, 0x70, 0x4c, 0x9f, 0x76, 0x0b, 0xaa, 0xdc, 0x13, 0xe1, 0xa3, 0x52, 0x3e, 0x7a, 0x54, 0xd2,
-	0x3e, 0x86, 0x86, 0x34, 0x37, 0xd5, 0xf4, 0x33, 0x5f, 0x68, 0xb5, 0xef, 0x40, 0x3d, 0xf4, 0xc4,
-	0x45, 0x0f, 0xbb, 0x64, 0x62, 0x58, 0xe2, 0xb4, 0xc0, 0x08, 0xbd, 0x03, 0x6a, 0x7a, 0x33, 0x3c,
-	0xb1, 0xb6, 0xa0, 0xe2, 0xd1, 0x54, 0x13, 0x7d, 0x89, 0x51, 0xc1, 0xe5, 0x6b, 0x73, 0x48, 0xfc,
-	0x63, 0xc7, 0x27, 0xff, 0x65, 0xa7, 0x35, 0xa4, 0x43, 0xe9, 0xdc, 0xf1, 0x09, 0xbf, 0x6c, 0xac,
-	0x44, 0x5a, 0x02, 0x63, 0x31, 0x1d, 0xd3, 0xf7, 0x60, 0x2b, 0x69, 0xfd, 0x75, 0x4e, 0x75, 0x9f,
-	0xc3, 0xfa, 0x90, 0x22, 0x66, 0x4c, 0x86, 0x8b, 0xe9, 0x58, 0xb8, 0x40, 0x83, 0xda, 0xdc, 0x23,
-	0xae, 0xe4, 0xfe, 0x90, 0xa6, 0x87, 0x1f, 0xc3, 0xf3, 0x5e, 0x39, 0x6e, 0xf8, 0x76, 0x2d, 0xe8,
-	0xe0, 0x90, 0x18, 0x57, 0xf7, 0xfa, 0x20, 0xec, 0xfc, 0x21, 0x0f, 0x0d, 0xc9, 0x9d, 0x68, 0x1d,
-	0x56, 0xbb, 0x83, 0xa3, 0x83, 0x11, 0x7e, 0x72, 0xd2, 0x1d, 0xf4, 0xf6, 0x4e, 0x86, 0x0f, 0x95,
-	0x5c, 0x8a, 0xb9, 0xff, 0x48, 0xc9, 0xa7, 0x98, 0xa3, 0x2f, 0x94, 0x42, 0x8a, 0xf9, 0xd9, 0xa1,
-	0x52, 0x4c, 0x4b, 0xee, 0x2b, 0xa5, 0x14, 0xf3, 0xf3, 0x27, 0x4a, 0x39, 0xc5, 0xec, 0xf7, 0x94,
-	0x4a, 0x8a, 0x79, 0xb8, 0xaf, 0x54, 0x77, 0x5e, 0x40, 0x85, 0xbf, 0x32, 0x2b, 0xb0, 0xf4, 0x78,
-	0xd0, 0xdd, 0x7d, 0xbc, 0x77, 0xb2, 0x77, 0x70, 0x72, 0x34, 0x54, 0x72, 0x12, 0xe7, 0xe9, 0x7e,
-	0x60, 0x56, 0x3e, 0xce, 0xe9, 0x1e, 0x28, 0x05, 0xb4, 0x0c, 0x75, 0xce, 0xf9, 0x6c, 0x57, 0x29,
-	0x4a, 0x24, 0x35, 0x2e, 0x22, 0xfb, 0x3d, 0xa5, 0xbc, 0x73, 0x00, 0x15, 0x76, 0x25, 0x45, 0x1b,
-	0xa0, 0x0c, 0x07, 0x78, 0x74, 0x72, 0xff, 0xc9, 0xc9, 0xe1, 0x60, 0xd8, 0x1f, 0xf5, 0x07, 0x07,
-	0x4a, 0x0e, 0x6d, 0x01, 0x12, 0xdc, 0x2e, 0xde, 0xdb, 0x1d, 0xed, 0xf5, 0x4e, 0x76, 0x47, 0x4a,
-	0x5e, 0xe6, 0x1f, 0x1d, 0xf6, 0x04, 0xbf, 0xb0, 0xf3, 0x6d, 0xa8, 0x87, 0xbd, 0x17, 0x21, 0x58,
-	0xa1, 0x42, 0x03, 0xdc, 0xdb, 0xc3, 0x27, 0xbb, 0xc3, 0x2e, 0x73, 0xb8, 0xc4, 0xeb, 0xed, 0x0d,
-	0xbb, 0x4a, 0x7e, 0x47, 0x87, 0x52, 0x80, 0x36, 0xd4, 0x80, 0xea, 0xf1, 0x60, 0xb4, 0x77, 0x72,
-	0x74, 0xa8, 0xe4, 0x02, 0x4b, 0x29, 0xd1, 0x1b, 0x7c, 0x71, 0xa0, 0xe4, 0x3b, 0xbf, 0x00, 0xa8,
-	0x3e, 0x65, 0xff, 0xf6, 0x83, 0x30, 0x2c, 0xc7, 0xde, 0x12, 0xd1, 0xed, 0x08, 0x86, 0x59, 0x4f,
-	0xad, 0xda, 0x7b, 0x17, 0x8e, 0x33, 0x10, 0xe9, 0x39, 0xf4, 0x18, 0x1a, 0xd2, 0x73, 0x19, 0xda,
-	0xce, 0x9a, 0x21, 0x1e, 0x0e, 0xb5, 0x5b, 0x17, 0x8c, 0x26, 0xb4, 0x89, 0x47, 0x9f, 0x84, 0xb6,
-	0xc4, 0x5b, 0x5a, 0x42, 0x5b, 0xf2, 0xa5, 0x48, 0xcf, 0xa1, 0x3e, 0x40, 0x34, 0x80, 0x6e, 0x66,
-	0x89, 0x0b, 0x5d, 0xdb, 0xd9, 0x83, 0x09, 0xc3, 0xc4, 0xa9, 0x25, 0x61, 0x58, 0xe2, 0x1c, 0x97,
-	0x30, 0x2c, 0x79, 0xd4, 0xd1, 0x73, 0xe8, 0x08, 0x56, 0xe2, 0x77, 0x5b, 0x14, 0xf7, 0x74, 0xfa,
-	0x16, 0xae, 0x35, 0x2f, 0x16, 0x48, 0xec, 0x57, 0xfc, 0xc0, 0x75, 0x33, 0xcb, 0x8a, 0xec, 0xfd,
-	0x26, 0xea, 0x95, 0x9e, 0xe3, 0x50, 0x89, 0x6e, 0x8a, 0x09, 0xa8, 0xa4, 0xee, 0x9c, 0x09, 0xa8,
-	0xa4, 0xaf, 0x98, 0x7a, 0x0e, 0x7d, 0x1f, 0x56, 0x13, 0x97, 0x09, 0xd4, 0xcc, 0x9a, 0x25, 0xdf,
-	0x61, 0xb4, 0xf7, 0x5f, 0x23, 0x11, 0x6a, 0x9e, 0xd0, 0x2b, 0x50, 0xc6, 0xa9, 0x1d, 0x7d, 0x98,
-	0x88, 0xeb, 0x45, 0x37, 0x0e, 0xad, 0x75, 0xb9, 0x60, 0xb8, 0xdc, 0x97, 0xf4, 0x1a, 0x9d, 0x3e,
-	0xcc, 0xa2, 0x3b, 0x19, 0x4a, 0x32, 0x0e, 0xfa, 0xda, 0x87, 0x97, 0xca, 0x85, 0x6b, 0x3d, 0x80,
-	0x7a, 0x78, 0x32, 0x45, 0x5a, 0x7c, 0x9e, 0x7c, 0x86, 0xd5, 0x6e, 0x66, 0x8e, 0x85, 0x7a, 0x7e,
-	0x00, 0x4a, 0xb2, 0x1f, 0xa3, 0xf7, 0x2f, 0x3d, 0x78, 0x68, 0xfa, 0xeb, 0x44, 0x64, 0x3c, 0xc7,
-	0x3b, 0x9f, 0x8c, 0xe7, 0xcc, 0x8e, 0x2e, 0xe3, 0x39, 0xbb, 0x69, 0xea, 0x39, 0x34, 0x80, 0x25,
-	0xb9, 0x75, 0xa1, 0x5b, 0xb1, 0x39, 0xc9, 0x0e, 0xa9, 0xdd, 0xbe, 0x68, 0x58, 0x28, 0x3c, 0xad,
-	0x50, 0x81, 0x8f, 0xff, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x31, 0xc2, 0x11, 0xd1, 0x09, 0x29, 0x00,
-	0x00,
+		GoTypes:           file_zendesk_proto_goTypes,
+		DependencyIndexes: file_zendesk_proto_depIdxs,
+		EnumInfos:         file_zendesk_proto_enumTypes,
+		MessageInfos:      file_zendesk_proto_msgTypes,
+	}.Build()
+	File_zendesk_proto = out.File
+	file_zendesk_proto_goTypes = nil
+	file_zendesk_proto_depIdxs = nil
 }
@@ -0,0 +1,734 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: zendesk.proto
+
+package protobuf
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Zendesk_GetCategories_FullMethodName          = "/protobuf.Zendesk/GetCategories"
+	Zendesk_GetCategory_FullMethodName            = "/protobuf.Zendesk/GetCategory"
+	Zendesk_GetSections_FullMethodName            = "/protobuf.Zendesk/GetSections"
+	Zendesk_GetSection_FullMethodName             = "/protobuf.Zendesk/GetSection"
+	Zendesk_GetArticles_FullMethodName            = "/protobuf.Zendesk/GetArticles"
+	Zendesk_GetTopArticles_FullMethodName         = "/protobuf.Zendesk/GetTopArticles"
+	Zendesk_GetArticle_FullMethodName             = "/protobuf.Zendesk/GetArticle"
+	Zendesk_GetTicketForm_FullMethodName          = "/protobuf.Zendesk/GetTicketForm"
+	Zendesk_GetTicketFields_FullMethodName        = "/protobuf.Zendesk/GetTicketFields"
+	Zendesk_GetSearchTitleArticles_FullMethodName = "/protobuf.Zendesk/GetSearchTitleArticles"
+	Zendesk_GetSearchBodyArticles_FullMethodName  = "/protobuf.Zendesk/GetSearchBodyArticles"
+	Zendesk_GetStatus_FullMethodName              = "/protobuf.Zendesk/GetStatus"
+	Zendesk_SetCreateRequest_FullMethodName       = "/protobuf.Zendesk/SetCreateRequest"
+	Zendesk_SetVoteArticle_FullMethodName         = "/protobuf.Zendesk/SetVoteArticle"
+	Zendesk_SetForceSync_FullMethodName           = "/protobuf.Zendesk/SetForceSync"
+	Zendesk_StreamArticles_FullMethodName         = "/protobuf.Zendesk/StreamArticles"
+	Zendesk_WatchChanges_FullMethodName           = "/protobuf.Zendesk/WatchChanges"
+)
+
+// ZendeskClient is the client API for Zendesk service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ZendeskClient interface {
+	GetCategories(ctx context.Context, in *GetCategoriesRequest, opts ...grpc.CallOption) (*GetCategoriesResponse, error)
+	GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...grpc.CallOption) (*GetCategoryResponse, error)
+	GetSections(ctx context.Context, in *GetSectionsRequest, opts ...grpc.CallOption) (*GetSectionsResponse, error)
+	GetSection(ctx context.Context, in *GetSectionRequest, opts ...grpc.CallOption) (*GetSectionResponse, error)
+	GetArticles(ctx context.Context, in *GetArticlesRequest, opts ...grpc.CallOption) (*GetArticlesResponse, error)
+	GetTopArticles(ctx context.Context, in *GetTopArticlesRequest, opts ...grpc.CallOption) (*GetTopArticlesResponse, error)
+	GetArticle(ctx context.Context, in *GetArticleRequest, opts ...grpc.CallOption) (*GetArticleResponse, error)
+	GetTicketForm(ctx context.Context, in *GetTicketFormRequest, opts ...grpc.CallOption) (*GetTicketFormResponse, error)
+	GetTicketFields(ctx context.Context, in *GetTicketFieldsRequest, opts ...grpc.CallOption) (*GetTicketFieldsResponse, error)
+	GetSearchTitleArticles(ctx context.Context, in *GetSearchTitleArticlesRequest, opts ...grpc.CallOption) (*GetSearchTitleArticlesResponse, error)
+	GetSearchBodyArticles(ctx context.Context, in *GetSearchBodyArticlesRequest, opts ...grpc.CallOption) (*GetSearchBodyArticlesResponse, error)
+	GetStatus(ctx context.Context, in *GetStatusRequest, opts ...grpc.CallOption) (*GetStatusResponse, error)
+	SetCreateRequest(ctx context.Context, in *SetCreateRequestRequest, opts ...grpc.CallOption) (*SetCreateRequestResponse, error)
+	SetVoteArticle(ctx context.Context, in *SetVoteArticleRequest, opts ...grpc.CallOption) (*SetVoteArticleResponse, error)
+	SetForceSync(ctx context.Context, in *SetForceSyncRequest, opts ...grpc.CallOption) (*SetForceSyncResponse, error)
+	StreamArticles(ctx context.Context, in *StreamArticlesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamArticlesResponse], error)
+	WatchChanges(ctx context.Context, in *WatchChangesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchChangesResponse], error)
+}
+
+type zendeskClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewZendeskClient(cc grpc.ClientConnInterface) ZendeskClient {
+	return &zendeskClient{cc}
+}
+
+func (c *zendeskClient) GetCategories(ctx context.Context, in *GetCategoriesRequest, opts ...grpc.CallOption) (*GetCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategoriesResponse)
+	err := c.cc.Invoke(ctx, Zendesk_GetCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zendeskClient) GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...grpc.CallOption) (*GetCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategoryResponse)
+	err := c.cc.Invoke(ctx, Zendesk_GetCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zendeskClient) GetSections(ctx context.Context, in *GetSectionsRequest, opts ...grpc.CallOption) (*GetSectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSectionsResponse)
+	err := c.cc.Invoke(ctx, Zendesk_GetSections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zendeskClient) GetSection(ctx context.Context, in *GetSectionRequest, opts ...grpc.CallOption) (*GetSectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSectionResponse)
+	err := c.cc.Invoke(ctx, Zendesk_GetSection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zendeskClient) GetArticles(ctx context.Context, in *GetArticlesRequest, opts ...grpc.CallOption) (*GetArticlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetArticlesResponse)
+	err := c.cc.Invoke(ctx, Zendesk_GetArticles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zendeskClient) GetTopArticles(ctx context.Context, in *GetTopArticlesRequest, opts ...grpc.CallOption) (*GetTopArticlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTopArticlesResponse)
+	err := c.cc.Invoke(ctx, Zendesk_GetTopArticles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zendeskClient) GetArticle(ctx context.Context, in *GetArticleRequest, opts ...grpc.CallOption) (*GetArticleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetArticleResponse)
+	err := c.cc.Invoke(ctx, Zendesk_GetArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zendeskClient) GetTicketForm(ctx context.Context, in *GetTicketFormRequest, opts ...grpc.CallOption) (*GetTicketFormResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTicketFormResponse)
+	err := c.cc.Invoke(ctx, Zendesk_GetTicketForm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zendeskClient) GetTicketFields(ctx context.Context, in *GetTicketFieldsRequest, opts ...grpc.CallOption) (*GetTicketFieldsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTicketFieldsResponse)
+	err := c.cc.Invoke(ctx, Zendesk_GetTicketFields_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zendeskClient) GetSearchTitleArticles(ctx context.Context, in *GetSearchTitleArticlesRequest, opts ...grpc.CallOption) (*GetSearchTitleArticlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSearchTitleArticlesResponse)
+	err := c.cc.Invoke(ctx, Zendesk_GetSearchTitleArticles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zendeskClient) GetSearchBodyArticles(ctx context.Context, in *GetSearchBodyArticlesRequest, opts ...grpc.CallOption) (*GetSearchBodyArticlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSearchBodyArticlesResponse)
+	err := c.cc.Invoke(ctx, Zendesk_GetSearchBodyArticles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zendeskClient) GetStatus(ctx context.Context, in *GetStatusRequest, opts ...grpc.CallOption) (*GetStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStatusResponse)
+	err := c.cc.Invoke(ctx, Zendesk_GetStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zendeskClient) SetCreateRequest(ctx context.Context, in *SetCreateRequestRequest, opts ...grpc.CallOption) (*SetCreateRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCreateRequestResponse)
+	err := c.cc.Invoke(ctx, Zendesk_SetCreateRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zendeskClient) SetVoteArticle(ctx context.Context, in *SetVoteArticleRequest, opts ...grpc.CallOption) (*SetVoteArticleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetVoteArticleResponse)
+	err := c.cc.Invoke(ctx, Zendesk_SetVoteArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zendeskClient) SetForceSync(ctx context.Context, in *SetForceSyncRequest, opts ...grpc.CallOption) (*SetForceSyncResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetForceSyncResponse)
+	err := c.cc.Invoke(ctx, Zendesk_SetForceSync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zendeskClient) StreamArticles(ctx context.Context, in *StreamArticlesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamArticlesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Zendesk_ServiceDesc.Streams[0], Zendesk_StreamArticles_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamArticlesRequest, StreamArticlesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Zendesk_StreamArticlesClient = grpc.ServerStreamingClient[StreamArticlesResponse]
+
+func (c *zendeskClient) WatchChanges(ctx context.Context, in *WatchChangesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchChangesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Zendesk_ServiceDesc.Streams[1], Zendesk_WatchChanges_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchChangesRequest, WatchChangesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Zendesk_WatchChangesClient = grpc.ServerStreamingClient[WatchChangesResponse]
+
+// ZendeskServer is the server API for Zendesk service.
+// All implementations should embed UnimplementedZendeskServer
+// for forward compatibility.
+type ZendeskServer interface {
+	GetCategories(context.Context, *GetCategoriesRequest) (*GetCategoriesResponse, error)
+	GetCategory(context.Context, *GetCategoryRequest) (*GetCategoryResponse, error)
+	GetSections(context.Context, *GetSectionsRequest) (*GetSectionsResponse, error)
+	GetSection(context.Context, *GetSectionRequest) (*GetSectionResponse, error)
+	GetArticles(context.Context, *GetArticlesRequest) (*GetArticlesResponse, error)
+	GetTopArticles(context.Context, *GetTopArticlesRequest) (*GetTopArticlesResponse, error)
+	GetArticle(context.Context, *GetArticleRequest) (*GetArticleResponse, error)
+	GetTicketForm(context.Context, *GetTicketFormRequest) (*GetTicketFormResponse, error)
+	GetTicketFields(context.Context, *GetTicketFieldsRequest) (*GetTicketFieldsResponse, error)
+	GetSearchTitleArticles(context.Context, *GetSearchTitleArticlesRequest) (*GetSearchTitleArticlesResponse, error)
+	GetSearchBodyArticles(context.Context, *GetSearchBodyArticlesRequest) (*GetSearchBodyArticlesResponse, error)
+	GetStatus(context.Context, *GetStatusRequest) (*GetStatusResponse, error)
+	SetCreateRequest(context.Context, *SetCreateRequestRequest) (*SetCreateRequestResponse, error)
+	SetVoteArticle(context.Context, *SetVoteArticleRequest) (*SetVoteArticleResponse, error)
+	SetForceSync(context.Context, *SetForceSyncRequest) (*SetForceSyncResponse, error)
+	StreamArticles(*StreamArticlesRequest, grpc.ServerStreamingServer[StreamArticlesResponse]) error
+	WatchChanges(*WatchChangesRequest, grpc.ServerStreamingServer[WatchChangesResponse]) error
+}
+
+// UnimplementedZendeskServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedZendeskServer struct{}
+
+func (UnimplementedZendeskServer) GetCategories(context.Context, *GetCategoriesRequest) (*GetCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategories not implemented")
+}
+func (UnimplementedZendeskServer) GetCategory(context.Context, *GetCategoryRequest) (*GetCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategory not implemented")
+}
+func (UnimplementedZendeskServer) GetSections(context.Context, *GetSectionsRequest) (*GetSectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSections not implemented")
+}
+func (UnimplementedZendeskServer) GetSection(context.Context, *GetSectionRequest) (*GetSectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSection not implemented")
+}
+func (UnimplementedZendeskServer) GetArticles(context.Context, *GetArticlesRequest) (*GetArticlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArticles not implemented")
+}
+func (UnimplementedZendeskServer) GetTopArticles(context.Context, *GetTopArticlesRequest) (*GetTopArticlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopArticles not implemented")
+}
+func (UnimplementedZendeskServer) GetArticle(context.Context, *GetArticleRequest) (*GetArticleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArticle not implemented")
+}
+func (UnimplementedZendeskServer) GetTicketForm(context.Context, *GetTicketFormRequest) (*GetTicketFormResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicketForm not implemented")
+}
+func (UnimplementedZendeskServer) GetTicketFields(context.Context, *GetTicketFieldsRequest) (*GetTicketFieldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicketFields not implemented")
+}
+func (UnimplementedZendeskServer) GetSearchTitleArticles(context.Context, *GetSearchTitleArticlesRequest) (*GetSearchTitleArticlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSearchTitleArticles not implemented")
+}
+func (UnimplementedZendeskServer) GetSearchBodyArticles(context.Context, *GetSearchBodyArticlesRequest) (*GetSearchBodyArticlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSearchBodyArticles not implemented")
+}
+func (UnimplementedZendeskServer) GetStatus(context.Context, *GetStatusRequest) (*GetStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
+}
+func (UnimplementedZendeskServer) SetCreateRequest(context.Context, *SetCreateRequestRequest) (*SetCreateRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCreateRequest not implemented")
+}
+func (UnimplementedZendeskServer) SetVoteArticle(context.Context, *SetVoteArticleRequest) (*SetVoteArticleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVoteArticle not implemented")
+}
+func (UnimplementedZendeskServer) SetForceSync(context.Context, *SetForceSyncRequest) (*SetForceSyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetForceSync not implemented")
+}
+func (UnimplementedZendeskServer) StreamArticles(*StreamArticlesRequest, grpc.ServerStreamingServer[StreamArticlesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamArticles not implemented")
+}
+func (UnimplementedZendeskServer) WatchChanges(*WatchChangesRequest, grpc.ServerStreamingServer[WatchChangesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchChanges not implemented")
+}
+func (UnimplementedZendeskServer) testEmbeddedByValue() {}
+
+// UnsafeZendeskServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ZendeskServer will
+// result in compilation errors.
+type UnsafeZendeskServer interface {
+	mustEmbedUnimplementedZendeskServer()
+}
+
+func RegisterZendeskServer(s grpc.ServiceRegistrar, srv ZendeskServer) {
+	// If the following call pancis, it indicates UnimplementedZendeskServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Zendesk_ServiceDesc, srv)
+}
+
+func _Zendesk_GetCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZendeskServer).GetCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Zendesk_GetCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZendeskServer).GetCategories(ctx, req.(*GetCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zendesk_GetCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZendeskServer).GetCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Zendesk_GetCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZendeskServer).GetCategory(ctx, req.(*GetCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zendesk_GetSections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZendeskServer).GetSections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Zendesk_GetSections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZendeskServer).GetSections(ctx, req.(*GetSectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zendesk_GetSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZendeskServer).GetSection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Zendesk_GetSection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZendeskServer).GetSection(ctx, req.(*GetSectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zendesk_GetArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZendeskServer).GetArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Zendesk_GetArticles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZendeskServer).GetArticles(ctx, req.(*GetArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zendesk_GetTopArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZendeskServer).GetTopArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Zendesk_GetTopArticles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZendeskServer).GetTopArticles(ctx, req.(*GetTopArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zendesk_GetArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZendeskServer).GetArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Zendesk_GetArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZendeskServer).GetArticle(ctx, req.(*GetArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zendesk_GetTicketForm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTicketFormRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZendeskServer).GetTicketForm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Zendesk_GetTicketForm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZendeskServer).GetTicketForm(ctx, req.(*GetTicketFormRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zendesk_GetTicketFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTicketFieldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZendeskServer).GetTicketFields(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Zendesk_GetTicketFields_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZendeskServer).GetTicketFields(ctx, req.(*GetTicketFieldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zendesk_GetSearchTitleArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSearchTitleArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZendeskServer).GetSearchTitleArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Zendesk_GetSearchTitleArticles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZendeskServer).GetSearchTitleArticles(ctx, req.(*GetSearchTitleArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zendesk_GetSearchBodyArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSearchBodyArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZendeskServer).GetSearchBodyArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Zendesk_GetSearchBodyArticles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZendeskServer).GetSearchBodyArticles(ctx, req.(*GetSearchBodyArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zendesk_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZendeskServer).GetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Zendesk_GetStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZendeskServer).GetStatus(ctx, req.(*GetStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zendesk_SetCreateRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCreateRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZendeskServer).SetCreateRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Zendesk_SetCreateRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZendeskServer).SetCreateRequest(ctx, req.(*SetCreateRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zendesk_SetVoteArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetVoteArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZendeskServer).SetVoteArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Zendesk_SetVoteArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZendeskServer).SetVoteArticle(ctx, req.(*SetVoteArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zendesk_SetForceSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetForceSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZendeskServer).SetForceSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Zendesk_SetForceSync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZendeskServer).SetForceSync(ctx, req.(*SetForceSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zendesk_StreamArticles_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamArticlesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ZendeskServer).StreamArticles(m, &grpc.GenericServerStream[StreamArticlesRequest, StreamArticlesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Zendesk_StreamArticlesServer = grpc.ServerStreamingServer[StreamArticlesResponse]
+
+func _Zendesk_WatchChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ZendeskServer).WatchChanges(m, &grpc.GenericServerStream[WatchChangesRequest, WatchChangesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Zendesk_WatchChangesServer = grpc.ServerStreamingServer[WatchChangesResponse]
+
+// Zendesk_ServiceDesc is the grpc.ServiceDesc for Zendesk service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Zendesk_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "protobuf.Zendesk",
+	HandlerType: (*ZendeskServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCategories",
+			Handler:    _Zendesk_GetCategories_Handler,
+		},
+		{
+			MethodName: "GetCategory",
+			Handler:    _Zendesk_GetCategory_Handler,
+		},
+		{
+			MethodName: "GetSections",
+			Handler:    _Zendesk_GetSections_Handler,
+		},
+		{
+			MethodName: "GetSection",
+			Handler:    _Zendesk_GetSection_Handler,
+		},
+		{
+			MethodName: "GetArticles",
+			Handler:    _Zendesk_GetArticles_Handler,
+		},
+		{
+			MethodName: "GetTopArticles",
+			Handler:    _Zendesk_GetTopArticles_Handler,
+		},
+		{
+			MethodName: "GetArticle",
+			Handler:    _Zendesk_GetArticle_Handler,
+		},
+		{
+			MethodName: "GetTicketForm",
+			Handler:    _Zendesk_GetTicketForm_Handler,
+		},
+		{
+			MethodName: "GetTicketFields",
+			Handler:    _Zendesk_GetTicketFields_Handler,
+		},
+		{
+			MethodName: "GetSearchTitleArticles",
+			Handler:    _Zendesk_GetSearchTitleArticles_Handler,
+		},
+		{
+			MethodName: "GetSearchBodyArticles",
+			Handler:    _Zendesk_GetSearchBodyArticles_Handler,
+		},
+		{
+			MethodName: "GetStatus",
+			Handler:    _Zendesk_GetStatus_Handler,
+		},
+		{
+			MethodName: "SetCreateRequest",
+			Handler:    _Zendesk_SetCreateRequest_Handler,
+		},
+		{
+			MethodName: "SetVoteArticle",
+			Handler:    _Zendesk_SetVoteArticle_Handler,
+		},
+		{
+			MethodName: "SetForceSync",
+			Handler:    _Zendesk_SetForceSync_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamArticles",
+			Handler:       _Zendesk_StreamArticles_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchChanges",
+			Handler:       _Zendesk_WatchChanges_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "zendesk.proto",
+}
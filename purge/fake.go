@@ -0,0 +1,63 @@
+package purge
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+)
+
+// FakeServer is a local purge API recording the purged keys and paths, it's for testing only.
+// The keys are posted to URL + "/purge" and the paths are purged under URL.
+type FakeServer struct {
+	*httptest.Server
+
+	mu    sync.Mutex
+	keys  []string
+	paths []string
+}
+
+// NewFakeServer starts a FakeServer, it has to be closed by the caller.
+func NewFakeServer() *FakeServer {
+	s := new(FakeServer)
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+func (s *FakeServer) serve(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/purge":
+		req := new(purgeKeysRequest)
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.keys = append(s.keys, req.SurrogateKeys...)
+	case r.Method == MethodPurge:
+		s.paths = append(s.paths, r.URL.RequestURI())
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// Endpoint returns the endpoint of purging the keys.
+func (s *FakeServer) Endpoint() string {
+	return s.URL + "/purge"
+}
+
+// Keys returns the purged keys.
+func (s *FakeServer) Keys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.keys...)
+}
+
+// Paths returns the purged paths.
+func (s *FakeServer) Paths() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.paths...)
+}
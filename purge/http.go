@@ -0,0 +1,132 @@
+package purge
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/honestbee/Zen/tracing"
+)
+
+// MethodPurge is the http method purging a cached URL.
+const MethodPurge = "PURGE"
+
+// maxConcurrentPurges limits the PURGE requests in flight, so that a large sync does not flood the CDN.
+const maxConcurrentPurges = 8
+
+// HTTPPurger purges the surrogate keys by posting them to a generic purge API,
+// and the paths by sending the PURGE requests to the CDN base URL.
+type HTTPPurger struct {
+	endpoint string
+	token    string
+	baseURL  string
+	client   *http.Client
+}
+
+// NewHTTPPurger returns a HTTPPurger instance, the keys are not purged if endpoint is empty
+// and the paths are not purged if baseURL is empty.
+func NewHTTPPurger(endpoint, token, baseURL string, timeout time.Duration) *HTTPPurger {
+	return &HTTPPurger{
+		endpoint: endpoint,
+		token:    token,
+		baseURL:  strings.TrimSuffix(baseURL, "/"),
+		client: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+type purgeKeysRequest struct {
+	SurrogateKeys []string `json:"surrogate_keys"`
+}
+
+// Purge purges the keys in one request, the responses of the paths are tagged with the keys,
+// so the paths are only purged if the keys are not supported by the CDN or failed to be purged.
+func (p *HTTPPurger) Purge(ctx context.Context, req *Request) error {
+	var keysErr error
+	if p.endpoint != "" && len(req.Keys) > 0 {
+		if keysErr = p.purgeKeys(ctx, req.Keys); keysErr == nil {
+			return nil
+		}
+	}
+
+	if p.baseURL == "" {
+		return keysErr
+	}
+	if err := p.purgePaths(ctx, req.Paths); err != nil {
+		return errors.Wrapf(err, "purge: [Purge] purge paths failed")
+	}
+	return keysErr
+}
+
+func (p *HTTPPurger) purgeKeys(ctx context.Context, keys []string) error {
+	body, err := json.Marshal(&purgeKeysRequest{SurrogateKeys: keys})
+	if err != nil {
+		return errors.Wrapf(err, "purge: [purgeKeys] json marshal failed")
+	}
+	return errors.Wrapf(p.do(ctx, http.MethodPost, p.endpoint, body), "purge: [purgeKeys] purge keys failed")
+}
+
+// purgePaths sends the PURGE requests concurrently, all the paths are tried and the failures are collected.
+func (p *HTTPPurger) purgePaths(ctx context.Context, paths []string) error {
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		failures []string
+	)
+	sem := make(chan struct{}, maxConcurrentPurges)
+	for _, path := range paths {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(path string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := p.do(ctx, MethodPurge, p.baseURL+path, nil); err != nil {
+				mu.Lock()
+				failures = append(failures, err.Error())
+				mu.Unlock()
+			}
+		}(path)
+	}
+	wg.Wait()
+
+	if len(failures) > 0 {
+		return errors.Errorf("purge: [purgePaths] %d of %d paths failed: %s", len(failures), len(paths), strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+func (p *HTTPPurger) do(ctx context.Context, method, url string, body []byte) error {
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrapf(err, "purge: [do] url[%s] http NewRequest failed", url)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if p.token != "" {
+		req.Header.Set("Authorization", "Bearer "+p.token)
+	}
+
+	span, ctx := tracing.StartSpan(ctx, req.URL.Path, tracing.WithKind(tracing.KindClient))
+	defer span.Finish()
+
+	resp, err := p.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.Wrapf(err, "purge: [do] url[%s] http client do failed", url)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return errors.Errorf("purge: [do] %s url[%s] status expect[2xx], actual[%v]", method, url, resp.Status)
+	}
+	return nil
+}
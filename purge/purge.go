@@ -0,0 +1,88 @@
+package purge
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/honestbee/Zen/config"
+)
+
+const (
+	// NoneKind disables the CDN purging.
+	NoneKind = "none"
+	// HTTPKind purges the CDN with a generic HTTP purge API.
+	HTTPKind = "http"
+)
+
+// Request is the stale responses to be purged from the CDN.
+type Request struct {
+	// Keys are the Surrogate-Key of the responses.
+	Keys []string
+	// Paths are the request URIs of the responses, relative to the CDN base URL.
+	Paths []string
+}
+
+// Purger is the interface of purging the stale responses from the CDN.
+type Purger interface {
+	Purge(ctx context.Context, req *Request) error
+}
+
+// NewPurger returns the Purger configured by conf.
+func NewPurger(conf *config.Config) (Purger, error) {
+	switch conf.Purge.Purger {
+	case NoneKind, "":
+		return NopPurger{}, nil
+	case HTTPKind:
+		return NewHTTPPurger(
+			conf.Purge.Endpoint,
+			conf.Purge.Token,
+			conf.Purge.BaseURL,
+			time.Duration(conf.Purge.TimeoutSec)*time.Second,
+		), nil
+	default:
+		return nil, errors.Errorf("purge: [NewPurger] unknown purger:%q", conf.Purge.Purger)
+	}
+}
+
+// NopPurger purges nothing, it's used when there is no CDN in front of the server.
+type NopPurger struct{}
+
+// Purge does nothing.
+func (NopPurger) Purge(ctx context.Context, req *Request) error {
+	return nil
+}
+
+// ListPaths returns the request URIs of the v1 and v2 listing of the kind, such as /api/categories.
+func ListPaths(kind, countryCode, locale string) []string {
+	query := canonicalQuery(countryCode, locale)
+	return []string{
+		fmt.Sprintf("/api/%s?%s", kind, query),
+		fmt.Sprintf("/api/v2/%s?%s", kind, query),
+	}
+}
+
+// ItemPaths returns the request URIs of the v1 and v2 item routes of the kind, such as /api/articles/:article_id.
+func ItemPaths(kind string, ids []int, countryCode, locale string) []string {
+	query := canonicalQuery(countryCode, locale)
+	paths := make([]string, 0, 2*len(ids))
+	for _, id := range ids {
+		paths = append(paths,
+			fmt.Sprintf("/api/%s/%d?%s", kind, id, query),
+			fmt.Sprintf("/api/v2/%s/%d?%s", kind, id, query),
+		)
+	}
+	return paths
+}
+
+// canonicalQuery returns the query of the country and locale sorted by the keys, the CDN
+// is expected to normalize the cache keys of the other parameter orders in the same way.
+func canonicalQuery(countryCode, locale string) string {
+	return url.Values{
+		"country_code": []string{countryCode},
+		"locale":       []string{locale},
+	}.Encode()
+}
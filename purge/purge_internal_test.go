@@ -0,0 +1,194 @@
+package purge
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+
+	"github.com/honestbee/Zen/config"
+)
+
+func TestNewPurger(t *testing.T) {
+	testCases := [...]struct {
+		description string
+		purger      string
+		expectType  string
+		expectErr   bool
+	}{
+		{
+			description: "testing default purger case",
+			purger:      "",
+			expectType:  "purge.NopPurger",
+		},
+		{
+			description: "testing none purger case",
+			purger:      NoneKind,
+			expectType:  "purge.NopPurger",
+		},
+		{
+			description: "testing http purger case",
+			purger:      HTTPKind,
+			expectType:  "*purge.HTTPPurger",
+		},
+		{
+			description: "testing unknown purger case",
+			purger:      "unknown",
+			expectType:  "<nil>",
+			expectErr:   true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			p, err := NewPurger(&config.Config{
+				Purge: &config.Purge{Purger: tt.purger, TimeoutSec: 1},
+			})
+			if tt.expectErr != (err != nil) {
+				t.Errorf("[%s] expect error:%v, actual:%v", tt.description, tt.expectErr, err)
+			}
+			if actual := fmt.Sprintf("%T", p); actual != tt.expectType {
+				t.Errorf("[%s] expect type:%s, actual:%s", tt.description, tt.expectType, actual)
+			}
+		})
+	}
+}
+
+func TestPaths(t *testing.T) {
+	expect := []string{
+		"/api/sections?country_code=sg&locale=zh-tw",
+		"/api/v2/sections?country_code=sg&locale=zh-tw",
+	}
+	if diff := deep.Equal(expect, ListPaths("sections", "sg", "zh-tw")); diff != nil {
+		t.Errorf("[ListPaths] %v", diff)
+	}
+
+	expect = []string{
+		"/api/sections/1?country_code=sg&locale=zh-tw",
+		"/api/v2/sections/1?country_code=sg&locale=zh-tw",
+		"/api/sections/2?country_code=sg&locale=zh-tw",
+		"/api/v2/sections/2?country_code=sg&locale=zh-tw",
+	}
+	if diff := deep.Equal(expect, ItemPaths("sections", []int{1, 2}, "sg", "zh-tw")); diff != nil {
+		t.Errorf("[ItemPaths] %v", diff)
+	}
+}
+
+func TestHTTPPurgerPurge(t *testing.T) {
+	broken := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer broken.Close()
+
+	req := &Request{
+		Keys:  []string{"articles-tw-en-us", "article-1"},
+		Paths: []string{"/api/articles/1?country_code=tw&locale=en-us"},
+	}
+
+	testCases := [...]struct {
+		description string
+		purgeKeys   bool
+		purgePaths  bool
+		broken      bool
+		expectKeys  []string
+		expectPaths []string
+		expectErr   bool
+	}{
+		{
+			description: "testing keys cover paths case",
+			purgeKeys:   true,
+			purgePaths:  true,
+			expectKeys:  req.Keys,
+		},
+		{
+			description: "testing keys only case",
+			purgeKeys:   true,
+			expectKeys:  req.Keys,
+		},
+		{
+			description: "testing paths only case",
+			purgePaths:  true,
+			expectPaths: req.Paths,
+		},
+		{
+			description: "testing purge api status failed case",
+			broken:      true,
+			expectErr:   true,
+		},
+		{
+			description: "testing purge api failed falls back to paths case",
+			purgePaths:  true,
+			broken:      true,
+			expectPaths: req.Paths,
+			expectErr:   true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			serv := NewFakeServer()
+			defer serv.Close()
+
+			var endpoint, baseURL string
+			if tt.purgeKeys {
+				endpoint = serv.Endpoint()
+			}
+			if tt.broken {
+				endpoint = broken.URL
+			}
+			if tt.purgePaths {
+				baseURL = serv.URL + "/"
+			}
+
+			err := NewHTTPPurger(endpoint, "token", baseURL, time.Second).Purge(context.Background(), req)
+			if tt.expectErr != (err != nil) {
+				t.Errorf("[%s] expect error:%v, actual:%v", tt.description, tt.expectErr, err)
+			}
+			if diff := deep.Equal(tt.expectKeys, serv.Keys()); diff != nil {
+				t.Errorf("[%s] keys %v", tt.description, diff)
+			}
+			if diff := deep.Equal(tt.expectPaths, serv.Paths()); diff != nil {
+				t.Errorf("[%s] paths %v", tt.description, diff)
+			}
+		})
+	}
+}
+
+func TestHTTPPurgerPurgePathsFailures(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		purged []string
+	)
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		purged = append(purged, r.URL.Path)
+		mu.Unlock()
+		if strings.HasSuffix(r.URL.Path, "/1") {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer serv.Close()
+
+	paths := ItemPaths("articles", []int{1, 2, 3}, "tw", "en-us")
+	err := NewHTTPPurger("", "", serv.URL, time.Second).Purge(context.Background(), &Request{Paths: paths})
+	if err == nil || !strings.Contains(err.Error(), "2 of 6 paths failed") {
+		t.Errorf("expect the 2 failed paths in the error, actual:%v", err)
+	}
+
+	// The paths after the failed ones are purged as well.
+	sort.Strings(purged)
+	expect := []string{
+		"/api/articles/1", "/api/articles/2", "/api/articles/3",
+		"/api/v2/articles/1", "/api/v2/articles/2", "/api/v2/articles/3",
+	}
+	if diff := deep.Equal(expect, purged); diff != nil {
+		t.Errorf("purged paths %v", diff)
+	}
+}
@@ -0,0 +1,71 @@
+package redact
+
+import (
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// Mask is the replacement of the scrubbed values.
+	Mask = "[redacted]"
+	// EmailMask is the replacement of the scrubbed emails.
+	EmailMask = "[email]"
+	// PhoneMask is the replacement of the scrubbed phone numbers.
+	PhoneMask = "[phone]"
+
+	// minSecretLen is the min length of a secret to be scrubbed,
+	// shorter values would mess up the whole message.
+	minSecretLen = 3
+)
+
+var (
+	emailRegexp = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	// phoneRegexp matches the international numbers and the numbers grouped by spaces or dashes,
+	// plain digits are kept since they are mostly the zendesk ids.
+	phoneRegexp = regexp.MustCompile(`\+\d{8,15}\b|(\+\d{1,3}[\s\-]?)?(\(\d{1,4}\)[\s\-]?|\b\d{2,4}[\s\-])\d{3,4}[\s\-]?\d{3,4}\b`)
+	// queryRegexp matches the url query values which carry the user input.
+	queryRegexp = regexp.MustCompile(`(?i)([?&](?:query|q|email|name|phone)=)[^&\s"\]]*`)
+)
+
+// String scrubs the emails, phone numbers, user input url query values and the given secrets from s.
+func String(s string, secrets ...string) string {
+	for _, secret := range secrets {
+		if len(secret) < minSecretLen {
+			continue
+		}
+		s = strings.Replace(s, secret, Mask, -1)
+	}
+
+	s = queryRegexp.ReplaceAllString(s, "${1}"+Mask)
+	s = emailRegexp.ReplaceAllString(s, EmailMask)
+	return phoneRegexp.ReplaceAllString(s, PhoneMask)
+}
+
+// Error returns an error whose message is scrubbed by String, nil is returned if err is nil.
+func Error(err error, secrets ...string) error {
+	if err == nil {
+		return nil
+	}
+	return errors.New(String(err.Error(), secrets...))
+}
+
+// Writer scrubs every written log line by String before passing it to the underlying writer.
+type Writer struct {
+	w io.Writer
+}
+
+// NewWriter returns a Writer instance.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// Write implements io.Writer.
+func (w *Writer) Write(p []byte) (int, error) {
+	if _, err := w.w.Write([]byte(String(string(p)))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,84 @@
+package redact
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestString(t *testing.T) {
+	testCases := [...]struct {
+		description string
+		input       string
+		secrets     []string
+		expect      string
+	}{
+		{
+			description: "testing email case",
+			input:       "requester zen.project.tester@honestbee.com not found",
+			expect:      "requester [email] not found",
+		},
+		{
+			description: "testing international phone case",
+			input:       "call +886 912 345 678 or +6591234567",
+			expect:      "call [phone] or [phone]",
+		},
+		{
+			description: "testing local phone case",
+			input:       "call (02) 2345-6789 or 0912-345-678",
+			expect:      "call [phone] or [phone]",
+		},
+		{
+			description: "testing zendesk ids and dates are kept case",
+			input:       "article id:360001339052 updated at 2019-02-15 10:30:12",
+			expect:      "article id:360001339052 updated at 2019-02-15 10:30:12",
+		},
+		{
+			description: "testing url query case",
+			input:       "url[https://honestbeehelp-tw.zendesk.com/api/v2/help_center/articles/search.json?per_page=10&page=1&locale=zh-tw&query=my+order] connect failed",
+			expect:      "url[https://honestbeehelp-tw.zendesk.com/api/v2/help_center/articles/search.json?per_page=10&page=1&locale=zh-tw&query=[redacted]] connect failed",
+		},
+		{
+			description: "testing secrets case",
+			input:       "zen project tester sent HB-ORDER-778899",
+			secrets:     []string{"zen project tester", "HB-ORDER-778899", "", "zp"},
+			expect:      "[redacted] sent [redacted]",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			if actual := String(tt.input, tt.secrets...); actual != tt.expect {
+				t.Errorf("[%s] expect:%s, actual:%s", tt.description, tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestError(t *testing.T) {
+	if err := Error(nil); err != nil {
+		t.Errorf("expect nil error, actual:%v", err)
+	}
+
+	err := Error(errors.New("zen project tester <zen.project.tester@honestbee.com>"), "zen project tester")
+	if expect := "[redacted] <[email]>"; err.Error() != expect {
+		t.Errorf("expect:%s, actual:%s", expect, err.Error())
+	}
+}
+
+func TestWriter(t *testing.T) {
+	buf := new(bytes.Buffer)
+	input := []byte(`{"error":"requester zen.project.tester@honestbee.com not found"}`)
+
+	n, err := NewWriter(buf).Write(input)
+	if err != nil {
+		t.Fatalf("write failed:%v", err)
+	}
+	if n != len(input) {
+		t.Errorf("written length expect:%d, actual:%d", len(input), n)
+	}
+	if expect := `{"error":"requester [email] not found"}`; buf.String() != expect {
+		t.Errorf("expect:%s, actual:%s", expect, buf.String())
+	}
+}
@@ -0,0 +1,57 @@
+package redact
+
+import (
+	"context"
+	"regexp"
+
+	gographqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/graph-gophers/graphql-go/introspection"
+	"github.com/graph-gophers/graphql-go/trace"
+)
+
+// argRegexp matches the inline string arguments of a graphql query which carry the user input.
+var argRegexp = regexp.MustCompile(`(?i)\b(query|name|email|subject|body|value)(\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+// Tracer wraps a graphql tracer and scrubs the query string and errors before they are tagged.
+type Tracer struct {
+	tracer trace.Tracer
+}
+
+// NewTracer returns a Tracer instance wrapping t.
+func NewTracer(t trace.Tracer) *Tracer {
+	return &Tracer{tracer: t}
+}
+
+// TraceQuery implements trace.Tracer.
+func (t *Tracer) TraceQuery(ctx context.Context, queryString string, operationName string, variables map[string]interface{}, varTypes map[string]*introspection.Type) (context.Context, trace.TraceQueryFinishFunc) {
+	queryString = String(argRegexp.ReplaceAllString(queryString, `${1}${2}"`+Mask+`"`))
+
+	ctx, finish := t.tracer.TraceQuery(ctx, queryString, operationName, nil, varTypes)
+	return ctx, func(errs []*gographqlerrors.QueryError) {
+		redacted := make([]*gographqlerrors.QueryError, 0, len(errs))
+		for _, err := range errs {
+			redacted = append(redacted, queryError(err))
+		}
+		finish(redacted)
+	}
+}
+
+// TraceField implements trace.Tracer, the field arguments are not passed to the tracer.
+func (t *Tracer) TraceField(ctx context.Context, label, typeName, fieldName string, trivial bool, args map[string]interface{}) (context.Context, trace.TraceFieldFinishFunc) {
+	ctx, finish := t.tracer.TraceField(ctx, label, typeName, fieldName, trivial, nil)
+	return ctx, func(err *gographqlerrors.QueryError) {
+		finish(queryError(err))
+	}
+}
+
+// queryError returns a copy of err whose messages are scrubbed.
+func queryError(err *gographqlerrors.QueryError) *gographqlerrors.QueryError {
+	if err == nil {
+		return nil
+	}
+
+	redacted := *err
+	redacted.Message = String(err.Message)
+	redacted.ResolverError = Error(err.ResolverError)
+	return &redacted
+}
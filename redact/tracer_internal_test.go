@@ -0,0 +1,71 @@
+package redact
+
+import (
+	"context"
+	"testing"
+
+	gographqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/graph-gophers/graphql-go/introspection"
+	"github.com/graph-gophers/graphql-go/trace"
+	"github.com/pkg/errors"
+)
+
+type recordTracer struct {
+	query     string
+	variables map[string]interface{}
+	args      map[string]interface{}
+	errs      []*gographqlerrors.QueryError
+}
+
+func (r *recordTracer) TraceQuery(ctx context.Context, queryString string, operationName string, variables map[string]interface{}, varTypes map[string]*introspection.Type) (context.Context, trace.TraceQueryFinishFunc) {
+	r.query = queryString
+	r.variables = variables
+	return ctx, func(errs []*gographqlerrors.QueryError) {
+		r.errs = errs
+	}
+}
+
+func (r *recordTracer) TraceField(ctx context.Context, label, typeName, fieldName string, trivial bool, args map[string]interface{}) (context.Context, trace.TraceFieldFinishFunc) {
+	r.args = args
+	return ctx, func(err *gographqlerrors.QueryError) {
+		r.errs = []*gographqlerrors.QueryError{err}
+	}
+}
+
+func TestTracer(t *testing.T) {
+	record := &recordTracer{}
+	tracer := NewTracer(record)
+
+	_, finish := tracer.TraceQuery(context.Background(),
+		`mutation { createRequest(countryCode: "tw", data: {request: {requester: {name: "zen project tester", email: "zen.project.tester@honestbee.com"}, subject: "call +65 9123 4567"}}) }`,
+		"",
+		map[string]interface{}{"email": "zen.project.tester@honestbee.com"},
+		nil,
+	)
+	finish([]*gographqlerrors.QueryError{{
+		Message:       "zen.project.tester@honestbee.com is invalid",
+		ResolverError: errors.New("zen.project.tester@honestbee.com is invalid"),
+	}})
+
+	expectQuery := `mutation { createRequest(countryCode: "tw", data: {request: {requester: {name: "[redacted]", email: "[redacted]"}, subject: "[redacted]"}}) }`
+	if record.query != expectQuery {
+		t.Errorf("query expect:%s, actual:%s", expectQuery, record.query)
+	}
+	if record.variables != nil {
+		t.Errorf("variables expect nil, actual:%v", record.variables)
+	}
+	if expect := "[email] is invalid"; record.errs[0].Message != expect || record.errs[0].ResolverError.Error() != expect {
+		t.Errorf("error expect:%s, actual:%v", expect, record.errs[0])
+	}
+
+	_, fieldFinish := tracer.TraceField(context.Background(), "Query.search", "Query", "search", false,
+		map[string]interface{}{"query": "zen project tester"},
+	)
+	fieldFinish(nil)
+	if record.args != nil {
+		t.Errorf("args expect nil, actual:%v", record.args)
+	}
+	if record.errs[0] != nil {
+		t.Errorf("error expect nil, actual:%v", record.errs[0])
+	}
+}
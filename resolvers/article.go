@@ -45,13 +45,55 @@ func (r *ArticlesResolver) Count(ctx context.Context) int32 {
 	return int32(r.m.Count)
 }
 
+// Edges is the Articles's field edges.
+func (r *ArticlesResolver) Edges(ctx context.Context) []*ArticleEdgeResolver {
+	ret := make([]*ArticleEdgeResolver, 0, len(r.m.Articles))
+	for _, article := range r.m.Articles {
+		ret = append(ret, &ArticleEdgeResolver{
+			m:      article,
+			cursor: inout.EncodeCursor(article.Cursor(), r.m.Connection.SortBy, r.m.Connection.SortOrder),
+		})
+	}
+	return ret
+}
+
+// PageInfo is the Articles's field page_info.
+func (r *ArticlesResolver) PageInfo(ctx context.Context) *ConnectionPageInfoResolver {
+	cursors := make([]string, 0, len(r.m.Articles))
+	for _, article := range r.m.Articles {
+		cursors = append(cursors, inout.EncodeCursor(article.Cursor(), r.m.Connection.SortBy, r.m.Connection.SortOrder))
+	}
+	return newConnectionPageInfoResolver(r.m.Connection.HasNextPage, r.m.Connection.HasPreviousPage, cursors)
+}
+
+// ArticleEdgeResolver defines resolver models.
+type ArticleEdgeResolver struct {
+	m      *models.Article
+	cursor string
+}
+
+// Cursor is the ArticleEdge's field cursor.
+func (r *ArticleEdgeResolver) Cursor(ctx context.Context) string {
+	return r.cursor
+}
+
+// Node is the ArticleEdge's field node.
+func (r *ArticleEdgeResolver) Node(ctx context.Context) *ArticleResolver {
+	return &ArticleResolver{m: r.m}
+}
+
 // ArticleResolver defines resolver models.
 type ArticleResolver struct {
 	m *models.Article
 }
 
-// ID is the Article's field id.
+// ID is the Article's field id, it is the global id of the article.
 func (r *ArticleResolver) ID(ctx context.Context) gographql.ID {
+	return inout.EncodeNodeID(inout.NodeTypeArticle, r.m.CountryCode, r.m.ID)
+}
+
+// ZendeskID is the Article's field zendesk_id.
+func (r *ArticleResolver) ZendeskID(ctx context.Context) gographql.ID {
 	return gographql.ID(strconv.Itoa(r.m.ID))
 }
 
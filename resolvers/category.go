@@ -45,13 +45,55 @@ func (r *CategoriesResolver) Count(ctx context.Context) int32 {
 	return int32(r.m.Count)
 }
 
+// Edges is the Categories's field edges.
+func (r *CategoriesResolver) Edges(ctx context.Context) []*CategoryEdgeResolver {
+	ret := make([]*CategoryEdgeResolver, 0, len(r.m.Categories))
+	for _, category := range r.m.Categories {
+		ret = append(ret, &CategoryEdgeResolver{
+			m:      category,
+			cursor: inout.EncodeCursor(category.Cursor(), r.m.Connection.SortBy, r.m.Connection.SortOrder),
+		})
+	}
+	return ret
+}
+
+// PageInfo is the Categories's field page_info.
+func (r *CategoriesResolver) PageInfo(ctx context.Context) *ConnectionPageInfoResolver {
+	cursors := make([]string, 0, len(r.m.Categories))
+	for _, category := range r.m.Categories {
+		cursors = append(cursors, inout.EncodeCursor(category.Cursor(), r.m.Connection.SortBy, r.m.Connection.SortOrder))
+	}
+	return newConnectionPageInfoResolver(r.m.Connection.HasNextPage, r.m.Connection.HasPreviousPage, cursors)
+}
+
+// CategoryEdgeResolver defines resolver models.
+type CategoryEdgeResolver struct {
+	m      *models.Category
+	cursor string
+}
+
+// Cursor is the CategoryEdge's field cursor.
+func (r *CategoryEdgeResolver) Cursor(ctx context.Context) string {
+	return r.cursor
+}
+
+// Node is the CategoryEdge's field node.
+func (r *CategoryEdgeResolver) Node(ctx context.Context) *CategoryResolver {
+	return &CategoryResolver{m: r.m}
+}
+
 // CategoryResolver defines resolver models.
 type CategoryResolver struct {
 	m *models.Category
 }
 
-// ID is the Category's field id.
+// ID is the Category's field id, it is the global id of the category.
 func (r *CategoryResolver) ID(ctx context.Context) gographql.ID {
+	return inout.EncodeNodeID(inout.NodeTypeCategory, r.m.CountryCode, r.m.ID)
+}
+
+// ZendeskID is the Category's field zendesk_id.
+func (r *CategoryResolver) ZendeskID(ctx context.Context) gographql.ID {
 	return gographql.ID(strconv.Itoa(r.m.ID))
 }
 
@@ -117,9 +159,10 @@ func (r *CategoryResolver) Locale(ctx context.Context) string {
 
 // SectionsConnection is the Category's field sections.
 func (r *CategoryResolver) SectionsConnection(ctx context.Context, data inout.QuerySectionsIn) (*SectionsResolver, error) {
-	// Process input params. Ingore error for connection since country code
-	// and locale are snake_case already.
-	_ = data.ProcessInputParams()
+	// Process input params. Country code and locale are inherited from the parent.
+	if err := data.ProcessConnectionParams(); err != nil {
+		return nil, err
+	}
 
 	id := gographql.ID(strconv.Itoa(r.m.ID))
 	data.CategoryID = &id
@@ -136,9 +179,10 @@ func (r *CategoryResolver) SectionsConnection(ctx context.Context, data inout.Qu
 
 // ArticlesConnection is the Category's field articles.
 func (r *CategoryResolver) ArticlesConnection(ctx context.Context, data inout.QueryArticlesIn) (*ArticlesResolver, error) {
-	// Process input params. Ingore error for connection since country code
-	// and locale are snake_case already.
-	_ = data.ProcessInputParams()
+	// Process input params. Country code and locale are inherited from the parent.
+	if err := data.ProcessConnectionParams(); err != nil {
+		return nil, err
+	}
 
 	id := gographql.ID(strconv.Itoa(r.m.ID))
 	data.CategoryID = &id
@@ -0,0 +1,46 @@
+package resolvers
+
+import (
+	"context"
+)
+
+// ConnectionPageInfoResolver defines resolver models.
+type ConnectionPageInfoResolver struct {
+	hasNextPage     bool
+	hasPreviousPage bool
+	startCursor     *string
+	endCursor       *string
+}
+
+// newConnectionPageInfoResolver returns the page info resolver of the edge cursors.
+func newConnectionPageInfoResolver(hasNextPage, hasPreviousPage bool, cursors []string) *ConnectionPageInfoResolver {
+	r := &ConnectionPageInfoResolver{
+		hasNextPage:     hasNextPage,
+		hasPreviousPage: hasPreviousPage,
+	}
+	if len(cursors) > 0 {
+		r.startCursor = &cursors[0]
+		r.endCursor = &cursors[len(cursors)-1]
+	}
+	return r
+}
+
+// HasNextPage is the ConnectionPageInfo's field has_next_page.
+func (r *ConnectionPageInfoResolver) HasNextPage(ctx context.Context) bool {
+	return r.hasNextPage
+}
+
+// HasPreviousPage is the ConnectionPageInfo's field has_previous_page.
+func (r *ConnectionPageInfoResolver) HasPreviousPage(ctx context.Context) bool {
+	return r.hasPreviousPage
+}
+
+// StartCursor is the ConnectionPageInfo's field start_cursor.
+func (r *ConnectionPageInfoResolver) StartCursor(ctx context.Context) *string {
+	return r.startCursor
+}
+
+// EndCursor is the ConnectionPageInfo's field end_cursor.
+func (r *ConnectionPageInfoResolver) EndCursor(ctx context.Context) *string {
+	return r.endCursor
+}
@@ -1,15 +1,29 @@
 package resolvers
 
 import (
+	"context"
+	"sync/atomic"
+
 	gographql "github.com/graph-gophers/graphql-go"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
 	"github.com/rs/zerolog"
-	graphqltrace "gopkg.in/DataDog/dd-trace-go.v1/contrib/graph-gophers/graphql-go"
+	"github.com/vektah/gqlparser/v2/ast"
 
+	"github.com/honestbee/Zen/antispam"
+	"github.com/honestbee/Zen/auth"
 	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/cost"
 	"github.com/honestbee/Zen/dataloader"
 	"github.com/honestbee/Zen/examiner"
+	"github.com/honestbee/Zen/gqldoc"
+	"github.com/honestbee/Zen/health"
+	"github.com/honestbee/Zen/metrics"
 	"github.com/honestbee/Zen/models"
+	"github.com/honestbee/Zen/redact"
 	"github.com/honestbee/Zen/schema"
+	"github.com/honestbee/Zen/subscription"
+	"github.com/honestbee/Zen/tracing"
+	"github.com/honestbee/Zen/votes"
 	"github.com/honestbee/Zen/zendesk"
 )
 
@@ -17,6 +31,16 @@ import (
 type GraphQL struct {
 	Schema *gographql.Schema
 	Loader dataloader.Collection
+
+	// subscriptionSchema executes the subscriptions as queries rooted at the subscription type,
+	// since the subscription operations can not be executed by Schema.
+	subscriptionSchema *gographql.Schema
+	broker             *subscription.Broker
+
+	conf     *config.GraphQL
+	logger   *zerolog.Logger
+	service  models.Service
+	analyzer *cost.Analyzer
 }
 
 // New return the new GraphQL.
@@ -24,9 +48,16 @@ func New(conf *config.Config,
 	logger *zerolog.Logger,
 	service models.Service,
 	examiner *examiner.Examiner,
-	zendesk *zendesk.ZenDesk) (*GraphQL, error) {
+	zendesk *zendesk.ZenDesk,
+	guard *antispam.Guard,
+	broker *subscription.Broker,
+	authn *auth.Authenticator,
+	checker *health.Checker) (*GraphQL, error) {
+
+	tracer := gographql.Tracer(metrics.NewTracer(redact.NewTracer(tracing.NewGraphQLTracer())))
+	payloads := newEventPayloads()
 
-	return &GraphQL{
+	g := &GraphQL{
 		Schema: gographql.MustParseSchema(
 			schema.String(),
 			&Resolver{
@@ -35,11 +66,162 @@ func New(conf *config.Config,
 				service:  service,
 				examiner: examiner,
 				zendesk:  zendesk,
+				guard:    guard,
+				auth:     authn,
+				votes:    votes.New(conf, service, zendesk),
+				checker:  checker,
 			},
-			gographql.Tracer(graphqltrace.NewTracer(graphqltrace.WithServiceName("helpcenter-zendesk-graphql"))),
+			tracer,
 			gographql.MaxDepth(conf.GraphQL.MaxDepth),
 			gographql.MaxParallelism(conf.GraphQL.MaxParallelism),
 		),
 		Loader: dataloader.Initialize(service, examiner, zendesk),
-	}, nil
+		subscriptionSchema: gographql.MustParseSchema(
+			schema.SubscriptionString(),
+			&SubscriptionResolver{service: service, payloads: payloads},
+			tracer,
+			gographql.MaxDepth(conf.GraphQL.MaxDepth),
+			gographql.MaxParallelism(conf.GraphQL.MaxParallelism),
+		),
+		broker:  broker,
+		conf:    conf.GraphQL,
+		logger:  logger,
+		service: service,
+	}
+	g.analyzer = cost.NewAnalyzer(g.Schema)
+
+	return g, nil
+}
+
+// Exec executes the operation if its static cost is inside the max cost and the budget of the client,
+// the cost is reported in the extensions of the response.
+func (g *GraphQL) Exec(ctx context.Context, query, operationName string, variables map[string]interface{}) *gographql.Response {
+	doc, err := gqldoc.Parse(query)
+	if err != nil {
+		return &gographql.Response{Errors: []*gqlerrors.QueryError{err}}
+	}
+	return g.exec(ctx, doc, operationName, variables)
+}
+
+func (g *GraphQL) exec(ctx context.Context, doc *gqldoc.Document, operationName string, variables map[string]interface{}) *gographql.Response {
+	query := doc.Source()
+	extensions, errs := g.checkCost(ctx, doc, operationName, variables, true)
+	if len(errs) > 0 {
+		return &gographql.Response{Errors: errs, Extensions: extensions}
+	}
+
+	res := g.Schema.Exec(ctx, query, operationName, variables)
+	res.Extensions = extensions
+	return res
+}
+
+// checkCost checks the static cost of the operation, the cost is spent from the budget
+// of the client if spend is true. The operation is rejected if it is not found in the document,
+// since it can not run without being analyzed.
+func (g *GraphQL) checkCost(ctx context.Context, doc *gqldoc.Document, operationName string, variables map[string]interface{}, spend bool) (map[string]interface{}, []*gqlerrors.QueryError) {
+	op, err := doc.Operation(operationName)
+	if err != nil {
+		return nil, []*gqlerrors.QueryError{gqlerrors.Errorf("%s", err)}
+	}
+	requested := g.analyzer.Cost(doc, op, variables)
+
+	report := map[string]interface{}{"requestedQueryCost": requested}
+	extensions := map[string]interface{}{"cost": report}
+
+	if max := g.conf.MaxCost; max > 0 {
+		report["maximumQueryCost"] = max
+		if requested > max {
+			return extensions, []*gqlerrors.QueryError{gqlerrors.Errorf("query cost %d exceeds the max cost %d", requested, max)}
+		}
+	}
+
+	client := antispam.RemoteIPFromContext(ctx)
+	if budget := g.conf.CostBudget; !spend || budget <= 0 || client == "" {
+		return extensions, nil
+	}
+
+	remaining, ok, err := g.service.SpendCostBudget(ctx, client, requested, g.conf.CostBudget, g.conf.CostBudgetWindowSec)
+	if err != nil {
+		// The broken budget storage does not block the queries.
+		g.logger.Error().Err(err).Msgf("resolvers: [checkCost] service.SpendCostBudget failed")
+		return extensions, nil
+	}
+	report["remainingBudget"] = remaining
+	if !ok {
+		return extensions, []*gqlerrors.QueryError{gqlerrors.Errorf("query cost %d exceeds the remaining budget %d, retry later", requested, remaining)}
+	}
+	return extensions, nil
+}
+
+// Subscribe executes the subscription for each event matched by its arguments until ctx is done,
+// the responses are sent to the returned channel which is closed when the subscription ends.
+// The other operations are executed once. The returned errors mean the operation is not executed.
+// The document is parsed once, and it is only executed for the events matched by its fields.
+func (g *GraphQL) Subscribe(ctx context.Context, query, operationName string, variables map[string]interface{}) (<-chan *gographql.Response, []*gqlerrors.QueryError) {
+	if errs := g.Schema.Validate(query); len(errs) > 0 {
+		return nil, errs
+	}
+	doc, parseErr := gqldoc.Parse(query)
+	if parseErr != nil {
+		return nil, []*gqlerrors.QueryError{parseErr}
+	}
+	op, err := doc.Operation(operationName)
+	if err != nil {
+		return nil, []*gqlerrors.QueryError{gqlerrors.Errorf("%s", err)}
+	}
+
+	responses := make(chan *gographql.Response, 1)
+
+	if op.Operation != ast.Subscription {
+		responses <- g.exec(g.Loader.Attach(ctx), doc, operationName, variables)
+		close(responses)
+		return responses, nil
+	}
+
+	// The subscription is executed for each event, its cost is only checked against the max cost.
+	if _, errs := g.checkCost(ctx, doc, operationName, variables, false); len(errs) > 0 {
+		return nil, errs
+	}
+
+	// The arguments are checked by executing the subscription without any event,
+	// the execution records the matches of the subscription fields.
+	document := subscriptionAsQuery(doc, op)
+	subscribed := &subscriptionEvent{}
+	check := g.subscriptionSchema.Exec(withSubscriptionEvent(ctx, subscribed), document, operationName, variables)
+	if len(check.Errors) > 0 {
+		return nil, check.Errors
+	}
+
+	if g.broker == nil {
+		return nil, []*gqlerrors.QueryError{gqlerrors.Errorf("subscriptions are not supported")}
+	}
+	events, err := g.broker.Subscribe(ctx)
+	if err != nil {
+		return nil, []*gqlerrors.QueryError{gqlerrors.Errorf("%s", err)}
+	}
+
+	go func() {
+		defer close(responses)
+
+		for event := range events {
+			if !subscribed.match(event) {
+				continue
+			}
+
+			e := &subscriptionEvent{event: event}
+			eventCtx := withSubscriptionEvent(g.Loader.Attach(ctx), e)
+			res := g.subscriptionSchema.Exec(eventCtx, document, operationName, variables)
+			if atomic.LoadInt32(&e.matched) == 0 {
+				continue
+			}
+
+			select {
+			case responses <- res:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return responses, nil
 }
@@ -0,0 +1,90 @@
+package resolvers
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	"github.com/honestbee/Zen/antispam"
+	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/models"
+)
+
+func TestGraphQLExec(t *testing.T) {
+	logger := zerolog.New(ioutil.Discard)
+	conf := &config.Config{
+		GraphQL: &config.GraphQL{MaxDepth: 13, MaxParallelism: 10, MaxCost: 100, CostBudget: 5, CostBudgetWindowSec: 60},
+	}
+	g, err := New(conf, &logger, models.NewMockService(), nil, nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("new graphql failed:%v", err)
+	}
+
+	testCases := [...]struct {
+		description string
+		ctx         context.Context
+		query       string
+		expect      string
+	}{
+		{
+			description: "testing over max cost case",
+			ctx:         context.Background(),
+			query:       `{ allCategories(perPage: 100) { edges { node { name } } } }`,
+			expect:      `{"errors":[{"message":"query cost 102 exceeds the max cost 100"}],"extensions":{"cost":{"maximumQueryCost":100,"requestedQueryCost":102}}}`,
+		},
+		{
+			description: "testing no client budget case",
+			ctx:         context.Background(),
+			query:       `{ nodes(ids: []) { id } }`,
+			expect:      `{"data":{"nodes":[]},"extensions":{"cost":{"maximumQueryCost":100,"requestedQueryCost":1}}}`,
+		},
+		{
+			description: "testing client budget spent case",
+			ctx:         antispam.WithRemoteIP(context.Background(), "10.0.0.1"),
+			query:       `{ nodes(ids: ["a", "b"]) { ... on Category { sectionsConnection(first: 1) { pageInfo { hasNextPage } } } } }`,
+			expect:      `{"data":{"nodes":null},"errors":[{"message":"inout: [DecodeNodeID] base64 decode id:a failed: illegal base64 data at input byte 0","path":["nodes"]}],"extensions":{"cost":{"maximumQueryCost":100,"remainingBudget":0,"requestedQueryCost":5}}}`,
+		},
+		{
+			description: "testing client budget exceeded case",
+			ctx:         antispam.WithRemoteIP(context.Background(), "10.0.0.1"),
+			query:       `{ nodes(ids: []) { id } }`,
+			expect:      `{"errors":[{"message":"query cost 1 exceeds the remaining budget 0, retry later"}],"extensions":{"cost":{"maximumQueryCost":100,"remainingBudget":0,"requestedQueryCost":1}}}`,
+		},
+		{
+			description: "testing budget of another client case",
+			ctx:         antispam.WithRemoteIP(context.Background(), "10.0.0.2"),
+			query:       `{ nodes(ids: []) { id } }`,
+			expect:      `{"data":{"nodes":[]},"extensions":{"cost":{"maximumQueryCost":100,"remainingBudget":4,"requestedQueryCost":1}}}`,
+		},
+		{
+			description: "testing syntax error case",
+			ctx:         antispam.WithRemoteIP(context.Background(), "10.0.0.3"),
+			query:       `{ nodes(ids: []) { id }`,
+			expect:      `{"errors":[{"message":"Expected Name, found \u003cEOF\u003e","locations":[{"line":1,"column":24}]}]}`,
+		},
+		{
+			description: "testing operation not selected case",
+			ctx:         antispam.WithRemoteIP(context.Background(), "10.0.0.3"),
+			query:       `query A { nodes(ids: []) { id } } query B { nodes(ids: []) { id } }`,
+			expect:      `{"errors":[{"message":"more than one operation in query document and no operation name given"}]}`,
+		},
+		{
+			description: "testing status without health checker case",
+			ctx:         context.Background(),
+			query:       `{ status { liveness readiness checks { name status } syncs { countryCode } } }`,
+			expect:      `{"data":{"status":{"liveness":"unknown","readiness":"unknown","checks":[],"syncs":[]}},"extensions":{"cost":{"maximumQueryCost":100,"requestedQueryCost":3}}}`,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			b, _ := json.Marshal(g.Exec(g.Loader.Attach(tt.ctx), tt.query, "", nil))
+			if actual := string(b); actual != tt.expect {
+				t.Errorf("[%s] expect:%s, actual:%s", tt.description, tt.expect, actual)
+			}
+		})
+	}
+}
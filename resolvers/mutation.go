@@ -7,23 +7,65 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/honestbee/Zen/antispam"
+	"github.com/honestbee/Zen/auth"
 	"github.com/honestbee/Zen/errs"
 	"github.com/honestbee/Zen/inout"
+	"github.com/honestbee/Zen/models"
+	"github.com/honestbee/Zen/redact"
+	"github.com/honestbee/Zen/session"
+	"github.com/honestbee/Zen/votes"
 )
 
 // CreateRequest create a new createRequest resolver.
 func (r *Resolver) CreateRequest(ctx context.Context, data inout.MutationRequestsIn) (*string, error) {
+	if r.conf.Auth.TicketsScopeRequired {
+		if err := auth.Require(ctx, auth.ScopeTicketsCreate); err != nil {
+			return nil, err
+		}
+	}
+
 	// Process input params.
 	if err := data.ProcessInputParams(); err != nil {
 		return nil, err
 	}
 
+	sub := &antispam.Submission{
+		Source:       "graphql",
+		RemoteIP:     antispam.RemoteIPFromContext(ctx),
+		CountryCode:  data.CountryCode,
+		Email:        data.Data.Request.Requester.Email,
+		Subject:      data.Data.Request.Subject,
+		Body:         data.Data.Request.Comment.Body,
+		CaptchaToken: data.CaptchaToken,
+		Honeypot:     data.Website,
+	}
+	if err := r.guard.Check(ctx, sub); err != nil {
+		return nil, antispam.RejectedErr(err, "resolver: [CreateRequest] guard check failed")
+	}
+
+	// The viewed articles are attached on a best effort basis, the request is filed without them on failure.
+	if sessionID := session.IDFromContext(ctx); sessionID != "" {
+		if articleIDs, err := r.service.GetSessionViewedArticles(ctx, sessionID); err == nil {
+			data.Data.AttachViewedArticles(r.conf.Analytics.DeflectionFieldID, articleIDs)
+		}
+	}
+
 	if err := r.zendesk.CreateRequest(ctx, data.CountryCode, data.Data); err != nil {
+		r.guard.Release(ctx, sub)
 		return nil, errs.NewErr(
 			errs.InvalidAttributeErrorCode,
-			errors.Wrapf(err, "resolver: [CreateRequest] zendesk.CreateRequest failed"),
+			errors.Wrapf(
+				redact.Error(err, data.Data.Secrets(r.conf)...),
+				"resolver: [CreateRequest] zendesk.CreateRequest failed",
+			),
 		)
 	}
+	r.service.RecordSessionEvent(ctx, &models.SessionEvent{
+		SessionID:   session.IDFromContext(ctx),
+		Kind:        models.RequestSessionEvent,
+		CountryCode: data.CountryCode,
+	})
 
 	ret := http.StatusText(http.StatusCreated)
 	return &ret, nil
@@ -47,15 +89,28 @@ func (r *Resolver) VoteArticle(ctx context.Context, data inout.MutationVoteArtic
 		)
 	}
 
-	voteResult, err := r.zendesk.CreateVote(ctx, int(articleID64), data.Vote, data.CountryCode, data.Locale)
+	voteResult, err := r.votes.Cast(ctx, &votes.Ballot{
+		ArticleID:   int(articleID64),
+		Value:       data.Vote,
+		Voter:       session.Voter(ctx, antispam.RemoteIPFromContext(ctx), r.conf.Votes.DeviceSecret),
+		RemoteIP:    antispam.RemoteIPFromContext(ctx),
+		CountryCode: data.CountryCode,
+		Locale:      data.Locale,
+	})
 	if err != nil {
-		return nil, errs.NewErr(
-			errs.ServerInternalErrorCode,
-			errors.Wrapf(err, "resolver: [VoteArticle] zendesk.CreateVote failed"),
-		)
+		return nil, votes.CastErr(err, "resolver: [VoteArticle] votes.Cast failed")
 	}
 
-	defer r.examiner.SyncArticle(ctx, int(articleID64), data.CountryCode, data.Locale)
+	if !voteResult.Duplicate {
+		defer r.examiner.SyncArticle(ctx, int(articleID64), data.CountryCode, data.Locale)
+		r.service.RecordSessionEvent(ctx, &models.SessionEvent{
+			SessionID:   session.IDFromContext(ctx),
+			Kind:        models.VoteSessionEvent,
+			ArticleID:   int(articleID64),
+			CountryCode: data.CountryCode,
+			Locale:      data.Locale,
+		})
+	}
 
 	articleOut, err := r.service.GetArticleByArticleID(ctx, int(articleID64), data.Locale, data.CountryCode)
 	if err != nil {
@@ -70,13 +125,26 @@ func (r *Resolver) VoteArticle(ctx context.Context, data inout.MutationVoteArtic
 	return &ArticleResolver{m: articleOut}, nil
 }
 
-// ForceSync create a new forceSync resolver.
+// ForceSync create a new forceSync resolver, the sync:write scope is required.
+// The username and password arguments are kept for the clients not sending the credentials in the headers.
 func (r *Resolver) ForceSync(ctx context.Context, data inout.MutationForceSyncIn) (*string, error) {
-	if r.conf.HTTP.BasicAuthUser != data.Username || r.conf.HTTP.BasicAuthPwd != data.Password {
-		return nil, errs.NewErr(
-			errs.UnauthorizedErrCode,
-			errors.Errorf("resolver: [ForceSync] user or password not match"),
-		)
+	if data.Username != nil && *data.Username != "" {
+		var pwd string
+		if data.Password != nil {
+			pwd = *data.Password
+		}
+		p, err := r.auth.BasicAuth(*data.Username, pwd)
+		if err != nil {
+			return nil, errs.NewErr(
+				errs.UnauthorizedErrCode,
+				errors.Wrapf(err, "resolver: [ForceSync] user or password not match"),
+			)
+		}
+		ctx = auth.WithPrincipal(ctx, p)
+	}
+
+	if err := auth.Require(ctx, auth.ScopeSyncWrite); err != nil {
+		return nil, err
 	}
 
 	go func() {
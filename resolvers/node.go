@@ -0,0 +1,166 @@
+package resolvers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+
+	gographql "github.com/graph-gophers/graphql-go"
+	"github.com/pkg/errors"
+
+	"github.com/honestbee/Zen/dataloader"
+	"github.com/honestbee/Zen/errs"
+	"github.com/honestbee/Zen/inout"
+)
+
+// node is the resolver of an object which can be refetched by its global id.
+type node interface {
+	ID(ctx context.Context) gographql.ID
+}
+
+// NodeResolver defines resolver models.
+type NodeResolver struct {
+	node
+}
+
+// ToCategory converts the Node to the Category.
+func (r *NodeResolver) ToCategory() (*CategoryResolver, bool) {
+	c, ok := r.node.(*CategoryResolver)
+	return c, ok
+}
+
+// ToSection converts the Node to the Section.
+func (r *NodeResolver) ToSection() (*SectionResolver, bool) {
+	s, ok := r.node.(*SectionResolver)
+	return s, ok
+}
+
+// ToArticle converts the Node to the Article.
+func (r *NodeResolver) ToArticle() (*ArticleResolver, bool) {
+	a, ok := r.node.(*ArticleResolver)
+	return a, ok
+}
+
+// ToTicketForm converts the Node to the TicketForm.
+func (r *NodeResolver) ToTicketForm() (*TicketFormResolver, bool) {
+	f, ok := r.node.(*TicketFormResolver)
+	return f, ok
+}
+
+// ToTicketField converts the Node to the TicketField.
+func (r *NodeResolver) ToTicketField() (*TicketFieldResolver, bool) {
+	f, ok := r.node.(*TicketFieldResolver)
+	return f, ok
+}
+
+// Node creates a new node resolver.
+func (r *Resolver) Node(ctx context.Context, data inout.QueryNodeIn) (*NodeResolver, error) {
+	// Process input params.
+	if err := data.ProcessInputParams(); err != nil {
+		return nil, err
+	}
+
+	return loadNode(ctx, data.ID, data.Locale)
+}
+
+// Nodes creates a new nodes resolver, the nodes are loaded at the same time
+// so that the dataloaders batch them.
+func (r *Resolver) Nodes(ctx context.Context, data inout.QueryNodesIn) ([]*NodeResolver, error) {
+	// Process input params.
+	if err := data.ProcessInputParams(); err != nil {
+		return nil, err
+	}
+
+	var (
+		ret     = make([]*NodeResolver, len(data.IDs))
+		errList = make([]error, len(data.IDs))
+		wg      sync.WaitGroup
+	)
+
+	wg.Add(len(data.IDs))
+	for i, id := range data.IDs {
+		go func(i int, id gographql.ID) {
+			defer wg.Done()
+			ret[i], errList[i] = loadNode(ctx, id, data.Locale)
+		}(i, id)
+	}
+	wg.Wait()
+
+	for _, err := range errList {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+// loadNode loads the object of the global id, nil is returned if the object is not found.
+func loadNode(ctx context.Context, id gographql.ID, locale string) (*NodeResolver, error) {
+	nodeID, err := inout.DecodeNodeID(id)
+	if err != nil {
+		return nil, errs.NewErr(errs.InvalidAttributeErrorCode, err)
+	}
+	zendeskID := gographql.ID(strconv.Itoa(nodeID.ID))
+
+	var n node
+	switch nodeID.Type {
+	case inout.NodeTypeCategory:
+		m, err := dataloader.LoadCategory(ctx, inout.QueryCategoryIn{
+			CategoryIDOrKeyName: zendeskID,
+			CountryCode:         nodeID.CountryCode,
+			Locale:              locale,
+		})
+		if err != nil {
+			return nodeNotFound(err)
+		}
+		n = &CategoryResolver{m: m}
+	case inout.NodeTypeSection:
+		m, err := dataloader.LoadSection(ctx, inout.QuerySectionIn{
+			SectionID:   zendeskID,
+			CountryCode: nodeID.CountryCode,
+			Locale:      locale,
+		})
+		if err != nil {
+			return nodeNotFound(err)
+		}
+		n = &SectionResolver{m: m}
+	case inout.NodeTypeArticle:
+		m, err := dataloader.LoadArticle(ctx, inout.QueryArticleIn{
+			ArticleID:   zendeskID,
+			CountryCode: nodeID.CountryCode,
+			Locale:      locale,
+		})
+		if err != nil {
+			return nodeNotFound(err)
+		}
+		n = &ArticleResolver{m: m}
+	case inout.NodeTypeTicketForm:
+		m, err := dataloader.LoadTicketForm(ctx, inout.QueryTicketFormIn{FormID: zendeskID})
+		if err != nil {
+			return nodeNotFound(err)
+		}
+		n = &TicketFormResolver{m: m}
+	case inout.NodeTypeTicketField:
+		m, err := dataloader.LoadTicketField(ctx, inout.QueryTicketFieldIn{FieldID: zendeskID, Locale: locale})
+		if err != nil {
+			return nodeNotFound(err)
+		}
+		n = &TicketFieldResolver{m: m}
+	default:
+		return nil, errs.NewErr(
+			errs.InvalidAttributeErrorCode,
+			errors.Errorf("resolvers: [loadNode] id:%s has an unknown type:%s", id, nodeID.Type),
+		)
+	}
+
+	return &NodeResolver{node: n}, nil
+}
+
+// nodeNotFound returns nil for the not found error so that the node is null, the other errors are kept.
+func nodeNotFound(err error) (*NodeResolver, error) {
+	if e, ok := err.(*errs.Error); ok && e.Status == http.StatusNotFound {
+		return nil, nil
+	}
+	return nil, err
+}
@@ -123,6 +123,11 @@ func (r *Resolver) OneArticle(ctx context.Context, data inout.QueryArticleIn) (*
 
 // OneTicketForm creates a new oneTicketForm resolver.
 func (r *Resolver) OneTicketForm(ctx context.Context, data inout.QueryTicketFormIn) (*TicketFormResolver, error) {
+	// Process input params.
+	if err := data.ProcessInputParams(); err != nil {
+		return nil, err
+	}
+
 	result, err := dataloader.LoadTicketForm(ctx, data)
 	if err != nil {
 		return nil, err
@@ -168,5 +173,5 @@ func (r *Resolver) SearchBodyArticles(ctx context.Context, data inout.QuerySearc
 
 // Status creates a new status resolver.
 func (r *Resolver) Status(ctx context.Context) (*StatusResolver, error) {
-	return &StatusResolver{}, nil
+	return &StatusResolver{checker: r.checker}, nil
 }
@@ -3,9 +3,13 @@ package resolvers
 import (
 	"github.com/rs/zerolog"
 
+	"github.com/honestbee/Zen/antispam"
+	"github.com/honestbee/Zen/auth"
 	"github.com/honestbee/Zen/config"
 	"github.com/honestbee/Zen/examiner"
+	"github.com/honestbee/Zen/health"
 	"github.com/honestbee/Zen/models"
+	"github.com/honestbee/Zen/votes"
 	"github.com/honestbee/Zen/zendesk"
 )
 
@@ -16,4 +20,8 @@ type Resolver struct {
 	service  models.Service
 	examiner *examiner.Examiner
 	zendesk  *zendesk.ZenDesk
+	guard    *antispam.Guard
+	auth     *auth.Authenticator
+	votes    *votes.Ledger
+	checker  *health.Checker
 }
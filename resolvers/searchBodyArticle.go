@@ -45,13 +45,55 @@ func (r *SearchBodyArticlesResolver) Count(ctx context.Context) int32 {
 	return int32(r.m.Count)
 }
 
+// Edges is the SearchBodyArticles's field edges.
+func (r *SearchBodyArticlesResolver) Edges(ctx context.Context) []*SearchBodyArticleEdgeResolver {
+	ret := make([]*SearchBodyArticleEdgeResolver, 0, len(r.m.Articles))
+	for i, article := range r.m.Articles {
+		ret = append(ret, &SearchBodyArticleEdgeResolver{
+			m:      article,
+			cursor: inout.EncodeOffsetCursor(r.m.Connection.Offset + i),
+		})
+	}
+	return ret
+}
+
+// PageInfo is the SearchBodyArticles's field page_info.
+func (r *SearchBodyArticlesResolver) PageInfo(ctx context.Context) *ConnectionPageInfoResolver {
+	cursors := make([]string, 0, len(r.m.Articles))
+	for i := range r.m.Articles {
+		cursors = append(cursors, inout.EncodeOffsetCursor(r.m.Connection.Offset+i))
+	}
+	return newConnectionPageInfoResolver(r.m.Connection.HasNextPage, r.m.Connection.HasPreviousPage, cursors)
+}
+
+// SearchBodyArticleEdgeResolver defines resolver models.
+type SearchBodyArticleEdgeResolver struct {
+	m      *models.SearchArticle
+	cursor string
+}
+
+// Cursor is the SearchBodyArticleEdge's field cursor.
+func (r *SearchBodyArticleEdgeResolver) Cursor(ctx context.Context) string {
+	return r.cursor
+}
+
+// Node is the SearchBodyArticleEdge's field node.
+func (r *SearchBodyArticleEdgeResolver) Node(ctx context.Context) *SearchBodyArticleResolver {
+	return &SearchBodyArticleResolver{m: r.m}
+}
+
 // SearchBodyArticleResolver defines resolver models.
 type SearchBodyArticleResolver struct {
 	m *models.SearchArticle
 }
 
-// ID is the SearchArticle's field id.
+// ID is the SearchArticle's field id, it is the global id of the article.
 func (r *SearchBodyArticleResolver) ID(ctx context.Context) gographql.ID {
+	return inout.EncodeNodeID(inout.NodeTypeArticle, r.m.CountryCode, r.m.ID)
+}
+
+// ZendeskID is the SearchArticle's field zendesk_id.
+func (r *SearchBodyArticleResolver) ZendeskID(ctx context.Context) gographql.ID {
 	return gographql.ID(strconv.Itoa(r.m.ID))
 }
 
@@ -45,13 +45,55 @@ func (r *SectionsResolver) Count(ctx context.Context) int32 {
 	return int32(r.m.Count)
 }
 
+// Edges is the Sections's field edges.
+func (r *SectionsResolver) Edges(ctx context.Context) []*SectionEdgeResolver {
+	ret := make([]*SectionEdgeResolver, 0, len(r.m.Sections))
+	for _, section := range r.m.Sections {
+		ret = append(ret, &SectionEdgeResolver{
+			m:      section,
+			cursor: inout.EncodeCursor(section.Cursor(), r.m.Connection.SortBy, r.m.Connection.SortOrder),
+		})
+	}
+	return ret
+}
+
+// PageInfo is the Sections's field page_info.
+func (r *SectionsResolver) PageInfo(ctx context.Context) *ConnectionPageInfoResolver {
+	cursors := make([]string, 0, len(r.m.Sections))
+	for _, section := range r.m.Sections {
+		cursors = append(cursors, inout.EncodeCursor(section.Cursor(), r.m.Connection.SortBy, r.m.Connection.SortOrder))
+	}
+	return newConnectionPageInfoResolver(r.m.Connection.HasNextPage, r.m.Connection.HasPreviousPage, cursors)
+}
+
+// SectionEdgeResolver defines resolver models.
+type SectionEdgeResolver struct {
+	m      *models.Section
+	cursor string
+}
+
+// Cursor is the SectionEdge's field cursor.
+func (r *SectionEdgeResolver) Cursor(ctx context.Context) string {
+	return r.cursor
+}
+
+// Node is the SectionEdge's field node.
+func (r *SectionEdgeResolver) Node(ctx context.Context) *SectionResolver {
+	return &SectionResolver{m: r.m}
+}
+
 // SectionResolver defines resolver models.
 type SectionResolver struct {
 	m *models.Section
 }
 
-// ID is the Section's field id.
+// ID is the Section's field id, it is the global id of the section.
 func (r *SectionResolver) ID(ctx context.Context) gographql.ID {
+	return inout.EncodeNodeID(inout.NodeTypeSection, r.m.CountryCode, r.m.ID)
+}
+
+// ZendeskID is the Section's field zendesk_id.
+func (r *SectionResolver) ZendeskID(ctx context.Context) gographql.ID {
 	return gographql.ID(strconv.Itoa(r.m.ID))
 }
 
@@ -129,9 +171,10 @@ func (r *SectionResolver) CategoryConnection(ctx context.Context) (*CategoryReso
 
 // ArticlesConnection is the Section's field articles.
 func (r *SectionResolver) ArticlesConnection(ctx context.Context, data inout.QueryArticlesIn) (*ArticlesResolver, error) {
-	// Process input params. Ingore error for connection since country code
-	// and locale are snake_case already.
-	_ = data.ProcessInputParams()
+	// Process input params. Country code and locale are inherited from the parent.
+	if err := data.ProcessConnectionParams(); err != nil {
+		return nil, err
+	}
 
 	id := gographql.ID(strconv.Itoa(r.m.ID))
 	data.SectionID = &id
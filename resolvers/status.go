@@ -8,10 +8,12 @@ import (
 	gographql "github.com/graph-gophers/graphql-go"
 
 	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/health"
 )
 
-// StatusResolver defines resolver models.
+// StatusResolver defines resolver models, the health fields are unknown without the checker.
 type StatusResolver struct {
+	checker *health.Checker
 }
 
 // GoVersion is the Status's field go_version.
@@ -28,3 +30,104 @@ func (r *StatusResolver) AppVersion(ctx context.Context) string {
 func (r *StatusResolver) ServerTime(ctx context.Context) gographql.Time {
 	return gographql.Time{Time: time.Now().UTC()}
 }
+
+// Liveness is the Status's field liveness.
+func (r *StatusResolver) Liveness(ctx context.Context) string {
+	if r.checker == nil {
+		return health.StatusUnknown
+	}
+	return r.checker.Live().Status
+}
+
+// Readiness is the Status's field readiness.
+func (r *StatusResolver) Readiness(ctx context.Context) string {
+	if r.checker == nil {
+		return health.StatusUnknown
+	}
+	return r.checker.Ready().Status
+}
+
+// Checks is the Status's field checks.
+func (r *StatusResolver) Checks(ctx context.Context) []*HealthCheckResolver {
+	if r.checker == nil {
+		return []*HealthCheckResolver{}
+	}
+	checks := r.checker.Ready().Checks
+	ret := make([]*HealthCheckResolver, len(checks))
+	for i, check := range checks {
+		ret[i] = &HealthCheckResolver{result: check}
+	}
+	return ret
+}
+
+// Syncs is the Status's field syncs.
+func (r *StatusResolver) Syncs(ctx context.Context) []*SyncAgeResolver {
+	if r.checker == nil {
+		return []*SyncAgeResolver{}
+	}
+	syncs := r.checker.Ready().Syncs
+	ret := make([]*SyncAgeResolver, len(syncs))
+	for i, age := range syncs {
+		ret[i] = &SyncAgeResolver{age: age}
+	}
+	return ret
+}
+
+// HealthCheckResolver defines resolver models.
+type HealthCheckResolver struct {
+	result *health.Result
+}
+
+// Name is the HealthCheck's field name.
+func (r *HealthCheckResolver) Name(ctx context.Context) string {
+	return r.result.Name
+}
+
+// Status is the HealthCheck's field status.
+func (r *HealthCheckResolver) Status(ctx context.Context) string {
+	return r.result.Status
+}
+
+// Error is the HealthCheck's field error.
+func (r *HealthCheckResolver) Error(ctx context.Context) *string {
+	if r.result.Error == "" {
+		return nil
+	}
+	return &r.result.Error
+}
+
+// CheckedAt is the HealthCheck's field checked_at.
+func (r *HealthCheckResolver) CheckedAt(ctx context.Context) *gographql.Time {
+	if r.result.CheckedAt.IsZero() {
+		return nil
+	}
+	return &gographql.Time{Time: r.result.CheckedAt}
+}
+
+// SyncAgeResolver defines resolver models.
+type SyncAgeResolver struct {
+	age *health.SyncAge
+}
+
+// CountryCode is the SyncAge's field country_code.
+func (r *SyncAgeResolver) CountryCode(ctx context.Context) string {
+	return r.age.CountryCode
+}
+
+// SyncedAt is the SyncAge's field synced_at.
+func (r *SyncAgeResolver) SyncedAt(ctx context.Context) *gographql.Time {
+	if r.age.SyncedAt == nil {
+		return nil
+	}
+	return &gographql.Time{Time: *r.age.SyncedAt}
+}
+
+// AgeSec is the SyncAge's field age_sec.
+func (r *SyncAgeResolver) AgeSec(ctx context.Context) int32 {
+	return int32(r.age.AgeSec)
+}
+
+// Stale is the SyncAge's field stale.
+func (r *SyncAgeResolver) Stale(ctx context.Context) bool {
+	return r.age.Stale
+}
@@ -0,0 +1,195 @@
+package resolvers
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/honestbee/Zen/errs"
+	"github.com/honestbee/Zen/gqldoc"
+	"github.com/honestbee/Zen/inout"
+	"github.com/honestbee/Zen/models"
+)
+
+const (
+	// recentEvents is the number of the recent events whose payloads are kept for the subscriptions.
+	recentEvents = 64
+)
+
+type subscriptionEventKey struct{}
+
+// subscriptionEvent is the event resolved by an execution of a subscription,
+// matched is set if any subscription field resolves the event. The execution
+// without any event records the matches of the subscription fields instead.
+type subscriptionEvent struct {
+	event   *models.Event
+	matched int32
+
+	mu      sync.Mutex
+	matches []func(*models.Event) bool
+}
+
+func withSubscriptionEvent(ctx context.Context, event *subscriptionEvent) context.Context {
+	return context.WithValue(ctx, subscriptionEventKey{}, event)
+}
+
+// matchEvent returns the event of the execution if match returns true for it, nil is returned
+// for the execution which checks the arguments before subscribing, and the match is recorded.
+func matchEvent(ctx context.Context, match func(*models.Event) bool) *models.Event {
+	e, ok := ctx.Value(subscriptionEventKey{}).(*subscriptionEvent)
+	if !ok {
+		return nil
+	}
+	if e.event == nil {
+		e.mu.Lock()
+		e.matches = append(e.matches, match)
+		e.mu.Unlock()
+		return nil
+	}
+	if !match(e.event) {
+		return nil
+	}
+	atomic.StoreInt32(&e.matched, 1)
+	return e.event
+}
+
+// match returns true if any recorded match of the subscription fields returns true for the event.
+func (e *subscriptionEvent) match(event *models.Event) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for _, match := range e.matches {
+		if match(event) {
+			return true
+		}
+	}
+	return false
+}
+
+// eventPayloads are the payloads of the recent events shared by the subscriptions. A payload
+// is decided by its event only, so it is loaded once for all the subscriptions matching the event.
+type eventPayloads struct {
+	mu       sync.Mutex
+	recent   []*models.Event
+	payloads map[*models.Event]map[string]*payload
+}
+
+type payload struct {
+	once  sync.Once
+	value interface{}
+	err   error
+}
+
+func newEventPayloads() *eventPayloads {
+	return &eventPayloads{payloads: make(map[*models.Event]map[string]*payload)}
+}
+
+// load returns the payload of the field for the event, fn is only called by the first load
+// of the event and the field. The payloads of the oldest events are dropped.
+func (p *eventPayloads) load(event *models.Event, field string, fn func() (interface{}, error)) (interface{}, error) {
+	p.mu.Lock()
+	fields, ok := p.payloads[event]
+	if !ok {
+		if len(p.recent) >= recentEvents {
+			delete(p.payloads, p.recent[0])
+			p.recent = p.recent[1:]
+		}
+		fields = make(map[string]*payload)
+		p.payloads[event] = fields
+		p.recent = append(p.recent, event)
+	}
+	pl, ok := fields[field]
+	if !ok {
+		pl = &payload{}
+		fields[field] = pl
+	}
+	p.mu.Unlock()
+
+	pl.once.Do(func() {
+		pl.value, pl.err = fn()
+	})
+	return pl.value, pl.err
+}
+
+// SubscriptionResolver is the subscription resolver, each event is resolved
+// by executing the subscription as a query of which the root is this resolver.
+type SubscriptionResolver struct {
+	service  models.Service
+	payloads *eventPayloads
+}
+
+// ArticleUpdated resolves the updated article of the event.
+func (r *SubscriptionResolver) ArticleUpdated(ctx context.Context, data inout.SubscriptionArticleUpdatedIn) (*ArticleResolver, error) {
+	// Process input params.
+	if err := data.ProcessInputParams(); err != nil {
+		return nil, err
+	}
+
+	event := matchEvent(ctx, data.Match)
+	if event == nil {
+		return nil, nil
+	}
+
+	// The article is got from the database since the cache may be refreshed after the event.
+	// It is shared by the subscriptions, so it is not loaded by the context of any of them.
+	v, err := r.payloads.load(event, "articleUpdated", func() (interface{}, error) {
+		return r.service.GetArticleByArticleID(context.Background(), event.IDs[0], event.Locale, event.CountryCode)
+	})
+	if err != nil {
+		if err == models.ErrNotFound {
+			return nil, nil
+		}
+		return nil, errs.NewErr(
+			errs.ServerInternalErrorCode,
+			errors.Wrapf(err, "resolvers: [ArticleUpdated] service.GetArticleByArticleID failed"),
+		)
+	}
+
+	return &ArticleResolver{m: v.(*models.Article)}, nil
+}
+
+// CategoriesChanged resolves all categories after the change of the event.
+func (r *SubscriptionResolver) CategoriesChanged(ctx context.Context, data inout.SubscriptionCategoriesChangedIn) (*[]*CategoryResolver, error) {
+	// Process input params.
+	if err := data.ProcessInputParams(); err != nil {
+		return nil, err
+	}
+
+	event := matchEvent(ctx, data.Match)
+	if event == nil {
+		return nil, nil
+	}
+
+	// The categories are shared by the subscriptions, the event has the country and the locale of data.
+	v, err := r.payloads.load(event, "categoriesChanged", func() (interface{}, error) {
+		categories, _, err := r.service.GetCategories(context.Background(), data.GetCategoriesParams())
+		return categories, err
+	})
+	if err != nil {
+		return nil, errs.NewErr(
+			errs.ServerInternalErrorCode,
+			errors.Wrapf(err, "resolvers: [CategoriesChanged] service.GetCategories failed"),
+		)
+	}
+
+	categories := v.([]*models.Category)
+	ret := make([]*CategoryResolver, len(categories))
+	for i, category := range categories {
+		ret[i] = &CategoryResolver{m: category}
+	}
+	return &ret, nil
+}
+
+// subscriptionAsQuery returns the document in which the keyword of the subscription operation
+// is replaced by query, so that it is executed by the subscription schema. The keyword is padded
+// by spaces to keep the locations of the errors.
+func subscriptionAsQuery(doc *gqldoc.Document, op *ast.OperationDefinition) string {
+	source := doc.Source()
+	start := doc.Offset(op.Position)
+	padding := strings.Repeat(" ", len("subscription")-len("query"))
+	return source[:start] + "query" + padding + source[start+len("subscription"):]
+}
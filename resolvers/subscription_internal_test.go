@@ -0,0 +1,216 @@
+package resolvers
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+	"github.com/rs/zerolog"
+
+	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/gqldoc"
+	"github.com/honestbee/Zen/models"
+	"github.com/honestbee/Zen/subscription"
+)
+
+func TestSubscriptionAsQuery(t *testing.T) {
+	testCases := []struct {
+		description   string
+		document      string
+		operationName string
+		expect        string
+	}{
+		{
+			description: "testing subscription case",
+			document:    `subscription { articleUpdated { id } }`,
+			expect:      `query        { articleUpdated { id } }`,
+		},
+		{
+			description: "testing named subscription with fragment case",
+			document:    "# subscription\nfragment f on Article { id }\nsubscription S($s: ID = \"subscription\") { articleUpdated(sectionId: $s) { ...f } }",
+			expect:      "# subscription\nfragment f on Article { id }\nquery        S($s: ID = \"subscription\") { articleUpdated(sectionId: $s) { ...f } }",
+		},
+		{
+			description:   "testing operation name case",
+			document:      `query Q { oneArticle(articleId: 1) { id } } subscription S { articleUpdated { id } }`,
+			operationName: "S",
+			expect:        `query Q { oneArticle(articleId: 1) { id } } query        S { articleUpdated { id } }`,
+		},
+		{
+			description: "testing multibyte comment case",
+			document:    "# 訂閱\nsubscription { articleUpdated { id } }",
+			expect:      "# 訂閱\nquery        { articleUpdated { id } }",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			doc, parseErr := gqldoc.Parse(tt.document)
+			if parseErr != nil {
+				t.Fatalf("[%s] parse failed:%v", tt.description, parseErr)
+			}
+			op, err := doc.Operation(tt.operationName)
+			if err != nil {
+				t.Fatalf("[%s] operation failed:%v", tt.description, err)
+			}
+			if actual := subscriptionAsQuery(doc, op); tt.expect != actual {
+				t.Errorf("[%s] expect:%q, actual:%q", tt.description, tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestEventPayloadsLoad(t *testing.T) {
+	p := newEventPayloads()
+	event := &models.Event{Type: models.EventCategoriesChanged, CountryCode: "tw", Locale: "en-us"}
+
+	var calls int32
+	load := func() (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		return "payload", nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if v, err := p.load(event, "categoriesChanged", load); v != "payload" || err != nil {
+				t.Errorf("expect the payload, actual:%v %v", v, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls != 1 {
+		t.Errorf("expect the payload loaded once for the subscriptions, actual:%d", calls)
+	}
+
+	// The payloads of the oldest events are dropped.
+	for i := 0; i < recentEvents; i++ {
+		p.load(&models.Event{}, "categoriesChanged", load)
+	}
+	p.load(event, "categoriesChanged", load)
+	if calls != recentEvents+2 {
+		t.Errorf("expect the dropped payload loaded again, actual calls:%d", calls)
+	}
+	if len(p.payloads) != recentEvents || len(p.recent) != recentEvents {
+		t.Errorf("expect %d payloads kept, actual:%d %d", recentEvents, len(p.payloads), len(p.recent))
+	}
+}
+
+func TestGraphQLSubscribe(t *testing.T) {
+	logger := zerolog.New(ioutil.Discard)
+	conf := &config.Config{
+		GraphQL:      &config.GraphQL{MaxDepth: 13, MaxParallelism: 10},
+		Subscription: &config.Subscription{BufferSize: 16},
+	}
+	mockServ := models.NewMockService()
+	broker, _ := subscription.New(conf, &logger, mockServ)
+	defer broker.Close()
+	graphql, err := New(conf, &logger, mockServ, nil, nil, nil, broker, nil, nil)
+	if err != nil {
+		t.Fatalf("new graphql failed:%v", err)
+	}
+
+	t.Run("testing invalid arguments case", func(t *testing.T) {
+		_, errs := graphql.Subscribe(context.Background(), `subscription { articleUpdated(sectionId: "x") { zendeskId } }`, "", nil)
+		if len(errs) == 0 {
+			t.Errorf("expect errors, actual none")
+		}
+	})
+
+	t.Run("testing unknown field case", func(t *testing.T) {
+		_, errs := graphql.Subscribe(context.Background(), `subscription { articleRemoved { zendeskId } }`, "", nil)
+		if len(errs) == 0 {
+			t.Errorf("expect errors, actual none")
+		}
+	})
+
+	t.Run("testing matched events case", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		responses, errs := graphql.Subscribe(ctx, `subscription S($s: ID) { articleUpdated(countryCode: TW, sectionId: $s) { zendeskId title } }`, "S",
+			map[string]interface{}{"s": "33456789"})
+		if len(errs) != 0 {
+			t.Fatalf("expect no errors, actual:%v", errs)
+		}
+
+		unmatched := []*models.Event{
+			{Type: models.EventArticleUpdated, CountryCode: "sg", Locale: "en-us", IDs: []int{33456710}, SectionID: 33456789},
+			{Type: models.EventArticleUpdated, CountryCode: "tw", Locale: "en-us", IDs: []int{33456710}, SectionID: 1},
+			{Type: models.EventCategoriesChanged, CountryCode: "tw", Locale: "en-us", IDs: []int{1}},
+		}
+		matched := &models.Event{Type: models.EventArticleUpdated, CountryCode: "tw", Locale: "en-us", IDs: []int{33456710}, SectionID: 33456789}
+
+		// The broker subscribes the events of the mock in background, publish until a response is received.
+		var actual json.RawMessage
+		for i := 0; i < 100 && actual == nil; i++ {
+			mockServ.PublishEvent(context.Background(), matched)
+			select {
+			case res := <-responses:
+				if len(res.Errors) != 0 {
+					t.Fatalf("expect no errors, actual:%v", res.Errors)
+				}
+				actual = res.Data
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+
+		expect := map[string]interface{}{
+			"articleUpdated": map[string]interface{}{
+				"zendeskId": "33456710",
+				"title":     "testing article 1",
+			},
+		}
+		var actualData map[string]interface{}
+		json.Unmarshal(actual, &actualData)
+		if diff := deep.Equal(expect, actualData); diff != nil {
+			t.Errorf("%v", diff)
+		}
+
+		// Drain the responses of the matched events published more than once.
+		for drained := false; !drained; {
+			select {
+			case <-responses:
+			case <-time.After(50 * time.Millisecond):
+				drained = true
+			}
+		}
+		for _, event := range unmatched {
+			mockServ.PublishEvent(context.Background(), event)
+		}
+		select {
+		case res := <-responses:
+			t.Errorf("expect no response for the unmatched events, actual:%s", res.Data)
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		cancel()
+		for range responses {
+		}
+	})
+
+	t.Run("testing query case", func(t *testing.T) {
+		responses, errs := graphql.Subscribe(context.Background(), `{ nodes(ids: []) { id } }`, "", nil)
+		if len(errs) != 0 {
+			t.Fatalf("expect no errors, actual:%v", errs)
+		}
+
+		count := 0
+		for res := range responses {
+			count++
+			if string(res.Data) != `{"nodes":[]}` {
+				t.Errorf("expect nodes data, actual:%s", res.Data)
+			}
+		}
+		if count != 1 {
+			t.Errorf("expect 1 response, actual:%d", count)
+		}
+	})
+}
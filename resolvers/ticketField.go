@@ -16,8 +16,13 @@ type TicketFieldResolver struct {
 	m *models.TicketField
 }
 
-// ID is the TicketForm's field updated_at.
+// ID is the TicketField's field id, it is the global id of the ticket field.
 func (r *TicketFieldResolver) ID(ctx context.Context) gographql.ID {
+	return inout.EncodeNodeID(inout.NodeTypeTicketField, "", r.m.ID)
+}
+
+// ZendeskID is the TicketField's field zendesk_id.
+func (r *TicketFieldResolver) ZendeskID(ctx context.Context) gographql.ID {
 	return gographql.ID(strconv.Itoa(r.m.ID))
 }
 
@@ -16,8 +16,13 @@ type TicketFormResolver struct {
 	m *models.SyncTicketForm
 }
 
-// ID is the TicketForm's field id.
+// ID is the TicketForm's field id, it is the global id of the ticket form.
 func (r *TicketFormResolver) ID(ctx context.Context) gographql.ID {
+	return inout.EncodeNodeID(inout.NodeTypeTicketForm, "", r.m.ID)
+}
+
+// ZendeskID is the TicketForm's field zendesk_id.
+func (r *TicketFormResolver) ZendeskID(ctx context.Context) gographql.ID {
 	return gographql.ID(strconv.Itoa(r.m.ID))
 }
 
@@ -1,16 +1,28 @@
 package router
 
 import (
+	"fmt"
 	"net/http"
 
+	"github.com/julienschmidt/httprouter"
+	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
-	httptrace "gopkg.in/DataDog/dd-trace-go.v1/contrib/julienschmidt/httprouter"
 
+	"github.com/honestbee/Zen/antispam"
+	"github.com/honestbee/Zen/auth"
 	"github.com/honestbee/Zen/config"
 	"github.com/honestbee/Zen/examiner"
+	"github.com/honestbee/Zen/gateway"
 	"github.com/honestbee/Zen/handlers"
+	"github.com/honestbee/Zen/health"
+	"github.com/honestbee/Zen/metrics"
 	"github.com/honestbee/Zen/models"
+	"github.com/honestbee/Zen/openapi"
+	"github.com/honestbee/Zen/persisted"
+	"github.com/honestbee/Zen/redact"
 	"github.com/honestbee/Zen/resolvers"
+	"github.com/honestbee/Zen/tracing"
+	"github.com/honestbee/Zen/votes"
 	"github.com/honestbee/Zen/zendesk"
 )
 
@@ -20,46 +32,141 @@ func New(conf *config.Config,
 	service models.Service,
 	examiner *examiner.Examiner,
 	zend *zendesk.ZenDesk,
-	graphql *resolvers.GraphQL) (*httptrace.Router, error) {
+	graphql *resolvers.GraphQL,
+	guard *antispam.Guard,
+	store *persisted.Store,
+	authn *auth.Authenticator,
+	gw *gateway.Gateway,
+	checker *health.Checker) (*httprouter.Router, error) {
 
 	e := &handlers.Env{
-		Config:   conf,
-		Logger:   logger,
-		Service:  service,
-		Examiner: examiner,
-		ZenDesk:  zend,
-		GraphQL:  graphql,
+		Config:    conf,
+		Logger:    logger,
+		Service:   service,
+		Examiner:  examiner,
+		ZenDesk:   zend,
+		GraphQL:   graphql,
+		Guard:     guard,
+		Persisted: store,
+		Auth:      authn,
+		Votes:     votes.New(conf, service, zend),
 	}
 
-	mux := httptrace.New(httptrace.WithServiceName("helpcenter-zendesk-http"))
+	mux := httprouter.New()
 	mux.PanicHandler = func(w http.ResponseWriter, r *http.Request, v interface{}) {
 		e.Logger.Error().Fields(map[string]interface{}{
 			"from":   r.RemoteAddr,
-			"path":   r.RequestURI,
+			"path":   redact.String(r.RequestURI),
 			"method": r.Method,
-		}).Msgf("panic:%v", v)
+		}).Msgf("panic:%s", redact.String(fmt.Sprint(v)))
 	}
 
-	// RESTful handlers.
-	mux.GET("/api/categories", handlers.Middleware(e, handlers.GetCategoriesDecompressor, handlers.GetCategoriesHandler))
-	mux.GET("/api/categories/:category_id/sections", handlers.Middleware(e, handlers.GetSectionsDecompressor, handlers.GetSectionsHandler))
-	mux.GET("/api/categories/:category_id/articles", handlers.Middleware(e, handlers.GetCategoriesArticlesDecompressor, handlers.GetCategoriesArticlesHandler))
-	mux.GET("/api/category/:category_key_name", handlers.Middleware(e, handlers.GetCategoryKeyNameToIDDecompressor, handlers.GetCategoryKeyNameToIDHandler))
-	mux.GET("/api/sections/:section_id/articles", handlers.Middleware(e, handlers.GetArticlesDecompressor, handlers.GetArticlesHandler))
-	mux.GET("/api/sections/:section_id", handlers.Middleware(e, handlers.GetSectionDecompressor, handlers.GetSectionHandler))
-	mux.GET("/api/articles/:article_id", handlers.Middleware(e, handlers.GetArticleDecompressor, handlers.GetArticleHandler))
-	mux.GET("/api/toparticles/:top_n", handlers.Middleware(e, handlers.GetTopNArticlesDecompressor, handlers.GetTopNArticlesHandler))
-	mux.GET("/api/ticket_forms/:form_id", handlers.Middleware(e, handlers.GetTicketFormDecompressor, handlers.GetTicketFormHandler))
-	mux.GET("/api/instant_search", handlers.Middleware(e, handlers.GetInstantSearchDecompressor, handlers.GetInstantSearchHandler))
-	mux.GET("/api/search", handlers.Middleware(e, handlers.GetSearchDecompressor, handlers.GetSearchHandler))
-	mux.GET("/api/status", handlers.StatusHandler)
-	mux.POST("/api/requests", handlers.Middleware(e, handlers.CreateRequestDecompressor, handlers.CreateRequestHandler))
-	mux.POST("/api/vote/:article_id/:value", handlers.Middleware(e, handlers.CreateVoteDecompressor, handlers.CreateVoteHandler))
-	mux.POST("/api/forcesync", handlers.Middleware(e, handlers.CreateForceSyncDecompressor, handlers.CreateForceSyncHandler))
+	// RESTful handlers, the requests are validated against the OpenAPI document.
+	spec, err := openapi.Load()
+	if err != nil {
+		return nil, errors.Wrapf(err, "router: [New] load openapi spec failed")
+	}
+	validator := openapi.NewValidator(spec, conf, logger)
+	for _, rt := range restRoutes(e, spec) {
+		h, err := validator.Handle(rt.method, rt.path, rt.handle, openapi.WriteError)
+		if err != nil {
+			return nil, errors.Wrapf(err, "router: [New] validator handle failed")
+		}
+		mux.Handle(rt.method, rt.path, instrument(rt.path, h))
+	}
+	for _, rt := range v2Routes(e) {
+		h, err := validator.Handle(rt.method, rt.path, rt.handle, handlers.WriteV2Error)
+		if err != nil {
+			return nil, errors.Wrapf(err, "router: [New] validator handle failed")
+		}
+		mux.Handle(rt.method, rt.path, instrument(rt.path, h))
+	}
 
 	// GraphQL handlers.
-	mux.POST("/graphql", handlers.GraphQLMiddleware(e, handlers.CreateGraphQLDecompressor, handlers.CreateGraphQLHandler))
+	mux.POST("/graphql", instrument("/graphql", handlers.GraphQLMiddleware(e, handlers.CreateGraphQLDecompressor, handlers.CreateGraphQLHandler)))
+	mux.GET("/graphql", instrument("/graphql", handlers.UpgradeOr(
+		handlers.GraphQLWS(e),
+		handlers.GraphQLMiddleware(e, handlers.GetGraphQLDecompressor, handlers.CreateGraphQLHandler),
+	)))
 	mux.Handler("GET", "/graphiql", handlers.GraphiQL{})
 
+	// gRPC gateway handlers, the routes are defined by the google.api.http annotations of zendesk.proto.
+	if gw != nil {
+		gwHandle := instrument("/v1/*path", func(w http.ResponseWriter, r *http.Request, _ httprouter.Params) { gw.ServeHTTP(w, r) })
+		mux.GET("/v1/*path", gwHandle)
+		mux.POST("/v1/*path", gwHandle)
+	}
+
+	// Liveness and readiness probes, they are requested without the credentials.
+	if checker != nil {
+		mux.Handler("GET", "/healthz", checker.LiveHandler())
+		mux.Handler("GET", "/readyz", checker.ReadyHandler())
+	}
+
+	// Prometheus metrics, they are scraped without the credentials.
+	if conf.Metrics.Enable {
+		mux.Handler("GET", conf.Metrics.Path, metrics.Handler())
+	}
+
 	return mux, nil
 }
+
+// instrument wraps h of the route in the metrics and the tracing span.
+func instrument(route string, h httprouter.Handle) httprouter.Handle {
+	return metrics.Handle(route, tracing.Handle(route, h))
+}
+
+// route is a RESTful route, all of the routes have to be documented by the OpenAPI document.
+type route struct {
+	method string
+	path   string
+	handle httprouter.Handle
+}
+
+func restRoutes(e *handlers.Env, spec *openapi.Spec) []route {
+	return []route{
+		{http.MethodGet, "/api/categories", handlers.Middleware(e, handlers.GetCategoriesDecompressor, handlers.GetCategoriesHandler)},
+		{http.MethodGet, "/api/categories/:category_id/sections", handlers.Middleware(e, handlers.GetSectionsDecompressor, handlers.GetSectionsHandler)},
+		{http.MethodGet, "/api/categories/:category_id/articles", handlers.Middleware(e, handlers.GetCategoriesArticlesDecompressor, handlers.GetCategoriesArticlesHandler)},
+		{http.MethodGet, "/api/category/:category_key_name", handlers.Middleware(e, handlers.GetCategoryKeyNameToIDDecompressor, handlers.GetCategoryKeyNameToIDHandler)},
+		{http.MethodGet, "/api/sections/:section_id/articles", handlers.Middleware(e, handlers.GetArticlesDecompressor, handlers.GetArticlesHandler)},
+		{http.MethodGet, "/api/sections/:section_id", handlers.Middleware(e, handlers.GetSectionDecompressor, handlers.GetSectionHandler)},
+		{http.MethodGet, "/api/articles/:article_id", handlers.Middleware(e, handlers.GetArticleDecompressor, handlers.GetArticleHandler)},
+		{http.MethodGet, "/api/toparticles/:top_n", handlers.Middleware(e, handlers.GetTopNArticlesDecompressor, handlers.GetTopNArticlesHandler)},
+		{http.MethodGet, "/api/ticket_forms/:form_id", handlers.Middleware(e, handlers.GetTicketFormDecompressor, handlers.GetTicketFormHandler)},
+		{http.MethodGet, "/api/instant_search", handlers.Middleware(e, handlers.GetInstantSearchDecompressor, handlers.GetInstantSearchHandler)},
+		{http.MethodGet, "/api/search", handlers.Middleware(e, handlers.GetSearchDecompressor, handlers.GetSearchHandler)},
+		{http.MethodGet, "/api/status", handlers.StatusHandler},
+		{http.MethodGet, "/api/openapi.json", func(w http.ResponseWriter, r *http.Request, _ httprouter.Params) { spec.ServeHTTP(w, r) }},
+		{http.MethodPost, "/api/requests", handlers.Middleware(e, handlers.CreateRequestDecompressor, handlers.CreateRequestHandler)},
+		{http.MethodPost, "/api/vote/:article_id/:value", handlers.Middleware(e, handlers.CreateVoteDecompressor, handlers.CreateVoteHandler)},
+		{http.MethodPost, "/api/forcesync", handlers.Middleware(e, handlers.CreateForceSyncDecompressor, handlers.CreateForceSyncHandler)},
+		{http.MethodGet, "/api/analytics/search_queries/:report", handlers.Middleware(e, handlers.GetSearchQueryStatsDecompressor, handlers.GetSearchQueryStatsHandler)},
+		{http.MethodGet, "/api/analytics/deflection/:group", handlers.Middleware(e, handlers.GetDeflectionStatsDecompressor, handlers.GetDeflectionStatsHandler)},
+		{http.MethodGet, "/api/analytics/votes/:article_id", handlers.Middleware(e, handlers.GetArticleVoteHistoryDecompressor, handlers.GetArticleVoteHistoryHandler)},
+	}
+}
+
+// v2Routes are the RESTful routes of the v2 API, the responses are wrapped by the envelope
+// and the listings are paged by the cursors.
+func v2Routes(e *handlers.Env) []route {
+	return []route{
+		{http.MethodGet, "/api/v2/categories", handlers.V2Middleware(e, handlers.V2ListDecompressor(handlers.GetCategoriesDecompressor), handlers.GetV2CategoriesHandler)},
+		{http.MethodGet, "/api/v2/categories/:category_id/sections", handlers.V2Middleware(e, handlers.V2ListDecompressor(handlers.GetSectionsDecompressor), handlers.GetV2SectionsHandler)},
+		{http.MethodGet, "/api/v2/categories/:category_id/articles", handlers.V2Middleware(e, handlers.V2ListDecompressor(handlers.GetCategoriesArticlesDecompressor), handlers.GetV2CategoriesArticlesHandler)},
+		{http.MethodGet, "/api/v2/category/:category_key_name", handlers.V2Middleware(e, handlers.GetCategoryKeyNameToIDDecompressor, handlers.GetV2CategoryKeyNameToIDHandler)},
+		{http.MethodGet, "/api/v2/sections/:section_id/articles", handlers.V2Middleware(e, handlers.V2ListDecompressor(handlers.GetArticlesDecompressor), handlers.GetV2ArticlesHandler)},
+		{http.MethodGet, "/api/v2/sections/:section_id", handlers.V2Middleware(e, handlers.GetSectionDecompressor, handlers.GetV2SectionHandler)},
+		{http.MethodGet, "/api/v2/articles/:article_id", handlers.V2Middleware(e, handlers.GetArticleDecompressor, handlers.GetV2ArticleHandler)},
+		{http.MethodGet, "/api/v2/toparticles/:top_n", handlers.V2Middleware(e, handlers.GetTopNArticlesDecompressor, handlers.GetV2TopNArticlesHandler)},
+		{http.MethodGet, "/api/v2/ticket_forms/:form_id", handlers.V2Middleware(e, handlers.GetTicketFormDecompressor, handlers.GetV2TicketFormHandler)},
+		{http.MethodGet, "/api/v2/instant_search", handlers.V2Middleware(e, handlers.GetInstantSearchDecompressor, handlers.GetV2InstantSearchHandler)},
+		{http.MethodGet, "/api/v2/search", handlers.V2Middleware(e, handlers.GetV2SearchDecompressor, handlers.GetV2SearchHandler)},
+		{http.MethodPost, "/api/v2/requests", handlers.V2Middleware(e, handlers.CreateRequestDecompressor, handlers.CreateV2RequestHandler)},
+		{http.MethodPost, "/api/v2/vote/:article_id/:value", handlers.V2Middleware(e, handlers.CreateVoteDecompressor, handlers.CreateV2VoteHandler)},
+		{http.MethodPost, "/api/v2/forcesync", handlers.V2Middleware(e, handlers.CreateForceSyncDecompressor, handlers.CreateV2ForceSyncHandler)},
+		{http.MethodGet, "/api/v2/analytics/search_queries/:report", handlers.V2Middleware(e, handlers.GetSearchQueryStatsDecompressor, handlers.GetV2SearchQueryStatsHandler)},
+		{http.MethodGet, "/api/v2/analytics/deflection/:group", handlers.V2Middleware(e, handlers.GetDeflectionStatsDecompressor, handlers.GetV2DeflectionStatsHandler)},
+		{http.MethodGet, "/api/v2/analytics/votes/:article_id", handlers.V2Middleware(e, handlers.GetArticleVoteHistoryDecompressor, handlers.GetV2ArticleVoteHistoryHandler)},
+	}
+}
@@ -0,0 +1,106 @@
+package router
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/handlers"
+	"github.com/honestbee/Zen/openapi"
+)
+
+// TestRestRoutesDocumented fails if any RESTful route is missing from the OpenAPI document,
+// or any operation of the document is not routed.
+func TestRestRoutesDocumented(t *testing.T) {
+	spec, err := openapi.Load()
+	if err != nil {
+		t.Fatalf("load spec failed:%v", err)
+	}
+
+	routed := make(map[string]bool)
+	for _, rt := range append(restRoutes(&handlers.Env{}, spec), v2Routes(&handlers.Env{})...) {
+		if spec.Operation(rt.method, rt.path) == nil {
+			t.Errorf("route %s %s is missing from the openapi document", rt.method, rt.path)
+		}
+		routed[rt.method+" "+openapi.Path(rt.path)] = true
+	}
+
+	for path, ops := range spec.Paths {
+		for method := range ops {
+			if key := strings.ToUpper(method) + " " + path; !routed[key] {
+				t.Errorf("operation %s of the openapi document is not routed", key)
+			}
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	logger := zerolog.New(ioutil.Discard)
+	conf := &config.Config{HTTP: &config.HTTP{}, Auth: &config.Auth{}, Metrics: &config.Metrics{Enable: true, Path: "/metrics"}}
+	mux, err := New(conf, &logger, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("new router failed:%v", err)
+	}
+
+	testCases := [...]struct {
+		description  string
+		method       string
+		target       string
+		expectStatus int
+		expectBody   string
+	}{
+		{
+			description:  "testing openapi document case",
+			method:       http.MethodGet,
+			target:       "/api/openapi.json",
+			expectStatus: http.StatusOK,
+			expectBody:   `"openapi": "3.0.3"`,
+		},
+		{
+			description:  "testing invalid query case",
+			method:       http.MethodGet,
+			target:       "/api/categories?per_page=many",
+			expectStatus: http.StatusBadRequest,
+			expectBody:   `{"error":"You passed an invalid value for the attributes."}`,
+		},
+		{
+			description:  "testing invalid path case",
+			method:       http.MethodPost,
+			target:       "/api/vote/1/sideways",
+			expectStatus: http.StatusBadRequest,
+			expectBody:   `{"error":"You passed an invalid value for the attributes."}`,
+		},
+		{
+			description:  "testing v2 invalid query case",
+			method:       http.MethodGet,
+			target:       "/api/v2/categories?first=0",
+			expectStatus: http.StatusBadRequest,
+			expectBody:   `"code":"invalid_attribute","title":"You passed an invalid value for the attributes.","detail":"is less than 1","source":{"parameter":"first"}`,
+		},
+		{
+			description:  "testing metrics case",
+			method:       http.MethodGet,
+			target:       "/metrics",
+			expectStatus: http.StatusOK,
+			expectBody:   `zen_http_requests_total{code="400",method="GET",route="/api/categories"} 1`,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, httptest.NewRequest(tt.method, tt.target, nil))
+			if w.Code != tt.expectStatus {
+				t.Errorf("[%s] expect status:%d, actual:%d", tt.description, tt.expectStatus, w.Code)
+			}
+			if !strings.Contains(w.Body.String(), tt.expectBody) {
+				t.Errorf("[%s] expect body contains:%s, actual:%s", tt.description, tt.expectBody, w.Body.String())
+			}
+		})
+	}
+}
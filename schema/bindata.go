@@ -3,12 +3,15 @@
 // enum.graphql
 // input/request.graphql
 // interface/article.graphql
+// interface/node.graphql
 // interface/pageInfo.graphql
 // mutation.graphql
 // query.graphql
 // schema.graphql
+// subscription.graphql
 // type/article.graphql
 // type/category.graphql
+// type/connectionPageInfo.graphql
 // type/customType.graphql
 // type/searchBodyArticle.graphql
 // type/searchTitleArticle.graphql
@@ -83,7 +86,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _enumGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x90\xcf\x4a\xc4\x30\x10\xc6\xef\x79\x8a\x01\xef\xfa\x0c\x35\x29\xa6\xfe\x69\x8b\xc9\xba\xb8\x97\x22\x66\x60\x0f\x6e\x66\x49\x53\x65\x59\xfa\xee\xe2\x4c\x0a\xa5\x9e\x7e\xf3\x65\xc2\xef\x0b\xb9\x01\x7f\x44\xc0\x38\x9d\x40\xd3\x14\x73\xba\x68\x0a\x78\xab\xb6\x27\x70\x55\x00\x00\xee\x81\x61\x9f\x18\x7e\xcf\x78\xec\x25\x59\xc6\xcb\x3b\xa3\x31\x8c\xde\xaa\x59\xa9\x55\xcb\x33\x7d\x7e\x7c\x2d\x05\x12\x8a\xbb\x6e\x87\x9d\xe3\xe9\x60\x87\xa2\x3e\xd8\x41\xb7\x52\x52\xad\x4b\x1a\xb3\xd1\x3a\x4a\xf9\xfe\x02\x23\xa5\x0c\x27\xcc\x47\x0a\xa5\xa2\x2c\xa4\xa2\xef\x5c\xe3\x9b\x4e\x8c\xfa\xb5\xae\x7c\x6d\x86\xca\x73\xdc\xf5\x66\x89\xff\xd5\x5d\x0a\x98\xc4\x4e\x7f\xe3\x4a\x2e\x2b\xf1\x57\x4e\x33\x4d\xed\xf4\xc6\xf2\x46\x19\x21\xe1\x39\xe1\x88\x31\x8f\x30\x9d\xef\x02\xfd\x44\xf8\xa6\xbc\xfc\x06\x5f\xb9\x96\xc7\x88\xa7\xdb\xb7\x6a\x56\xbf\x01\x00\x00\xff\xff\x51\x9e\xb8\x5d\xa5\x01\x00\x00")
+var _enumGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x90\xc1\x4a\xc4\x30\x10\x86\xef\xf3\x14\x03\xde\xd7\x67\xa8\x49\x31\x5d\xb5\x2d\x26\xeb\xe2\x5e\x8a\x98\x81\x3d\xb8\x99\x25\x4d\x95\x65\xd9\x77\x17\x27\x2d\x84\x7a\xfa\xe6\xcf\x84\xef\x0f\xb9\x43\x77\x24\xa4\x30\x9d\x50\xf1\x14\x52\xbc\x28\xf6\xb4\x81\xf5\x09\x5e\x01\x11\xd1\x3e\x0a\xcc\x93\xc0\xed\x05\xdb\x5e\xe0\x8c\xe0\xe5\x5d\xd0\x68\x41\x6f\xe0\x06\x50\xb4\x3c\xf3\xe7\xc7\xd7\x52\x90\xc3\xec\xae\xdb\x61\x67\x65\x3a\x98\x61\x56\x1f\xcc\xa0\x5a\x99\xb6\x55\x59\xd2\xe8\x95\xd6\x72\x4c\x0f\x17\x1c\x39\x26\x3c\x51\x3a\xb2\xdf\x40\xb9\xc8\x15\x7d\x67\x1b\xd7\x74\xd9\xa8\x5e\xeb\xca\xd5\x7a\xa8\x9c\xc4\x5d\xaf\x97\xf8\x5f\xdd\x45\x4f\x31\xdb\xf9\x6f\x2c\xe4\x79\x95\xfd\x95\x55\x42\x5d\x5b\xb5\xb2\xbc\x71\x22\x8c\x74\x8e\x34\x52\x48\x23\x4e\xe7\x7b\xcf\x3f\x01\xbf\x39\x2d\xbf\x21\x57\xae\xf3\x63\x04\xba\xdb\xb7\x70\x83\xdf\x01\x00\x51\x9e\xb8\x5d\xa5\x01\x00\x00")
 
 func enumGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -103,7 +106,7 @@ func enumGraphql() (*asset, error) {
 	return a, nil
 }
 
-var _inputRequestGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8e\x31\x0e\xc2\x30\x0c\x45\xf7\x9c\xc2\xbd\x46\xd7\xa2\x4a\xac\x65\x44\x0c\x69\x63\x21\x43\x9d\x40\xe2\x20\x21\xc4\xdd\x19\x92\xb6\x09\x30\xda\xff\x7d\xfb\x91\xbd\x45\x81\x01\xef\x11\x83\xec\xb4\x68\x78\x29\x00\x00\x9f\x36\xed\x12\x35\xea\xad\x54\x05\x67\x70\x72\xcc\x68\x37\xb0\x4b\x73\x53\x5e\x41\xbf\xc6\xc3\xb2\x49\x40\x88\xe3\x05\x27\x69\xe1\x20\x9e\xec\x39\x2d\x85\xa6\x2b\x4a\xef\x3c\xef\xcd\x92\xa4\x5f\x31\x88\xe3\x9e\x70\x36\xa1\x85\x63\xb7\x8d\xcd\xe9\xc7\x2f\x8b\x64\xcd\xd1\x99\xe7\xf6\xe5\x9b\x5d\xad\x32\x6d\x35\x63\xed\x84\xac\x69\xfe\x73\xa0\x70\xc8\x5d\x32\x75\xf3\xa1\xe7\x88\x65\xf3\x13\x00\x00\xff\xff\xe7\x51\xf9\x82\x73\x01\x00\x00")
+var _inputRequestGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8e\xbd\xae\xc2\x30\x0c\x46\xf7\x3c\x85\xfb\x1a\x5d\x7b\x55\xe9\xae\x30\x22\x86\x34\xb5\x50\xa0\x49\xc0\x71\x90\x10\xea\xbb\x23\x88\xdb\x84\x9f\xc9\xb2\xbf\x63\xfb\x58\x7f\x4e\x0c\x1b\xbc\x24\x8c\xfc\xa7\x59\xc3\x5d\x01\x00\x50\x9e\xb4\x4b\xd4\xa8\x59\xa9\x37\x58\x40\x13\x9c\x43\x5f\xc0\x2e\xf7\x4d\x7d\x05\x69\x8d\xa5\x20\x65\x20\xa6\xe1\x88\x86\x5b\xd8\x32\x59\x7f\xc8\x43\xb6\xe6\x84\xdc\x07\x72\xff\xe3\x92\xbc\x02\x93\x22\x07\xd7\x5b\x9c\xc6\xd8\xc2\xae\x2b\x6d\xb3\xff\xf2\x13\x11\xd1\x1c\xc2\x78\x2b\x5f\x3e\x59\x29\x48\x42\x7b\xed\xb0\xd0\xcf\xd7\xe8\xb4\x9d\x7e\x1c\xa8\x1c\x64\xd7\xae\xce\x79\xf3\xaa\xa7\x54\x1d\x9b\xd5\x63\x00\xe7\x51\xf9\x82\x73\x01\x00\x00")
 
 func inputRequestGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -123,7 +126,7 @@ func inputRequestGraphql() (*asset, error) {
 	return a, nil
 }
 
-var _interfaceArticleGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xcf\xb1\x4e\x03\x31\x0c\x06\xe0\xfd\x9e\xc2\x88\x9d\x07\xc8\x76\xb4\xcb\x49\x88\xa5\x30\x21\x06\x5f\xe2\x72\x96\x92\xb8\x72\x1c\xa4\x0a\xf1\xee\xa8\x0d\x15\x6d\x8e\x2d\xfa\x7e\x5b\xf1\x7f\x0f\x63\x06\xce\x46\xba\x47\x4f\x60\x0b\x1a\x04\x2a\x5e\x79\xa6\x02\xa3\x1a\xfb\x48\xd3\x25\x7f\x18\xfe\x46\xfb\x0c\xbe\x06\x00\x00\x0e\x0e\xa6\xed\xdd\xf9\x8d\xd5\x16\xd1\x29\x38\xd8\x99\x72\xfe\x68\xea\x25\x25\xca\x56\xb6\x5c\x70\x8e\xe4\xe0\x51\x24\x12\xe6\x96\x06\xc5\xbd\x75\x76\x50\x49\x62\x14\x7a\x96\xc2\xc6\x92\x1d\x4c\xd9\x1a\x7d\x8a\xd1\xae\xa6\x4e\x36\x52\xb3\x5d\x99\x57\x42\xa3\x30\x9a\x83\x17\x4e\xd4\xb0\x1e\xc2\x1a\x8b\x54\xf5\xf4\x24\x1e\x4f\x87\x5e\xb7\x90\x6a\xe7\xf9\xee\xa6\x0b\xb7\x95\xe2\xe0\xed\x77\xe9\xbd\xe5\x14\x78\xf5\x49\xc4\x99\xe2\x33\xa6\x7f\xc6\xfd\xe9\x72\x3d\x6e\x24\x74\xdf\x57\x8d\xb7\xb0\x58\x8a\xaf\x3d\x66\x4c\xdd\x9e\xb1\xf5\x4d\x66\x09\xc7\x5b\x89\x5d\xdf\xef\xe1\x27\x00\x00\xff\xff\x3e\x63\xc9\x9b\x27\x02\x00\x00")
+var _interfaceArticleGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xcf\xc1\x4e\x02\x31\x10\x06\xe0\x3b\x4f\x31\x86\xab\xe1\x01\xf6\x86\x70\xd9\xc4\x78\x01\x4f\xc6\xc3\x6c\x67\xa0\x13\xdb\x0e\xb6\x53\x09\x1a\xdf\xdd\xc8\x8a\x48\xf1\xb6\xf9\xfe\xfe\x3b\x33\x53\x98\x27\x90\x64\x9c\x37\xe8\x18\xcc\xa3\x01\x71\x71\x59\x06\x2e\x30\xcf\x26\x2e\x70\x7f\xca\x67\x93\xf3\xd3\x36\x83\x8f\x09\x00\xc0\x14\xd6\x9e\x41\x77\xf8\x5a\x19\xb6\x41\x07\x0c\x20\x74\x0b\x62\xb0\xc7\x02\xe6\x19\xde\x39\x11\x97\x17\x10\x82\xbd\x17\xe7\x41\xca\xc9\x7a\x82\xa4\xfb\xd9\xf1\x4f\x42\x1d\xf4\xcb\x9b\xe3\xf7\x6f\x7c\x26\xac\xe6\x35\xf7\xd4\xc1\xca\xb2\xa4\xed\xa8\x4e\x63\xe4\x64\x65\x29\x05\x87\xc0\x1d\xdc\xa9\x06\xc6\x34\xa6\x94\x71\x63\x8d\xed\xb2\x46\x35\xa6\x96\xb5\x88\x89\xa6\x0e\xfa\x64\x63\xfb\x4d\x8d\x57\x35\x36\xb2\xd0\x9a\xec\x8f\xb9\xcc\x68\x4c\x73\xeb\x60\x2d\x91\xc7\x6a\xdd\xd1\x35\x16\xad\xd9\xf1\xbd\x3a\x0c\x7c\x79\x85\x56\x23\xbc\xde\xe9\xc4\x63\xa5\x74\xf0\xf4\x53\x7a\x1e\x73\x26\xb9\x1a\x12\x70\xe0\xf0\x80\xf1\x9f\xe7\xee\x7b\xf3\x7c\x58\x28\x35\xe3\x6b\x0e\x97\xe0\x2d\x86\xc7\x16\x13\xc6\xa6\x67\x62\xed\x25\x83\xd2\xe1\x52\x42\x73\xef\xe7\xe4\x6b\x00\x94\x64\xa7\x2f\x84\x02\x00\x00")
 
 func interfaceArticleGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -143,7 +146,27 @@ func interfaceArticleGraphql() (*asset, error) {
 	return a, nil
 }
 
-var _interfacePageinfoGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x52\x56\x70\xcc\x53\xc8\xcc\x2b\x49\x2d\x4a\x4b\x4c\x4e\x55\x28\xc9\x48\x2c\x51\x48\x49\x2d\x4e\x2e\xca\x4c\x4a\x2d\x56\x08\x48\x4c\x4f\xf5\xcc\x4b\xcb\xd7\xe3\x42\x28\x81\x89\x29\x54\x73\x29\x28\x28\x28\x14\x24\xa6\xa7\x5a\x29\x78\xe6\x95\x28\x42\xb8\xa9\x45\x01\x68\x22\x89\xe9\xa9\xce\xf9\xa5\x79\x25\x48\x62\xc9\x48\xfc\x5a\x2e\x40\x00\x00\x00\xff\xff\x28\xe7\x48\x09\x84\x00\x00\x00")
+var _interfaceNodeGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xcc\x3d\xaa\xc2\x40\x14\x47\xf1\x7e\x56\x71\x1e\x69\x1f\x2e\xc0\x4e\xb0\xb1\xb1\x72\x03\xf3\xf1\x8f\x73\x25\xcc\x68\x72\x45\x44\xdc\xbb\xa4\xb2\xb1\x3e\x87\xdf\xc0\xae\x61\xcd\x35\x8f\x31\x0b\xaf\xd1\x29\x5a\xf2\x6c\x49\x0b\xc7\x5e\xf4\x4f\x6c\xf4\x74\x51\x76\x1e\xd5\x72\x25\xc7\x46\x12\xb3\x46\x79\xae\x2a\xa4\x27\xe6\x0b\xfd\x1a\x6f\x77\x71\x9e\x7a\x8a\x13\x56\x36\xe1\x0b\xaf\x12\xaf\x00\x30\x70\xaa\xfa\x31\xaf\xcd\xca\x96\xc3\xfe\x2f\xbc\xc3\x67\x00\xf6\xb0\xfb\x49\x99\x00\x00\x00")
+
+func interfaceNodeGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		_interfaceNodeGraphql,
+		"interface/node.graphql",
+	)
+}
+
+func interfaceNodeGraphql() (*asset, error) {
+	bytes, err := interfaceNodeGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "interface/node.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _interfacePageinfoGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\xcc\x31\x4e\x03\x31\x10\x85\xe1\xde\xa7\x78\x84\x06\x24\x94\x03\xa4\x02\x51\xa5\x4b\xc3\x01\x26\xe3\x67\xc7\x12\x1a\xaf\x66\x66\x0b\x84\xb8\x3b\x62\x29\xb6\x87\xfe\xff\xfe\x7b\xbc\x18\x86\x25\xbd\x89\x12\x79\x93\x44\x65\xa8\x8f\x2b\x03\x79\x23\x66\x6b\xc1\xc4\x22\x7d\x58\x7f\xc2\x48\x8c\x80\x73\x79\x17\x65\xc5\xf5\x63\x8b\x74\xf5\x98\x0e\x9d\x66\xd4\x1c\xd3\xe2\x58\xf6\xeb\x45\x3a\xcf\xd6\x26\x3e\x0b\x80\x9f\x15\x4f\x38\x5b\xde\xe1\xb9\x72\x71\xaa\x24\xeb\x83\x53\x62\xda\x09\x87\xb7\xe0\xd6\x6c\x64\x58\x24\xa5\x1e\x0f\x8f\xbf\x96\x7e\xf9\x0f\x97\xce\xd7\xb9\x5a\xfe\x75\xa0\x3b\x2e\x5f\xe5\x7b\x00\xa9\xa5\x12\xec\x3e\x01\x00\x00")
 
 func interfacePageinfoGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -163,7 +186,7 @@ func interfacePageinfoGraphql() (*asset, error) {
 	return a, nil
 }
 
-var _mutationGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x8f\x41\x4b\xfb\x40\x10\xc5\xef\xf9\x14\x2f\xf4\xd2\x42\xf8\xff\xef\x0b\x3d\x94\x56\x24\xa0\x22\x46\xbd\xca\xb8\x3b\xb5\x0b\x71\x27\xee\x4e\x2c\x41\xfc\xee\x92\x4d\x8c\xde\x3c\x2d\xf3\x7b\x6f\xdf\xbc\x59\xe1\xfe\xc4\xb8\xee\x95\xd4\x4b\x80\x0e\x1d\x23\x72\x17\x39\x71\xd0\x04\x6a\x5b\xc8\x11\x7a\x62\x70\xd0\x38\xa0\x13\x3f\x72\x1f\x54\x32\xdd\xdd\xd6\xff\x8a\xfc\x6b\xc9\xf8\x28\x00\x60\x85\x86\x83\x83\x8d\x4c\x3a\x46\xbe\xf5\x9c\x34\x2b\x13\xba\x9b\xc8\xda\x4a\x3f\x06\xef\xc5\xb1\xc1\xfe\x67\xc0\x16\xcd\x65\x05\x47\x4a\x06\xb3\xf9\x40\x4a\xe5\xc6\xa0\xd1\xe8\xc3\x4b\xb1\xec\x51\x50\x54\x6f\x5b\xc6\xbb\x28\xa3\xef\xfe\x3b\x39\x07\x3c\x0f\xf0\x63\x57\x97\x8d\xa3\xb4\x9b\x6c\xeb\xd9\x5e\x3b\x83\xfa\x50\x56\x59\x33\x78\x14\xe5\xb2\xc2\x1f\x8d\x5a\xb1\xd4\xb2\xc1\x55\x7e\xb1\xc5\xc5\xcd\xd3\x43\xb3\x31\x98\xc3\x7f\xd7\x3a\x4a\xb4\x8c\x34\x04\x9b\x61\x1e\x9b\x21\xd8\x75\x9f\x38\x06\x7a\xe5\xef\x5b\xca\x0a\x1d\xa5\x74\x96\xe8\x16\xb4\xc1\x72\xe9\x67\xf1\x15\x00\x00\xff\xff\x0a\xfe\xd1\x1f\xa8\x01\x00\x00")
+var _mutationGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x52\xc1\x6e\xd4\x30\x14\xbc\xe7\x2b\xa6\xed\xa5\x2b\x45\xcb\x3d\xd2\x1e\xaa\x16\xa1\x95\x00\x21\x52\xb8\xa2\xb7\xf6\xb4\xb1\x9a\xda\xc6\x7e\x21\x8a\x10\xff\x8e\xec\x4d\x16\xb8\x70\x4a\xde\xf3\xbc\x79\xe3\x19\xdf\xe0\x71\x20\x3e\x4c\x2a\xea\x82\x87\x2e\x91\x48\x8c\x89\x99\x5e\x33\x64\x1c\x11\x9e\xa0\x03\x41\xaf\x69\x41\x0c\xae\xf4\x9d\xd7\x50\xbb\x77\x9f\x8e\xfb\xa6\x4e\x5d\x38\x7e\x36\x00\x70\x83\x9e\xde\xc2\x24\x8a\x16\xca\xef\x13\xb3\xae\x27\x33\x4f\xd9\x29\xe1\x32\x04\x43\xf0\x5c\x62\x50\xcc\x83\x33\x03\xf2\x10\xa6\xd1\xe2\x44\xc8\x38\xcb\x92\xc1\xd7\xa8\xcb\xbe\x4e\x9e\xc9\x3e\x9f\xb9\x6e\x4d\x98\x8a\xa4\xfb\x60\xd9\xe1\xfe\x4f\x81\x03\xfa\x77\x2d\xac\xa8\x74\x58\xc1\x0f\xa2\x72\xd5\xc2\x48\x54\x33\xc8\x63\x78\xa1\xef\xd0\x6b\x72\xfe\x19\x07\x5c\x5f\xb7\x9b\xa6\x7f\xba\xbb\xad\x6a\x2e\x57\x52\x48\x52\x67\x46\xe2\x47\x50\x62\x8a\x6f\x6c\x98\x3d\x4e\x0b\x5c\xb1\xc5\x56\x60\x39\xba\x3b\xc3\x6e\x57\xf8\xd1\x76\x38\x3e\x5c\xb5\x75\xac\xc3\xd7\xa0\x2c\x7a\xfe\x7f\x85\x31\x18\x19\xd9\xe1\x7d\xfd\xe2\x80\xb7\x1f\xbf\x7d\xe9\x77\x1d\x56\xf2\xbf\x65\x3d\x85\x64\x88\xbc\x78\xd3\xd6\x60\xca\x5f\x37\xa7\x62\x73\x36\x21\x72\x8b\x51\xa2\xc3\x0b\x17\x84\x54\x61\x27\x4a\x62\x82\x16\x47\x4a\x1e\x25\x28\x97\x68\xf7\x2b\xf5\x94\x99\xbc\xbc\x12\xe2\x2d\xa2\xe4\x3c\x87\x64\x21\x89\xb0\xe5\x95\x18\x51\xda\x16\xb9\x44\x5d\xe8\x4c\xa2\xa5\x57\x27\x63\x79\x23\x75\xc3\x40\xb1\x4c\xa5\xcc\x4a\x59\x89\xab\xda\x7e\xf1\xe6\x76\x5b\xb0\x59\xdd\x5e\xb6\x6c\x9d\x1d\x3a\xf4\x9a\x9c\x7f\x6e\x7e\x35\xbf\x07\x00\xfa\x29\x8c\x7d\xb0\x02\x00\x00")
 
 func mutationGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -183,7 +206,7 @@ func mutationGraphql() (*asset, error) {
 	return a, nil
 }
 
-var _queryGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x94\x41\x8f\xd3\x30\x10\x85\xef\xf9\x15\xaf\xda\x03\x59\x29\x5a\x81\xb8\x45\xca\xa1\x5b\x60\x15\x81\xda\xa2\x94\x13\x42\xc8\x9b\xcc\xb6\xd6\xa6\x99\xe0\x4c\x0f\x16\xe2\xbf\x23\x3b\x4e\x93\x76\x57\x08\x51\x0e\x7b\x72\x3c\x33\x1e\xbf\xf7\x79\xda\x2b\x6c\x76\x84\xcf\x07\x32\x16\x62\x5b\x82\xa1\xd6\x50\x47\x8d\x74\x50\x75\x0d\x7e\x80\xec\x08\xd4\x88\xb1\x68\x59\xbb\xb8\x6e\x84\x7d\x74\xbe\xce\x6f\x22\x7f\xaa\x6f\xf0\x33\x02\x80\x2b\xdc\x91\xf8\xc3\xa5\x12\xda\xb2\xd1\xd4\xdd\xf8\x8c\xaa\xeb\xc5\x31\x14\x97\x7c\x70\x5d\x17\x5c\x51\x8a\xc5\xb8\x41\x86\xe2\x2e\x41\xcd\xa5\xaa\x29\xc5\x27\xbf\x22\xc3\xfb\xe5\xf7\x2f\x45\x82\x96\xcc\x5a\x6d\x29\x45\xde\x08\x32\xbc\x7d\x9d\xa0\x9d\xec\xdf\x24\xe8\xd8\xc8\xad\x4d\x51\xf8\x15\x19\xd6\xab\x22\xdf\xe4\xab\x65\x9f\x5a\x99\x8a\x4c\x9f\xf5\x9f\xc8\x30\x2f\x16\xd7\x29\x46\x6d\xb3\x89\x91\x60\xc2\xe2\xde\x42\x3b\xf7\x15\xd8\xe0\x91\x6c\xa3\xf6\xd4\xfb\xe2\x86\xc2\x59\x1b\x0f\xe5\x79\xb5\x32\x1f\xfb\xa2\x14\xf9\xbb\x59\x82\x7f\xf2\x3b\xca\xb2\xfe\xaa\x33\xc2\x1d\x95\xa2\xb9\xe9\x06\xbc\x45\xd8\xbf\x3c\xb8\x83\xb2\x29\xda\xa0\x7e\x24\x7b\xc4\x19\xaa\xe3\x50\x91\x57\x17\x32\x0c\xfd\xa2\x33\x7c\xca\x88\x2e\xeb\xc9\x78\xce\x43\xe0\xe5\xf1\x1b\x94\x4d\xf9\x09\xb7\xcb\x33\x0f\xc2\xed\xd1\x83\x4b\xfb\x9b\x2f\x00\xf7\x35\x74\x9b\x7d\x9b\xa2\xeb\x63\xcf\xbc\x5b\xa8\x8e\x43\xc5\xc5\xef\x16\xfa\x4d\xdf\x4d\x74\xf9\x48\x82\x07\x36\xfb\xee\x19\x05\x1b\x9f\xfe\xc0\x66\x1f\xbb\x92\xa0\xe0\x3a\xc5\x98\x98\x45\x27\x33\xa8\x4c\xb9\x1b\x2c\xbd\xea\x20\x5a\x6a\xf2\x05\x7d\x6a\xe3\xf6\x47\xa6\x3f\xdc\x1f\x5d\x8a\x42\x8c\x6e\xb6\x97\x70\x2d\x9e\x34\x3f\x41\xfc\x44\xd6\x3d\x57\x76\xa2\xea\x96\x2b\xfb\x7f\x45\xfd\xed\xf0\xfe\xe1\x17\x7e\x2e\xec\x84\xb3\x28\x39\x84\x21\xed\xbf\x9d\x60\xb7\xce\xa2\x5f\xd1\xef\x00\x00\x00\xff\xff\xb7\x2c\x05\x1a\x87\x06\x00\x00")
+var _queryGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x55\x4d\x6f\xe3\x36\x10\xbd\xfb\x57\x3c\x63\x0f\xeb\x00\x42\x90\xa2\x37\x01\x3e\x64\x93\x76\x21\xb4\x48\xd2\x3a\x3d\x05\x41\x41\x93\x63\x9b\x0d\x4d\x2a\xe4\xa8\x86\x5a\xf4\xbf\x17\xfc\x90\xa5\x24\xed\xa2\xf0\xf6\x92\x8b\x24\x92\xa3\x37\xef\xbd\x19\x92\x1f\x70\xbf\x23\xfc\xd4\x91\xef\xc1\x7d\x4b\xf0\xd4\x7a\x0a\x64\x39\x40\x18\x03\xb7\x01\xef\x08\x64\xd9\xf7\x68\x9d\x8e\xf3\xda\xb2\x4b\xb3\x97\x77\xcd\xf9\x2c\x23\x18\x1d\x58\xdb\x2d\x9e\x3b\xf2\x9a\x02\x84\x27\xfc\x4c\x46\xf4\x90\x9d\x0f\xce\x43\x3a\x6b\x49\xb2\x76\x36\x54\xd8\x68\x1f\x18\xc2\x2a\x88\x0d\x93\x8f\x49\x8d\x90\x94\x40\x55\x24\x20\x05\x93\x42\x4b\xfe\x4e\x6c\x29\x05\xb6\x62\x4b\x43\x32\xb7\xfe\x8d\x24\x43\xab\x9c\xc7\xb5\xe2\xb9\x23\x6c\x8d\x5b\x0b\x13\x67\xab\x04\xe4\xc5\x01\x7f\x90\x55\x14\x9e\x8e\xa1\x4f\xd4\x32\xb4\x1d\xe6\x1b\x95\xb0\x63\xb4\x56\x10\x7e\xdb\xed\xb3\x72\x29\x63\xe0\xda\xf1\xee\x7c\x96\x6c\xc9\x0e\xfd\x39\x03\x80\x0f\xf8\x4c\x91\xfd\xc0\x63\xdd\x43\x73\x18\xf3\x9f\xa7\x28\xeb\x14\x2d\xb4\xaa\xd1\x5c\xcf\x2b\x18\x27\x85\xa1\x1a\x3f\xa6\x37\x96\xf8\xee\xe6\xd7\x5f\x56\x67\x35\x6e\x9c\xa2\x09\x6a\x86\x0c\x58\xf7\x51\x83\xf6\x6f\x54\x0d\x01\x87\x9d\x96\xbb\xa4\xc9\x3a\xc6\xc6\x75\xd1\xcc\x38\xea\x8c\x19\x09\x84\x85\x56\xa1\xc6\x43\x73\x3d\x7f\xfc\x12\x8b\x87\x48\xe3\x71\x3e\x9b\xea\x33\x06\xb1\x0c\x5b\x17\x0b\x9a\x21\x85\x31\x57\xc7\xa9\x85\x74\x5d\x6c\x8b\x2b\xa7\xa8\xc6\xd5\x38\xc0\x12\xab\xcf\xff\x96\xac\x1a\xaa\x5a\xa3\xb1\x8c\x25\xbe\xbd\xa8\xd0\x4e\xc6\xdf\x54\x08\xce\xf3\xa7\xbe\xc6\x2a\xbd\xb1\xc4\xdd\xed\xaa\xb9\x6f\x6e\x6f\xf2\xd2\xad\x57\xe4\xf3\x6a\xfa\xc4\x12\x97\xab\xab\xd2\x55\x09\xb6\xca\x7d\x55\x63\xc5\x5e\xdb\xed\x59\x8d\x91\xf6\x7c\xa2\xb1\xe8\xeb\x87\x12\x6a\x05\xe7\xf1\x44\xbd\x15\x7b\xca\x92\x9d\xa5\xf2\x6f\xbf\x18\xc2\x1b\x75\xeb\x7f\xc8\x41\xa5\xbc\x27\x59\x31\xd2\xea\x53\xaa\x57\xe6\x87\xb2\x5d\x06\xe7\x57\x65\xfc\xae\x7c\x1f\x48\x4f\x5d\x2f\xc2\x46\xd3\x8f\x4e\x97\xe8\x45\x89\x68\xd4\x57\xda\x5b\xf0\x5e\xb7\xb5\xf0\xac\xa5\x99\x34\xf5\x65\x99\x78\x57\xd6\x0e\xa4\xa7\xd6\xb2\x6b\x6f\x8e\xf2\x2a\x78\x61\x9f\x48\x95\xc3\x04\xbf\x6b\x3a\x84\xe1\x44\x37\x22\x30\x0e\xda\x2a\x77\xb8\x16\x7d\x80\x8a\x0f\xe7\x63\xa3\x81\xf5\x9e\xa0\x37\xd0\xfc\x31\xe0\xa2\x2a\xf8\xf1\xaf\x01\x1a\x52\x58\xac\x09\x1b\x6d\x98\x7c\x4e\x71\x2c\x5a\xdc\x43\xe3\x56\xc9\x26\xb3\x6b\x8f\x26\x47\x92\x49\xd3\xa9\x95\xad\x26\xc4\x07\x8f\x2f\xaa\x91\x40\xec\x9a\x6a\x42\x21\x8e\xcf\x6a\x3c\x14\x06\xf3\xc7\x89\x63\x45\xd1\xd8\x8c\x11\x47\x78\xb9\xcb\x27\xbe\x0e\xe9\xd4\x8d\xd7\x5a\x3f\x58\x97\xd7\xe1\x29\x74\x86\xc3\xd4\x17\xe8\x00\x69\xb4\x8c\xa6\x6f\xbc\xdb\x1f\x1b\xbb\x64\x5e\x94\xb8\xaf\x6b\xec\x17\x0c\xdf\x36\xc4\xb4\xdb\x39\x72\x89\xd7\x83\xdf\x87\x7f\xd8\x6f\xf7\x69\xf9\x7b\xe7\xf7\x8b\x18\x52\x68\x9d\xd5\x18\x17\x5e\xdc\x09\x45\x79\x51\xf1\x31\x80\x35\x9b\x7c\x7d\xe5\xa5\xfb\x38\x2e\x3c\xc2\xe2\x79\x4a\xf0\x54\xb5\xb1\x70\xab\x37\xe0\xf3\xc7\x2f\xd1\x5a\x3b\xd5\x4f\x58\x7d\x72\xaa\xff\x7f\x49\xfd\xd7\x2d\x7f\xda\x91\xf9\x9a\xf3\x8b\x12\xb0\xe0\xae\x9c\x5c\xf9\x3b\x6a\x11\xdc\x85\xf9\xec\xaf\xd9\xdf\x03\x00\x45\xc2\x9f\x2a\xcf\x09\x00\x00")
 
 func queryGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -203,7 +226,7 @@ func queryGraphql() (*asset, error) {
 	return a, nil
 }
 
-var _schemaGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2a\x4e\xce\x48\xcd\x4d\x54\xa8\xe6\x52\x50\x50\x50\x28\x2c\x4d\x2d\xaa\xb4\x52\x08\x04\x51\x60\x81\xdc\xd2\x92\xc4\x92\xcc\xfc\x3c\x2b\x05\x5f\x28\x8b\xab\x96\x0b\x10\x00\x00\xff\xff\xf7\xd1\xd7\x38\x33\x00\x00\x00")
+var _schemaGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x52\x00\xad\xff\x73\x63\x68\x65\x6d\x61\x20\x7b\x0a\x20\x20\x20\x20\x71\x75\x65\x72\x79\x3a\x20\x51\x75\x65\x72\x79\x0a\x20\x20\x20\x20\x6d\x75\x74\x61\x74\x69\x6f\x6e\x3a\x20\x4d\x75\x74\x61\x74\x69\x6f\x6e\x0a\x20\x20\x20\x20\x73\x75\x62\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x53\x75\x62\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x0a\x7d\x0a\x03\x00\x2c\x13\x71\xb2\x52\x00\x00\x00")
 
 func schemaGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -223,7 +246,27 @@ func schemaGraphql() (*asset, error) {
 	return a, nil
 }
 
-var _typeArticleGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x91\xc1\x6a\xc3\x30\x0c\x86\xef\x7e\x0a\x95\xdd\xf7\x00\xb9\x75\xed\x25\x30\x46\xa1\xdb\x69\xf4\xa0\xd8\x6a\x6a\xb0\xad\x60\xcb\x83\x32\xfa\xee\xa3\xb1\x0b\xa9\x5b\xd8\x2d\xfa\x24\xe5\xff\xf5\xfb\x05\xd6\x20\xe7\x89\x40\x4e\x28\x60\x28\xe9\x68\x07\x4a\xb0\x8e\x62\xb5\xa3\xf4\xaa\xe6\xee\xad\x04\xeb\x27\x47\x9e\x82\x24\xd8\xe1\x48\x7d\x38\x32\xfc\x2a\x00\x80\x09\x47\xea\xa0\x0f\xb2\x2a\x25\xc5\x5d\x43\x70\xa4\x0d\xe7\x20\x0b\xa6\x9b\x1a\xab\x4e\x07\xdf\x55\x72\x75\x50\x17\xa5\xfe\xb1\x79\xef\x72\x69\xb2\xa2\x3e\x08\xc5\x23\x6a\xaa\x66\xad\xe9\xa0\xdf\x56\xcd\x2c\x27\x8e\xbd\xe9\x60\x2f\xd1\x86\xf1\xe6\xcc\xcf\x7f\xd8\xda\x84\x83\xa3\x0e\xde\x98\x1d\x61\x28\x5d\x13\xf1\x28\x0d\x9b\x22\x7b\x16\x32\x2d\xe6\x64\xc5\x72\x58\x5c\xf9\xc3\x42\xfb\xec\x1b\xf2\x90\x4d\x24\x14\x32\x6b\xe9\xe0\xd3\x7a\x2a\x30\x4f\xe6\x11\x26\xce\x51\xd3\x3b\x6b\xbc\x1a\x5d\x5e\xc1\x59\xe6\xf9\xc6\xd3\x0d\x97\x95\x6b\xda\x75\xe9\x50\xfa\x64\xec\x83\x88\xc3\x81\xdc\x07\xfa\x27\xe3\xf3\x2b\xc6\xf3\x86\x4d\x23\x9f\xa3\xbb\x07\x27\xf1\xee\xab\x85\x01\x7d\xb3\x27\x56\xda\x4b\x06\x36\xe7\x7b\xe2\x9e\xdc\xab\x51\x68\xe4\xab\x95\x10\x48\x97\xd8\x37\x95\x95\xa8\x0a\x5d\xf6\xf7\xe5\x43\x5d\xd4\x5f\x00\x00\x00\xff\xff\xc0\x88\x6d\xdf\x0d\x03\x00\x00")
+var _subscriptionGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x91\x41\x4f\xe3\x40\x0c\x85\xef\xf9\x15\x6f\xd5\xcb\x56\xea\x76\xef\x91\x7a\x40\x01\x55\x95\x10\x97\xd2\x13\x42\x68\x98\x71\x9b\x11\x93\x71\xf0\xb8\x85\x80\xf8\xef\x28\x93\x40\xc3\x95\x53\x62\xc7\xfe\xde\x7b\xf1\x0c\xb7\x35\x61\x7b\x7c\x4c\x56\x7c\xab\x9e\x23\xb4\x6b\x09\x42\xad\x50\xa2\xa8\x09\x26\x04\xf0\x1e\x5a\x13\x2c\x47\xa5\xa8\xb0\xb5\x89\x07\x02\x9d\xf2\x40\x22\x39\x91\x03\x9f\x48\xf2\xd4\x41\x4c\x5b\x3f\x87\x7f\x2f\x09\xad\xb0\xb2\xe5\x00\x8e\xf8\x3f\xf6\x97\xc5\x20\x3a\x6e\x1b\x21\xf4\x42\x30\x7b\x1d\x01\xf4\x6a\x1a\x1f\x49\x90\xba\x68\x53\x6e\x0d\x8a\xee\xdb\xc1\x5e\xb8\xc1\x1b\x45\x47\xe9\x69\x59\x64\xcb\x3f\x42\xbc\x17\x00\x30\xc3\x9a\x34\xef\x1b\x51\x6f\x43\x16\x95\x0e\xea\x1b\x82\x57\xf8\x04\x2b\x64\xb4\x77\x2f\x38\xb6\xae\x7f\x5d\x20\x91\xed\x21\x1b\x87\xe0\x1b\xaf\x69\x4a\x48\x50\xce\xf5\x38\xb4\xcc\x42\xe3\xc7\xdd\x40\xf8\x6b\xf9\x18\x55\xba\x8a\x1d\x95\xa8\xce\x05\x56\xd8\xae\x17\x08\x6c\x4d\xa0\x12\xd7\xf9\x89\x15\xae\x6e\x1e\x76\xdb\x89\x6e\x89\xcd\xe5\xbc\xc4\xc5\x40\x9d\x44\xe9\x6f\x61\x8d\xd2\x81\xc5\x53\x9a\xa6\x31\xb1\x1b\xaf\xd4\x4c\x62\x2d\xbe\x42\xf5\xf9\x1c\x05\x52\x72\x83\xe3\x33\xa5\x1a\x7e\xed\xef\x4c\xcf\x4b\xdc\x55\x03\xaa\xfb\x73\x5f\x7c\x14\x9f\x03\x00\xa8\xa4\x18\x2b\x51\x02\x00\x00")
+
+func subscriptionGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		_subscriptionGraphql,
+		"subscription.graphql",
+	)
+}
+
+func subscriptionGraphql() (*asset, error) {
+	bytes, err := subscriptionGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "subscription.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _typeArticleGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x94\xcd\x6e\xd3\x40\x10\xc7\xef\x7e\x8a\x49\x2b\x21\x90\x50\x1f\x60\x4f\x84\x94\x83\x25\x54\x55\xa4\x3d\x55\x3d\x4c\x76\x26\xf6\x8a\xf5\x8e\xd9\x1d\x13\x05\xd4\x77\x47\x5e\x3b\x1f\x76\x8a\x40\x70\xfd\xcd\xfc\xe7\x63\xe7\x6f\x5f\xc3\x12\x74\xdf\x32\x68\x8d\x0a\xc4\xc9\x46\xb7\xe1\x04\xcb\xa8\xce\x7a\x4e\xef\xc1\x29\xb8\x04\x08\x5f\xd8\xe3\x1e\x6c\x17\x93\x44\xb0\x12\x02\x5b\x75\x12\x6e\x8a\x2c\x3f\xe4\x83\x6b\x5a\xcf\x0d\x07\x4d\x70\x8f\x15\x97\x61\x2b\xf0\xb3\x00\x00\x68\xb1\x62\x03\x65\xd0\x05\x7c\x20\x6e\x23\x5b\x54\xa6\xb7\x91\x31\x49\x30\x70\xf5\x98\x38\xe7\x64\x89\x0b\x49\x19\xe9\xe6\xea\xdd\xa0\xe5\x78\xff\x3f\x72\xac\x78\x25\x5d\xd0\x7f\x2d\x60\x4f\xe2\x3c\x0f\x8e\xeb\x1a\x78\x1a\x37\x5f\x3c\xff\xbe\x2a\x53\xc5\x69\x5e\x32\xc3\x93\xfe\x13\x55\xbc\x78\x5e\x1c\xc7\xed\xa7\x30\xb0\x3a\xbe\xf3\xe1\x31\x17\xc5\x4b\x51\xfc\xe1\x6a\x7d\xad\xe9\x5d\x7a\x32\x9e\x61\xb8\xa0\x81\xb5\x46\x17\xaa\xa1\x61\x10\x62\x73\xc8\xfd\x9b\x0e\xd3\xea\xe7\x47\x1f\x51\x19\x94\xe3\x16\x2d\xc3\x1b\xb8\x13\x3a\x34\xbf\x86\x87\x9a\x41\x5a\xfc\xd6\x31\x54\x5e\x36\xe8\xc1\x51\x36\xd9\x0e\x13\x68\xcd\xf0\x83\x03\x71\xfa\x0a\x8e\x60\x57\x3b\x5b\xf7\xee\x1b\x59\x49\x10\x64\x77\x93\x47\x76\x64\xa0\xbc\x1d\xc6\x3f\x86\x4f\x08\x3b\xad\x25\x96\x34\xdd\xd3\x4a\x93\xa7\xbc\x75\x09\x37\x9e\x0d\x7c\x14\xf1\x8c\x61\x88\x52\xc4\xad\xce\x58\x1b\xa5\x11\x65\x9a\x63\x49\xae\x77\xff\x99\x25\xbe\x8b\xf2\xba\x6b\x66\xe4\xcc\x75\x99\xd9\xc8\xbd\x3f\x96\x6a\xe0\xc1\x35\x3c\x34\xee\x5a\xba\x84\x49\xba\x68\xf9\xb3\x58\xf4\x3c\xdd\x42\x3a\x25\xbc\x9c\xe9\x80\x07\x49\x32\xf0\x34\x8a\x46\x57\x31\xb9\x8b\x26\x1e\x37\xec\xef\xb0\x79\x25\x3d\x5b\x3e\xee\x57\xd9\x1a\x63\x28\x07\xba\xe8\xa7\xa0\xd6\xc6\x3f\xce\x61\xc0\x66\xa6\x53\xa7\xf3\x4d\x36\x42\xfb\x29\xf1\xaf\xec\xdb\xff\x27\x2a\x89\xfb\xd3\xc7\x60\x60\x35\xb2\x9c\x90\x06\x7a\x1e\x5f\xb3\x55\x27\xa1\x78\x29\x7e\x0d\x00\x6a\xc4\xa3\x76\xe2\x04\x00\x00")
 
 func typeArticleGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -243,7 +286,7 @@ func typeArticleGraphql() (*asset, error) {
 	return a, nil
 }
 
-var _typeCategoryGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x91\x41\x6b\xe3\x30\x10\x85\xef\xfa\x15\x2f\xec\x65\x17\xc2\xd2\xd2\x9b\xc0\x07\xc7\xbd\x18\x4a\x12\x70\x7a\x2a\x3d\xa8\xf6\xd4\x11\x95\x25\x23\x8d\x0f\xa2\xe4\xbf\x97\x5a\xa6\x71\x9c\x42\x6f\x3d\x79\xe6\x7b\x63\xde\x1b\xcd\x1f\xe4\xe0\xd8\x13\xf8\xa8\x18\x0d\x85\xda\xeb\x17\x0a\x28\x14\x53\xeb\xbc\xa6\xf0\x5f\x8c\xfa\x19\x40\x77\xbd\xa1\x8e\x2c\x07\xec\x55\x4b\xa5\x7d\x75\x78\x17\x00\xd0\xab\x96\x24\x4a\xcb\xab\xd4\x92\xdf\x2f\x88\x6a\xa9\x70\x83\xe5\x19\xab\x97\xfd\x97\x93\xc4\xd3\x64\x1b\x57\xcf\xe2\x24\xc4\x0f\x69\xe3\x65\xd6\x38\xa5\xd2\x8d\x44\x79\x3f\x05\x70\x41\xb3\x76\x76\xee\xe7\x49\x31\x35\x39\x4b\x1c\x74\x47\x09\x0e\x7d\x73\x0d\x83\x1b\x7c\x4d\x0f\xae\x56\x86\x24\x2a\xf6\xda\xb6\x49\x71\x03\x8f\xf3\x12\x1b\xe7\x0c\x29\x3b\x5b\xcd\xc7\xc2\x35\x8b\xf9\x37\x8a\x5b\xd5\x2d\xe0\xe0\xcd\x25\x38\x72\x67\x1e\x97\xd0\x5e\xfd\x97\xde\xa1\x4f\x6b\xcd\x05\xf3\x4d\xd2\x40\xf5\xe7\x60\x28\x9c\xb5\xa9\xfc\x3b\x3f\x13\x32\xdc\xdd\xac\xcf\x87\x44\x86\xdb\x35\x82\xf3\xbc\x89\x12\xd5\xf8\x45\x86\xfd\xae\x2a\x0f\xe5\x6e\x9b\xa4\x9d\x6f\xc8\x27\x75\x2c\x91\x21\xaf\x8a\x7f\x12\xd5\x64\x36\x3a\x2b\xcf\xba\x36\xf4\x3b\xce\xf9\x64\x26\x4e\xe2\x23\x00\x00\xff\xff\x6c\x9b\xfc\x69\xe3\x02\x00\x00")
+var _typeCategoryGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x53\xc1\x6a\x1b\x31\x10\xbd\xef\x57\x3c\x27\x97\x04\x8c\x69\xe9\x4d\x60\xa8\xe3\xf6\xb0\x50\x6c\x53\x3b\xa7\x92\x83\x22\x8d\x77\x45\x64\x69\x2b\xcd\x62\xb6\x25\xff\x5e\x2c\x39\x6b\x7b\xd3\xd2\xd2\x1e\x7a\xda\xe5\xcd\xcc\x9b\xa7\x79\x33\xd7\x98\x81\xbb\x86\xc0\xb5\x64\x68\x8a\x2a\x98\x47\x8a\x98\x4b\xa6\xca\x07\x43\x71\x0c\xc3\x30\x11\x12\x9f\xc9\xca\x0e\xaa\x0d\xd1\x07\x28\xef\x1c\x29\x36\xde\x4d\x8a\x44\x70\xaa\x80\xd9\x35\x96\x76\xe4\x38\x62\x25\x2b\x2a\xdd\xd6\xe3\x7b\x01\x00\x8d\xac\x48\xa0\x74\x3c\xc2\x7b\x4d\x4d\x20\x25\x99\xf4\x4d\x20\x19\xbd\x13\xb8\xba\x8f\x94\x72\x52\x89\x71\x91\x49\xea\xc9\xd5\x6d\xae\xa5\xb0\xfa\x97\x72\x59\xd1\xdc\xb7\x8e\xff\x96\x40\x9d\x8a\x93\x1e\xd5\x3f\x58\xe0\xcb\xf1\xf5\xdd\xe8\xe1\xd7\xc4\xa4\x2b\x8a\x43\xd6\x04\x9e\x11\x7c\xd4\x15\x8d\x1e\x46\xbd\xe4\x83\x12\x81\x79\x3f\xed\x97\x81\x8e\x8a\xe7\xa2\xf8\x8d\x7b\x89\xec\xd2\x9e\x04\x1d\xcd\xc8\x4e\x0a\xac\x39\x18\x57\xe5\x96\xce\x6b\x12\x7d\xf2\x1f\x35\x19\x34\x38\x77\x7f\xe1\xf5\x4b\xb3\x6b\x6c\x6a\x82\x6f\xe4\xd7\x96\x50\x59\xff\x28\x2d\x8c\x4e\xcb\xb5\x97\x11\x5c\x13\xbe\x91\xd3\x14\x9f\x60\x34\xf6\xb5\x51\xf5\x61\xeb\x8e\x58\xa9\xe1\xfc\x7e\x92\x98\x8c\x16\x28\x3f\x64\xb9\x7d\xf8\x04\x35\x3e\x9a\xc3\x5a\x9e\x3b\x15\xe8\xe0\xc6\x8c\x05\x36\x66\x47\x39\xaf\x6d\xf4\x6b\x30\xfa\x36\x28\xfa\xe4\x95\xb4\x74\x39\x18\xdf\x72\xca\x17\xb8\xf3\xde\x92\x74\x47\xea\xc3\x52\x84\x6e\xee\xf5\x20\xff\x89\xba\x85\xdc\x0d\xc0\x36\xd8\x4b\xa0\xe6\x9d\xbd\x1f\x82\xee\x55\x5d\x76\xb6\xc9\xcf\x3a\x0f\xd8\x9f\x28\x8d\xf9\x2c\xe3\x69\x67\x6e\xce\x4f\x07\x53\xbc\x7b\x33\x3e\x5d\x22\xa6\x78\x3b\x46\xf4\x81\xef\x3a\x81\x75\xfa\x62\x8a\xd5\x72\x5d\x6e\xca\xe5\x22\x87\x96\x41\x53\xc8\xd1\xf4\x8b\x29\x66\xeb\xf9\x18\x5b\x13\x62\x3e\xa8\x31\xe4\x96\xa9\xdf\xa6\x5b\x81\xf5\x51\x47\x12\x25\x03\x1b\x65\xe9\xbf\x8b\x9a\x05\x36\xca\x52\x2c\x9e\x8b\x1f\x03\x00\xbf\xbc\xc2\xb7\xfb\x04\x00\x00")
 
 func typeCategoryGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -263,7 +306,27 @@ func typeCategoryGraphql() (*asset, error) {
 	return a, nil
 }
 
-var _typeCustomtypeGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x52\x56\x08\xc9\xcc\x4d\x55\xc8\x2c\x56\x48\x54\x08\x72\x73\x36\x36\x36\xb6\x54\x28\xc9\xcc\x4d\x2d\x2e\x49\xcc\x2d\xd0\xe3\x2a\x4e\x4e\xcc\x49\x2c\x02\xab\xe1\x02\x04\x00\x00\xff\xff\x0d\x9d\xf9\x69\x2b\x00\x00\x00")
+var _typeConnectionpageinfoGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xcd\x31\xca\xc2\x50\x10\xc4\xf1\x3e\xa7\x98\x8f\xaf\xf7\x00\xe9\x34\x95\x8d\x04\x3d\xc1\xfa\x32\x26\x0f\xc2\x6e\x78\xbb\x11\x83\x78\x77\x31\xa0\x85\xd8\xfe\xfe\x0c\xf3\x8f\x2d\x62\x99\x88\x18\x24\xd0\xd1\x53\xc9\x67\x3a\x62\x20\x8e\x1c\x65\x41\x9a\x8b\x5b\x41\x32\x55\xa6\xc8\xa6\x98\xa4\xe7\xa6\x5a\x57\xcd\x47\x5b\xe9\xb9\xd7\x8b\xe1\x5e\x01\xc0\x20\x7e\xe0\x2d\x5e\x5a\x63\x67\x36\x52\xf4\xef\x5d\xda\xc2\x6b\xb6\xd9\x7f\x54\x0f\x29\xd1\xac\x97\x35\x4e\x51\xb2\xf6\xab\x53\xbb\x2f\x7d\x54\xcf\x01\x00\x5b\x77\x85\xdb\xbc\x00\x00\x00")
+
+func typeConnectionpageinfoGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		_typeConnectionpageinfoGraphql,
+		"type/connectionPageInfo.graphql",
+	)
+}
+
+func typeConnectionpageinfoGraphql() (*asset, error) {
+	bytes, err := typeConnectionpageinfoGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "type/connectionPageInfo.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _typeCustomtypeGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x2b\x00\xd4\xff\x23\x20\x54\x69\x6d\x65\x20\x69\x73\x20\x61\x20\x52\x46\x43\x33\x33\x33\x39\x20\x74\x69\x6d\x65\x73\x74\x61\x6d\x70\x2e\x0a\x73\x63\x61\x6c\x61\x72\x20\x54\x69\x6d\x65\x0a\x03\x00\x0d\x9d\xf9\x69\x2b\x00\x00\x00")
 
 func typeCustomtypeGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -283,7 +346,7 @@ func typeCustomtypeGraphql() (*asset, error) {
 	return a, nil
 }
 
-var _typeSearchbodyarticleGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x91\xcd\x8a\xeb\x30\x0c\x85\xf7\x79\x0a\x95\xbb\xbf\x0f\x90\x5d\x7f\x36\x81\xcb\xa5\x90\x99\xd5\xd0\x85\x62\xab\x89\xc1\xb6\x82\x2d\x0f\x84\xa1\xef\x3e\x34\x4e\x21\x75\x3a\x30\xbb\xf8\x93\x14\x9d\xa3\xf3\x07\xf6\x20\xd3\x48\x20\x03\x0a\x68\x8a\x2a\x98\x8e\x22\xb4\x84\x41\x0d\x07\xd6\xd3\x3e\x88\x51\x96\xe2\xdf\x6a\xee\xdb\x16\xc0\xb8\xd1\x92\x23\x2f\x11\xce\xd8\x53\xe3\xaf\x0c\x5f\x15\x00\xc0\x88\x3d\xd5\xd0\x78\xd9\xe5\x27\x85\x73\x41\xb0\xa7\x23\x27\x2f\x2b\xa6\x8a\x37\x2e\x7b\x6a\xf8\xd8\x2c\xdf\x5d\xaa\x5b\x55\xfd\xda\xc4\x4f\x1e\xd6\x16\x16\xd4\x78\xa1\x70\x45\x45\x8b\x15\xa3\x6b\x68\x4e\x8b\xa2\x24\x03\x87\x46\xd7\xd0\x4a\x30\xbe\x7f\xe8\x76\xf3\x1f\x4e\x26\x62\x67\xa9\x86\x03\xb3\x25\xf4\xb9\xaa\x03\x5e\xa5\x60\x63\x60\xc7\x42\xba\xc4\x1c\x8d\x18\xf6\xab\x1b\x7c\xb2\x50\x9b\x5c\x41\x36\x97\x0b\x84\x42\x7a\x2f\x35\xbc\x19\x47\x19\xa6\x51\x6f\x61\xe4\x14\x14\xfd\x63\x85\x77\xa1\x6b\x17\x9c\x64\xee\x2f\x34\x3d\x70\x1e\x99\xb3\xc8\x43\x97\x5c\x27\x6d\x36\x4b\x2c\x76\x64\xff\xa3\x7b\xd1\x3e\x67\x1c\xa6\x23\xeb\x62\x7d\x0a\xf6\x19\x0c\xe2\xec\x7b\x09\x3d\xba\x62\x4e\x8c\x94\x4e\x3a\xd6\xd3\x33\xb1\x2f\xfc\x46\x6f\xc6\x91\xa4\x88\x12\x85\x7a\xbe\xeb\xf3\x9e\x54\xce\xe2\xb8\xb0\x3c\x95\xe9\xba\xde\xe6\x8f\xea\x56\x7d\x07\x00\x00\xff\xff\x4a\x06\xcb\xf3\x54\x03\x00\x00")
+var _typeSearchbodyarticleGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x94\xcb\x8e\xdb\x3c\x0c\x85\xf7\x7e\x0a\x66\x66\xf3\xff\x40\x91\x07\xf0\xaa\x99\x4c\x17\x06\x8a\x62\xd0\xcc\xac\x8a\x59\x30\x22\x63\x0b\x95\x45\x57\xa2\x1b\xb8\xc5\xbc\x7b\x61\xd9\xb9\xd9\x09\xd0\xcb\xf6\x93\x0f\xc9\x23\x1e\xf9\x1e\x56\xa0\x5d\xc3\xa0\x15\x2a\x10\x47\x13\xec\x96\x23\x6c\x18\x83\xa9\x1e\x84\xba\x55\x50\x6b\x1c\xc7\x77\x60\x15\x6c\x04\x84\xcf\xec\xb0\x03\xd3\x86\x28\x01\x8c\x78\xcf\x46\xad\xf8\x65\x96\x0a\xcd\x95\x60\xeb\xc6\x71\xcd\x5e\x23\x3c\x61\xc9\x85\xdf\x09\xfc\xcc\x00\x00\x1a\x2c\x39\x87\xc2\xeb\x02\xde\x13\x37\x81\x0d\x2a\xd3\x7f\x81\x31\x8a\xcf\xe1\xee\x25\x72\xfa\x26\x49\xac\x8f\xca\x48\xcb\xbb\xff\x07\x2d\x87\xa7\x7f\x91\x63\xc9\x6b\x69\xbd\xfe\x6d\x01\x73\x12\xa7\x79\x70\xb4\x9b\xc3\x97\xd9\x1d\x2c\x5e\x6f\xd7\x67\x2a\x39\x4e\x8b\x27\x78\xad\xd2\x07\x2a\x79\xf1\xba\x38\x5a\xe8\x27\xcb\x61\x7d\xdc\xc2\xe1\x82\x17\xd9\x5b\x96\xfd\xf6\x76\xfb\xaa\xb7\xf6\xd7\x9f\x8d\xeb\x1a\x76\x9e\xc3\x46\x83\xf5\xe5\x30\x84\x17\xe2\x7c\xae\xfa\xb3\xfe\xb7\x7a\x9f\x47\x67\x44\x85\x57\x0e\x3b\x34\x87\x99\xee\xe1\xb9\x62\x90\x06\xbf\xb5\x0c\xa5\x93\x2d\x3a\xb0\x94\xd2\xba\xc7\x08\x5a\x31\xfc\x60\x4f\x1c\xbf\x82\x25\xd8\x57\xd6\x54\x7d\x8c\x47\x56\x10\x78\xd9\x2f\x93\x13\x4b\x39\x14\x8f\x83\xab\xe3\xf1\x09\x61\xab\x95\x84\x82\x2e\xed\x1b\xa9\xd3\x78\x8f\x36\xe2\xd6\x71\x0e\x0f\x22\x8e\xd1\x0f\xa7\x14\x70\xa7\x13\xd6\x04\xa9\x45\x99\xa6\x58\xa2\xed\x9f\xd1\x59\xa2\xbe\x8b\xf2\xa6\xad\x27\xe4\x2c\xb4\x89\x99\xc0\x7d\xa8\x56\x9a\xc3\xb3\xad\x79\x68\xdc\x36\x34\x87\x51\xda\x60\xf8\xa3\x18\x74\x7c\xe9\x42\x5a\x25\x9c\xcf\x74\xc0\x83\x24\xe5\x71\xd8\xfc\x18\x40\x26\x3b\x6b\xe2\x70\xcb\xee\x13\xd6\x57\x3e\x4f\x2f\x26\x74\x6b\xa1\x49\xfb\x36\xb8\x4b\x50\x69\xed\x5e\xa6\xd0\x63\x3d\xd1\xa9\xd5\xa9\x93\xad\x50\x77\x49\xdc\x15\xbf\xd1\xdb\xa6\x61\xbd\x84\xfd\xbf\xa7\x94\xd0\x9d\x1e\x53\x0e\xeb\x91\x0d\xaa\x81\x9e\x9f\x6f\xd8\xa8\x15\x9f\xbd\x65\xbf\x06\x00\x29\x2f\xe1\x0f\x4a\x05\x00\x00")
 
 func typeSearchbodyarticleGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -303,7 +366,7 @@ func typeSearchbodyarticleGraphql() (*asset, error) {
 	return a, nil
 }
 
-var _typeSearchtitlearticleGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x52\x56\x70\x54\x28\xa9\x2c\x48\x55\x28\xc9\x48\x2c\x51\x48\x49\x2d\x4e\x2e\xca\x4c\x4a\x2d\x56\x08\x4e\x4d\x2c\x4a\xce\x08\xc9\x2c\xc9\x49\x75\x2c\x2a\xc9\x4c\xce\x49\xd5\xe3\x02\xab\xc3\x94\x50\xa8\xe6\x52\x50\x50\x50\x28\x01\x09\x59\x29\x04\x97\x14\x65\xe6\xa5\x2b\x82\x85\x92\x13\x4b\x52\xd3\xf3\x8b\x2a\x43\x30\xa5\x4a\x8b\x72\x10\x02\xb5\x5c\x80\x00\x00\x00\xff\xff\xc0\x5c\x15\xb6\x87\x00\x00\x00")
+var _typeSearchtitlearticleGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x52\x56\x70\x54\x28\xa9\x2c\x48\x55\x28\xc9\x48\x2c\x51\x48\x49\x2d\x4e\x2e\xca\x4c\x4a\x2d\x56\x08\x4e\x4d\x2c\x4a\xce\x08\xc9\x2c\xc9\x49\x75\x2c\x2a\xc9\x4c\xce\x49\xd5\xe3\x02\xab\xc3\x94\x50\xa8\xe6\x52\x50\x50\x50\x28\x01\xa9\xb5\x52\x08\x2e\x29\xca\xcc\x4b\x57\x04\x0b\x25\x27\x96\xa4\xa6\xe7\x17\x55\x86\x60\x4a\x95\x16\xe5\x20\x04\x6a\xb9\x00\x03\x00\xc0\x5c\x15\xb6\x87\x00\x00\x00")
 
 func typeSearchtitlearticleGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -323,7 +386,7 @@ func typeSearchtitlearticleGraphql() (*asset, error) {
 	return a, nil
 }
 
-var _typeSectionGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x91\xc1\x6a\xf3\x30\x10\x84\xef\x7a\x8a\x09\xff\xe5\x2f\x84\xd2\xd2\x9b\xc0\x07\xc7\xbd\x18\x4a\x13\x70\x7a\x2a\x3d\xa8\xf2\xd6\x11\xc8\x92\x91\xd6\x87\x50\xf2\xee\x25\x96\x4a\x1c\xb7\xd0\x93\xbd\xdf\x8c\x99\x59\xef\x3f\x94\xe0\xe3\x40\xe0\x83\x62\xb4\x14\x75\x30\xef\x14\xd1\x90\x66\xe3\x5d\xbc\x15\x93\xfa\x3d\xc2\xf4\x83\xa5\x9e\x1c\x47\xec\x54\x47\xb5\xfb\xf0\xf8\x14\x00\x30\xa8\x8e\x24\x6a\xc7\xab\x34\x52\xd8\x2d\x88\xea\xa8\xf2\xa3\xe3\x19\xd3\x8b\x39\xe6\x1c\x89\xd7\x1c\xb9\x7a\x13\x27\x21\xfe\xa8\x79\xdd\x32\x17\x32\xad\x44\xfd\x98\xb3\x7d\x34\x67\x69\x1e\x1d\x48\x31\xb5\x25\x4b\xec\x4d\x4f\x09\x8e\x43\xfb\x13\x46\x3f\x06\x4d\x4f\x5e\x2b\x4b\x12\x0d\x07\xe3\xba\xa4\xf8\x91\x27\xbf\xc4\xc6\x7b\x4b\xca\xcd\xb6\x0a\xc7\xca\xb7\x0b\xff\x18\xec\x35\x38\x70\x6f\x5f\x96\xd0\xa9\x7e\xf1\x5d\x5a\x78\x48\x1b\xcc\x05\xfb\x4b\x29\xad\x98\x3a\x7f\x8e\x77\x2e\xfd\x10\x89\x2a\xb3\xc9\xa0\x02\x1b\x6d\x29\x5e\x0c\xff\xe7\xd7\x42\x81\x87\xbb\xf5\xe5\x9e\x28\x70\xbf\x46\xf4\x81\x37\x47\x89\x66\x7a\xa2\xc0\x6e\xdb\xd4\xfb\x7a\xfb\x9c\xa4\x6d\x68\x29\x24\x75\x7a\x45\x81\xb2\xa9\x6e\x24\xca\x1c\x26\x4e\xe2\x2b\x00\x00\xff\xff\xb3\x99\x44\x47\x6c\x02\x00\x00")
+var _typeSectionGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x53\x41\x8b\xdb\x3c\x10\xbd\xfb\x57\xbc\xec\x5e\x76\x21\x84\xef\xa3\x37\x41\xa0\xd9\xb4\x07\x43\xd9\x84\x26\x7b\x2a\x7b\xd0\x4a\x13\x5b\x54\xd1\xb8\x92\x4c\x70\xcb\xfe\xf7\x62\xc9\x89\x93\xb4\xa5\xa5\x3d\xd9\xbc\x79\x6f\xf4\xa4\x37\x73\x8b\x05\x62\xd7\x10\x62\x2d\x23\x34\x05\xe5\xcd\x0b\x05\x6c\x48\x45\xc3\x2e\x4c\x61\x22\x4c\x80\xc4\x47\xb2\xb2\x83\x6a\x7d\x60\x0f\xc5\xce\x65\xc6\xac\x48\xf2\x23\x1f\x66\xdf\x58\xda\x93\x8b\x01\x6b\x59\x51\xe9\x76\x8c\x6f\x05\x00\x34\xb2\x22\x81\xd2\xc5\x09\xde\x6a\x6a\x3c\x29\x19\x49\xdf\x79\x92\x81\x9d\xc0\xcd\x53\xa0\xc4\x49\x12\xe3\x42\x24\xa9\x67\x37\xf7\x59\x4b\x7e\xfd\x2f\x72\x59\xd1\x92\x5b\x17\xff\xb6\x81\x1a\xc5\xc9\x4f\x18\xae\x2b\xf0\x69\xb8\xf9\xe4\xf9\xd7\x5d\x49\x57\x14\xae\x5b\x26\x70\xd4\xbf\xd7\x15\x4d\x9e\x27\x27\xbb\xbd\x0b\x81\xe5\xe9\x9d\x8f\x8f\x39\x29\x5e\x8b\xe2\x37\xa9\xf5\xbd\x2e\x73\xe9\x91\x21\x86\x9c\xa0\xc0\x26\x7a\xe3\xaa\x7c\xa0\x63\x4d\xe2\xc8\xfd\x93\x13\x2e\xbb\x9f\x87\xfe\xc8\xfa\x78\xd2\x2d\xb6\x35\x81\x1b\xf9\xa5\x25\x54\x96\x5f\xa4\x85\xd1\x69\xa2\x0e\x32\x20\xd6\x84\xaf\xe4\x34\x85\xcf\x30\x1a\x87\xda\xa8\xba\x1f\xb5\x01\x2b\x35\x1c\x1f\x66\xc9\x9f\xd1\x02\xe5\xbb\xec\xf5\x54\x1e\xa1\x86\x83\xe9\x7d\x9c\x05\xa4\x3c\xf5\x39\x2c\xa2\xc0\xd6\xec\x29\xf3\xda\x46\xff\x08\x06\x6e\xbd\xa2\x0f\xac\xa4\xa5\xcb\x57\xe1\x36\x26\xbe\xc0\x03\xb3\x25\xe9\x86\xd6\xfd\x2c\xf8\x6e\xc9\xfa\x8a\xdf\x7a\x7b\x09\xd4\x71\x6f\x9f\xae\x41\x27\xf7\x57\xba\x9c\x5f\x93\x6f\x70\x5e\xb0\x3f\x31\xd5\x2f\x4d\xc5\xbe\x1b\x27\x43\x60\x39\x60\x89\x20\x7d\x34\xca\x52\x18\x09\x77\xe7\xdb\x83\x39\xde\xfc\x37\x1d\x97\x11\x73\xfc\x3f\x45\x60\x1f\x1f\x3a\x81\x4d\xfa\x62\x8e\xf5\x6a\x53\x6e\xcb\xd5\x63\x2e\xad\xbc\x26\x9f\xab\xe9\x17\x73\x2c\x36\xcb\x29\x76\xc6\x87\xbc\x53\x53\xc8\x5d\xa4\xd3\x58\xdd\x0b\x2c\x7c\x34\xca\x52\x28\x5e\x8b\xef\x03\x00\x55\xa1\xfb\x0f\x65\x04\x00\x00")
 
 func typeSectionGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -343,7 +406,7 @@ func typeSectionGraphql() (*asset, error) {
 	return a, nil
 }
 
-var _typeStatusGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x52\x56\x70\x54\x28\xa9\x2c\x48\x55\x28\xc9\x48\x2c\x51\x48\x49\x2d\x4e\x2e\xca\x4c\x4a\x2d\x56\x08\x2e\x49\x2c\x29\x2d\xd6\xe3\x02\xcb\x41\x38\x0a\xd5\x5c\x0a\x0a\x0a\x0a\xe9\xf9\x61\xa9\x45\xc5\x99\xf9\x79\x56\x0a\xc1\x25\x45\x99\x79\xe9\x8a\x60\xe1\xc4\x82\x02\xac\xe2\xc5\xa9\x45\x65\xa9\x45\x21\x99\xb9\xa9\x56\x0a\x20\x52\x91\xab\x96\x0b\x10\x00\x00\xff\xff\x88\x6b\xed\x9c\x75\x00\x00\x00")
+var _typeStatusGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x52\x3d\x6f\xdb\x30\x10\xdd\xf5\x2b\x9e\x91\x35\xf0\x0f\xd0\xe6\x66\x69\x97\x2e\x0e\xba\x14\x1d\x68\xf2\x49\x22\xcc\x1c\x0d\x1e\xe5\x42\x2d\xf2\xdf\x0b\x8a\xb4\x1b\xb5\x0d\xba\x08\xe2\xbb\xc3\xfb\x22\x1f\x70\x40\x5e\x2e\x44\x9e\x4c\x86\xa3\xda\xe4\x4f\x54\x1c\xb3\xc9\xb3\xee\xbb\x75\x56\x0f\xf8\xd9\x01\xc0\x18\xbf\x30\xa9\x8f\xd2\xe3\x98\x93\x97\x71\xb7\xc2\xe6\x72\xf9\x27\xae\x4c\x57\xa6\x67\xff\xc2\x1e\xe5\x5b\xb7\x1f\xf0\x3c\x11\xc1\x5f\x29\x54\x85\xae\x02\x8f\x88\xe7\x47\x0c\xc6\x07\xc4\x84\x59\xce\x12\xbf\xcb\x7e\xdd\xbf\x6d\x6e\xb9\x2b\x4b\xa2\x71\xfe\x2f\x1a\xc7\x31\x19\x47\x07\x3f\x20\x4a\x58\xf0\x83\xe2\xa8\x67\x78\xc5\x2c\x89\xc6\x4e\xe6\x14\xf8\x8e\xde\x9d\x73\x2b\x68\x27\xda\xb3\xf6\xf8\xfa\x91\x26\xe4\xe9\xa9\x1c\x77\xdf\x5a\xd0\x45\x6c\x19\x1d\x17\xb1\x87\x91\x05\x7e\xed\xba\xf7\xfa\xcd\xab\x71\x9d\x43\x46\x1c\x60\x30\xad\x84\x55\xa0\xb5\xfe\x46\xa3\x55\x2f\xe6\x85\x5b\x43\xb5\xb8\x2d\xc6\x94\x62\xba\x41\xad\xa7\xcf\x73\x08\x38\x71\x88\xa9\xdc\x34\x31\xf8\xa4\xb9\xaa\x95\x42\x5c\x14\xee\x7f\x27\xa4\x3b\xe4\x7a\x5b\xff\x8b\x60\x46\x16\xff\xe5\x37\x18\xcd\xd0\xd9\x5a\xaa\x0e\x73\x80\x2e\x62\xcb\xcc\xc0\xc6\x59\x72\x5a\x6e\x8f\xa9\xf6\xd3\x22\xb5\xd9\x53\x74\x7f\x24\x6b\x9e\x7d\x25\x6f\x6b\xc5\xab\xf0\xca\xb4\xb2\xd3\xed\xef\xcd\xbf\xb1\x5c\x20\x33\xf2\x48\xdb\xe3\x93\xe4\x7b\x51\x81\x3d\x3e\xc4\x18\x68\x64\xd7\xbd\x76\xbf\x06\x00\x27\xc2\x1c\x59\xfa\x02\x00\x00")
 
 func typeStatusGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -363,7 +426,7 @@ func typeStatusGraphql() (*asset, error) {
 	return a, nil
 }
 
-var _typeTicketfieldGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x92\xc1\x4e\xfb\x30\x0c\xc6\xef\x7d\x0a\x4f\xff\xfb\xff\x01\x7a\x1b\x4c\x93\x76\x01\xa4\x4d\x5c\xd0\x0e\x5e\x63\x15\x8b\x34\x09\x89\x5b\x98\x10\xef\x8e\x96\x0e\xa9\x8b\x57\xb4\x5b\xf5\xfb\xec\xfa\x73\xfc\xfd\x83\x25\xc8\x31\x10\xc8\x2b\x0a\x18\x4a\x4d\xe4\x03\x25\xd8\x71\xf3\x46\xb2\x66\xb2\xe6\x7f\x95\x0b\x26\x04\xbe\x2a\x00\x00\x36\x35\x6c\x56\x8b\xfc\xdd\x47\x5b\xc3\x56\x22\xbb\x76\x04\xa7\x9e\x82\xb0\xd8\x02\x45\xfc\xd8\x69\x3a\xba\x08\xc2\xde\xa9\xf2\xd5\x9c\x16\x7c\xe2\x91\x6e\x9c\x8c\x08\x1b\xe1\x81\x6a\xb8\xf3\xde\x12\xba\xf3\x3f\xe8\xbd\xe7\x48\xa6\xc0\x8d\xb7\x16\x43\x22\xb3\xf6\x71\xd9\x92\x93\xa4\xfa\x5a\xfa\x0c\x6b\x1f\x9f\xd1\xb2\x41\x6d\x20\xaf\xb7\x71\x4f\x3e\x0a\xda\xeb\x6b\x5e\x57\x07\x4e\x7c\x98\x8a\x17\x73\xc9\xb0\xe0\xbc\xfc\xbb\xce\x8c\x2c\xd8\x5e\x0e\x6b\x22\xa1\x90\x59\x4a\x0d\x3b\xee\xe8\x7c\xbc\x60\x34\x8c\xd4\xf9\xe1\x34\xb9\x7c\xa9\x3e\x89\xef\x72\x0e\x1e\xf3\x1d\x52\x0d\x2f\x93\x70\xdc\x97\xfa\x62\x9f\xfb\xd2\x31\x09\xfd\xd1\xb7\x2d\xf5\xc5\xbe\xfa\xae\xaa\x1b\x02\xaa\x26\xea\xc4\xaa\x92\x2b\x11\x76\xd8\xe9\x78\x3e\x28\x38\xa0\xed\x27\xe8\x36\x8b\x6a\x39\x6d\x51\x95\x9c\x2d\x6a\x5b\xca\xc1\x4f\x00\x00\x00\xff\xff\x0b\x4c\xfc\x70\xc5\x03\x00\x00")
+var _typeTicketfieldGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x93\xc1\xee\x9b\x30\x0c\xc6\xef\x3c\x85\xab\xff\x75\xea\x03\x70\xeb\x56\x55\xe2\xd2\x4d\x2a\xda\x65\xea\xc1\x25\x16\x58\x0d\x49\x9a\x18\x58\x57\xed\xdd\x27\xa0\xdd\x28\x29\x53\x6f\xe8\xf7\x39\xf6\xf7\x11\xe7\x03\x36\x20\x57\x47\x20\x15\x0a\x28\x0a\x85\xe7\x13\x05\xc8\xb9\x38\x93\xec\x98\xb4\x5a\x27\x43\xc1\x84\x00\xd7\x4e\x53\x4d\x46\x02\xec\xad\x22\xb8\x25\x00\x00\x1f\x90\x57\x04\xd6\xe1\xa5\x21\x28\xb5\x3d\xa1\x06\x56\x9f\x80\x05\x3a\x0c\x20\x15\xc1\x2f\x32\x8a\xc2\x19\x58\x41\x57\x71\x51\x01\x87\x07\xcb\x14\x18\xdb\xad\x87\x4e\xac\x52\xc8\xb6\xab\xe1\xfb\xaf\xfc\x0f\x35\x5e\xa7\x70\x10\xcf\xa6\x1c\x41\x6f\x70\x46\x58\xf4\x0c\x79\xec\xf2\x98\x8e\x91\x9d\xb0\x35\x51\xf9\x76\x49\x73\x36\xf0\x48\x33\x23\x23\xc2\x42\xb8\xa5\x14\x3e\x5b\xab\x09\xcd\xbd\x07\x5d\x1a\xf6\xa4\x66\xb8\xb0\x5a\xa3\x0b\xa4\x76\xd6\x6f\xca\xfe\x37\x46\xe7\x4a\xfa\xe9\x76\xd6\x7f\x47\xcd\x0a\x63\x03\xd2\x07\xc9\xcc\x37\xeb\x05\xf5\xeb\x98\xaf\xd5\x96\x03\x9f\xa6\xe2\xd3\x5c\x52\x2c\xb8\x2c\x3f\xe2\x2c\xc8\x82\xe5\xf3\xb0\xc2\x13\x0a\xa9\x8d\xa4\x90\x73\x4d\xf7\xcb\x73\x2a\x86\x9e\x6a\xdb\xf6\x93\x67\x2d\x8b\x26\x88\xad\x87\xa5\xfb\x3a\xdc\x51\x48\xe1\xc7\x64\x13\xbf\xcc\xf5\xd5\x71\x38\x17\xae\x41\xe8\x3f\xe7\x0e\x73\x7d\x75\x4c\x7e\x27\xc9\x1b\xaf\x21\x9a\x18\x3f\x8f\xa8\x04\x6e\xd1\x56\x1b\xac\xe3\xf5\xdc\x47\xb0\x45\xdd\x4c\xd0\x7b\x16\xa3\x70\xb1\xc5\xa8\x04\x6e\x0b\xb6\x22\x07\x7f\x06\x00\x7f\xae\x39\xa0\x32\x04\x00\x00")
 
 func typeTicketfieldGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -383,7 +446,7 @@ func typeTicketfieldGraphql() (*asset, error) {
 	return a, nil
 }
 
-var _typeTicketformGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x90\xc1\x4a\xc3\x40\x10\x86\xef\x79\x8a\x29\x5e\xf4\xe2\x03\x2c\x78\x48\xad\x42\x40\x7a\x69\xea\xa5\x14\x99\xee\x0e\x3a\xb8\x99\x0d\x33\xa3\xa5\x88\xef\x2e\x49\x84\x62\x83\xa7\x5d\xbe\xef\xdf\xe5\xe7\xbf\x82\x1a\xfc\xd4\x13\xf8\x1b\x3a\x24\xb2\xa8\x7c\x20\x83\x96\xe3\x3b\xf9\x63\xd1\xee\xb6\x1a\xfd\x19\xc0\x57\x05\x00\xc0\x29\x40\xb3\x5a\x8c\xf7\x0f\xcd\x01\x36\xae\x2c\xaf\x13\x10\xec\xe8\x2f\x51\x3c\xae\x67\x30\xb1\xf5\x19\x4f\x73\xa1\x78\x5c\xfd\xe7\x48\xd2\xd6\x48\x9f\xd9\xf8\x90\x29\xc0\xb2\x94\x4c\x28\x93\xec\x8b\xb1\x73\x91\x00\x8d\xf8\x84\x30\x3a\x7f\x5e\xe6\x58\xea\x9c\x97\x8a\x92\xec\xc2\x28\x99\x2b\x47\xa7\x34\xea\x66\x08\xec\x86\xcf\xf6\x93\x8f\x4a\xe8\x94\x6a\x0f\xd0\x72\x47\xbf\x03\xf4\x69\x0e\x7d\x9a\x8c\x29\x27\xbb\x2f\x22\x14\x87\x66\xd7\xb9\x44\x1c\x7a\x3f\x8d\x27\xdc\xc1\xc3\xfa\x65\xbb\xb9\x09\xb0\x6b\xcf\x0f\x16\xfb\xea\xbb\xfa\x09\x00\x00\xff\xff\x6d\xa6\x8a\x81\x9d\x01\x00\x00")
+var _typeTicketformGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x91\xc1\x4a\xc3\x40\x10\x86\xef\x79\x8a\xbf\xf4\xa2\x20\x7d\x80\x80\x87\xd6\x2a\x04\xa4\x97\xb6\x5e\x4a\x91\xed\xce\xd0\x0c\xdd\xec\xc6\xdd\xa9\xa1\x8a\xef\x2e\x49\xaa\xc5\x06\x4f\xbb\x7c\xdf\xec\xf0\xb3\xff\x18\x53\xe8\xa9\x66\x68\x69\x14\xc4\xc9\x46\xd9\x71\xc2\x4a\xec\x81\xf5\x29\xc4\x6a\x92\x75\xfe\x02\x20\x55\xed\xb8\x62\xaf\x09\x8b\x40\x8c\xcf\x0c\x00\xc6\x58\x95\x8c\x50\x9b\xb7\x23\x63\xef\xc2\xce\x38\x08\xdd\x41\x14\x8d\x49\xd0\x92\xf1\xc1\x9e\x38\x1d\x20\x84\xa6\x14\x5b\x42\xd2\x0f\x2b\x08\x3e\x34\x93\x6e\x93\x50\x8e\x62\x3e\xea\xee\xbf\xfa\x82\x8e\xd1\xe5\x58\x6a\x14\xbf\xef\x81\x37\x15\xff\x25\xd1\x34\x8b\x01\x24\x49\xb5\x33\xa7\xa1\x88\xa6\x99\xff\xe7\xd8\xd3\x3a\x71\x7c\x91\x24\x3b\xc7\x39\x66\x21\x38\x36\xbe\x97\x75\x48\xa2\x12\x7c\x8e\xc2\x6b\x8f\x8c\x55\x79\xbf\x9e\x13\x3f\x75\x6e\x16\x8d\xa7\x74\x65\x22\x27\x8d\x62\x95\xa9\xd3\x45\x3b\xb0\x69\x97\x6d\xfb\x97\x36\xb2\x51\xa6\xa9\xe6\x58\x49\xc5\xe7\x0f\xa8\x69\x08\xb5\xef\x47\xd8\x51\x7a\x08\xde\xb3\x6d\x93\xdd\xb8\x60\x4d\x9b\xfb\xb9\x3b\x71\x8f\xc7\xc5\xeb\x7a\x79\x9b\x63\x73\x2e\x54\xd8\xd1\x68\x9b\x7d\x65\xdf\x03\x00\xcf\xca\xbd\xdc\x0a\x02\x00\x00")
 
 func typeTicketformGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -458,12 +521,15 @@ var _bindata = map[string]func() (*asset, error){
 	"enum.graphql": enumGraphql,
 	"input/request.graphql": inputRequestGraphql,
 	"interface/article.graphql": interfaceArticleGraphql,
+	"interface/node.graphql": interfaceNodeGraphql,
 	"interface/pageInfo.graphql": interfacePageinfoGraphql,
 	"mutation.graphql": mutationGraphql,
 	"query.graphql": queryGraphql,
 	"schema.graphql": schemaGraphql,
+	"subscription.graphql": subscriptionGraphql,
 	"type/article.graphql": typeArticleGraphql,
 	"type/category.graphql": typeCategoryGraphql,
+	"type/connectionPageInfo.graphql": typeConnectionpageinfoGraphql,
 	"type/customType.graphql": typeCustomtypeGraphql,
 	"type/searchBodyArticle.graphql": typeSearchbodyarticleGraphql,
 	"type/searchTitleArticle.graphql": typeSearchtitlearticleGraphql,
@@ -514,27 +580,24 @@ type bintree struct {
 }
 var _bintree = &bintree{nil, map[string]*bintree{
 	"enum.graphql": &bintree{enumGraphql, map[string]*bintree{}},
-	"input": &bintree{nil, map[string]*bintree{
-		"request.graphql": &bintree{inputRequestGraphql, map[string]*bintree{}},
-	}},
-	"interface": &bintree{nil, map[string]*bintree{
-		"article.graphql": &bintree{interfaceArticleGraphql, map[string]*bintree{}},
-		"pageInfo.graphql": &bintree{interfacePageinfoGraphql, map[string]*bintree{}},
-	}},
+	"input/request.graphql": &bintree{inputRequestGraphql, map[string]*bintree{}},
+	"interface/article.graphql": &bintree{interfaceArticleGraphql, map[string]*bintree{}},
+	"interface/node.graphql": &bintree{interfaceNodeGraphql, map[string]*bintree{}},
+	"interface/pageInfo.graphql": &bintree{interfacePageinfoGraphql, map[string]*bintree{}},
 	"mutation.graphql": &bintree{mutationGraphql, map[string]*bintree{}},
 	"query.graphql": &bintree{queryGraphql, map[string]*bintree{}},
 	"schema.graphql": &bintree{schemaGraphql, map[string]*bintree{}},
-	"type": &bintree{nil, map[string]*bintree{
-		"article.graphql": &bintree{typeArticleGraphql, map[string]*bintree{}},
-		"category.graphql": &bintree{typeCategoryGraphql, map[string]*bintree{}},
-		"customType.graphql": &bintree{typeCustomtypeGraphql, map[string]*bintree{}},
-		"searchBodyArticle.graphql": &bintree{typeSearchbodyarticleGraphql, map[string]*bintree{}},
-		"searchTitleArticle.graphql": &bintree{typeSearchtitlearticleGraphql, map[string]*bintree{}},
-		"section.graphql": &bintree{typeSectionGraphql, map[string]*bintree{}},
-		"status.graphql": &bintree{typeStatusGraphql, map[string]*bintree{}},
-		"ticketField.graphql": &bintree{typeTicketfieldGraphql, map[string]*bintree{}},
-		"ticketForm.graphql": &bintree{typeTicketformGraphql, map[string]*bintree{}},
-	}},
+	"subscription.graphql": &bintree{subscriptionGraphql, map[string]*bintree{}},
+	"type/article.graphql": &bintree{typeArticleGraphql, map[string]*bintree{}},
+	"type/category.graphql": &bintree{typeCategoryGraphql, map[string]*bintree{}},
+	"type/connectionPageInfo.graphql": &bintree{typeConnectionpageinfoGraphql, map[string]*bintree{}},
+	"type/customType.graphql": &bintree{typeCustomtypeGraphql, map[string]*bintree{}},
+	"type/searchBodyArticle.graphql": &bintree{typeSearchbodyarticleGraphql, map[string]*bintree{}},
+	"type/searchTitleArticle.graphql": &bintree{typeSearchtitlearticleGraphql, map[string]*bintree{}},
+	"type/section.graphql": &bintree{typeSectionGraphql, map[string]*bintree{}},
+	"type/status.graphql": &bintree{typeStatusGraphql, map[string]*bintree{}},
+	"type/ticketField.graphql": &bintree{typeTicketfieldGraphql, map[string]*bintree{}},
+	"type/ticketForm.graphql": &bintree{typeTicketformGraphql, map[string]*bintree{}},
 }}
 
 // RestoreAsset restores an asset under the given directory
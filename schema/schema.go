@@ -4,15 +4,38 @@ package schema
 
 import "bytes"
 
+const (
+	schemaAssetName = "schema.graphql"
+	// subscriptionSchema roots the queries at the subscription type, so that each event
+	// of a subscription is resolved by executing the subscription as a query.
+	subscriptionSchema = `schema {
+    query: Subscription
+}
+`
+)
+
 // String reads the .graphql schema files from the generated _bindata.go file, concatenating the
 // files together into one string.
 //
 // If this method complains about not finding functions AssetNames() or MustAsset(),
 // run `go generate` against this package to generate the functions.
 func String() string {
+	return concat(nil)
+}
+
+// SubscriptionString returns the schema of which the query type is the subscription type.
+func SubscriptionString() string {
+	return concat(map[string][]byte{schemaAssetName: []byte(subscriptionSchema)})
+}
+
+// concat concatenates the .graphql schema files, the files of the replaces are replaced by their contents.
+func concat(replaces map[string][]byte) string {
 	buf := bytes.Buffer{}
 	for _, name := range AssetNames() {
-		b := MustAsset(name)
+		b, ok := replaces[name]
+		if !ok {
+			b = MustAsset(name)
+		}
 		buf.Write(b)
 
 		// Add a newline if the file does not end in a newline.
@@ -0,0 +1,25 @@
+#!/usr/bin/env bash
+# Generates a local CA, a server certificate for localhost and a client certificate
+# signed by the CA, for trying TLS and mutual TLS locally.
+set -euo pipefail
+
+dir=${1:-certs-local}
+mkdir -p "$dir"
+cd "$dir"
+
+openssl req -x509 -newkey rsa:2048 -nodes -days 365 -subj "/CN=zen-local-ca" \
+    -keyout ca-key.pem -out ca.pem
+
+openssl req -newkey rsa:2048 -nodes -subj "/CN=localhost" \
+    -keyout server-key.pem -out server.csr
+printf "subjectAltName=DNS:localhost,IP:127.0.0.1\nextendedKeyUsage=serverAuth\n" > server.ext
+openssl x509 -req -in server.csr -CA ca.pem -CAkey ca-key.pem -CAcreateserial -days 365 \
+    -extfile server.ext -out server.pem
+
+openssl req -newkey rsa:2048 -nodes -subj "/CN=zen-local-client" \
+    -keyout client-key.pem -out client.csr
+printf "extendedKeyUsage=clientAuth\n" > client.ext
+openssl x509 -req -in client.csr -CA ca.pem -CAkey ca-key.pem -CAcreateserial -days 365 \
+    -extfile client.ext -out client.pem
+
+rm -f server.csr server.ext client.csr client.ext
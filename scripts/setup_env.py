@@ -23,6 +23,7 @@ with open("env.yml", 'r') as f:
     config['cache']['host'] = os.environ.get(env + "_" + 'REDIS_HOST')
     config['zendesk']['auth_token'] = os.environ.get(env + "_" + 'ZENDESK_AUTH_TOKEN')
     config['datadog']['env'] = os.environ.get(env + "_" + 'DATADOG_ENV')
+    config['antispam']['captcha_secret'] = os.environ.get(env + "_" + 'CAPTCHA_SECRET')
 
 with open("env.yml", 'w') as f:
     yaml.dump(config, f, default_flow_style=False)
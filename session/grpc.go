@@ -0,0 +1,39 @@
+package session
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// Metadata is the gRPC metadata key carrying the anonymous session id.
+	Metadata = "x-session-id"
+	// DeviceMetadata is the gRPC metadata key carrying the device id.
+	DeviceMetadata = "x-device-id"
+)
+
+// FromMetadata returns a copy of ctx carrying the session id and the device id of the incoming gRPC metadata.
+func FromMetadata(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(Metadata); len(values) > 0 {
+		ctx = WithID(ctx, values[0])
+	}
+	if values := md.Get(DeviceMetadata); len(values) > 0 {
+		ctx = WithDeviceID(ctx, values[0])
+	}
+	return ctx
+}
+
+// UnaryServerInterceptor puts the session id and the device id of the metadata into the context,
+// the issued device id is sent back in the header metadata.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx = FromMetadata(ctx)
+		ctx = WithDeviceIssuer(ctx, func(deviceID string) {
+			grpc.SetHeader(ctx, metadata.Pairs(DeviceMetadata, deviceID))
+		})
+		return handler(ctx, req)
+	}
+}
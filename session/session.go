@@ -0,0 +1,167 @@
+package session
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/honestbee/Zen/auth"
+)
+
+const (
+	// Header is the http header carrying the anonymous session id.
+	Header = "X-Session-Id"
+	// Variable is the graphql variable carrying the anonymous session id,
+	// it is used if the header is not sent.
+	Variable = "sessionId"
+	// DeviceHeader is the http header carrying the device id of the client, it identifies the voter if it's
+	// signed by the server, the issued device id is sent back in the same response header.
+	DeviceHeader = "X-Device-Id"
+	// DeviceVariable is the graphql variable carrying the device id, it is used if the header is not sent.
+	DeviceVariable = "deviceId"
+	// MaxIDLength is the max length of the session id and the device id.
+	MaxIDLength = 64
+
+	// deviceIDBytes and deviceSignatureBytes are the random bytes and the HMAC bytes of the issued device ids,
+	// which are "<hex id>-<hex signature>".
+	deviceIDBytes        = 12
+	deviceSignatureBytes = 16
+)
+
+type idKey struct{}
+
+type deviceIDKey struct{}
+
+type deviceIssuerKey struct{}
+
+// Valid reports whether id is a session id or a device id, which is 1 to 64 letters, digits, dashes or underscores.
+func Valid(id string) bool {
+	if id == "" || len(id) > MaxIDLength {
+		return false
+	}
+	for _, c := range id {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '-', c == '_':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// WithID returns a copy of ctx carrying the session id, ctx is returned as it is if id is not valid
+// since the sessions are tracked on a best effort basis.
+func WithID(ctx context.Context, id string) context.Context {
+	if !Valid(id) {
+		return ctx
+	}
+	return context.WithValue(ctx, idKey{}, id)
+}
+
+// IDFromContext returns the session id carried by ctx, it is empty for the untracked requests.
+func IDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(idKey{}).(string)
+	return id
+}
+
+// WithDeviceID returns a copy of ctx carrying the device id, ctx is returned as it is if id is not valid.
+func WithDeviceID(ctx context.Context, id string) context.Context {
+	if !Valid(id) {
+		return ctx
+	}
+	return context.WithValue(ctx, deviceIDKey{}, id)
+}
+
+// DeviceIDFromContext returns the device id carried by ctx, it is empty if the client didn't send it.
+func DeviceIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(deviceIDKey{}).(string)
+	return id
+}
+
+// NewDeviceID returns a random device id signed by the secret.
+func NewDeviceID(secret string) (string, error) {
+	b := make([]byte, deviceIDBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	id := hex.EncodeToString(b)
+	return id + "-" + deviceSignature(secret, id), nil
+}
+
+// VerifyDeviceID reports whether the device id is issued by NewDeviceID with the secret.
+func VerifyDeviceID(secret, deviceID string) bool {
+	i := strings.IndexByte(deviceID, '-')
+	if secret == "" || i < 0 {
+		return false
+	}
+	return hmac.Equal([]byte(deviceID[i+1:]), []byte(deviceSignature(secret, deviceID[:i])))
+}
+
+func deviceSignature(secret, id string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(id))
+	return hex.EncodeToString(mac.Sum(nil)[:deviceSignatureBytes])
+}
+
+// WithDeviceIssuer returns a copy of ctx carrying the function sending an issued device id back to the client,
+// such as by the response header. Only the first issued id of the request is sent.
+func WithDeviceIssuer(ctx context.Context, issue func(deviceID string)) context.Context {
+	var once sync.Once
+	return context.WithValue(ctx, deviceIssuerKey{}, func(deviceID string) {
+		once.Do(func() { issue(deviceID) })
+	})
+}
+
+// Voter returns the identity of the voter, which is the authenticated principal, the device id signed by
+// the deviceSecret or the remote ip in order. The self declared device ids are not trusted, so a device id
+// is issued to the client without a signed one if the deviceSecret is set, the votes of a client ignoring it
+// are capped by its remote ip. It is empty if none is known.
+func Voter(ctx context.Context, remoteIP, deviceSecret string) string {
+	if p := auth.PrincipalFromContext(ctx); p != nil {
+		return "principal:" + p.Name
+	}
+	if deviceSecret != "" {
+		if id := DeviceIDFromContext(ctx); VerifyDeviceID(deviceSecret, id) {
+			return "device:" + id
+		}
+		if issue, ok := ctx.Value(deviceIssuerKey{}).(func(string)); ok {
+			if id, err := NewDeviceID(deviceSecret); err == nil {
+				issue(id)
+				return "device:" + id
+			}
+		}
+	}
+	if remoteIP != "" {
+		return "ip:" + remoteIP
+	}
+	return ""
+}
+
+// FromRequest returns a copy of the request context carrying the session id and the device id of the headers.
+func FromRequest(r *http.Request) context.Context {
+	return FromHeader(r.Context(), r.Header)
+}
+
+// FromHeader returns a copy of ctx carrying the session id and the device id of the headers.
+func FromHeader(ctx context.Context, header http.Header) context.Context {
+	return WithDeviceID(WithID(ctx, header.Get(Header)), header.Get(DeviceHeader))
+}
+
+// FromVariables returns a copy of ctx carrying the session id and the device id of the graphql variables,
+// the ids already carried by ctx are preferred.
+func FromVariables(ctx context.Context, variables map[string]interface{}) context.Context {
+	if IDFromContext(ctx) == "" {
+		id, _ := variables[Variable].(string)
+		ctx = WithID(ctx, id)
+	}
+	if DeviceIDFromContext(ctx) == "" {
+		id, _ := variables[DeviceVariable].(string)
+		ctx = WithDeviceID(ctx, id)
+	}
+	return ctx
+}
@@ -0,0 +1,332 @@
+package session
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/honestbee/Zen/auth"
+)
+
+func TestValid(t *testing.T) {
+	testCases := [...]struct {
+		description string
+		input       string
+		expect      bool
+	}{
+		{
+			description: "testing uuid case",
+			input:       "0b6c4f1e-2b7a-4c1d-9a57-3d1c1f0e8a42",
+			expect:      true,
+		},
+		{
+			description: "testing underscore case",
+			input:       "anon_42",
+			expect:      true,
+		},
+		{
+			description: "testing max length case",
+			input:       strings.Repeat("a", MaxIDLength),
+			expect:      true,
+		},
+		{
+			description: "testing empty case",
+			input:       "",
+			expect:      false,
+		},
+		{
+			description: "testing too long case",
+			input:       strings.Repeat("a", MaxIDLength+1),
+			expect:      false,
+		},
+		{
+			description: "testing separator case",
+			input:       "anon|42",
+			expect:      false,
+		},
+		{
+			description: "testing whitespace case",
+			input:       "anon 42",
+			expect:      false,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			if actual := Valid(tt.input); actual != tt.expect {
+				t.Errorf("[%s] expect:%v, actual:%v", tt.description, tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestFromRequest(t *testing.T) {
+	testCases := [...]struct {
+		description string
+		header      string
+		expect      string
+	}{
+		{
+			description: "testing header case",
+			header:      "anon-42",
+			expect:      "anon-42",
+		},
+		{
+			description: "testing no header case",
+			header:      "",
+			expect:      "",
+		},
+		{
+			description: "testing invalid header case",
+			header:      "<script>",
+			expect:      "",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/api/articles/1", nil)
+			if tt.header != "" {
+				r.Header.Set(Header, tt.header)
+			}
+			if actual := IDFromContext(FromRequest(r)); actual != tt.expect {
+				t.Errorf("[%s] expect:%q, actual:%q", tt.description, tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestFromVariables(t *testing.T) {
+	testCases := [...]struct {
+		description string
+		ctx         context.Context
+		variables   map[string]interface{}
+		expect      string
+	}{
+		{
+			description: "testing variable case",
+			ctx:         context.Background(),
+			variables:   map[string]interface{}{Variable: "anon-42"},
+			expect:      "anon-42",
+		},
+		{
+			description: "testing header preferred case",
+			ctx:         WithID(context.Background(), "anon-header"),
+			variables:   map[string]interface{}{Variable: "anon-42"},
+			expect:      "anon-header",
+		},
+		{
+			description: "testing no variables case",
+			ctx:         context.Background(),
+			variables:   nil,
+			expect:      "",
+		},
+		{
+			description: "testing non string variable case",
+			ctx:         context.Background(),
+			variables:   map[string]interface{}{Variable: 42},
+			expect:      "",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			if actual := IDFromContext(FromVariables(tt.ctx, tt.variables)); actual != tt.expect {
+				t.Errorf("[%s] expect:%q, actual:%q", tt.description, tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestDeviceID(t *testing.T) {
+	id, err := NewDeviceID("secret")
+	if err != nil {
+		t.Fatalf("expect no error, actual:%v", err)
+	}
+	if !Valid(id) {
+		t.Errorf("expect the device id %q is valid", id)
+	}
+
+	testCases := [...]struct {
+		description string
+		secret      string
+		input       string
+		expect      bool
+	}{
+		{
+			description: "testing issued device id case",
+			secret:      "secret",
+			input:       id,
+			expect:      true,
+		},
+		{
+			description: "testing other secret case",
+			secret:      "other",
+			input:       id,
+			expect:      false,
+		},
+		{
+			description: "testing empty secret case",
+			secret:      "",
+			input:       id,
+			expect:      false,
+		},
+		{
+			description: "testing self declared device id case",
+			secret:      "secret",
+			input:       "device-42",
+			expect:      false,
+		},
+		{
+			description: "testing unsigned device id case",
+			secret:      "secret",
+			input:       "device42",
+			expect:      false,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			if actual := VerifyDeviceID(tt.secret, tt.input); actual != tt.expect {
+				t.Errorf("[%s] expect:%v, actual:%v", tt.description, tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestVoter(t *testing.T) {
+	signed, _ := NewDeviceID("secret")
+
+	testCases := [...]struct {
+		description  string
+		principal    *auth.Principal
+		header       http.Header
+		variables    map[string]interface{}
+		secret       string
+		remoteIP     string
+		expect       string
+		expectIssued bool
+	}{
+		{
+			description: "testing principal case",
+			principal:   &auth.Principal{Name: "mobile"},
+			header:      http.Header{DeviceHeader: []string{signed}},
+			secret:      "secret",
+			remoteIP:    "10.0.0.1",
+			expect:      "principal:mobile",
+		},
+		{
+			description: "testing signed device header case",
+			header:      http.Header{DeviceHeader: []string{signed}},
+			secret:      "secret",
+			remoteIP:    "10.0.0.1",
+			expect:      "device:" + signed,
+		},
+		{
+			description: "testing signed device variable case",
+			variables:   map[string]interface{}{DeviceVariable: signed},
+			secret:      "secret",
+			remoteIP:    "10.0.0.1",
+			expect:      "device:" + signed,
+		},
+		{
+			description:  "testing self declared device header case",
+			header:       http.Header{DeviceHeader: []string{"device-42"}},
+			secret:       "secret",
+			remoteIP:     "10.0.0.1",
+			expectIssued: true,
+		},
+		{
+			description: "testing device header without secret case",
+			header:      http.Header{DeviceHeader: []string{signed}},
+			remoteIP:    "10.0.0.1",
+			expect:      "ip:10.0.0.1",
+		},
+		{
+			description: "testing remote ip case",
+			remoteIP:    "10.0.0.1",
+			expect:      "ip:10.0.0.1",
+		},
+		{
+			description: "testing unknown case",
+			expect:      "",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			ctx := FromVariables(FromHeader(context.Background(), tt.header), tt.variables)
+			if tt.principal != nil {
+				ctx = auth.WithPrincipal(ctx, tt.principal)
+			}
+			issued := ""
+			ctx = WithDeviceIssuer(ctx, func(id string) { issued = id })
+
+			actual := Voter(ctx, tt.remoteIP, tt.secret)
+			if tt.expectIssued {
+				if !VerifyDeviceID(tt.secret, issued) || actual != "device:"+issued {
+					t.Errorf("[%s] expect the issued device id, actual:%q, issued:%q", tt.description, actual, issued)
+				}
+				return
+			}
+			if actual != tt.expect {
+				t.Errorf("[%s] expect:%q, actual:%q", tt.description, tt.expect, actual)
+			}
+			if issued != "" {
+				t.Errorf("[%s] expect no device id issued, actual:%q", tt.description, issued)
+			}
+		})
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	testCases := [...]struct {
+		description  string
+		md           metadata.MD
+		expect       string
+		expectDevice string
+	}{
+		{
+			description: "testing metadata case",
+			md:          metadata.Pairs(Metadata, "anon-42"),
+			expect:      "anon-42",
+		},
+		{
+			description:  "testing device metadata case",
+			md:           metadata.Pairs(Metadata, "anon-42", DeviceMetadata, "device-42"),
+			expect:       "anon-42",
+			expectDevice: "device-42",
+		},
+		{
+			description: "testing no metadata case",
+			md:          nil,
+			expect:      "",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			actual, device := "", ""
+			UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				actual, device = IDFromContext(ctx), DeviceIDFromContext(ctx)
+				return nil, nil
+			})
+			if actual != tt.expect {
+				t.Errorf("[%s] expect:%q, actual:%q", tt.description, tt.expect, actual)
+			}
+			if device != tt.expectDevice {
+				t.Errorf("[%s] expect device:%q, actual:%q", tt.description, tt.expectDevice, device)
+			}
+		})
+	}
+}
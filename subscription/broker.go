@@ -0,0 +1,147 @@
+package subscription
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/models"
+)
+
+const (
+	// reconnectDelay is the waiting time before subscribing the events again after the subscription ends.
+	reconnectDelay = time.Second
+)
+
+var (
+	// ErrBrokerClosed means the broker has been closed.
+	ErrBrokerClosed = errors.New("subscription: broker is closed")
+)
+
+// Broker fans out the content change events to the subscribers of this replica,
+// it keeps one events subscription per replica and subscribes again if it ends.
+type Broker struct {
+	logger     *zerolog.Logger
+	service    models.Service
+	bufferSize int
+
+	mu          sync.Mutex
+	closed      bool
+	subscribers map[chan *models.Event]struct{}
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// New returns a Broker instance and starts receiving the events.
+func New(conf *config.Config, logger *zerolog.Logger, service models.Service) (*Broker, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	b := &Broker{
+		logger:      logger,
+		service:     service,
+		bufferSize:  conf.Subscription.BufferSize,
+		subscribers: make(map[chan *models.Event]struct{}),
+		cancel:      cancel,
+		done:        make(chan struct{}),
+	}
+
+	go b.run(ctx)
+
+	return b, nil
+}
+
+func (b *Broker) run(ctx context.Context) {
+	defer close(b.done)
+
+	for {
+		events, err := b.service.SubscribeEvents(ctx)
+		if err != nil {
+			b.logger.Error().Err(err).Msgf("subscription: [run] service.SubscribeEvents failed")
+		} else {
+			for event := range events {
+				b.publish(event)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(reconnectDelay):
+			b.logger.Info().Msgf("subscription: [run] subscribing events again")
+		}
+	}
+}
+
+// publish sends the event to all the subscribers, the event is dropped for a subscriber
+// whose buffer is full so that a slow subscriber does not block the others.
+func (b *Broker) publish(event *models.Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for subscriber := range b.subscribers {
+		select {
+		case subscriber <- event:
+		default:
+			b.logger.Warn().Fields(map[string]interface{}{
+				"type":        event.Type,
+				"countryCode": event.CountryCode,
+				"locale":      event.Locale,
+			}).Msgf("subscription: [publish] subscriber is slow, event dropped")
+		}
+	}
+}
+
+// Subscribe returns the events received after subscribing until ctx is done,
+// the returned channel is closed when ctx is done or the broker is closed.
+func (b *Broker) Subscribe(ctx context.Context) (<-chan *models.Event, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil, ErrBrokerClosed
+	}
+
+	events := make(chan *models.Event, b.bufferSize)
+	b.subscribers[events] = struct{}{}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-b.done:
+		}
+		b.unsubscribe(events)
+	}()
+
+	return events, nil
+}
+
+func (b *Broker) unsubscribe(events chan *models.Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.subscribers[events]; ok {
+		delete(b.subscribers, events)
+		close(events)
+	}
+}
+
+// Close stops receiving the events and ends all the subscriptions.
+func (b *Broker) Close() error {
+	b.cancel()
+	<-b.done
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for events := range b.subscribers {
+		delete(b.subscribers, events)
+		close(events)
+	}
+	return nil
+}
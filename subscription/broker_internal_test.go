@@ -0,0 +1,115 @@
+package subscription
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+	"github.com/rs/zerolog"
+
+	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/models"
+)
+
+var logger = zerolog.New(ioutil.Discard)
+
+func newTestBroker(t *testing.T, bufferSize int) (*Broker, *models.MockModels) {
+	mockServ := models.NewMockService()
+	b, err := New(&config.Config{
+		Subscription: &config.Subscription{BufferSize: bufferSize},
+	}, &logger, mockServ)
+	if err != nil {
+		t.Fatalf("new broker failed:%v", err)
+	}
+	return b, mockServ
+}
+
+func TestBrokerSubscribe(t *testing.T) {
+	b, mockServ := newTestBroker(t, 2)
+	defer b.Close()
+
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	events1, err := b.Subscribe(ctx1)
+	if err != nil {
+		t.Fatalf("subscribe failed:%v", err)
+	}
+	events2, err := b.Subscribe(context.Background())
+	if err != nil {
+		t.Fatalf("subscribe failed:%v", err)
+	}
+
+	// The broker subscribes the events of the mock in background, publish until the event is received.
+	event := &models.Event{Type: models.EventCategoriesChanged, CountryCode: "sg", Locale: "en-us", IDs: []int{1}}
+	var actual1 *models.Event
+	for i := 0; i < 100 && actual1 == nil; i++ {
+		mockServ.PublishEvent(context.Background(), event)
+		select {
+		case actual1 = <-events1:
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	if diff := deep.Equal(event, actual1); diff != nil {
+		t.Errorf("[subscriber 1] %v", diff)
+	}
+	select {
+	case actual2 := <-events2:
+		if diff := deep.Equal(event, actual2); diff != nil {
+			t.Errorf("[subscriber 2] %v", diff)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("[subscriber 2] expect an event, actual none")
+	}
+
+	cancel1()
+	select {
+	case _, ok := <-events1:
+		if ok {
+			t.Errorf("expect the canceled subscription closed, actual an event")
+		}
+	case <-time.After(time.Second):
+		t.Errorf("expect the canceled subscription closed, actual not")
+	}
+}
+
+func TestBrokerSlowSubscriber(t *testing.T) {
+	b, _ := newTestBroker(t, 1)
+	defer b.Close()
+
+	events, err := b.Subscribe(context.Background())
+	if err != nil {
+		t.Fatalf("subscribe failed:%v", err)
+	}
+
+	b.publish(&models.Event{Type: models.EventArticleUpdated, IDs: []int{1}})
+	b.publish(&models.Event{Type: models.EventArticleUpdated, IDs: []int{2}})
+
+	if actual := <-events; actual.IDs[0] != 1 {
+		t.Errorf("expect the first event, actual:%v", actual.IDs)
+	}
+	select {
+	case actual := <-events:
+		t.Errorf("expect the second event dropped, actual:%v", actual.IDs)
+	default:
+	}
+}
+
+func TestBrokerClose(t *testing.T) {
+	b, _ := newTestBroker(t, 1)
+
+	events, err := b.Subscribe(context.Background())
+	if err != nil {
+		t.Fatalf("subscribe failed:%v", err)
+	}
+
+	if err = b.Close(); err != nil {
+		t.Errorf("expect no error, actual:%v", err)
+	}
+	if _, ok := <-events; ok {
+		t.Errorf("expect the subscription closed, actual an event")
+	}
+	if _, err = b.Subscribe(context.Background()); err != ErrBrokerClosed {
+		t.Errorf("expect ErrBrokerClosed, actual:%v", err)
+	}
+}
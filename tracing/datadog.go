@@ -0,0 +1,147 @@
+package tracing
+
+import (
+	"encoding/binary"
+	"strconv"
+	"strings"
+	"sync"
+
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace"
+	ddtracer "gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+
+	"github.com/honestbee/Zen/config"
+)
+
+// kindTags are the span.kind tags of the kinds.
+var kindTags = map[Kind]string{
+	KindInternal: "internal",
+	KindServer:   "server",
+	KindClient:   "client",
+	KindProducer: "producer",
+	KindConsumer: "consumer",
+}
+
+// datadogTracer sends the spans to the datadog agent, the W3C span contexts are converted into
+// the datadog ones by the lower 64 bits of the trace ids.
+type datadogTracer struct {
+	service string
+}
+
+func newDatadog(conf *config.Config) *datadogTracer {
+	ddtracer.Start(
+		ddtracer.WithServiceName(conf.Tracing.ServiceName),
+		ddtracer.WithGlobalTag("env", conf.Datadog.Env),
+		ddtracer.WithAgentAddr(conf.Datadog.Host+":"+conf.Datadog.Port),
+		ddtracer.WithDebugMode(conf.Datadog.Debug),
+	)
+
+	return &datadogTracer{service: conf.Tracing.ServiceName}
+}
+
+// Start implements Tracer, the service of the span is suffixed with the component.
+func (t *datadogTracer) Start(operation string, parent SpanContext, opts *StartOptions) Span {
+	service := t.service
+	if opts.Service != "" {
+		service += "-" + opts.Service
+	}
+
+	ddopts := []ddtrace.StartSpanOption{
+		ddtracer.ServiceName(service),
+		ddtracer.Tag("span.kind", kindTags[opts.Kind]),
+	}
+	if opts.Resource != "" {
+		ddopts = append(ddopts, ddtracer.ResourceName(opts.Resource))
+	}
+	for k, v := range opts.Tags {
+		ddopts = append(ddopts, ddtracer.Tag(k, v))
+	}
+	if len(opts.Links) > 0 {
+		links := make([]string, 0, len(opts.Links))
+		for _, link := range opts.Links {
+			links = append(links, link.Traceparent())
+		}
+		ddopts = append(ddopts, ddtracer.Tag("links", strings.Join(links, ",")))
+	}
+
+	s := &datadogSpan{sampled: true}
+	if parent.IsValid() {
+		if p, ok := opts.parent.(*datadogSpan); ok {
+			ddopts = append(ddopts, ddtracer.ChildOf(p.span.Context()))
+		} else if ddctx, ok := datadogContext(parent); ok {
+			ddopts = append(ddopts, ddtracer.ChildOf(ddctx))
+		}
+		copy(s.traceHigh[:], parent.TraceID[:8])
+		s.sampled = parent.Sampled
+	}
+	s.span = ddtracer.StartSpan(operation, ddopts...)
+	return s
+}
+
+// Stop implements Tracer.
+func (t *datadogTracer) Stop() error {
+	ddtracer.Stop()
+	return nil
+}
+
+// datadogContext returns the datadog span context of sc.
+func datadogContext(sc SpanContext) (ddtrace.SpanContext, bool) {
+	priority := "0"
+	if sc.Sampled {
+		priority = "1"
+	}
+	ddctx, err := ddtracer.Extract(ddtracer.TextMapCarrier{
+		ddtracer.DefaultTraceIDHeader:  strconv.FormatUint(binary.BigEndian.Uint64(sc.TraceID[8:]), 10),
+		ddtracer.DefaultParentIDHeader: strconv.FormatUint(binary.BigEndian.Uint64(sc.SpanID[:]), 10),
+		ddtracer.DefaultPriorityHeader: priority,
+	})
+	return ddctx, err == nil
+}
+
+// datadogSpan keeps the upper 64 bits of the W3C trace id, which datadog doesn't keep, to propagate the same trace id.
+type datadogSpan struct {
+	span      ddtrace.Span
+	traceHigh [8]byte
+	sampled   bool
+
+	mu       sync.Mutex
+	err      error
+	finished bool
+}
+
+// Context implements Span.
+func (s *datadogSpan) Context() SpanContext {
+	sc := SpanContext{Sampled: s.sampled}
+	copy(sc.TraceID[:8], s.traceHigh[:])
+	binary.BigEndian.PutUint64(sc.TraceID[8:], s.span.Context().TraceID())
+	binary.BigEndian.PutUint64(sc.SpanID[:], s.span.Context().SpanID())
+	return sc
+}
+
+// SetTag implements Span.
+func (s *datadogSpan) SetTag(key string, value interface{}) {
+	s.span.SetTag(key, value)
+}
+
+// SetError implements Span.
+func (s *datadogSpan) SetError(err error) {
+	if err == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+// Finish implements Span.
+func (s *datadogSpan) Finish() {
+	s.mu.Lock()
+	if s.finished {
+		s.mu.Unlock()
+		return
+	}
+	s.finished = true
+	err := s.err
+	s.mu.Unlock()
+
+	s.span.Finish(ddtracer.WithError(err))
+}
@@ -0,0 +1,58 @@
+package tracing
+
+import (
+	"context"
+
+	gographqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/graph-gophers/graphql-go/introspection"
+	"github.com/graph-gophers/graphql-go/trace"
+)
+
+// GraphQLTracer spans the graphql operations and their non trivial fields.
+type GraphQLTracer struct{}
+
+// NewGraphQLTracer returns a GraphQLTracer instance.
+func NewGraphQLTracer() *GraphQLTracer {
+	return &GraphQLTracer{}
+}
+
+// TraceQuery implements trace.Tracer, the query string and the variables are not tagged.
+func (t *GraphQLTracer) TraceQuery(ctx context.Context, queryString string, operationName string, variables map[string]interface{}, varTypes map[string]*introspection.Type) (context.Context, trace.TraceQueryFinishFunc) {
+	resource := operationName
+	if resource == "" {
+		resource = "anonymous"
+	}
+
+	span, ctx := StartSpan(ctx, "graphql.query",
+		WithService("graphql"),
+		WithResource(resource),
+		WithTag("graphql.operation.name", operationName),
+	)
+	return ctx, func(errs []*gographqlerrors.QueryError) {
+		if len(errs) > 0 {
+			span.SetTag("graphql.errors", len(errs))
+			span.SetError(errs[0])
+		}
+		span.Finish()
+	}
+}
+
+// TraceField implements trace.Tracer, the trivial fields are not spanned.
+func (t *GraphQLTracer) TraceField(ctx context.Context, label, typeName, fieldName string, trivial bool, args map[string]interface{}) (context.Context, trace.TraceFieldFinishFunc) {
+	if trivial {
+		return ctx, func(*gographqlerrors.QueryError) {}
+	}
+
+	span, ctx := StartSpan(ctx, "graphql.field",
+		WithService("graphql"),
+		WithResource(typeName+"."+fieldName),
+		WithTag("graphql.type", typeName),
+		WithTag("graphql.field", fieldName),
+	)
+	return ctx, func(err *gographqlerrors.QueryError) {
+		if err != nil {
+			span.SetError(err)
+		}
+		span.Finish()
+	}
+}
@@ -0,0 +1,65 @@
+package tracing
+
+import (
+	"context"
+
+	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/honestbee/Zen/errs"
+)
+
+// UnaryServerInterceptor returns the interceptor spanning the unary calls,
+// the spans continue the traces of the traceparent metadata.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		span, ctx := startGRPCSpan(ctx, info.FullMethod)
+		defer span.Finish()
+
+		resp, err := handler(ctx, req)
+		finishGRPCSpan(span, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor returns the interceptor spanning the streams,
+// the spans continue the traces of the traceparent metadata.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		span, ctx := startGRPCSpan(ss.Context(), info.FullMethod)
+		defer span.Finish()
+
+		wrapped := grpcmiddleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+		err := handler(srv, wrapped)
+		finishGRPCSpan(span, err)
+		return err
+	}
+}
+
+func startGRPCSpan(ctx context.Context, method string) (Span, context.Context) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = Extract(ctx, MetadataCarrier(md))
+	}
+	return StartSpan(ctx, "grpc.server",
+		WithKind(KindServer),
+		WithService("grpc"),
+		WithResource(method),
+		WithTag("rpc.system", "grpc"),
+		WithTag("rpc.method", method),
+	)
+}
+
+func finishGRPCSpan(span Span, err error) {
+	code := status.Code(err)
+	if er, ok := err.(*errs.Error); ok {
+		code = er.GRPCStatus
+	}
+	span.SetTag("grpc.code", code.String())
+	if code != codes.OK {
+		span.SetError(err)
+	}
+}
@@ -0,0 +1,62 @@
+package tracing
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/pkg/errors"
+)
+
+// Handle wraps h in a server span of the route, the span continues the trace of the traceparent header.
+// The websocket upgrades are not spanned since they last as long as the connections,
+// their requests only carry the remote span context.
+func Handle(route string, h httprouter.Handle) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		ctx := Extract(r.Context(), HeaderCarrier(r.Header))
+		if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+			h(w, r.WithContext(ctx), ps)
+			return
+		}
+
+		span, ctx := StartSpan(ctx, "http.request",
+			WithKind(KindServer),
+			WithService("http"),
+			WithResource(r.Method+" "+route),
+			WithTag("http.method", r.Method),
+			WithTag("http.route", route),
+		)
+		defer span.Finish()
+
+		sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+		h(sw, r.WithContext(ctx), ps)
+
+		span.SetTag("http.status_code", sw.status)
+		if sw.status >= http.StatusInternalServerError {
+			span.SetError(errors.Errorf("tracing: [Handle] route[%s] status:%d", route, sw.status))
+		}
+	}
+}
+
+// statusWriter records the response status, it is 200 if the handler doesn't write the header.
+type statusWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+// WriteHeader implements http.ResponseWriter.
+func (w *statusWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.status = status
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+// Flush implements http.Flusher if the wrapped writer does.
+func (w *statusWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
@@ -0,0 +1,189 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/honestbee/Zen/config"
+)
+
+const (
+	// otlpQueueSize is the max number of the finished spans waiting for the export, the later ones are dropped.
+	otlpQueueSize = 2048
+	// otlpBatchSize is the max number of the spans exported by a request.
+	otlpBatchSize = 512
+	// otlpTimeout is the timeout of an export request, and of the flush on Stop.
+	otlpTimeout = 10 * time.Second
+	// otlpScopeName is the instrumentation scope of the spans.
+	otlpScopeName = "github.com/honestbee/Zen/tracing"
+)
+
+// otlpTracer exports the sampled spans to an OTLP/HTTP collector by the OpenTelemetry SDK.
+type otlpTracer struct {
+	provider *sdktrace.TracerProvider
+	tracer   trace.Tracer
+}
+
+func newOTLP(conf *config.Config, logger *zerolog.Logger) (*otlpTracer, error) {
+	if conf.Tracing.OTLPEndpoint == "" {
+		return nil, errors.New("tracing: [newOTLP] otlp endpoint is empty")
+	}
+	if conf.Tracing.SampleRatio < 0 || conf.Tracing.SampleRatio > 1 {
+		return nil, errors.Errorf("tracing: [newOTLP] sample ratio:%v is not between 0 and 1", conf.Tracing.SampleRatio)
+	}
+	headers, err := parseHeaders(conf.Tracing.OTLPHeaders)
+	if err != nil {
+		return nil, errors.Wrapf(err, "tracing: [newOTLP] parse headers failed")
+	}
+	interval := time.Duration(conf.Tracing.OTLPFlushIntervalSec) * time.Second
+	if interval <= 0 {
+		return nil, errors.Errorf("tracing: [newOTLP] flush interval sec:%d is not positive", conf.Tracing.OTLPFlushIntervalSec)
+	}
+
+	exporter, err := otlptracehttp.New(context.Background(),
+		otlptracehttp.WithEndpointURL(conf.Tracing.OTLPEndpoint),
+		otlptracehttp.WithHeaders(headers),
+		otlptracehttp.WithTimeout(otlpTimeout),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "tracing: [newOTLP] endpoint[%s] new otlp exporter failed", conf.Tracing.OTLPEndpoint)
+	}
+
+	// The SDK reports the failed exports to the global error handler.
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		logger.Warn().Err(err).Msg("tracing: [newOTLP] otlp export failed")
+	}))
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter,
+			sdktrace.WithBatchTimeout(interval),
+			sdktrace.WithMaxQueueSize(otlpQueueSize),
+			sdktrace.WithMaxExportBatchSize(otlpBatchSize),
+			sdktrace.WithExportTimeout(otlpTimeout),
+		),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", conf.Tracing.ServiceName))),
+		// The root spans are sampled by the trace id, the children follow their parents.
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(conf.Tracing.SampleRatio))),
+	)
+
+	return &otlpTracer{
+		provider: provider,
+		tracer:   provider.Tracer(otlpScopeName),
+	}, nil
+}
+
+// parseHeaders parses the comma separated key=value pairs.
+func parseHeaders(s string) (map[string]string, error) {
+	headers := make(map[string]string)
+	for _, pair := range strings.Split(s, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" {
+			return nil, errors.Errorf("tracing: [parseHeaders] header:%q is not a key=value pair", pair)
+		}
+		headers[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+	}
+	return headers, nil
+}
+
+// Start implements Tracer, the span is named by the resource if it is set.
+func (t *otlpTracer) Start(operation string, parent SpanContext, opts *StartOptions) Span {
+	name := operation
+	if opts.Resource != "" {
+		name = opts.Resource
+	}
+
+	ctx := context.Background()
+	if parent.IsValid() {
+		if p, ok := opts.parent.(*otlpSpan); ok {
+			ctx = trace.ContextWithSpan(ctx, p.span)
+		} else {
+			ctx = trace.ContextWithRemoteSpanContext(ctx, parent.otel())
+		}
+	}
+
+	attrs := []attribute.KeyValue{attribute.String("operation.name", operation)}
+	for k, v := range opts.Tags {
+		attrs = append(attrs, keyValue(k, v))
+	}
+	links := make([]trace.Link, 0, len(opts.Links))
+	for _, link := range opts.Links {
+		links = append(links, trace.Link{SpanContext: link.otel()})
+	}
+
+	_, span := t.tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKind(opts.Kind)),
+		trace.WithAttributes(attrs...),
+		trace.WithLinks(links...),
+	)
+	return &otlpSpan{span: span}
+}
+
+// Stop implements Tracer, it exports the queued spans.
+func (t *otlpTracer) Stop() error {
+	ctx, cancel := context.WithTimeout(context.Background(), otlpTimeout)
+	defer cancel()
+
+	return errors.Wrapf(t.provider.Shutdown(ctx), "tracing: [Stop] otlp tracer provider shutdown failed")
+}
+
+// otlpSpan is a span exported by the otlpTracer.
+type otlpSpan struct {
+	span trace.Span
+}
+
+// Context implements Span.
+func (s *otlpSpan) Context() SpanContext {
+	return spanContextOf(s.span.SpanContext())
+}
+
+// SetTag implements Span.
+func (s *otlpSpan) SetTag(key string, value interface{}) {
+	s.span.SetAttributes(keyValue(key, value))
+}
+
+// SetError implements Span.
+func (s *otlpSpan) SetError(err error) {
+	if err == nil {
+		return
+	}
+	s.span.RecordError(err)
+	s.span.SetStatus(codes.Error, err.Error())
+}
+
+// Finish implements Span, the unsampled spans are not exported.
+func (s *otlpSpan) Finish() {
+	s.span.End()
+}
+
+// keyValue returns the attribute of the value, the values of the other types are formatted as strings.
+func keyValue(key string, value interface{}) attribute.KeyValue {
+	switch v := value.(type) {
+	case string:
+		return attribute.String(key, v)
+	case bool:
+		return attribute.Bool(key, v)
+	case int:
+		return attribute.Int(key, v)
+	case int64:
+		return attribute.Int64(key, v)
+	case float64:
+		return attribute.Float64(key, v)
+	default:
+		return attribute.String(key, fmt.Sprint(v))
+	}
+}
@@ -0,0 +1,101 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+// TraceparentHeader is the W3C trace context header, see https://www.w3.org/TR/trace-context/.
+const TraceparentHeader = "traceparent"
+
+// propagator propagates the span contexts in the W3C trace context headers.
+var propagator = propagation.TraceContext{}
+
+// Carrier carries the propagated span context.
+type Carrier interface {
+	Get(key string) string
+	Set(key, value string)
+	Keys() []string
+}
+
+// HeaderCarrier carries the span context in the http headers.
+type HeaderCarrier http.Header
+
+// Get implements Carrier.
+func (c HeaderCarrier) Get(key string) string { return http.Header(c).Get(key) }
+
+// Set implements Carrier.
+func (c HeaderCarrier) Set(key, value string) { http.Header(c).Set(key, value) }
+
+// Keys implements Carrier.
+func (c HeaderCarrier) Keys() []string { return propagation.HeaderCarrier(c).Keys() }
+
+// MetadataCarrier carries the span context in the gRPC metadata.
+type MetadataCarrier metadata.MD
+
+// Get implements Carrier.
+func (c MetadataCarrier) Get(key string) string {
+	if values := metadata.MD(c).Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// Set implements Carrier.
+func (c MetadataCarrier) Set(key, value string) { metadata.MD(c).Set(key, value) }
+
+// Keys implements Carrier.
+func (c MetadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Traceparent returns the traceparent header value of sc, it is empty if sc is invalid.
+func (sc SpanContext) Traceparent() string {
+	c := propagation.MapCarrier{}
+	propagator.Inject(trace.ContextWithRemoteSpanContext(context.Background(), sc.otel()), c)
+	return c.Get(TraceparentHeader)
+}
+
+// otel returns the OpenTelemetry span context of sc.
+func (sc SpanContext) otel() trace.SpanContext {
+	var flags trace.TraceFlags
+	if sc.Sampled {
+		flags = trace.FlagsSampled
+	}
+	return trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    sc.TraceID,
+		SpanID:     sc.SpanID,
+		TraceFlags: flags,
+		Remote:     true,
+	})
+}
+
+// spanContextOf returns the span context of the OpenTelemetry span context.
+func spanContextOf(sc trace.SpanContext) SpanContext {
+	return SpanContext{TraceID: sc.TraceID(), SpanID: sc.SpanID(), Sampled: sc.IsSampled()}
+}
+
+// Extract returns a copy of ctx carrying the remote span context of c,
+// ctx is returned as is if c has no valid traceparent.
+func Extract(ctx context.Context, c Carrier) context.Context {
+	sc := trace.SpanContextFromContext(propagator.Extract(context.Background(), c))
+	if !sc.IsValid() {
+		return ctx
+	}
+	return context.WithValue(ctx, remoteKey{}, spanContextOf(sc))
+}
+
+// Inject sets the traceparent of the span context of ctx into c, nothing is set if it is invalid.
+func Inject(ctx context.Context, c Carrier) {
+	if sc := SpanContextFromContext(ctx); sc.IsValid() {
+		propagator.Inject(trace.ContextWithRemoteSpanContext(context.Background(), sc.otel()), c)
+	}
+}
@@ -0,0 +1,229 @@
+package tracing
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/honestbee/Zen/config"
+)
+
+// The exporters of the spans.
+const (
+	ExporterDatadog = "datadog"
+	ExporterOTLP    = "otlp"
+	ExporterNone    = "none"
+)
+
+// Kind is the role of a span in a trace, the values are the span kinds of OTLP.
+type Kind int
+
+// The kinds of the spans.
+const (
+	KindInternal Kind = iota + 1
+	KindServer
+	KindClient
+	KindProducer
+	KindConsumer
+)
+
+// SpanContext identifies a span across the process boundaries.
+type SpanContext struct {
+	TraceID [16]byte
+	SpanID  [8]byte
+	Sampled bool
+}
+
+// IsValid reports whether both of the trace id and the span id are set.
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID != [16]byte{} && sc.SpanID != [8]byte{}
+}
+
+// TraceIDString returns the hex trace id.
+func (sc SpanContext) TraceIDString() string {
+	return hex.EncodeToString(sc.TraceID[:])
+}
+
+// SpanIDString returns the hex span id.
+func (sc SpanContext) SpanIDString() string {
+	return hex.EncodeToString(sc.SpanID[:])
+}
+
+// Span is a timed operation of a trace.
+type Span interface {
+	// Context returns the span context propagated to the children of the span.
+	Context() SpanContext
+	// SetTag sets a key value pair on the span.
+	SetTag(key string, value interface{})
+	// SetError marks the span as failed by err, nil errors are ignored.
+	SetError(err error)
+	// Finish ends the span, the later calls are ignored.
+	Finish()
+}
+
+// Tracer starts the spans of a tracing backend.
+type Tracer interface {
+	// Start starts a span of operation, the parent is invalid for a root span.
+	Start(operation string, parent SpanContext, opts *StartOptions) Span
+	// Stop flushes the finished spans and stops the tracer.
+	Stop() error
+}
+
+// StartOptions are the options of a span.
+type StartOptions struct {
+	Kind Kind
+	// Resource is the accessed resource, such as the route or the method, it names the span if it is set.
+	Resource string
+	// Service is the component of the span, the backends with the services per span suffix their service with it.
+	Service string
+	Tags    map[string]interface{}
+	Links   []SpanContext
+	NewRoot bool
+
+	// parent is the span of the parent if it is started by this process.
+	parent Span
+}
+
+// StartOption sets a StartOptions field.
+type StartOption func(*StartOptions)
+
+// WithKind sets the kind of the span.
+func WithKind(kind Kind) StartOption {
+	return func(o *StartOptions) { o.Kind = kind }
+}
+
+// WithResource sets the resource of the span.
+func WithResource(resource string) StartOption {
+	return func(o *StartOptions) { o.Resource = resource }
+}
+
+// WithService sets the component of the span.
+func WithService(service string) StartOption {
+	return func(o *StartOptions) { o.Service = service }
+}
+
+// WithTag sets a tag of the span.
+func WithTag(key string, value interface{}) StartOption {
+	return func(o *StartOptions) {
+		if o.Tags == nil {
+			o.Tags = make(map[string]interface{})
+		}
+		o.Tags[key] = value
+	}
+}
+
+// WithLink links the span to sc, the invalid span contexts are ignored.
+func WithLink(sc SpanContext) StartOption {
+	return func(o *StartOptions) {
+		if sc.IsValid() {
+			o.Links = append(o.Links, sc)
+		}
+	}
+}
+
+// WithNewRoot starts a new trace instead of the child of the span in the context.
+func WithNewRoot() StartOption {
+	return func(o *StartOptions) { o.NewRoot = true }
+}
+
+var (
+	mu     sync.RWMutex
+	global Tracer = noopTracer{}
+)
+
+// New returns the tracer of the configured exporter, the datadog tracer is started only if datadog is enabled.
+func New(conf *config.Config, logger *zerolog.Logger) (Tracer, error) {
+	switch strings.ToLower(conf.Tracing.Exporter) {
+	case ExporterDatadog:
+		if !conf.Datadog.Enable {
+			return noopTracer{}, nil
+		}
+		return newDatadog(conf), nil
+	case ExporterOTLP:
+		t, err := newOTLP(conf, logger)
+		return t, errors.Wrapf(err, "tracing: [New] new otlp tracer failed")
+	case ExporterNone, "":
+		return noopTracer{}, nil
+	default:
+		return nil, errors.Errorf("tracing: [New] unknown exporter:%s", conf.Tracing.Exporter)
+	}
+}
+
+// SetTracer sets the tracer starting the spans, the spans are not recorded until it's set.
+func SetTracer(t Tracer) {
+	mu.Lock()
+	defer mu.Unlock()
+	global = t
+}
+
+func tracer() Tracer {
+	mu.RLock()
+	defer mu.RUnlock()
+	return global
+}
+
+type (
+	spanKey   struct{}
+	remoteKey struct{}
+)
+
+// StartSpan starts a span of operation as the child of the span in ctx, or the remote span extracted into ctx,
+// and returns the span with the context carrying it.
+func StartSpan(ctx context.Context, operation string, opts ...StartOption) (Span, context.Context) {
+	o := &StartOptions{Kind: KindInternal}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	var parent SpanContext
+	if !o.NewRoot {
+		parent = SpanContextFromContext(ctx)
+		o.parent = SpanFromContext(ctx)
+	}
+
+	span := tracer().Start(operation, parent, o)
+	return span, ContextWithSpan(ctx, span)
+}
+
+// ContextWithSpan returns a copy of ctx carrying span.
+func ContextWithSpan(ctx context.Context, span Span) context.Context {
+	return context.WithValue(ctx, spanKey{}, span)
+}
+
+// SpanFromContext returns the span carried by ctx, it is nil if there's none.
+func SpanFromContext(ctx context.Context) Span {
+	span, _ := ctx.Value(spanKey{}).(Span)
+	return span
+}
+
+// SpanContextFromContext returns the span context of the span carried by ctx, or the remote span context
+// extracted into ctx, it is invalid if there's neither.
+func SpanContextFromContext(ctx context.Context) SpanContext {
+	if span := SpanFromContext(ctx); span != nil {
+		return span.Context()
+	}
+	sc, _ := ctx.Value(remoteKey{}).(SpanContext)
+	return sc
+}
+
+// noopTracer records nothing, its spans keep the parent so that the remote span context is still propagated.
+type noopTracer struct{}
+
+func (noopTracer) Start(operation string, parent SpanContext, opts *StartOptions) Span {
+	return noopSpan{sc: parent}
+}
+
+func (noopTracer) Stop() error { return nil }
+
+type noopSpan struct {
+	sc SpanContext
+}
+
+func (s noopSpan) Context() SpanContext                 { return s.sc }
+func (s noopSpan) SetTag(key string, value interface{}) {}
+func (s noopSpan) SetError(err error)                   {}
+func (s noopSpan) Finish()                              {}
@@ -0,0 +1,491 @@
+package tracing
+
+import (
+	"context"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/julienschmidt/httprouter"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/errs"
+)
+
+const (
+	testTraceID     = "4bf92f3577b34da6a3ce929d0e0e4736"
+	testSpanID      = "00f067aa0ba902b7"
+	testTraceparent = "00-" + testTraceID + "-" + testSpanID + "-01"
+)
+
+// collector is a fake OTLP collector keeping the exported spans.
+type collector struct {
+	mu    sync.Mutex
+	reqs  []*coltracepb.ExportTraceServiceRequest
+	hdrs  []http.Header
+	spans []*tracepb.Span
+}
+
+func (c *collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	req := new(coltracepb.ExportTraceServiceRequest)
+	if err := proto.Unmarshal(body, req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reqs = append(c.reqs, req)
+	c.hdrs = append(c.hdrs, r.Header)
+	for _, rs := range req.ResourceSpans {
+		for _, ss := range rs.ScopeSpans {
+			c.spans = append(c.spans, ss.Spans...)
+		}
+	}
+	w.Header().Set("Content-Type", "application/x-protobuf")
+}
+
+// newTestTracer sets an otlp tracer exporting to a fake collector, the spans are exported by stop.
+func newTestTracer(t *testing.T, sampleRatio float64) (*collector, func()) {
+	c := new(collector)
+	srv := httptest.NewServer(c)
+
+	logger := zerolog.New(ioutil.Discard)
+	tracer, err := newOTLP(&config.Config{Tracing: &config.Tracing{
+		ServiceName:          "zen-test",
+		SampleRatio:          sampleRatio,
+		OTLPEndpoint:         srv.URL + "/v1/traces",
+		OTLPHeaders:          "x-api-key=secret",
+		OTLPFlushIntervalSec: 60,
+	}}, &logger)
+	if err != nil {
+		t.Fatalf("new otlp tracer failed:%v", err)
+	}
+	SetTracer(tracer)
+
+	return c, func() {
+		if err := tracer.Stop(); err != nil {
+			t.Errorf("stop otlp tracer failed:%v", err)
+		}
+		SetTracer(noopTracer{})
+		srv.Close()
+	}
+}
+
+func attributeValue(attrs []*commonpb.KeyValue, key string) interface{} {
+	for _, kv := range attrs {
+		if kv.Key != key {
+			continue
+		}
+		switch v := kv.Value.Value.(type) {
+		case *commonpb.AnyValue_StringValue:
+			return v.StringValue
+		case *commonpb.AnyValue_IntValue:
+			return v.IntValue
+		case *commonpb.AnyValue_BoolValue:
+			return v.BoolValue
+		case *commonpb.AnyValue_DoubleValue:
+			return v.DoubleValue
+		}
+	}
+	return nil
+}
+
+func tag(span *tracepb.Span, key string) interface{} {
+	return attributeValue(span.Attributes, key)
+}
+
+func TestExtract(t *testing.T) {
+	testCases := [...]struct {
+		description string
+		value       string
+		expect      string
+	}{
+		{
+			description: "testing sampled case",
+			value:       testTraceparent,
+			expect:      testTraceparent,
+		},
+		{
+			description: "testing not sampled case",
+			value:       "00-" + testTraceID + "-" + testSpanID + "-00",
+			expect:      "00-" + testTraceID + "-" + testSpanID + "-00",
+		},
+		{
+			description: "testing later version case",
+			value:       "01-" + testTraceID + "-" + testSpanID + "-01-extra",
+			expect:      testTraceparent,
+		},
+		{
+			description: "testing version 00 with extra field case",
+			value:       testTraceparent + "-extra",
+			expect:      "",
+		},
+		{
+			description: "testing invalid version case",
+			value:       "ff-" + testTraceID + "-" + testSpanID + "-01",
+			expect:      "",
+		},
+		{
+			description: "testing uppercase case",
+			value:       "00-4BF92F3577B34DA6A3CE929D0E0E4736-" + testSpanID + "-01",
+			expect:      "",
+		},
+		{
+			description: "testing zero trace id case",
+			value:       "00-00000000000000000000000000000000-" + testSpanID + "-01",
+			expect:      "",
+		},
+		{
+			description: "testing zero span id case",
+			value:       "00-" + testTraceID + "-0000000000000000-01",
+			expect:      "",
+		},
+		{
+			description: "testing short trace id case",
+			value:       "00-4bf92f3577b34da6-" + testSpanID + "-01",
+			expect:      "",
+		},
+		{
+			description: "testing not hex case",
+			value:       "00-" + testTraceID + "-" + testSpanID + "-0x",
+			expect:      "",
+		},
+		{
+			description: "testing missing fields case",
+			value:       "00-" + testTraceID,
+			expect:      "",
+		},
+	}
+
+	for _, tt := range testCases {
+		in := http.Header{}
+		in.Set(TraceparentHeader, tt.value)
+		sc := SpanContextFromContext(Extract(context.Background(), HeaderCarrier(in)))
+		if actual := sc.Traceparent(); actual != tt.expect {
+			t.Errorf("[%s] expect traceparent:%q, actual:%q", tt.description, tt.expect, actual)
+		}
+	}
+}
+
+func TestPropagation(t *testing.T) {
+	testCases := [...]struct {
+		description string
+		header      string
+		expect      string
+	}{
+		{
+			description: "testing valid traceparent case",
+			header:      testTraceparent,
+			expect:      testTraceparent,
+		},
+		{
+			description: "testing invalid traceparent case",
+			header:      "invalid",
+			expect:      "",
+		},
+		{
+			description: "testing missing traceparent case",
+			header:      "",
+			expect:      "",
+		},
+	}
+
+	for _, tt := range testCases {
+		in := http.Header{}
+		if tt.header != "" {
+			in.Set(TraceparentHeader, tt.header)
+		}
+		ctx := Extract(context.Background(), HeaderCarrier(in))
+
+		// The spans of the noop tracer propagate the remote span context as is.
+		span, ctx := StartSpan(ctx, "test")
+		span.Finish()
+
+		md := metadata.MD{}
+		Inject(ctx, MetadataCarrier(md))
+		if actual := MetadataCarrier(md).Get(TraceparentHeader); actual != tt.expect {
+			t.Errorf("[%s] expect traceparent:%s, actual:%s", tt.description, tt.expect, actual)
+		}
+	}
+}
+
+func TestOTLPExport(t *testing.T) {
+	c, stop := newTestTracer(t, 1)
+
+	in := http.Header{}
+	in.Set(TraceparentHeader, testTraceparent)
+	ctx := Extract(context.Background(), HeaderCarrier(in))
+
+	parent, ctx := StartSpan(ctx, "parent", WithKind(KindServer), WithResource("GET /api/categories"), WithTag("http.status_code", 200))
+	child, _ := StartSpan(ctx, "child", WithTag("db.system", "postgresql"))
+	child.SetError(errors.New("query failed"))
+	child.Finish()
+	child.Finish()
+	parent.Finish()
+	task, _ := StartSpan(ctx, "task", WithNewRoot(), WithLink(parent.Context()))
+	task.Finish()
+	stop()
+
+	if len(c.reqs) != 1 {
+		t.Fatalf("expect export requests:1, actual:%d", len(c.reqs))
+	}
+	if actual := c.hdrs[0].Get("x-api-key"); actual != "secret" {
+		t.Errorf("expect header x-api-key:secret, actual:%s", actual)
+	}
+	if actual := attributeValue(c.reqs[0].ResourceSpans[0].Resource.Attributes, "service.name"); actual != "zen-test" {
+		t.Errorf("expect resource service.name:zen-test, actual:%v", actual)
+	}
+	if len(c.spans) != 3 {
+		t.Fatalf("expect spans:3, actual:%d", len(c.spans))
+	}
+
+	spans := make(map[string]*tracepb.Span)
+	for _, span := range c.spans {
+		spans[tag(span, "operation.name").(string)] = span
+	}
+
+	testCases := [...]struct {
+		description  string
+		span         *tracepb.Span
+		expectName   string
+		expectKind   Kind
+		expectTrace  string
+		expectParent string
+		expectLinks  []string
+		expectStatus *tracepb.Status
+		expectTag    string
+		expectValue  interface{}
+	}{
+		{
+			description:  "testing remote parent case",
+			span:         spans["parent"],
+			expectName:   "GET /api/categories",
+			expectKind:   KindServer,
+			expectTrace:  testTraceID,
+			expectParent: testSpanID,
+			expectTag:    "http.status_code",
+			expectValue:  int64(200),
+		},
+		{
+			description:  "testing local parent case",
+			span:         spans["child"],
+			expectName:   "child",
+			expectKind:   KindInternal,
+			expectTrace:  testTraceID,
+			expectParent: parent.Context().SpanIDString(),
+			expectStatus: &tracepb.Status{Code: tracepb.Status_STATUS_CODE_ERROR, Message: "query failed"},
+			expectTag:    "db.system",
+			expectValue:  "postgresql",
+		},
+		{
+			description: "testing new root case",
+			span:        spans["task"],
+			expectName:  "task",
+			expectKind:  KindInternal,
+			expectTrace: task.Context().TraceIDString(),
+			expectLinks: []string{testTraceID + "-" + parent.Context().SpanIDString()},
+		},
+	}
+
+	for _, tt := range testCases {
+		if tt.span.Name != tt.expectName {
+			t.Errorf("[%s] expect name:%s, actual:%s", tt.description, tt.expectName, tt.span.Name)
+		}
+		if Kind(tt.span.Kind) != tt.expectKind {
+			t.Errorf("[%s] expect kind:%d, actual:%d", tt.description, tt.expectKind, tt.span.Kind)
+		}
+		if actual := hex.EncodeToString(tt.span.TraceId); actual != tt.expectTrace {
+			t.Errorf("[%s] expect trace id:%s, actual:%s", tt.description, tt.expectTrace, actual)
+		}
+		if actual := hex.EncodeToString(tt.span.ParentSpanId); actual != tt.expectParent {
+			t.Errorf("[%s] expect parent span id:%s, actual:%s", tt.description, tt.expectParent, actual)
+		}
+		var links []string
+		for _, link := range tt.span.Links {
+			links = append(links, hex.EncodeToString(link.TraceId)+"-"+hex.EncodeToString(link.SpanId))
+		}
+		if diff := deep.Equal(links, tt.expectLinks); diff != nil {
+			t.Errorf("[%s] links diff:%v", tt.description, diff)
+		}
+		if tt.expectStatus != nil && (tt.span.Status.GetCode() != tt.expectStatus.Code || tt.span.Status.GetMessage() != tt.expectStatus.Message) {
+			t.Errorf("[%s] expect status:%v, actual:%v", tt.description, tt.expectStatus, tt.span.Status)
+		}
+		if tt.expectStatus == nil && tt.span.Status.GetCode() == tracepb.Status_STATUS_CODE_ERROR {
+			t.Errorf("[%s] expect no error status, actual:%v", tt.description, tt.span.Status)
+		}
+		if tt.expectTag != "" && tag(tt.span, tt.expectTag) != tt.expectValue {
+			t.Errorf("[%s] expect tag %s:%v, actual:%v", tt.description, tt.expectTag, tt.expectValue, tag(tt.span, tt.expectTag))
+		}
+	}
+	if task.Context().TraceID == parent.Context().TraceID {
+		t.Errorf("expect new root starts a new trace, actual trace id:%s", task.Context().TraceIDString())
+	}
+}
+
+func TestOTLPSampling(t *testing.T) {
+	c, stop := newTestTracer(t, 0)
+
+	root, _ := StartSpan(context.Background(), "root")
+	root.Finish()
+
+	in := http.Header{}
+	in.Set(TraceparentHeader, testTraceparent)
+	sampled, _ := StartSpan(Extract(context.Background(), HeaderCarrier(in)), "sampled")
+	sampled.Finish()
+
+	in.Set(TraceparentHeader, "00-"+testTraceID+"-"+testSpanID+"-00")
+	notSampled, _ := StartSpan(Extract(context.Background(), HeaderCarrier(in)), "not sampled")
+	notSampled.Finish()
+	stop()
+
+	if len(c.spans) != 1 || tag(c.spans[0], "operation.name") != "sampled" {
+		t.Errorf("expect the sampled span only, actual:%+v", c.spans)
+	}
+	if root.Context().Sampled || !root.Context().IsValid() {
+		t.Errorf("expect valid not sampled root, actual:%+v", root.Context())
+	}
+}
+
+func TestNew(t *testing.T) {
+	logger := zerolog.New(ioutil.Discard)
+
+	testCases := [...]struct {
+		description string
+		tracing     *config.Tracing
+		datadog     *config.Datadog
+		expectErr   bool
+		expectNoop  bool
+	}{
+		{
+			description: "testing none case",
+			tracing:     &config.Tracing{Exporter: ExporterNone},
+			datadog:     &config.Datadog{},
+			expectNoop:  true,
+		},
+		{
+			description: "testing datadog disabled case",
+			tracing:     &config.Tracing{Exporter: ExporterDatadog},
+			datadog:     &config.Datadog{Enable: false},
+			expectNoop:  true,
+		},
+		{
+			description: "testing unknown exporter case",
+			tracing:     &config.Tracing{Exporter: "zipkin"},
+			datadog:     &config.Datadog{},
+			expectErr:   true,
+		},
+		{
+			description: "testing otlp invalid sample ratio case",
+			tracing:     &config.Tracing{Exporter: ExporterOTLP, OTLPEndpoint: "http://localhost:4318/v1/traces", SampleRatio: 2, OTLPFlushIntervalSec: 5},
+			datadog:     &config.Datadog{},
+			expectErr:   true,
+		},
+		{
+			description: "testing otlp invalid headers case",
+			tracing:     &config.Tracing{Exporter: ExporterOTLP, OTLPEndpoint: "http://localhost:4318/v1/traces", SampleRatio: 1, OTLPHeaders: "x-api-key", OTLPFlushIntervalSec: 5},
+			datadog:     &config.Datadog{},
+			expectErr:   true,
+		},
+		{
+			description: "testing otlp empty endpoint case",
+			tracing:     &config.Tracing{Exporter: ExporterOTLP, SampleRatio: 1, OTLPFlushIntervalSec: 5},
+			datadog:     &config.Datadog{},
+			expectErr:   true,
+		},
+		{
+			description: "testing otlp case",
+			tracing:     &config.Tracing{Exporter: ExporterOTLP, OTLPEndpoint: "http://localhost:4318/v1/traces", SampleRatio: 1, OTLPFlushIntervalSec: 5},
+			datadog:     &config.Datadog{},
+		},
+	}
+
+	for _, tt := range testCases {
+		tracer, err := New(&config.Config{Tracing: tt.tracing, Datadog: tt.datadog}, &logger)
+		if (err != nil) != tt.expectErr {
+			t.Errorf("[%s] expect error:%v, actual:%v", tt.description, tt.expectErr, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if _, noop := tracer.(noopTracer); noop != tt.expectNoop {
+			t.Errorf("[%s] expect noop:%v, actual:%T", tt.description, tt.expectNoop, tracer)
+		}
+		tracer.Stop()
+	}
+}
+
+func TestHandle(t *testing.T) {
+	c, stop := newTestTracer(t, 1)
+
+	var propagated SpanContext
+	h := Handle("/api/articles/:article_id", func(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+		propagated = SpanContextFromContext(r.Context())
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	r := httptest.NewRequest(http.MethodGet, "/api/articles/1", nil)
+	r.Header.Set(TraceparentHeader, testTraceparent)
+	h(httptest.NewRecorder(), r, nil)
+	stop()
+
+	if len(c.spans) != 1 {
+		t.Fatalf("expect spans:1, actual:%d", len(c.spans))
+	}
+	span := c.spans[0]
+	if span.Name != "GET /api/articles/:article_id" || Kind(span.Kind) != KindServer {
+		t.Errorf("expect server span GET /api/articles/:article_id, actual:%s kind:%d", span.Name, span.Kind)
+	}
+	if hex.EncodeToString(span.TraceId) != testTraceID || hex.EncodeToString(span.ParentSpanId) != testSpanID {
+		t.Errorf("expect child of %s, actual trace id:%x parent:%x", testTraceparent, span.TraceId, span.ParentSpanId)
+	}
+	if actual := hex.EncodeToString(span.SpanId); actual != propagated.SpanIDString() {
+		t.Errorf("expect handler context carries span:%s, actual:%s", actual, propagated.SpanIDString())
+	}
+	if tag(span, "http.status_code") != int64(502) || span.Status.GetCode() != tracepb.Status_STATUS_CODE_ERROR {
+		t.Errorf("expect failed span with status code 502, actual:%v, status:%+v", tag(span, "http.status_code"), span.Status)
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	c, stop := newTestTracer(t, 1)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(TraceparentHeader, testTraceparent))
+	interceptor := UnaryServerInterceptor()
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/protobuf.Zendesk/GetCategories"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, errs.NewErr(errs.RecordNotFoundErrorCode, errors.New("not found"))
+		})
+	if err == nil {
+		t.Errorf("expect the handler error, actual nil")
+	}
+	stop()
+
+	if len(c.spans) != 1 {
+		t.Fatalf("expect spans:1, actual:%d", len(c.spans))
+	}
+	span := c.spans[0]
+	if span.Name != "/protobuf.Zendesk/GetCategories" || hex.EncodeToString(span.TraceId) != testTraceID || hex.EncodeToString(span.ParentSpanId) != testSpanID {
+		t.Errorf("expect child span of %s, actual:%+v", testTraceparent, span)
+	}
+	if tag(span, "grpc.code") != "NotFound" || span.Status.GetCode() != tracepb.Status_STATUS_CODE_ERROR {
+		t.Errorf("expect failed span with code NotFound, actual:%v, status:%+v", tag(span, "grpc.code"), span.Status)
+	}
+}
@@ -0,0 +1,154 @@
+package votes
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+
+	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/errs"
+	"github.com/honestbee/Zen/models"
+	"github.com/honestbee/Zen/zendesk"
+)
+
+var (
+	// ErrUnknownVoter means the voter has neither a principal, a device id nor a remote ip.
+	ErrUnknownVoter = errors.New("votes: voter is unknown")
+	// ErrTooManyVotes means the votes from the remote ip on the article exceed the limit.
+	ErrTooManyVotes = errors.New("votes: too many votes from the ip")
+	// ErrVoteInProgress means another vote of the voter on the article is being cast.
+	ErrVoteInProgress = errors.New("votes: vote of the voter is in progress")
+)
+
+// Ballot is a vote of a voter on an article to be cast.
+type Ballot struct {
+	ArticleID int
+	Value     string
+	Voter     string
+	// RemoteIP caps the votes on the article whichever the voter is.
+	RemoteIP    string
+	CountryCode string
+	Locale      string
+}
+
+// Result is the votes of the article after the ballot is cast.
+type Result struct {
+	VoteSum   int
+	VoteCount int
+	// Duplicate is true if the voter has cast the same vote, it is not forwarded to zendesk.
+	Duplicate bool
+}
+
+// Ledger keeps one vote per voter on an article, only the net change is forwarded to zendesk.
+type Ledger struct {
+	conf    *config.Config
+	service models.HelpDeskService
+	zend    *zendesk.ZenDesk
+}
+
+// New returns a Ledger instance.
+func New(conf *config.Config, service models.HelpDeskService, zend *zendesk.ZenDesk) *Ledger {
+	return &Ledger{
+		conf:    conf,
+		service: service,
+		zend:    zend,
+	}
+}
+
+// Cast casts the ballot. The same vote cast again is ignored and the current votes of the article are returned,
+// a changed vote withdraws the previous vote from zendesk before the new vote is forwarded.
+func (l *Ledger) Cast(ctx context.Context, b *Ballot) (*Result, error) {
+	if b.Voter == "" {
+		return nil, ErrUnknownVoter
+	}
+	// The voters are kept hashed since they may be the remote ips.
+	voter := hashVoter(b.Voter)
+
+	locked, err := l.service.LockArticleVote(ctx, b.ArticleID, b.CountryCode, voter)
+	if err != nil {
+		return nil, errors.Wrapf(err, "votes: [Cast] service.LockArticleVote failed")
+	}
+	if !locked {
+		return nil, ErrVoteInProgress
+	}
+	defer l.service.UnlockArticleVote(ctx, b.ArticleID, b.CountryCode, voter)
+
+	previous, err := l.service.GetArticleVote(ctx, b.ArticleID, b.CountryCode, voter)
+	if err != nil && err != models.ErrNotFound {
+		return nil, errors.Wrapf(err, "votes: [Cast] service.GetArticleVote failed")
+	}
+
+	previousValue := ""
+	if previous != nil {
+		if previous.Value == b.Value {
+			article, err := l.service.GetArticleByArticleID(ctx, b.ArticleID, b.Locale, b.CountryCode)
+			if err != nil {
+				return nil, errors.Wrapf(err, "votes: [Cast] service.GetArticleByArticleID failed")
+			}
+			return &Result{VoteSum: article.VoteSum, VoteCount: article.VoteCount, Duplicate: true}, nil
+		}
+
+		previousValue = previous.Value
+	}
+
+	// The new and the changed votes are capped per ip, so that a voter can't vote again by a new identity.
+	if err := l.limitIP(ctx, b); err != nil {
+		return nil, err
+	}
+
+	if previous != nil && previous.ZendeskVoteID != 0 {
+		if err := l.zend.DeleteVote(ctx, previous.ZendeskVoteID, b.CountryCode); err != nil {
+			return nil, errors.Wrapf(err, "votes: [Cast] zendesk.DeleteVote failed")
+		}
+	}
+
+	vote, err := l.zend.CreateVote(ctx, b.ArticleID, b.Value, b.CountryCode, b.Locale)
+	if err != nil {
+		return nil, errors.Wrapf(err, "votes: [Cast] zendesk.CreateVote failed")
+	}
+
+	if err := l.service.SaveArticleVote(ctx, &models.ArticleVote{
+		ArticleID:     b.ArticleID,
+		CountryCode:   b.CountryCode,
+		Voter:         voter,
+		Value:         b.Value,
+		ZendeskVoteID: vote.ID,
+	}, previousValue); err != nil {
+		return nil, errors.Wrapf(err, "votes: [Cast] service.SaveArticleVote failed")
+	}
+
+	return &Result{VoteSum: vote.VoteSum, VoteCount: vote.VoteCount}, nil
+}
+
+func (l *Ledger) limitIP(ctx context.Context, b *Ballot) error {
+	if l.conf.Votes.MaxPerIP == 0 || b.RemoteIP == "" {
+		return nil
+	}
+	count, err := l.service.PlusOneArticleVoteIPCounter(ctx, b.ArticleID, b.CountryCode, hashVoter(b.RemoteIP), l.conf.Votes.IPWindowSec)
+	if err != nil {
+		return errors.Wrapf(err, "votes: [Cast] service.PlusOneArticleVoteIPCounter failed")
+	}
+	if count > l.conf.Votes.MaxPerIP {
+		return ErrTooManyVotes
+	}
+	return nil
+}
+
+// CastErr wraps the error returned by Cast with the matching error code.
+func CastErr(err error, msg string) *errs.Error {
+	switch errors.Cause(err) {
+	case ErrUnknownVoter:
+		return errs.NewErr(errs.InvalidAttributeErrorCode, errors.Wrap(err, msg))
+	case ErrVoteInProgress, ErrTooManyVotes:
+		return errs.NewErr(errs.TooManyRequestsErrCode, errors.Wrap(err, msg))
+	default:
+		return errs.NewErr(errs.ServerInternalErrorCode, errors.Wrap(err, msg))
+	}
+}
+
+func hashVoter(voter string) string {
+	h := sha256.Sum256([]byte(voter))
+	return hex.EncodeToString(h[:])
+}
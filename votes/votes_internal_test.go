@@ -0,0 +1,171 @@
+package votes
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/pkg/errors"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/models"
+	"github.com/honestbee/Zen/zendesk"
+)
+
+func TestCast(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://honestbeehelp-tw.zendesk.com/").
+		Post("/hc/en-us/articles/3345679/vote").
+		Filter(func(req *http.Request) bool { return req.PostFormValue("value") == models.UpVote }).
+		Reply(http.StatusOK).
+		JSON(&zendesk.Vote{ID: 360002569612, VoteSum: 5, VoteCount: 7, Value: models.UpVote})
+
+	gock.New("https://honestbeehelp-tw.zendesk.com/").
+		Delete("/api/v2/help_center/votes/360002569612.json").
+		Reply(http.StatusNoContent)
+
+	gock.New("https://honestbeehelp-tw.zendesk.com/").
+		Post("/hc/en-us/articles/3345679/vote").
+		Filter(func(req *http.Request) bool { return req.PostFormValue("value") == models.DownVote }).
+		Reply(http.StatusOK).
+		JSON(&zendesk.Vote{ID: 360002569613, VoteSum: 3, VoteCount: 7, Value: models.DownVote})
+
+	ms := models.NewMockService()
+	zend, _ := zendesk.NewZenDesk(&config.Config{
+		ZenDesk: &config.ZenDesk{
+			RequestTimeoutSec: 20,
+			TWBaseURL:         "https://honestbeehelp-tw.zendesk.com",
+		},
+	})
+	ledger := New(&config.Config{Votes: &config.Votes{MaxPerIP: 2, IPWindowSec: 60}}, ms, zend)
+
+	ctx := context.Background()
+	// The voter "device:busy" is voting in another request.
+	ms.LockArticleVote(ctx, 3345679, "tw", hashVoter("device:busy"))
+
+	ballot := func(voter, value, countryCode string) *Ballot {
+		return &Ballot{ArticleID: 3345679, Value: value, Voter: voter, RemoteIP: "10.0.0.1", CountryCode: countryCode, Locale: "en-us"}
+	}
+
+	// The cases are run in order, the ledger keeps the votes of the previous cases.
+	testCases := [...]struct {
+		description string
+		input       *Ballot
+		expect      *Result
+		expectErr   error
+	}{
+		{
+			description: "testing first vote case",
+			input:       ballot("device:a", models.UpVote, "tw"),
+			expect:      &Result{VoteSum: 5, VoteCount: 7},
+		},
+		{
+			description: "testing duplicate vote case",
+			input:       ballot("device:a", models.UpVote, "tw"),
+			expect:      &Result{VoteSum: 0, VoteCount: 0, Duplicate: true},
+		},
+		{
+			description: "testing changed vote case",
+			input:       ballot("device:a", models.DownVote, "tw"),
+			expect:      &Result{VoteSum: 3, VoteCount: 7},
+		},
+		{
+			description: "testing unknown voter case",
+			input:       ballot("", models.UpVote, "tw"),
+			expectErr:   ErrUnknownVoter,
+		},
+		{
+			description: "testing vote in progress case",
+			input:       ballot("device:busy", models.UpVote, "tw"),
+			expectErr:   ErrVoteInProgress,
+		},
+		{
+			description: "testing too many votes from the ip case",
+			input:       ballot("device:b", models.UpVote, "tw"),
+			expectErr:   ErrTooManyVotes,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			actual, err := ledger.Cast(ctx, tt.input)
+			if errors.Cause(err) != tt.expectErr {
+				t.Errorf("[%s] expect error:%v, actual:%v", tt.description, tt.expectErr, err)
+			} else if diff := deep.Equal(tt.expect, actual); diff != nil {
+				t.Errorf("[%s] %v", tt.description, diff)
+			}
+		})
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("expect all the zendesk requests are sent, pending:%d", len(gock.Pending()))
+	}
+
+	vote, err := ms.GetArticleVote(ctx, 3345679, "tw", hashVoter("device:a"))
+	if err != nil {
+		t.Fatalf("expect the vote is kept, actual:%v", err)
+	}
+	if vote.Value != models.DownVote || vote.ZendeskVoteID != 360002569613 {
+		t.Errorf("expect the changed vote is kept, actual:%+v", vote)
+	}
+
+	history, _ := ms.GetArticleVoteHistory(ctx, &models.GetArticleVoteHistoryParams{ArticleID: 3345679, WindowDays: 30, CountryCode: "tw"})
+	if len(history) != 1 || history[0].Votes != 2 || history[0].Upvotes != 0 || history[0].Downvotes != 1 {
+		t.Errorf("expect the change is recorded once, actual:%+v", history)
+	}
+}
+
+func TestCastServiceError(t *testing.T) {
+	ledger := New(&config.Config{Votes: &config.Votes{}}, models.NewMockService(), nil)
+
+	_, err := ledger.Cast(context.Background(), &Ballot{
+		ArticleID:   3345679,
+		Value:       models.UpVote,
+		Voter:       "ip:10.0.0.1",
+		CountryCode: models.ModelsReturnErrorCountryCode,
+		Locale:      "en-us",
+	})
+	if err == nil {
+		t.Errorf("expect an error, actual nil")
+	}
+}
+
+func TestCastErr(t *testing.T) {
+	testCases := [...]struct {
+		description string
+		input       error
+		expect      int
+	}{
+		{
+			description: "testing unknown voter case",
+			input:       ErrUnknownVoter,
+			expect:      http.StatusBadRequest,
+		},
+		{
+			description: "testing vote in progress case",
+			input:       errors.Wrap(ErrVoteInProgress, "wrapped"),
+			expect:      http.StatusTooManyRequests,
+		},
+		{
+			description: "testing too many votes case",
+			input:       ErrTooManyVotes,
+			expect:      http.StatusTooManyRequests,
+		},
+		{
+			description: "testing other error case",
+			input:       errors.New("zendesk is down"),
+			expect:      http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.description, func(t *testing.T) {
+			if actual := CastErr(tt.input, "votes: testing").Status; actual != tt.expect {
+				t.Errorf("[%s] expect:%d, actual:%d", tt.description, tt.expect, actual)
+			}
+		})
+	}
+}
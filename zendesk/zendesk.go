@@ -8,14 +8,17 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/pkg/errors"
-	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
 
 	"github.com/honestbee/Zen/config"
+	"github.com/honestbee/Zen/metrics"
+	"github.com/honestbee/Zen/redact"
+	"github.com/honestbee/Zen/tracing"
 )
 
 // ZenDesk is the instance to conmunicate with zendesk API.
@@ -52,28 +55,56 @@ func NewZenDesk(conf *config.Config) (*ZenDesk, error) {
 	}, nil
 }
 
-func (z *ZenDesk) connect(ctx context.Context, dest interface{}, expectStatus int, req *http.Request) error {
-	span, _ := tracer.StartSpanFromContext(ctx, req.URL.Path)
-	defer span.Finish()
+// statusError is the error of the unexpected response status.
+type statusError struct {
+	url    string
+	expect int
+	status int
+	actual string
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("zendesk: [connect] url[%s] status expect[%v], actual[%v]", e.url, e.expect, e.actual)
+}
+
+// connect sends req in a client span, the traceparent of the span is propagated to zendesk.
+func (z *ZenDesk) connect(ctx context.Context, dest interface{}, expectStatus int, req *http.Request) (err error) {
+	span, ctx := tracing.StartSpan(ctx, "zendesk.request",
+		tracing.WithKind(tracing.KindClient),
+		tracing.WithService("zendesk"),
+		tracing.WithResource(req.Method+" "+req.URL.Path),
+		tracing.WithTag("http.method", req.Method),
+		tracing.WithTag("peer.hostname", req.URL.Host),
+	)
+	defer func() {
+		span.SetError(err)
+		span.Finish()
+	}()
 
 	req.Header.Set("Cache-Control", "no-cache")
+	tracing.Inject(ctx, tracing.HeaderCarrier(req.Header))
+	start := time.Now()
 	resp, err := z.client.Do(req)
 	if err != nil {
-		return errors.Wrapf(err, "zendesk: [connect] url[%s] http client do failed", req.URL.String())
+		metrics.ObserveZendeskRequest(req.URL.Host, 0, start)
+		return errors.Wrapf(redact.Error(err), "zendesk: [connect] url[%s] http client do failed", redact.String(req.URL.String()))
 	}
 	defer resp.Body.Close()
+	metrics.ObserveZendeskRequest(req.URL.Host, resp.StatusCode, start)
+	span.SetTag("http.status_code", resp.StatusCode)
 
 	if resp.StatusCode != expectStatus {
-		return errors.Errorf("zendesk: [connect] url[%s] status expect[%v], actual[%v]",
-			req.RequestURI,
-			expectStatus,
-			resp.Status,
-		)
+		return &statusError{
+			url:    redact.String(req.URL.String()),
+			expect: expectStatus,
+			status: resp.StatusCode,
+			actual: resp.Status,
+		}
 	}
 
 	if dest != nil {
 		if err = json.NewDecoder(resp.Body).Decode(dest); err != nil {
-			return errors.Wrapf(err, "zendesk: [connect] url[%s] json decode failed", req.URL.String())
+			return errors.Wrapf(err, "zendesk: [connect] url[%s] json decode failed", redact.String(req.URL.String()))
 		}
 	}
 
@@ -83,7 +114,7 @@ func (z *ZenDesk) connect(ctx context.Context, dest interface{}, expectStatus in
 func (z *ZenDesk) authConnectPOST(ctx context.Context, dest interface{}, url string, expectStatus int, params io.Reader) error {
 	req, err := http.NewRequest(http.MethodPost, url, params)
 	if err != nil {
-		return errors.Wrapf(err, "zendesk: [authConnectPOST] url[%s] http NewRequest failed", url)
+		return errors.Wrapf(redact.Error(err), "zendesk: [authConnectPOST] url[%s] http NewRequest failed", redact.String(url))
 	}
 	req.Header.Set("Authorization", "Basic "+z.token)
 	req.Header.Set("Content-Type", "application/json")
@@ -91,48 +122,62 @@ func (z *ZenDesk) authConnectPOST(ctx context.Context, dest interface{}, url str
 	return errors.Wrapf(
 		z.connect(ctx, dest, expectStatus, req),
 		"zendesk: [authConnectPOST] url[%s] connect failed",
-		url,
+		redact.String(url),
 	)
 }
 
 func (z *ZenDesk) connectPOST(ctx context.Context, dest interface{}, url string, expectStatus int, params io.Reader) error {
 	req, err := http.NewRequest(http.MethodPost, url, params)
 	if err != nil {
-		return errors.Wrapf(err, "zendesk: [connectPOST] url[%s] http NewRequest failed", url)
+		return errors.Wrapf(redact.Error(err), "zendesk: [connectPOST] url[%s] http NewRequest failed", redact.String(url))
 	}
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
 	return errors.Wrapf(
 		z.connect(ctx, dest, expectStatus, req),
 		"zendesk: [connectPOST] url[%s] connect failed",
-		url,
+		redact.String(url),
 	)
 }
 
 func (z *ZenDesk) authConnectGET(ctx context.Context, dest interface{}, url string, expectStatus int, params io.Reader) error {
 	req, err := http.NewRequest(http.MethodGet, url, params)
 	if err != nil {
-		return errors.Wrapf(err, "zendesk: [authConnectGET] url[%s] http NewRequest failed", url)
+		return errors.Wrapf(redact.Error(err), "zendesk: [authConnectGET] url[%s] http NewRequest failed", redact.String(url))
 	}
 	req.Header.Set("Authorization", "Basic "+z.token)
 
 	return errors.Wrapf(
 		z.connect(ctx, dest, expectStatus, req),
 		"zendesk: [authConnectGET] url[%s] connect failed",
-		url,
+		redact.String(url),
+	)
+}
+
+func (z *ZenDesk) authConnectDELETE(ctx context.Context, url string, expectStatus int) error {
+	req, err := http.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return errors.Wrapf(redact.Error(err), "zendesk: [authConnectDELETE] url[%s] http NewRequest failed", redact.String(url))
+	}
+	req.Header.Set("Authorization", "Basic "+z.token)
+
+	return errors.Wrapf(
+		z.connect(ctx, nil, expectStatus, req),
+		"zendesk: [authConnectDELETE] url[%s] connect failed",
+		redact.String(url),
 	)
 }
 
 func (z *ZenDesk) connectGET(ctx context.Context, dest interface{}, url string, expectStatus int, params io.Reader) error {
 	req, err := http.NewRequest(http.MethodGet, url, params)
 	if err != nil {
-		return errors.Wrapf(err, "zendesk: [connectGET] url[%s] http NewRequest failed", url)
+		return errors.Wrapf(redact.Error(err), "zendesk: [connectGET] url[%s] http NewRequest failed", redact.String(url))
 	}
 
 	return errors.Wrapf(
 		z.connect(ctx, dest, expectStatus, req),
 		"zendesk: [connectGET] url[%s] connect failed",
-		url,
+		redact.String(url),
 	)
 }
 
@@ -336,6 +381,21 @@ func (z *ZenDesk) CreateVote(ctx context.Context, id int, expectVote, countryCod
 	return vote, nil
 }
 
+// DeleteVote deletes the vote depends on vote id and country code, so the vote can be cast again.
+// The vote already deleted is not an error.
+func (z *ZenDesk) DeleteVote(ctx context.Context, voteID int64, countryCode string) error {
+	url := fmt.Sprintf("%s/api/v2/help_center/votes/%d.json",
+		z.identifyCountryCode(countryCode),
+		voteID,
+	)
+
+	err := z.authConnectDELETE(ctx, url, http.StatusNoContent)
+	if se, ok := errors.Cause(err).(*statusError); ok && se.status == http.StatusNotFound {
+		return nil
+	}
+	return errors.Wrapf(err, "zendesk: [DeleteVote] connect failed")
+}
+
 // InstantSearch returns search result depends on query text, country code and locale.
 func (z *ZenDesk) InstantSearch(ctx context.Context, queryText, countryCode, locale string) (*InstantSearch, error) {
 	url := fmt.Sprintf("%s/hc/api/internal/instant_search.json?locale=%s&query=%s",
@@ -380,6 +440,70 @@ func (z *ZenDesk) Search(ctx context.Context, categoryIDs []int, queryText, coun
 	return search, nil
 }
 
+// SearchFrom returns the search results from the offset, the page of pagination is set by the offset.
+// The offset of a cursor may point inside a zendesk page, then the rest of the page is joined with
+// the head of the next one, so that the results start right at the offset.
+func (z *ZenDesk) SearchFrom(ctx context.Context, categoryIDs []int, queryText, countryCode, locale string, offset int, pagination *Pagination) (*Search, error) {
+	pagination.Page = 1
+	if pagination.PerPage > 0 {
+		pagination.Page = offset/pagination.PerPage + 1
+	}
+	search, err := z.Search(ctx, categoryIDs, queryText, countryCode, locale, pagination)
+	if err != nil {
+		return nil, errors.Wrapf(err, "zendesk: [SearchFrom] search failed")
+	}
+
+	skip := offset - (search.Page-1)*search.PerPage
+	if skip <= 0 {
+		return search, nil
+	}
+
+	articles := search.Articles
+	if search.Page < search.PageCount {
+		pagination.Page++
+		next, err := z.Search(ctx, categoryIDs, queryText, countryCode, locale, pagination)
+		if err != nil {
+			return nil, errors.Wrapf(err, "zendesk: [SearchFrom] search next page failed")
+		}
+		articles = append(articles, next.Articles...)
+	}
+	if skip > len(articles) {
+		skip = len(articles)
+	}
+	articles = articles[skip:]
+	if len(articles) > search.PerPage {
+		articles = articles[:search.PerPage]
+	}
+	search.Articles = articles
+	return search, nil
+}
+
+// Ping checks the help centers of all the countries are reachable.
+func (z *ZenDesk) Ping(ctx context.Context) error {
+	for _, countryCode := range z.CountryCodes() {
+		addr := fmt.Sprintf("%s/api/v2/help_center/locales.json", z.urlTable[countryCode])
+		req, err := http.NewRequest(http.MethodGet, addr, nil)
+		if err != nil {
+			return errors.Wrapf(redact.Error(err), "zendesk: [Ping] url[%s] http NewRequest failed", redact.String(addr))
+		}
+		if err = z.connect(ctx, nil, http.StatusOK, req.WithContext(ctx)); err != nil {
+			return errors.Wrapf(err, "zendesk: [Ping] country code[%s] connect failed", countryCode)
+		}
+	}
+
+	return nil
+}
+
+// CountryCodes returns the sorted country codes of the help centers.
+func (z *ZenDesk) CountryCodes() []string {
+	countryCodes := make([]string, 0, len(z.urlTable))
+	for countryCode := range z.urlTable {
+		countryCodes = append(countryCodes, countryCode)
+	}
+	sort.Strings(countryCodes)
+	return countryCodes
+}
+
 func (z *ZenDesk) identifyCountryCode(countryCode string) string {
 	return z.urlTable[countryCode]
 }
@@ -697,3 +697,9 @@ func TestZenDeskSearch(t *testing.T) {
 		})
 	}
 }
+
+func TestPing(t *testing.T) {
+	if err := zend.Ping(context.Background()); err != nil {
+		t.Errorf("expect no error, actual:%v", err)
+	}
+}